@@ -672,8 +672,12 @@ func (r *ApplicationSetReconciler) createOrUpdateInCluster(ctx context.Context,
 
 		action, err := utils.CreateOrUpdate(ctx, appLog, r.Client, applicationSet.Spec.IgnoreApplicationDifferences, normalizers.IgnoreNormalizerOpts{}, found, func() error {
 			// Copy only the Application/ObjectMeta fields that are significant, from the generatedApp
+			pause := found.Spec.SyncPolicy.GetPause()
 			found.Spec = generatedApp.Spec
 
+			// The sync pause is set through the Pause API of the application, and kept until it expires or is resumed
+			found.Spec.SetSyncPause(pause)
+
 			// allow setting the Operation field to trigger a sync operation on an Application
 			if generatedApp.Operation != nil {
 				found.Operation = generatedApp.Operation
@@ -718,10 +722,10 @@ func (r *ApplicationSetReconciler) createOrUpdateInCluster(ctx context.Context,
 				}
 			}
 
-			// Preserve post-delete finalizers:
+			// Preserve pre-delete and post-delete finalizers:
 			//   https://github.com/argoproj/argo-cd/issues/17181
 			for _, finalizer := range found.Finalizers {
-				if strings.HasPrefix(finalizer, argov1alpha1.PostDeleteFinalizerName) {
+				if finalizer == argov1alpha1.PreDeleteFinalizerName || strings.HasPrefix(finalizer, argov1alpha1.PostDeleteFinalizerName) {
 					if generatedApp.Finalizers == nil {
 						generatedApp.Finalizers = []string{}
 					}
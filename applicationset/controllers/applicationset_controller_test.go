@@ -1011,7 +1011,7 @@ func TestCreateOrUpdateInCluster(t *testing.T) {
 			},
 		},
 		{
-			name: "Ensure that argocd post-delete finalizers are preserved from an existing app",
+			name: "Ensure that argocd pre-delete and post-delete finalizers are preserved from an existing app",
 			appSet: v1alpha1.ApplicationSet{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "name",
@@ -1036,6 +1036,7 @@ func TestCreateOrUpdateInCluster(t *testing.T) {
 						Namespace:       "namespace",
 						ResourceVersion: "2",
 						Finalizers: []string{
+							v1alpha1.PreDeleteFinalizerName,
 							v1alpha1.PostDeleteFinalizerName,
 							v1alpha1.PostDeleteFinalizerName + "/mystage",
 						},
@@ -1067,6 +1068,7 @@ func TestCreateOrUpdateInCluster(t *testing.T) {
 						Namespace:       "namespace",
 						ResourceVersion: "2",
 						Finalizers: []string{
+							v1alpha1.PreDeleteFinalizerName,
 							v1alpha1.PostDeleteFinalizerName,
 							v1alpha1.PostDeleteFinalizerName + "/mystage",
 						},
@@ -1077,6 +1079,77 @@ func TestCreateOrUpdateInCluster(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Ensure that the sync pause is preserved from an existing app",
+			appSet: v1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "name",
+					Namespace: "namespace",
+				},
+				Spec: v1alpha1.ApplicationSetSpec{
+					Template: v1alpha1.ApplicationSetTemplate{
+						Spec: v1alpha1.ApplicationSpec{
+							Project: "project",
+						},
+					},
+				},
+			},
+			existingApps: []v1alpha1.Application{
+				{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       application.ApplicationKind,
+						APIVersion: "argoproj.io/v1alpha1",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "app1",
+						Namespace:       "namespace",
+						ResourceVersion: "2",
+					},
+					Spec: v1alpha1.ApplicationSpec{
+						Project: "project",
+						SyncPolicy: &v1alpha1.SyncPolicy{
+							Automated: &v1alpha1.SyncPolicyAutomated{},
+							Pause:     &v1alpha1.SyncPause{Until: metav1.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC), PausedBy: "admin"},
+						},
+					},
+				},
+			},
+			desiredApps: []v1alpha1.Application{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "app1",
+						Namespace: "namespace",
+					},
+					Spec: v1alpha1.ApplicationSpec{
+						Project: "project",
+						SyncPolicy: &v1alpha1.SyncPolicy{
+							Automated: &v1alpha1.SyncPolicyAutomated{Prune: true},
+						},
+					},
+				},
+			},
+			expected: []v1alpha1.Application{
+				{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       application.ApplicationKind,
+						APIVersion: "argoproj.io/v1alpha1",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "app1",
+						Namespace:       "namespace",
+						ResourceVersion: "3",
+					},
+					Spec: v1alpha1.ApplicationSpec{
+						Project: "project",
+						SyncPolicy: &v1alpha1.SyncPolicy{
+							Automated: &v1alpha1.SyncPolicyAutomated{Prune: true},
+							// the time is decoded in the local time zone by the client
+							Pause: &v1alpha1.SyncPause{Until: metav1.NewTime(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC).Local()), PausedBy: "admin"},
+						},
+					},
+				},
+			},
+		},
 	} {
 		t.Run(c.name, func(t *testing.T) {
 			initObjs := []crtclient.Object{&c.appSet}
@@ -36,6 +36,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/errors"
 	kubeutil "github.com/argoproj/argo-cd/v3/util/kube"
 	"github.com/argoproj/argo-cd/v3/util/settings"
+	"github.com/argoproj/argo-cd/v3/util/snapshot"
 	"github.com/argoproj/argo-cd/v3/util/tls"
 	"github.com/argoproj/argo-cd/v3/util/trace"
 )
@@ -70,6 +71,7 @@ func NewCommand() *cobra.Command {
 		selfHealBackoffCapSeconds        int
 		selfHealBackoffCooldownSeconds   int
 		syncTimeout                      int
+		queueStarvationTimeout           time.Duration
 		statusProcessors                 int
 		operationProcessors              int
 		glogLevel                        int
@@ -83,6 +85,7 @@ func NewCommand() *cobra.Command {
 		redisClient                      *redis.Client
 		repoServerPlaintext              bool
 		repoServerStrictTLS              bool
+		repoServerAffinity               bool
 		otlpAddress                      string
 		otlpInsecure                     bool
 		otlpHeaders                      map[string]string
@@ -90,9 +93,13 @@ func NewCommand() *cobra.Command {
 		applicationNamespaces            []string
 		persistResourceHealth            bool
 		shardingAlgorithm                string
+		shardingLoadTolerance            float64
+		shardingRebalanceInterval        time.Duration
 		enableDynamicClusterDistribution bool
 		serverSideDiff                   bool
 		ignoreNormalizerOpts             normalizers.IgnoreNormalizerOpts
+		syncSnapshotStore                string
+		syncSnapshotRetention            int
 
 		// argocd k8s event logging flag
 		enableK8sEvent  []string
@@ -164,7 +171,7 @@ func NewCommand() *cobra.Command {
 				tlsConfig.Certificates = pool
 			}
 
-			repoClientset := apiclient.NewRepoServerClientset(repoServerAddress, repoServerTimeoutSeconds, tlsConfig)
+			repoClientset := apiclient.NewRepoServerClientset(repoServerAddress, repoServerTimeoutSeconds, tlsConfig, apiclient.WithRepoAffinity(repoServerAffinity))
 
 			commitClientset := commitclient.NewCommitServerClientset(commitServerAddress)
 
@@ -180,6 +187,8 @@ func NewCommand() *cobra.Command {
 			kubectl := kubeutil.NewKubectl()
 			clusterSharding, err := sharding.GetClusterSharding(kubeClient, settingsMgr, shardingAlgorithm, enableDynamicClusterDistribution)
 			errors.CheckError(err)
+			snapshotStore, err := snapshot.NewStore(syncSnapshotStore, kubeClient, namespace)
+			errors.CheckError(err)
 			var selfHealBackoff *wait.Backoff
 			if selfHealBackoffTimeoutSeconds != 0 {
 				selfHealBackoff = &wait.Backoff{
@@ -215,11 +224,14 @@ func NewCommand() *cobra.Command {
 				clusterSharding,
 				applicationNamespaces,
 				&workqueueRateLimit,
+				queueStarvationTimeout,
 				serverSideDiff,
 				enableDynamicClusterDistribution,
 				ignoreNormalizerOpts,
 				enableK8sEvent,
 				hydratorEnabled,
+				snapshotStore,
+				syncSnapshotRetention,
 			)
 			errors.CheckError(err)
 			cacheutil.CollectMetrics(redisClient, appController.GetMetricsServer(), nil)
@@ -247,6 +259,10 @@ func NewCommand() *cobra.Command {
 
 			go appController.Run(ctx, statusProcessors, operationProcessors)
 
+			if shardingAlgorithm == common.LoadAwareShardingAlgorithm {
+				go sharding.NewLoadAwareRebalancer(kubeClient, namespace, clusterSharding, cache, shardingLoadTolerance, shardingRebalanceInterval).Run(ctx)
+			}
+
 			<-ctx.Done()
 
 			log.Println("clean shutdown")
@@ -275,10 +291,13 @@ func NewCommand() *cobra.Command {
 	command.Flags().IntVar(&selfHealBackoffFactor, "self-heal-backoff-factor", env.ParseNumFromEnv("ARGOCD_APPLICATION_CONTROLLER_SELF_HEAL_BACKOFF_FACTOR", 3, 0, math.MaxInt32), "Specifies factor of exponential timeout between application self heal attempts")
 	command.Flags().IntVar(&selfHealBackoffCapSeconds, "self-heal-backoff-cap-seconds", env.ParseNumFromEnv("ARGOCD_APPLICATION_CONTROLLER_SELF_HEAL_BACKOFF_CAP_SECONDS", 300, 0, math.MaxInt32), "Specifies max timeout of exponential backoff between application self heal attempts")
 	command.Flags().IntVar(&selfHealBackoffCooldownSeconds, "self-heal-backoff-cooldown-seconds", env.ParseNumFromEnv("ARGOCD_APPLICATION_CONTROLLER_SELF_HEAL_BACKOFF_COOLDOWN_SECONDS", 330, 0, math.MaxInt32), "Specifies period of time the app needs to stay synced before the self heal backoff can reset")
+	command.Flags().StringVar(&syncSnapshotStore, "sync-snapshot-store", env.StringFromEnv("ARGOCD_APPLICATION_CONTROLLER_SYNC_SNAPSHOT_STORE", snapshot.StoreSecret), "Store of the live state snapshots taken by sync operations with the Snapshot=true sync option. Either \"secret\" or an s3://bucket/prefix URL")
+	command.Flags().IntVar(&syncSnapshotRetention, "sync-snapshot-retention", env.ParseNumFromEnv("ARGOCD_APPLICATION_CONTROLLER_SYNC_SNAPSHOT_RETENTION", 10, 0, math.MaxInt32), "Number of live state snapshots kept per application. 0 keeps all snapshots")
 	command.Flags().IntVar(&syncTimeout, "sync-timeout", env.ParseNumFromEnv("ARGOCD_APPLICATION_CONTROLLER_SYNC_TIMEOUT", 0, 0, math.MaxInt32), "Specifies the timeout after which a sync would be terminated. 0 means no timeout (default 0).")
 	command.Flags().Int64Var(&kubectlParallelismLimit, "kubectl-parallelism-limit", env.ParseInt64FromEnv("ARGOCD_APPLICATION_CONTROLLER_KUBECTL_PARALLELISM_LIMIT", 20, 0, math.MaxInt64), "Number of allowed concurrent kubectl fork/execs. Any value less than 1 means no limit.")
 	command.Flags().BoolVar(&repoServerPlaintext, "repo-server-plaintext", env.ParseBoolFromEnv("ARGOCD_APPLICATION_CONTROLLER_REPO_SERVER_PLAINTEXT", false), "Disable TLS on connections to repo server")
 	command.Flags().BoolVar(&repoServerStrictTLS, "repo-server-strict-tls", env.ParseBoolFromEnv("ARGOCD_APPLICATION_CONTROLLER_REPO_SERVER_STRICT_TLS", false), "Whether to use strict validation of the TLS cert presented by the repo server")
+	command.Flags().BoolVar(&repoServerAffinity, "repo-server-affinity", env.ParseBoolFromEnv("ARGOCD_APPLICATION_CONTROLLER_REPO_SERVER_AFFINITY", false), "Route the requests for the same repository to the same repo server replica. The repo server address must resolve to all the replicas, e.g. using a headless service")
 	command.Flags().StringSliceVar(&metricsAplicationLabels, "metrics-application-labels", []string{}, "List of Application labels that will be added to the argocd_application_labels metric")
 	command.Flags().StringSliceVar(&metricsAplicationConditions, "metrics-application-conditions", []string{}, "List of Application conditions that will be added to the argocd_application_conditions metric")
 	command.Flags().StringSliceVar(&metricsClusterLabels, "metrics-cluster-labels", []string{}, "List of Cluster labels that will be added to the argocd_cluster_labels metric")
@@ -288,7 +307,9 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringSliceVar(&otlpAttrs, "otlp-attrs", env.StringsFromEnv("ARGOCD_APPLICATION_CONTROLLER_OTLP_ATTRS", []string{}, ","), "List of OpenTelemetry collector extra attrs when send traces, each attribute is separated by a colon(e.g. key:value)")
 	command.Flags().StringSliceVar(&applicationNamespaces, "application-namespaces", env.StringsFromEnv("ARGOCD_APPLICATION_NAMESPACES", []string{}, ","), "List of additional namespaces that applications are allowed to be reconciled from")
 	command.Flags().BoolVar(&persistResourceHealth, "persist-resource-health", env.ParseBoolFromEnv("ARGOCD_APPLICATION_CONTROLLER_PERSIST_RESOURCE_HEALTH", false), "Enables storing the managed resources health in the Application CRD")
-	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", env.StringFromEnv(common.EnvControllerShardingAlgorithm, common.DefaultShardingAlgorithm), "Enables choice of sharding method. Supported sharding methods are : [legacy, round-robin, consistent-hashing] ")
+	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", env.StringFromEnv(common.EnvControllerShardingAlgorithm, common.DefaultShardingAlgorithm), "Enables choice of sharding method. Supported sharding methods are : [legacy, round-robin, consistent-hashing, load-aware] ")
+	command.Flags().Float64Var(&shardingLoadTolerance, "sharding-load-tolerance", env.ParseFloat64FromEnv(common.EnvControllerShardingLoadTolerance, sharding.DefaultShardingLoadTolerance, 0, math.MaxFloat64), "Tolerated deviation of a shard load from the average shard load before clusters are rebalanced, used by the load-aware sharding method")
+	command.Flags().DurationVar(&shardingRebalanceInterval, "sharding-rebalance-interval", env.ParseDurationFromEnv(common.EnvControllerShardingRebalanceInterval, sharding.DefaultShardingRebalanceInterval, time.Minute, math.MaxInt64), "Interval at which clusters are rebalanced across shards, used by the load-aware sharding method")
 	// global queue rate limit config
 	command.Flags().Int64Var(&workqueueRateLimit.BucketSize, "wq-bucket-size", env.ParseInt64FromEnv("WORKQUEUE_BUCKET_SIZE", 500, 1, math.MaxInt64), "Set Workqueue Rate Limiter Bucket Size, default 500")
 	command.Flags().Float64Var(&workqueueRateLimit.BucketQPS, "wq-bucket-qps", env.ParseFloat64FromEnv("WORKQUEUE_BUCKET_QPS", math.MaxFloat64, 1, math.MaxFloat64), "Set Workqueue Rate Limiter Bucket QPS, default set to MaxFloat64 which disables the bucket limiter")
@@ -298,6 +319,7 @@ func NewCommand() *cobra.Command {
 	command.Flags().DurationVar(&workqueueRateLimit.BaseDelay, "wq-basedelay-ns", time.Duration(env.ParseInt64FromEnv("WORKQUEUE_BASE_DELAY_NS", time.Millisecond.Nanoseconds(), time.Nanosecond.Nanoseconds(), (24*time.Hour).Nanoseconds())), "Set Workqueue Per Item Rate Limiter Base Delay duration in nanoseconds, default 1000000 (1ms)")
 	command.Flags().DurationVar(&workqueueRateLimit.MaxDelay, "wq-maxdelay-ns", time.Duration(env.ParseInt64FromEnv("WORKQUEUE_MAX_DELAY_NS", time.Second.Nanoseconds(), 1*time.Millisecond.Nanoseconds(), (24*time.Hour).Nanoseconds())), "Set Workqueue Per Item Rate Limiter Max Delay duration in nanoseconds, default 1000000000 (1s)")
 	command.Flags().Float64Var(&workqueueRateLimit.BackoffFactor, "wq-backoff-factor", env.ParseFloat64FromEnv("WORKQUEUE_BACKOFF_FACTOR", 1.5, 0, math.MaxFloat64), "Set Workqueue Per Item Rate Limiter Backoff Factor, default is 1.5")
+	command.Flags().DurationVar(&queueStarvationTimeout, "queue-starvation-timeout", env.ParseDurationFromEnv("ARGOCD_APPLICATION_CONTROLLER_QUEUE_STARVATION_TIMEOUT", time.Minute, 0, math.MaxInt64), "Time after which an application queued with a lower reconcile priority is processed ahead of higher priority applications. 0 disables starvation protection")
 	command.Flags().BoolVar(&enableDynamicClusterDistribution, "dynamic-cluster-distribution-enabled", env.ParseBoolFromEnv(common.EnvEnableDynamicClusterDistribution, false), "Enables dynamic cluster distribution.")
 	command.Flags().BoolVar(&serverSideDiff, "server-side-diff-enabled", env.ParseBoolFromEnv(common.EnvServerSideDiff, false), "Feature flag to enable ServerSide diff. Default (\"false\")")
 	command.Flags().DurationVar(&ignoreNormalizerOpts.JQExecutionTimeout, "ignore-normalizer-jq-execution-timeout-seconds", env.ParseDurationFromEnv("ARGOCD_IGNORE_NORMALIZER_JQ_TIMEOUT", 0*time.Second, 0, math.MaxInt64), "Set ignore normalizer JQ execution timeout")
@@ -53,33 +53,38 @@ var (
 
 func NewCommand() *cobra.Command {
 	var (
-		parallelismLimit                   int64
-		listenPort                         int
-		listenHost                         string
-		metricsPort                        int
-		metricsHost                        string
-		otlpAddress                        string
-		otlpInsecure                       bool
-		otlpHeaders                        map[string]string
-		otlpAttrs                          []string
-		cacheSrc                           func() (*reposervercache.Cache, error)
-		tlsConfigCustomizer                tls.ConfigCustomizer
-		tlsConfigCustomizerSrc             func() (tls.ConfigCustomizer, error)
-		redisClient                        *redis.Client
-		disableTLS                         bool
-		maxCombinedDirectoryManifestsSize  string
-		cmpTarExcludedGlobs                []string
-		allowOutOfBoundsSymlinks           bool
-		streamedManifestMaxTarSize         string
-		streamedManifestMaxExtractedSize   string
-		helmManifestMaxExtractedSize       string
-		helmRegistryMaxIndexSize           string
-		ociManifestMaxExtractedSize        string
-		disableOCIManifestMaxExtractedSize bool
-		disableManifestMaxExtractedSize    bool
-		includeHiddenDirectories           bool
-		cmpUseManifestGeneratePaths        bool
-		ociMediaTypes                      []string
+		parallelismLimit                       int64
+		listenPort                             int
+		listenHost                             string
+		metricsPort                            int
+		metricsHost                            string
+		otlpAddress                            string
+		otlpInsecure                           bool
+		otlpHeaders                            map[string]string
+		otlpAttrs                              []string
+		cacheSrc                               func() (*reposervercache.Cache, error)
+		tlsConfigCustomizer                    tls.ConfigCustomizer
+		tlsConfigCustomizerSrc                 func() (tls.ConfigCustomizer, error)
+		redisClient                            *redis.Client
+		disableTLS                             bool
+		maxCombinedDirectoryManifestsSize      string
+		cmpTarExcludedGlobs                    []string
+		allowOutOfBoundsSymlinks               bool
+		streamedManifestMaxTarSize             string
+		streamedManifestMaxExtractedSize       string
+		helmManifestMaxExtractedSize           string
+		helmRegistryMaxIndexSize               string
+		ociManifestMaxExtractedSize            string
+		disableOCIManifestMaxExtractedSize     bool
+		archiveManifestMaxExtractedSize        string
+		disableArchiveManifestMaxExtractedSize bool
+		disableManifestMaxExtractedSize        bool
+		includeHiddenDirectories               bool
+		cmpUseManifestGeneratePaths            bool
+		ociMediaTypes                          []string
+		cosignTrustRootPath                    string
+		provenanceSigningKeyPath               string
+		helmDependencyCacheMaxSize             string
 	)
 	command := cobra.Command{
 		Use:               cliName,
@@ -131,9 +136,15 @@ func NewCommand() *cobra.Command {
 			ociManifestMaxExtractedSizeQuantity, err := resource.ParseQuantity(ociManifestMaxExtractedSize)
 			errors.CheckError(err)
 
+			archiveManifestMaxExtractedSizeQuantity, err := resource.ParseQuantity(archiveManifestMaxExtractedSize)
+			errors.CheckError(err)
+
 			helmRegistryMaxIndexSizeQuantity, err := resource.ParseQuantity(helmRegistryMaxIndexSize)
 			errors.CheckError(err)
 
+			helmDependencyCacheMaxSizeQuantity, err := resource.ParseQuantity(helmDependencyCacheMaxSize)
+			errors.CheckError(err)
+
 			askPassServer := askpass.NewServer(askpass.SocketPath)
 			metricsServer := metrics.NewMetricsServer()
 			cacheutil.CollectMetrics(redisClient, metricsServer, nil)
@@ -152,9 +163,14 @@ func NewCommand() *cobra.Command {
 				HelmRegistryMaxIndexSize:                     helmRegistryMaxIndexSizeQuantity.ToDec().Value(),
 				OCIManifestMaxExtractedSize:                  ociManifestMaxExtractedSizeQuantity.ToDec().Value(),
 				DisableOCIManifestMaxExtractedSize:           disableOCIManifestMaxExtractedSize,
+				ArchiveManifestMaxExtractedSize:              archiveManifestMaxExtractedSizeQuantity.ToDec().Value(),
+				DisableArchiveManifestMaxExtractedSize:       disableArchiveManifestMaxExtractedSize,
 				IncludeHiddenDirectories:                     includeHiddenDirectories,
 				CMPUseManifestGeneratePaths:                  cmpUseManifestGeneratePaths,
 				OCIMediaTypes:                                ociMediaTypes,
+				CosignTrustRootPath:                          cosignTrustRootPath,
+				ProvenanceSigningKeyPath:                     provenanceSigningKeyPath,
+				HelmDependencyCacheMaxSize:                   helmDependencyCacheMaxSizeQuantity.ToDec().Value(),
 			}, askPassServer)
 			errors.CheckError(err)
 
@@ -257,13 +273,18 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringVar(&streamedManifestMaxTarSize, "streamed-manifest-max-tar-size", env.StringFromEnv("ARGOCD_REPO_SERVER_STREAMED_MANIFEST_MAX_TAR_SIZE", "100M"), "Maximum size of streamed manifest archives")
 	command.Flags().StringVar(&streamedManifestMaxExtractedSize, "streamed-manifest-max-extracted-size", env.StringFromEnv("ARGOCD_REPO_SERVER_STREAMED_MANIFEST_MAX_EXTRACTED_SIZE", "1G"), "Maximum size of streamed manifest archives when extracted")
 	command.Flags().StringVar(&helmManifestMaxExtractedSize, "helm-manifest-max-extracted-size", env.StringFromEnv("ARGOCD_REPO_SERVER_HELM_MANIFEST_MAX_EXTRACTED_SIZE", "1G"), "Maximum size of helm manifest archives when extracted")
+	command.Flags().StringVar(&helmDependencyCacheMaxSize, "helm-dependency-cache-max-size", env.StringFromEnv("ARGOCD_REPO_SERVER_HELM_DEPENDENCY_CACHE_MAX_SIZE", "1G"), "Maximum size of the chart dependencies cached by the repo server for all applications. The least recently used dependencies are evicted beyond it. Set to 0 to disable the cache")
 	command.Flags().StringVar(&helmRegistryMaxIndexSize, "helm-registry-max-index-size", env.StringFromEnv("ARGOCD_REPO_SERVER_HELM_MANIFEST_MAX_INDEX_SIZE", "1G"), "Maximum size of registry index file")
 	command.Flags().StringVar(&ociManifestMaxExtractedSize, "oci-manifest-max-extracted-size", env.StringFromEnv("ARGOCD_REPO_SERVER_OCI_MANIFEST_MAX_EXTRACTED_SIZE", "1G"), "Maximum size of oci manifest archives when extracted")
 	command.Flags().BoolVar(&disableOCIManifestMaxExtractedSize, "disable-oci-manifest-max-extracted-size", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_DISABLE_OCI_MANIFEST_MAX_EXTRACTED_SIZE", false), "Disable maximum size of oci manifest archives when extracted")
+	command.Flags().StringVar(&archiveManifestMaxExtractedSize, "archive-manifest-max-extracted-size", env.StringFromEnv("ARGOCD_REPO_SERVER_ARCHIVE_MANIFEST_MAX_EXTRACTED_SIZE", "1G"), "Maximum size of archive repository manifest archives when downloaded and when extracted")
+	command.Flags().BoolVar(&disableArchiveManifestMaxExtractedSize, "disable-archive-manifest-max-extracted-size", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_DISABLE_ARCHIVE_MANIFEST_MAX_EXTRACTED_SIZE", false), "Disable maximum size of archive repository manifest archives when downloaded and when extracted")
 	command.Flags().BoolVar(&disableManifestMaxExtractedSize, "disable-helm-manifest-max-extracted-size", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_DISABLE_HELM_MANIFEST_MAX_EXTRACTED_SIZE", false), "Disable maximum size of helm manifest archives when extracted")
 	command.Flags().BoolVar(&includeHiddenDirectories, "include-hidden-directories", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_INCLUDE_HIDDEN_DIRECTORIES", false), "Include hidden directories from Git")
 	command.Flags().BoolVar(&cmpUseManifestGeneratePaths, "plugin-use-manifest-generate-paths", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_PLUGIN_USE_MANIFEST_GENERATE_PATHS", false), "Pass the resources described in argocd.argoproj.io/manifest-generate-paths value to the cmpserver to generate the application manifests.")
 	command.Flags().StringSliceVar(&ociMediaTypes, "oci-layer-media-types", env.StringsFromEnv("ARGOCD_REPO_SERVER_OCI_LAYER_MEDIA_TYPES", []string{"application/vnd.oci.image.layer.v1.tar", "application/vnd.oci.image.layer.v1.tar+gzip", "application/vnd.cncf.helm.chart.content.v1.tar+gzip"}, ","), "Comma separated list of allowed media types for OCI media types. This only accounts for media types within layers.")
+	command.Flags().StringVar(&cosignTrustRootPath, "cosign-trust-root-path", env.StringFromEnv("ARGOCD_REPO_SERVER_COSIGN_TRUST_ROOT_PATH", common.DefaultPathCosignTrustRoot), "Path to the directory holding the certificate authorities (fulcio.pem) and transparency log keys (rekor.pub) used to verify keyless cosign signatures of OCI artifacts")
+	command.Flags().StringVar(&provenanceSigningKeyPath, "provenance-signing-key-path", env.StringFromEnv("ARGOCD_REPO_SERVER_PROVENANCE_SIGNING_KEY_PATH", ""), "Path to the PEM encoded private key used to sign the provenance attestations of generated manifests. Attestations are not generated if empty")
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(&command)
 	cacheSrc = reposervercache.AddCacheFlagsToCmd(&command, cacheutil.Options{
 		OnClientCreated: func(client *redis.Client) {
@@ -407,6 +407,7 @@ func reconcileApplications(
 	appStateManager := controller.NewAppStateManager(
 		argoDB,
 		appClientset,
+		kubeClientset,
 		repoServerClient,
 		namespace,
 		kubeutil.NewKubectl(),
@@ -423,6 +424,9 @@ func reconcileApplications(
 		0,
 		serverSideDiff,
 		ignoreNormalizerOpts,
+		nil,
+		0,
+		argo.NewHelmValuesClientGetter(kubeClientset),
 	)
 
 	appsList, err := appClientset.ArgoprojV1alpha1().Applications(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
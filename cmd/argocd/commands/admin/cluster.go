@@ -14,6 +14,7 @@ import (
 	"github.com/redis/go-redis/v9"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
@@ -92,6 +93,22 @@ func loadClusters(ctx context.Context, kubeClient kubernetes.Interface, appClien
 	}
 	clusterShardingCache := sharding.NewClusterSharding(argoDB, shard, replicas, shardingAlgorithm)
 	clusterShardingCache.Init(clustersList, appItems)
+	if shardingAlgorithm == common.LoadAwareShardingAlgorithm {
+		// the load-aware assignments are computed by the controllers and published in the shard mapping config map
+		shardMappingCM, err := kubeClient.CoreV1().ConfigMaps(namespace).Get(ctx, common.ArgoCDAppControllerShardConfigMapName, metav1.GetOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		if err == nil {
+			assignments, err := sharding.GetClusterShardAssignments(shardMappingCM)
+			if err != nil {
+				return nil, err
+			}
+			if assignments != nil {
+				clusterShardingCache.UpdateClusterAssignments(assignments.Assignments)
+			}
+		}
+	}
 	clusterShards := clusterShardingCache.GetDistribution()
 
 	var cache *appstatecache.Cache
@@ -221,7 +238,7 @@ func NewClusterShardsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 	clientConfig = cli.AddKubectlFlagsToCmd(&command)
 	command.Flags().IntVar(&shard, "shard", -1, "Cluster shard filter")
 	command.Flags().IntVar(&replicas, "replicas", 0, "Application controller replicas count. Inferred from number of running controller pods if not specified")
-	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", common.DefaultShardingAlgorithm, "Sharding method. Defaults: legacy. Supported sharding methods are : [legacy, round-robin, consistent-hashing] ")
+	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", common.DefaultShardingAlgorithm, "Sharding method. Defaults: legacy. Supported sharding methods are : [legacy, round-robin, consistent-hashing, load-aware] ")
 	command.Flags().BoolVar(&portForwardRedis, "port-forward-redis", true, "Automatically port-forward ha proxy redis from current namespace?")
 
 	cacheSrc = appstatecache.AddCacheFlagsToCmd(&command)
@@ -236,18 +253,26 @@ func NewClusterShardsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 func printStatsSummary(clusters []ClusterWithInfo) {
 	totalResourcesCount := int64(0)
 	resourcesCountByShard := map[int]int64{}
+	totalWeight := int64(0)
+	weightByShard := map[int]int64{}
 	for _, c := range clusters {
 		totalResourcesCount += c.Info.CacheInfo.ResourcesCount
 		resourcesCountByShard[c.Shard] += c.Info.CacheInfo.ResourcesCount
+		weight := sharding.NewClusterLoad(c.Info).Weight()
+		totalWeight += weight
+		weightByShard[c.Shard] += weight
 	}
 
 	avgResourcesByShard := totalResourcesCount / int64(len(resourcesCountByShard))
+	avgWeightByShard := totalWeight / int64(len(weightByShard))
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	_, _ = fmt.Fprintf(w, "SHARD\tRESOURCES COUNT\n")
+	_, _ = fmt.Fprintf(w, "SHARD\tRESOURCES COUNT\tWEIGHT\n")
 	for shard := 0; shard < len(resourcesCountByShard); shard++ {
 		cnt := resourcesCountByShard[shard]
 		percent := (float64(cnt) / float64(avgResourcesByShard)) * 100.0
-		_, _ = fmt.Fprintf(w, "%d\t%s\n", shard, fmt.Sprintf("%d (%.0f%%)", cnt, percent))
+		weight := weightByShard[shard]
+		weightPercent := (float64(weight) / float64(avgWeightByShard)) * 100.0
+		_, _ = fmt.Fprintf(w, "%d\t%s\t%s\n", shard, fmt.Sprintf("%d (%.0f%%)", cnt, percent), fmt.Sprintf("%d (%.0f%%)", weight, weightPercent))
 	}
 	_ = w.Flush()
 }
@@ -508,7 +533,7 @@ argocd admin cluster stats target-cluster`,
 	clientConfig = cli.AddKubectlFlagsToCmd(&command)
 	command.Flags().IntVar(&shard, "shard", -1, "Cluster shard filter")
 	command.Flags().IntVar(&replicas, "replicas", 0, "Application controller replicas count. Inferred from number of running controller pods if not specified")
-	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", common.DefaultShardingAlgorithm, "Sharding method. Defaults: legacy. Supported sharding methods are : [legacy, round-robin, consistent-hashing] ")
+	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", common.DefaultShardingAlgorithm, "Sharding method. Defaults: legacy. Supported sharding methods are : [legacy, round-robin, consistent-hashing, load-aware] ")
 	command.Flags().BoolVar(&portForwardRedis, "port-forward-redis", true, "Automatically port-forward ha proxy redis from current namespace?")
 	cacheSrc = appstatecache.AddCacheFlagsToCmd(&command)
 
@@ -490,7 +490,7 @@ func NewResourceHealthCommand(cmdCtx commandContext) *cobra.Command {
 	command := &cobra.Command{
 		Use:   "health RESOURCE_YAML_PATH",
 		Short: "Assess resource health",
-		Long:  "Assess resource health using the lua script configured in the 'resource.customizations' field of 'argocd-cm' ConfigMap",
+		Long:  "Assess resource health using the lua script or CEL expression configured in the 'resource.customizations' field of 'argocd-cm' ConfigMap",
 		Example: `
 argocd admin settings resource-overrides health ./deploy.yaml --argocd-cm-path ./argocd-cm.yaml`,
 		Run: func(c *cobra.Command, args []string) {
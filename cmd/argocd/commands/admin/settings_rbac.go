@@ -62,7 +62,7 @@ var validRBACResourcesActions = map[string]actionTraitMap{
 	rbac.ResourceGPGKeys:         defaultCRDActions,
 	rbac.ResourceLogs:            logsActions,
 	rbac.ResourceExec:            execActions,
-	rbac.ResourceProjects:        defaultCRUDActions,
+	rbac.ResourceProjects:        projectsActions,
 	rbac.ResourceRepositories:    defaultCRUDActions,
 }
 
@@ -88,6 +88,15 @@ var applicationsActions = actionTraitMap{
 	rbac.ActionAction:   rbacTrait{allowPath: true},
 	rbac.ActionOverride: rbacTrait{},
 	rbac.ActionSync:     rbacTrait{},
+	rbac.ActionPause:    rbacTrait{},
+}
+
+var projectsActions = actionTraitMap{
+	rbac.ActionCreate: rbacTrait{},
+	rbac.ActionGet:    rbacTrait{},
+	rbac.ActionUpdate: rbacTrait{},
+	rbac.ActionDelete: rbacTrait{},
+	rbac.ActionFreeze: rbacTrait{},
 }
 
 var accountsActions = actionTraitMap{
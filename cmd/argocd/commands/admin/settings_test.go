@@ -343,6 +343,23 @@ func TestResourceOverrideHealth(t *testing.T) {
 		require.NoError(t, err)
 		assert.Contains(t, out, "Progressing")
 	})
+
+	t.Run("HealthAssessmentConfiguredCEL", func(t *testing.T) {
+		cmd := NewResourceOverridesCommand(newCmdContext(map[string]string{
+			"resource.customizations": `example.com/ExampleResource:
+  health.cel: |
+    {'status': 'Suspended', 'message': 'Resource ' + obj.metadata.name + ' is suspended'}
+`,
+		}))
+		out, err := captureStdout(func() {
+			cmd.SetArgs([]string{"health", f})
+			err := cmd.Execute()
+			require.NoError(t, err)
+		})
+		require.NoError(t, err)
+		assert.Contains(t, out, "STATUS: Suspended")
+		assert.Contains(t, out, "MESSAGE: Resource example-resource is suspended")
+	})
 }
 
 func TestResourceOverrideAction(t *testing.T) {
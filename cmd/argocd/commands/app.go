@@ -2,6 +2,7 @@ package commands
 
 import (
 	"context"
+	"crypto"
 	"encoding/json"
 	stderrors "errors"
 	"fmt"
@@ -52,12 +53,14 @@ import (
 	argodiff "github.com/argoproj/argo-cd/v3/util/argo/diff"
 	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
 	"github.com/argoproj/argo-cd/v3/util/cli"
+	"github.com/argoproj/argo-cd/v3/util/cosign"
 	"github.com/argoproj/argo-cd/v3/util/errors"
 	"github.com/argoproj/argo-cd/v3/util/git"
 	"github.com/argoproj/argo-cd/v3/util/grpc"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	logutils "github.com/argoproj/argo-cd/v3/util/log"
 	"github.com/argoproj/argo-cd/v3/util/manifeststream"
+	"github.com/argoproj/argo-cd/v3/util/provenance"
 	"github.com/argoproj/argo-cd/v3/util/templates"
 	"github.com/argoproj/argo-cd/v3/util/text/label"
 )
@@ -88,17 +91,23 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationSyncCommand(clientOpts))
 	command.AddCommand(NewApplicationHistoryCommand(clientOpts))
 	command.AddCommand(NewApplicationRollbackCommand(clientOpts))
+	command.AddCommand(NewApplicationRestoreSnapshotCommand(clientOpts))
+	command.AddCommand(NewApplicationVerifyProvenanceCommand(clientOpts))
 	command.AddCommand(NewApplicationListCommand(clientOpts))
 	command.AddCommand(NewApplicationDeleteCommand(clientOpts))
 	command.AddCommand(NewApplicationWaitCommand(clientOpts))
 	command.AddCommand(NewApplicationManifestsCommand(clientOpts))
 	command.AddCommand(NewApplicationTerminateOpCommand(clientOpts))
+	command.AddCommand(NewApplicationPauseCommand(clientOpts))
+	command.AddCommand(NewApplicationResumeCommand(clientOpts))
 	command.AddCommand(NewApplicationEditCommand(clientOpts))
 	command.AddCommand(NewApplicationPatchCommand(clientOpts))
 	command.AddCommand(NewApplicationPatchResourceCommand(clientOpts))
 	command.AddCommand(NewApplicationDeleteResourceCommand(clientOpts))
 	command.AddCommand(NewApplicationResourceActionsCommand(clientOpts))
 	command.AddCommand(NewApplicationListResourcesCommand(clientOpts))
+	command.AddCommand(NewApplicationDriftCommand(clientOpts))
+	command.AddCommand(NewApplicationOrphansCommand(clientOpts))
 	command.AddCommand(NewApplicationLogsCommand(clientOpts))
 	command.AddCommand(NewApplicationAddSourceCommand(clientOpts))
 	command.AddCommand(NewApplicationRemoveSourceCommand(clientOpts))
@@ -472,7 +481,7 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: app.Spec.Project})
 			errors.CheckError(err)
 
-			windows := proj.Spec.SyncWindows.Matches(app)
+			windows := app.GetSyncWindows(proj)
 
 			switch output {
 			case "yaml", "json":
@@ -693,11 +702,17 @@ func printAppSummaryTable(app *argoappv1.Application, appURL string, windows *ar
 			} else {
 				status = "Sync Denied"
 			}
+			if reason, err := windows.BlockReason(); err == nil && reason != "" {
+				status += " (" + reason + ")"
+			}
 		} else {
 			status = "Sync Allowed"
 		}
 		for _, w := range *windows {
 			s := w.Kind + ":" + w.Schedule + ":" + w.Duration
+			if w.Schedule == "" && w.ExpiresAt != nil {
+				s = w.Kind + ":until " + w.ExpiresAt.Format(time.RFC3339)
+			}
 			wds = append(wds, s)
 		}
 	} else {
@@ -718,6 +733,9 @@ func printAppSummaryTable(app *argoappv1.Application, appURL string, windows *ar
 		syncPolicy = "Manual"
 	}
 	fmt.Printf(printOpFmtStr, "Sync Policy:", syncPolicy)
+	if pause := app.Spec.SyncPolicy.GetPause(); pause.IsActive(time.Now()) {
+		fmt.Printf(printOpFmtStr, "Auto-Sync Paused:", formatSyncPause(pause))
+	}
 	syncStatusStr := string(app.Status.Sync.Status)
 	switch app.Status.Sync.Status {
 	case argoappv1.SyncStatusCodeSynced:
@@ -941,6 +959,7 @@ type unsetOpts struct {
 	valuesLiteral           bool
 	ignoreMissingValueFiles bool
 	pluginEnvs              []string
+	cueTags                 []string
 	passCredentials         bool
 	ref                     bool
 }
@@ -1060,6 +1079,7 @@ func NewApplicationUnsetCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 	command.Flags().StringArrayVar(&opts.kustomizeReplicas, "kustomize-replica", []string{}, "Kustomize replicas name (e.g. --kustomize-replica my-deployment --kustomize-replica my-statefulset)")
 	command.Flags().BoolVar(&opts.ignoreMissingComponents, "ignore-missing-components", false, "Unset the kustomize ignore-missing-components option (revert to false)")
 	command.Flags().StringArrayVar(&opts.pluginEnvs, "plugin-env", []string{}, "Unset plugin env variables (e.g --plugin-env name)")
+	command.Flags().StringArrayVar(&opts.cueTags, "cue-tag", []string{}, "Unset CUE tags (e.g --cue-tag env)")
 	command.Flags().BoolVar(&opts.passCredentials, "pass-credentials", false, "Unset passCredentials")
 	command.Flags().BoolVar(&opts.ref, "ref", false, "Unset ref on the source")
 	command.Flags().IntVar(&sourcePosition, "source-position", -1, "Position of the source from the list of sources of the app. Counting starts at 1.")
@@ -1181,6 +1201,19 @@ func unset(source *argoappv1.ApplicationSource, opts unsetOpts) (updated bool, n
 			}
 		}
 	}
+
+	if source.CUE != nil {
+		if len(opts.cueTags) == 0 {
+			return false, !needToUnsetRef
+		}
+		for _, name := range opts.cueTags {
+			tagCount := len(source.CUE.Tags)
+			source.CUE.RemoveTag(name)
+			if len(source.CUE.Tags) != tagCount {
+				updated = true
+			}
+		}
+	}
 	return updated, false
 }
 
@@ -1287,12 +1320,21 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		sourcePositions      []int64
 		sourceNames          []string
 		ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts
+		output               string
 	)
 	shortDesc := "Perform a diff against the target and live state."
 	command := &cobra.Command{
 		Use:   "diff APPNAME",
 		Short: shortDesc,
 		Long:  shortDesc + "\nUses 'diff' to render the difference. KUBECTL_EXTERNAL_DIFF environment variable can be used to select your own diff tool.\nReturns the following exit codes: 2 on general errors, 1 when a diff is found, and 0 when no diff is found\nKubernetes Secrets are ignored from this diff.",
+		Example: `  # Print the difference as unified text diffs
+  argocd app diff my-app
+
+  # Print one JSON record per changed resource, including the normalized live and target objects and a JSON patch
+  argocd app diff my-app --output json
+
+  # Print only the JSON patches of the changed resources
+  argocd app diff my-app --output json-patch`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -1313,6 +1355,10 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				errors.Fatal(errors.ErrorGeneric, "While using --revisions and --source-names, length of values for both flags should be same.")
 			}
 
+			if !isValidDiffOutput(output) {
+				errors.Fatal(errors.ErrorGeneric, fmt.Sprintf("Unknown output format: %s. One of: text|json|json-patch|yaml", output))
+			}
+
 			clientset := headless.NewClientOrDie(clientOpts, c)
 			conn, appIf := clientset.NewApplicationClientOrDie()
 			defer utilio.Close(conn)
@@ -1342,7 +1388,7 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			defer utilio.Close(conn)
 			argoSettings, err := settingsIf.Get(ctx, &settings.SettingsQuery{})
 			errors.CheckError(err)
-			diffOption := &DifferenceOption{}
+			diffOption := &DifferenceOption{output: output}
 			switch {
 			case app.Spec.HasMultipleSources() && len(revisions) > 0 && len(sourcePositions) > 0:
 				numOfSources := int64(len(app.Spec.GetSources()))
@@ -1418,6 +1464,7 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().Int64SliceVar(&sourcePositions, "source-positions", []int64{}, "List of source positions. Default is empty array. Counting start at 1.")
 	command.Flags().StringArrayVar(&sourceNames, "source-names", []string{}, "List of source names. Default is an empty array.")
 	command.Flags().DurationVar(&ignoreNormalizerOpts.JQExecutionTimeout, "ignore-normalizer-jq-execution-timeout", normalizers.DefaultJQExecutionTimeout, "Set ignore normalizer JQ execution timeout")
+	command.Flags().StringVarP(&output, "output", "o", diffOutputText, "Output format. One of: text|json|json-patch|yaml")
 	return command
 }
 
@@ -1430,11 +1477,15 @@ type DifferenceOption struct {
 	res           *repoapiclient.ManifestResponse
 	serversideRes *repoapiclient.ManifestResponse
 	revisions     []string
+	// output is the output format of the diff. Unified text diffs are printed if it is empty.
+	output string
 }
 
 // findandPrintDiff ... Prints difference between application current state and state stored in git or locally, returns boolean as true if difference is found else returns false
 func findandPrintDiff(ctx context.Context, app *argoappv1.Application, proj *argoappv1.AppProject, resources *application.ManagedResourcesResponse, argoSettings *settings.Settings, diffOptions *DifferenceOption, ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts) bool {
 	var foundDiffs bool
+	structured := diffOptions.output != "" && diffOptions.output != diffOutputText
+	diffs := make([]*resourceDiff, 0)
 	liveObjs, err := cmdutil.LiveObjects(resources.Items)
 	errors.CheckError(err)
 	items := make([]objKeyLiveTarget, 0)
@@ -1499,6 +1550,13 @@ func findandPrintDiff(ctx context.Context, app *argoappv1.Application, proj *arg
 		errors.CheckError(err)
 
 		if diffRes.Modified || item.target == nil || item.live == nil {
+			foundDiffs = true
+			if structured {
+				res, err := newResourceDiff(app, item.key, item.live, item.target, &diffRes)
+				errors.CheckError(err)
+				diffs = append(diffs, res)
+				continue
+			}
 			fmt.Printf("\n===== %s/%s %s/%s ======\n", item.key.Group, item.key.Kind, item.key.Namespace, item.key.Name)
 			var live *unstructured.Unstructured
 			var target *unstructured.Unstructured
@@ -1511,12 +1569,12 @@ func findandPrintDiff(ctx context.Context, app *argoappv1.Application, proj *arg
 				live = item.live
 				target = item.target
 			}
-			if !foundDiffs {
-				foundDiffs = true
-			}
 			_ = cli.PrintDiff(item.key.Name, live, target)
 		}
 	}
+	if structured {
+		errors.CheckError(printResourceDiffs(diffs, diffOptions.output))
+	}
 	return foundDiffs
 }
 
@@ -1557,12 +1615,13 @@ func groupObjsForDiff(resources *application.ManagedResourcesResponse, objs map[
 // NewApplicationDeleteCommand returns a new instance of an `argocd app delete` command
 func NewApplicationDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		cascade           bool
-		noPrompt          bool
-		propagationPolicy string
-		selector          string
-		wait              bool
-		appNamespace      string
+		cascade            bool
+		noPrompt           bool
+		propagationPolicy  string
+		selector           string
+		wait               bool
+		appNamespace       string
+		skipPreDeleteHooks bool
 	)
 	command := &cobra.Command{
 		Use:   "delete APPNAME",
@@ -1578,7 +1637,10 @@ func NewApplicationDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.
   argocd app delete -l app.kubernetes.io/instance!=my-app
   argocd app delete -l app.kubernetes.io/instance
   argocd app delete -l '!app.kubernetes.io/instance'
-  argocd app delete -l 'app.kubernetes.io/instance notin (my-app,other-app)'`,
+  argocd app delete -l 'app.kubernetes.io/instance notin (my-app,other-app)'
+
+  # Delete an app without running its PreDelete hooks
+  argocd app delete my-app --skip-pre-delete-hooks`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -1624,6 +1686,9 @@ func NewApplicationDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 				if c.Flag("propagation-policy").Changed {
 					appDeleteReq.PropagationPolicy = &propagationPolicy
 				}
+				if skipPreDeleteHooks {
+					appDeleteReq.SkipPreDeleteHooks = &skipPreDeleteHooks
+				}
 				messageForSingle := "Are you sure you want to delete '" + appFullName + "' and all its resources? [y/n] "
 				messageForAll := "Are you sure you want to delete '" + appFullName + "' and all its resources? [y/n/a] where 'a' is to delete all specified apps and their resources without prompting "
 
@@ -1649,6 +1714,7 @@ func NewApplicationDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 	command.Flags().StringVarP(&selector, "selector", "l", "", "Delete all apps with matching label. Supports '=', '==', '!=', in, notin, exists & not exists. Matching apps must satisfy all of the specified label constraints.")
 	command.Flags().BoolVar(&wait, "wait", false, "Wait until deletion of the application(s) completes")
 	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace where the application will be deleted from")
+	command.Flags().BoolVar(&skipPreDeleteHooks, "skip-pre-delete-hooks", false, "Delete the application without running its PreDelete hooks")
 	return command
 }
 
@@ -2004,6 +2070,8 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		retryBackoffDuration    time.Duration
 		retryBackoffMaxDuration time.Duration
 		retryBackoffFactor      int64
+		retryFailedOnly         bool
+		retryFailed             bool
 		local                   string
 		localRepoRoot           string
 		infos                   []string
@@ -2042,7 +2110,10 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
   argocd app sync my-app --resource apps:Deployment:my-service --resource :Service:my-service
   argocd app sync my-app --resource '!*:Service:*'
   # Specify namespace if the application has resources with the same name in different namespaces
-  argocd app sync my-app --resource argoproj.io:Rollout:my-namespace/my-rollout`,
+  argocd app sync my-app --resource argoproj.io:Rollout:my-namespace/my-rollout
+
+  # Retry only the resources which failed in the last sync, at the revision that sync was performed against
+  argocd app sync my-app --retry-failed`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 			if len(args) == 0 && selector == "" && len(projects) == 0 {
@@ -2079,6 +2150,10 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				}
 			}
 
+			if retryFailed && (revision != "" || len(revisions) > 0 || len(resources) > 0 || len(labels) > 0 || local != "" || diffChanges) {
+				log.Fatal("Cannot use --retry-failed together with --revision, --revisions, --resource, --label, --local or --preview-changes")
+			}
+
 			acdClient := headless.NewClientOrDie(clientOpts, c)
 			conn, appIf := acdClient.NewApplicationClientOrDie()
 			defer utilio.Close(conn)
@@ -2260,6 +2335,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					SyncOptions:     syncOptionsFactory(),
 					Revisions:       revisions,
 					SourcePositions: sourcePositions,
+					RetryFailed:     &retryFailed,
 				}
 
 				switch strategy {
@@ -2280,6 +2356,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 							MaxDuration: retryBackoffMaxDuration.String(),
 							Factor:      ptr.To(retryBackoffFactor),
 						},
+						FailedResourcesOnly: retryFailedOnly,
 					}
 				}
 				if diffChanges {
@@ -2342,6 +2419,8 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().DurationVar(&retryBackoffDuration, "retry-backoff-duration", argoappv1.DefaultSyncRetryDuration, "Retry backoff base duration. Input needs to be a duration (e.g. 2m, 1h)")
 	command.Flags().DurationVar(&retryBackoffMaxDuration, "retry-backoff-max-duration", argoappv1.DefaultSyncRetryMaxDuration, "Max retry backoff duration. Input needs to be a duration (e.g. 2m, 1h)")
 	command.Flags().Int64Var(&retryBackoffFactor, "retry-backoff-factor", argoappv1.DefaultSyncRetryFactor, "Factor multiplies the base duration after each failed retry")
+	command.Flags().BoolVar(&retryFailedOnly, "retry-failed-resources-only", false, "Limit each sync retry to the resources which failed in the previous attempt")
+	command.Flags().BoolVar(&retryFailed, "retry-failed", false, "Sync only the resources which failed in the last sync operation, at the revision that operation was performed against")
 	command.Flags().StringVar(&strategy, "strategy", "", "Sync strategy (one of: apply|hook)")
 	command.Flags().BoolVar(&force, "force", false, "Use a force apply")
 	command.Flags().BoolVar(&replace, "replace", false, "Use a kubectl create/replace instead apply")
@@ -2994,6 +3073,247 @@ func NewApplicationRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobr
 	return command
 }
 
+// NewApplicationRestoreSnapshotCommand returns a new instance of an `argocd app restore-snapshot` command
+func NewApplicationRestoreSnapshotCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		dryRun       bool
+		timeout      uint
+		output       string
+		appNamespace string
+	)
+	command := &cobra.Command{
+		Use:   "restore-snapshot APPNAME [SNAPSHOT]",
+		Short: "Reapply the live state of the resources captured before a sync operation, omitted will restore the snapshot taken by the last sync",
+		Example: `  # Restore the live state captured before the last sync operation
+  argocd app restore-snapshot my-app
+
+  # Restore a specific snapshot
+  argocd app restore-snapshot my-app 20250304050607`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+			if len(args) == 0 || len(args) > 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			var id string
+			if len(args) > 1 {
+				id = args[1]
+			}
+			acdClient := headless.NewClientOrDie(clientOpts, c)
+			conn, appIf := acdClient.NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+
+			app, err := appIf.RestoreSnapshot(ctx, &application.ApplicationRestoreSnapshotRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				Id:           &id,
+				DryRun:       &dryRun,
+			})
+			errors.CheckError(err)
+
+			_, _, err = waitOnApplicationStatus(ctx, acdClient, app.QualifiedName(), timeout, watchOpts{
+				operation: true,
+			}, nil, output)
+			errors.CheckError(err)
+		},
+	}
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Preview apply without affecting cluster")
+	command.Flags().UintVar(&timeout, "timeout", defaultCheckTimeoutSeconds, "Time out after this many seconds")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide|tree|tree=detailed")
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Restore snapshot of application in namespace")
+	return command
+}
+
+// NewApplicationVerifyProvenanceCommand returns a new instance of an `argocd app verify-provenance` command
+func NewApplicationVerifyProvenanceCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		publicKeyPaths []string
+		output         string
+		appNamespace   string
+	)
+	command := &cobra.Command{
+		Use:   "verify-provenance APPNAME [ID]",
+		Short: "Verify the manifests of a deployment by History ID against their signed provenance attestations, omitted will verify the last deployment",
+		Example: `  # Verify the last deployment of an application
+  argocd app verify-provenance my-app --public-key provenance.pub
+
+  # Verify a specific deployment and print the verified provenance statements
+  argocd app verify-provenance my-app 3 --public-key provenance.pub -o json`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+			if len(args) == 0 || len(args) > 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			if len(publicKeyPaths) == 0 {
+				errors.Fatal(errors.ErrorGeneric, "At least one public key must be specified with --public-key")
+			}
+			var keys []crypto.PublicKey
+			for _, path := range publicKeyPaths {
+				data, err := os.ReadFile(path)
+				errors.CheckError(err)
+				key, err := cosign.ParsePublicKey(data)
+				if err != nil {
+					log.Fatalf("Invalid public key %s: %v", path, err)
+				}
+				keys = append(keys, key)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+			app, err := appIf.Get(ctx, &application.ApplicationQuery{
+				Name:         &appName,
+				AppNamespace: &appNs,
+			})
+			errors.CheckError(err)
+
+			var depInfo *argoappv1.RevisionHistory
+			if len(args) > 1 {
+				depID, err := strconv.Atoi(args[1])
+				errors.CheckError(err)
+				depInfo, err = findRevisionHistory(app, int64(depID))
+				errors.CheckError(err)
+			} else {
+				if len(app.Status.History) == 0 {
+					log.Fatalf("Application '%s' has no deployments", app.Name)
+				}
+				depInfo = &app.Status.History[len(app.Status.History)-1]
+			}
+			if depInfo.ManifestsDigest == "" && len(depInfo.ManifestsDigests) == 0 {
+				log.Fatalf("Deployment %d of application '%s' has no recorded manifests digest", depInfo.ID, app.Name)
+			}
+
+			manifests, err := appIf.GetManifests(ctx, &application.ApplicationManifestQuery{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				HistoryId:    ptr.To(depInfo.ID),
+			})
+			errors.CheckError(err)
+
+			results := verifyProvenance(depInfo, manifests.Provenance, keys)
+			switch output {
+			case "json", "yaml":
+				err := PrintResourceList(results, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				printProvenanceResultTable(depInfo.ID, results)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+			for _, res := range results {
+				if !res.Verified {
+					os.Exit(1)
+				}
+			}
+		},
+	}
+	command.Flags().StringArrayVar(&publicKeyPaths, "public-key", []string{}, "Path to a PEM encoded public key trusted to sign provenance attestations (can be repeated)")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only verify application in namespace")
+	return command
+}
+
+// provenanceResult is the result of the verification of the provenance of the manifests deployed for a source
+type provenanceResult struct {
+	Source          string                `json:"source"`
+	Revision        string                `json:"revision"`
+	ManifestsDigest string                `json:"manifestsDigest"`
+	Verified        bool                  `json:"verified"`
+	KeyID           string                `json:"keyID,omitempty"`
+	Message         string                `json:"message,omitempty"`
+	Statement       *provenance.Statement `json:"statement,omitempty"`
+}
+
+// verifyProvenance verifies that the manifests deployed for each source of the given revision history entry are the
+// subject of one of the given provenance attestations, which must be signed by one of the given keys and attest that
+// they were generated from the revision of the source that was deployed
+func verifyProvenance(depInfo *argoappv1.RevisionHistory, envelopes []string, keys []crypto.PublicKey) []provenanceResult {
+	sources := depInfo.Sources
+	revisions := depInfo.Revisions
+	digests := depInfo.ManifestsDigests
+	if len(sources) == 0 {
+		sources = argoappv1.ApplicationSources{depInfo.Source}
+		revisions = []string{depInfo.Revision}
+		digests = []string{depInfo.ManifestsDigest}
+	}
+
+	type verifiedStatement struct {
+		statement *provenance.Statement
+		keyID     string
+	}
+	var verified []verifiedStatement
+	var verifyErrs []string
+	for _, envelope := range envelopes {
+		statement, keyID, err := provenance.Verify(envelope, keys)
+		if err != nil {
+			verifyErrs = append(verifyErrs, err.Error())
+			continue
+		}
+		verified = append(verified, verifiedStatement{statement: statement, keyID: keyID})
+	}
+
+	results := make([]provenanceResult, len(sources))
+	for i, source := range sources {
+		res := provenanceResult{Source: source.RepoURL}
+		switch {
+		case source.Chart != "":
+			res.Source += " (chart " + source.Chart + ")"
+		case source.Path != "":
+			res.Source += " (path " + source.Path + ")"
+		}
+		if i < len(revisions) {
+			res.Revision = revisions[i]
+		}
+		if i < len(digests) {
+			res.ManifestsDigest = digests[i]
+		}
+		switch {
+		case source.IsRef() && source.Path == "" && source.Chart == "":
+			res.Verified = true
+			res.Message = "Reference source without manifests"
+		case res.ManifestsDigest == "":
+			res.Message = "No manifests digest recorded"
+		default:
+			res.Message = "No valid provenance attestation of the manifests"
+			if len(verifyErrs) > 0 {
+				res.Message += ": " + strings.Join(verifyErrs, ", ")
+			}
+			for _, v := range verified {
+				if v.statement.ManifestsDigest() != res.ManifestsDigest {
+					continue
+				}
+				if revision := v.statement.SourceRevision(); revision != res.Revision {
+					res.Message = fmt.Sprintf("Manifests are attested to be generated from revision %s", revision)
+					continue
+				}
+				res.Verified = true
+				res.KeyID = v.keyID
+				res.Message = "Verified"
+				res.Statement = v.statement
+				break
+			}
+		}
+		results[i] = res
+	}
+	return results
+}
+
+func printProvenanceResultTable(id int64, results []provenanceResult) {
+	fmt.Printf(printOpFmtStr, "ID:", strconv.FormatInt(id, 10))
+	fmt.Println()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "SOURCE\tREVISION\tMANIFESTS DIGEST\tKEY ID\tRESULT\n")
+	for _, res := range results {
+		keyID := res.KeyID
+		if len(keyID) > 16 {
+			keyID = keyID[:16]
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", res.Source, res.Revision, res.ManifestsDigest, keyID, res.Message)
+	}
+	_ = w.Flush()
+}
+
 const (
 	printOpFmtStr              = "%-20s%s\n"
 	defaultCheckTimeoutSeconds = 0
@@ -3010,6 +3330,12 @@ func printOperationResult(opState *argoappv1.OperationState) {
 		} else {
 			fmt.Printf(printOpFmtStr, "Sync Revision:", opState.SyncResult.Revision)
 		}
+		if opState.Operation.Sync != nil && opState.Operation.Sync.RestoreSnapshot != "" {
+			fmt.Printf(printOpFmtStr, "Restored Snapshot:", opState.Operation.Sync.RestoreSnapshot)
+		}
+		if opState.SyncResult.Snapshot != "" {
+			fmt.Printf(printOpFmtStr, "Snapshot:", opState.SyncResult.Snapshot)
+		}
 	}
 	fmt.Printf(printOpFmtStr, "Phase:", opState.Phase)
 	fmt.Printf(printOpFmtStr, "Start:", opState.StartedAt)
@@ -3213,6 +3539,85 @@ func NewApplicationTerminateOpCommand(clientOpts *argocdclient.ClientOptions) *c
 	return command
 }
 
+// NewApplicationPauseCommand returns a new instance of an `argocd app pause` command
+func NewApplicationPauseCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		until  string
+		reason string
+	)
+	command := &cobra.Command{
+		Use:   "pause APPNAME",
+		Short: "Pause automated sync and self-heal of an application until a given time",
+		Example: `  # Pause automated sync of an application for two hours
+  argocd app pause my-app --until 2h --reason "incident 1234"
+
+  # Pause automated sync of an application until a given time
+  argocd app pause my-app --until 2025-01-01T00:00:00Z`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			expiry, err := parseFreezeExpiry(until, time.Now())
+			errors.CheckError(err)
+			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+			_, err = appIf.Pause(ctx, &application.ApplicationPauseRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				Until:        ptr.To(expiry.UTC().Format(time.RFC3339)),
+				Reason:       &reason,
+			})
+			errors.CheckError(err)
+			fmt.Printf("Application '%s' automated sync paused until %s\n", appName, expiry.Format(time.RFC3339))
+		},
+	}
+	command.Flags().StringVar(&until, "until", "1h", "Time until which automated sync is paused, as a RFC3339 time or a duration (e.g. 30m, 2h)")
+	command.Flags().StringVar(&reason, "reason", "", "Reason why automated sync is paused")
+	return command
+}
+
+// NewApplicationResumeCommand returns a new instance of an `argocd app resume` command
+func NewApplicationResumeCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "resume APPNAME",
+		Short: "Resume automated sync of a paused application",
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+			_, err := appIf.Resume(ctx, &application.ApplicationResumeRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+			})
+			errors.CheckError(err)
+			fmt.Printf("Application '%s' automated sync resumed\n", appName)
+		},
+	}
+	return command
+}
+
+// formatSyncPause returns a human readable description of a sync pause
+func formatSyncPause(pause *argoappv1.SyncPause) string {
+	description := "until " + pause.Until.Format(time.RFC3339)
+	if pause.PausedBy != "" {
+		description += " by " + pause.PausedBy
+	}
+	if pause.Reason != "" {
+		description += ": " + pause.Reason
+	}
+	return description
+}
+
 func NewApplicationEditCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var appNamespace string
 	command := &cobra.Command{
@@ -0,0 +1,168 @@
+package commands
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/argoproj/gitops-engine/pkg/diff"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"gomodules.xyz/jsonpatch/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const (
+	diffOutputText      = "text"
+	diffOutputJSON      = "json"
+	diffOutputJSONPatch = "json-patch"
+	diffOutputYAML      = "yaml"
+)
+
+const (
+	// diffActionCreate means that the resource does not exist in the live state yet
+	diffActionCreate = "create"
+	// diffActionUpdate means that the live state of the resource differs from its target state
+	diffActionUpdate = "update"
+	// diffActionDelete means that the resource is removed from the compared target state
+	diffActionDelete = "delete"
+	// diffActionPrune means that the resource is already marked as requiring pruning by the application controller
+	diffActionPrune = "prune"
+)
+
+// resourceDiff is the structured difference of a single resource printed by `argocd app diff --output json|json-patch|yaml`
+type resourceDiff struct {
+	Group     string `json:"group"`
+	Version   string `json:"version"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	Action    string `json:"action"`
+	// Live is the normalized live state of the resource
+	Live *unstructured.Unstructured `json:"live,omitempty"`
+	// Target is the normalized target state of the resource, as it would look in the cluster after the sync
+	Target *unstructured.Unstructured `json:"target,omitempty"`
+	// Patch is the RFC 6902 JSON patch which transforms the live state into the target state
+	Patch []jsonpatch.Operation `json:"patch"`
+}
+
+func isValidDiffOutput(output string) bool {
+	switch output {
+	case diffOutputText, diffOutputJSON, diffOutputJSONPatch, diffOutputYAML:
+		return true
+	}
+	return false
+}
+
+// newResourceDiff builds the structured difference of a resource from the result of the diff normalization
+func newResourceDiff(app *argoappv1.Application, key kube.ResourceKey, live, target *unstructured.Unstructured, diffRes *diff.DiffResult) (*resourceDiff, error) {
+	normalizedLive, err := unmarshalDiffState(diffRes.NormalizedLive)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling normalized live state of %s: %w", key, err)
+	}
+	predictedLive, err := unmarshalDiffState(diffRes.PredictedLive)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling predicted live state of %s: %w", key, err)
+	}
+	res := &resourceDiff{
+		Group:     key.Group,
+		Kind:      key.Kind,
+		Namespace: key.Namespace,
+		Name:      key.Name,
+	}
+	switch {
+	case live == nil:
+		res.Action = diffActionCreate
+		res.Target = predictedLive
+	case target == nil:
+		res.Action = diffActionDelete
+		if requiresPruning(app, key) {
+			res.Action = diffActionPrune
+		}
+		res.Live = normalizedLive
+	default:
+		res.Action = diffActionUpdate
+		res.Live = normalizedLive
+		res.Target = predictedLive
+	}
+	for _, obj := range []*unstructured.Unstructured{res.Target, res.Live, target, live} {
+		if obj != nil && obj.GetAPIVersion() != "" {
+			res.Version = schema.FromAPIVersionAndKind(obj.GetAPIVersion(), obj.GetKind()).Version
+			break
+		}
+	}
+	res.Patch, err = createJSONPatch(res.Live, res.Target)
+	if err != nil {
+		return nil, fmt.Errorf("error creating json patch for %s: %w", key, err)
+	}
+	return res, nil
+}
+
+func unmarshalDiffState(data []byte) (*unstructured.Unstructured, error) {
+	var obj map[string]any
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, nil
+	}
+	return &unstructured.Unstructured{Object: obj}, nil
+}
+
+// createJSONPatch returns the JSON patch from the live to the target object. A missing object is treated as an empty
+// document.
+func createJSONPatch(live, target *unstructured.Unstructured) ([]jsonpatch.Operation, error) {
+	marshal := func(obj *unstructured.Unstructured) ([]byte, error) {
+		if obj == nil {
+			return []byte("{}"), nil
+		}
+		return json.Marshal(obj.Object)
+	}
+	liveData, err := marshal(live)
+	if err != nil {
+		return nil, err
+	}
+	targetData, err := marshal(target)
+	if err != nil {
+		return nil, err
+	}
+	patch, err := jsonpatch.CreatePatch(liveData, targetData)
+	if err != nil {
+		return nil, err
+	}
+	if patch == nil {
+		patch = []jsonpatch.Operation{}
+	}
+	return patch, nil
+}
+
+func requiresPruning(app *argoappv1.Application, key kube.ResourceKey) bool {
+	for _, res := range app.Status.Resources {
+		if res.Group == key.Group && res.Kind == key.Kind && res.Namespace == key.Namespace && res.Name == key.Name {
+			return res.RequiresPruning
+		}
+	}
+	return false
+}
+
+// printResourceDiffs prints the structured differences in the given output format
+func printResourceDiffs(diffs []*resourceDiff, output string) error {
+	slices.SortFunc(diffs, func(a, b *resourceDiff) int {
+		return cmp.Or(cmp.Compare(a.Group, b.Group), cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+	if output == diffOutputJSONPatch {
+		// only the identity of the resources and the patches are printed
+		patches := make([]*resourceDiff, len(diffs))
+		for i := range diffs {
+			patch := *diffs[i]
+			patch.Live = nil
+			patch.Target = nil
+			patches[i] = &patch
+		}
+		return PrintResourceList(patches, diffOutputJSON, false)
+	}
+	return PrintResourceList(diffs, output, false)
+}
@@ -0,0 +1,129 @@
+package commands
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gomodules.xyz/jsonpatch/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	argodiff "github.com/argoproj/argo-cd/v3/util/argo/diff"
+	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
+)
+
+func newDiffTestConfigMap(data map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]any{"name": "my-config", "namespace": "default"},
+		"data":       data,
+	}}
+}
+
+func diffTestResource(t *testing.T, app *v1alpha1.Application, live, target *unstructured.Unstructured) *resourceDiff {
+	t.Helper()
+	diffConfig, err := argodiff.NewDiffConfigBuilder().
+		WithDiffSettings(app.Spec.IgnoreDifferences, nil, false, normalizers.IgnoreNormalizerOpts{}).
+		WithNoCache().
+		Build()
+	require.NoError(t, err)
+	diffRes, err := argodiff.StateDiff(live, target, diffConfig)
+	require.NoError(t, err)
+	res, err := newResourceDiff(app, kube.NewResourceKey("", "ConfigMap", "default", "my-config"), live, target, &diffRes)
+	require.NoError(t, err)
+	return res
+}
+
+func TestNewResourceDiff(t *testing.T) {
+	app := &v1alpha1.Application{}
+
+	t.Run("Update", func(t *testing.T) {
+		app := &v1alpha1.Application{Spec: v1alpha1.ApplicationSpec{IgnoreDifferences: []v1alpha1.ResourceIgnoreDifferences{{
+			Kind: "ConfigMap", JSONPointers: []string{"/data/ignored"},
+		}}}}
+		live := newDiffTestConfigMap(map[string]any{"key": "old", "ignored": "live"})
+		target := newDiffTestConfigMap(map[string]any{"key": "new", "ignored": "target"})
+
+		res := diffTestResource(t, app, live, target)
+		assert.Equal(t, diffActionUpdate, res.Action)
+		assert.Equal(t, "v1", res.Version)
+		assert.Equal(t, "ConfigMap", res.Kind)
+		assert.Equal(t, "default", res.Namespace)
+		assert.Equal(t, "my-config", res.Name)
+		require.NotNil(t, res.Live)
+		require.NotNil(t, res.Target)
+		// ignored differences are normalized the same way as in the controller
+		assert.Equal(t, []jsonpatch.Operation{{Operation: "replace", Path: "/data/key", Value: "new"}}, res.Patch)
+	})
+	t.Run("Create", func(t *testing.T) {
+		res := diffTestResource(t, app, nil, newDiffTestConfigMap(map[string]any{"key": "new"}))
+		assert.Equal(t, diffActionCreate, res.Action)
+		assert.Nil(t, res.Live)
+		require.NotNil(t, res.Target)
+		assert.Len(t, res.Patch, 4)
+	})
+	t.Run("Delete", func(t *testing.T) {
+		res := diffTestResource(t, app, newDiffTestConfigMap(map[string]any{"key": "old"}), nil)
+		assert.Equal(t, diffActionDelete, res.Action)
+		require.NotNil(t, res.Live)
+		assert.Nil(t, res.Target)
+		assert.Len(t, res.Patch, 4)
+	})
+	t.Run("Prune", func(t *testing.T) {
+		app := &v1alpha1.Application{Status: v1alpha1.ApplicationStatus{Resources: []v1alpha1.ResourceStatus{{
+			Version: "v1", Kind: "ConfigMap", Namespace: "default", Name: "my-config", RequiresPruning: true,
+		}}}}
+		res := diffTestResource(t, app, newDiffTestConfigMap(map[string]any{"key": "old"}), nil)
+		assert.Equal(t, diffActionPrune, res.Action)
+	})
+}
+
+func TestPrintResourceDiffs(t *testing.T) {
+	diffs := []*resourceDiff{{
+		Version: "v1", Kind: "Service", Namespace: "default", Name: "my-svc", Action: diffActionCreate,
+		Target: newDiffTestConfigMap(nil),
+		Patch:  []jsonpatch.Operation{{Operation: "add", Path: "/kind", Value: "Service"}},
+	}, {
+		Version: "v1", Kind: "ConfigMap", Namespace: "default", Name: "my-config", Action: diffActionUpdate,
+		Live:   newDiffTestConfigMap(map[string]any{"key": "old"}),
+		Target: newDiffTestConfigMap(map[string]any{"key": "new"}),
+		Patch:  []jsonpatch.Operation{{Operation: "replace", Path: "/data/key", Value: "new"}},
+	}}
+
+	t.Run("JSON", func(t *testing.T) {
+		out, err := captureOutput(func() error {
+			return printResourceDiffs(diffs, diffOutputJSON)
+		})
+		require.NoError(t, err)
+		var printed []map[string]any
+		require.NoError(t, json.Unmarshal([]byte(out), &printed))
+		require.Len(t, printed, 2)
+		assert.Equal(t, "ConfigMap", printed[0]["kind"])
+		assert.Contains(t, printed[0], "live")
+		assert.Equal(t, "Service", printed[1]["kind"])
+	})
+	t.Run("JSONPatch", func(t *testing.T) {
+		out, err := captureOutput(func() error {
+			return printResourceDiffs(diffs, diffOutputJSONPatch)
+		})
+		require.NoError(t, err)
+		assert.JSONEq(t, `[
+  {"group":"","version":"v1","kind":"ConfigMap","namespace":"default","name":"my-config","action":"update","patch":[{"op":"replace","path":"/data/key","value":"new"}]},
+  {"group":"","version":"v1","kind":"Service","namespace":"default","name":"my-svc","action":"create","patch":[{"op":"add","path":"/kind","value":"Service"}]}
+]`, out)
+		// the original records are left untouched
+		assert.NotNil(t, diffs[0].Live)
+	})
+	t.Run("YAML", func(t *testing.T) {
+		out, err := captureOutput(func() error {
+			return printResourceDiffs(diffs, diffOutputYAML)
+		})
+		require.NoError(t, err)
+		assert.Contains(t, out, "action: update")
+		assert.Contains(t, out, "- op: replace")
+	})
+}
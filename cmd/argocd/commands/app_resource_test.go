@@ -2,11 +2,14 @@ package commands
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 	"text/tabwriter"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
@@ -117,3 +120,57 @@ func TestPrintResourcesTree(t *testing.T) {
 
 	assert.Equal(t, expectation, output)
 }
+
+func TestPrintResourceDriftHistory(t *testing.T) {
+	driftedAt := metav1.NewTime(time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC))
+	healedAt := metav1.NewTime(time.Date(2025, 1, 1, 10, 5, 0, 0, time.UTC))
+	history := []*v1alpha1.ResourceDriftEpisode{
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook", DriftedAt: driftedAt, FieldManagers: []string{"kubectl-edit", "hpa"}, HealedAt: &healedAt, HealType: v1alpha1.ResourceDriftHealTypeSync, HealedBy: "admin"},
+		{Kind: "ConfigMap", Namespace: "default", Name: "config", DriftedAt: healedAt, FieldManagers: []string{"kubectl-patch"}},
+	}
+	buf := &bytes.Buffer{}
+
+	printResourceDriftHistory(buf, history)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.Contains(t, lines[0], "FIELD MANAGERS")
+	assert.Contains(t, lines[1], "kubectl-edit,hpa")
+	assert.Contains(t, lines[1], "Sync (admin)")
+	assert.Contains(t, lines[2], "kubectl-patch")
+	// the last episode is not healed yet
+	fields := strings.Fields(lines[2])
+	assert.Equal(t, []string{"-", "-"}, fields[len(fields)-2:])
+}
+
+func TestFilterOrphanedNodes(t *testing.T) {
+	nodes := []v1alpha1.ResourceNode{
+		{ResourceRef: v1alpha1.ResourceRef{Kind: "ConfigMap", Namespace: "default", Name: "config"}},
+		{ResourceRef: v1alpha1.ResourceRef{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook"}},
+		{ResourceRef: v1alpha1.ResourceRef{Group: "apps", Kind: "ReplicaSet", Namespace: "default", Name: "guestbook-5d8f"}, ParentRefs: []v1alpha1.ResourceRef{{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook"}}},
+	}
+
+	assert.Len(t, filterOrphanedNodes(nodes, "", "", "", ""), 2)
+	filtered := filterOrphanedNodes(nodes, "apps", "", "", "")
+	require.Len(t, filtered, 1)
+	assert.Equal(t, "guestbook", filtered[0].Name)
+	assert.Empty(t, filterOrphanedNodes(nodes, "", "ConfigMap", "other", ""))
+}
+
+func TestPrintOrphanedNodes(t *testing.T) {
+	createdAt := metav1.NewTime(time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC))
+	nodes := []v1alpha1.ResourceNode{
+		{ResourceRef: v1alpha1.ResourceRef{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook"}, CreatedAt: &createdAt},
+		{ResourceRef: v1alpha1.ResourceRef{Kind: "ConfigMap", Namespace: "default", Name: "config"}},
+	}
+	buf := &bytes.Buffer{}
+
+	printOrphanedNodes(buf, nodes)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t, []string{"GROUP", "KIND", "NAMESPACE", "NAME", "CREATED", "AT"}, strings.Fields(lines[0]))
+	assert.Contains(t, lines[1], "guestbook")
+	assert.Contains(t, lines[1], "2025-01-01")
+	assert.Equal(t, []string{"ConfigMap", "default", "config", "-"}, strings.Fields(lines[2]))
+}
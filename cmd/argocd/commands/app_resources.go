@@ -2,7 +2,9 @@ package commands
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/utils"
@@ -287,3 +289,217 @@ func NewApplicationListResourcesCommand(clientOpts *argocdclient.ClientOptions)
 	command.Flags().StringVar(&project, "project", "", `The name of the application's project - specifying this allows the command to report "not found" instead of "permission denied" if the app does not exist`)
 	return command
 }
+
+// NewApplicationDriftCommand returns a new instance of an `argocd app drift` command
+func NewApplicationDriftCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		group        string
+		kind         string
+		namespace    string
+		resourceName string
+		output       string
+		project      string
+		appNamespace string
+	)
+	command := &cobra.Command{
+		Use:   "drift APPNAME",
+		Short: "Show the drift history of application resources",
+		Example: `  # Show the drift history of all the resources of an application
+  argocd app drift my-app
+
+  # Show the drift history of a single deployment
+  argocd app drift my-app --kind Deployment --resource-name my-deployment
+
+  # Print the drift history as JSON
+  argocd app drift my-app -o json`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+			history, err := appIf.ResourceDriftHistory(ctx, &applicationpkg.ResourcesQuery{
+				ApplicationName: &appName,
+				AppNamespace:    &appNs,
+				Project:         &project,
+				Group:           &group,
+				Kind:            &kind,
+				Namespace:       &namespace,
+				Name:            &resourceName,
+			})
+			errors.CheckError(err)
+			switch output {
+			case "json", "yaml":
+				errors.CheckError(PrintResourceList(history.Items, output, false))
+			case "wide", "":
+				printResourceDriftHistory(os.Stdout, history.Items)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVar(&group, "group", "", "Only show the drift history of resources in the given group")
+	command.Flags().StringVar(&kind, "kind", "", "Only show the drift history of resources of the given kind")
+	command.Flags().StringVar(&namespace, "namespace", "", "Only show the drift history of resources in the given namespace")
+	command.Flags().StringVar(&resourceName, "resource-name", "", "Only show the drift history of resources with the given name")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: wide|json|yaml")
+	command.Flags().StringVar(&project, "project", "", `The name of the application's project - specifying this allows the command to report "not found" instead of "permission denied" if the app does not exist`)
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace of the target application where the drift history is stored")
+	return command
+}
+
+func printResourceDriftHistory(out io.Writer, history []*v1alpha1.ResourceDriftEpisode) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "DRIFTED AT\tGROUP\tKIND\tNAMESPACE\tNAME\tFIELD MANAGERS\tHEALED AT\tHEALED BY\n")
+	for _, episode := range history {
+		healedAt := "-"
+		healedBy := "-"
+		if episode.IsHealed() {
+			healedAt = episode.HealedAt.String()
+			healedBy = string(episode.HealType)
+			if episode.HealedBy != "" {
+				healedBy = fmt.Sprintf("%s (%s)", healedBy, episode.HealedBy)
+			}
+		}
+		managers := strings.Join(episode.FieldManagers, ",")
+		if managers == "" {
+			managers = "-"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", episode.DriftedAt.String(), episode.Group, episode.Kind, episode.Namespace, episode.Name, managers, healedAt, healedBy)
+	}
+	_ = w.Flush()
+}
+
+// NewApplicationOrphansCommand returns a new instance of an `argocd app orphans` command
+func NewApplicationOrphansCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		group        string
+		kind         string
+		namespace    string
+		resourceName string
+		adopt        bool
+		deleteOrphan bool
+		output       string
+		project      string
+		appNamespace string
+	)
+	command := &cobra.Command{
+		Use:   "orphans APPNAME",
+		Short: "List, adopt or delete the orphaned resources of an application",
+		Example: `  # List the orphaned resources of an application
+  argocd app orphans my-app
+
+  # Print a git patch adding an orphaned config map to the application source repository
+  argocd app orphans my-app --kind ConfigMap --resource-name my-config --adopt > my-config.patch
+
+  # Delete all the orphaned config maps of an application
+  argocd app orphans my-app --kind ConfigMap --delete`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			if adopt && deleteOrphan {
+				errors.Fatal(errors.ErrorGeneric, "--adopt and --delete are mutually exclusive")
+			}
+			if output != "patch" && output != "manifest" {
+				errors.Fatal(errors.ErrorGeneric, "unknown output format: "+output)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+			tree, err := appIf.ResourceTree(ctx, &applicationpkg.ResourcesQuery{
+				ApplicationName: &appName,
+				AppNamespace:    &appNs,
+				Project:         &project,
+			})
+			errors.CheckError(err)
+			orphans := filterOrphanedNodes(tree.OrphanedNodes, group, kind, namespace, resourceName)
+
+			switch {
+			case adopt:
+				for _, node := range orphans {
+					res, err := appIf.ExportOrphanedResource(ctx, &applicationpkg.ApplicationResourceRequest{
+						Name:         &appName,
+						AppNamespace: &appNs,
+						Namespace:    ptr.To(node.Namespace),
+						ResourceName: ptr.To(node.Name),
+						Version:      ptr.To(node.Version),
+						Group:        ptr.To(node.Group),
+						Kind:         ptr.To(node.Kind),
+						Project:      ptr.To(project),
+					})
+					errors.CheckError(err)
+					if output == "patch" && res.GetPatch() != "" {
+						fmt.Print(res.GetPatch())
+					} else {
+						fmt.Printf("---\n%s", res.GetManifest())
+					}
+				}
+			case deleteOrphan:
+				promptUtil := utils.NewPrompt(clientOpts.PromptsEnabled)
+				for _, node := range orphans {
+					if !promptUtil.Confirm(fmt.Sprintf("Are you sure you want to delete orphaned resource %s/%s %s/%s ? [y/n]", node.Group, node.Kind, node.Namespace, node.Name)) {
+						fmt.Printf("The command to delete %s/%s %s/%s was cancelled.\n", node.Group, node.Kind, node.Namespace, node.Name)
+						continue
+					}
+					_, err = appIf.DeleteResource(ctx, &applicationpkg.ApplicationResourceDeleteRequest{
+						Name:         &appName,
+						AppNamespace: &appNs,
+						Namespace:    ptr.To(node.Namespace),
+						ResourceName: ptr.To(node.Name),
+						Version:      ptr.To(node.Version),
+						Group:        ptr.To(node.Group),
+						Kind:         ptr.To(node.Kind),
+						Project:      ptr.To(project),
+					})
+					errors.CheckError(err)
+					log.Infof("Resource '%s' deleted", node.Name)
+				}
+			default:
+				printOrphanedNodes(os.Stdout, orphans)
+			}
+		},
+	}
+	command.Flags().StringVar(&group, "group", "", "Only select orphaned resources in the given group")
+	command.Flags().StringVar(&kind, "kind", "", "Only select orphaned resources of the given kind")
+	command.Flags().StringVar(&namespace, "namespace", "", "Only select orphaned resources in the given namespace")
+	command.Flags().StringVar(&resourceName, "resource-name", "", "Only select orphaned resources with the given name")
+	command.Flags().BoolVar(&adopt, "adopt", false, "Export the selected orphaned resources to be added to the application source repository")
+	command.Flags().BoolVar(&deleteOrphan, "delete", false, "Delete the selected orphaned resources")
+	command.Flags().StringVarP(&output, "output", "o", "patch", "Output format of adopted resources. One of: patch|manifest")
+	command.Flags().StringVar(&project, "project", "", `The name of the application's project - specifying this allows the command to report "not found" instead of "permission denied" if the app does not exist`)
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace of the target application")
+	return command
+}
+
+// filterOrphanedNodes returns the top level orphaned nodes matching the given filters
+func filterOrphanedNodes(nodes []v1alpha1.ResourceNode, group, kind, namespace, name string) []v1alpha1.ResourceNode {
+	var filtered []v1alpha1.ResourceNode
+	for _, node := range nodes {
+		if len(node.ParentRefs) > 0 {
+			continue
+		}
+		if (group == "" || node.Group == group) && (kind == "" || node.Kind == kind) && (namespace == "" || node.Namespace == namespace) && (name == "" || node.Name == name) {
+			filtered = append(filtered, node)
+		}
+	}
+	return filtered
+}
+
+func printOrphanedNodes(out io.Writer, nodes []v1alpha1.ResourceNode) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "GROUP\tKIND\tNAMESPACE\tNAME\tCREATED AT\n")
+	for _, node := range nodes {
+		createdAt := "-"
+		if node.CreatedAt != nil {
+			createdAt = node.CreatedAt.String()
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", node.Group, node.Kind, node.Namespace, node.Name, createdAt)
+	}
+	_ = w.Flush()
+}
@@ -2,7 +2,12 @@ package commands
 
 import (
 	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"io"
 	"net/http"
@@ -46,6 +51,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/provenance"
 )
 
 func Test_getInfos(t *testing.T) {
@@ -359,6 +365,82 @@ func TestFindRevisionHistoryWithPassedIdThatNotExist(t *testing.T) {
 	require.EqualError(t, err, "application '' does not have deployment id '4' in history", "Find revision history should fail with correct error message")
 }
 
+func Test_verifyProvenance(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	signer, err := provenance.NewSigner(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	require.NoError(t, err)
+	otherKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	commitSHA := "a4ebc9d6ad64e34bd2ae1ee3fe8ec4a4a8ed7e13"
+	digest := provenance.Digest([]string{`{"kind":"ConfigMap"}`})
+	sign := func(digest string, revision string) string {
+		envelope, err := signer.Sign(&provenance.Statement{
+			Type:          provenance.StatementType,
+			Subject:       []provenance.ResourceDescriptor{provenance.NewSubject("guestbook", digest)},
+			PredicateType: provenance.PredicateType,
+			Predicate: provenance.Predicate{BuildDefinition: provenance.BuildDefinition{
+				ResolvedDependencies: []provenance.ResourceDescriptor{provenance.NewDependency("", "https://github.com/org/repo", revision, true)},
+			}},
+		})
+		require.NoError(t, err)
+		return envelope
+	}
+	depInfo := &v1alpha1.RevisionHistory{
+		ID:              1,
+		Source:          v1alpha1.ApplicationSource{RepoURL: "https://github.com/org/repo", Path: "guestbook"},
+		Revision:        commitSHA,
+		ManifestsDigest: digest,
+	}
+
+	t.Run("Verified", func(t *testing.T) {
+		results := verifyProvenance(depInfo, []string{sign(provenance.Digest(nil), commitSHA), sign(digest, commitSHA)}, []crypto.PublicKey{key.Public()})
+		require.Len(t, results, 1)
+		assert.True(t, results[0].Verified)
+		assert.Equal(t, "https://github.com/org/repo (path guestbook)", results[0].Source)
+		assert.Equal(t, signer.KeyID(), results[0].KeyID)
+		assert.Equal(t, digest, results[0].Statement.ManifestsDigest())
+	})
+	t.Run("UntrustedKey", func(t *testing.T) {
+		results := verifyProvenance(depInfo, []string{sign(digest, commitSHA)}, []crypto.PublicKey{otherKey})
+		require.Len(t, results, 1)
+		assert.False(t, results[0].Verified)
+		assert.Equal(t, "No valid provenance attestation of the manifests: provenance is not signed by any of the trusted public keys", results[0].Message)
+	})
+	t.Run("OtherManifests", func(t *testing.T) {
+		results := verifyProvenance(depInfo, []string{sign(provenance.Digest(nil), commitSHA)}, []crypto.PublicKey{key.Public()})
+		assert.False(t, results[0].Verified)
+		assert.Equal(t, "No valid provenance attestation of the manifests", results[0].Message)
+	})
+	t.Run("OtherRevision", func(t *testing.T) {
+		results := verifyProvenance(depInfo, []string{sign(digest, "main")}, []crypto.PublicKey{key.Public()})
+		assert.False(t, results[0].Verified)
+		assert.Equal(t, "Manifests are attested to be generated from revision main", results[0].Message)
+	})
+	t.Run("MultipleSources", func(t *testing.T) {
+		depInfo := &v1alpha1.RevisionHistory{
+			ID: 2,
+			Sources: v1alpha1.ApplicationSources{
+				{RepoURL: "https://github.com/org/repo", Path: "guestbook"},
+				{RepoURL: "https://github.com/org/values", Ref: "values"},
+				{RepoURL: "https://github.com/org/other", Path: "other"},
+			},
+			Revisions:        []string{commitSHA, commitSHA, commitSHA},
+			ManifestsDigests: []string{digest, provenance.Digest(nil)},
+		}
+		results := verifyProvenance(depInfo, []string{sign(digest, commitSHA)}, []crypto.PublicKey{key.Public()})
+		require.Len(t, results, 3)
+		assert.True(t, results[0].Verified)
+		assert.True(t, results[1].Verified)
+		assert.Equal(t, "Reference source without manifests", results[1].Message)
+		assert.False(t, results[2].Verified)
+		assert.Equal(t, "No manifests digest recorded", results[2].Message)
+	})
+}
+
 func Test_groupObjsByKey(t *testing.T) {
 	localObjs := []*unstructured.Unstructured{
 		{
@@ -452,6 +534,12 @@ func TestFormatSyncPolicy(t *testing.T) {
 	})
 }
 
+func TestFormatSyncPause(t *testing.T) {
+	until := metav1.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, "until 2025-01-01T12:00:00Z", formatSyncPause(&v1alpha1.SyncPause{Until: until}))
+	assert.Equal(t, "until 2025-01-01T12:00:00Z by admin: maintenance", formatSyncPause(&v1alpha1.SyncPause{Until: until, PausedBy: "admin", Reason: "maintenance"}))
+}
+
 func TestFormatConditionSummary(t *testing.T) {
 	t.Run("No conditions are defined", func(t *testing.T) {
 		app := v1alpha1.Application{
@@ -1215,6 +1303,19 @@ func Test_unset(t *testing.T) {
 	updated, nothingToUnset = unset(pluginSource, unsetOpts{pluginEnvs: []string{"env-1"}})
 	assert.False(t, updated)
 	assert.False(t, nothingToUnset)
+
+	cueSource := &v1alpha1.ApplicationSource{
+		CUE: &v1alpha1.ApplicationSourceCUE{
+			Tags: []v1alpha1.CUETag{{Name: "env", Value: "prod"}, {Name: "region", Value: "eu"}},
+		},
+	}
+	updated, nothingToUnset = unset(cueSource, unsetOpts{cueTags: []string{"env"}})
+	assert.Equal(t, []v1alpha1.CUETag{{Name: "region", Value: "eu"}}, cueSource.CUE.Tags)
+	assert.True(t, updated)
+	assert.False(t, nothingToUnset)
+	updated, nothingToUnset = unset(cueSource, unsetOpts{cueTags: []string{"env"}})
+	assert.False(t, updated)
+	assert.False(t, nothingToUnset)
 }
 
 func Test_unset_nothingToUnset(t *testing.T) {
@@ -1227,6 +1328,7 @@ func Test_unset_nothingToUnset(t *testing.T) {
 		{"kustomize", v1alpha1.ApplicationSource{Kustomize: &v1alpha1.ApplicationSourceKustomize{}}},
 		{"helm", v1alpha1.ApplicationSource{Helm: &v1alpha1.ApplicationSourceHelm{}}},
 		{"plugin", v1alpha1.ApplicationSource{Plugin: &v1alpha1.ApplicationSourcePlugin{}}},
+		{"cue", v1alpha1.ApplicationSource{CUE: &v1alpha1.ApplicationSourceCUE{}}},
 	}
 
 	for _, testCase := range testCases {
@@ -2200,6 +2302,14 @@ func (c *fakeAppServiceClient) ManagedResources(_ context.Context, _ *applicatio
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) ResourceDriftHistory(_ context.Context, _ *applicationpkg.ResourcesQuery, _ ...grpc.CallOption) (*applicationpkg.ResourceDriftHistoryResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) ExportOrphanedResource(_ context.Context, _ *applicationpkg.ApplicationResourceRequest, _ ...grpc.CallOption) (*applicationpkg.OrphanedResourceExportResponse, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) ResourceTree(_ context.Context, _ *applicationpkg.ResourcesQuery, _ ...grpc.CallOption) (*v1alpha1.ApplicationTree, error) {
 	return nil, nil
 }
@@ -2212,10 +2322,22 @@ func (c *fakeAppServiceClient) Rollback(_ context.Context, _ *applicationpkg.App
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) RestoreSnapshot(_ context.Context, _ *applicationpkg.ApplicationRestoreSnapshotRequest, _ ...grpc.CallOption) (*v1alpha1.Application, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) TerminateOperation(_ context.Context, _ *applicationpkg.OperationTerminateRequest, _ ...grpc.CallOption) (*applicationpkg.OperationTerminateResponse, error) {
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) Pause(_ context.Context, _ *applicationpkg.ApplicationPauseRequest, _ ...grpc.CallOption) (*v1alpha1.Application, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) Resume(_ context.Context, _ *applicationpkg.ApplicationResumeRequest, _ ...grpc.CallOption) (*v1alpha1.Application, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) GetResource(_ context.Context, _ *applicationpkg.ApplicationResourceRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationResourceResponse, error) {
 	return nil, nil
 }
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -44,6 +45,7 @@ argocd proj windows list <project-name>`,
 	roleCommand.AddCommand(NewProjectWindowsEnableManualSyncCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectWindowsAddWindowCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectWindowsDeleteCommand(clientOpts))
+	roleCommand.AddCommand(NewProjectWindowsFreezeCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectWindowsListCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectWindowsUpdateCommand(clientOpts))
 	return roleCommand
@@ -210,6 +212,71 @@ argocd proj windows add PROJECT \
 	return command
 }
 
+// NewProjectWindowsFreezeCommand returns a new instance of an `argocd proj windows freeze` command
+func NewProjectWindowsFreezeCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		until        string
+		reason       string
+		applications []string
+		namespaces   []string
+		clusters     []string
+	)
+	command := &cobra.Command{
+		Use:   "freeze PROJECT",
+		Short: "Block the syncs of a project until a given time",
+		Long:  "Add a deny sync window to a project, which blocks the syncs until it expires. The window applies to all the applications of the project unless applications, namespaces or clusters are given. Requires the freeze action on the project.",
+		Example: `
+#Block the syncs of all the applications of a project until a given time
+argocd proj windows freeze PROJECT --until 2026-12-31T00:00:00Z --reason "Year-end freeze"
+
+#Block the syncs of the production applications for the next 4 hours
+argocd proj windows freeze PROJECT --until 4h --applications "prod-\\*" --reason "Incident 123"`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 || until == "" {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			expiry, err := parseFreezeExpiry(until, time.Now())
+			errors.CheckError(err)
+
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer utilio.Close(conn)
+
+			_, err = projIf.Freeze(ctx, &projectpkg.ProjectFreezeRequest{
+				Name:         projName,
+				Until:        expiry.UTC().Format(time.RFC3339),
+				Reason:       reason,
+				Applications: applications,
+				Namespaces:   namespaces,
+				Clusters:     clusters,
+			})
+			errors.CheckError(err)
+			fmt.Printf("Project '%s' syncs are frozen until %s\n", projName, expiry.UTC().Format(time.RFC3339))
+		},
+	}
+	command.Flags().StringVar(&until, "until", "", "Time until which the syncs are blocked, either in RFC3339 format or as a duration from now (e.g. --until 2026-12-31T00:00:00Z or --until 4h)")
+	command.Flags().StringVar(&reason, "reason", "", "Reason of the freeze, which is shown on blocked sync attempts")
+	command.Flags().StringSliceVar(&applications, "applications", []string{}, "Applications that the freeze will be applied to. Comma separated, wildcards supported (e.g. --applications prod-\\*,website)")
+	command.Flags().StringSliceVar(&namespaces, "namespaces", []string{}, "Namespaces that the freeze will be applied to. Comma separated, wildcards supported (e.g. --namespaces default,\\*-prod)")
+	command.Flags().StringSliceVar(&clusters, "clusters", []string{}, "Clusters that the freeze will be applied to. Comma separated, wildcards supported (e.g. --clusters prod,staging)")
+	return command
+}
+
+// parseFreezeExpiry parses the expiry of a freeze window, given either as a RFC3339 time or as a duration from now
+func parseFreezeExpiry(until string, now time.Time) (time.Time, error) {
+	if expiry, err := time.Parse(time.RFC3339, until); err == nil {
+		return expiry, nil
+	}
+	duration, err := time.ParseDuration(until)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("cannot parse '%s' as a RFC3339 time or a duration", until)
+	}
+	return now.Add(duration), nil
+}
+
 // NewProjectWindowsDeleteCommand returns a new instance of an `argocd proj windows delete` command
 func NewProjectWindowsDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command := &cobra.Command{
@@ -368,11 +435,15 @@ func printSyncWindows(proj *v1alpha1.AppProject) {
 	if proj.Spec.SyncWindows.HasWindows() {
 		for i, window := range proj.Spec.SyncWindows {
 			isActive, _ := window.Active()
+			schedule := window.Schedule
+			if schedule == "" && window.ExpiresAt != nil {
+				schedule = "until " + window.ExpiresAt.Format(time.RFC3339)
+			}
 			vals := []any{
 				strconv.Itoa(i),
 				formatBoolOutput(isActive),
 				window.Kind,
-				window.Schedule,
+				schedule,
 				window.Duration,
 				formatListOutput(window.Applications),
 				formatListOutput(window.Namespaces),
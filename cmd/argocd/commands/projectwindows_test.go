@@ -0,0 +1,24 @@
+package commands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFreezeExpiry(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	expiry, err := parseFreezeExpiry("2026-12-31T00:00:00Z", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC), expiry)
+
+	expiry, err = parseFreezeExpiry("4h", now)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(4*time.Hour), expiry)
+
+	_, err = parseFreezeExpiry("tomorrow", now)
+	require.EqualError(t, err, "cannot parse 'tomorrow' as a RFC3339 time or a duration")
+}
@@ -85,6 +85,9 @@ type AppOptions struct {
 	kustomizeApiVersions            []string //nolint:revive //FIXME(var-naming)
 	ignoreMissingComponents         bool
 	pluginEnvs                      []string
+	cuePackage                      string
+	cueExpression                   string
+	cueTags                         []string
 	Validate                        bool
 	directoryExclude                string
 	directoryInclude                string
@@ -92,6 +95,7 @@ type AppOptions struct {
 	retryBackoffDuration            time.Duration
 	retryBackoffMaxDuration         time.Duration
 	retryBackoffFactor              int64
+	retryFailedResourcesOnly        bool
 	ref                             string
 	SourceName                      string
 	drySourceRepo                   string
@@ -167,6 +171,9 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().StringArrayVar(&opts.kustomizeReplicas, "kustomize-replica", []string{}, "Kustomize replicas (e.g. --kustomize-replica my-development=2 --kustomize-replica my-statefulset=4)")
 	command.Flags().BoolVar(&opts.ignoreMissingComponents, "ignore-missing-components", false, "Ignore locally missing component directories when setting Kustomize components")
 	command.Flags().StringArrayVar(&opts.pluginEnvs, "plugin-env", []string{}, "Additional plugin envs")
+	command.Flags().StringVar(&opts.cuePackage, "cue-package", "", "CUE package to evaluate")
+	command.Flags().StringVar(&opts.cueExpression, "cue-expression", "", "CUE expression selecting the manifests within the evaluated package")
+	command.Flags().StringArrayVar(&opts.cueTags, "cue-tag", []string{}, "CUE tags injected into the fields annotated with @tag() (e.g. --cue-tag env=prod)")
 	command.Flags().BoolVar(&opts.Validate, "validate", true, "Validation of repo and cluster")
 	command.Flags().StringArrayVar(&opts.kustomizeCommonLabels, "kustomize-common-label", []string{}, "Set common labels in Kustomize")
 	command.Flags().StringArrayVar(&opts.kustomizeCommonAnnotations, "kustomize-common-annotation", []string{}, "Set common labels in Kustomize")
@@ -183,6 +190,7 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().DurationVar(&opts.retryBackoffDuration, "sync-retry-backoff-duration", argoappv1.DefaultSyncRetryDuration, "Sync retry backoff base duration. Input needs to be a duration (e.g. 2m, 1h)")
 	command.Flags().DurationVar(&opts.retryBackoffMaxDuration, "sync-retry-backoff-max-duration", argoappv1.DefaultSyncRetryMaxDuration, "Max sync retry backoff duration. Input needs to be a duration (e.g. 2m, 1h)")
 	command.Flags().Int64Var(&opts.retryBackoffFactor, "sync-retry-backoff-factor", argoappv1.DefaultSyncRetryFactor, "Factor multiplies the base duration after each failed sync retry")
+	command.Flags().BoolVar(&opts.retryFailedResourcesOnly, "sync-retry-failed-resources-only", false, "Limit each sync retry to the resources which failed in the previous attempt")
 	command.Flags().StringVar(&opts.ref, "ref", "", "Ref is reference to another source within sources field")
 	command.Flags().StringVar(&opts.SourceName, "source-name", "", "Name of the source from the list of sources of the app.")
 }
@@ -306,6 +314,12 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 		}
 		spec.SyncPolicy.Automated.AllowEmpty = appOpts.allowEmpty
 	}
+	if flags.Changed("sync-retry-failed-resources-only") {
+		if spec.SyncPolicy == nil || spec.SyncPolicy.Retry == nil {
+			log.Fatal("Cannot set --sync-retry-failed-resources-only: application not configured with sync retry")
+		}
+		spec.SyncPolicy.Retry.FailedResourcesOnly = appOpts.retryFailedResourcesOnly
+	}
 
 	return visited
 }
@@ -513,6 +527,27 @@ func setJsonnetOptLibs(src *argoappv1.ApplicationSource, libs []string) {
 	src.Directory.Jsonnet.Libs = append(src.Directory.Jsonnet.Libs, libs...)
 }
 
+type cueOpts struct {
+	pkg        string
+	expression string
+	tags       []string
+}
+
+func setCUEOpt(src *argoappv1.ApplicationSource, opts cueOpts) {
+	if src.CUE == nil {
+		src.CUE = &argoappv1.ApplicationSourceCUE{}
+	}
+	if opts.pkg != "" {
+		src.CUE.Package = opts.pkg
+	}
+	if opts.expression != "" {
+		src.CUE.Expression = opts.expression
+	}
+	for _, text := range opts.tags {
+		src.CUE.AddTag(argoappv1.NewCUETag(text))
+	}
+}
+
 // SetParameterOverrides updates an existing or appends a new parameter override in the application
 // The app is assumed to be a helm app and is expected to be in the form:
 // param=value
@@ -794,6 +829,12 @@ func ConstructSource(source *argoappv1.ApplicationSource, appOpts AppOptions, fl
 			setJsonnetOptLibs(source, appOpts.jsonnetLibs)
 		case "plugin-env":
 			setPluginOptEnvs(source, appOpts.pluginEnvs)
+		case "cue-package":
+			setCUEOpt(source, cueOpts{pkg: appOpts.cuePackage})
+		case "cue-expression":
+			setCUEOpt(source, cueOpts{expression: appOpts.cueExpression})
+		case "cue-tag":
+			setCUEOpt(source, cueOpts{tags: appOpts.cueTags})
 		case "ref":
 			source.Ref = appOpts.ref
 		case "source-name":
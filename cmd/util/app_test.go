@@ -207,6 +207,16 @@ func Test_setPluginOptEnvs(t *testing.T) {
 	})
 }
 
+func Test_setCUEOpt(t *testing.T) {
+	src := v1alpha1.ApplicationSource{}
+	setCUEOpt(&src, cueOpts{pkg: "guestbook", expression: "objects"})
+	assert.Equal(t, &v1alpha1.ApplicationSourceCUE{Package: "guestbook", Expression: "objects"}, src.CUE)
+	setCUEOpt(&src, cueOpts{tags: []string{"env=dev", "debug"}})
+	setCUEOpt(&src, cueOpts{tags: []string{"env=prod"}})
+	assert.Equal(t, []v1alpha1.CUETag{{Name: "env", Value: "prod"}, {Name: "debug"}}, src.CUE.Tags)
+	assert.Equal(t, "objects", src.CUE.Expression)
+}
+
 type appOptionsFixture struct {
 	spec    *v1alpha1.ApplicationSpec
 	command *cobra.Command
@@ -275,6 +285,13 @@ func Test_setAppSpecOptions(t *testing.T) {
 		require.NoError(t, f.SetFlag("sync-retry-limit", "0"))
 		assert.Nil(t, f.spec.SyncPolicy.Retry)
 	})
+	t.Run("RetryFailedResourcesOnly", func(t *testing.T) {
+		f := newAppOptionsFixture()
+		require.NoError(t, f.SetFlag("sync-retry-limit", "5"))
+		require.NoError(t, f.SetFlag("sync-retry-failed-resources-only", "true"))
+		assert.Equal(t, int64(5), f.spec.SyncPolicy.Retry.Limit)
+		assert.True(t, f.spec.SyncPolicy.Retry.FailedResourcesOnly)
+	})
 	t.Run("Kustomize", func(t *testing.T) {
 		require.NoError(t, f.SetFlag("kustomize-replica", "my-deployment=2"))
 		require.NoError(t, f.SetFlag("kustomize-replica", "my-statefulset=4"))
@@ -21,9 +21,9 @@ func ValidateBearerTokenForHTTPSRepoOnly(bearerToken string, isHTTPS bool) error
 }
 
 func ValidateBearerTokenForGitOnly(bearerToken string, repoType string) error {
-	// Bearer token is only valid for Git repositories
-	if bearerToken != "" && repoType != "git" {
-		err := stderrors.New("--bearer-token is only supported for Git repositories")
+	// Bearer token is only valid for Git and archive repositories
+	if bearerToken != "" && repoType != "git" && repoType != "archive" {
+		err := stderrors.New("--bearer-token is only supported for Git and archive repositories")
 		return err
 	}
 	return nil
@@ -66,7 +66,7 @@ func TestValidateBearerTokenForGitOnly(t *testing.T) {
 			bearerToken: "some-token",
 			repoType:    "helm",
 			expectError: true,
-			errorMsg:    "--bearer-token is only supported for Git repositories",
+			errorMsg:    "--bearer-token is only supported for Git and archive repositories",
 		},
 		{
 			name:        "Bearer token with git repo",
@@ -74,6 +74,12 @@ func TestValidateBearerTokenForGitOnly(t *testing.T) {
 			repoType:    "git",
 			expectError: false,
 		},
+		{
+			name:        "Bearer token with archive repo",
+			bearerToken: "some-token",
+			repoType:    "archive",
+			expectError: false,
+		},
 		{
 			name:        "No bearer token with helm repo",
 			bearerToken: "",
@@ -91,7 +97,7 @@ func TestValidateBearerTokenForGitOnly(t *testing.T) {
 			bearerToken: "some-token",
 			repoType:    "",
 			expectError: true,
-			errorMsg:    "--bearer-token is only supported for Git repositories",
+			errorMsg:    "--bearer-token is only supported for Git and archive repositories",
 		},
 	}
 
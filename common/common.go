@@ -90,6 +90,8 @@ const (
 	DefaultSSHKnownHostsName = "ssh_known_hosts"
 	// DefaultGnuPgHomePath is the Default path to GnuPG home directory
 	DefaultGnuPgHomePath = "/app/config/gpg/keys"
+	// DefaultPathCosignTrustRoot is the default path where the trust root for keyless cosign signatures is located
+	DefaultPathCosignTrustRoot = "/app/config/cosign"
 	// DefaultAppConfigPath is the Default path to repo server TLS endpoint config
 	DefaultAppConfigPath = "/app/config"
 	// DefaultPluginSockFilePath is the Default path to cmp server plugin socket file
@@ -136,6 +138,11 @@ const (
 	// cluster changes, this algorithm minimises the changes between shard and clusters assignments.
 	ConsistentHashingWithBoundedLoadsAlgorithm = "consistent-hashing"
 
+	// LoadAwareShardingAlgorithm weights clusters by their measured cost (resources count, watch events rate and
+	// number of applications) and periodically rebalances them across shards so that the load of every shard stays
+	// within a configurable tolerance of the average load.
+	LoadAwareShardingAlgorithm = "load-aware"
+
 	DefaultShardingAlgorithm = LegacyShardingAlgorithm
 )
 
@@ -176,6 +183,8 @@ const (
 	LabelKeyLegacyApplicationName = "applications.argoproj.io/app-name"
 	// LabelKeySecretType contains the type of argocd secret (currently: 'cluster', 'repository', 'repo-config' or 'repo-creds')
 	LabelKeySecretType = "argocd.argoproj.io/secret-type"
+	// LabelKeyHelmValues must be set to true on ConfigMaps and Secrets which Applications may use as Helm values sources
+	LabelKeyHelmValues = "argocd.argoproj.io/helm-values"
 	// LabelKeyClusterKubernetesVersion contains the kubernetes version of the cluster secret if it has been enabled
 	LabelKeyClusterKubernetesVersion = "argocd.argoproj.io/kubernetes-version"
 	// LabelValueSecretTypeCluster indicates a secret type of cluster
@@ -221,6 +230,12 @@ const (
 	// AnnotationKeyAppSkipReconcile tells the Application to skip the Application controller reconcile.
 	// Skip reconcile when the value is "true" or any other string values that can be strconv.ParseBool() to be true.
 	AnnotationKeyAppSkipReconcile = "argocd.argoproj.io/skip-reconcile"
+	// AnnotationKeyApplicationSharding when set to "true" on a cluster secret distributes the Applications targeting
+	// the cluster across all application controller shards instead of assigning the whole cluster to a single shard.
+	AnnotationKeyApplicationSharding = "argocd.argoproj.io/application-sharding"
+	// AnnotationKeyReconcilePriority sets the priority class (critical, high, normal or low) in which an Application is
+	// queued for reconciliation and sync operations. When set on an AppProject it is the default for its Applications.
+	AnnotationKeyReconcilePriority = "argocd.argoproj.io/reconcile-priority"
 	// LabelKeyComponentRepoServer is the label key to identify the component as repo-server
 	LabelKeyComponentRepoServer = "app.kubernetes.io/component"
 	// LabelValueComponentRepoServer is the label value for the repo-server component
@@ -265,6 +280,10 @@ const (
 	EnvControllerShard = "ARGOCD_CONTROLLER_SHARD"
 	// EnvControllerShardingAlgorithm is the distribution sharding algorithm to be used: legacy or round-robin
 	EnvControllerShardingAlgorithm = "ARGOCD_CONTROLLER_SHARDING_ALGORITHM"
+	// EnvControllerShardingLoadTolerance is the tolerated deviation of a shard load from the average shard load used by the load-aware sharding algorithm
+	EnvControllerShardingLoadTolerance = "ARGOCD_CONTROLLER_SHARDING_LOAD_TOLERANCE"
+	// EnvControllerShardingRebalanceInterval is the interval at which the load-aware sharding algorithm rebalances clusters across shards
+	EnvControllerShardingRebalanceInterval = "ARGOCD_CONTROLLER_SHARDING_REBALANCE_INTERVAL"
 	// EnvEnableDynamicClusterDistribution enables dynamic sharding (ALPHA)
 	EnvEnableDynamicClusterDistribution = "ARGOCD_ENABLE_DYNAMIC_CLUSTER_DISTRIBUTION"
 	// EnvEnableGRPCTimeHistogramEnv enables gRPC metrics collection
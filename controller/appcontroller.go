@@ -49,6 +49,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/controller/hydrator"
 	hydratortypes "github.com/argoproj/argo-cd/v3/controller/hydrator/types"
 	"github.com/argoproj/argo-cd/v3/controller/metrics"
+	"github.com/argoproj/argo-cd/v3/controller/priorityqueue"
 	"github.com/argoproj/argo-cd/v3/controller/sharding"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -61,6 +62,7 @@ import (
 	argodiff "github.com/argoproj/argo-cd/v3/util/argo/diff"
 	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
 	"github.com/argoproj/argo-cd/v3/util/env"
+	"github.com/argoproj/argo-cd/v3/util/snapshot"
 	"github.com/argoproj/argo-cd/v3/util/stats"
 
 	"github.com/argoproj/argo-cd/v3/pkg/ratelimiter"
@@ -138,6 +140,7 @@ type ApplicationController struct {
 	kubectlSemaphore              *semaphore.Weighted
 	clusterSharding               sharding.ClusterShardingCache
 	projByNameCache               sync.Map
+	orphanedResources             sync.Map
 	applicationNamespaces         []string
 	ignoreNormalizerOpts          normalizers.IgnoreNormalizerOpts
 
@@ -145,6 +148,9 @@ type ApplicationController struct {
 	dynamicClusterDistributionEnabled bool
 	deploymentInformer                informerv1.DeploymentInformer
 
+	// helmValuesInformerFactory informs about the ConfigMaps and Secrets holding the Helm values of applications
+	helmValuesInformerFactory informers.SharedInformerFactory
+
 	hydrator *hydrator.Hydrator
 }
 
@@ -176,11 +182,14 @@ func NewApplicationController(
 	clusterSharding sharding.ClusterShardingCache,
 	applicationNamespaces []string,
 	rateLimiterConfig *ratelimiter.AppControllerRateLimiterConfig,
+	queueStarvationTimeout time.Duration,
 	serverSideDiff bool,
 	dynamicClusterDistributionEnabled bool,
 	ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts,
 	enableK8sEvent []string,
 	hydratorEnabled bool,
+	snapshotStore snapshot.Store,
+	snapshotRetention int,
 ) (*ApplicationController, error) {
 	log.Infof("appResyncPeriod=%v, appHardResyncPeriod=%v, appResyncJitter=%v", appResyncPeriod, appHardResyncPeriod, appResyncJitter)
 	db := db.NewDB(namespace, settingsMgr, kubeClientset)
@@ -194,8 +203,6 @@ func NewApplicationController(
 		kubeClientset:                     kubeClientset,
 		kubectl:                           kubectl,
 		applicationClientset:              applicationClientset,
-		appRefreshQueue:                   workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), workqueue.TypedRateLimitingQueueConfig[string]{Name: "app_reconciliation_queue"}),
-		appOperationQueue:                 workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), workqueue.TypedRateLimitingQueueConfig[string]{Name: "app_operation_processing_queue"}),
 		projectRefreshQueue:               workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), workqueue.TypedRateLimitingQueueConfig[string]{Name: "project_reconciliation_queue"}),
 		appComparisonTypeRefreshQueue:     workqueue.NewTypedRateLimitingQueue(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig)),
 		appHydrateQueue:                   workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), workqueue.TypedRateLimitingQueueConfig[string]{Name: "app_hydration_queue"}),
@@ -219,6 +226,8 @@ func NewApplicationController(
 		ignoreNormalizerOpts:              ignoreNormalizerOpts,
 		metricsClusterLabels:              metricsClusterLabels,
 	}
+	ctrl.appRefreshQueue = ctrl.newAppPriorityQueue("app_reconciliation_queue", rateLimiterConfig, queueStarvationTimeout)
+	ctrl.appOperationQueue = ctrl.newAppPriorityQueue("app_operation_processing_queue", rateLimiterConfig, queueStarvationTimeout)
 	if hydratorEnabled {
 		ctrl.hydrator = hydrator.NewHydrator(&ctrl, appResyncPeriod, commitClientset, repoClientset, db)
 	}
@@ -329,7 +338,14 @@ func NewApplicationController(
 		}
 	}
 	stateCache := statecache.NewLiveStateCache(db, appInformer, ctrl.settingsMgr, ctrl.metricsServer, ctrl.handleObjectUpdated, clusterSharding, argo.NewResourceTracking())
-	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectl, ctrl.onKubectlRun, ctrl.settingsMgr, stateCache, ctrl.metricsServer, argoCache, ctrl.statusRefreshTimeout, argo.NewResourceTracking(), persistResourceHealth, repoErrorGracePeriod, serverSideDiff, ignoreNormalizerOpts)
+	// the Helm values are read from the namespaces of the applications
+	helmValuesNamespace := namespace
+	if len(applicationNamespaces) > 0 {
+		helmValuesNamespace = metav1.NamespaceAll
+	}
+	ctrl.helmValuesInformerFactory = argo.NewHelmValuesInformerFactory(kubeClientset, helmValuesNamespace, 0)
+	helmValues := argo.NewHelmValuesListerGetter(ctrl.helmValuesInformerFactory)
+	appStateManager := NewAppStateManager(db, applicationClientset, kubeClientset, repoClientset, namespace, kubectl, ctrl.onKubectlRun, ctrl.settingsMgr, stateCache, ctrl.metricsServer, argoCache, ctrl.statusRefreshTimeout, argo.NewResourceTracking(), persistResourceHealth, repoErrorGracePeriod, serverSideDiff, ignoreNormalizerOpts, snapshotStore, snapshotRetention, helmValues)
 	ctrl.appInformer = appInformer
 	ctrl.appLister = appLister
 	ctrl.projInformer = projInformer
@@ -625,6 +641,7 @@ func (ctrl *ApplicationController) getResourceTree(destCluster *appv1.Cluster, a
 	if err != nil {
 		return nil, fmt.Errorf("failed to iterate resource hierarchy v2: %w", err)
 	}
+	setResourceNodeConflicts(nodes, a.Status.OperationState)
 	ts.AddCheckpoint("process_managed_resources_ms")
 	orphanedNodes := make([]appv1.ResourceNode, 0)
 	orphanedNodesKeys := make([]kube.ResourceKey, 0)
@@ -912,10 +929,13 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 
 	go ctrl.appInformer.Run(ctx.Done())
 	go ctrl.projInformer.Run(ctx.Done())
+	ctrl.helmValuesInformerFactory.Start(ctx.Done())
 
 	errors.CheckError(ctrl.stateCache.Init())
 
-	if !cache.WaitForCacheSync(ctx.Done(), ctrl.appInformer.HasSynced, ctrl.projInformer.HasSynced) {
+	helmValuesConfigMapsSynced := ctrl.helmValuesInformerFactory.Core().V1().ConfigMaps().Informer().HasSynced
+	helmValuesSecretsSynced := ctrl.helmValuesInformerFactory.Core().V1().Secrets().Informer().HasSynced
+	if !cache.WaitForCacheSync(ctx.Done(), ctrl.appInformer.HasSynced, ctrl.projInformer.HasSynced, helmValuesConfigMapsSynced, helmValuesSecretsSynced) {
 		log.Error("Timed out waiting for caches to sync")
 		return
 	}
@@ -1125,10 +1145,48 @@ func (ctrl *ApplicationController) processProjectQueueItem() (processNext bool)
 		if err := ctrl.finalizeProjectDeletion(origProj.DeepCopy()); err != nil {
 			log.Warnf("Failed to finalize project deletion: %v", err)
 		}
+		return
+	}
+	if err := ctrl.removeExpiredProjectWindows(origProj.DeepCopy()); err != nil {
+		log.Warnf("Failed to remove expired sync windows of project '%s': %v", origProj.Name, err)
+	}
+	// Reprocess the project when its next sync window expires, so that the window is removed
+	if expiry := origProj.Spec.NextWindowExpiry(); expiry != nil {
+		ctrl.projectRefreshQueue.AddAfter(key, time.Until(*expiry))
 	}
 	return
 }
 
+// removeExpiredProjectWindows removes the expired sync windows, such as sync freezes, from the project
+func (ctrl *ApplicationController) removeExpiredProjectWindows(proj *appv1.AppProject) error {
+	removed := proj.Spec.RemoveExpiredWindows()
+	if removed == 0 {
+		return nil
+	}
+	// The resource version makes the patch fail instead of overwriting windows added in the meantime
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"resourceVersion": proj.ResourceVersion,
+		},
+		"spec": map[string]any{
+			"syncWindows": proj.Spec.SyncWindows,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error marshaling sync windows: %w", err)
+	}
+	_, err = ctrl.applicationClientset.ArgoprojV1alpha1().AppProjects(ctrl.namespace).Patch(context.Background(), proj.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if apierrors.IsConflict(err) {
+		// The project has changed and is processed again with its latest version
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error patching project: %w", err)
+	}
+	log.Infof("Removed %d expired sync windows from project '%s'", removed, proj.Name)
+	return nil
+}
+
 func (ctrl *ApplicationController) finalizeProjectDeletion(proj *appv1.AppProject) error {
 	apps, err := ctrl.appLister.Applications(ctrl.namespace).List(labels.Everything())
 	if err != nil {
@@ -1203,6 +1261,7 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 	if err != nil {
 		logCtx.Warnf("Unable to get destination cluster: %v", err)
 		app.UnSetCascadedDeletion()
+		app.UnSetPreDeleteFinalizer()
 		app.UnSetPostDeleteFinalizer()
 		if err := ctrl.updateFinalizers(app); err != nil {
 			return err
@@ -1216,6 +1275,26 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 	}
 	config := metrics.AddMetricsTransportWrapper(ctrl.metricsServer, app, clusterRESTConfig)
 
+	if app.HasPreDeleteFinalizer() {
+		// pre-delete hooks only run when the resources of the application are deleted
+		if app.CascadedDeletion() {
+			objsMap, err := ctrl.getPermittedAppLiveObjects(destCluster, app, proj, projectClusters)
+			if err != nil {
+				return err
+			}
+
+			done, err := ctrl.executePreDeleteHooks(app, proj, objsMap, config, logCtx)
+			if err != nil {
+				return err
+			}
+			if !done {
+				return nil
+			}
+		}
+		app.UnSetPreDeleteFinalizer()
+		return ctrl.updateFinalizers(app)
+	}
+
 	if app.CascadedDeletion() {
 		deletionApproved := app.IsDeletionConfirmed(app.DeletionTimestamp.Time)
 
@@ -1412,10 +1491,17 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 				ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &retryAfter)
 				return
 			}
+			if retry := state.Operation.Retry; retry.FailedResourcesOnly && state.Operation.Sync != nil {
+				// Narrow the retry attempt to the resources which failed in the previous attempt
+				if syncOp := state.Operation.Sync.RetryFailedResources(state.SyncResult); syncOp != nil {
+					logCtx.Infof("Retrying %d failed resources", len(syncOp.Resources))
+					state.Operation.Sync = syncOp
+				}
+			}
 			// Get rid of sync results and null out previous operation completion time
 			// This will start the retry attempt
 			state.FinishedAt = nil
-			state.SyncResult = nil
+			state.SyncResult = retrySyncResult(app, state)
 			ctrl.setOperationState(app, state)
 		case ctrl.syncTimeout != time.Duration(0) && time.Now().After(state.StartedAt.Add(ctrl.syncTimeout)) && !terminating:
 			state.Phase = synccommon.OperationTerminating
@@ -1492,6 +1578,18 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 	ts.AddCheckpoint("request_app_refresh_ms")
 }
 
+// retrySyncResult returns the sync result a retry attempt of the given operation starts with. The results of the
+// previous attempt are dropped, except the ID of the snapshot taken by the first attempt: the snapshot of the live state
+// before the operation must not be taken again over the state the previous attempt has already changed.
+func retrySyncResult(app *appv1.Application, state *appv1.OperationState) *appv1.SyncOperationResult {
+	if state.SyncResult == nil || state.SyncResult.Snapshot == "" || state.Operation.Sync == nil {
+		return nil
+	}
+	syncResult := newSyncOperationResult(app, *state.Operation.Sync)
+	syncResult.Snapshot = state.SyncResult.Snapshot
+	return syncResult
+}
+
 func (ctrl *ApplicationController) setOperationState(app *appv1.Application, state *appv1.OperationState) {
 	logCtx := log.WithFields(applog.GetAppLogFields(app))
 	if state.Phase == "" {
@@ -1769,9 +1867,23 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		logCtx.Errorf("Failed to cache app resources: %v", err)
 	} else {
 		app.Status.Summary = tree.GetSummary(app)
+		ctrl.cleanupOrphanedResources(destCluster, app, project, tree.OrphanedNodes)
 	}
 
-	canSync, _ := project.Spec.SyncWindows.Matches(app).CanSync(false)
+	if err := ctrl.recordResourceDrift(app, compareResult); err != nil {
+		logCtx.Warnf("Failed to record resource drift: %v", err)
+	}
+	ts.AddCheckpoint("record_resource_drift_ms")
+
+	ctrl.liftExpiredSyncPause(app)
+	ts.AddCheckpoint("lift_expired_sync_pause_ms")
+
+	canSync := false
+	if syncWindows, err := argo.GetSyncWindows(app, project, ctrl.settingsMgr); err != nil {
+		logCtx.Warnf("Failed to get sync windows: %v", err)
+	} else {
+		canSync, _ = syncWindows.CanSync(false)
+	}
 	if canSync {
 		syncErrCond, opDuration := ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources, compareResult.revisionsMayHaveChanges)
 		setOpDuration = opDuration
@@ -1807,8 +1919,13 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 	patchDuration = ctrl.persistAppStatus(origApp, &app.Status)
 	// This is a partly a duplicate of patch_ms, but more descriptive and allows to have measurement for the next step.
 	ts.AddCheckpoint("persist_app_status_ms")
-	if (compareResult.hasPostDeleteHooks != app.HasPostDeleteFinalizer() || compareResult.hasPostDeleteHooks != app.HasPostDeleteFinalizer("cleanup")) &&
+	if (compareResult.hasPreDeleteHooks != app.HasPreDeleteFinalizer() || compareResult.hasPostDeleteHooks != app.HasPostDeleteFinalizer() || compareResult.hasPostDeleteHooks != app.HasPostDeleteFinalizer("cleanup")) &&
 		app.GetDeletionTimestamp() == nil {
+		if compareResult.hasPreDeleteHooks {
+			app.SetPreDeleteFinalizer()
+		} else {
+			app.UnSetPreDeleteFinalizer()
+		}
 		if compareResult.hasPostDeleteHooks {
 			app.SetPostDeleteFinalizer()
 			app.SetPostDeleteFinalizer("cleanup")
@@ -2067,6 +2184,29 @@ func (ctrl *ApplicationController) persistAppStatus(orig *appv1.Application, new
 	return patchDuration
 }
 
+// liftExpiredSyncPause removes the sync pause of the application once it has expired. While the pause is active, a
+// refresh is scheduled for the time it expires so that automated sync resumes on time.
+func (ctrl *ApplicationController) liftExpiredSyncPause(app *appv1.Application) {
+	pause := app.Spec.SyncPolicy.GetPause()
+	if pause == nil {
+		return
+	}
+	if remaining := time.Until(pause.Until.Time); remaining > 0 {
+		ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &remaining)
+		return
+	}
+	logCtx := log.WithFields(applog.GetAppLogFields(app))
+	_, err := ctrl.PatchAppWithWriteBack(context.Background(), app.Name, app.Namespace, types.MergePatchType, []byte(`{"spec":{"syncPolicy":{"pause":null}}}`), metav1.PatchOptions{})
+	if err != nil {
+		logCtx.Warnf("Failed to lift expired sync pause: %v", err)
+		return
+	}
+	app.Spec.SyncPolicy.Pause = nil
+	message := "Automated sync resumed: pause expired at " + pause.Until.Format(time.RFC3339)
+	logCtx.Info(message)
+	ctrl.logAppEvent(context.TODO(), app, argo.EventInfo{Reason: argo.EventReasonAutoSyncResumed, Type: corev1.EventTypeNormal}, message)
+}
+
 // autoSync will initiate a sync operation for an application configured with automated sync
 func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *appv1.SyncStatus, resources []appv1.ResourceStatus, shouldCompareRevisions bool) (*appv1.ApplicationCondition, time.Duration) {
 	logCtx := log.WithFields(applog.GetAppLogFields(app))
@@ -2082,6 +2222,11 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		return nil, 0
 	}
 
+	if pause := app.Spec.SyncPolicy.Pause; pause.IsActive(time.Now()) {
+		logCtx.Infof("Skipping auto-sync: automated sync is paused until %s", pause.Until.Format(time.RFC3339))
+		return nil, 0
+	}
+
 	if app.Operation != nil {
 		logCtx.Infof("Skipping auto-sync: another operation is in progress")
 		return nil, 0
@@ -2340,7 +2485,7 @@ func (ctrl *ApplicationController) canProcessApp(obj any) bool {
 	if err != nil {
 		return ctrl.clusterSharding.IsManagedCluster(nil)
 	}
-	return ctrl.clusterSharding.IsManagedCluster(destCluster)
+	return ctrl.clusterSharding.IsManagedApplication(app, destCluster)
 }
 
 func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.SharedIndexInformer, applisters.ApplicationLister) {
@@ -2484,6 +2629,7 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				delApp, delOK := obj.(*appv1.Application)
 				if err == nil && delOK {
 					ctrl.clusterSharding.DeleteApp(delApp)
+					ctrl.orphanedResources.Delete(key)
 				}
 			},
 		},
@@ -2508,7 +2654,7 @@ func (ctrl *ApplicationController) projectErrorToCondition(err error, app *appv1
 }
 
 func (ctrl *ApplicationController) RegisterClusterSecretUpdater(ctx context.Context) {
-	updater := NewClusterInfoUpdater(ctrl.stateCache, ctrl.db, ctrl.appLister.Applications(""), ctrl.cache, ctrl.clusterSharding.IsManagedCluster, ctrl.getAppProj, ctrl.namespace)
+	updater := NewClusterInfoUpdater(ctrl.stateCache, ctrl.metricsServer, ctrl.db, ctrl.appLister.Applications(""), ctrl.cache, ctrl.clusterSharding.IsCachedCluster, ctrl.getAppProj, ctrl.namespace)
 	go updater.Run(ctx)
 }
 
@@ -2542,6 +2688,48 @@ func automatedSyncEnabled(oldApp *appv1.Application, newApp *appv1.Application)
 	return false
 }
 
+// newAppPriorityQueue returns a queue of app keys which hands out applications by their reconcile priority
+func (ctrl *ApplicationController) newAppPriorityQueue(name string, rateLimiterConfig *ratelimiter.AppControllerRateLimiterConfig, starvationTimeout time.Duration) workqueue.TypedRateLimitingInterface[string] {
+	queue := priorityqueue.New(ctrl.getAppPriority, starvationTimeout, func(priority priorityqueue.Priority, latency time.Duration) {
+		if ctrl.metricsServer != nil {
+			ctrl.metricsServer.ObserveQueueLatency(name, priority.String(), latency)
+		}
+	})
+	return priorityqueue.NewRateLimitingQueue(name, ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), queue)
+}
+
+// getAppPriority returns the reconcile priority of the application with the given key. Applications without a valid
+// priority annotation inherit the priority of their project.
+func (ctrl *ApplicationController) getAppPriority(appKey string) priorityqueue.Priority {
+	if ctrl.appInformer == nil {
+		return priorityqueue.PriorityNormal
+	}
+	obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(appKey)
+	if err != nil || !exists {
+		return priorityqueue.PriorityNormal
+	}
+	app, ok := obj.(*appv1.Application)
+	if !ok {
+		return priorityqueue.PriorityNormal
+	}
+	if priority, ok := priorityqueue.ParsePriority(app.Annotations[common.AnnotationKeyReconcilePriority]); ok {
+		return priority
+	}
+	if ctrl.projInformer == nil {
+		return priorityqueue.PriorityNormal
+	}
+	obj, exists, err = ctrl.projInformer.GetIndexer().GetByKey(ctrl.namespace + "/" + app.Spec.GetProject())
+	if err != nil || !exists {
+		return priorityqueue.PriorityNormal
+	}
+	if proj, ok := obj.(*appv1.AppProject); ok {
+		if priority, ok := priorityqueue.ParsePriority(proj.Annotations[common.AnnotationKeyReconcilePriority]); ok {
+			return priority
+		}
+	}
+	return priorityqueue.PriorityNormal
+}
+
 // toAppKey returns the application key from a given appName, that is, it will
 // replace underscores with forward-slashes to become a <namespace>/<name>
 // format. If the appName is an unqualified name (such as, "app"), it will use
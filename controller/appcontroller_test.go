@@ -21,6 +21,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v3/common"
 	statecache "github.com/argoproj/argo-cd/v3/controller/cache"
+	"github.com/argoproj/argo-cd/v3/controller/priorityqueue"
 	"github.com/argoproj/argo-cd/v3/controller/sharding"
 
 	"github.com/argoproj/gitops-engine/pkg/cache/mocks"
@@ -54,6 +55,7 @@ import (
 	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
 	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v3/util/settings"
+	"github.com/argoproj/argo-cd/v3/util/snapshot"
 	utilTest "github.com/argoproj/argo-cd/v3/util/test"
 )
 
@@ -75,6 +77,7 @@ type fakeData struct {
 	applicationNamespaces          []string
 	updateRevisionForPathsResponse *apiclient.UpdateRevisionForPathsResponse
 	additionalObjs                 []runtime.Object
+	clusterResources               []*clustercache.Resource
 }
 
 type MockKubectl struct {
@@ -189,11 +192,14 @@ func newFakeControllerWithResync(data *fakeData, appResyncPeriod time.Duration,
 		nil,
 		data.applicationNamespaces,
 		nil,
+		time.Minute,
 		false,
 		false,
 		normalizers.IgnoreNormalizerOpts{},
 		testEnableEventList,
 		false,
+		snapshot.NewSecretStore(kubeClient, test.FakeArgoCDNamespace),
+		0,
 	)
 	db := &dbmocks.ArgoDB{}
 	db.On("GetApplicationControllerReplicas").Return(1)
@@ -210,6 +216,15 @@ func newFakeControllerWithResync(data *fakeData, appResyncPeriod time.Duration,
 	clusterCacheMock.On("IsNamespaced", mock.Anything).Return(true, nil)
 	clusterCacheMock.On("GetOpenAPISchema").Return(nil, nil)
 	clusterCacheMock.On("GetGVKParser").Return(nil)
+	clusterCacheMock.On("FindResources", mock.Anything, mock.Anything).Return(func(namespace string, predicates ...func(*clustercache.Resource) bool) map[kube.ResourceKey]*clustercache.Resource {
+		res := make(map[kube.ResourceKey]*clustercache.Resource)
+		for _, r := range data.clusterResources {
+			if (namespace == "" || r.Ref.Namespace == namespace) && predicates[0](r) {
+				res[r.ResourceKey()] = r
+			}
+		}
+		return res
+	})
 
 	mockStateCache := mockstatecache.LiveStateCache{}
 	ctrl.appStateManager.(*appStateManager).liveStateCache = &mockStateCache
@@ -447,6 +462,45 @@ var fakePostDeleteHook = `
 }
 `
 
+var fakePreDeleteHook = `
+{
+  "apiVersion": "batch/v1",
+  "kind": "Job",
+  "metadata": {
+    "name": "pre-delete-hook",
+    "namespace": "default",
+    "labels": {
+      "app.kubernetes.io/instance": "my-app"
+    },
+    "annotations": {
+      "argocd.argoproj.io/hook": "PreDelete",
+      "argocd.argoproj.io/hook-delete-policy": "HookSucceeded"
+    }
+  },
+  "spec": {
+    "template": {
+      "metadata": {
+        "name": "pre-delete-hook"
+      },
+      "spec": {
+        "containers": [
+          {
+            "name": "pre-delete-hook",
+            "image": "busybox",
+            "command": [
+              "/bin/sh",
+              "-c",
+              "sleep 5 && echo hello from the pre-delete-hook job"
+            ]
+          }
+        ],
+        "restartPolicy": "Never"
+      }
+    }
+  }
+}
+`
+
 var fakeServiceAccount = `
 {
   "apiVersion": "v1",
@@ -567,6 +621,15 @@ func newFakePostDeleteHook() map[string]any {
 	return hook
 }
 
+func newFakePreDeleteHook() map[string]any {
+	var hook map[string]any
+	err := yaml.Unmarshal([]byte(fakePreDeleteHook), &hook)
+	if err != nil {
+		panic(err)
+	}
+	return hook
+}
+
 func newFakeRoleBinding() map[string]any {
 	var roleBinding map[string]any
 	err := yaml.Unmarshal([]byte(fakeRoleBinding), &roleBinding)
@@ -736,6 +799,22 @@ func TestSkipAutoSync(t *testing.T) {
 		assert.Nil(t, app.Operation)
 	})
 
+	// Verify we skip when auto-sync is paused
+	t.Run("AutoSyncIsPaused", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Pause = &v1alpha1.SyncPause{Until: metav1.NewTime(time.Now().Add(time.Hour))}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		syncStatus := v1alpha1.SyncStatus{
+			Status:   v1alpha1.SyncStatusCodeOutOfSync,
+			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+		}
+		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{}, true)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, app.Operation)
+	})
+
 	// Verify we skip when auto-sync is disabled
 	t.Run("AutoSyncEnableFieldIsSetFalse", func(t *testing.T) {
 		app := newFakeApp()
@@ -838,6 +917,39 @@ func TestSkipAutoSync(t *testing.T) {
 }
 
 // TestAutoSyncIndicateError verifies we skip auto-sync and return error condition if previous sync failed
+func TestLiftExpiredSyncPause(t *testing.T) {
+	t.Run("Expired", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Pause = &v1alpha1.SyncPause{Until: metav1.NewTime(time.Now().Add(-time.Minute)), Reason: "maintenance"}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+		var patched string
+		fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			patched = string(action.(kubetesting.PatchAction).GetPatch())
+			return true, &v1alpha1.Application{}, nil
+		})
+
+		ctrl.liftExpiredSyncPause(app)
+		assert.JSONEq(t, `{"spec":{"syncPolicy":{"pause":null}}}`, patched)
+		assert.Nil(t, app.Spec.SyncPolicy.Pause)
+	})
+	t.Run("Active", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Pause = &v1alpha1.SyncPause{Until: metav1.NewTime(time.Now().Add(time.Hour))}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+		patched := false
+		fakeAppCs.PrependReactor("patch", "*", func(_ kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			patched = true
+			return true, &v1alpha1.Application{}, nil
+		})
+
+		ctrl.liftExpiredSyncPause(app)
+		assert.False(t, patched)
+		assert.NotNil(t, app.Spec.SyncPolicy.Pause)
+	})
+}
+
 func TestAutoSyncIndicateError(t *testing.T) {
 	app := newFakeApp()
 	app.Spec.Source.Helm = &v1alpha1.ApplicationSourceHelm{
@@ -1244,6 +1356,160 @@ func TestFinalizeAppDeletion(t *testing.T) {
 		// finalizer is not removed
 		assert.False(t, patched)
 	})
+
+	newPreDeleteController := func(app *v1alpha1.Application, liveObjs ...*unstructured.Unstructured) (*ApplicationController, *bool) {
+		managedLiveObjs := map[kube.ResourceKey]*unstructured.Unstructured{}
+		for _, obj := range liveObjs {
+			managedLiveObjs[kube.GetResourceKey(obj)] = obj
+		}
+		ctrl := newFakeController(&fakeData{
+			manifestResponses: []*apiclient.ManifestResponse{{
+				Manifests: []string{fakePreDeleteHook},
+			}},
+			apps:            []runtime.Object{app, &defaultProj},
+			managedLiveObjs: managedLiveObjs,
+		}, nil)
+
+		patched := false
+		fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+		defaultReactor := fakeAppCs.ReactionChain[0]
+		fakeAppCs.ReactionChain = nil
+		fakeAppCs.AddReactor("get", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			return defaultReactor.React(action)
+		})
+		fakeAppCs.AddReactor("patch", "*", func(_ kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			patched = true
+			return true, &v1alpha1.Application{}, nil
+		})
+		return ctrl, &patched
+	}
+
+	t.Run("PreDelete_HookIsCreated", func(t *testing.T) {
+		app := newFakeApp()
+		app.SetCascadedDeletion(v1alpha1.ResourcesFinalizerName)
+		app.SetPreDeleteFinalizer()
+		app.Spec.Destination.Namespace = test.FakeArgoCDNamespace
+		ctrl, patched := newPreDeleteController(app)
+
+		err := ctrl.finalizeApplicationDeletion(app, func(_ string) ([]*v1alpha1.Cluster, error) {
+			return []*v1alpha1.Cluster{}, nil
+		})
+		require.NoError(t, err)
+		// finalizer is not deleted
+		assert.False(t, *patched)
+		// pre-delete hook is created and no resource is deleted
+		require.Len(t, ctrl.kubectl.(*MockKubectl).CreatedResources, 1)
+		require.Equal(t, "pre-delete-hook", ctrl.kubectl.(*MockKubectl).CreatedResources[0].GetName())
+		assert.Empty(t, ctrl.kubectl.(*MockKubectl).DeletedResources)
+	})
+
+	t.Run("PreDelete_HookSucceeded", func(t *testing.T) {
+		app := newFakeApp()
+		app.SetCascadedDeletion(v1alpha1.ResourcesFinalizerName)
+		app.SetPreDeleteFinalizer()
+		app.Spec.Destination.Namespace = test.FakeArgoCDNamespace
+		liveHook := &unstructured.Unstructured{Object: newFakePreDeleteHook()}
+		require.NoError(t, unstructured.SetNestedField(liveHook.Object, []any{
+			map[string]any{"type": "Complete", "status": "True"},
+		}, "status", "conditions"))
+		ctrl, patched := newPreDeleteController(app, liveHook)
+
+		err := ctrl.finalizeApplicationDeletion(app, func(_ string) ([]*v1alpha1.Cluster, error) {
+			return []*v1alpha1.Cluster{}, nil
+		})
+		require.NoError(t, err)
+		// hook is deleted according to its delete policy and the finalizer is removed
+		require.Len(t, ctrl.kubectl.(*MockKubectl).DeletedResources, 1)
+		assert.Equal(t, "pre-delete-hook", ctrl.kubectl.(*MockKubectl).DeletedResources[0].Name)
+		assert.True(t, *patched)
+	})
+
+	t.Run("PreDelete_HookFailed", func(t *testing.T) {
+		app := newFakeApp()
+		app.SetCascadedDeletion(v1alpha1.ResourcesFinalizerName)
+		app.SetPreDeleteFinalizer()
+		app.Spec.Destination.Namespace = test.FakeArgoCDNamespace
+		liveHook := &unstructured.Unstructured{Object: newFakePreDeleteHook()}
+		require.NoError(t, unstructured.SetNestedField(liveHook.Object, []any{
+			map[string]any{"type": "Failed", "status": "True", "message": "Job has reached the specified backoff limit"},
+		}, "status", "conditions"))
+		ctrl, patched := newPreDeleteController(app, liveHook)
+
+		err := ctrl.finalizeApplicationDeletion(app, func(_ string) ([]*v1alpha1.Cluster, error) {
+			return []*v1alpha1.Cluster{}, nil
+		})
+		require.EqualError(t, err, "PreDelete hooks failed: Job default/pre-delete-hook: Job has reached the specified backoff limit")
+		// the failed hook is kept because of its HookSucceeded delete policy, and deletion is blocked
+		assert.Empty(t, ctrl.kubectl.(*MockKubectl).DeletedResources)
+		assert.Empty(t, ctrl.kubectl.(*MockKubectl).CreatedResources)
+		assert.False(t, *patched)
+	})
+
+	t.Run("PreDelete_HookFailedWithBeforeHookCreationPolicy", func(t *testing.T) {
+		app := newFakeApp()
+		app.SetCascadedDeletion(v1alpha1.ResourcesFinalizerName)
+		app.SetPreDeleteFinalizer()
+		app.Spec.Destination.Namespace = test.FakeArgoCDNamespace
+		liveHook := &unstructured.Unstructured{Object: newFakePreDeleteHook()}
+		liveHook.SetAnnotations(map[string]string{
+			"argocd.argoproj.io/hook":               "PreDelete",
+			"argocd.argoproj.io/hook-delete-policy": "BeforeHookCreation",
+		})
+		require.NoError(t, unstructured.SetNestedField(liveHook.Object, []any{
+			map[string]any{"type": "Failed", "status": "True", "message": "Job has reached the specified backoff limit"},
+		}, "status", "conditions"))
+		ctrl, patched := newPreDeleteController(app, liveHook)
+
+		err := ctrl.finalizeApplicationDeletion(app, func(_ string) ([]*v1alpha1.Cluster, error) {
+			return []*v1alpha1.Cluster{}, nil
+		})
+		require.EqualError(t, err, "PreDelete hooks failed: Job default/pre-delete-hook: Job has reached the specified backoff limit")
+		// the failed hook is neither deleted nor created again
+		assert.Empty(t, ctrl.kubectl.(*MockKubectl).DeletedResources)
+		assert.Empty(t, ctrl.kubectl.(*MockKubectl).CreatedResources)
+		assert.False(t, *patched)
+	})
+
+	t.Run("PreDelete_HookFailedWithHookFailedPolicy", func(t *testing.T) {
+		app := newFakeApp()
+		app.SetCascadedDeletion(v1alpha1.ResourcesFinalizerName)
+		app.SetPreDeleteFinalizer()
+		app.Spec.Destination.Namespace = test.FakeArgoCDNamespace
+		liveHook := &unstructured.Unstructured{Object: newFakePreDeleteHook()}
+		liveHook.SetAnnotations(map[string]string{
+			"argocd.argoproj.io/hook":               "PreDelete",
+			"argocd.argoproj.io/hook-delete-policy": "HookFailed",
+		})
+		require.NoError(t, unstructured.SetNestedField(liveHook.Object, []any{
+			map[string]any{"type": "Failed", "status": "True", "message": "Job has reached the specified backoff limit"},
+		}, "status", "conditions"))
+		ctrl, patched := newPreDeleteController(app, liveHook)
+
+		err := ctrl.finalizeApplicationDeletion(app, func(_ string) ([]*v1alpha1.Cluster, error) {
+			return []*v1alpha1.Cluster{}, nil
+		})
+		require.EqualError(t, err, "PreDelete hooks failed: Job default/pre-delete-hook: Job has reached the specified backoff limit")
+		// the failed hook is deleted according to its delete policy, so that it is run again, and deletion is blocked
+		require.Len(t, ctrl.kubectl.(*MockKubectl).DeletedResources, 1)
+		assert.Equal(t, "pre-delete-hook", ctrl.kubectl.(*MockKubectl).DeletedResources[0].Name)
+		assert.Empty(t, ctrl.kubectl.(*MockKubectl).CreatedResources)
+		assert.False(t, *patched)
+	})
+
+	t.Run("PreDelete_NonCascaded", func(t *testing.T) {
+		app := newFakeApp()
+		app.SetPreDeleteFinalizer()
+		app.Spec.Destination.Namespace = test.FakeArgoCDNamespace
+		ctrl, patched := newPreDeleteController(app)
+
+		err := ctrl.finalizeApplicationDeletion(app, func(_ string) ([]*v1alpha1.Cluster, error) {
+			return []*v1alpha1.Cluster{}, nil
+		})
+		require.NoError(t, err)
+		// hooks are not run when the resources are kept
+		assert.Empty(t, ctrl.kubectl.(*MockKubectl).CreatedResources)
+		assert.True(t, *patched)
+	})
 }
 
 // TestNormalizeApplication verifies we normalize an application during reconciliation
@@ -2074,6 +2340,40 @@ func TestFinalizeProjectDeletion_DoesNotHaveApplications(t *testing.T) {
 	}, receivedPatch)
 }
 
+func TestRemoveExpiredProjectWindows(t *testing.T) {
+	expired := metav1.NewTime(time.Now().Add(-time.Hour))
+	active := metav1.NewTime(time.Now().Add(time.Hour))
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: test.FakeArgoCDNamespace, ResourceVersion: "1"},
+		Spec: v1alpha1.AppProjectSpec{
+			SyncWindows: v1alpha1.SyncWindows{
+				{Kind: "deny", Applications: []string{"*"}, ExpiresAt: &expired},
+				{Kind: "deny", Applications: []string{"*"}, ExpiresAt: &active},
+			},
+		},
+	}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{proj}}, nil)
+
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	receivedPatch := map[string]any{}
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			require.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+		}
+		return true, &v1alpha1.AppProject{}, nil
+	})
+
+	err := ctrl.removeExpiredProjectWindows(proj.DeepCopy())
+	require.NoError(t, err)
+	assert.Equal(t, "1", receivedPatch["metadata"].(map[string]any)["resourceVersion"])
+	windows := receivedPatch["spec"].(map[string]any)["syncWindows"].([]any)
+	require.Len(t, windows, 1)
+	assert.Equal(t, active.UTC().Format(time.RFC3339), windows[0].(map[string]any)["expiresAt"])
+
+	require.NotNil(t, proj.Spec.NextWindowExpiry())
+	assert.True(t, active.Time.Equal(*proj.Spec.NextWindowExpiry()))
+}
+
 func TestProcessRequestedAppOperation_FailedNoRetries(t *testing.T) {
 	app := newFakeApp()
 	app.Spec.Project = "default"
@@ -2193,6 +2493,55 @@ func TestProcessRequestedAppOperation_RunningPreviouslyFailed(t *testing.T) {
 	assert.Equal(t, string(synccommon.OperationSucceeded), phase)
 }
 
+func TestProcessRequestedAppOperation_RetryFailedResourcesOnly(t *testing.T) {
+	app := newFakeApp()
+	app.Operation = &v1alpha1.Operation{
+		Sync:  &v1alpha1.SyncOperation{},
+		Retry: v1alpha1.RetryStrategy{Limit: 1, FailedResourcesOnly: true},
+	}
+	app.Status.OperationState.Operation.Retry = app.Operation.Retry
+	app.Status.OperationState.Phase = synccommon.OperationRunning
+	app.Status.OperationState.SyncResult.Resources = []*v1alpha1.ResourceResult{{
+		Name:   "guestbook",
+		Kind:   "Deployment",
+		Group:  "apps",
+		Status: synccommon.ResultCodeSyncFailed,
+	}, {
+		Name:   "guestbook",
+		Kind:   "Service",
+		Status: synccommon.ResultCodeSynced,
+	}}
+
+	data := &fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+	}
+	ctrl := newFakeController(data, nil)
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	var receivedPatches []map[string]any
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			receivedPatch := map[string]any{}
+			require.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+			receivedPatches = append(receivedPatches, receivedPatch)
+		}
+		return true, &v1alpha1.Application{}, nil
+	})
+
+	ctrl.processRequestedAppOperation(app)
+
+	require.NotEmpty(t, receivedPatches)
+	resources, _, _ := unstructured.NestedSlice(receivedPatches[0], "status", "operationState", "operation", "sync", "resources")
+	assert.Equal(t, []any{map[string]any{"group": "apps", "kind": "Deployment", "name": "guestbook"}}, resources)
+	revision, _, _ := unstructured.NestedString(receivedPatches[0], "status", "operationState", "operation", "sync", "revision")
+	assert.Equal(t, "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", revision)
+}
+
 func TestProcessRequestedAppOperation_HasRetriesTerminated(t *testing.T) {
 	app := newFakeApp()
 	app.Operation = &v1alpha1.Operation{
@@ -2351,6 +2700,29 @@ func TestToAppKey(t *testing.T) {
 	}
 }
 
+func TestGetAppPriority(t *testing.T) {
+	defaultApp := newFakeApp()
+	criticalApp := newFakeApp()
+	criticalApp.Name = "critical-app"
+	criticalApp.Annotations = map[string]string{common.AnnotationKeyReconcilePriority: "critical"}
+	projectApp := newFakeApp()
+	projectApp.Name = "project-app"
+	projectApp.Spec.Project = "low-priority"
+	lowProj := v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "low-priority",
+			Namespace:   test.FakeArgoCDNamespace,
+			Annotations: map[string]string{common.AnnotationKeyReconcilePriority: "low"},
+		},
+	}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{defaultApp, criticalApp, projectApp, &defaultProj, &lowProj}}, nil)
+
+	assert.Equal(t, priorityqueue.PriorityNormal, ctrl.getAppPriority(ctrl.toAppKey(defaultApp.QualifiedName())))
+	assert.Equal(t, priorityqueue.PriorityCritical, ctrl.getAppPriority(ctrl.toAppKey(criticalApp.QualifiedName())))
+	assert.Equal(t, priorityqueue.PriorityLow, ctrl.getAppPriority(ctrl.toAppKey(projectApp.QualifiedName())))
+	assert.Equal(t, priorityqueue.PriorityNormal, ctrl.getAppPriority(ctrl.toAppKey("missing-app")))
+}
+
 func Test_canProcessApp(t *testing.T) {
 	app := newFakeApp()
 	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
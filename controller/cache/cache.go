@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"os/exec"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -28,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/transport"
 
 	"github.com/argoproj/argo-cd/v3/controller/metrics"
 	"github.com/argoproj/argo-cd/v3/controller/sharding"
@@ -75,6 +77,12 @@ const (
 	// EnvClusterCacheEventsProcessingInterval is the env variable to control the interval between processing events when BatchEventsProcessing is enabled
 	EnvClusterCacheEventsProcessingInterval = "ARGOCD_CLUSTER_CACHE_EVENTS_PROCESSING_INTERVAL"
 
+	// EnvClusterCacheSnapshotDir is the env variable holding the directory cluster cache snapshots are persisted to
+	EnvClusterCacheSnapshotDir = "ARGOCD_CLUSTER_CACHE_SNAPSHOT_DIR"
+
+	// EnvClusterCacheSnapshotInterval is the env variable to control the interval between cluster cache snapshots
+	EnvClusterCacheSnapshotInterval = "ARGOCD_CLUSTER_CACHE_SNAPSHOT_INTERVAL"
+
 	// AnnotationIgnoreResourceUpdates when set to true on an untracked resource,
 	// argo will apply `ignoreResourceUpdates` configuration on it.
 	AnnotationIgnoreResourceUpdates = "argocd.argoproj.io/ignore-resource-updates"
@@ -115,6 +123,12 @@ var (
 
 	// clusterCacheEventsProcessingInterval specifies the interval between processing events when BatchEventsProcessing is enabled
 	clusterCacheEventsProcessingInterval = 100 * time.Millisecond
+
+	// clusterCacheSnapshotDir is the directory cluster cache snapshots are persisted to. Snapshots are disabled if empty.
+	clusterCacheSnapshotDir = ""
+
+	// clusterCacheSnapshotInterval is the interval between cluster cache snapshots
+	clusterCacheSnapshotInterval = 10 * time.Minute
 )
 
 func init() {
@@ -128,6 +142,8 @@ func init() {
 	clusterCacheRetryUseBackoff = env.ParseBoolFromEnv(EnvClusterCacheRetryUseBackoff, false)
 	clusterCacheBatchEventsProcessing = env.ParseBoolFromEnv(EnvClusterCacheBatchEventsProcessing, true)
 	clusterCacheEventsProcessingInterval = env.ParseDurationFromEnv(EnvClusterCacheEventsProcessingInterval, clusterCacheEventsProcessingInterval, 0, math.MaxInt64)
+	clusterCacheSnapshotDir = env.StringFromEnv(EnvClusterCacheSnapshotDir, clusterCacheSnapshotDir)
+	clusterCacheSnapshotInterval = env.ParseDurationFromEnv(EnvClusterCacheSnapshotInterval, clusterCacheSnapshotInterval, time.Minute, math.MaxInt64)
 }
 
 type LiveStateCache interface {
@@ -172,6 +188,19 @@ type NodeInfo struct {
 	Labels     map[string]string
 }
 
+type ResourceQuotaInfo struct {
+	Hard corev1.ResourceList
+	Used corev1.ResourceList
+	// Scoped is true if the quota only tracks the objects matching its scopes
+	Scoped bool
+}
+
+type LimitRangeInfo struct {
+	// DefaultRequests and DefaultLimits are applied to the containers which don't specify their resources
+	DefaultRequests corev1.ResourceList
+	DefaultLimits   corev1.ResourceList
+}
+
 type ResourceInfo struct {
 	Info    []appv1.InfoItem
 	AppName string
@@ -183,6 +212,10 @@ type ResourceInfo struct {
 	PodInfo *PodInfo
 	// NodeInfo is available for nodes only
 	NodeInfo *NodeInfo
+	// ResourceQuotaInfo is available for resource quotas only
+	ResourceQuotaInfo *ResourceQuotaInfo
+	// LimitRangeInfo is available for limit ranges only
+	LimitRangeInfo *LimitRangeInfo
 
 	manifestHash string
 }
@@ -196,6 +229,12 @@ func NewLiveStateCache(
 	clusterSharding sharding.ClusterShardingCache,
 	resourceTracking argo.ResourceTracking,
 ) LiveStateCache {
+	if appInformer != nil {
+		// the Applications are indexed to find the namespaces watched by the caches of the application sharded clusters
+		if err := appInformer.AddIndexers(cache.Indexers{destinationNamespaceIndex: indexByDestinationNamespace}); err != nil {
+			log.Warnf("Failed to index applications by destination namespace: %v", err)
+		}
+	}
 	return &liveStateCache{
 		appInformer:      appInformer,
 		db:               db,
@@ -233,6 +272,41 @@ type liveStateCache struct {
 	clusters      map[string]clustercache.ClusterCache
 	cacheSettings cacheSettings
 	lock          sync.RWMutex
+
+	// scopes holds the namespaces watched by the caches of the application sharded clusters
+	scopes map[string]clusterScope
+	// appNamespaces holds the destination namespaces of the Applications processed by the current shard for the
+	// application sharded clusters
+	appNamespaces map[string]*appNamespaces
+	// resourceVersions holds the resource versions tracked for the snapshots of the cluster caches
+	resourceVersions map[string]*resourceVersionTracker
+	// snapshotStates holds the states served to the cluster caches when they are synchronized
+	snapshotStates map[string]*clusterSnapshotState
+}
+
+// clusterScope holds the namespaces and cluster-level resources watched by a cluster cache
+type clusterScope struct {
+	namespaces       []string
+	clusterResources bool
+}
+
+// appNamespaces holds the destination namespaces of the Applications of an application sharded cluster which are
+// processed by the current shard
+type appNamespaces struct {
+	namespaces map[string]bool
+	// anyNamespace is true if the resources of some Applications might be deployed to any namespace
+	anyNamespace bool
+}
+
+// destinationNamespaceIndex is the name of the index of the Applications by destination namespace
+const destinationNamespaceIndex = "destinationNamespace"
+
+func indexByDestinationNamespace(obj any) ([]string, error) {
+	app, ok := obj.(*appv1.Application)
+	if !ok {
+		return nil, nil
+	}
+	return []string{app.Spec.Destination.Namespace}, nil
 }
 
 func (c *liveStateCache) loadCacheSettings() (*cacheSettings, error) {
@@ -516,6 +590,29 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 	if err != nil {
 		return nil, fmt.Errorf("error getting cluster RESTConfig: %w", err)
 	}
+	scope, apps := c.getClusterScope(cluster)
+	c.setClusterScope(cluster.Server, scope, apps)
+
+	var snapshotState *clusterSnapshotState
+	var tracker *resourceVersionTracker
+	// the state of the cache of an application sharded cluster is also served to the cache when its scope changes
+	if clusterCacheSnapshotDir != "" || apps != nil {
+		var snapshot *clusterSnapshot
+		if clusterCacheSnapshotDir != "" {
+			snapshot = loadUsableClusterSnapshot(cluster.Server, scope, cacheSettings, resourceCustomLabels)
+		}
+		snapshotState = newClusterSnapshotState(snapshot)
+		tracker = newResourceVersionTracker()
+		clusterCacheConfig.WrapTransport = transport.Wrappers(clusterCacheConfig.WrapTransport, wrapSnapshotTransport(snapshotState, tracker))
+		if c.resourceVersions == nil {
+			c.resourceVersions = make(map[string]*resourceVersionTracker)
+		}
+		c.resourceVersions[cluster.Server] = tracker
+		if c.snapshotStates == nil {
+			c.snapshotStates = make(map[string]*clusterSnapshotState)
+		}
+		c.snapshotStates[cluster.Server] = snapshotState
+	}
 	// Controller dynamically fetches all resource types available on the cluster
 	// using a discovery API that may contain deprecated APIs.
 	// This causes log flooding when managing a large number of clusters.
@@ -537,9 +634,12 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 		clustercache.SetClusterSyncRetryTimeout(clusterSyncRetryTimeoutDuration),
 		clustercache.SetResyncTimeout(clusterCacheResyncDuration),
 		clustercache.SetSettings(cacheSettings.clusterSettings),
-		clustercache.SetNamespaces(cluster.Namespaces),
-		clustercache.SetClusterResources(cluster.ClusterResources),
+		clustercache.SetNamespaces(scope.namespaces),
+		clustercache.SetClusterResources(scope.clusterResources),
 		clustercache.SetPopulateResourceInfoHandler(func(un *unstructured.Unstructured, isRoot bool) (any, bool) {
+			if info, cacheManifest, ok := snapshotState.takeResourceInfo(un); ok {
+				return info, cacheManifest
+			}
 			res := &ResourceInfo{}
 			populateNodeInfo(un, res, resourceCustomLabels)
 			c.lock.RLock()
@@ -582,6 +682,9 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 		var ref corev1.ObjectReference
 		if newRes != nil {
 			ref = newRes.Ref
+			if tracker != nil {
+				tracker.observeResource(newRes)
+			}
 		} else {
 			ref = oldRes.Ref
 		}
@@ -636,6 +739,254 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 	return clusterCache, nil
 }
 
+// getClusterScope returns the namespaces which should be watched by the cache of the given cluster, along with the
+// destination namespaces of the Applications processed by the current shard if the cluster is application sharded.
+// The cache of an application sharded cluster only watches the namespaces of the Applications processed by the
+// current shard, so that the resources of a large cluster are spread across the controller replicas.
+func (c *liveStateCache) getClusterScope(cluster *appv1.Cluster) (clusterScope, *appNamespaces) {
+	if c.clusterSharding == nil || !c.clusterSharding.IsApplicationShardedCluster(cluster) || c.appInformer == nil {
+		return clusterScope{namespaces: cluster.Namespaces, clusterResources: cluster.ClusterResources}, nil
+	}
+	apps := &appNamespaces{namespaces: make(map[string]bool)}
+	for _, namespace := range c.appInformer.GetIndexer().ListIndexFuncValues(destinationNamespaceIndex) {
+		if !c.hasManagedApps(cluster, namespace) {
+			continue
+		}
+		if namespace == "" {
+			apps.anyNamespace = true
+		} else {
+			apps.namespaces[namespace] = true
+		}
+	}
+	return newClusterScope(cluster, apps), apps
+}
+
+// hasManagedApps returns whether the current shard processes some Applications deploying their resources to the given
+// namespace of the cluster.
+func (c *liveStateCache) hasManagedApps(cluster *appv1.Cluster, namespace string) bool {
+	objs, err := c.appInformer.GetIndexer().ByIndex(destinationNamespaceIndex, namespace)
+	if err != nil {
+		log.Warnf("Failed to list the applications deploying to namespace %q: %v", namespace, err)
+		return false
+	}
+	for _, obj := range objs {
+		app, ok := obj.(*appv1.Application)
+		if !ok {
+			continue
+		}
+		destCluster, err := argo.GetDestinationCluster(context.Background(), app.Spec.Destination, c.db)
+		if err == nil && destCluster.Server == cluster.Server && c.clusterSharding.IsManagedApplication(app, destCluster) {
+			return true
+		}
+	}
+	return false
+}
+
+// newClusterScope returns the scope of the cache of an application sharded cluster whose Applications processed by the
+// current shard deploy to the given namespaces.
+func newClusterScope(cluster *appv1.Cluster, apps *appNamespaces) clusterScope {
+	defaultScope := clusterScope{namespaces: cluster.Namespaces, clusterResources: cluster.ClusterResources}
+	if apps.anyNamespace {
+		// the resources of an application might be deployed to any namespace
+		return defaultScope
+	}
+	allowedNamespaces := make(map[string]bool, len(cluster.Namespaces))
+	for _, ns := range cluster.Namespaces {
+		allowedNamespaces[ns] = true
+	}
+	scope := clusterScope{
+		// cluster-level resources are still watched if the cluster is not restricted to a set of namespaces
+		clusterResources: len(cluster.Namespaces) == 0 || cluster.ClusterResources,
+	}
+	for ns := range apps.namespaces {
+		if len(allowedNamespaces) == 0 || allowedNamespaces[ns] {
+			scope.namespaces = append(scope.namespaces, ns)
+		}
+	}
+	if len(scope.namespaces) == 0 {
+		return defaultScope
+	}
+	sort.Strings(scope.namespaces)
+	return scope
+}
+
+// setClusterScope records the scope of the given cluster cache. The lock must be held by the caller.
+func (c *liveStateCache) setClusterScope(server string, scope clusterScope, apps *appNamespaces) {
+	if c.scopes == nil {
+		c.scopes = make(map[string]clusterScope)
+	}
+	if c.appNamespaces == nil {
+		c.appNamespaces = make(map[string]*appNamespaces)
+	}
+	c.scopes[server] = scope
+	if apps != nil {
+		c.appNamespaces[server] = apps
+	} else {
+		delete(c.appNamespaces, server)
+	}
+}
+
+// refreshClusterScope updates the namespaces watched by the cache of an application sharded cluster when the
+// Applications processed by the current shard have changed.
+func (c *liveStateCache) refreshClusterScope(cluster *appv1.Cluster) {
+	scope, apps := c.getClusterScope(cluster)
+	c.lock.Lock()
+	clusterCache, ok := c.clusters[cluster.Server]
+	if !ok {
+		c.lock.Unlock()
+		return
+	}
+	oldScope := c.scopes[cluster.Server]
+	c.setClusterScope(cluster.Server, scope, apps)
+	c.lock.Unlock()
+	c.updateClusterCacheScope(cluster.Server, clusterCache, oldScope, scope)
+}
+
+// refreshClusterScopeNamespace updates the namespaces watched by the cache of an application sharded cluster when the
+// Applications deploying to the given namespace have changed. Only the Applications deploying to the namespace are
+// considered.
+func (c *liveStateCache) refreshClusterScopeNamespace(cluster *appv1.Cluster, namespace string) {
+	managed := c.hasManagedApps(cluster, namespace)
+	c.lock.Lock()
+	clusterCache, ok := c.clusters[cluster.Server]
+	apps := c.appNamespaces[cluster.Server]
+	if !ok || apps == nil {
+		c.lock.Unlock()
+		return
+	}
+	switch {
+	case namespace == "":
+		apps.anyNamespace = managed
+	case managed:
+		apps.namespaces[namespace] = true
+	default:
+		delete(apps.namespaces, namespace)
+	}
+	scope := newClusterScope(cluster, apps)
+	oldScope := c.scopes[cluster.Server]
+	c.scopes[cluster.Server] = scope
+	c.lock.Unlock()
+	c.updateClusterCacheScope(cluster.Server, clusterCache, oldScope, scope)
+}
+
+// updateClusterCacheScope restarts the watches of the given cluster cache with the new scope. The resources which
+// remain in scope are served to the cache from its current state, so that only the namespaces added to the scope are
+// listed from the API server.
+func (c *liveStateCache) updateClusterCacheScope(server string, clusterCache clustercache.ClusterCache, oldScope clusterScope, scope clusterScope) {
+	if reflect.DeepEqual(oldScope, scope) {
+		return
+	}
+	c.lock.RLock()
+	tracker := c.resourceVersions[server]
+	state := c.snapshotStates[server]
+	c.lock.RUnlock()
+
+	info := clusterCache.GetClusterInfo()
+	if tracker != nil && state != nil && info.LastCacheSyncTime != nil && info.SyncError == nil {
+		state.load(newClusterScopeSnapshot(server, scope, clusterCache, tracker))
+	}
+	log.Infof("Updating namespaces watched by the cache of cluster %s to %v", server, scope.namespaces)
+	clusterCache.Invalidate(clustercache.SetNamespaces(scope.namespaces), clustercache.SetClusterResources(scope.clusterResources))
+	// the watches are started again, from the resource versions of the served lists if any
+	tracker.reset()
+	go func() {
+		// warm up cluster cache
+		_ = clusterCache.EnsureSynced()
+	}()
+}
+
+// handleAppEvent refreshes the scope of the cache of the application sharded cluster targeted by the given
+// Application.
+func (c *liveStateCache) handleAppEvent(obj any) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	app, ok := obj.(*appv1.Application)
+	if !ok {
+		return
+	}
+	destCluster, err := argo.GetDestinationCluster(context.Background(), app.Spec.Destination, c.db)
+	if err != nil || !c.clusterSharding.IsApplicationShardedCluster(destCluster) {
+		return
+	}
+	c.refreshClusterScopeNamespace(destCluster, app.Spec.Destination.Namespace)
+}
+
+// loadUsableClusterSnapshot returns the snapshot used to warm up the cache of the given cluster, or nil if there is no
+// usable snapshot of the cluster.
+func loadUsableClusterSnapshot(server string, scope clusterScope, cacheSettings cacheSettings, resourceCustomLabels []string) *clusterSnapshot {
+	snapshot, err := loadClusterSnapshot(clusterCacheSnapshotDir, server)
+	if err != nil {
+		log.Warnf("Failed to load the cache snapshot of cluster %s: %v", server, err)
+		return nil
+	}
+	if snapshot == nil {
+		return nil
+	}
+	settingsHash, err := snapshotSettingsHash(cacheSettings, resourceCustomLabels)
+	if err != nil {
+		log.Warnf("Failed to load the cache snapshot of cluster %s: %v", server, err)
+		return nil
+	}
+	if !isSnapshotUsable(snapshot, scope, settingsHash) {
+		log.Infof("Ignoring the cache snapshot of cluster %s: the settings have changed since %s", server, snapshot.CreatedAt)
+		return nil
+	}
+	log.Infof("Warming up the cache of cluster %s from the snapshot taken at %s", server, snapshot.CreatedAt)
+	return snapshot
+}
+
+// runClusterSnapshots periodically persists the state of the synced cluster caches.
+func (c *liveStateCache) runClusterSnapshots(ctx context.Context) {
+	ticker := time.NewTicker(clusterCacheSnapshotInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.saveClusterSnapshots()
+		}
+	}
+}
+
+func (c *liveStateCache) saveClusterSnapshots() {
+	c.lock.RLock()
+	clusters := make(map[string]clustercache.ClusterCache, len(c.clusters))
+	scopes := make(map[string]clusterScope, len(c.clusters))
+	trackers := make(map[string]*resourceVersionTracker, len(c.clusters))
+	for server, clusterCache := range c.clusters {
+		clusters[server] = clusterCache
+		scopes[server] = c.scopes[server]
+		trackers[server] = c.resourceVersions[server]
+	}
+	cacheSettings := c.cacheSettings
+	c.lock.RUnlock()
+
+	resourceCustomLabels, err := c.settingsMgr.GetResourceCustomLabels()
+	if err != nil {
+		log.Warnf("Failed to snapshot cluster caches: error getting custom label: %v", err)
+		return
+	}
+	settingsHash, err := snapshotSettingsHash(cacheSettings, resourceCustomLabels)
+	if err != nil {
+		log.Warnf("Failed to snapshot cluster caches: %v", err)
+		return
+	}
+	for server, clusterCache := range clusters {
+		info := clusterCache.GetClusterInfo()
+		if info.LastCacheSyncTime == nil || info.SyncError != nil || trackers[server] == nil {
+			continue
+		}
+		snapshot := newClusterSnapshot(server, scopes[server], settingsHash, clusterCache, trackers[server])
+		if err := saveClusterSnapshot(clusterCacheSnapshotDir, snapshot); err != nil {
+			log.Warnf("Failed to snapshot the cache of cluster %s: %v", server, err)
+			continue
+		}
+		log.Debugf("Persisted the cache snapshot of cluster %s", server)
+	}
+}
+
 func (c *liveStateCache) getSyncedCluster(server *appv1.Cluster) (clustercache.ClusterCache, error) {
 	clusterCache, err := c.getCluster(server)
 	if err != nil {
@@ -655,8 +1006,11 @@ func (c *liveStateCache) invalidate(cacheSettings cacheSettings) {
 	clusters := c.clusters
 	c.lock.Unlock()
 
-	for _, clust := range clusters {
+	for server, clust := range clusters {
 		clust.Invalidate(clustercache.SetSettings(cacheSettings.clusterSettings))
+		c.lock.RLock()
+		c.resourceVersions[server].reset()
+		c.lock.RUnlock()
 	}
 	log.Info("live state cache invalidated")
 }
@@ -747,7 +1101,7 @@ func (c *liveStateCache) isClusterHasApps(apps []any, cluster *appv1.Cluster) bo
 			log.Warnf("Failed to get destination cluster: %v", err)
 			continue
 		}
-		if destCluster.Server == cluster.Server {
+		if destCluster.Server == cluster.Server && c.clusterSharding.IsManagedApplication(app, destCluster) {
 			return true
 		}
 	}
@@ -800,6 +1154,29 @@ func (c *liveStateCache) Init() error {
 func (c *liveStateCache) Run(ctx context.Context) error {
 	go c.watchSettings(ctx)
 
+	if clusterCacheSnapshotDir != "" {
+		go c.runClusterSnapshots(ctx)
+	}
+
+	if c.appInformer != nil {
+		_, err := c.appInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc: c.handleAppEvent,
+			UpdateFunc: func(oldObj, newObj any) {
+				oldApp, oldOK := oldObj.(*appv1.Application)
+				newApp, newOK := newObj.(*appv1.Application)
+				if oldOK && newOK && oldApp.Spec.Destination == newApp.Spec.Destination {
+					return
+				}
+				c.handleAppEvent(oldObj)
+				c.handleAppEvent(newObj)
+			},
+			DeleteFunc: c.handleAppEvent,
+		})
+		if err != nil {
+			return fmt.Errorf("error adding application event handler: %w", err)
+		}
+	}
+
 	kube.RetryUntilSucceed(ctx, clustercache.ClusterRetryTimeout, "watch clusters", logutils.NewLogrusLogger(logutils.NewWithCurrentConfig()), func() error {
 		return c.db.WatchClusters(ctx, c.handleAddEvent, c.handleModEvent, c.handleDeleteEvent)
 	})
@@ -810,7 +1187,7 @@ func (c *liveStateCache) Run(ctx context.Context) error {
 }
 
 func (c *liveStateCache) canHandleCluster(cluster *appv1.Cluster) bool {
-	return c.clusterSharding.IsManagedCluster(cluster)
+	return c.clusterSharding.IsCachedCluster(cluster)
 }
 
 func (c *liveStateCache) handleAddEvent(cluster *appv1.Cluster) {
@@ -847,6 +1224,10 @@ func (c *liveStateCache) handleModEvent(oldCluster *appv1.Cluster, newCluster *a
 			cluster.Invalidate()
 			c.lock.Lock()
 			delete(c.clusters, newCluster.Server)
+			delete(c.scopes, newCluster.Server)
+			delete(c.appNamespaces, newCluster.Server)
+			delete(c.resourceVersions, newCluster.Server)
+			delete(c.snapshotStates, newCluster.Server)
 			c.lock.Unlock()
 			return
 		}
@@ -860,11 +1241,16 @@ func (c *liveStateCache) handleModEvent(oldCluster *appv1.Cluster, newCluster *a
 				log.Errorf("error getting cluster REST config: %v", err)
 			}
 		}
-		if !reflect.DeepEqual(oldCluster.Namespaces, newCluster.Namespaces) {
-			updateSettings = append(updateSettings, clustercache.SetNamespaces(newCluster.Namespaces))
+		scope, apps := c.getClusterScope(newCluster)
+		c.lock.Lock()
+		oldScope := c.scopes[newCluster.Server]
+		c.setClusterScope(newCluster.Server, scope, apps)
+		c.lock.Unlock()
+		if !reflect.DeepEqual(oldScope.namespaces, scope.namespaces) {
+			updateSettings = append(updateSettings, clustercache.SetNamespaces(scope.namespaces))
 		}
-		if !reflect.DeepEqual(oldCluster.ClusterResources, newCluster.ClusterResources) {
-			updateSettings = append(updateSettings, clustercache.SetClusterResources(newCluster.ClusterResources))
+		if oldScope.clusterResources != scope.clusterResources {
+			updateSettings = append(updateSettings, clustercache.SetClusterResources(scope.clusterResources))
 		}
 		forceInvalidate := false
 		if newCluster.RefreshRequestedAt != nil &&
@@ -875,6 +1261,9 @@ func (c *liveStateCache) handleModEvent(oldCluster *appv1.Cluster, newCluster *a
 
 		if len(updateSettings) > 0 || forceInvalidate {
 			cluster.Invalidate(updateSettings...)
+			c.lock.RLock()
+			c.resourceVersions[newCluster.Server].reset()
+			c.lock.RUnlock()
 			go func() {
 				// warm up cluster cache
 				_ = cluster.EnsureSynced()
@@ -892,6 +1281,10 @@ func (c *liveStateCache) handleDeleteEvent(clusterServer string) {
 		cluster.Invalidate()
 		c.lock.Lock()
 		delete(c.clusters, clusterServer)
+		delete(c.scopes, clusterServer)
+		delete(c.appNamespaces, clusterServer)
+		delete(c.resourceVersions, clusterServer)
+		delete(c.snapshotStates, clusterServer)
 		c.lock.Unlock()
 	}
 }
@@ -919,5 +1312,12 @@ func (c *liveStateCache) GetClusterCache(server *appv1.Cluster) (clustercache.Cl
 
 // UpdateShard will update the shard of ClusterSharding when the shard has changed.
 func (c *liveStateCache) UpdateShard(shard int) bool {
-	return c.clusterSharding.UpdateShard(shard)
+	updated := c.clusterSharding.UpdateShard(shard)
+	// the applications processed by the shard might have changed: update the scope of the application sharded clusters
+	for _, cluster := range c.clusterSharding.GetClusters() {
+		if c.clusterSharding.IsApplicationShardedCluster(cluster) {
+			c.refreshClusterScope(cluster)
+		}
+	}
+	return updated
 }
@@ -3,6 +3,7 @@ package cache
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net"
 	"net/url"
 	"sync"
@@ -24,6 +25,7 @@ import (
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/stretchr/testify/mock"
 	"k8s.io/client-go/kubernetes/fake"
+	clientcache "k8s.io/client-go/tools/cache"
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/controller/metrics"
@@ -148,6 +150,157 @@ func TestHandleModEvent_NoChanges(_ *testing.T) {
 	})
 }
 
+func TestGetClusterScope(t *testing.T) {
+	cluster := &appv1.Cluster{
+		ID:          "1",
+		Server:      "https://mycluster",
+		Annotations: map[string]string{common.AnnotationKeyApplicationSharding: "true"},
+	}
+	db := &dbmocks.ArgoDB{}
+	db.On("GetCluster", mock.Anything, cluster.Server).Return(cluster, nil)
+	appInformer := clientcache.NewSharedIndexInformer(&clientcache.ListWatch{}, &appv1.Application{}, 0, clientcache.Indexers{destinationNamespaceIndex: indexByDestinationNamespace})
+	expected := make([][]string, 2)
+	for i, ns := range []string{"ns-a", "ns-b", "ns-c", "ns-d", "ns-e", "ns-f"} {
+		app := &appv1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("app-%d", i), Namespace: "argocd"},
+			Spec:       appv1.ApplicationSpec{Destination: appv1.ApplicationDestination{Server: cluster.Server, Namespace: ns}},
+		}
+		require.NoError(t, appInformer.GetStore().Add(app))
+		shard := sharding.GetApplicationShard(app, 2)
+		expected[shard] = append(expected[shard], ns)
+	}
+
+	for shard := range expected {
+		clustersCache := liveStateCache{
+			db:              db,
+			appInformer:     appInformer,
+			clusterSharding: sharding.NewClusterSharding(db, shard, 2, common.DefaultShardingAlgorithm),
+		}
+		scope, apps := clustersCache.getClusterScope(cluster)
+		require.NotNil(t, apps)
+		assert.Equal(t, expected[shard], scope.namespaces)
+		assert.True(t, scope.clusterResources)
+	}
+
+	t.Run("cluster restricted to namespaces", func(t *testing.T) {
+		restricted := cluster.DeepCopy()
+		restricted.Namespaces = append([]string{"other"}, expected[0]...)
+		clustersCache := liveStateCache{
+			db:              db,
+			appInformer:     appInformer,
+			clusterSharding: sharding.NewClusterSharding(db, 0, 2, common.DefaultShardingAlgorithm),
+		}
+		scope, _ := clustersCache.getClusterScope(restricted)
+		assert.Equal(t, expected[0], scope.namespaces)
+		assert.False(t, scope.clusterResources)
+	})
+
+	t.Run("cluster not application sharded", func(t *testing.T) {
+		notSharded := &appv1.Cluster{Server: cluster.Server, Namespaces: []string{"default"}}
+		clustersCache := liveStateCache{
+			db:              db,
+			appInformer:     appInformer,
+			clusterSharding: sharding.NewClusterSharding(db, 0, 2, common.DefaultShardingAlgorithm),
+		}
+		scope, apps := clustersCache.getClusterScope(notSharded)
+		assert.Equal(t, []string{"default"}, scope.namespaces)
+		assert.Nil(t, apps)
+	})
+
+	t.Run("application without destination namespace", func(t *testing.T) {
+		store := clientcache.NewSharedIndexInformer(&clientcache.ListWatch{}, &appv1.Application{}, 0, clientcache.Indexers{destinationNamespaceIndex: indexByDestinationNamespace})
+		require.NoError(t, store.GetStore().Add(&appv1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "argocd"},
+			Spec:       appv1.ApplicationSpec{Destination: appv1.ApplicationDestination{Server: cluster.Server}},
+		}))
+		for shard := 0; shard < 2; shard++ {
+			clustersCache := liveStateCache{
+				db:              db,
+				appInformer:     store,
+				clusterSharding: sharding.NewClusterSharding(db, shard, 2, common.DefaultShardingAlgorithm),
+			}
+			scope, _ := clustersCache.getClusterScope(cluster)
+			assert.Empty(t, scope.namespaces)
+		}
+	})
+}
+
+func TestHandleAppEvent_UpdatesClusterScope(t *testing.T) {
+	cluster := &appv1.Cluster{
+		ID:          "1",
+		Server:      "https://mycluster",
+		Annotations: map[string]string{common.AnnotationKeyApplicationSharding: "true"},
+	}
+	db := &dbmocks.ArgoDB{}
+	db.On("GetCluster", mock.Anything, cluster.Server).Return(cluster, nil)
+	newApp := func(shard int, namespace string) *appv1.Application {
+		for i := 0; ; i++ {
+			app := &appv1.Application{
+				ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("app-%s-%d", namespace, i), Namespace: "argocd"},
+				Spec:       appv1.ApplicationSpec{Destination: appv1.ApplicationDestination{Server: cluster.Server, Namespace: namespace}},
+			}
+			if sharding.GetApplicationShard(app, 2) == shard {
+				return app
+			}
+		}
+	}
+	appInformer := clientcache.NewSharedIndexInformer(&clientcache.ListWatch{}, &appv1.Application{}, 0, clientcache.Indexers{destinationNamespaceIndex: indexByDestinationNamespace})
+	require.NoError(t, appInformer.GetStore().Add(newApp(0, "default")))
+
+	now := time.Now()
+	clusterCache := newTestClusterCache()
+	clusterCache.On("GetClusterInfo").Return(cache.ClusterInfo{LastCacheSyncTime: &now})
+	clusterCache.On("Invalidate", mock.Anything, mock.Anything).Return().Once()
+	clusterCache.On("EnsureSynced").Return(nil).Maybe()
+	tracker := newResourceVersionTracker()
+	for _, path := range []string{"/api/v1/namespaces/default/pods", "/api/v1/namespaces/default/secrets", "/apis/apps/v1/namespaces/default/deployments"} {
+		tracker.observeWatch(path, "105")
+	}
+	state := newClusterSnapshotState(nil)
+	clustersCache := liveStateCache{
+		db:               db,
+		appInformer:      appInformer,
+		clusterSharding:  sharding.NewClusterSharding(db, 0, 2, common.DefaultShardingAlgorithm),
+		clusters:         map[string]cache.ClusterCache{cluster.Server: clusterCache},
+		resourceVersions: map[string]*resourceVersionTracker{cluster.Server: tracker},
+		snapshotStates:   map[string]*clusterSnapshotState{cluster.Server: state},
+	}
+	scope, apps := clustersCache.getClusterScope(cluster)
+	assert.Equal(t, []string{"default"}, scope.namespaces)
+	clustersCache.setClusterScope(cluster.Server, scope, apps)
+
+	// the application is processed by another shard
+	app := newApp(1, "kube-system")
+	require.NoError(t, appInformer.GetStore().Add(app))
+	clustersCache.handleAppEvent(app)
+	assert.Equal(t, []string{"default"}, clustersCache.scopes[cluster.Server].namespaces)
+
+	app = newApp(0, "kube-system")
+	require.NoError(t, appInformer.GetStore().Add(app))
+	clustersCache.handleAppEvent(app)
+	assert.Equal(t, []string{"default", "kube-system"}, clustersCache.scopes[cluster.Server].namespaces)
+	clusterCache.AssertCalled(t, "Invalidate", mock.Anything, mock.Anything)
+
+	// the resources of the namespace which remains in scope are served from the cache
+	body, err := state.takeList("/api/v1/namespaces/default/pods")
+	require.NoError(t, err)
+	var list unstructured.UnstructuredList
+	require.NoError(t, list.UnmarshalJSON(body))
+	assert.Equal(t, "105", list.GetResourceVersion())
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, "guestbook-1", list.Items[0].GetName())
+	body, err = state.takeList("/api/v1/namespaces/default/secrets")
+	require.NoError(t, err)
+	assert.NotNil(t, body)
+	// the resources of the namespace added to the scope are listed from the API server
+	body, err = state.takeList("/api/v1/namespaces/kube-system/pods")
+	require.NoError(t, err)
+	assert.Nil(t, body)
+	// the watches are tracked again once they are restarted
+	_, ok := tracker.resourceVersion("/api/v1/namespaces/default/pods", podsAPI.GroupKind, "default")
+	assert.False(t, ok)
+}
+
 func TestHandleAddEvent_ClusterExcluded(t *testing.T) {
 	db := &dbmocks.ArgoDB{}
 	db.On("GetApplicationControllerReplicas").Return(1)
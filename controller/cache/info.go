@@ -56,6 +56,10 @@ func populateNodeInfo(un *unstructured.Unstructured, res *ResourceInfo, customLa
 			populateServiceInfo(un, res)
 		case "Node":
 			populateHostNodeInfo(un, res)
+		case "ResourceQuota":
+			populateResourceQuotaInfo(un, res)
+		case "LimitRange":
+			populateLimitRangeInfo(un, res)
 		}
 	case "extensions", "networking.k8s.io":
 		if gvk.Kind == kube.IngressKind {
@@ -476,6 +480,45 @@ func populateHostNodeInfo(un *unstructured.Unstructured, res *ResourceInfo) {
 	}
 }
 
+func populateResourceQuotaInfo(un *unstructured.Unstructured, res *ResourceInfo) {
+	quota := corev1.ResourceQuota{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(un.Object, &quota)
+	if err != nil {
+		return
+	}
+	// the status reflects the limits enforced by the quota controller, the spec is used until the quota is processed
+	hard := quota.Status.Hard
+	if len(hard) == 0 {
+		hard = quota.Spec.Hard
+	}
+	res.ResourceQuotaInfo = &ResourceQuotaInfo{
+		Hard:   hard,
+		Used:   quota.Status.Used,
+		Scoped: len(quota.Spec.Scopes) > 0 || quota.Spec.ScopeSelector != nil,
+	}
+}
+
+func populateLimitRangeInfo(un *unstructured.Unstructured, res *ResourceInfo) {
+	limitRange := corev1.LimitRange{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(un.Object, &limitRange)
+	if err != nil {
+		return
+	}
+	info := &LimitRangeInfo{DefaultRequests: corev1.ResourceList{}, DefaultLimits: corev1.ResourceList{}}
+	for _, item := range limitRange.Spec.Limits {
+		if item.Type != corev1.LimitTypeContainer {
+			continue
+		}
+		for name, quantity := range item.DefaultRequest {
+			info.DefaultRequests[name] = quantity
+		}
+		for name, quantity := range item.Default {
+			info.DefaultLimits[name] = quantity
+		}
+	}
+	res.LimitRangeInfo = info
+}
+
 func generateManifestHash(un *unstructured.Unstructured, ignores []v1alpha1.ResourceIgnoreDifferences, overrides map[string]v1alpha1.ResourceOverride, opts normalizers.IgnoreNormalizerOpts) (string, error) {
 	normalizer, err := normalizers.NewIgnoreNormalizer(ignores, overrides, opts)
 	if err != nil {
@@ -913,6 +913,83 @@ status:
 	}, info.NodeInfo)
 }
 
+func TestGetResourceQuotaInfo(t *testing.T) {
+	quota := strToUnstructured(`
+apiVersion: v1
+kind: ResourceQuota
+metadata:
+  name: compute
+  namespace: default
+spec:
+  hard:
+    requests.cpu: "2"
+    pods: "10"
+status:
+  hard:
+    requests.cpu: "2"
+    pods: "10"
+  used:
+    requests.cpu: 500m
+    pods: "1"
+`)
+
+	info := &ResourceInfo{}
+	populateNodeInfo(quota, info, []string{})
+	assert.Equal(t, &ResourceQuotaInfo{
+		Hard: corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("2"), corev1.ResourcePods: resource.MustParse("10")},
+		Used: corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("500m"), corev1.ResourcePods: resource.MustParse("1")},
+	}, info.ResourceQuotaInfo)
+
+	t.Run("NotProcessed", func(t *testing.T) {
+		quota := strToUnstructured(`
+apiVersion: v1
+kind: ResourceQuota
+metadata:
+  name: compute
+  namespace: default
+spec:
+  hard:
+    pods: "10"
+  scopes:
+  - BestEffort
+`)
+		info := &ResourceInfo{}
+		populateNodeInfo(quota, info, []string{})
+		assert.Equal(t, &ResourceQuotaInfo{
+			Hard:   corev1.ResourceList{corev1.ResourcePods: resource.MustParse("10")},
+			Scoped: true,
+		}, info.ResourceQuotaInfo)
+	})
+}
+
+func TestGetLimitRangeInfo(t *testing.T) {
+	limitRange := strToUnstructured(`
+apiVersion: v1
+kind: LimitRange
+metadata:
+  name: defaults
+  namespace: default
+spec:
+  limits:
+  - type: Container
+    default:
+      memory: 512Mi
+    defaultRequest:
+      cpu: 100m
+      memory: 256Mi
+  - type: PersistentVolumeClaim
+    max:
+      storage: 1Gi
+`)
+
+	info := &ResourceInfo{}
+	populateNodeInfo(limitRange, info, []string{})
+	assert.Equal(t, &LimitRangeInfo{
+		DefaultRequests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m"), corev1.ResourceMemory: resource.MustParse("256Mi")},
+		DefaultLimits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")},
+	}, info.LimitRangeInfo)
+}
+
 func TestGetServiceInfo(t *testing.T) {
 	info := &ResourceInfo{}
 	populateNodeInfo(testService, info, []string{})
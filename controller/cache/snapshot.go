@@ -0,0 +1,502 @@
+package cache
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/transport"
+
+	"github.com/argoproj/argo-cd/v3/common"
+)
+
+// secretGroupKind is the group kind of Secrets, which are not persisted in snapshots
+var secretGroupKind = schema.GroupKind{Kind: kube.SecretKind}
+
+// clusterSnapshot holds the state of a cluster cache persisted on disk. It is used to warm up the cluster cache when
+// the controller restarts, instead of listing every resource of the cluster.
+type clusterSnapshot struct {
+	Server    string      `json:"server"`
+	CreatedAt metav1.Time `json:"createdAt"`
+	// SettingsHash is the hash of the settings used to compute the information of the resources
+	SettingsHash     string         `json:"settingsHash"`
+	Namespaces       []string       `json:"namespaces,omitempty"`
+	ClusterResources bool           `json:"clusterResources,omitempty"`
+	APIs             []*apiSnapshot `json:"apis"`
+}
+
+// apiSnapshot holds the resources of a single API along with the resource versions the watches of the API are
+// resumed from.
+type apiSnapshot struct {
+	Group      string `json:"group,omitempty"`
+	Version    string `json:"version"`
+	Resource   string `json:"resource"`
+	Kind       string `json:"kind"`
+	Namespaced bool   `json:"namespaced,omitempty"`
+	// ResourceVersions holds the resource versions of the lists of the API by namespace, or by an empty namespace if
+	// the API is listed across all namespaces
+	ResourceVersions map[string]string   `json:"resourceVersions"`
+	Items            []*resourceSnapshot `json:"items"`
+}
+
+type resourceSnapshot struct {
+	// Object is either the whole resource manifest, if it is cached, or the resource metadata
+	Object   map[string]any `json:"object"`
+	Manifest bool           `json:"manifest,omitempty"`
+	Info     *ResourceInfo  `json:"info,omitempty"`
+	// ManifestHash is the unexported manifest hash of Info
+	ManifestHash string `json:"manifestHash,omitempty"`
+}
+
+func (a *apiSnapshot) groupVersion() schema.GroupVersion {
+	return schema.GroupVersion{Group: a.Group, Version: a.Version}
+}
+
+// listPath returns the path of the request listing the resources of the API in the given namespace.
+func (a *apiSnapshot) listPath(namespace string) string {
+	path := "/apis/" + a.Group + "/" + a.Version
+	if a.Group == "" {
+		path = "/api/" + a.Version
+	}
+	if namespace != "" {
+		path += "/namespaces/" + namespace
+	}
+	return path + "/" + a.Resource
+}
+
+// newClusterSnapshot captures the state of the given synced cluster cache. Lists whose resource versions are not
+// tracked, and Secrets, whose data must not be written to disk, are not persisted and are listed on startup.
+func newClusterSnapshot(server string, scope clusterScope, settingsHash string, clusterCache clustercache.ClusterCache, tracker *resourceVersionTracker) *clusterSnapshot {
+	return &clusterSnapshot{
+		Server:           server,
+		CreatedAt:        metav1.Now(),
+		SettingsHash:     settingsHash,
+		Namespaces:       scope.namespaces,
+		ClusterResources: scope.clusterResources,
+		APIs:             snapshotAPIs(scope, clusterCache, tracker, true),
+	}
+}
+
+// newClusterScopeSnapshot captures the state of the given synced cluster cache which remains in the given scope. It is
+// served to the cache once its scope is updated and is never persisted, so Secrets are captured as well.
+func newClusterScopeSnapshot(server string, scope clusterScope, clusterCache clustercache.ClusterCache, tracker *resourceVersionTracker) *clusterSnapshot {
+	return &clusterSnapshot{
+		Server:           server,
+		CreatedAt:        metav1.Now(),
+		Namespaces:       scope.namespaces,
+		ClusterResources: scope.clusterResources,
+		APIs:             snapshotAPIs(scope, clusterCache, tracker, false),
+	}
+}
+
+// snapshotAPIs captures the resources of the APIs watched by the given cluster cache within the given scope. Only the
+// lists whose resource versions are tracked are captured: the other lists are sent to the API server.
+func snapshotAPIs(scope clusterScope, clusterCache clustercache.ClusterCache, tracker *resourceVersionTracker, excludeSecrets bool) []*apiSnapshot {
+	apis := make(map[schema.GroupKind]*apiSnapshot)
+	for _, api := range clusterCache.GetAPIResources() {
+		if excludeSecrets && api.GroupKind == secretGroupKind {
+			continue
+		}
+		namespaces := []string{""}
+		if api.Meta.Namespaced && len(scope.namespaces) > 0 {
+			namespaces = scope.namespaces
+		}
+		snapshotAPI := &apiSnapshot{
+			Group:            api.GroupVersionResource.Group,
+			Version:          api.GroupVersionResource.Version,
+			Resource:         api.GroupVersionResource.Resource,
+			Kind:             api.GroupKind.Kind,
+			Namespaced:       api.Meta.Namespaced,
+			ResourceVersions: make(map[string]string),
+		}
+		// the resource versions are captured before the resources, so that the cache holds every change up to them
+		for _, namespace := range namespaces {
+			version, ok := tracker.resourceVersion(snapshotAPI.listPath(namespace), api.GroupKind, namespace)
+			if !ok && namespace != "" {
+				// the namespace might be covered by a list across all namespaces
+				version, ok = tracker.resourceVersion(snapshotAPI.listPath(""), api.GroupKind, namespace)
+			}
+			if ok {
+				snapshotAPI.ResourceVersions[namespace] = version
+			}
+		}
+		if len(snapshotAPI.ResourceVersions) > 0 {
+			apis[api.GroupKind] = snapshotAPI
+		}
+	}
+	for _, r := range clusterCache.FindResources("") {
+		api, ok := apis[r.ResourceKey().GroupKind()]
+		if !ok {
+			continue
+		}
+		if _, ok := api.ResourceVersions[r.Ref.Namespace]; !ok && api.Namespaced && len(scope.namespaces) > 0 {
+			continue
+		}
+		item := &resourceSnapshot{Manifest: r.Resource != nil}
+		if r.Resource != nil {
+			item.Object = r.Resource.DeepCopy().Object
+		} else {
+			item.Object = resourceMetadataObject(api, r)
+		}
+		if info, ok := r.Info.(*ResourceInfo); ok {
+			item.Info = info
+			item.ManifestHash = info.manifestHash
+		}
+		api.Items = append(api.Items, item)
+	}
+	result := make([]*apiSnapshot, 0, len(apis))
+	for _, api := range apis {
+		result = append(result, api)
+	}
+	return result
+}
+
+// resourceMetadataObject returns an object holding the metadata of a resource whose manifest is not cached.
+func resourceMetadataObject(api *apiSnapshot, r *clustercache.Resource) map[string]any {
+	un := &unstructured.Unstructured{}
+	un.SetAPIVersion(api.groupVersion().String())
+	un.SetKind(api.Kind)
+	un.SetNamespace(r.Ref.Namespace)
+	un.SetName(r.Ref.Name)
+	un.SetUID(r.Ref.UID)
+	un.SetResourceVersion(r.ResourceVersion)
+	un.SetOwnerReferences(r.OwnerRefs)
+	if r.CreationTimestamp != nil {
+		un.SetCreationTimestamp(*r.CreationTimestamp)
+	}
+	return un.Object
+}
+
+// snapshotSettingsHash returns the hash of the settings used to compute the information of the resources. A snapshot
+// taken with different settings, or by a different version of Argo CD, is discarded.
+func snapshotSettingsHash(settings cacheSettings, resourceCustomLabels []string) (string, error) {
+	data, err := json.Marshal(map[string]any{
+		"version":              common.GetVersion().Version,
+		"appInstanceLabelKey":  settings.appInstanceLabelKey,
+		"trackingMethod":       settings.trackingMethod,
+		"installationID":       settings.installationID,
+		"resourceOverrides":    settings.resourceOverrides,
+		"resourceCustomLabels": resourceCustomLabels,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error marshalling cache settings: %w", err)
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+func clusterSnapshotPath(dir string, server string) string {
+	hash := sha256.Sum256([]byte(server))
+	return filepath.Join(dir, hex.EncodeToString(hash[:])+".json.gz")
+}
+
+// saveClusterSnapshot atomically writes the snapshot to the given directory.
+func saveClusterSnapshot(dir string, snapshot *clusterSnapshot) error {
+	f, err := os.CreateTemp(dir, "snapshot-*.tmp")
+	if err != nil {
+		return fmt.Errorf("error creating snapshot file: %w", err)
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+	w := gzip.NewWriter(f)
+	if err := json.NewEncoder(w).Encode(snapshot); err != nil {
+		return fmt.Errorf("error writing snapshot: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("error writing snapshot: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("error writing snapshot: %w", err)
+	}
+	if err := os.Rename(f.Name(), clusterSnapshotPath(dir, snapshot.Server)); err != nil {
+		return fmt.Errorf("error renaming snapshot file: %w", err)
+	}
+	return nil
+}
+
+// loadClusterSnapshot reads the snapshot of the given cluster, or returns nil if there is no snapshot.
+func loadClusterSnapshot(dir string, server string) (*clusterSnapshot, error) {
+	f, err := os.Open(clusterSnapshotPath(dir, server))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error opening snapshot file: %w", err)
+	}
+	defer f.Close()
+	r, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("error reading snapshot: %w", err)
+	}
+	var snapshot clusterSnapshot
+	if err := json.NewDecoder(r).Decode(&snapshot); err != nil {
+		return nil, fmt.Errorf("error reading snapshot: %w", err)
+	}
+	if snapshot.Server != server {
+		return nil, fmt.Errorf("snapshot belongs to cluster %s", snapshot.Server)
+	}
+	return &snapshot, nil
+}
+
+// isSnapshotUsable returns whether the snapshot can be used to warm up a cluster cache with the given scope and
+// settings.
+func isSnapshotUsable(snapshot *clusterSnapshot, scope clusterScope, settingsHash string) bool {
+	return snapshot.SettingsHash == settingsHash &&
+		snapshot.ClusterResources == scope.clusterResources &&
+		(len(snapshot.Namespaces) == 0 && len(scope.namespaces) == 0 || reflect.DeepEqual(snapshot.Namespaces, scope.namespaces))
+}
+
+type snapshotList struct {
+	api       *apiSnapshot
+	namespace string
+}
+
+type restoredResourceInfo struct {
+	info     *ResourceInfo
+	manifest bool
+}
+
+// clusterSnapshotState serves the content of a snapshot to a cluster cache being synchronized. The first list
+// request of every API is answered with the snapshot resources and resource version, so that the cluster cache
+// resumes the watch of the API from the snapshot. If the resource version is too old, the watch fails with a
+// "410 Gone" error and the cluster cache falls back to listing the resources from the API server.
+type clusterSnapshotState struct {
+	lock sync.Mutex
+	// lists holds the snapshot lists by request path
+	lists map[string]snapshotList
+	// infos holds the information of the snapshot resources by UID and resource version
+	infos map[string]restoredResourceInfo
+}
+
+// newClusterSnapshotState returns a state serving the content of the given snapshot, or nothing if the snapshot is nil.
+func newClusterSnapshotState(snapshot *clusterSnapshot) *clusterSnapshotState {
+	state := &clusterSnapshotState{}
+	state.load(snapshot)
+	return state
+}
+
+// load replaces the content served by the state with the content of the given snapshot.
+func (s *clusterSnapshotState) load(snapshot *clusterSnapshot) {
+	lists := make(map[string]snapshotList)
+	infos := make(map[string]restoredResourceInfo)
+	if snapshot != nil {
+		for _, api := range snapshot.APIs {
+			for namespace := range api.ResourceVersions {
+				lists[api.listPath(namespace)] = snapshotList{api: api, namespace: namespace}
+			}
+			for _, item := range api.Items {
+				if item.Info == nil {
+					continue
+				}
+				item.Info.manifestHash = item.ManifestHash
+				un := unstructured.Unstructured{Object: item.Object}
+				infos[restoredInfoKey(&un)] = restoredResourceInfo{info: item.Info, manifest: item.Manifest}
+			}
+		}
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.lists = lists
+	s.infos = infos
+}
+
+func restoredInfoKey(un *unstructured.Unstructured) string {
+	return string(un.GetUID()) + "/" + un.GetResourceVersion()
+}
+
+// takeResourceInfo returns the information of the given resource stored in the snapshot, if the resource has not
+// changed since the snapshot was taken.
+func (s *clusterSnapshotState) takeResourceInfo(un *unstructured.Unstructured) (*ResourceInfo, bool, bool) {
+	if s == nil {
+		return nil, false, false
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	key := restoredInfoKey(un)
+	restored, ok := s.infos[key]
+	if !ok {
+		return nil, false, false
+	}
+	delete(s.infos, key)
+	return restored.info, restored.manifest, true
+}
+
+// takeList returns the body of the list request with the given path, or nil if the request must be sent to the API
+// server. Each list is served only once.
+func (s *clusterSnapshotState) takeList(path string) ([]byte, error) {
+	path = apiPath(path)
+	if path == "" {
+		return nil, nil
+	}
+
+	s.lock.Lock()
+	list, ok := s.lists[path]
+	delete(s.lists, path)
+	s.lock.Unlock()
+	if !ok {
+		return nil, nil
+	}
+
+	items := make([]map[string]any, 0, len(list.api.Items))
+	for _, item := range list.api.Items {
+		if list.namespace == "" || (&unstructured.Unstructured{Object: item.Object}).GetNamespace() == list.namespace {
+			items = append(items, item.Object)
+		}
+	}
+	return json.Marshal(map[string]any{
+		"apiVersion": list.api.groupVersion().String(),
+		"kind":       list.api.Kind + "List",
+		"metadata":   map[string]any{"resourceVersion": list.api.ResourceVersions[list.namespace]},
+		"items":      items,
+	})
+}
+
+// apiPath returns the given request path without the prefix of the cluster API server, e.g. when the API server is
+// proxied, or an empty string if the path is not a Kubernetes API path.
+func apiPath(path string) string {
+	idx := max(strings.LastIndex(path, "/api/"), strings.LastIndex(path, "/apis/"))
+	if idx < 0 {
+		return ""
+	}
+	return path[idx:]
+}
+
+// wrapSnapshotTransport returns a transport wrapper tracking the resource versions the watches of the cluster cache are
+// started from, and serving the lists of the given snapshot state, if any.
+func wrapSnapshotTransport(state *clusterSnapshotState, tracker *resourceVersionTracker) transport.WrapperFunc {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return &snapshotRoundTripper{state: state, tracker: tracker, delegate: rt}
+	}
+}
+
+type snapshotRoundTripper struct {
+	state    *clusterSnapshotState
+	tracker  *resourceVersionTracker
+	delegate http.RoundTripper
+}
+
+func (t *snapshotRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return t.delegate.RoundTrip(req)
+	}
+	query := req.URL.Query()
+	if query.Get("watch") == "true" {
+		t.tracker.observeWatch(req.URL.Path, query.Get("resourceVersion"))
+	} else if t.state != nil && query.Get("continue") == "" {
+		body, err := t.state.takeList(req.URL.Path)
+		if err != nil {
+			log.Warnf("Failed to serve %s from the cluster cache snapshot: %v", req.URL.Path, err)
+		} else if body != nil {
+			log.Debugf("Serving %s from the cluster cache snapshot", req.URL.Path)
+			return &http.Response{
+				Status:        "200 OK",
+				StatusCode:    http.StatusOK,
+				Proto:         "HTTP/1.1",
+				ProtoMajor:    1,
+				ProtoMinor:    1,
+				Header:        http.Header{"Content-Type": []string{"application/json"}},
+				Body:          io.NopCloser(bytes.NewReader(body)),
+				ContentLength: int64(len(body)),
+				Request:       req,
+			}, nil
+		}
+	}
+	return t.delegate.RoundTrip(req)
+}
+
+type groupKindNamespace struct {
+	groupKind schema.GroupKind
+	namespace string
+}
+
+// resourceVersionTracker tracks the resource versions up to which a cluster cache holds the resources of every list.
+// Resource versions are opaque, but they are etcd revisions for the built-in resources and the custom resources: the
+// cache holds every change up to the resource version a watch is started from, which is the resource version of the
+// list the cache was loaded from or of the last event received by the previous watch, and up to the resource version
+// of every event it has applied since. APIs with non numeric resource versions are not tracked.
+type resourceVersionTracker struct {
+	lock sync.Mutex
+	// watches holds the resource versions the watches are started from by list request path
+	watches map[string]uint64
+	// events holds the resource versions of the most recent events applied to the cache by group kind and namespace
+	events map[groupKindNamespace]uint64
+}
+
+func newResourceVersionTracker() *resourceVersionTracker {
+	return &resourceVersionTracker{
+		watches: make(map[string]uint64),
+		events:  make(map[groupKindNamespace]uint64),
+	}
+}
+
+// reset forgets the tracked resource versions once the cluster cache is invalidated, since the watches are started
+// again.
+func (t *resourceVersionTracker) reset() {
+	if t == nil {
+		return
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.watches = make(map[string]uint64)
+	t.events = make(map[groupKindNamespace]uint64)
+}
+
+func (t *resourceVersionTracker) observeWatch(path string, resourceVersion string) {
+	path = apiPath(path)
+	version, err := strconv.ParseUint(resourceVersion, 10, 64)
+	if path == "" || err != nil {
+		return
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.watches[path] = max(t.watches[path], version)
+}
+
+// observeResource records the resource version of a resource once it is applied to the cache.
+func (t *resourceVersionTracker) observeResource(r *clustercache.Resource) {
+	version, err := strconv.ParseUint(r.ResourceVersion, 10, 64)
+	if err != nil {
+		return
+	}
+	key := groupKindNamespace{groupKind: r.ResourceKey().GroupKind(), namespace: r.Ref.Namespace}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.events[key] = max(t.events[key], version)
+}
+
+// resourceVersion returns the resource version up to which the cache holds the resources returned by the list request
+// with the given path, or false if the list is not watched.
+func (t *resourceVersionTracker) resourceVersion(path string, gk schema.GroupKind, namespace string) (string, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	version, ok := t.watches[path]
+	if !ok {
+		return "", false
+	}
+	for key, eventVersion := range t.events {
+		if key.groupKind == gk && (namespace == "" || key.namespace == namespace) {
+			version = max(version, eventVersion)
+		}
+	}
+	return strconv.FormatUint(version, 10), true
+}
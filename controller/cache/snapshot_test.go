@@ -0,0 +1,318 @@
+package cache
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/cache/mocks"
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+var (
+	podsAPI = kube.APIResourceInfo{
+		GroupKind:            schema.GroupKind{Kind: "Pod"},
+		GroupVersionResource: schema.GroupVersionResource{Version: "v1", Resource: "pods"},
+		Meta:                 metav1.APIResource{Namespaced: true},
+	}
+	deploymentsAPI = kube.APIResourceInfo{
+		GroupKind:            schema.GroupKind{Group: "apps", Kind: "Deployment"},
+		GroupVersionResource: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"},
+		Meta:                 metav1.APIResource{Namespaced: true},
+	}
+	secretsAPI = kube.APIResourceInfo{
+		GroupKind:            schema.GroupKind{Kind: "Secret"},
+		GroupVersionResource: schema.GroupVersionResource{Version: "v1", Resource: "secrets"},
+		Meta:                 metav1.APIResource{Namespaced: true},
+	}
+)
+
+func newTestClusterCache() *mocks.ClusterCache {
+	deployment := &unstructured.Unstructured{}
+	deployment.SetAPIVersion("apps/v1")
+	deployment.SetKind("Deployment")
+	deployment.SetNamespace("default")
+	deployment.SetName("guestbook")
+	deployment.SetUID("deployment-uid")
+	deployment.SetResourceVersion("120")
+
+	secret := &unstructured.Unstructured{}
+	secret.SetAPIVersion("v1")
+	secret.SetKind("Secret")
+	secret.SetNamespace("default")
+	secret.SetName("guestbook")
+	secret.SetUID("secret-uid")
+	secret.SetResourceVersion("90")
+	secret.Object["data"] = map[string]any{"password": "c2VjcmV0"}
+
+	resources := map[kube.ResourceKey]*cache.Resource{
+		kube.NewResourceKey("apps", "Deployment", "default", "guestbook"): {
+			ResourceVersion: "120",
+			Ref:             corev1.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "guestbook", UID: "deployment-uid"},
+			Info:            &ResourceInfo{AppName: "guestbook", Health: &health.HealthStatus{Status: health.HealthStatusHealthy}, manifestHash: "deployment-hash"},
+			Resource:        deployment,
+		},
+		kube.NewResourceKey("", "Pod", "default", "guestbook-1"): {
+			ResourceVersion: "100",
+			Ref:             corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: "guestbook-1", UID: "pod-1-uid"},
+			OwnerRefs:       []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "guestbook-abc", UID: "rs-uid"}},
+			Info:            &ResourceInfo{Images: []string{"guestbook:v1"}},
+		},
+		kube.NewResourceKey("", "Pod", "kube-system", "coredns"): {
+			ResourceVersion: "110",
+			Ref:             corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Namespace: "kube-system", Name: "coredns", UID: "pod-2-uid"},
+			Info:            &ResourceInfo{},
+		},
+		kube.NewResourceKey("", "Secret", "default", "guestbook"): {
+			ResourceVersion: "90",
+			Ref:             corev1.ObjectReference{APIVersion: "v1", Kind: "Secret", Namespace: "default", Name: "guestbook", UID: "secret-uid"},
+			Info:            &ResourceInfo{AppName: "guestbook"},
+			Resource:        secret,
+		},
+	}
+	clusterCache := &mocks.ClusterCache{}
+	clusterCache.On("GetAPIResources").Return([]kube.APIResourceInfo{podsAPI, deploymentsAPI, secretsAPI})
+	clusterCache.On("FindResources", "").Return(resources)
+	return clusterCache
+}
+
+func newTestResourceVersionTracker() *resourceVersionTracker {
+	tracker := newResourceVersionTracker()
+	for _, path := range []string{"/api/v1/pods", "/api/v1/secrets", "/apis/apps/v1/deployments", "/api/v1/namespaces/default/pods"} {
+		tracker.observeWatch(path, "105")
+	}
+	tracker.observeResource(&cache.Resource{
+		ResourceVersion: "110",
+		Ref:             corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Namespace: "kube-system", Name: "coredns"},
+	})
+	return tracker
+}
+
+func TestClusterSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	snapshot := newClusterSnapshot("https://mycluster", clusterScope{}, "hash", newTestClusterCache(), newTestResourceVersionTracker())
+	// Secrets are not persisted
+	require.Len(t, snapshot.APIs, 2)
+	for _, api := range snapshot.APIs {
+		assert.NotEqual(t, "Secret", api.Kind)
+	}
+	require.NoError(t, saveClusterSnapshot(dir, snapshot))
+
+	loaded, err := loadClusterSnapshot(dir, "https://mycluster")
+	require.NoError(t, err)
+	require.NotNil(t, loaded)
+	assert.True(t, isSnapshotUsable(loaded, clusterScope{}, "hash"))
+	assert.False(t, isSnapshotUsable(loaded, clusterScope{}, "other-hash"))
+	assert.False(t, isSnapshotUsable(loaded, clusterScope{namespaces: []string{"default"}}, "hash"))
+
+	missing, err := loadClusterSnapshot(dir, "https://othercluster")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	state := newClusterSnapshotState(loaded)
+
+	t.Run("list is served once", func(t *testing.T) {
+		body, err := state.takeList("/api/v1/pods")
+		require.NoError(t, err)
+		var list unstructured.UnstructuredList
+		require.NoError(t, list.UnmarshalJSON(body))
+		assert.Equal(t, "110", list.GetResourceVersion())
+		assert.Len(t, list.Items, 2)
+
+		body, err = state.takeList("/api/v1/pods")
+		require.NoError(t, err)
+		assert.Nil(t, body)
+	})
+
+	t.Run("list of a proxied cluster", func(t *testing.T) {
+		body, err := state.takeList("/k8s/clusters/c-1/apis/apps/v1/deployments")
+		require.NoError(t, err)
+		var list unstructured.UnstructuredList
+		require.NoError(t, list.UnmarshalJSON(body))
+		assert.Equal(t, "105", list.GetResourceVersion())
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, "guestbook", list.Items[0].GetName())
+
+		body, err = state.takeList("/api/v1/secrets")
+		require.NoError(t, err)
+		assert.Nil(t, body)
+	})
+
+	t.Run("resource information is restored", func(t *testing.T) {
+		pod := &unstructured.Unstructured{}
+		pod.SetUID("pod-1-uid")
+		pod.SetResourceVersion("100")
+		info, manifest, ok := state.takeResourceInfo(pod)
+		require.True(t, ok)
+		assert.False(t, manifest)
+		assert.Equal(t, []string{"guestbook:v1"}, info.Images)
+
+		_, _, ok = state.takeResourceInfo(pod)
+		assert.False(t, ok)
+
+		deployment := &unstructured.Unstructured{}
+		deployment.SetUID("deployment-uid")
+		deployment.SetResourceVersion("120")
+		info, manifest, ok = state.takeResourceInfo(deployment)
+		require.True(t, ok)
+		assert.True(t, manifest)
+		assert.Equal(t, health.HealthStatusHealthy, info.Health.Status)
+		assert.Equal(t, "deployment-hash", info.manifestHash)
+
+		// the resource has changed since the snapshot was taken
+		updated := &unstructured.Unstructured{}
+		updated.SetUID("pod-2-uid")
+		updated.SetResourceVersion("111")
+		_, _, ok = state.takeResourceInfo(updated)
+		assert.False(t, ok)
+
+		var nilState *clusterSnapshotState
+		_, _, ok = nilState.takeResourceInfo(updated)
+		assert.False(t, ok)
+	})
+}
+
+func TestClusterSnapshot_Namespaces(t *testing.T) {
+	tracker := newResourceVersionTracker()
+	tracker.observeWatch("/api/v1/namespaces/default/pods", "105")
+	tracker.observeWatch("/apis/apps/v1/deployments", "107")
+	scope := clusterScope{namespaces: []string{"default", "kube-system"}}
+	snapshot := newClusterSnapshot("https://mycluster", scope, "hash", newTestClusterCache(), tracker)
+	require.Len(t, snapshot.APIs, 2)
+	apis := make(map[string]*apiSnapshot)
+	for _, api := range snapshot.APIs {
+		apis[api.Kind] = api
+	}
+	// the list of pods in the kube-system namespace is not tracked
+	assert.Equal(t, map[string]string{"default": "105"}, apis["Pod"].ResourceVersions)
+	require.Len(t, apis["Pod"].Items, 1)
+	// the namespaces are covered by the list of deployments across all namespaces
+	assert.Equal(t, map[string]string{"default": "107", "kube-system": "107"}, apis["Deployment"].ResourceVersions)
+
+	state := newClusterSnapshotState(snapshot)
+	body, err := state.takeList("/api/v1/pods")
+	require.NoError(t, err)
+	assert.Nil(t, body)
+	body, err = state.takeList("/api/v1/namespaces/kube-system/pods")
+	require.NoError(t, err)
+	assert.Nil(t, body)
+	body, err = state.takeList("/api/v1/namespaces/default/pods")
+	require.NoError(t, err)
+	var list unstructured.UnstructuredList
+	require.NoError(t, list.UnmarshalJSON(body))
+	assert.Equal(t, "105", list.GetResourceVersion())
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, "guestbook-1", list.Items[0].GetName())
+	body, err = state.takeList("/apis/apps/v1/namespaces/kube-system/deployments")
+	require.NoError(t, err)
+	require.NoError(t, list.UnmarshalJSON(body))
+	assert.Equal(t, "107", list.GetResourceVersion())
+	assert.Empty(t, list.Items)
+}
+
+func TestClusterScopeSnapshot(t *testing.T) {
+	snapshot := newClusterScopeSnapshot("https://mycluster", clusterScope{}, newTestClusterCache(), newTestResourceVersionTracker())
+	// the snapshot is not persisted, so Secrets are served as well
+	require.Len(t, snapshot.APIs, 3)
+
+	state := newClusterSnapshotState(nil)
+	body, err := state.takeList("/api/v1/secrets")
+	require.NoError(t, err)
+	assert.Nil(t, body)
+
+	state.load(snapshot)
+	body, err = state.takeList("/api/v1/secrets")
+	require.NoError(t, err)
+	var list unstructured.UnstructuredList
+	require.NoError(t, list.UnmarshalJSON(body))
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, "guestbook", list.Items[0].GetName())
+}
+
+func TestResourceVersionTracker(t *testing.T) {
+	tracker := newResourceVersionTracker()
+	_, ok := tracker.resourceVersion("/api/v1/pods", podsAPI.GroupKind, "")
+	assert.False(t, ok)
+
+	tracker.observeWatch("/k8s/clusters/c-1/api/v1/pods", "100")
+	tracker.observeWatch("/api/v1/pods", "not-a-number")
+	version, ok := tracker.resourceVersion("/api/v1/pods", podsAPI.GroupKind, "")
+	require.True(t, ok)
+	assert.Equal(t, "100", version)
+
+	tracker.observeResource(&cache.Resource{ResourceVersion: "120", Ref: corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: "a"}})
+	tracker.observeResource(&cache.Resource{ResourceVersion: "130", Ref: corev1.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "a"}})
+	version, _ = tracker.resourceVersion("/api/v1/pods", podsAPI.GroupKind, "")
+	assert.Equal(t, "120", version)
+
+	tracker.observeWatch("/api/v1/namespaces/other/pods", "110")
+	version, _ = tracker.resourceVersion("/api/v1/namespaces/other/pods", podsAPI.GroupKind, "other")
+	assert.Equal(t, "110", version)
+
+	tracker.reset()
+	_, ok = tracker.resourceVersion("/api/v1/pods", podsAPI.GroupKind, "")
+	assert.False(t, ok)
+}
+
+func TestSnapshotRoundTripper(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.String())
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"apiVersion":"v1","kind":"PodList","metadata":{"resourceVersion":"200"},"items":[]}`)
+	}))
+	defer server.Close()
+
+	snapshot := newClusterSnapshot(server.URL, clusterScope{}, "hash", newTestClusterCache(), newTestResourceVersionTracker())
+	state := newClusterSnapshotState(snapshot)
+	tracker := newResourceVersionTracker()
+	config := &rest.Config{Host: server.URL, WrapTransport: wrapSnapshotTransport(state, tracker)}
+	client, err := dynamic.NewForConfig(config)
+	require.NoError(t, err)
+	pods := client.Resource(podsAPI.GroupVersionResource)
+
+	list, err := pods.List(t.Context(), metav1.ListOptions{Limit: 500})
+	require.NoError(t, err)
+	assert.Equal(t, "110", list.GetResourceVersion())
+	assert.Len(t, list.Items, 2)
+	assert.Empty(t, requests)
+
+	// the following lists are sent to the API server
+	list, err = pods.List(t.Context(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "200", list.GetResourceVersion())
+	assert.Len(t, requests, 1)
+
+	// the resource versions watches are started from are tracked
+	_, _ = pods.Watch(t.Context(), metav1.ListOptions{ResourceVersion: "110"})
+	version, ok := tracker.resourceVersion("/api/v1/pods", podsAPI.GroupKind, "")
+	require.True(t, ok)
+	assert.Equal(t, "110", version)
+}
+
+func TestSnapshotSettingsHash(t *testing.T) {
+	settings := cacheSettings{appInstanceLabelKey: "app.kubernetes.io/instance", trackingMethod: appv1.TrackingMethodAnnotation}
+	hash, err := snapshotSettingsHash(settings, nil)
+	require.NoError(t, err)
+	sameHash, err := snapshotSettingsHash(settings, nil)
+	require.NoError(t, err)
+	assert.Equal(t, hash, sameHash)
+
+	settings.resourceOverrides = map[string]appv1.ResourceOverride{"apps/Deployment": {HealthLua: "return {}"}}
+	otherHash, err := snapshotSettingsHash(settings, nil)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, otherHash)
+}
@@ -33,6 +33,7 @@ var clusterInfoTimeout = env.ParseDurationFromEnv(EnvClusterInfoTimeout, default
 
 type clusterInfoUpdater struct {
 	infoSource    metrics.HasClustersInfo
+	eventsSource  metrics.HasClusterEventsRate
 	db            db.ArgoDB
 	appLister     v1alpha1.ApplicationNamespaceLister
 	cache         *appstatecache.Cache
@@ -44,6 +45,7 @@ type clusterInfoUpdater struct {
 
 func NewClusterInfoUpdater(
 	infoSource metrics.HasClustersInfo,
+	eventsSource metrics.HasClusterEventsRate,
 	db db.ArgoDB,
 	appLister v1alpha1.ApplicationNamespaceLister,
 	cache *appstatecache.Cache,
@@ -51,7 +53,7 @@ func NewClusterInfoUpdater(
 	projGetter func(app *appv1.Application) (*appv1.AppProject, error),
 	namespace string,
 ) *clusterInfoUpdater {
-	return &clusterInfoUpdater{infoSource, db, appLister, cache, clusterFilter, projGetter, namespace, time.Time{}}
+	return &clusterInfoUpdater{infoSource, eventsSource, db, appLister, cache, clusterFilter, projGetter, namespace, time.Time{}}
 }
 
 func (c *clusterInfoUpdater) Run(ctx context.Context) {
@@ -156,6 +158,9 @@ func (c *clusterInfoUpdater) getUpdatedClusterInfo(ctx context.Context, apps []*
 			clusterInfo.CacheInfo.LastCacheSyncTime = &syncTime
 			clusterInfo.CacheInfo.APIsCount = int64(info.APIsCount)
 			clusterInfo.CacheInfo.ResourcesCount = int64(info.ResourcesCount)
+			if c.eventsSource != nil {
+				clusterInfo.CacheInfo.EventsPerMinute = c.eventsSource.GetClusterEventsPerMinute(cluster.Server)
+			}
 		default:
 			clusterInfo.ConnectionState.Status = appv1.ConnectionStatusFailed
 			clusterInfo.ConnectionState.Message = info.SyncError.Error()
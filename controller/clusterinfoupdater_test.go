@@ -88,7 +88,7 @@ func TestClusterSecretUpdater(t *testing.T) {
 		}
 
 		lister := applisters.NewApplicationLister(appInformer.GetIndexer()).Applications(fakeNamespace)
-		updater := NewClusterInfoUpdater(nil, argoDB, lister, appCache, nil, nil, fakeNamespace)
+		updater := NewClusterInfoUpdater(nil, nil, argoDB, lister, appCache, nil, nil, fakeNamespace)
 
 		err = updater.updateClusterInfo(t.Context(), *cluster, info)
 		require.NoError(t, err, "Invoking updateClusterInfo failed.")
@@ -0,0 +1,161 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube/scheme"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8smanagedfields "k8s.io/apimachinery/pkg/util/managedfields"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo/managedfields"
+	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
+	"github.com/argoproj/argo-cd/v3/util/env"
+)
+
+// resourceDriftHistoryLimit is the maximum number of drift episodes kept per application. Zero disables the recording.
+var resourceDriftHistoryLimit = env.ParseNumFromEnv("ARGOCD_APPLICATION_DRIFT_HISTORY_LIMIT", 100, 0, math.MaxInt32)
+
+// recordResourceDrift updates the drift history of the application using the result of the latest comparison.
+// A drift episode is opened when a resource goes out of sync because of fields updated by a manager other than
+// Argo CD, and closed once the resource is observed in sync again.
+func (ctrl *ApplicationController) recordResourceDrift(app *appv1.Application, compareResult *comparisonResult) error {
+	if resourceDriftHistoryLimit == 0 {
+		return nil
+	}
+	appName := app.InstanceName(ctrl.namespace)
+	var history []*appv1.ResourceDriftEpisode
+	if err := ctrl.cache.GetAppResourceDriftHistory(appName, &history); err != nil && !errors.Is(err, appstatecache.ErrCacheMiss) {
+		return fmt.Errorf("error getting app drift history: %w", err)
+	}
+
+	open := map[kube.ResourceKey]*appv1.ResourceDriftEpisode{}
+	for _, episode := range history {
+		if !episode.IsHealed() {
+			open[episode.Key()] = episode
+		}
+	}
+
+	var parser *k8smanagedfields.GvkParser
+	if compareResult.diffConfig != nil {
+		parser = compareResult.diffConfig.GVKParser()
+	}
+	now := metav1.Now()
+	changed := false
+	for i, res := range compareResult.resources {
+		if res.Hook || i >= len(compareResult.managedResources) {
+			continue
+		}
+		key := kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)
+		episode, drifted := open[key]
+		delete(open, key)
+		switch res.Status {
+		case appv1.SyncStatusCodeSynced:
+			if drifted {
+				healResourceDrift(app, episode, now)
+				changed = true
+			}
+		case appv1.SyncStatusCodeOutOfSync:
+			if drifted {
+				continue
+			}
+			managers, err := driftManagers(compareResult.managedResources[i], parser)
+			if err != nil {
+				log.WithField("application", app.QualifiedName()).Debugf("Failed to attribute drift of %s: %v", key.String(), err)
+				continue
+			}
+			if len(managers) == 0 {
+				// the resource is out of sync because its desired state has changed
+				continue
+			}
+			history = append(history, &appv1.ResourceDriftEpisode{
+				Group:         res.Group,
+				Kind:          res.Kind,
+				Namespace:     res.Namespace,
+				Name:          res.Name,
+				DriftedAt:     now,
+				FieldManagers: managers,
+			})
+			changed = true
+		}
+	}
+	// resources which are no longer part of the application can't be drifted anymore
+	for _, episode := range open {
+		episode.HealedAt = &now
+		episode.HealType = appv1.ResourceDriftHealTypeExternal
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+	if len(history) > resourceDriftHistoryLimit {
+		history = history[len(history)-resourceDriftHistoryLimit:]
+	}
+	return ctrl.cache.SetAppResourceDriftHistory(appName, history)
+}
+
+// healResourceDrift closes the given drift episode and attributes the healing to the last sync operation of the
+// application if it has synced the resource after it drifted.
+func healResourceDrift(app *appv1.Application, episode *appv1.ResourceDriftEpisode, now metav1.Time) {
+	episode.HealedAt = &now
+	episode.HealType = appv1.ResourceDriftHealTypeExternal
+	op := app.Status.OperationState
+	if op == nil || !op.Phase.Completed() || op.FinishedAt == nil || op.FinishedAt.Before(&episode.DriftedAt) || op.SyncResult == nil {
+		return
+	}
+	for _, res := range op.SyncResult.Resources {
+		if res.Group == episode.Group && res.Kind == episode.Kind && res.Namespace == episode.Namespace && res.Name == episode.Name {
+			if op.Operation.InitiatedBy.Automated {
+				episode.HealType = appv1.ResourceDriftHealTypeSelfHeal
+			} else {
+				episode.HealType = appv1.ResourceDriftHealTypeSync
+				episode.HealedBy = op.Operation.InitiatedBy.Username
+			}
+			return
+		}
+	}
+}
+
+// driftManagers returns the managers, other than Argo CD itself, owning the live fields which differ from the
+// predicted live state of the given resource.
+func driftManagers(res managedResource, parser *k8smanagedfields.GvkParser) ([]string, error) {
+	if res.Live == nil || res.Target == nil {
+		return nil, nil
+	}
+	normalizedLive, err := unmarshalDiffState(res.Diff.NormalizedLive)
+	if err != nil {
+		return nil, err
+	}
+	predictedLive, err := unmarshalDiffState(res.Diff.PredictedLive)
+	if err != nil {
+		return nil, err
+	}
+	normalizedLive.SetManagedFields(res.Live.GetManagedFields())
+	managers, err := managedfields.DriftManagers(normalizedLive, predictedLive, scheme.ResolveParseableType(res.Target.GroupVersionKind(), parser))
+	if err != nil {
+		return nil, err
+	}
+	var foreign []string
+	for _, manager := range managers {
+		if manager != common.ArgoCDSSAManager {
+			foreign = append(foreign, manager)
+		}
+	}
+	return foreign, nil
+}
+
+func unmarshalDiffState(data []byte) (*unstructured.Unstructured, error) {
+	obj := &unstructured.Unstructured{}
+	if err := json.Unmarshal(data, &obj.Object); err != nil {
+		return nil, fmt.Errorf("error unmarshaling diff state: %w", err)
+	}
+	return obj, nil
+}
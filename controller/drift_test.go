@@ -0,0 +1,132 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/test"
+	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
+)
+
+func newDriftComparisonResult(status v1alpha1.SyncStatusCode, manager string) *comparisonResult {
+	live := test.NewDeployment()
+	live.SetManagedFields([]metav1.ManagedFieldsEntry{{
+		Manager:    manager,
+		Operation:  metav1.ManagedFieldsOperationUpdate,
+		APIVersion: "apps/v1",
+		FieldsType: "FieldsV1",
+		FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:replicas":{}}}`)},
+	}})
+	res := managedResource{
+		Group:     "apps",
+		Version:   "v1",
+		Kind:      kube.DeploymentKind,
+		Namespace: live.GetNamespace(),
+		Name:      live.GetName(),
+		Live:      live,
+		Target:    test.NewDeployment(),
+	}
+	res.Diff.NormalizedLive = []byte(`{"apiVersion":"apps/v1","kind":"Deployment","spec":{"replicas":3}}`)
+	res.Diff.PredictedLive = []byte(`{"apiVersion":"apps/v1","kind":"Deployment","spec":{"replicas":2}}`)
+	return &comparisonResult{
+		managedResources: []managedResource{res},
+		resources: []v1alpha1.ResourceStatus{{
+			Group:     res.Group,
+			Version:   res.Version,
+			Kind:      res.Kind,
+			Namespace: res.Namespace,
+			Name:      res.Name,
+			Status:    status,
+		}},
+	}
+}
+
+func getDriftHistory(t *testing.T, ctrl *ApplicationController, app *v1alpha1.Application) []*v1alpha1.ResourceDriftEpisode {
+	t.Helper()
+	var history []*v1alpha1.ResourceDriftEpisode
+	require.NoError(t, ctrl.cache.GetAppResourceDriftHistory(app.InstanceName(ctrl.namespace), &history))
+	return history
+}
+
+func TestRecordResourceDrift(t *testing.T) {
+	t.Run("drift healed by self-heal", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+
+		require.NoError(t, ctrl.recordResourceDrift(app, newDriftComparisonResult(v1alpha1.SyncStatusCodeOutOfSync, "kubectl-edit")))
+		history := getDriftHistory(t, ctrl, app)
+		require.Len(t, history, 1)
+		assert.Equal(t, []string{"kubectl-edit"}, history[0].FieldManagers)
+		assert.False(t, history[0].IsHealed())
+
+		// the episode is not duplicated while the resource is still drifted
+		require.NoError(t, ctrl.recordResourceDrift(app, newDriftComparisonResult(v1alpha1.SyncStatusCodeOutOfSync, "kubectl-edit")))
+		require.Len(t, getDriftHistory(t, ctrl, app), 1)
+
+		finishedAt := metav1.NewTime(time.Now().Add(time.Minute))
+		app.Status.OperationState = &v1alpha1.OperationState{
+			Operation:  v1alpha1.Operation{InitiatedBy: v1alpha1.OperationInitiator{Automated: true}},
+			Phase:      synccommon.OperationSucceeded,
+			FinishedAt: &finishedAt,
+			SyncResult: &v1alpha1.SyncOperationResult{Resources: v1alpha1.ResourceResults{{
+				Group: "apps", Version: "v1", Kind: kube.DeploymentKind, Name: "nginx-deployment",
+			}}},
+		}
+		require.NoError(t, ctrl.recordResourceDrift(app, newDriftComparisonResult(v1alpha1.SyncStatusCodeSynced, "kubectl-edit")))
+		history = getDriftHistory(t, ctrl, app)
+		require.Len(t, history, 1)
+		assert.True(t, history[0].IsHealed())
+		assert.Equal(t, v1alpha1.ResourceDriftHealTypeSelfHeal, history[0].HealType)
+	})
+
+	t.Run("drift healed by manual sync", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+
+		require.NoError(t, ctrl.recordResourceDrift(app, newDriftComparisonResult(v1alpha1.SyncStatusCodeOutOfSync, "kubectl-edit")))
+		finishedAt := metav1.NewTime(time.Now().Add(time.Minute))
+		app.Status.OperationState = &v1alpha1.OperationState{
+			Operation:  v1alpha1.Operation{InitiatedBy: v1alpha1.OperationInitiator{Username: "admin"}},
+			Phase:      synccommon.OperationSucceeded,
+			FinishedAt: &finishedAt,
+			SyncResult: &v1alpha1.SyncOperationResult{Resources: v1alpha1.ResourceResults{{
+				Group: "apps", Version: "v1", Kind: kube.DeploymentKind, Name: "nginx-deployment",
+			}}},
+		}
+		require.NoError(t, ctrl.recordResourceDrift(app, newDriftComparisonResult(v1alpha1.SyncStatusCodeSynced, "kubectl-edit")))
+		history := getDriftHistory(t, ctrl, app)
+		require.Len(t, history, 1)
+		assert.Equal(t, v1alpha1.ResourceDriftHealTypeSync, history[0].HealType)
+		assert.Equal(t, "admin", history[0].HealedBy)
+	})
+
+	t.Run("drift healed externally", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+
+		require.NoError(t, ctrl.recordResourceDrift(app, newDriftComparisonResult(v1alpha1.SyncStatusCodeOutOfSync, "kubectl-edit")))
+		require.NoError(t, ctrl.recordResourceDrift(app, &comparisonResult{}))
+		history := getDriftHistory(t, ctrl, app)
+		require.Len(t, history, 1)
+		assert.Equal(t, v1alpha1.ResourceDriftHealTypeExternal, history[0].HealType)
+		assert.Empty(t, history[0].HealedBy)
+	})
+
+	t.Run("desired state change is not a drift", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+
+		require.NoError(t, ctrl.recordResourceDrift(app, newDriftComparisonResult(v1alpha1.SyncStatusCodeOutOfSync, common.ArgoCDSSAManager)))
+		var history []*v1alpha1.ResourceDriftEpisode
+		require.ErrorIs(t, ctrl.cache.GetAppResourceDriftHistory(app.InstanceName(ctrl.namespace), &history), appstatecache.ErrCacheMiss)
+	})
+}
@@ -2,6 +2,10 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
 
 	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
@@ -18,6 +22,11 @@ import (
 )
 
 var (
+	preDeleteHook  = "PreDelete"
+	preDeleteHooks = map[string]string{
+		"argocd.argoproj.io/hook": preDeleteHook,
+		"helm.sh/hook":            "pre-delete",
+	}
 	postDeleteHook  = "PostDelete"
 	postDeleteHooks = map[string]string{
 		"argocd.argoproj.io/hook": postDeleteHook,
@@ -26,14 +35,22 @@ var (
 )
 
 func isHook(obj *unstructured.Unstructured) bool {
-	return hook.IsHook(obj) || isPostDeleteHook(obj)
+	return hook.IsHook(obj) || isPreDeleteHook(obj) || isPostDeleteHook(obj)
+}
+
+func isPreDeleteHook(obj *unstructured.Unstructured) bool {
+	return isDeleteHook(obj, preDeleteHooks)
 }
 
 func isPostDeleteHook(obj *unstructured.Unstructured) bool {
+	return isDeleteHook(obj, postDeleteHooks)
+}
+
+func isDeleteHook(obj *unstructured.Unstructured, hookAnnotations map[string]string) bool {
 	if obj == nil || obj.GetAnnotations() == nil {
 		return false
 	}
-	for k, v := range postDeleteHooks {
+	for k, v := range hookAnnotations {
 		if val, ok := obj.GetAnnotations()[k]; ok && val == v {
 			return true
 		}
@@ -41,10 +58,43 @@ func isPostDeleteHook(obj *unstructured.Unstructured) bool {
 	return false
 }
 
+// executePreDeleteHooks runs the pre-delete hooks of the application and returns true once all of them have succeeded.
+// An error is returned if any of the hooks has failed, which blocks the deletion of the application resources. Failed
+// hooks with the HookFailed delete policy are deleted, and so run again, the other failed hooks are left in place until
+// the user deletes them.
+func (ctrl *ApplicationController) executePreDeleteHooks(app *v1alpha1.Application, proj *v1alpha1.AppProject, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) (bool, error) {
+	done, failedHooks, err := ctrl.executeDeleteHooks(preDeleteHook, isPreDeleteHook, app, proj, liveObjs, config, logCtx)
+	if err != nil || !done {
+		return false, err
+	}
+	if len(failedHooks) > 0 {
+		var messages []string
+		for key, message := range failedHooks {
+			messages = append(messages, message)
+			if err := ctrl.deleteFailedHook(preDeleteHook, liveObjs[key], config, logCtx); err != nil {
+				return false, err
+			}
+		}
+		sort.Strings(messages)
+		return false, fmt.Errorf("%s hooks failed: %s", preDeleteHook, strings.Join(messages, "; "))
+	}
+	if _, err := ctrl.cleanupDeleteHooks(preDeleteHook, isPreDeleteHook, liveObjs, config, logCtx); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Application, proj *v1alpha1.AppProject, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) (bool, error) {
+	done, _, err := ctrl.executeDeleteHooks(postDeleteHook, isPostDeleteHook, app, proj, liveObjs, config, logCtx)
+	return done, err
+}
+
+// executeDeleteHooks creates the hooks of the given type which are missing, and returns true once none of them is
+// progressing anymore, along with the description of the hooks which have failed by resource key.
+func (ctrl *ApplicationController) executeDeleteHooks(hookType string, isHookOfType func(obj *unstructured.Unstructured) bool, app *v1alpha1.Application, proj *v1alpha1.AppProject, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) (bool, map[kube.ResourceKey]string, error) {
 	appLabelKey, err := ctrl.settingsMgr.GetAppInstanceLabelKey()
 	if err != nil {
-		return false, err
+		return false, nil, err
 	}
 	var revisions []string
 	for _, src := range app.Spec.GetSources() {
@@ -53,11 +103,11 @@ func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Applicat
 
 	targets, _, _, err := ctrl.appStateManager.GetRepoObjs(app, app.Spec.GetSources(), appLabelKey, revisions, false, false, false, proj, true)
 	if err != nil {
-		return false, err
+		return false, nil, err
 	}
 	runningHooks := map[kube.ResourceKey]*unstructured.Unstructured{}
 	for key, obj := range liveObjs {
-		if isPostDeleteHook(obj) {
+		if isHookOfType(obj) {
 			runningHooks[key] = obj
 		}
 	}
@@ -67,7 +117,7 @@ func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Applicat
 		if obj.GetNamespace() == "" {
 			obj.SetNamespace(app.Spec.Destination.Namespace)
 		}
-		if !isPostDeleteHook(obj) {
+		if !isHookOfType(obj) {
 			continue
 		}
 		if runningHook := runningHooks[kube.GetResourceKey(obj)]; runningHook == nil {
@@ -78,25 +128,26 @@ func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Applicat
 	for _, obj := range expectedHook {
 		_, err = ctrl.kubectl.CreateResource(context.Background(), config, obj.GroupVersionKind(), obj.GetName(), obj.GetNamespace(), obj, metav1.CreateOptions{})
 		if err != nil {
-			return false, err
+			return false, nil, err
 		}
 		createdCnt++
 	}
 	if createdCnt > 0 {
-		logCtx.Infof("Created %d post-delete hooks", createdCnt)
-		return false, nil
+		logCtx.Infof("Created %d %s hooks", createdCnt, hookType)
+		return false, nil, nil
 	}
 	resourceOverrides, err := ctrl.settingsMgr.GetResourceOverrides()
 	if err != nil {
-		return false, err
+		return false, nil, err
 	}
 	healthOverrides := lua.ResourceHealthOverrides(resourceOverrides)
 
 	progressingHooksCnt := 0
-	for _, obj := range runningHooks {
+	failedHooks := map[kube.ResourceKey]string{}
+	for key, obj := range runningHooks {
 		hookHealth, err := health.GetResourceHealth(obj, healthOverrides)
 		if err != nil {
-			return false, err
+			return false, nil, err
 		}
 		if hookHealth == nil {
 			logCtx.WithFields(log.Fields{
@@ -110,19 +161,37 @@ func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Applicat
 				Status: health.HealthStatusHealthy,
 			}
 		}
-		if hookHealth.Status == health.HealthStatusProgressing {
+		switch hookHealth.Status {
+		case health.HealthStatusProgressing:
 			progressingHooksCnt++
+		case health.HealthStatusDegraded:
+			failedHooks[key] = fmt.Sprintf("%s %s/%s: %s", obj.GetKind(), obj.GetNamespace(), obj.GetName(), hookHealth.Message)
 		}
 	}
 	if progressingHooksCnt > 0 {
-		logCtx.Infof("Waiting for %d post-delete hooks to complete", progressingHooksCnt)
-		return false, nil
+		logCtx.Infof("Waiting for %d %s hooks to complete", progressingHooksCnt, hookType)
+		return false, nil, nil
 	}
 
-	return true, nil
+	return true, failedHooks, nil
+}
+
+// deleteFailedHook deletes the given failed hook if it has the HookFailed delete policy
+func (ctrl *ApplicationController) deleteFailedHook(hookType string, obj *unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) error {
+	if obj.GetDeletionTimestamp() != nil || !slices.Contains(hook.DeletePolicies(obj), common.HookDeletePolicyHookFailed) {
+		return nil
+	}
+	logCtx.Infof("Deleting failed %s hook %s/%s", hookType, obj.GetNamespace(), obj.GetName())
+	return ctrl.kubectl.DeleteResource(context.Background(), config, obj.GroupVersionKind(), obj.GetName(), obj.GetNamespace(), metav1.DeleteOptions{})
 }
 
 func (ctrl *ApplicationController) cleanupPostDeleteHooks(liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) (bool, error) {
+	return ctrl.cleanupDeleteHooks(postDeleteHook, isPostDeleteHook, liveObjs, config, logCtx)
+}
+
+// cleanupDeleteHooks deletes the hooks of the given type according to their delete policies, and returns true once
+// they are gone.
+func (ctrl *ApplicationController) cleanupDeleteHooks(hookType string, isHookOfType func(obj *unstructured.Unstructured) bool, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) (bool, error) {
 	resourceOverrides, err := ctrl.settingsMgr.GetResourceOverrides()
 	if err != nil {
 		return false, err
@@ -133,7 +202,7 @@ func (ctrl *ApplicationController) cleanupPostDeleteHooks(liveObjs map[kube.Reso
 	aggregatedHealth := health.HealthStatusHealthy
 	var hooks []*unstructured.Unstructured
 	for _, obj := range liveObjs {
-		if !isPostDeleteHook(obj) {
+		if !isHookOfType(obj) {
 			continue
 		}
 		hookHealth, err := health.GetResourceHealth(obj, healthOverrides)
@@ -160,7 +229,7 @@ func (ctrl *ApplicationController) cleanupPostDeleteHooks(liveObjs map[kube.Reso
 			if obj.GetDeletionTimestamp() != nil {
 				continue
 			}
-			logCtx.Infof("Deleting post-delete hook %s/%s", obj.GetNamespace(), obj.GetName())
+			logCtx.Infof("Deleting %s hook %s/%s", hookType, obj.GetNamespace(), obj.GetName())
 			err = ctrl.kubectl.DeleteResource(context.Background(), config, obj.GroupVersionKind(), obj.GetName(), obj.GetNamespace(), metav1.DeleteOptions{})
 			if err != nil {
 				return false, err
@@ -168,7 +237,7 @@ func (ctrl *ApplicationController) cleanupPostDeleteHooks(liveObjs map[kube.Reso
 		}
 	}
 	if pendingDeletionCount > 0 {
-		logCtx.Infof("Waiting for %d post-delete hooks to be deleted", pendingDeletionCount)
+		logCtx.Infof("Waiting for %d %s hooks to be deleted", pendingDeletionCount, hookType)
 		return false, nil
 	}
 	return true, nil
@@ -0,0 +1,70 @@
+package metrics
+
+import (
+	"sync"
+	"time"
+)
+
+// eventsRateWindow is the duration over which the rate of cluster events is measured
+const eventsRateWindow = time.Minute
+
+// HasClusterEventsRate is implemented by sources reporting the rate of watch events received from each cluster.
+type HasClusterEventsRate interface {
+	GetClusterEventsPerMinute(server string) int64
+}
+
+// clusterEventsRate keeps track of the number of watch events received from each cluster in order to report the
+// per minute events rate of the clusters.
+type clusterEventsRate struct {
+	lock     sync.Mutex
+	clusters map[string]*eventsWindow
+	now      func() time.Time
+}
+
+type eventsWindow struct {
+	start time.Time
+	count int64
+	// rate is the events per minute rate measured over the previous window
+	rate int64
+}
+
+func newClusterEventsRate() *clusterEventsRate {
+	return &clusterEventsRate{clusters: make(map[string]*eventsWindow), now: time.Now}
+}
+
+// inc records a single event received from the given cluster.
+func (r *clusterEventsRate) inc(server string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	now := r.now()
+	window, ok := r.clusters[server]
+	if !ok {
+		r.clusters[server] = &eventsWindow{start: now, count: 1}
+		return
+	}
+	if elapsed := now.Sub(window.start); elapsed >= eventsRateWindow {
+		window.rate = perMinute(window.count, elapsed)
+		window.start = now
+		window.count = 0
+	}
+	window.count++
+}
+
+// perMinute returns the events per minute rate of the given cluster.
+func (r *clusterEventsRate) perMinute(server string) int64 {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	window, ok := r.clusters[server]
+	if !ok {
+		return 0
+	}
+	// the current window is complete but no event has been received since: the rate is based on the current window
+	if elapsed := r.now().Sub(window.start); elapsed >= eventsRateWindow {
+		return perMinute(window.count, elapsed)
+	}
+	return window.rate
+}
+
+func perMinute(count int64, elapsed time.Duration) int64 {
+	return count * int64(time.Minute) / int64(elapsed)
+}
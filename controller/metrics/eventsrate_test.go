@@ -0,0 +1,34 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterEventsRate(t *testing.T) {
+	now := time.Now()
+	rate := newClusterEventsRate()
+	rate.now = func() time.Time { return now }
+
+	assert.Equal(t, int64(0), rate.perMinute("https://localhost:6443"))
+
+	for i := 0; i < 30; i++ {
+		rate.inc("https://localhost:6443")
+	}
+	// the first window is not complete yet
+	assert.Equal(t, int64(0), rate.perMinute("https://localhost:6443"))
+
+	now = now.Add(2 * time.Minute)
+	// the window is complete but no event has been received since
+	assert.Equal(t, int64(15), rate.perMinute("https://localhost:6443"))
+
+	rate.inc("https://localhost:6443")
+	assert.Equal(t, int64(15), rate.perMinute("https://localhost:6443"))
+
+	now = now.Add(time.Minute)
+	rate.inc("https://localhost:6443")
+	assert.Equal(t, int64(1), rate.perMinute("https://localhost:6443"))
+	assert.Equal(t, int64(0), rate.perMinute("https://kubernetes.default.svc"))
+}
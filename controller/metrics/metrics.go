@@ -44,9 +44,11 @@ type MetricsServer struct {
 	redisRequestHistogram             *prometheus.HistogramVec
 	resourceEventsProcessingHistogram *prometheus.HistogramVec
 	resourceEventsNumberGauge         *prometheus.GaugeVec
+	queueLatencyHistogram             *prometheus.HistogramVec
 	registry                          *prometheus.Registry
 	hostname                          string
 	cron                              *cron.Cron
+	clusterEventsRate                 *clusterEventsRate
 }
 
 const (
@@ -156,6 +158,15 @@ var (
 		Name: "argocd_resource_events_processed_in_batch",
 		Help: "Number of resource events processed in batch",
 	}, []string{"server"})
+
+	queueLatencyHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "argocd_app_queue_latency_seconds",
+			Help:    "Time applications spent in the controller queues before being processed, per priority class.",
+			Buckets: []float64{0.01, 0.1, 0.5, 1, 5, 10, 30, 60, 300},
+		},
+		[]string{"queue", "priority"},
+	)
 )
 
 // NewMetricsServer returns a new prometheus server which collects application metrics
@@ -208,6 +219,7 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 	registry.MustRegister(redisRequestHistogram)
 	registry.MustRegister(resourceEventsProcessingHistogram)
 	registry.MustRegister(resourceEventsNumberGauge)
+	registry.MustRegister(queueLatencyHistogram)
 
 	kubectl.RegisterWithClientGo()
 	kubectl.RegisterWithPrometheus(registry)
@@ -230,7 +242,9 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 		redisRequestHistogram:             redisRequestHistogram,
 		resourceEventsProcessingHistogram: resourceEventsProcessingHistogram,
 		resourceEventsNumberGauge:         resourceEventsNumberGauge,
+		queueLatencyHistogram:             queueLatencyHistogram,
 		hostname:                          hostname,
+		clusterEventsRate:                 newClusterEventsRate(),
 		// This cron is used to expire the metrics cache.
 		// Currently clearing the metrics cache is logging and deleting from the map
 		// so there is no possibility of panic, but we will add a chain to keep robfig/cron v1 behavior.
@@ -281,6 +295,12 @@ func (m *MetricsServer) SetOrphanedResourcesMetric(app *argoappv1.Application, n
 // IncClusterEventsCount increments the number of cluster events
 func (m *MetricsServer) IncClusterEventsCount(server, group, kind string) {
 	m.clusterEventsCounter.WithLabelValues(server, group, kind).Inc()
+	m.clusterEventsRate.inc(server)
+}
+
+// GetClusterEventsPerMinute returns the rate of events received from the cluster during the last minute
+func (m *MetricsServer) GetClusterEventsPerMinute(server string) int64 {
+	return m.clusterEventsRate.perMinute(server)
 }
 
 // IncKubernetesRequest increments the kubernetes requests counter for an application
@@ -314,6 +334,11 @@ func (m *MetricsServer) ObserveResourceEventsProcessingDuration(server string, d
 	m.resourceEventsNumberGauge.WithLabelValues(server).Set(float64(processedEventsNumber))
 }
 
+// ObserveQueueLatency observes the time an application spent in the given controller queue
+func (m *MetricsServer) ObserveQueueLatency(queue string, priority string, latency time.Duration) {
+	m.queueLatencyHistogram.WithLabelValues(queue, priority).Observe(latency.Seconds())
+}
+
 // IncReconcile increments the reconcile counter for an application
 func (m *MetricsServer) IncReconcile(app *argoappv1.Application, destServer string, duration time.Duration) {
 	m.reconcileHistogram.WithLabelValues(app.Namespace, destServer).Observe(duration.Seconds())
@@ -344,6 +369,7 @@ func (m *MetricsServer) SetExpiration(cacheExpiration time.Duration) error {
 		m.redisRequestHistogram.Reset()
 		m.resourceEventsProcessingHistogram.Reset()
 		m.resourceEventsNumberGauge.Reset()
+		m.queueLatencyHistogram.Reset()
 		kubectl.ResetAll()
 	})
 	if err != nil {
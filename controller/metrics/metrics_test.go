@@ -600,6 +600,41 @@ argocd_app_reconcile_count{dest_server="https://localhost:6443",namespace="argoc
 	assertMetricsPrinted(t, appReconcileMetrics, body)
 }
 
+func TestQueueLatencyMetrics(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	mockDB := mocks.NewArgoDB(t)
+	metricsServ, err := NewMetricsServer("localhost:8082", appLister, appFilter, noOpHealthCheck, []string{}, []string{}, mockDB)
+	require.NoError(t, err)
+
+	queueLatencyMetrics := `
+# HELP argocd_app_queue_latency_seconds Time applications spent in the controller queues before being processed, per priority class.
+# TYPE argocd_app_queue_latency_seconds histogram
+argocd_app_queue_latency_seconds_bucket{priority="critical",queue="app_reconciliation_queue",le="0.01"} 0
+argocd_app_queue_latency_seconds_bucket{priority="critical",queue="app_reconciliation_queue",le="0.1"} 0
+argocd_app_queue_latency_seconds_bucket{priority="critical",queue="app_reconciliation_queue",le="0.5"} 0
+argocd_app_queue_latency_seconds_bucket{priority="critical",queue="app_reconciliation_queue",le="1"} 0
+argocd_app_queue_latency_seconds_bucket{priority="critical",queue="app_reconciliation_queue",le="5"} 1
+argocd_app_queue_latency_seconds_bucket{priority="critical",queue="app_reconciliation_queue",le="10"} 1
+argocd_app_queue_latency_seconds_bucket{priority="critical",queue="app_reconciliation_queue",le="30"} 1
+argocd_app_queue_latency_seconds_bucket{priority="critical",queue="app_reconciliation_queue",le="60"} 1
+argocd_app_queue_latency_seconds_bucket{priority="critical",queue="app_reconciliation_queue",le="300"} 1
+argocd_app_queue_latency_seconds_bucket{priority="critical",queue="app_reconciliation_queue",le="+Inf"} 1
+argocd_app_queue_latency_seconds_sum{priority="critical",queue="app_reconciliation_queue"} 2
+argocd_app_queue_latency_seconds_count{priority="critical",queue="app_reconciliation_queue"} 1
+`
+	metricsServ.ObserveQueueLatency("app_reconciliation_queue", "critical", 2*time.Second)
+
+	req, err := http.NewRequest(http.MethodGet, "/metrics", http.NoBody)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	body := rr.Body.String()
+	log.Println(body)
+	assertMetricsPrinted(t, queueLatencyMetrics, body)
+}
+
 func TestOrphanedResourcesMetric(t *testing.T) {
 	cancel, appLister := newFakeLister()
 	defer cancel()
@@ -0,0 +1,120 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/controller/metrics"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+// orphanedResourceState holds what the controller knows about an orphaned resource of an application
+type orphanedResourceState struct {
+	// orphanedSince is the time at which the resource was first observed orphaned
+	orphanedSince time.Time
+	// resourceVersion is the version of the resource when the cleanup policy was last evaluated
+	resourceVersion string
+	// matches is true if the resource matches the cleanup policy of the project
+	matches bool
+}
+
+// cleanupOrphanedResources deletes the orphaned resources of the application which match the cleanup policy of its
+// project and have been orphaned for longer than the grace period. The time at which resources became orphaned is
+// only kept in memory, so the grace period starts over when the controller restarts.
+func (ctrl *ApplicationController) cleanupOrphanedResources(destCluster *appv1.Cluster, app *appv1.Application, proj *appv1.AppProject, orphanedNodes []appv1.ResourceNode) {
+	appKey := app.Namespace + "/" + app.Name
+	if proj.Spec.OrphanedResources == nil || proj.Spec.OrphanedResources.Cleanup == nil {
+		ctrl.orphanedResources.Delete(appKey)
+		return
+	}
+	logCtx := log.WithFields(applog.GetAppLogFields(app))
+	cleanup := proj.Spec.OrphanedResources.Cleanup
+	gracePeriod, err := cleanup.GetGracePeriod()
+	if err != nil {
+		logCtx.Warnf("Skipping orphaned resources cleanup: %v", err)
+		return
+	}
+
+	var previous map[kube.ResourceKey]*orphanedResourceState
+	if val, ok := ctrl.orphanedResources.Load(appKey); ok {
+		previous = val.(map[kube.ResourceKey]*orphanedResourceState)
+	}
+	now := time.Now()
+	current := make(map[kube.ResourceKey]*orphanedResourceState)
+	var expired []appv1.ResourceNode
+	for _, node := range orphanedNodes {
+		// children are garbage collected with their top level resource
+		if len(node.ParentRefs) > 0 {
+			continue
+		}
+		key := kube.NewResourceKey(node.Group, node.Kind, node.Namespace, node.Name)
+		state, ok := previous[key]
+		if !ok {
+			state = &orphanedResourceState{orphanedSince: now}
+		}
+		current[key] = state
+		if now.Sub(state.orphanedSince) >= gracePeriod {
+			expired = append(expired, node)
+		}
+	}
+	ctrl.orphanedResources.Store(appKey, current)
+	if len(expired) == 0 {
+		return
+	}
+
+	clusterRESTConfig, err := destCluster.RESTConfig()
+	if err != nil {
+		logCtx.Warnf("Skipping orphaned resources cleanup: %v", err)
+		return
+	}
+	config := metrics.AddMetricsTransportWrapper(ctrl.metricsServer, app, clusterRESTConfig)
+	for _, node := range expired {
+		key := kube.NewResourceKey(node.Group, node.Kind, node.Namespace, node.Name)
+		state := current[key]
+		if state.resourceVersion == node.ResourceVersion && state.resourceVersion != "" && !state.matches {
+			continue
+		}
+		// the labels of the resource are not part of the resource tree, so the live object is retrieved each time
+		// the resource changes
+		live, err := ctrl.kubectl.GetResource(context.Background(), config, node.GroupKindVersion(), node.Name, node.Namespace)
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				logCtx.Warnf("Failed to get orphaned resource %s: %v", key.String(), err)
+			}
+			continue
+		}
+		matches, err := cleanup.Matches(key, live.GetLabels())
+		if err != nil {
+			logCtx.Warnf("Skipping orphaned resources cleanup: %v", err)
+			return
+		}
+		state.resourceVersion = live.GetResourceVersion()
+		state.matches = matches
+		if !matches || live.GetDeletionTimestamp() != nil {
+			continue
+		}
+		uid := live.GetUID()
+		propagationPolicy := metav1.DeletePropagationBackground
+		err = ctrl.kubectl.DeleteResource(context.Background(), config, node.GroupKindVersion(), node.Name, node.Namespace, metav1.DeleteOptions{
+			PropagationPolicy: &propagationPolicy,
+			Preconditions:     &metav1.Preconditions{UID: &uid},
+		})
+		if err != nil {
+			logCtx.Warnf("Failed to delete orphaned resource %s: %v", key.String(), err)
+			continue
+		}
+		delete(current, key)
+		message := fmt.Sprintf("Deleted orphaned resource %s/%s '%s' after %s", node.Group, node.Kind, node.Name, now.Sub(state.orphanedSince).Round(time.Second))
+		logCtx.Info(message)
+		ctrl.logAppEvent(context.TODO(), app, argo.EventInfo{Reason: argo.EventReasonResourceDeleted, Type: corev1.EventTypeNormal}, message)
+	}
+}
@@ -0,0 +1,104 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func newOrphanedConfigMap(name string, labels map[string]string) *unstructured.Unstructured {
+	obj := test.NewConfigMap()
+	obj.SetName(name)
+	obj.SetLabels(labels)
+	obj.SetResourceVersion("1")
+	return obj
+}
+
+func newOrphanedNode(obj *unstructured.Unstructured) v1alpha1.ResourceNode {
+	return v1alpha1.ResourceNode{
+		ResourceRef: v1alpha1.ResourceRef{
+			Version:   "v1",
+			Kind:      obj.GetKind(),
+			Namespace: obj.GetNamespace(),
+			Name:      obj.GetName(),
+		},
+		ResourceVersion: obj.GetResourceVersion(),
+	}
+}
+
+func TestCleanupOrphanedResources(t *testing.T) {
+	temporary := newOrphanedConfigMap("temporary", map[string]string{"temporary": "true"})
+	permanent := newOrphanedConfigMap("permanent", nil)
+	liveObjs := map[string]*unstructured.Unstructured{temporary.GetName(): temporary, permanent.GetName(): permanent}
+
+	newController := func() (*ApplicationController, *MockKubectl) {
+		app := newFakeApp()
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+		kubectl := ctrl.kubectl.(*MockKubectl)
+		kubectl.Kubectl.(*kubetest.MockKubectlCmd).WithGetResourceFunc(func(_ context.Context, _ *rest.Config, _ schema.GroupVersionKind, name string, _ string) (*unstructured.Unstructured, error) {
+			return liveObjs[name], nil
+		})
+		return ctrl, kubectl
+	}
+	newProject := func(gracePeriod string) *v1alpha1.AppProject {
+		proj := defaultProj.DeepCopy()
+		proj.Spec.OrphanedResources = &v1alpha1.OrphanedResourcesMonitorSettings{Cleanup: &v1alpha1.OrphanedResourcesCleanup{
+			Selector:    &metav1.LabelSelector{MatchLabels: map[string]string{"temporary": "true"}},
+			GracePeriod: gracePeriod,
+		}}
+		return proj
+	}
+	destCluster := &v1alpha1.Cluster{Server: test.FakeClusterURL}
+	nodes := []v1alpha1.ResourceNode{newOrphanedNode(temporary), newOrphanedNode(permanent)}
+
+	t.Run("matching resources are deleted after the grace period", func(t *testing.T) {
+		ctrl, kubectl := newController()
+		ctrl.cleanupOrphanedResources(destCluster, newFakeApp(), newProject("0s"), nodes)
+		assert.Equal(t, []kube.ResourceKey{kube.GetResourceKey(temporary)}, kubectl.DeletedResources)
+	})
+
+	t.Run("resources are not deleted during the grace period", func(t *testing.T) {
+		ctrl, kubectl := newController()
+		ctrl.cleanupOrphanedResources(destCluster, newFakeApp(), newProject("1h"), nodes)
+		assert.Empty(t, kubectl.DeletedResources)
+	})
+
+	t.Run("grace period starts when the resource is first observed orphaned", func(t *testing.T) {
+		ctrl, kubectl := newController()
+		app := newFakeApp()
+		ctrl.cleanupOrphanedResources(destCluster, app, newProject("1h"), nodes)
+		val, ok := ctrl.orphanedResources.Load(app.Namespace + "/" + app.Name)
+		assert.True(t, ok)
+		for _, state := range val.(map[kube.ResourceKey]*orphanedResourceState) {
+			state.orphanedSince = state.orphanedSince.Add(-2 * time.Hour)
+		}
+		ctrl.cleanupOrphanedResources(destCluster, app, newProject("1h"), nodes)
+		assert.Equal(t, []kube.ResourceKey{kube.GetResourceKey(temporary)}, kubectl.DeletedResources)
+	})
+
+	t.Run("child resources are not deleted", func(t *testing.T) {
+		ctrl, kubectl := newController()
+		child := newOrphanedNode(temporary)
+		child.ParentRefs = []v1alpha1.ResourceRef{{Kind: "ConfigMap", Name: "parent"}}
+		ctrl.cleanupOrphanedResources(destCluster, newFakeApp(), newProject("0s"), []v1alpha1.ResourceNode{child})
+		assert.Empty(t, kubectl.DeletedResources)
+	})
+
+	t.Run("no cleanup policy", func(t *testing.T) {
+		ctrl, kubectl := newController()
+		ctrl.cleanupOrphanedResources(destCluster, newFakeApp(), &defaultProj, nodes)
+		assert.Empty(t, kubectl.DeletedResources)
+	})
+}
@@ -0,0 +1,163 @@
+package priorityqueue
+
+import (
+	"strings"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+)
+
+// Priority is the priority class in which an item is queued. Items of a higher priority class are handed out before
+// items of lower priority classes.
+type Priority int
+
+const (
+	PriorityLow Priority = iota
+	PriorityNormal
+	PriorityHigh
+	PriorityCritical
+
+	numPriorities = int(PriorityCritical) + 1
+)
+
+var priorityNames = [numPriorities]string{"low", "normal", "high", "critical"}
+
+// String returns the name of the priority class
+func (p Priority) String() string {
+	if p < PriorityLow || int(p) >= numPriorities {
+		return "unknown"
+	}
+	return priorityNames[p]
+}
+
+// ParsePriority parses the name of a priority class. It returns false if the name is not known.
+func ParsePriority(name string) (Priority, bool) {
+	for i, n := range priorityNames {
+		if strings.EqualFold(strings.TrimSpace(name), n) {
+			return Priority(i), true
+		}
+	}
+	return PriorityNormal, false
+}
+
+type entry[T comparable] struct {
+	item     T
+	addedAt  time.Time
+	priority Priority
+}
+
+// Queue orders the items of a workqueue by priority class and in arrival order within each class. To prevent
+// starvation, the oldest item of a lower priority class is handed out once it has waited longer than the starvation
+// timeout. Queue is not thread-safe: the workqueue calls it while holding its own lock.
+type Queue[T comparable] struct {
+	priorityFunc      func(item T) Priority
+	starvationTimeout time.Duration
+	observeLatency    func(priority Priority, latency time.Duration)
+	clock             clock.PassiveClock
+
+	classes [numPriorities][]*entry[T]
+	queued  map[T]*entry[T]
+}
+
+var _ workqueue.Queue[string] = &Queue[string]{}
+
+// New returns a Queue which classifies items using the given function. A starvation timeout of zero disables
+// starvation protection. observeLatency, if not nil, is called with the time each item spent in the queue.
+func New[T comparable](priorityFunc func(item T) Priority, starvationTimeout time.Duration, observeLatency func(priority Priority, latency time.Duration)) *Queue[T] {
+	return &Queue[T]{
+		priorityFunc:      priorityFunc,
+		starvationTimeout: starvationTimeout,
+		observeLatency:    observeLatency,
+		clock:             clock.RealClock{},
+		queued:            map[T]*entry[T]{},
+	}
+}
+
+// NewRateLimitingQueue returns a rate limiting workqueue which hands out items in the order of the given Queue. The
+// queue reports the same workqueue metrics as a queue created with workqueue.NewTypedRateLimitingQueueWithConfig.
+func NewRateLimitingQueue[T comparable](name string, rateLimiter workqueue.TypedRateLimiter[T], queue *Queue[T]) workqueue.TypedRateLimitingInterface[T] {
+	return workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter, workqueue.TypedRateLimitingQueueConfig[T]{
+		Name: name,
+		DelayingQueue: workqueue.NewTypedDelayingQueueWithConfig(workqueue.TypedDelayingQueueConfig[T]{
+			Name:  name,
+			Queue: workqueue.NewTypedWithConfig(workqueue.TypedQueueConfig[T]{Name: name, Queue: queue}),
+		}),
+	})
+}
+
+// Push adds an item to the end of its priority class
+func (q *Queue[T]) Push(item T) {
+	e := &entry[T]{item: item, addedAt: q.clock.Now(), priority: q.priority(item)}
+	q.classes[e.priority] = append(q.classes[e.priority], e)
+	q.queued[item] = e
+}
+
+// Touch is called when an item which is still queued is added again. If the priority of the item has been raised in
+// the meantime, the item moves to the end of its new priority class while keeping its original arrival time.
+func (q *Queue[T]) Touch(item T) {
+	e, ok := q.queued[item]
+	if !ok {
+		return
+	}
+	priority := q.priority(item)
+	if priority <= e.priority {
+		return
+	}
+	class := q.classes[e.priority]
+	for i := range class {
+		if class[i] == e {
+			q.classes[e.priority] = append(class[:i], class[i+1:]...)
+			break
+		}
+	}
+	e.priority = priority
+	q.classes[priority] = append(q.classes[priority], e)
+}
+
+// Len returns the number of queued items
+func (q *Queue[T]) Len() int {
+	return len(q.queued)
+}
+
+// Pop removes and returns the next item. It must only be called if Len is greater than zero.
+func (q *Queue[T]) Pop() T {
+	now := q.clock.Now()
+	next := -1
+	for p := numPriorities - 1; p >= 0; p-- {
+		if len(q.classes[p]) == 0 {
+			continue
+		}
+		if next == -1 {
+			next = p
+			if q.starvationTimeout <= 0 {
+				break
+			}
+			continue
+		}
+		// a lower priority item which has waited too long is handed out first, oldest first
+		head := q.classes[p][0]
+		if now.Sub(head.addedAt) >= q.starvationTimeout && head.addedAt.Before(q.classes[next][0].addedAt) {
+			next = p
+		}
+	}
+	e := q.classes[next][0]
+	q.classes[next][0] = nil
+	q.classes[next] = q.classes[next][1:]
+	delete(q.queued, e.item)
+	if q.observeLatency != nil {
+		q.observeLatency(e.priority, now.Sub(e.addedAt))
+	}
+	return e.item
+}
+
+func (q *Queue[T]) priority(item T) Priority {
+	if q.priorityFunc == nil {
+		return PriorityNormal
+	}
+	priority := q.priorityFunc(item)
+	if priority < PriorityLow || int(priority) >= numPriorities {
+		return PriorityNormal
+	}
+	return priority
+}
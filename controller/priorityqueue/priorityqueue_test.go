@@ -0,0 +1,129 @@
+package priorityqueue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/util/workqueue"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func newTestQueue(priorities map[string]Priority, starvationTimeout time.Duration) (*Queue[string], *clocktesting.FakePassiveClock) {
+	q := New(func(item string) Priority {
+		if p, ok := priorities[item]; ok {
+			return p
+		}
+		return PriorityNormal
+	}, starvationTimeout, nil)
+	fakeClock := clocktesting.NewFakePassiveClock(time.Now())
+	q.clock = fakeClock
+	return q, fakeClock
+}
+
+func popAll(q *Queue[string]) []string {
+	var items []string
+	for q.Len() > 0 {
+		items = append(items, q.Pop())
+	}
+	return items
+}
+
+func TestParsePriority(t *testing.T) {
+	p, ok := ParsePriority("critical")
+	assert.True(t, ok)
+	assert.Equal(t, PriorityCritical, p)
+
+	p, ok = ParsePriority(" Low ")
+	assert.True(t, ok)
+	assert.Equal(t, PriorityLow, p)
+
+	p, ok = ParsePriority("urgent")
+	assert.False(t, ok)
+	assert.Equal(t, PriorityNormal, p)
+
+	assert.Equal(t, "high", PriorityHigh.String())
+	assert.Equal(t, "unknown", Priority(42).String())
+}
+
+func TestQueue_Pop(t *testing.T) {
+	t.Run("PriorityOrder", func(t *testing.T) {
+		q, _ := newTestQueue(map[string]Priority{"dev-1": PriorityLow, "dev-2": PriorityLow, "prod": PriorityCritical, "staging": PriorityHigh}, 0)
+		for _, item := range []string{"dev-1", "default", "dev-2", "staging", "prod"} {
+			q.Push(item)
+		}
+		assert.Equal(t, 5, q.Len())
+		assert.Equal(t, []string{"prod", "staging", "default", "dev-1", "dev-2"}, popAll(q))
+	})
+	t.Run("UnknownPriority", func(t *testing.T) {
+		q, _ := newTestQueue(map[string]Priority{"a": Priority(-1), "b": Priority(10)}, 0)
+		q.Push("a")
+		q.Push("b")
+		assert.Equal(t, []string{"a", "b"}, popAll(q))
+	})
+	t.Run("StarvationProtection", func(t *testing.T) {
+		q, fakeClock := newTestQueue(map[string]Priority{"dev": PriorityLow, "prod-1": PriorityCritical, "prod-2": PriorityCritical}, time.Minute)
+		q.Push("dev")
+		fakeClock.SetTime(fakeClock.Now().Add(30 * time.Second))
+		q.Push("prod-1")
+		assert.Equal(t, "prod-1", q.Pop())
+
+		fakeClock.SetTime(fakeClock.Now().Add(time.Minute))
+		q.Push("prod-2")
+		assert.Equal(t, []string{"dev", "prod-2"}, popAll(q))
+	})
+	t.Run("ObserveLatency", func(t *testing.T) {
+		observed := map[Priority]time.Duration{}
+		q := New(func(string) Priority { return PriorityHigh }, 0, func(priority Priority, latency time.Duration) {
+			observed[priority] = latency
+		})
+		fakeClock := clocktesting.NewFakePassiveClock(time.Now())
+		q.clock = fakeClock
+		q.Push("app")
+		fakeClock.SetTime(fakeClock.Now().Add(5 * time.Second))
+		q.Pop()
+		assert.Equal(t, map[Priority]time.Duration{PriorityHigh: 5 * time.Second}, observed)
+	})
+}
+
+func TestQueue_Touch(t *testing.T) {
+	priorities := map[string]Priority{"a": PriorityLow, "b": PriorityLow}
+	q, _ := newTestQueue(priorities, 0)
+	q.Push("a")
+	q.Push("b")
+	q.Push("c")
+
+	// raising the priority of a queued item moves it ahead
+	priorities["b"] = PriorityCritical
+	q.Touch("b")
+	// lowering it does not
+	priorities["c"] = PriorityLow
+	q.Touch("c")
+	q.Touch("unknown")
+
+	assert.Equal(t, []string{"b", "c", "a"}, popAll(q))
+}
+
+func TestNewRateLimitingQueue(t *testing.T) {
+	priorities := map[string]Priority{"prod": PriorityCritical}
+	q, _ := newTestQueue(priorities, 0)
+	queue := NewRateLimitingQueue("test_priority_queue", workqueue.DefaultTypedControllerRateLimiter[string](), q)
+	defer queue.ShutDown()
+
+	queue.Add("dev")
+	queue.Add("prod")
+	queue.Add("dev")
+	assert.Equal(t, 2, queue.Len())
+
+	item, shutdown := queue.Get()
+	require.False(t, shutdown)
+	assert.Equal(t, "prod", item)
+	queue.Done(item)
+
+	item, shutdown = queue.Get()
+	require.False(t, shutdown)
+	assert.Equal(t, "dev", item)
+	queue.Done(item)
+	assert.Equal(t, 0, queue.Len())
+}
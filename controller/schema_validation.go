@@ -0,0 +1,200 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/apiserver/pkg/endpoints/deprecation"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/kube-openapi/pkg/util/proto"
+	"k8s.io/kube-openapi/pkg/util/proto/validation"
+	"k8s.io/kubectl/pkg/util/openapi"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// compareOptionSchemaValidation enables validating the target resources against the OpenAPI schema of the destination
+// cluster
+const compareOptionSchemaValidation = "SchemaValidation=true"
+
+// maxSchemaViolations is the maximum number of schema violations reported per resource
+const maxSchemaViolations = 10
+
+// preserveUnknownFieldsExtension marks schemas of objects which accept fields not declared in the schema
+const preserveUnknownFieldsExtension = "x-kubernetes-preserve-unknown-fields"
+
+// validateTargetSchemas validates the target resources against the OpenAPI schema of the destination cluster
+func (m *appStateManager) validateTargetSchemas(destCluster *v1alpha1.Cluster, targetObjs []*unstructured.Unstructured) []v1alpha1.ApplicationCondition {
+	clusterCache, err := m.liveStateCache.GetClusterCache(destCluster)
+	if err != nil {
+		now := metav1.Now()
+		return []v1alpha1.ApplicationCondition{{
+			Type:               v1alpha1.ApplicationConditionComparisonError,
+			Message:            "Failed to load OpenAPI schema of destination cluster: " + err.Error(),
+			LastTransitionTime: &now,
+		}}
+	}
+	resources := clusterCache.GetOpenAPISchema()
+	if resources == nil {
+		return nil
+	}
+	return validateSchemas(targetObjs, resources, clusterCache.GetAPIResources(), clusterCache.GetServerVersion())
+}
+
+// validateSchemas returns an error condition for every resource which uses an API version not served by the cluster or
+// does not match its schema, and a warning condition for every resource which uses an API version deprecated in the
+// given Kubernetes version of the cluster. Resources of kinds unknown to the cluster, e.g. of custom resource definitions
+// which are not installed yet, are skipped.
+func validateSchemas(targetObjs []*unstructured.Unstructured, resources openapi.Resources, apiResources []kube.APIResourceInfo, serverVersion string) []v1alpha1.ApplicationCondition {
+	servedVersions := map[schema.GroupKind][]string{}
+	for _, apiResource := range apiResources {
+		versions := servedVersions[apiResource.GroupKind]
+		if !slices.Contains(versions, apiResource.GroupVersionResource.Version) {
+			servedVersions[apiResource.GroupKind] = append(versions, apiResource.GroupVersionResource.Version)
+		}
+	}
+	preserveUnknownFields := map[schema.GroupVersionKind]map[string]bool{}
+
+	var conditions []v1alpha1.ApplicationCondition
+	now := metav1.Now()
+	for _, obj := range targetObjs {
+		if obj == nil {
+			continue
+		}
+		gvk := obj.GroupVersionKind()
+		key := kube.GetResourceKey(obj)
+		resourceSchema := resources.LookupResource(gvk)
+		if resourceSchema == nil {
+			versions := servedVersions[gvk.GroupKind()]
+			if len(versions) > 0 && !slices.Contains(versions, gvk.Version) {
+				sort.Strings(versions)
+				conditions = append(conditions, v1alpha1.ApplicationCondition{
+					Type:               v1alpha1.ApplicationConditionSchemaValidationError,
+					Message:            fmt.Sprintf("Resource %s uses API version %s which is not served by the destination cluster, served versions are %s", key.String(), obj.GetAPIVersion(), strings.Join(versions, ", ")),
+					LastTransitionTime: &now,
+				})
+			}
+			continue
+		}
+
+		if warning := deprecationWarning(gvk, serverVersion); warning != "" {
+			conditions = append(conditions, v1alpha1.ApplicationCondition{
+				Type:               v1alpha1.ApplicationConditionSchemaValidationWarning,
+				Message:            fmt.Sprintf("Resource %s uses a deprecated API version: %s", key.String(), warning),
+				LastTransitionTime: &now,
+			})
+		}
+
+		preserved, ok := preserveUnknownFields[gvk]
+		if !ok {
+			collector := &preserveUnknownFieldsCollector{paths: map[string]bool{}, visited: map[string]bool{}}
+			resourceSchema.Accept(collector)
+			preserved = collector.paths
+			preserveUnknownFields[gvk] = preserved
+		}
+		var violations []string
+		for _, err := range validation.ValidateModel(obj.Object, resourceSchema, gvk.Kind) {
+			var unknownField validation.UnknownFieldError
+			if errors.As(unwrapValidationError(err), &unknownField) && preserved[unknownField.Path] {
+				continue
+			}
+			violations = append(violations, formatSchemaViolation(err))
+		}
+		if len(violations) == 0 {
+			continue
+		}
+		if len(violations) > maxSchemaViolations {
+			violations = append(violations[:maxSchemaViolations], fmt.Sprintf("and %d more", len(violations)-maxSchemaViolations))
+		}
+		conditions = append(conditions, v1alpha1.ApplicationCondition{
+			Type:               v1alpha1.ApplicationConditionSchemaValidationError,
+			Message:            fmt.Sprintf("Resource %s does not match the schema of the destination cluster: %s", key.String(), strings.Join(violations, "; ")),
+			LastTransitionTime: &now,
+		})
+	}
+	return conditions
+}
+
+// deprecationWarning returns the warning the Kubernetes API server returns for resources of the given built-in kind if
+// its API version is deprecated in the given Kubernetes version, according to the prerelease lifecycle of the types of
+// k8s.io/api, or an empty string otherwise. The API version is considered deprecated in any Kubernetes version if the
+// version is unknown.
+func deprecationWarning(gvk schema.GroupVersionKind, serverVersion string) string {
+	obj, err := scheme.Scheme.New(gvk)
+	if err != nil {
+		return ""
+	}
+	major, minor := 0, 0
+	if majorVersion, minorVersion, ok := strings.Cut(serverVersion, "."); ok {
+		major, minor, err = deprecation.MajorMinor(version.Info{Major: strings.TrimPrefix(majorVersion, "v"), Minor: minorVersion})
+		if err != nil {
+			major, minor = 0, 0
+		}
+	}
+	if !deprecation.IsDeprecated(obj, major, minor) {
+		return ""
+	}
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+	return deprecation.WarningMessage(obj)
+}
+
+func unwrapValidationError(err error) error {
+	var validationErr validation.ValidationError
+	if errors.As(err, &validationErr) {
+		return validationErr.Err
+	}
+	return err
+}
+
+func formatSchemaViolation(err error) string {
+	var validationErr validation.ValidationError
+	if errors.As(err, &validationErr) {
+		return fmt.Sprintf("%s: %v", validationErr.Path, validationErr.Err)
+	}
+	return err.Error()
+}
+
+// preserveUnknownFieldsCollector collects the paths of all object schemas which preserve unknown fields, since the
+// validation reports unknown fields regardless of the preserveUnknownFieldsExtension
+type preserveUnknownFieldsCollector struct {
+	paths   map[string]bool
+	visited map[string]bool
+}
+
+func (c *preserveUnknownFieldsCollector) VisitArray(a *proto.Array) {
+	a.SubType.Accept(c)
+}
+
+func (c *preserveUnknownFieldsCollector) VisitMap(m *proto.Map) {
+	m.SubType.Accept(c)
+}
+
+func (c *preserveUnknownFieldsCollector) VisitPrimitive(*proto.Primitive) {}
+
+func (c *preserveUnknownFieldsCollector) VisitKind(k *proto.Kind) {
+	path := k.GetPath().String()
+	if c.visited[path] {
+		return
+	}
+	c.visited[path] = true
+	if preserve, ok := k.GetExtensions()[preserveUnknownFieldsExtension].(bool); ok && preserve {
+		c.paths[path] = true
+	}
+	for _, field := range k.Fields {
+		field.Accept(c)
+	}
+}
+
+func (c *preserveUnknownFieldsCollector) VisitArbitrary(*proto.Arbitrary) {}
+
+func (c *preserveUnknownFieldsCollector) VisitReference(r proto.Reference) {
+	r.SubSchema().Accept(c)
+}
@@ -0,0 +1,197 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	. "github.com/argoproj/gitops-engine/pkg/utils/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/kube-openapi/pkg/util/proto"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+type fakeOpenAPIResources map[schema.GroupVersionKind]proto.Schema
+
+func (r fakeOpenAPIResources) LookupResource(gvk schema.GroupVersionKind) proto.Schema {
+	if s, ok := r[gvk]; ok {
+		return s
+	}
+	return nil
+}
+
+func (r fakeOpenAPIResources) GetConsumes(schema.GroupVersionKind, string) []string {
+	return nil
+}
+
+func newKindSchema(path string, description string, fields map[string]proto.Schema, extensions map[string]any) *proto.Kind {
+	return &proto.Kind{
+		BaseSchema: proto.BaseSchema{Path: proto.NewPath(path), Description: description, Extensions: extensions},
+		Fields:     fields,
+	}
+}
+
+func newPrimitiveSchema(path string, typ string) *proto.Primitive {
+	return &proto.Primitive{BaseSchema: proto.BaseSchema{Path: proto.NewPath(path)}, Type: typ}
+}
+
+func newResourceSchema(name string, description string, spec *proto.Kind) *proto.Kind {
+	return newKindSchema(name, description, map[string]proto.Schema{
+		"apiVersion": newPrimitiveSchema(name+".apiVersion", proto.String),
+		"kind":       newPrimitiveSchema(name+".kind", proto.String),
+		"metadata": newKindSchema(name+".metadata", "", map[string]proto.Schema{
+			"name":      newPrimitiveSchema(name+".metadata.name", proto.String),
+			"namespace": newPrimitiveSchema(name+".metadata.namespace", proto.String),
+		}, nil),
+		"spec": spec,
+	}, nil)
+}
+
+func TestValidateSchemas(t *testing.T) {
+	deploymentGVK := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: kube.DeploymentKind}
+	widgetGVK := schema.GroupVersionKind{Group: "example.com", Version: "v1beta1", Kind: "Widget"}
+	flowSchemaGVK := schema.GroupVersionKind{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta3", Kind: "FlowSchema"}
+	resources := fakeOpenAPIResources{
+		deploymentGVK: newResourceSchema("io.k8s.api.apps.v1.Deployment", "Deployment enables declarative updates for Pods and ReplicaSets.",
+			newKindSchema("io.k8s.api.apps.v1.DeploymentSpec", "", map[string]proto.Schema{
+				"replicas": newPrimitiveSchema("io.k8s.api.apps.v1.DeploymentSpec.replicas", proto.Integer),
+			}, nil)),
+		widgetGVK: newResourceSchema("com.example.v1beta1.Widget", "Deprecated: use example.com/v1 instead.",
+			newKindSchema("com.example.v1beta1.Widget.spec", "", map[string]proto.Schema{
+				"size": newPrimitiveSchema("com.example.v1beta1.Widget.spec.size", proto.String),
+			}, map[string]any{preserveUnknownFieldsExtension: true})),
+		flowSchemaGVK: newResourceSchema("io.k8s.api.flowcontrol.v1beta3.FlowSchema", "FlowSchema defines the schema of a group of flows.",
+			newKindSchema("io.k8s.api.flowcontrol.v1beta3.FlowSchemaSpec", "", map[string]proto.Schema{
+				"matchingPrecedence": newPrimitiveSchema("io.k8s.api.flowcontrol.v1beta3.FlowSchemaSpec.matchingPrecedence", proto.Integer),
+			}, nil)),
+	}
+	apiResources := []kube.APIResourceInfo{{
+		GroupKind:            schema.GroupKind{Group: "apps", Kind: kube.DeploymentKind},
+		GroupVersionResource: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"},
+	}, {
+		GroupKind:            schema.GroupKind{Group: "example.com", Kind: "Widget"},
+		GroupVersionResource: schema.GroupVersionResource{Group: "example.com", Version: "v1beta1", Resource: "widgets"},
+	}, {
+		GroupKind:            schema.GroupKind{Group: "flowcontrol.apiserver.k8s.io", Kind: "FlowSchema"},
+		GroupVersionResource: schema.GroupVersionResource{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta3", Resource: "flowschemas"},
+	}}
+	validateWithVersion := func(t *testing.T, manifest string, serverVersion string) []v1alpha1.ApplicationCondition {
+		t.Helper()
+		return validateSchemas([]*unstructured.Unstructured{Unstructured(manifest)}, resources, apiResources, serverVersion)
+	}
+	validate := func(t *testing.T, manifest string) []v1alpha1.ApplicationCondition {
+		t.Helper()
+		return validateWithVersion(t, manifest, "1.30")
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		conditions := validate(t, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+  namespace: default
+spec:
+  replicas: 1
+`)
+		assert.Empty(t, conditions)
+	})
+
+	t.Run("UnknownFieldAndWrongType", func(t *testing.T) {
+		conditions := validate(t, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+  namespace: default
+spec:
+  replica: 1
+  replicas: "one"
+`)
+		require.Len(t, conditions, 1)
+		assert.Equal(t, v1alpha1.ApplicationConditionSchemaValidationError, conditions[0].Type)
+		assert.Equal(t, `Resource apps/Deployment/default/guestbook does not match the schema of the destination cluster: Deployment.spec: unknown field "replica" in io.k8s.api.apps.v1.DeploymentSpec; Deployment.spec.replicas: invalid type for io.k8s.api.apps.v1.DeploymentSpec.replicas: got "string", expected "integer"`, conditions[0].Message)
+	})
+
+	t.Run("RemovedVersion", func(t *testing.T) {
+		conditions := validate(t, `
+apiVersion: apps/v1beta2
+kind: Deployment
+metadata:
+  name: guestbook
+  namespace: default
+`)
+		require.Len(t, conditions, 1)
+		assert.Equal(t, v1alpha1.ApplicationConditionSchemaValidationError, conditions[0].Type)
+		assert.Equal(t, "Resource apps/Deployment/default/guestbook uses API version apps/v1beta2 which is not served by the destination cluster, served versions are v1", conditions[0].Message)
+	})
+
+	t.Run("UnknownKind", func(t *testing.T) {
+		conditions := validate(t, `
+apiVersion: example.com/v1
+kind: Gadget
+metadata:
+  name: gadget
+  namespace: default
+`)
+		assert.Empty(t, conditions)
+	})
+
+	t.Run("PreservingUnknownFields", func(t *testing.T) {
+		// the deprecation of custom resource versions is not derived from their schema
+		conditions := validate(t, `
+apiVersion: example.com/v1beta1
+kind: Widget
+metadata:
+  name: widget
+  namespace: default
+spec:
+  size: large
+  color: blue
+`)
+		assert.Empty(t, conditions)
+	})
+
+	flowSchema := `
+apiVersion: flowcontrol.apiserver.k8s.io/v1beta3
+kind: FlowSchema
+metadata:
+  name: flows
+spec:
+  matchingPrecedence: 1000
+`
+	t.Run("DeprecatedVersion", func(t *testing.T) {
+		for _, serverVersion := range []string{"1.30", "1.31+", "v1.29", ""} {
+			conditions := validateWithVersion(t, flowSchema, serverVersion)
+			require.Len(t, conditions, 1, serverVersion)
+			assert.Equal(t, v1alpha1.ApplicationConditionSchemaValidationWarning, conditions[0].Type)
+			assert.Equal(t, "Resource flowcontrol.apiserver.k8s.io/FlowSchema//flows uses a deprecated API version: flowcontrol.apiserver.k8s.io/v1beta3 FlowSchema is deprecated in v1.29+, unavailable in v1.32+; use flowcontrol.apiserver.k8s.io/v1 FlowSchema", conditions[0].Message)
+		}
+	})
+
+	t.Run("NotYetDeprecatedVersion", func(t *testing.T) {
+		assert.Empty(t, validateWithVersion(t, flowSchema, "1.28"))
+	})
+}
+
+func TestValidateSchemas_LimitsViolations(t *testing.T) {
+	gvk := schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}
+	resources := fakeOpenAPIResources{
+		gvk: newResourceSchema("io.k8s.api.core.v1.ConfigMap", "", newKindSchema("io.k8s.api.core.v1.ConfigMap.spec", "", nil, nil)),
+	}
+	obj := &unstructured.Unstructured{Object: map[string]any{"apiVersion": "v1", "kind": "ConfigMap", "metadata": map[string]any{"name": "config"}}}
+	spec := map[string]any{}
+	for _, field := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l"} {
+		spec[field] = field
+	}
+	obj.Object["spec"] = spec
+
+	conditions := validateSchemas([]*unstructured.Unstructured{obj}, resources, nil, "1.30")
+	require.Len(t, conditions, 1)
+	assert.Contains(t, conditions[0].Message, `ConfigMap.spec: unknown field "j"`)
+	assert.NotContains(t, conditions[0].Message, `unknown field "k"`)
+	assert.Contains(t, conditions[0].Message, "; and 2 more")
+}
@@ -18,9 +18,15 @@ type ClusterShardingCache interface {
 	DeleteApp(a *v1alpha1.Application)
 	UpdateApp(a *v1alpha1.Application)
 	IsManagedCluster(c *v1alpha1.Cluster) bool
+	IsApplicationShardedCluster(c *v1alpha1.Cluster) bool
+	IsCachedCluster(c *v1alpha1.Cluster) bool
+	IsManagedApplication(a *v1alpha1.Application, c *v1alpha1.Cluster) bool
 	GetDistribution() map[string]int
 	GetAppDistribution() map[string]int
 	UpdateShard(shard int) bool
+	GetClusters() []*v1alpha1.Cluster
+	GetReplicas() int
+	UpdateClusterAssignments(assignments map[string]int)
 }
 
 type ClusterSharding struct {
@@ -29,6 +35,7 @@ type ClusterSharding struct {
 	Shards          map[string]int
 	Clusters        map[string]*v1alpha1.Cluster
 	Apps            map[string]*v1alpha1.Application
+	Assignments     map[string]int
 	lock            sync.RWMutex
 	getClusterShard DistributionFunction
 }
@@ -45,7 +52,7 @@ func NewClusterSharding(_ db.ArgoDB, shard, replicas int, shardingAlgorithm stri
 	distributionFunction := NoShardingDistributionFunction()
 	if replicas > 1 {
 		log.Debugf("Processing clusters from shard %d: Using filter function:  %s", shard, shardingAlgorithm)
-		distributionFunction = GetDistributionFunction(clusterSharding.getClusterAccessor(), clusterSharding.getAppAccessor(), shardingAlgorithm, replicas)
+		distributionFunction = GetDistributionFunction(clusterSharding.getClusterAccessor(), clusterSharding.getAppAccessor(), clusterSharding.getAssignmentAccessor(), shardingAlgorithm, replicas)
 	} else {
 		log.Info("Processing all cluster shards")
 	}
@@ -70,6 +77,31 @@ func (sharding *ClusterSharding) IsManagedCluster(c *v1alpha1.Cluster) bool {
 	return clusterShard == sharding.Shard
 }
 
+// IsApplicationShardedCluster returns whether the Applications targeting the cluster are distributed across all
+// shards. The cache of such a cluster is needed by every shard.
+func (sharding *ClusterSharding) IsApplicationShardedCluster(c *v1alpha1.Cluster) bool {
+	return sharding.Replicas > 1 && IsApplicationShardedCluster(c)
+}
+
+// IsCachedCluster returns whether the current shard maintains the cache of the cluster: either the cluster is
+// assigned to the shard, or some of its Applications might be.
+func (sharding *ClusterSharding) IsCachedCluster(c *v1alpha1.Cluster) bool {
+	return sharding.IsManagedCluster(c) || sharding.IsApplicationShardedCluster(c)
+}
+
+// IsManagedApplication returns whether or not the application targeting the given cluster should be processed by
+// the current shard.
+func (sharding *ClusterSharding) IsManagedApplication(a *v1alpha1.Application, c *v1alpha1.Cluster) bool {
+	if !sharding.IsApplicationShardedCluster(c) {
+		return sharding.IsManagedCluster(c)
+	}
+	sharding.lock.RLock()
+	defer sharding.lock.RUnlock()
+	appShard := GetApplicationShard(a, sharding.Replicas)
+	log.Debugf("Checking if application %s with shard %d should be processed by shard %d", a.QualifiedName(), appShard, sharding.Shard)
+	return appShard == sharding.Shard
+}
+
 func (sharding *ClusterSharding) Init(clusters *v1alpha1.ClusterList, apps *v1alpha1.ApplicationList) {
 	sharding.lock.Lock()
 	defer sharding.lock.Unlock()
@@ -212,6 +244,13 @@ func (sharding *ClusterSharding) getAppAccessor() appAccessor {
 	}
 }
 
+// A read lock should be acquired before calling getAssignmentAccessor.
+func (sharding *ClusterSharding) getAssignmentAccessor() assignmentAccessor {
+	return func() map[string]int {
+		return sharding.Assignments
+	}
+}
+
 func (sharding *ClusterSharding) AddApp(a *v1alpha1.Application) {
 	sharding.lock.Lock()
 	defer sharding.lock.Unlock()
@@ -276,3 +315,28 @@ func (sharding *ClusterSharding) UpdateShard(shard int) bool {
 	}
 	return false
 }
+
+// GetClusters returns the clusters known by the sharding cache.
+func (sharding *ClusterSharding) GetClusters() []*v1alpha1.Cluster {
+	sharding.lock.RLock()
+	defer sharding.lock.RUnlock()
+	return sharding.getClusterAccessor()()
+}
+
+// GetReplicas returns the number of replicas the clusters are distributed across.
+func (sharding *ClusterSharding) GetReplicas() int {
+	return sharding.Replicas
+}
+
+// UpdateClusterAssignments updates the cluster to shard assignments published by the load-aware sharding algorithm
+// and redistributes the clusters accordingly.
+func (sharding *ClusterSharding) UpdateClusterAssignments(assignments map[string]int) {
+	sharding.lock.Lock()
+	defer sharding.lock.Unlock()
+	newAssignments := make(map[string]int, len(assignments))
+	for k, v := range assignments {
+		newAssignments[k] = v
+	}
+	sharding.Assignments = newAssignments
+	sharding.updateDistribution()
+}
@@ -1,10 +1,12 @@
 package sharding
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	dbmocks "github.com/argoproj/argo-cd/v3/util/db/mocks"
 )
@@ -322,6 +324,58 @@ func TestClusterSharding_IsManagedCluster(t *testing.T) {
 	}))
 }
 
+func TestClusterSharding_IsManagedApplication(t *testing.T) {
+	replicas := 2
+	cluster := &v1alpha1.Cluster{
+		ID:          "2",
+		Server:      "https://127.0.0.1:6443",
+		Annotations: map[string]string{common.AnnotationKeyApplicationSharding: "true"},
+	}
+	apps := make([]v1alpha1.Application, 0, 10)
+	for i := 0; i < 10; i++ {
+		apps = append(apps, createApp(fmt.Sprintf("app%d", i), cluster.Server))
+	}
+	shardings := []*ClusterSharding{setupTestSharding(0, replicas), setupTestSharding(1, replicas)}
+	for _, sharding := range shardings {
+		sharding.Init(&v1alpha1.ClusterList{Items: []v1alpha1.Cluster{*cluster}}, &v1alpha1.ApplicationList{Items: apps})
+		assert.True(t, sharding.IsApplicationShardedCluster(cluster))
+	}
+
+	managedByShard := make([]int, replicas)
+	for i := range apps {
+		managed := 0
+		for shard, sharding := range shardings {
+			if sharding.IsManagedApplication(&apps[i], cluster) {
+				managed++
+				managedByShard[shard]++
+			}
+		}
+		// every application is processed by a single shard
+		assert.Equal(t, 1, managed)
+	}
+	// the applications of the cluster are spread across both shards
+	assert.Positive(t, managedByShard[0])
+	assert.Positive(t, managedByShard[1])
+
+	// every shard maintains the cache of the cluster
+	for _, sharding := range shardings {
+		assert.True(t, sharding.IsCachedCluster(cluster))
+	}
+
+	// applications of clusters which are not application sharded are processed by the shard of the cluster
+	notSharded := &v1alpha1.Cluster{ID: "2", Server: "https://127.0.0.1:6443"}
+	assert.False(t, shardings[0].IsApplicationShardedCluster(notSharded))
+	assert.False(t, shardings[0].IsCachedCluster(notSharded))
+	assert.True(t, shardings[1].IsCachedCluster(notSharded))
+	for i := range apps {
+		assert.False(t, shardings[0].IsManagedApplication(&apps[i], notSharded))
+		assert.True(t, shardings[1].IsManagedApplication(&apps[i], notSharded))
+	}
+
+	// application sharding requires multiple replicas
+	assert.False(t, setupTestSharding(0, 1).IsApplicationShardedCluster(cluster))
+}
+
 func TestClusterSharding_ClusterShardOfResourceShouldNotBeChanged(t *testing.T) {
 	shard := 1
 	replicas := 2
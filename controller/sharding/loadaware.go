@@ -0,0 +1,330 @@
+package sharding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// ShardClusterAssignmentsKey is the key of the shard mapping ConfigMap holding the cluster to shard
+// assignments published by the load-aware sharding algorithm.
+const ShardClusterAssignmentsKey = "clusterShardAssignments"
+
+const (
+	// DefaultShardingLoadTolerance is the default tolerated deviation of a shard load from the average shard load
+	DefaultShardingLoadTolerance = 0.2
+	// DefaultShardingRebalanceInterval is the default interval at which clusters are rebalanced across shards
+	DefaultShardingRebalanceInterval = 5 * time.Minute
+
+	// applicationWeight is the cost of a single application expressed in number of cached resources
+	applicationWeight = 100
+	// eventsPerMinuteWeight is the cost of a single watch event per minute expressed in number of cached resources
+	eventsPerMinuteWeight = 10
+)
+
+// ClusterLoad holds the measured cost of a cluster used by the load-aware sharding algorithm.
+type ClusterLoad struct {
+	// ResourcesCount is the number of resources held in the cluster cache
+	ResourcesCount int64
+	// EventsPerMinute is the rate of watch events received from the cluster
+	EventsPerMinute int64
+	// ApplicationsCount is the number of applications deployed to the cluster
+	ApplicationsCount int64
+}
+
+// NewClusterLoad returns the load of a cluster based on the cluster information reported by the controller.
+func NewClusterLoad(info v1alpha1.ClusterInfo) ClusterLoad {
+	return ClusterLoad{
+		ResourcesCount:    info.CacheInfo.ResourcesCount,
+		EventsPerMinute:   info.CacheInfo.EventsPerMinute,
+		ApplicationsCount: info.ApplicationsCount,
+	}
+}
+
+// Weight returns the cost of the cluster expressed in number of cached resources. Every cluster weights at least 1
+// so that clusters without any measurement are still spread across shards.
+func (l ClusterLoad) Weight() int64 {
+	return 1 + l.ResourcesCount + l.ApplicationsCount*applicationWeight + l.EventsPerMinute*eventsPerMinuteWeight
+}
+
+// ClusterShardAssignments stores the cluster to shard assignments published in the shard mapping ConfigMap by the
+// load-aware sharding algorithm, along with the weight of each cluster at the time of the assignment.
+type ClusterShardAssignments struct {
+	Replicas    int
+	Assignments map[string]int
+	Weights     map[string]int64
+	UpdatedAt   metav1.Time
+}
+
+// LoadAwareDistributionFunction returns a DistributionFunction using the cluster to shard assignments published by
+// the LoadAwareRebalancer. Clusters which have not been assigned yet, e.g. because they have just been added, are
+// distributed using the consistent hashing with bounded loads algorithm until the next rebalancing.
+func LoadAwareDistributionFunction(clusters clusterAccessor, apps appAccessor, assignments assignmentAccessor, replicas int) DistributionFunction {
+	fallback := ConsistentHashingWithBoundedLoadsDistributionFunction(clusters, apps, replicas)
+	return func(c *v1alpha1.Cluster) int {
+		if replicas > 0 {
+			if c == nil { // in-cluster does not necessarily have a secret assigned. So we are receiving a nil cluster here.
+				return 0
+			}
+			// if Shard is manually set and the assigned value is lower than the number of replicas,
+			// then its value is returned otherwise it is the default calculated value
+			if c.Shard != nil && int(*c.Shard) < replicas {
+				return int(*c.Shard)
+			}
+			if shard, ok := assignments()[c.Server]; ok && shard < replicas {
+				log.Debugf("Cluster with id=%s will be processed by shard %d", c.ID, shard)
+				return shard
+			}
+			return fallback(c)
+		}
+		log.Warnf("The number of replicas (%d) is lower than 1", replicas)
+		return -1
+	}
+}
+
+// computeLoadAwareAssignments assigns the clusters to shards so that the load of every shard stays within the given
+// tolerance of the average shard load. Previous assignments are kept as long as possible to avoid moving clusters
+// unnecessarily: only unassigned clusters are placed, and clusters are moved from the most loaded shard to the least
+// loaded shard until the tolerance is met or no move improves the balance. Clusters with a manually assigned shard are
+// accounted for in the shard load but are never moved.
+func computeLoadAwareAssignments(clusters []*v1alpha1.Cluster, weights map[string]int64, previous map[string]int, replicas int, tolerance float64) map[string]int {
+	assignments := make(map[string]int, len(clusters))
+	if replicas <= 0 {
+		return assignments
+	}
+	loads := make([]int64, replicas)
+	pinned := make(map[string]bool)
+	var unassigned []*v1alpha1.Cluster
+	var total int64
+	for _, c := range clusters {
+		total += weights[c.Server]
+		if c.Shard != nil && int(*c.Shard) < replicas {
+			assignments[c.Server] = int(*c.Shard)
+			pinned[c.Server] = true
+		} else if shard, ok := previous[c.Server]; ok && shard >= 0 && shard < replicas {
+			assignments[c.Server] = shard
+		} else {
+			unassigned = append(unassigned, c)
+			continue
+		}
+		loads[assignments[c.Server]] += weights[c.Server]
+	}
+
+	// place the heaviest clusters first on the least loaded shard
+	sort.Slice(unassigned, func(i, j int) bool {
+		wi, wj := weights[unassigned[i].Server], weights[unassigned[j].Server]
+		if wi != wj {
+			return wi > wj
+		}
+		return unassigned[i].Server < unassigned[j].Server
+	})
+	for _, c := range unassigned {
+		shard := leastLoadedShard(loads)
+		assignments[c.Server] = shard
+		loads[shard] += weights[c.Server]
+	}
+
+	maxLoad := float64(total) / float64(replicas) * (1 + tolerance)
+	servers := make([]string, 0, len(assignments))
+	for server := range assignments {
+		servers = append(servers, server)
+	}
+	sort.Strings(servers)
+	for range servers {
+		heaviest, lightest := mostLoadedShard(loads), leastLoadedShard(loads)
+		if float64(loads[heaviest]) <= maxLoad {
+			break
+		}
+		// move the heaviest cluster whose move strictly reduces the load difference between both shards
+		candidate := ""
+		for _, server := range servers {
+			w := weights[server]
+			if assignments[server] != heaviest || pinned[server] || w >= loads[heaviest]-loads[lightest] {
+				continue
+			}
+			if candidate == "" || w > weights[candidate] {
+				candidate = server
+			}
+		}
+		if candidate == "" {
+			break
+		}
+		log.Debugf("Moving cluster %s from shard %d to shard %d", candidate, heaviest, lightest)
+		assignments[candidate] = lightest
+		loads[heaviest] -= weights[candidate]
+		loads[lightest] += weights[candidate]
+	}
+	return assignments
+}
+
+func leastLoadedShard(loads []int64) int {
+	shard := 0
+	for i := range loads {
+		if loads[i] < loads[shard] {
+			shard = i
+		}
+	}
+	return shard
+}
+
+func mostLoadedShard(loads []int64) int {
+	shard := 0
+	for i := range loads {
+		if loads[i] > loads[shard] {
+			shard = i
+		}
+	}
+	return shard
+}
+
+// ClusterInfoGetter returns the information reported by the controllers about a cluster.
+type ClusterInfoGetter interface {
+	GetClusterInfo(server string, res *v1alpha1.ClusterInfo) error
+}
+
+// LoadAwareRebalancer periodically computes the load-aware cluster to shard assignments and publishes them through
+// the shard mapping ConfigMap. Every replica runs the rebalancer: the first replica noticing that the published
+// assignments are outdated recomputes them, and every replica applies the published assignments to its cluster
+// sharding cache. Concurrent updates are prevented by the optimistic locking of the ConfigMap.
+type LoadAwareRebalancer struct {
+	kubeClient      kubernetes.Interface
+	namespace       string
+	clusterSharding ClusterShardingCache
+	infoGetter      ClusterInfoGetter
+	tolerance       float64
+	interval        time.Duration
+}
+
+// NewLoadAwareRebalancer creates a new LoadAwareRebalancer.
+func NewLoadAwareRebalancer(kubeClient kubernetes.Interface, namespace string, clusterSharding ClusterShardingCache, infoGetter ClusterInfoGetter, tolerance float64, interval time.Duration) *LoadAwareRebalancer {
+	return &LoadAwareRebalancer{
+		kubeClient:      kubeClient,
+		namespace:       namespace,
+		clusterSharding: clusterSharding,
+		infoGetter:      infoGetter,
+		tolerance:       tolerance,
+		interval:        interval,
+	}
+}
+
+// Run rebalances the clusters every interval until the context is done.
+func (r *LoadAwareRebalancer) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		if err := r.rebalance(ctx); err != nil {
+			log.Warnf("Failed to rebalance clusters across shards: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *LoadAwareRebalancer) rebalance(ctx context.Context) error {
+	cm, err := r.kubeClient.CoreV1().ConfigMaps(r.namespace).Get(ctx, common.ArgoCDAppControllerShardConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("error getting sharding config map: %w", err)
+		}
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      common.ArgoCDAppControllerShardConfigMapName,
+				Namespace: r.namespace,
+			},
+		}
+	}
+	published, err := GetClusterShardAssignments(cm)
+	if err != nil {
+		return err
+	}
+
+	clusters := r.clusterSharding.GetClusters()
+	replicas := r.clusterSharding.GetReplicas()
+	if published == nil || published.Replicas != replicas || heartbeatCurrentTime().Sub(published.UpdatedAt.Time) >= r.interval || hasUnassignedClusters(clusters, published.Assignments) {
+		weights := r.getClusterWeights(clusters)
+		var previous map[string]int
+		if published != nil && published.Replicas == replicas {
+			previous = published.Assignments
+		}
+		updated := &ClusterShardAssignments{
+			Replicas:    replicas,
+			Assignments: computeLoadAwareAssignments(clusters, weights, previous, replicas, r.tolerance),
+			Weights:     weights,
+			UpdatedAt:   heartbeatCurrentTime(),
+		}
+		data, err := json.Marshal(updated)
+		if err != nil {
+			return fmt.Errorf("error marshalling cluster shard assignments: %w", err)
+		}
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		cm.Data[ShardClusterAssignmentsKey] = string(data)
+		if cm.ResourceVersion == "" {
+			_, err = r.kubeClient.CoreV1().ConfigMaps(r.namespace).Create(ctx, cm, metav1.CreateOptions{})
+		} else {
+			_, err = r.kubeClient.CoreV1().ConfigMaps(r.namespace).Update(ctx, cm, metav1.UpdateOptions{})
+		}
+		if err != nil {
+			if apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err) {
+				// another replica published the assignments in the meantime, they will be applied on the next iteration
+				log.Debug("Cluster shard assignments were updated concurrently")
+				return nil
+			}
+			return fmt.Errorf("error updating cluster shard assignments: %w", err)
+		}
+		log.Infof("Published load-aware assignments of %d clusters across %d shards", len(updated.Assignments), replicas)
+		published = updated
+	}
+	r.clusterSharding.UpdateClusterAssignments(published.Assignments)
+	return nil
+}
+
+func (r *LoadAwareRebalancer) getClusterWeights(clusters []*v1alpha1.Cluster) map[string]int64 {
+	weights := make(map[string]int64, len(clusters))
+	for _, c := range clusters {
+		var info v1alpha1.ClusterInfo
+		if err := r.infoGetter.GetClusterInfo(c.Server, &info); err != nil {
+			log.Debugf("No cluster info available for cluster %s: %v", c.Server, err)
+		}
+		weights[c.Server] = NewClusterLoad(info).Weight()
+	}
+	return weights
+}
+
+func hasUnassignedClusters(clusters []*v1alpha1.Cluster, assignments map[string]int) bool {
+	for _, c := range clusters {
+		if _, ok := assignments[c.Server]; !ok {
+			return true
+		}
+	}
+	return false
+}
+
+// GetClusterShardAssignments returns the cluster shard assignments published in the shard mapping ConfigMap by the
+// load-aware sharding algorithm, or nil if no assignments have been published yet.
+func GetClusterShardAssignments(cm *corev1.ConfigMap) (*ClusterShardAssignments, error) {
+	data, ok := cm.Data[ShardClusterAssignmentsKey]
+	if !ok || data == "" {
+		return nil, nil
+	}
+	var assignments ClusterShardAssignments
+	if err := json.Unmarshal([]byte(data), &assignments); err != nil {
+		return nil, fmt.Errorf("error unmarshalling cluster shard assignments: %w", err)
+	}
+	return &assignments, nil
+}
@@ -0,0 +1,220 @@
+package sharding
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+type fakeClusterInfoGetter map[string]v1alpha1.ClusterInfo
+
+func (g fakeClusterInfoGetter) GetClusterInfo(server string, res *v1alpha1.ClusterInfo) error {
+	info, ok := g[server]
+	if !ok {
+		return errors.New("not found")
+	}
+	*res = info
+	return nil
+}
+
+func shardLoads(assignments map[string]int, weights map[string]int64, replicas int) []int64 {
+	loads := make([]int64, replicas)
+	for server, shard := range assignments {
+		loads[shard] += weights[server]
+	}
+	return loads
+}
+
+func TestClusterLoadWeight(t *testing.T) {
+	assert.Equal(t, int64(1), ClusterLoad{}.Weight())
+	load := NewClusterLoad(v1alpha1.ClusterInfo{
+		CacheInfo:         v1alpha1.ClusterCacheInfo{ResourcesCount: 1000, EventsPerMinute: 5},
+		ApplicationsCount: 2,
+	})
+	assert.Equal(t, int64(1+1000+2*applicationWeight+5*eventsPerMinuteWeight), load.Weight())
+}
+
+func TestComputeLoadAwareAssignments(t *testing.T) {
+	_, _, cluster1, cluster2, cluster3, cluster4, cluster5 := createTestClusters()
+	clusters := getClusterPointers([]v1alpha1.Cluster{cluster1, cluster2, cluster3, cluster4, cluster5})
+	weights := map[string]int64{
+		cluster1.Server: 1000,
+		cluster2.Server: 600,
+		cluster3.Server: 400,
+		cluster4.Server: 200,
+		cluster5.Server: 100,
+	}
+
+	t.Run("unassigned clusters are balanced", func(t *testing.T) {
+		assignments := computeLoadAwareAssignments(clusters, weights, nil, 2, DefaultShardingLoadTolerance)
+		require.Len(t, assignments, 5)
+		loads := shardLoads(assignments, weights, 2)
+		assert.Equal(t, []int64{1100, 1200}, []int64{min(loads[0], loads[1]), max(loads[0], loads[1])})
+	})
+
+	t.Run("balanced previous assignments are kept", func(t *testing.T) {
+		previous := map[string]int{
+			cluster1.Server: 0,
+			cluster2.Server: 1,
+			cluster3.Server: 1,
+			cluster4.Server: 0,
+			cluster5.Server: 1,
+		}
+		assignments := computeLoadAwareAssignments(clusters, weights, previous, 2, DefaultShardingLoadTolerance)
+		assert.Equal(t, previous, assignments)
+	})
+
+	t.Run("overloaded shard is rebalanced", func(t *testing.T) {
+		previous := map[string]int{
+			cluster1.Server: 0,
+			cluster2.Server: 0,
+			cluster3.Server: 0,
+			cluster4.Server: 1,
+			cluster5.Server: 1,
+		}
+		assignments := computeLoadAwareAssignments(clusters, weights, previous, 2, DefaultShardingLoadTolerance)
+		loads := shardLoads(assignments, weights, 2)
+		maxLoad := float64(2300) / 2 * (1 + DefaultShardingLoadTolerance)
+		assert.LessOrEqual(t, float64(loads[0]), maxLoad)
+		assert.LessOrEqual(t, float64(loads[1]), maxLoad)
+		// a single cluster is moved to restore the balance
+		moved := 0
+		for server, shard := range previous {
+			if assignments[server] != shard {
+				moved++
+			}
+		}
+		assert.Equal(t, 1, moved)
+	})
+
+	t.Run("pinned clusters are never moved", func(t *testing.T) {
+		pinned := cluster1
+		pinned.Shard = ptr.To[int64](1)
+		assignments := computeLoadAwareAssignments(getClusterPointers([]v1alpha1.Cluster{pinned, cluster2, cluster3, cluster4, cluster5}), weights, map[string]int{cluster1.Server: 0}, 2, DefaultShardingLoadTolerance)
+		assert.Equal(t, 1, assignments[cluster1.Server])
+	})
+
+	t.Run("assignments beyond the replicas count are reassigned", func(t *testing.T) {
+		previous := map[string]int{cluster1.Server: 5}
+		assignments := computeLoadAwareAssignments(clusters, weights, previous, 2, DefaultShardingLoadTolerance)
+		assert.Less(t, assignments[cluster1.Server], 2)
+	})
+
+	t.Run("no replicas", func(t *testing.T) {
+		assert.Empty(t, computeLoadAwareAssignments(clusters, weights, nil, 0, DefaultShardingLoadTolerance))
+	})
+}
+
+func TestLoadAwareDistributionFunction(t *testing.T) {
+	clusterAccessor, _, cluster1, cluster2, cluster3, _, _ := createTestClusters()
+	appAccessor, _, _, _, _, _ := createTestApps()
+	assignments := map[string]int{cluster1.Server: 1, cluster2.Server: 0}
+	distributionFunction := LoadAwareDistributionFunction(clusterAccessor, appAccessor, func() map[string]int { return assignments }, 2)
+
+	assert.Equal(t, 0, distributionFunction(nil))
+	assert.Equal(t, 1, distributionFunction(&cluster1))
+	assert.Equal(t, 0, distributionFunction(&cluster2))
+	// unassigned clusters fall back to the consistent hashing algorithm
+	assert.Equal(t, ConsistentHashingWithBoundedLoadsDistributionFunction(clusterAccessor, appAccessor, 2)(&cluster3), distributionFunction(&cluster3))
+
+	pinned := cluster1
+	pinned.Shard = ptr.To[int64](0)
+	assert.Equal(t, 0, distributionFunction(&pinned))
+
+	assert.Equal(t, -1, LoadAwareDistributionFunction(clusterAccessor, appAccessor, func() map[string]int { return assignments }, 0)(&cluster1))
+}
+
+func TestLoadAwareRebalancer(t *testing.T) {
+	_, db, cluster1, cluster2, cluster3, _, _ := createTestClusters()
+	clusters := &v1alpha1.ClusterList{Items: []v1alpha1.Cluster{cluster1, cluster2, cluster3}}
+	infoGetter := fakeClusterInfoGetter{
+		cluster1.Server: {CacheInfo: v1alpha1.ClusterCacheInfo{ResourcesCount: 1000}},
+		cluster2.Server: {CacheInfo: v1alpha1.ClusterCacheInfo{ResourcesCount: 500}},
+		cluster3.Server: {CacheInfo: v1alpha1.ClusterCacheInfo{ResourcesCount: 500}},
+	}
+	now := metav1.Now()
+	heartbeatCurrentTime = func() metav1.Time { return now }
+	defer func() { heartbeatCurrentTime = metav1.Now }()
+
+	t.Run("assignments are published and applied", func(t *testing.T) {
+		kubeClient := kubefake.NewClientset()
+		clusterSharding := NewClusterSharding(db, 0, 2, common.LoadAwareShardingAlgorithm)
+		clusterSharding.Init(clusters, &v1alpha1.ApplicationList{})
+		rebalancer := NewLoadAwareRebalancer(kubeClient, "argocd", clusterSharding, infoGetter, DefaultShardingLoadTolerance, time.Minute)
+
+		require.NoError(t, rebalancer.rebalance(t.Context()))
+
+		cm, err := kubeClient.CoreV1().ConfigMaps("argocd").Get(t.Context(), common.ArgoCDAppControllerShardConfigMapName, metav1.GetOptions{})
+		require.NoError(t, err)
+		published, err := GetClusterShardAssignments(cm)
+		require.NoError(t, err)
+		require.NotNil(t, published)
+		assert.Equal(t, 2, published.Replicas)
+		assert.Equal(t, int64(1001), published.Weights[cluster1.Server])
+		assert.NotEqual(t, published.Assignments[cluster1.Server], published.Assignments[cluster2.Server])
+		assert.Equal(t, published.Assignments[cluster2.Server], published.Assignments[cluster3.Server])
+		assert.Equal(t, published.Assignments, clusterSharding.GetDistribution())
+	})
+
+	t.Run("up to date assignments are not recomputed", func(t *testing.T) {
+		data, err := json.Marshal(ClusterShardAssignments{
+			Replicas:    2,
+			Assignments: map[string]int{cluster1.Server: 1, cluster2.Server: 1, cluster3.Server: 1},
+			UpdatedAt:   now,
+		})
+		require.NoError(t, err)
+		kubeClient := kubefake.NewClientset(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDAppControllerShardConfigMapName, Namespace: "argocd", ResourceVersion: "1"},
+			Data:       map[string]string{ShardClusterAssignmentsKey: string(data)},
+		})
+		clusterSharding := NewClusterSharding(db, 0, 2, common.LoadAwareShardingAlgorithm)
+		clusterSharding.Init(clusters, &v1alpha1.ApplicationList{})
+		rebalancer := NewLoadAwareRebalancer(kubeClient, "argocd", clusterSharding, infoGetter, DefaultShardingLoadTolerance, time.Minute)
+
+		require.NoError(t, rebalancer.rebalance(t.Context()))
+
+		assert.Equal(t, map[string]int{cluster1.Server: 1, cluster2.Server: 1, cluster3.Server: 1}, clusterSharding.GetDistribution())
+		assert.False(t, clusterSharding.IsManagedCluster(&cluster1))
+	})
+
+	t.Run("outdated assignments are rebalanced", func(t *testing.T) {
+		data, err := json.Marshal(ClusterShardAssignments{
+			Replicas:    2,
+			Assignments: map[string]int{cluster1.Server: 1, cluster2.Server: 1, cluster3.Server: 1},
+			UpdatedAt:   metav1.NewTime(now.Add(-time.Hour)),
+		})
+		require.NoError(t, err)
+		kubeClient := kubefake.NewClientset(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDAppControllerShardConfigMapName, Namespace: "argocd", ResourceVersion: "1"},
+			Data:       map[string]string{ShardClusterAssignmentsKey: string(data)},
+		})
+		clusterSharding := NewClusterSharding(db, 0, 2, common.LoadAwareShardingAlgorithm)
+		clusterSharding.Init(clusters, &v1alpha1.ApplicationList{})
+		rebalancer := NewLoadAwareRebalancer(kubeClient, "argocd", clusterSharding, infoGetter, DefaultShardingLoadTolerance, time.Minute)
+
+		require.NoError(t, rebalancer.rebalance(t.Context()))
+
+		// the heaviest cluster is moved to the idle shard
+		assert.Equal(t, map[string]int{cluster1.Server: 0, cluster2.Server: 1, cluster3.Server: 1}, clusterSharding.GetDistribution())
+	})
+}
+
+func TestGetClusterShardAssignments(t *testing.T) {
+	assignments, err := GetClusterShardAssignments(&corev1.ConfigMap{})
+	require.NoError(t, err)
+	assert.Nil(t, assignments)
+
+	_, err = GetClusterShardAssignments(&corev1.ConfigMap{Data: map[string]string{ShardClusterAssignmentsKey: "invalid"}})
+	require.Error(t, err)
+}
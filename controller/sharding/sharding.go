@@ -49,6 +49,7 @@ type (
 	ClusterFilterFunction func(c *v1alpha1.Cluster) bool
 	clusterAccessor       func() []*v1alpha1.Cluster
 	appAccessor           func() []*v1alpha1.Application
+	assignmentAccessor    func() map[string]int
 )
 
 // shardApplicationControllerMapping stores the mapping of Shard Number to Application Controller in ConfigMap.
@@ -82,7 +83,7 @@ func GetClusterFilter(_ db.ArgoDB, distributionFunction DistributionFunction, re
 
 // GetDistributionFunction returns which DistributionFunction should be used based on the passed algorithm and
 // the current datas.
-func GetDistributionFunction(clusters clusterAccessor, apps appAccessor, shardingAlgorithm string, replicasCount int) DistributionFunction {
+func GetDistributionFunction(clusters clusterAccessor, apps appAccessor, assignments assignmentAccessor, shardingAlgorithm string, replicasCount int) DistributionFunction {
 	log.Debugf("Using filter function:  %s", shardingAlgorithm)
 	distributionFunction := LegacyDistributionFunction(replicasCount)
 	switch shardingAlgorithm {
@@ -92,6 +93,8 @@ func GetDistributionFunction(clusters clusterAccessor, apps appAccessor, shardin
 		distributionFunction = LegacyDistributionFunction(replicasCount)
 	case common.ConsistentHashingWithBoundedLoadsAlgorithm:
 		distributionFunction = ConsistentHashingWithBoundedLoadsDistributionFunction(clusters, apps, replicasCount)
+	case common.LoadAwareShardingAlgorithm:
+		distributionFunction = LoadAwareDistributionFunction(clusters, apps, assignments, replicasCount)
 	default:
 		log.Warnf("distribution type %s is not supported, defaulting to %s", shardingAlgorithm, common.DefaultShardingAlgorithm)
 	}
@@ -131,6 +134,28 @@ func LegacyDistributionFunction(replicas int) DistributionFunction {
 	}
 }
 
+// IsApplicationShardedCluster returns whether the Applications targeting the given cluster are distributed across
+// shards individually rather than being processed by the shard the cluster is assigned to.
+func IsApplicationShardedCluster(c *v1alpha1.Cluster) bool {
+	if c == nil || c.Annotations == nil {
+		return false
+	}
+	enabled, err := strconv.ParseBool(c.Annotations[common.AnnotationKeyApplicationSharding])
+	return err == nil && enabled
+}
+
+// GetApplicationShard returns the shard processing the given Application when its destination cluster is
+// application sharded. The shard is based on the hash of the Application qualified name, so that it is stable
+// across replicas and restarts.
+func GetApplicationShard(a *v1alpha1.Application, replicas int) int {
+	if replicas <= 0 {
+		return -1
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(a.QualifiedName()))
+	return int(h.Sum32() % uint32(replicas))
+}
+
 // RoundRobinDistributionFunction returns a DistributionFunction using an homogeneous distribution algorithm:
 // for a given cluster the function will return the shard number based on the modulo of the cluster rank in
 // the cluster's list sorted by uid on the shard number.
@@ -329,11 +354,15 @@ func GetOrUpdateShardFromConfigMap(kubeClient kubernetes.Interface, settingsMgr
 		return shard, nil
 	}
 	// Identify the available shard and update the ConfigMap
-	data := shardMappingCM.Data[ShardControllerMappingKey]
 	var shardMappingData []shardApplicationControllerMapping
-	err = json.Unmarshal([]byte(data), &shardMappingData)
-	if err != nil {
-		return -1, fmt.Errorf("error unmarshalling shard config map data: %w", err)
+	// the mapping may be missing if the ConfigMap was created by the load-aware sharding algorithm
+	if data, ok := shardMappingCM.Data[ShardControllerMappingKey]; ok {
+		err = json.Unmarshal([]byte(data), &shardMappingData)
+		if err != nil {
+			return -1, fmt.Errorf("error unmarshalling shard config map data: %w", err)
+		}
+	} else if shardMappingCM.Data == nil {
+		shardMappingCM.Data = map[string]string{}
 	}
 
 	shard, shardMappingData = getOrUpdateShardNumberForController(shardMappingData, hostname, replicas, shard)
@@ -110,7 +110,7 @@ func TestGetClusterFilterUnknown(t *testing.T) {
 	t.Setenv(common.EnvControllerShardingAlgorithm, "unknown")
 	replicasCount := 2
 	db.On("GetApplicationControllerReplicas").Return(replicasCount)
-	distributionFunction := GetDistributionFunction(clusterAccessor, appAccessor, "unknown", replicasCount)
+	distributionFunction := GetDistributionFunction(clusterAccessor, appAccessor, nil, "unknown", replicasCount)
 	assert.Equal(t, 0, distributionFunction(nil))
 	assert.Equal(t, 0, distributionFunction(&cluster1))
 	assert.Equal(t, 1, distributionFunction(&cluster2))
@@ -125,7 +125,7 @@ func TestLegacyGetClusterFilterWithFixedShard(t *testing.T) {
 	appAccessor, _, _, _, _, _ := createTestApps()
 	replicasCount := 5
 	db.On("GetApplicationControllerReplicas").Return(replicasCount)
-	filter := GetDistributionFunction(clusterAccessor, appAccessor, common.DefaultShardingAlgorithm, replicasCount)
+	filter := GetDistributionFunction(clusterAccessor, appAccessor, nil, common.DefaultShardingAlgorithm, replicasCount)
 	assert.Equal(t, 0, filter(nil))
 	assert.Equal(t, 4, filter(&cluster1))
 	assert.Equal(t, 1, filter(&cluster2))
@@ -135,13 +135,13 @@ func TestLegacyGetClusterFilterWithFixedShard(t *testing.T) {
 	var fixedShard int64 = 4
 	cluster5 := &v1alpha1.Cluster{ID: "5", Shard: &fixedShard}
 	clusterAccessor = getClusterAccessor([]v1alpha1.Cluster{cluster1, cluster2, cluster2, cluster4, *cluster5})
-	filter = GetDistributionFunction(clusterAccessor, appAccessor, common.DefaultShardingAlgorithm, replicasCount)
+	filter = GetDistributionFunction(clusterAccessor, appAccessor, nil, common.DefaultShardingAlgorithm, replicasCount)
 	assert.Equal(t, int(fixedShard), filter(cluster5))
 
 	fixedShard = 1
 	cluster5.Shard = &fixedShard
 	clusterAccessor = getClusterAccessor([]v1alpha1.Cluster{cluster1, cluster2, cluster2, cluster4, *cluster5})
-	filter = GetDistributionFunction(clusterAccessor, appAccessor, common.DefaultShardingAlgorithm, replicasCount)
+	filter = GetDistributionFunction(clusterAccessor, appAccessor, nil, common.DefaultShardingAlgorithm, replicasCount)
 	assert.Equal(t, int(fixedShard), filter(&v1alpha1.Cluster{ID: "4", Shard: &fixedShard}))
 }
 
@@ -153,7 +153,7 @@ func TestRoundRobinGetClusterFilterWithFixedShard(t *testing.T) {
 	replicasCount := 4
 	db.On("GetApplicationControllerReplicas").Return(replicasCount)
 
-	filter := GetDistributionFunction(clusterAccessor, appAccessor, common.RoundRobinShardingAlgorithm, replicasCount)
+	filter := GetDistributionFunction(clusterAccessor, appAccessor, nil, common.RoundRobinShardingAlgorithm, replicasCount)
 	assert.Equal(t, 0, filter(nil))
 	assert.Equal(t, 0, filter(&cluster1))
 	assert.Equal(t, 1, filter(&cluster2))
@@ -166,14 +166,14 @@ func TestRoundRobinGetClusterFilterWithFixedShard(t *testing.T) {
 	cluster5 := v1alpha1.Cluster{Name: "cluster5", ID: "5", Shard: &fixedShard}
 	clusters := []v1alpha1.Cluster{cluster1, cluster2, cluster3, cluster4, cluster5}
 	clusterAccessor = getClusterAccessor(clusters)
-	filter = GetDistributionFunction(clusterAccessor, appAccessor, common.RoundRobinShardingAlgorithm, replicasCount)
+	filter = GetDistributionFunction(clusterAccessor, appAccessor, nil, common.RoundRobinShardingAlgorithm, replicasCount)
 	assert.Equal(t, int(fixedShard), filter(&cluster5))
 
 	fixedShard = 1
 	cluster5 = v1alpha1.Cluster{Name: "cluster5", ID: "5", Shard: &fixedShard}
 	clusters = []v1alpha1.Cluster{cluster1, cluster2, cluster3, cluster4, cluster5}
 	clusterAccessor = getClusterAccessor(clusters)
-	filter = GetDistributionFunction(clusterAccessor, appAccessor, common.RoundRobinShardingAlgorithm, replicasCount)
+	filter = GetDistributionFunction(clusterAccessor, appAccessor, nil, common.RoundRobinShardingAlgorithm, replicasCount)
 	assert.Equal(t, int(fixedShard), filter(&v1alpha1.Cluster{Name: "cluster4", ID: "4", Shard: &fixedShard}))
 }
 
@@ -378,6 +378,25 @@ func TestConsistentHashingWhenClusterWithFixedShard(t *testing.T) {
 	assert.Equal(t, fixedShard, int64(distributionFunction(cluster)))
 }
 
+func TestIsApplicationShardedCluster(t *testing.T) {
+	assert.False(t, IsApplicationShardedCluster(nil))
+	assert.False(t, IsApplicationShardedCluster(&v1alpha1.Cluster{}))
+	assert.False(t, IsApplicationShardedCluster(&v1alpha1.Cluster{Annotations: map[string]string{common.AnnotationKeyApplicationSharding: "invalid"}}))
+	assert.False(t, IsApplicationShardedCluster(&v1alpha1.Cluster{Annotations: map[string]string{common.AnnotationKeyApplicationSharding: "false"}}))
+	assert.True(t, IsApplicationShardedCluster(&v1alpha1.Cluster{Annotations: map[string]string{common.AnnotationKeyApplicationSharding: "true"}}))
+}
+
+func TestGetApplicationShard(t *testing.T) {
+	app := createApp("app1", "https://kubernetes.default.svc")
+	assert.Equal(t, -1, GetApplicationShard(&app, 0))
+	assert.Equal(t, 0, GetApplicationShard(&app, 1))
+	shard := GetApplicationShard(&app, 3)
+	assert.GreaterOrEqual(t, shard, 0)
+	assert.Less(t, shard, 3)
+	// the shard is stable
+	assert.Equal(t, shard, GetApplicationShard(&app, 3))
+}
+
 func TestGetShardByIndexModuloReplicasCountDistributionFunction(t *testing.T) {
 	clusters, db, cluster1, cluster2, _, _, _ := createTestClusters()
 	replicasCount := 2
@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/sync/hook"
+	resourceutil "github.com/argoproj/gitops-engine/pkg/sync/resource"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube/scheme"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8smanagedfields "k8s.io/apimachinery/pkg/util/managedfields"
+	"k8s.io/client-go/rest"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/openapi"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo/managedfields"
+)
+
+// serverSideApplyConflicts returns the fields of the resources synced using server-side apply which are owned by
+// field managers other than the given ones and which are going to be changed by the sync.
+func serverSideApplyConflicts(lives, targets []*unstructured.Unstructured, serverSideApply bool, managers []string, parser *k8smanagedfields.GvkParser) map[kube.ResourceKey][]v1alpha1.FieldManagerConflict {
+	conflicts := map[kube.ResourceKey][]v1alpha1.FieldManagerConflict{}
+	for i, live := range lives {
+		target := targets[i]
+		if live == nil || target == nil || hook.IsHook(target) {
+			continue
+		}
+		if !serverSideApply && !resourceutil.HasAnnotationOption(target, synccommon.AnnotationSyncOptions, synccommon.SyncOptionServerSideApply) {
+			continue
+		}
+		key := kube.GetResourceKey(live)
+		found, err := managedfields.Conflicts(live, target, managers, scheme.ResolveParseableType(target.GroupVersionKind(), parser))
+		if err != nil {
+			log.Debugf("Failed to find server-side apply conflicts of %s: %v", key.String(), err)
+			continue
+		}
+		for _, conflict := range found {
+			conflicts[key] = append(conflicts[key], v1alpha1.FieldManagerConflict{Field: conflict.Field, Manager: conflict.Manager})
+		}
+	}
+	return conflicts
+}
+
+// formatFieldManagerConflicts formats the given conflicts as a comma separated list of fields and their managers
+func formatFieldManagerConflicts(conflicts []v1alpha1.FieldManagerConflict) string {
+	fields := make([]string, len(conflicts))
+	for i, conflict := range conflicts {
+		fields[i] = fmt.Sprintf("%s (%s)", conflict.Field, conflict.Manager)
+	}
+	return strings.Join(fields, ", ")
+}
+
+// conflictPolicyKubectl fails the server-side apply of the given resources instead of taking over the fields owned by
+// other field managers, which is what gitops-engine always does.
+type conflictPolicyKubectl struct {
+	kube.Kubectl
+	failed map[kube.ResourceKey][]v1alpha1.FieldManagerConflict
+}
+
+func (k *conflictPolicyKubectl) ManageResources(config *rest.Config, openAPISchema openapi.Resources) (kube.ResourceOperations, func(), error) {
+	resourceOps, cleanup, err := k.Kubectl.ManageResources(config, openAPISchema)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &conflictPolicyResourceOperations{ResourceOperations: resourceOps, failed: k.failed}, cleanup, nil
+}
+
+type conflictPolicyResourceOperations struct {
+	kube.ResourceOperations
+	failed map[kube.ResourceKey][]v1alpha1.FieldManagerConflict
+}
+
+func (o *conflictPolicyResourceOperations) ApplyResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, force, validate, serverSideApply bool, manager string) (string, error) {
+	if conflicts := o.failed[kube.GetResourceKey(obj)]; serverSideApply && len(conflicts) > 0 {
+		return "", fmt.Errorf("server-side apply conflicts with other field managers: %s", formatFieldManagerConflicts(conflicts))
+	}
+	return o.ResourceOperations.ApplyResource(ctx, obj, dryRunStrategy, force, validate, serverSideApply, manager)
+}
+
+// setResourceConflicts records the server-side apply conflicts of a resource in its sync result once the resource
+// has been applied. Subsequent iterations of the sync operation keep the conflicts found when it was applied.
+func setResourceConflicts(result *v1alpha1.ResourceResult, previous v1alpha1.ResourceResults, conflicts map[kube.ResourceKey][]v1alpha1.FieldManagerConflict, syncPolicy *v1alpha1.SyncPolicy) {
+	if _, prev := previous.Find(result.Group, result.Kind, result.Namespace, result.Name, result.SyncPhase); prev != nil && prev.Status != "" {
+		result.Conflicts = prev.Conflicts
+		return
+	}
+	if result.HookType != "" || (result.Status != synccommon.ResultCodeSynced && result.Status != synccommon.ResultCodeSyncFailed) {
+		return
+	}
+	result.Conflicts = conflicts[kube.NewResourceKey(result.Group, result.Kind, result.Namespace, result.Name)]
+	if len(result.Conflicts) > 0 && result.Status == synccommon.ResultCodeSynced && syncPolicy.GetServerSideApplyConflictAction(result.Group, result.Kind) == v1alpha1.ServerSideApplyConflictActionWarn {
+		result.Message = fmt.Sprintf("%s. Took over fields of other field managers: %s", result.Message, formatFieldManagerConflicts(result.Conflicts))
+	}
+}
+
+// setResourceNodeConflicts sets the server-side apply conflicts found by the last sync operation on the nodes of the
+// synced resources.
+func setResourceNodeConflicts(nodes []v1alpha1.ResourceNode, state *v1alpha1.OperationState) {
+	if state == nil || state.SyncResult == nil {
+		return
+	}
+	conflicts := map[kube.ResourceKey][]v1alpha1.FieldManagerConflict{}
+	for _, res := range state.SyncResult.Resources {
+		if len(res.Conflicts) > 0 {
+			conflicts[kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)] = res.Conflicts
+		}
+	}
+	if len(conflicts) == 0 {
+		return
+	}
+	for i := range nodes {
+		nodes[i].Conflicts = conflicts[kube.NewResourceKey(nodes[i].Group, nodes[i].Kind, nodes[i].Namespace, nodes[i].Name)]
+	}
+}
@@ -0,0 +1,151 @@
+package controller
+
+import (
+	"testing"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newConflictTestDeployment(replicas int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "guestbook", "namespace": "default"},
+		"spec":       map[string]any{"replicas": replicas, "revisionHistoryLimit": int64(3)},
+	}}
+}
+
+func newConflictTestLiveDeployment() *unstructured.Unstructured {
+	live := newConflictTestDeployment(3)
+	live.SetManagedFields([]metav1.ManagedFieldsEntry{{
+		Manager:    common.ArgoCDSSAManager,
+		Operation:  metav1.ManagedFieldsOperationApply,
+		APIVersion: "apps/v1",
+		FieldsType: "FieldsV1",
+		FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:revisionHistoryLimit":{}}}`)},
+	}, {
+		Manager:    "kube-controller-manager",
+		Operation:  metav1.ManagedFieldsOperationUpdate,
+		APIVersion: "apps/v1",
+		FieldsType: "FieldsV1",
+		FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:replicas":{}}}`)},
+	}})
+	return live
+}
+
+func TestServerSideApplyConflicts(t *testing.T) {
+	key := kube.NewResourceKey("apps", "Deployment", "default", "guestbook")
+	managers := []string{common.ArgoCDSSAManager}
+
+	t.Run("ServerSideApply", func(t *testing.T) {
+		target := newConflictTestDeployment(1)
+		target.Object["spec"].(map[string]any)["revisionHistoryLimit"] = int64(5)
+		conflicts := serverSideApplyConflicts([]*unstructured.Unstructured{newConflictTestLiveDeployment()}, []*unstructured.Unstructured{target}, true, managers, nil)
+		assert.Equal(t, map[kube.ResourceKey][]v1alpha1.FieldManagerConflict{
+			key: {{Field: ".spec.replicas", Manager: "kube-controller-manager"}},
+		}, conflicts)
+	})
+	t.Run("ServerSideApplyAnnotation", func(t *testing.T) {
+		target := newConflictTestDeployment(1)
+		target.SetAnnotations(map[string]string{synccommon.AnnotationSyncOptions: synccommon.SyncOptionServerSideApply})
+		conflicts := serverSideApplyConflicts([]*unstructured.Unstructured{newConflictTestLiveDeployment()}, []*unstructured.Unstructured{target}, false, managers, nil)
+		assert.Len(t, conflicts[key], 1)
+	})
+	t.Run("ClientSideApply", func(t *testing.T) {
+		conflicts := serverSideApplyConflicts([]*unstructured.Unstructured{newConflictTestLiveDeployment()}, []*unstructured.Unstructured{newConflictTestDeployment(1)}, false, managers, nil)
+		assert.Empty(t, conflicts)
+	})
+	t.Run("SameValue", func(t *testing.T) {
+		conflicts := serverSideApplyConflicts([]*unstructured.Unstructured{newConflictTestLiveDeployment()}, []*unstructured.Unstructured{newConflictTestDeployment(3)}, true, managers, nil)
+		assert.Empty(t, conflicts)
+	})
+	t.Run("MissingLive", func(t *testing.T) {
+		conflicts := serverSideApplyConflicts([]*unstructured.Unstructured{nil}, []*unstructured.Unstructured{newConflictTestDeployment(1)}, true, managers, nil)
+		assert.Empty(t, conflicts)
+	})
+}
+
+func TestConflictPolicyResourceOperations(t *testing.T) {
+	failed := newConflictTestDeployment(1)
+	other := newConflictTestDeployment(1)
+	other.SetName("other")
+	mockOps := &kubetest.MockResourceOps{}
+	ops := &conflictPolicyResourceOperations{ResourceOperations: mockOps, failed: map[kube.ResourceKey][]v1alpha1.FieldManagerConflict{
+		kube.GetResourceKey(failed): {{Field: ".spec.replicas", Manager: "kube-controller-manager"}},
+	}}
+
+	_, err := ops.ApplyResource(t.Context(), failed, cmdutil.DryRunNone, false, false, true, common.ArgoCDSSAManager)
+	require.EqualError(t, err, "server-side apply conflicts with other field managers: .spec.replicas (kube-controller-manager)")
+	assert.Empty(t, mockOps.GetLastResourceCommand(kube.GetResourceKey(failed)))
+
+	_, err = ops.ApplyResource(t.Context(), other, cmdutil.DryRunNone, false, false, true, common.ArgoCDSSAManager)
+	require.NoError(t, err)
+	assert.Equal(t, "apply", mockOps.GetLastResourceCommand(kube.GetResourceKey(other)))
+
+	// client-side apply does not conflict
+	_, err = ops.ApplyResource(t.Context(), failed, cmdutil.DryRunNone, false, false, false, "")
+	require.NoError(t, err)
+	assert.Equal(t, "apply", mockOps.GetLastResourceCommand(kube.GetResourceKey(failed)))
+}
+
+func TestSetResourceConflicts(t *testing.T) {
+	conflicts := map[kube.ResourceKey][]v1alpha1.FieldManagerConflict{
+		kube.NewResourceKey("apps", "Deployment", "default", "guestbook"): {{Field: ".spec.replicas", Manager: "kube-controller-manager"}},
+	}
+	newResult := func() *v1alpha1.ResourceResult {
+		return &v1alpha1.ResourceResult{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook", Status: synccommon.ResultCodeSynced, Message: "deployment.apps/guestbook serverside-applied", SyncPhase: synccommon.SyncPhaseSync}
+	}
+
+	t.Run("Force", func(t *testing.T) {
+		result := newResult()
+		setResourceConflicts(result, nil, conflicts, nil)
+		assert.Equal(t, conflicts[kube.NewResourceKey("apps", "Deployment", "default", "guestbook")], result.Conflicts)
+		assert.Equal(t, "deployment.apps/guestbook serverside-applied", result.Message)
+	})
+	t.Run("Warn", func(t *testing.T) {
+		result := newResult()
+		setResourceConflicts(result, nil, conflicts, &v1alpha1.SyncPolicy{ServerSideApplyConflicts: []v1alpha1.ServerSideApplyConflictPolicy{{
+			Group: "apps", Kind: "*", Action: v1alpha1.ServerSideApplyConflictActionWarn,
+		}}})
+		assert.Len(t, result.Conflicts, 1)
+		assert.Equal(t, "deployment.apps/guestbook serverside-applied. Took over fields of other field managers: .spec.replicas (kube-controller-manager)", result.Message)
+	})
+	t.Run("NotApplied", func(t *testing.T) {
+		result := newResult()
+		result.Status = ""
+		setResourceConflicts(result, nil, conflicts, nil)
+		assert.Empty(t, result.Conflicts)
+	})
+	t.Run("AppliedBefore", func(t *testing.T) {
+		previous := newResult()
+		previous.Conflicts = []v1alpha1.FieldManagerConflict{{Field: ".spec.template", Manager: "kubectl-edit"}}
+		result := newResult()
+		setResourceConflicts(result, v1alpha1.ResourceResults{previous}, conflicts, nil)
+		assert.Equal(t, previous.Conflicts, result.Conflicts)
+	})
+}
+
+func TestSetResourceNodeConflicts(t *testing.T) {
+	conflicts := []v1alpha1.FieldManagerConflict{{Field: ".spec.replicas", Manager: "kube-controller-manager"}}
+	nodes := []v1alpha1.ResourceNode{
+		{ResourceRef: v1alpha1.ResourceRef{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook"}},
+		{ResourceRef: v1alpha1.ResourceRef{Group: "apps", Kind: "ReplicaSet", Namespace: "default", Name: "guestbook-abc"}},
+	}
+	setResourceNodeConflicts(nodes, &v1alpha1.OperationState{SyncResult: &v1alpha1.SyncOperationResult{Resources: v1alpha1.ResourceResults{{
+		Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook", Conflicts: conflicts,
+	}}}})
+	assert.Equal(t, conflicts, nodes[0].Conflicts)
+	assert.Empty(t, nodes[1].Conflicts)
+
+	setResourceNodeConflicts(nodes, nil)
+}
@@ -27,6 +27,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/argoproj/argo-cd/v3/common"
 	statecache "github.com/argoproj/argo-cd/v3/controller/cache"
@@ -40,10 +41,13 @@ import (
 	argodiff "github.com/argoproj/argo-cd/v3/util/argo/diff"
 	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
 	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
+	"github.com/argoproj/argo-cd/v3/util/cosign"
 	"github.com/argoproj/argo-cd/v3/util/db"
 	"github.com/argoproj/argo-cd/v3/util/gpg"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/provenance"
 	"github.com/argoproj/argo-cd/v3/util/settings"
+	"github.com/argoproj/argo-cd/v3/util/snapshot"
 	"github.com/argoproj/argo-cd/v3/util/stats"
 )
 
@@ -89,9 +93,12 @@ type comparisonResult struct {
 	// timings maps phases of comparison to the duration it took to complete (for statistical purposes)
 	timings            map[string]time.Duration
 	diffResultList     *diff.DiffResultList
+	hasPreDeleteHooks  bool
 	hasPostDeleteHooks bool
 	// revisionsMayHaveChanges indicates if there are any possibilities that the revisions contain changes
 	revisionsMayHaveChanges bool
+	// manifestsDigests stores the digest of the manifests generated for each application source
+	manifestsDigests []string
 }
 
 func (res *comparisonResult) GetSyncStatus() *v1alpha1.SyncStatus {
@@ -108,6 +115,7 @@ type appStateManager struct {
 	db                    db.ArgoDB
 	settingsMgr           *settings.SettingsManager
 	appclientset          appclientset.Interface
+	kubeClientset         kubernetes.Interface
 	kubectl               kubeutil.Kubectl
 	onKubectlRun          kubeutil.OnKubectlRunFunc
 	repoClientset         apiclient.Clientset
@@ -121,6 +129,9 @@ type appStateManager struct {
 	repoErrorGracePeriod  time.Duration
 	serverSideDiff        bool
 	ignoreNormalizerOpts  normalizers.IgnoreNormalizerOpts
+	snapshotStore         snapshot.Store
+	snapshotRetention     int
+	helmValues            argo.HelmValuesGetter
 }
 
 // GetRepoObjs will generate the manifests for the given application delegating the
@@ -165,6 +176,10 @@ func (m *appStateManager) GetRepoObjs(app *v1alpha1.Application, sources []v1alp
 	if err != nil {
 		return nil, nil, false, fmt.Errorf("failed to get permitted OCI credentials for project %q: %w", proj.Name, err)
 	}
+	permittedGitRepos, err := argo.GetPermittedGitRepos(context.Background(), m.db, proj)
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("failed to get permitted git repositories for project %q: %w", proj.Name, err)
+	}
 
 	enabledSourceTypes, err := m.settingsMgr.GetEnabledSourceTypes()
 	if err != nil {
@@ -253,7 +268,12 @@ func (m *appStateManager) GetRepoObjs(app *v1alpha1.Application, sources []v1alp
 			appNamespace = ""
 		}
 
-		if !source.IsHelm() && !source.IsOCI() && syncedRevision != "" && keyManifestGenerateAnnotationExists && keyManifestGenerateAnnotationVal != "" {
+		helmValuesFrom, err := argo.GetHelmValuesFrom(context.Background(), m.helmValues, app.Namespace, &source)
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("failed to get helm values for source %d of %d: %w", i+1, len(sources), err)
+		}
+
+		if !source.IsHelm() && !source.IsOCI() && !source.IsArchive() && syncedRevision != "" && keyManifestGenerateAnnotationExists && keyManifestGenerateAnnotationVal != "" {
 			// Validate the manifest-generate-path annotation to avoid generating manifests if it has not changed.
 			updateRevisionResult, err := repoClient.UpdateRevisionForPaths(context.Background(), &apiclient.UpdateRevisionForPathsRequest{
 				Repo:               repo,
@@ -271,6 +291,7 @@ func (m *appStateManager) GetRepoObjs(app *v1alpha1.Application, sources []v1alp
 				RefSources:         refSources,
 				HasMultipleSources: app.Spec.HasMultipleSources(),
 				InstallationID:     installationID,
+				HelmValuesFromHash: helmValuesFrom.Hash(),
 			})
 			if err != nil {
 				return nil, nil, false, fmt.Errorf("failed to compare revisions for source %d of %d: %w", i+1, len(sources), err)
@@ -300,6 +321,12 @@ func (m *appStateManager) GetRepoObjs(app *v1alpha1.Application, sources []v1alp
 			helmRepoCreds = append(helmRepoCreds, permittedOCICredentials...)
 		}
 
+		// Signatures of OCI images are verified by the repository server before they are extracted
+		var ociSignatureVerification *v1alpha1.OCISignatureVerification
+		if source.IsOCI() {
+			ociSignatureVerification = proj.Spec.OCISignatureVerification
+		}
+
 		log.Debugf("Generating Manifest for source %s revision %s", source, revision)
 		manifestInfo, err := repoClient.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
 			Repo:                            repo,
@@ -325,6 +352,9 @@ func (m *appStateManager) GetRepoObjs(app *v1alpha1.Application, sources []v1alp
 			ProjectSourceRepos:              proj.Spec.SourceRepos,
 			AnnotationManifestGeneratePaths: app.GetAnnotation(v1alpha1.AnnotationKeyManifestGeneratePaths),
 			InstallationID:                  installationID,
+			OciSignatureVerification:        ociSignatureVerification,
+			HelmValuesFrom:                  helmValuesFrom.GetValues(),
+			GitRepos:                        permittedGitRepos,
 		})
 		if err != nil {
 			return nil, nil, false, fmt.Errorf("failed to generate manifest for source %d of %d: %w", i+1, len(sources), err)
@@ -515,13 +545,33 @@ func verifyGnuPGSignature(revision string, project *v1alpha1.AppProject, manifes
 			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
 		}
 	} else {
-		msg := fmt.Sprintf("Target revision %s in Git is not signed, but a signature is required", revision)
+		msg := fmt.Sprintf("Target revision %s is not signed, but a signature is required", revision)
 		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
 	}
 
 	return conditions
 }
 
+// verifyCosignSignature verifies the result of the cosign signature verification of an OCI image against the signature
+// verification of the project.
+func verifyCosignSignature(revision string, project *v1alpha1.AppProject, manifestInfo *apiclient.ManifestResponse) []v1alpha1.ApplicationCondition {
+	var msg string
+	if manifestInfo.VerifyResult == "" {
+		msg = fmt.Sprintf("Target revision %s is not signed, but a signature is required", revision)
+	} else if result, err := cosign.ParseResult(manifestInfo.VerifyResult); err != nil {
+		msg = fmt.Sprintf("Could not verify signature on revision '%s': %v", revision, err)
+	} else if policy, err := project.Spec.OCISignatureVerification.CosignPolicy(); err != nil {
+		msg = fmt.Sprintf("Invalid OCI signature verification in AppProject: %v", err)
+	} else if err := policy.Check(revision, result); err != nil {
+		msg = fmt.Sprintf("Signature verification of revision %s failed: %v", revision, err)
+	}
+	if msg == "" {
+		return nil
+	}
+	now := metav1.Now()
+	return []v1alpha1.ApplicationCondition{{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now}}
+}
+
 func isManagedNamespace(ns *unstructured.Unstructured, app *v1alpha1.Application) bool {
 	return ns != nil && ns.GetKind() == kubeutil.NamespaceKind && ns.GetName() == app.Spec.Destination.Namespace && app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.ManagedNamespaceMetadata != nil
 }
@@ -618,7 +668,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 	} else {
 		// Prevent applying local manifests for now when signature verification is enabled
 		// This is also enforced on API level, but as a last resort, we also enforce it here
-		if gpg.IsGPGEnabled() && verifySignature {
+		if gpg.IsGPGEnabled() && verifySignature || project.Spec.OCISignatureVerification != nil {
 			msg := "Cannot use local manifests when signature verification is required"
 			targetObjs = make([]*unstructured.Unstructured, 0)
 			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
@@ -679,6 +729,11 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 	}
 	ts.AddCheckpoint("dedup_ms")
 
+	if resourceutil.HasAnnotationOption(app, common.AnnotationCompareOptions, compareOptionSchemaValidation) {
+		conditions = append(conditions, m.validateTargetSchemas(destCluster, targetObjs)...)
+		ts.AddCheckpoint("schema_validation_ms")
+	}
+
 	liveObjByKey, err := m.liveStateCache.GetManagedLiveObjs(destCluster, app, targetObjs)
 	if err != nil {
 		liveObjByKey = make(map[kubeutil.ResourceKey]*unstructured.Unstructured)
@@ -753,8 +808,12 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 			}
 		}
 	}
+	hasPreDeleteHooks := false
 	hasPostDeleteHooks := false
 	for _, obj := range targetObjs {
+		if isPreDeleteHook(obj) {
+			hasPreDeleteHooks = true
+		}
 		if isPostDeleteHook(obj) {
 			hasPostDeleteHooks = true
 		}
@@ -961,9 +1020,15 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 
 	// Git has already performed the signature verification via its GPG interface, and the result is available
 	// in the manifest info received from the repository server. We now need to form our opinion about the result
-	// and stop processing if we do not agree about the outcome.
-	for _, manifestInfo := range manifestInfos {
-		if gpg.IsGPGEnabled() && verifySignature && manifestInfo != nil {
+	// and stop processing if we do not agree about the outcome. The same applies to the cosign signatures of OCI
+	// images, verified by the repository server.
+	for i, manifestInfo := range manifestInfos {
+		if manifestInfo == nil {
+			continue
+		}
+		if sources[i].IsOCI() && project.Spec.OCISignatureVerification != nil {
+			conditions = append(conditions, verifyCosignSignature(manifestInfo.Revision, project, manifestInfo)...)
+		} else if gpg.IsGPGEnabled() && verifySignature {
 			conditions = append(conditions, verifyGnuPGSignature(manifestInfo.Revision, project, manifestInfo)...)
 		}
 	}
@@ -976,10 +1041,15 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 		reconciliationResult:    reconciliation,
 		diffConfig:              diffConfig,
 		diffResultList:          diffResults,
+		hasPreDeleteHooks:       hasPreDeleteHooks,
 		hasPostDeleteHooks:      hasPostDeleteHooks,
 		revisionsMayHaveChanges: revisionsMayHaveChanges,
 	}
 
+	for _, manifestInfo := range manifestInfos {
+		compRes.manifestsDigests = append(compRes.manifestsDigests, provenance.Digest(manifestInfo.Manifests))
+	}
+
 	if hasMultipleSources {
 		for _, manifestInfo := range manifestInfos {
 			compRes.appSourceTypes = append(compRes.appSourceTypes, v1alpha1.ApplicationSourceType(manifestInfo.SourceType))
@@ -996,6 +1066,8 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 		v1alpha1.ApplicationConditionSharedResourceWarning:   true,
 		v1alpha1.ApplicationConditionRepeatedResourceWarning: true,
 		v1alpha1.ApplicationConditionExcludedResourceWarning: true,
+		v1alpha1.ApplicationConditionSchemaValidationError:   true,
+		v1alpha1.ApplicationConditionSchemaValidationWarning: true,
 	})
 	ts.AddCheckpoint("health_ms")
 	compRes.timings = ts.Timings()
@@ -1059,6 +1131,7 @@ func (m *appStateManager) persistRevisionHistory(
 	source v1alpha1.ApplicationSource,
 	revisions []string,
 	sources []v1alpha1.ApplicationSource,
+	manifestsDigests []string,
 	hasMultipleSources bool,
 	startedAt metav1.Time,
 	initiatedBy v1alpha1.OperationInitiator,
@@ -1070,22 +1143,27 @@ func (m *appStateManager) persistRevisionHistory(
 
 	if hasMultipleSources {
 		app.Status.History = append(app.Status.History, v1alpha1.RevisionHistory{
-			DeployedAt:      metav1.NewTime(time.Now().UTC()),
-			DeployStartedAt: &startedAt,
-			ID:              nextID,
-			Sources:         sources,
-			Revisions:       revisions,
-			InitiatedBy:     initiatedBy,
+			DeployedAt:       metav1.NewTime(time.Now().UTC()),
+			DeployStartedAt:  &startedAt,
+			ID:               nextID,
+			Sources:          sources,
+			Revisions:        revisions,
+			InitiatedBy:      initiatedBy,
+			ManifestsDigests: manifestsDigests,
 		})
 	} else {
-		app.Status.History = append(app.Status.History, v1alpha1.RevisionHistory{
+		history := v1alpha1.RevisionHistory{
 			Revision:        revision,
 			DeployedAt:      metav1.NewTime(time.Now().UTC()),
 			DeployStartedAt: &startedAt,
 			ID:              nextID,
 			Source:          source,
 			InitiatedBy:     initiatedBy,
-		})
+		}
+		if len(manifestsDigests) > 0 {
+			history.ManifestsDigest = manifestsDigests[0]
+		}
+		app.Status.History = append(app.Status.History, history)
 	}
 
 	app.Status.History = app.Status.History.Trunc(app.Spec.GetRevisionHistoryLimit())
@@ -1106,6 +1184,7 @@ func (m *appStateManager) persistRevisionHistory(
 func NewAppStateManager(
 	db db.ArgoDB,
 	appclientset appclientset.Interface,
+	kubeClientset kubernetes.Interface,
 	repoClientset apiclient.Clientset,
 	namespace string,
 	kubectl kubeutil.Kubectl,
@@ -1120,12 +1199,16 @@ func NewAppStateManager(
 	repoErrorGracePeriod time.Duration,
 	serverSideDiff bool,
 	ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts,
+	snapshotStore snapshot.Store,
+	snapshotRetention int,
+	helmValues argo.HelmValuesGetter,
 ) AppStateManager {
 	return &appStateManager{
 		liveStateCache:        liveStateCache,
 		cache:                 cache,
 		db:                    db,
 		appclientset:          appclientset,
+		kubeClientset:         kubeClientset,
 		kubectl:               kubectl,
 		onKubectlRun:          onKubectlRun,
 		repoClientset:         repoClientset,
@@ -1138,6 +1221,9 @@ func NewAppStateManager(
 		repoErrorGracePeriod:  repoErrorGracePeriod,
 		serverSideDiff:        serverSideDiff,
 		ignoreNormalizerOpts:  ignoreNormalizerOpts,
+		snapshotStore:         snapshotStore,
+		snapshotRetention:     snapshotRetention,
+		helmValues:            helmValues,
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -32,6 +33,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v3/test"
+	"github.com/argoproj/argo-cd/v3/util/cosign"
 )
 
 // TestCompareAppStateEmpty tests comparison when both git and live have no objects
@@ -914,7 +916,7 @@ func Test_appStateManager_persistRevisionHistory(t *testing.T) {
 		app.Spec.RevisionHistoryLimit = &i
 	}
 	addHistory := func() {
-		err := manager.persistRevisionHistory(app, "my-revision", v1alpha1.ApplicationSource{}, []string{}, []v1alpha1.ApplicationSource{}, false, metav1.Time{}, v1alpha1.OperationInitiator{})
+		err := manager.persistRevisionHistory(app, "my-revision", v1alpha1.ApplicationSource{}, []string{}, []v1alpha1.ApplicationSource{}, nil, false, metav1.Time{}, v1alpha1.OperationInitiator{})
 		require.NoError(t, err)
 	}
 	addHistory()
@@ -950,10 +952,18 @@ func Test_appStateManager_persistRevisionHistory(t *testing.T) {
 	assert.Len(t, app.Status.History, 9)
 
 	metav1NowTime := metav1.NewTime(time.Now())
-	err := manager.persistRevisionHistory(app, "my-revision", v1alpha1.ApplicationSource{}, []string{}, []v1alpha1.ApplicationSource{}, false, metav1NowTime, v1alpha1.OperationInitiator{})
+	err := manager.persistRevisionHistory(app, "my-revision", v1alpha1.ApplicationSource{}, []string{}, []v1alpha1.ApplicationSource{}, nil, false, metav1NowTime, v1alpha1.OperationInitiator{})
 	require.NoError(t, err)
 	assert.Equal(t, app.Status.History.LastRevisionHistory().DeployStartedAt, &metav1NowTime)
 
+	err = manager.persistRevisionHistory(app, "my-revision", v1alpha1.ApplicationSource{}, []string{}, []v1alpha1.ApplicationSource{}, []string{"sha256:abcd"}, false, metav1NowTime, v1alpha1.OperationInitiator{})
+	require.NoError(t, err)
+	assert.Equal(t, "sha256:abcd", app.Status.History.LastRevisionHistory().ManifestsDigest)
+
+	err = manager.persistRevisionHistory(app, "", v1alpha1.ApplicationSource{}, []string{"rev-1", "rev-2"}, []v1alpha1.ApplicationSource{{}, {}}, []string{"sha256:abcd", "sha256:ef01"}, true, metav1NowTime, v1alpha1.OperationInitiator{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sha256:abcd", "sha256:ef01"}, app.Status.History.LastRevisionHistory().ManifestsDigests)
+
 	// negative limit to 0
 	setRevisionHistoryLimit(-1)
 	addHistory()
@@ -1283,6 +1293,44 @@ func TestSignedResponseSignatureRequired(t *testing.T) {
 	}
 }
 
+func TestVerifyCosignSignature(t *testing.T) {
+	digest := "sha256:" + strings.Repeat("a", 64)
+	project := &v1alpha1.AppProject{Spec: v1alpha1.AppProjectSpec{
+		OCISignatureVerification: &v1alpha1.OCISignatureVerification{
+			Identities: []v1alpha1.OCISigningIdentity{{Issuer: "https://token.actions.githubusercontent.com", Subject: "https://github.com/argoproj/*"}},
+		},
+	}}
+	resultOf := func(subject string) string {
+		return (&cosign.Result{
+			Digest:  digest,
+			Signers: []cosign.Signer{{Issuer: "https://token.actions.githubusercontent.com", Subject: subject}},
+		}).String()
+	}
+
+	tests := []struct {
+		name         string
+		verifyResult string
+		message      string
+	}{
+		{name: "Trusted signer", verifyResult: resultOf("https://github.com/argoproj/argo-cd")},
+		{name: "Untrusted signer", verifyResult: resultOf("https://github.com/example/argo-cd"), message: "Signature verification of revision " + digest + " failed: found signatures of " + digest + " made by identity https://github.com/example/argo-cd issued by https://token.actions.githubusercontent.com, but none of them is allowed in AppProject"},
+		{name: "Not signed", message: "Target revision " + digest + " is not signed, but a signature is required"},
+		{name: "Invalid result", verifyResult: "gpg: Good signature", message: "Could not verify signature on revision '" + digest + "'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conditions := verifyCosignSignature(digest, project, &apiclient.ManifestResponse{Revision: digest, VerifyResult: tt.verifyResult})
+			if tt.message == "" {
+				assert.Empty(t, conditions)
+				return
+			}
+			require.Len(t, conditions, 1)
+			assert.Equal(t, v1alpha1.ApplicationConditionComparisonError, conditions[0].Type)
+			assert.Contains(t, conditions[0].Message, tt.message)
+		})
+	}
+}
+
 func TestComparisonResult_GetHealthStatus(t *testing.T) {
 	status := health.HealthStatusMissing
 	res := comparisonResult{
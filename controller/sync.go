@@ -138,12 +138,14 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 		state.SyncResult = newSyncOperationResult(app, syncOp)
 	}
 
-	if isBlocked, err := syncWindowPreventsSync(app, project); isBlocked {
+	if isBlocked, reason, err := m.syncWindowPreventsSync(app, project); isBlocked {
 		// If the operation is currently running, simply let the user know the sync is blocked by a current sync window
 		if state.Phase == common.OperationRunning {
 			state.Message = "Sync operation blocked by sync window"
 			if err != nil {
 				state.Message = fmt.Sprintf("%s: %v", state.Message, err)
+			} else if reason != "" {
+				state.Message = fmt.Sprintf("%s: %s", state.Message, reason)
 			}
 		}
 		return
@@ -157,8 +159,19 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 		revisions = []string{state.SyncResult.Revision}
 	}
 
+	localManifests := syncOp.Manifests
+	var restoredResources map[kube.ResourceKey]bool
+	if syncOp.RestoreSnapshot != "" {
+		localManifests, restoredResources, err = m.loadSnapshot(app, syncOp.RestoreSnapshot)
+		if err != nil {
+			state.Phase = common.OperationError
+			state.Message = fmt.Sprintf("Failed to load snapshot %s: %v", syncOp.RestoreSnapshot, err)
+			return
+		}
+	}
+
 	// ignore error if CompareStateRepoError, this shouldn't happen as noRevisionCache is true
-	compareResult, err := m.CompareAppState(app, project, revisions, sources, false, true, syncOp.Manifests, isMultiSourceSync)
+	compareResult, err := m.CompareAppState(app, project, revisions, sources, false, true, localManifests, isMultiSourceSync)
 	if err != nil && !stderrors.Is(err, ErrCompareStateRepo) {
 		state.Phase = common.OperationError
 		state.Message = err.Error()
@@ -169,6 +182,14 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 	// what we should be syncing to when resuming operations.
 	state.SyncResult.Revision = compareResult.syncStatus.Revision
 	state.SyncResult.Revisions = compareResult.syncStatus.Revisions
+	// Record the digests of the generated manifests, which are the subjects of their provenance attestations
+	state.SyncResult.ManifestsDigest = ""
+	state.SyncResult.ManifestsDigests = nil
+	if isMultiSourceSync {
+		state.SyncResult.ManifestsDigests = compareResult.manifestsDigests
+	} else if len(compareResult.manifestsDigests) > 0 {
+		state.SyncResult.ManifestsDigest = compareResult.manifestsDigests[0]
+	}
 
 	// validates if it should fail the sync on that revision if it finds shared resources
 	hasSharedResource, sharedResourceMessage := hasSharedResourceCondition(app)
@@ -257,6 +278,12 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 	}
 
 	reconciliationResult := compareResult.reconciliationResult
+	skipHooks := syncOp.IsApplyStrategy() || len(syncOp.Resources) > 0
+	if syncOp.FailedResourcesOnly {
+		// unlike other selective syncs, the retry of the failed resources of an operation runs the hooks of that operation
+		reconciliationResult.Hooks = selectHooks(reconciliationResult.Hooks, syncOp.Resources, app.Spec.Destination.Namespace)
+		skipHooks = syncOp.IsApplyStrategy() || len(reconciliationResult.Hooks) == 0
+	}
 
 	// if RespectIgnoreDifferences is enabled, it should normalize the target
 	// resources which in this case applies the live values in the configured
@@ -304,6 +331,53 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 		}
 	}
 
+	serverSideApply := syncOp.SyncOptions.HasOption(common.SyncOptionServerSideApply)
+	conflictManagers := []string{cdcommon.ArgoCDSSAManager}
+	if !syncOp.SyncOptions.HasOption(common.SyncOptionDisableClientSideApplyMigration) {
+		// the fields of the client-side apply manager are migrated to Argo CD before applying
+		conflictManagers = append(conflictManagers, clientSideApplyManager)
+	}
+	gvkParser, err := m.getGVKParser(destCluster)
+	if err != nil {
+		logEntry.Warnf("Failed to get GVK parser, server-side apply conflicts are detected without schema: %v", err)
+	}
+	ssaConflicts := serverSideApplyConflicts(reconciliationResult.Live, reconciliationResult.Target, serverSideApply, conflictManagers, gvkParser)
+	kubectl := m.kubectl
+	failedConflicts := map[kube.ResourceKey][]v1alpha1.FieldManagerConflict{}
+	for key, conflicts := range ssaConflicts {
+		if app.Spec.SyncPolicy.GetServerSideApplyConflictAction(key.Group, key.Kind) == v1alpha1.ServerSideApplyConflictActionFail {
+			failedConflicts[key] = conflicts
+		}
+	}
+	if len(failedConflicts) > 0 {
+		kubectl = &conflictPolicyKubectl{Kubectl: m.kubectl, failed: failedConflicts}
+	}
+
+	// the quotas are checked before the first resource is changed by the operation
+	if syncOp.SyncOptions.HasOption(syncOptionQuotaPreflight) && state.Phase != common.OperationTerminating && len(state.SyncResult.Resources) == 0 {
+		report, err := m.checkResourceQuotas(destCluster, syncOp, reconciliationResult)
+		if err != nil {
+			state.Phase = common.OperationError
+			state.Message = fmt.Sprintf("Failed to check resource quotas: %v", err)
+			return
+		}
+		if report != "" {
+			state.Phase = common.OperationFailed
+			state.Message = "Insufficient resource quota: " + report
+			return
+		}
+	}
+
+	// the snapshot is taken before the first resource is changed by the operation
+	if syncOp.SyncOptions.HasOption(syncOptionSnapshot) && !syncOp.DryRun && state.Phase != common.OperationTerminating && state.SyncResult.Snapshot == "" && len(state.SyncResult.Resources) == 0 {
+		state.SyncResult.Snapshot, err = m.snapshotLiveState(app, state, compareResult, reconciliationResult)
+		if err != nil {
+			state.Phase = common.OperationError
+			state.Message = fmt.Sprintf("Failed to snapshot live state: %v", err)
+			return
+		}
+	}
+
 	opts := []sync.SyncOpt{
 		sync.WithLogr(logutils.NewLogrusLogger(logEntry)),
 		sync.WithHealthOverride(lua.ResourceHealthOverrides(resourceOverrides)),
@@ -325,12 +399,14 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 			}
 			return nil
 		}),
-		sync.WithOperationSettings(syncOp.DryRun, syncOp.Prune, syncOp.SyncStrategy.Force(), syncOp.IsApplyStrategy() || len(syncOp.Resources) > 0),
+		sync.WithOperationSettings(syncOp.DryRun, syncOp.Prune, syncOp.SyncStrategy.Force(), skipHooks),
 		sync.WithInitialState(state.Phase, state.Message, initialResourcesRes, state.StartedAt),
 		sync.WithResourcesFilter(func(key kube.ResourceKey, target *unstructured.Unstructured, live *unstructured.Unstructured) bool {
 			return (len(syncOp.Resources) == 0 ||
+				isPreDeleteHook(target) ||
 				isPostDeleteHook(target) ||
 				argo.ContainsSyncResource(key.Name, key.Namespace, schema.GroupVersionKind{Kind: key.Kind, Group: key.Group}, syncOp.Resources)) &&
+				(restoredResources == nil || restoredResources[key]) &&
 				m.isSelfReferencedObj(live, target, app.GetName(), v1alpha1.TrackingMethod(trackingMethod), installationID)
 		}),
 		sync.WithManifestValidation(!syncOp.SyncOptions.HasOption(common.SyncOptionsDisableValidation)),
@@ -339,7 +415,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 		sync.WithResourceModificationChecker(syncOp.SyncOptions.HasOption("ApplyOutOfSyncOnly=true"), compareResult.diffResultList),
 		sync.WithPrunePropagationPolicy(&prunePropagationPolicy),
 		sync.WithReplace(syncOp.SyncOptions.HasOption(common.SyncOptionReplace)),
-		sync.WithServerSideApply(syncOp.SyncOptions.HasOption(common.SyncOptionServerSideApply)),
+		sync.WithServerSideApply(serverSideApply),
 		sync.WithServerSideApplyManager(cdcommon.ArgoCDSSAManager),
 		sync.WithClientSideApplyMigration(
 			!syncOp.SyncOptions.HasOption(common.SyncOptionDisableClientSideApplyMigration),
@@ -358,7 +434,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 		reconciliationResult,
 		restConfig,
 		rawConfig,
-		m.kubectl,
+		kubectl,
 		app.Spec.Destination.Namespace,
 		openAPISchema,
 		opts...,
@@ -380,6 +456,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 	}
 	var resState []common.ResourceSyncResult
 	state.Phase, state.Message, resState = syncCtx.GetState()
+	previousResults := state.SyncResult.Resources
 	state.SyncResult.Resources = nil
 
 	if app.Spec.SyncPolicy != nil {
@@ -404,7 +481,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 			res.Message = augmentedMsg
 		}
 
-		state.SyncResult.Resources = append(state.SyncResult.Resources, &v1alpha1.ResourceResult{
+		result := &v1alpha1.ResourceResult{
 			HookType:  res.HookType,
 			Group:     res.ResourceKey.Group,
 			Kind:      res.ResourceKey.Kind,
@@ -416,13 +493,15 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 			Status:    res.Status,
 			Message:   res.Message,
 			Images:    res.Images,
-		})
+		}
+		setResourceConflicts(result, previousResults, ssaConflicts, app.Spec.SyncPolicy)
+		state.SyncResult.Resources = append(state.SyncResult.Resources, result)
 	}
 
 	logEntry.WithField("duration", time.Since(start)).Info("sync/terminate complete")
 
-	if !syncOp.DryRun && len(syncOp.Resources) == 0 && state.Phase.Successful() {
-		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, compareResult.syncStatus.ComparedTo.Source, compareResult.syncStatus.Revisions, compareResult.syncStatus.ComparedTo.Sources, isMultiSourceSync, state.StartedAt, state.Operation.InitiatedBy)
+	if !syncOp.DryRun && len(syncOp.Resources) == 0 && syncOp.RestoreSnapshot == "" && state.Phase.Successful() {
+		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, compareResult.syncStatus.ComparedTo.Source, compareResult.syncStatus.Revisions, compareResult.syncStatus.ComparedTo.Sources, compareResult.manifestsDigests, isMultiSourceSync, state.StartedAt, state.Operation.InitiatedBy)
 		if err != nil {
 			state.Phase = common.OperationError
 			state.Message = fmt.Sprintf("failed to record sync to history: %v", err)
@@ -430,6 +509,31 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 	}
 }
 
+// selectHooks returns the hooks selected by the resources of a selective sync. The name of a hook with a generated name
+// depends on the operation it was created by, so such a hook is selected by any resource of its kind whose name starts
+// with its generateName.
+func selectHooks(hooks []*unstructured.Unstructured, resources []v1alpha1.SyncOperationResource, defaultNamespace string) []*unstructured.Unstructured {
+	var selected []*unstructured.Unstructured
+	for _, obj := range hooks {
+		gvk := obj.GroupVersionKind()
+		namespace := obj.GetNamespace()
+		if namespace == "" {
+			namespace = defaultNamespace
+		}
+		for _, r := range resources {
+			matches := r.HasIdentity(obj.GetName(), namespace, gvk)
+			if obj.GetName() == "" && obj.GetGenerateName() != "" {
+				matches = r.HasIdentity(r.Name, namespace, gvk) && strings.HasPrefix(r.Name, obj.GetGenerateName())
+			}
+			if matches {
+				selected = append(selected, obj)
+				break
+			}
+		}
+	}
+	return selected
+}
+
 // normalizeTargetResources modifies target resources to ensure ignored fields are not touched during synchronization:
 //   - applies normalization to the target resources based on the live resources
 //   - copies ignored fields from the matching live resources: apply normalizer to the live resource,
@@ -555,8 +659,14 @@ func delayBetweenSyncWaves(_ common.SyncPhase, _ int, finalWave bool) error {
 	return nil
 }
 
-func syncWindowPreventsSync(app *v1alpha1.Application, proj *v1alpha1.AppProject) (bool, error) {
-	window := proj.Spec.SyncWindows.Matches(app)
+// syncWindowPreventsSync returns true if the sync windows of the application prevent its sync, along with the reason
+// given by the active deny windows
+func (m *appStateManager) syncWindowPreventsSync(app *v1alpha1.Application, proj *v1alpha1.AppProject) (bool, string, error) {
+	window, err := argo.GetSyncWindows(app, proj, m.settingsMgr)
+	if err != nil {
+		// prevents sync because sync window has an error
+		return true, "", err
+	}
 	isManual := false
 	if app.Status.OperationState != nil {
 		isManual = !app.Status.OperationState.Operation.InitiatedBy.Automated
@@ -564,9 +674,13 @@ func syncWindowPreventsSync(app *v1alpha1.Application, proj *v1alpha1.AppProject
 	canSync, err := window.CanSync(isManual)
 	if err != nil {
 		// prevents sync because sync window has an error
-		return true, err
+		return true, "", err
+	}
+	if canSync {
+		return false, "", nil
 	}
-	return !canSync, nil
+	reason, err := window.BlockReason()
+	return true, reason, err
 }
 
 // deriveServiceAccountToImpersonate determines the service account to be used for impersonation for the sync operation.
@@ -0,0 +1,369 @@
+package controller
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/sync"
+	"github.com/argoproj/gitops-engine/pkg/sync/hook"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	resourcehelper "k8s.io/kubectl/pkg/util/resource"
+
+	statecache "github.com/argoproj/argo-cd/v3/controller/cache"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+// syncOptionQuotaPreflight enables checking the resource quotas of the destination namespaces before a sync
+const syncOptionQuotaPreflight = "QuotaPreflight=true"
+
+// quotaLimitsPrefix is the prefix of the quota resources tracking the limits of the containers
+const quotaLimitsPrefix = "limits."
+
+// quotaRequestResources are the resources which quotas also track by their name without the requests. prefix
+var quotaRequestResources = []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory, corev1.ResourceEphemeralStorage}
+
+// namespaceQuota is a resource quota of a destination namespace
+type namespaceQuota struct {
+	name string
+	info *statecache.ResourceQuotaInfo
+}
+
+// namespaceQuotas are the resource quotas and the container defaults of a namespace
+type namespaceQuotas struct {
+	quotas   []namespaceQuota
+	defaults *statecache.LimitRangeInfo
+}
+
+// quotaShortfall is a quota resource which would be exceeded by the sync
+type quotaShortfall struct {
+	quota    string
+	resource corev1.ResourceName
+	required resource.Quantity
+	used     resource.Quantity
+	hard     resource.Quantity
+}
+
+func (s quotaShortfall) String() string {
+	available := s.hard.DeepCopy()
+	available.Sub(s.used)
+	if available.Sign() < 0 {
+		available = resource.Quantity{}
+	}
+	return fmt.Sprintf("quota %s requires %s more %s, only %s of %s available", s.quota, s.required.String(), s.resource, available.String(), s.hard.String())
+}
+
+// workloadPodSpec returns the pod spec of the given workload and the number of pods it runs. The number of pods is
+// negative if the workload does not specify it. Resources which don't run pods return a nil pod spec.
+func workloadPodSpec(obj *unstructured.Unstructured) (*corev1.PodSpec, int64, error) {
+	if obj == nil {
+		return nil, 0, nil
+	}
+	var specPath []string
+	var replicasPath []string
+	gvk := obj.GroupVersionKind()
+	switch {
+	case gvk.Group == "" && gvk.Kind == kube.PodKind:
+		specPath = []string{"spec"}
+	case gvk.Group == "" && gvk.Kind == "ReplicationController",
+		gvk.Group == "apps" && (gvk.Kind == kube.DeploymentKind || gvk.Kind == kube.StatefulSetKind || gvk.Kind == kube.ReplicaSetKind):
+		specPath = []string{"spec", "template", "spec"}
+		replicasPath = []string{"spec", "replicas"}
+	case gvk.Group == "batch" && gvk.Kind == kube.JobKind:
+		specPath = []string{"spec", "template", "spec"}
+		replicasPath = []string{"spec", "parallelism"}
+	default:
+		return nil, 0, nil
+	}
+	podSpec, ok, err := unstructured.NestedMap(obj.Object, specPath...)
+	if err != nil || !ok {
+		return nil, 0, err
+	}
+	spec := &corev1.PodSpec{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(podSpec, spec); err != nil {
+		return nil, 0, fmt.Errorf("failed to read pod spec of %s %s/%s: %w", obj.GetKind(), obj.GetNamespace(), obj.GetName(), err)
+	}
+	replicas := int64(1)
+	if replicasPath != nil {
+		replicas, ok, err = unstructured.NestedInt64(obj.Object, replicasPath...)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !ok {
+			replicas = -1
+		}
+	}
+	return spec, replicas, nil
+}
+
+// podQuotaUsage returns the usage of a single pod with the given spec as tracked by resource quotas. The container
+// defaults of the namespace limit ranges are applied to containers which don't specify their resources.
+func podQuotaUsage(spec *corev1.PodSpec, defaults *statecache.LimitRangeInfo) corev1.ResourceList {
+	pod := &corev1.Pod{Spec: *spec.DeepCopy()}
+	applyDefaults := func(containers []corev1.Container) {
+		for i := range containers {
+			resources := &containers[i].Resources
+			if resources.Requests == nil {
+				resources.Requests = corev1.ResourceList{}
+			}
+			if resources.Limits == nil {
+				resources.Limits = corev1.ResourceList{}
+			}
+			// requests default to the limits of the container before the limit range defaults are applied
+			for name, quantity := range resources.Limits {
+				if _, ok := resources.Requests[name]; !ok {
+					resources.Requests[name] = quantity
+				}
+			}
+			if defaults == nil {
+				continue
+			}
+			for name, quantity := range defaults.DefaultLimits {
+				if _, ok := resources.Limits[name]; !ok {
+					resources.Limits[name] = quantity
+				}
+			}
+			for name, quantity := range defaults.DefaultRequests {
+				if _, ok := resources.Requests[name]; !ok {
+					resources.Requests[name] = quantity
+				}
+			}
+		}
+	}
+	applyDefaults(pod.Spec.InitContainers)
+	applyDefaults(pod.Spec.Containers)
+
+	requests, limits := resourcehelper.PodRequestsAndLimits(pod)
+	usage := corev1.ResourceList{corev1.ResourcePods: *resource.NewQuantity(1, resource.DecimalSI)}
+	for name, quantity := range requests {
+		usage[corev1.DefaultResourceRequestsPrefix+name] = quantity
+		if slices.Contains(quotaRequestResources, name) {
+			usage[name] = quantity
+		}
+	}
+	for name, quantity := range limits {
+		usage[quotaLimitsPrefix+name] = quantity
+	}
+	return usage
+}
+
+// addQuotaUsage adds the usage of the given number of pods to the total usage
+func addQuotaUsage(total corev1.ResourceList, usage corev1.ResourceList, pods int64) {
+	for name, quantity := range usage {
+		value := total[name]
+		value.Add(*resource.NewMilliQuantity(quantity.MilliValue()*pods, quantity.Format))
+		total[name] = value
+	}
+}
+
+// quotaUsageDelta returns the change of the quota usage of each namespace caused by the sync operation, by comparing
+// the pods run by the target workloads with the pods run by their live counterparts
+func quotaUsageDelta(syncOp v1alpha1.SyncOperation, reconciliationResult sync.ReconciliationResult, namespaces map[string]*namespaceQuotas) (map[string]corev1.ResourceList, error) {
+	deltas := map[string]corev1.ResourceList{}
+	for i, target := range reconciliationResult.Target {
+		live := reconciliationResult.Live[i]
+		obj := target
+		if obj == nil {
+			obj = live
+		}
+		if obj == nil || obj.GetNamespace() == "" || hook.IsHook(obj) {
+			continue
+		}
+		if len(syncOp.Resources) > 0 && !argo.ContainsSyncResource(obj.GetName(), obj.GetNamespace(), obj.GroupVersionKind(), syncOp.Resources) {
+			continue
+		}
+		if target == nil && !syncOp.Prune {
+			continue
+		}
+		var defaults *statecache.LimitRangeInfo
+		if quotas, ok := namespaces[obj.GetNamespace()]; ok {
+			defaults = quotas.defaults
+		}
+
+		targetSpec, targetPods, err := workloadPodSpec(target)
+		if err != nil {
+			return nil, err
+		}
+		liveSpec, livePods, err := workloadPodSpec(live)
+		if err != nil {
+			return nil, err
+		}
+		if targetSpec == nil && liveSpec == nil {
+			continue
+		}
+		if targetPods < 0 {
+			// the replicas of workloads which don't specify them, e.g. because of an autoscaler, are kept
+			targetPods = max(livePods, 1)
+		}
+		if livePods < 0 {
+			livePods = 1
+		}
+
+		delta, ok := deltas[obj.GetNamespace()]
+		if !ok {
+			delta = corev1.ResourceList{}
+			deltas[obj.GetNamespace()] = delta
+		}
+		if targetSpec != nil {
+			addQuotaUsage(delta, podQuotaUsage(targetSpec, defaults), targetPods)
+		}
+		if liveSpec != nil {
+			addQuotaUsage(delta, podQuotaUsage(liveSpec, defaults), -livePods)
+		}
+	}
+	return deltas, nil
+}
+
+// getNamespaceQuotas returns the resource quotas and the limit range defaults of the given namespaces from the
+// cluster cache
+func getNamespaceQuotas(cluster clustercache.ClusterCache, namespaces []string) map[string]*namespaceQuotas {
+	res := map[string]*namespaceQuotas{}
+	for _, namespace := range namespaces {
+		resources := cluster.FindResources(namespace, func(r *clustercache.Resource) bool {
+			key := r.ResourceKey()
+			return key.Group == "" && (key.Kind == "ResourceQuota" || key.Kind == "LimitRange")
+		})
+		keys := make([]kube.ResourceKey, 0, len(resources))
+		for key := range resources {
+			keys = append(keys, key)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return keys[i].String() < keys[j].String()
+		})
+
+		quotas := &namespaceQuotas{}
+		for _, key := range keys {
+			info, ok := resources[key].Info.(*statecache.ResourceInfo)
+			if !ok {
+				continue
+			}
+			if info.ResourceQuotaInfo != nil {
+				quotas.quotas = append(quotas.quotas, namespaceQuota{name: key.Name, info: info.ResourceQuotaInfo})
+			}
+			if info.LimitRangeInfo != nil {
+				// the first limit range which specifies a default wins, like in the LimitRanger admission plugin
+				if quotas.defaults == nil {
+					quotas.defaults = &statecache.LimitRangeInfo{DefaultRequests: corev1.ResourceList{}, DefaultLimits: corev1.ResourceList{}}
+				}
+				for name, quantity := range info.LimitRangeInfo.DefaultRequests {
+					if _, ok := quotas.defaults.DefaultRequests[name]; !ok {
+						quotas.defaults.DefaultRequests[name] = quantity
+					}
+				}
+				for name, quantity := range info.LimitRangeInfo.DefaultLimits {
+					if _, ok := quotas.defaults.DefaultLimits[name]; !ok {
+						quotas.defaults.DefaultLimits[name] = quantity
+					}
+				}
+			}
+		}
+		res[namespace] = quotas
+	}
+	return res
+}
+
+// targetQuotaLimits returns the hard limits of the resource quotas which are updated by the sync operation
+func targetQuotaLimits(reconciliationResult sync.ReconciliationResult) (map[kube.ResourceKey]corev1.ResourceList, error) {
+	res := map[kube.ResourceKey]corev1.ResourceList{}
+	for _, target := range reconciliationResult.Target {
+		if target == nil || target.GroupVersionKind().Group != "" || target.GetKind() != "ResourceQuota" {
+			continue
+		}
+		quota := corev1.ResourceQuota{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(target.Object, &quota); err != nil {
+			return nil, fmt.Errorf("failed to read resource quota %s/%s: %w", target.GetNamespace(), target.GetName(), err)
+		}
+		res[kube.GetResourceKey(target)] = quota.Spec.Hard
+	}
+	return res, nil
+}
+
+// quotaShortfalls returns the quota resources of each namespace which would be exceeded by the given usage changes.
+// Quotas with scopes are ignored since the pods they track cannot be determined reliably.
+func quotaShortfalls(deltas map[string]corev1.ResourceList, namespaces map[string]*namespaceQuotas, targetLimits map[kube.ResourceKey]corev1.ResourceList) map[string][]quotaShortfall {
+	res := map[string][]quotaShortfall{}
+	for namespace, delta := range deltas {
+		quotas, ok := namespaces[namespace]
+		if !ok {
+			continue
+		}
+		for _, quota := range quotas.quotas {
+			if quota.info.Scoped {
+				continue
+			}
+			hardLimits := quota.info.Hard
+			if limits, ok := targetLimits[kube.NewResourceKey("", "ResourceQuota", namespace, quota.name)]; ok {
+				hardLimits = limits
+			}
+			names := make([]string, 0, len(hardLimits))
+			for name := range hardLimits {
+				names = append(names, string(name))
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				required, ok := delta[corev1.ResourceName(name)]
+				if !ok || required.Sign() <= 0 {
+					continue
+				}
+				hard := hardLimits[corev1.ResourceName(name)]
+				used := quota.info.Used[corev1.ResourceName(name)]
+				total := used.DeepCopy()
+				total.Add(required)
+				if total.Cmp(hard) > 0 {
+					res[namespace] = append(res[namespace], quotaShortfall{quota: quota.name, resource: corev1.ResourceName(name), required: required, used: used, hard: hard})
+				}
+			}
+		}
+	}
+	return res
+}
+
+// formatQuotaShortfalls returns a report of the exceeded quotas grouped by namespace
+func formatQuotaShortfalls(shortfalls map[string][]quotaShortfall) string {
+	namespaces := make([]string, 0, len(shortfalls))
+	for namespace := range shortfalls {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	messages := make([]string, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		items := make([]string, 0, len(shortfalls[namespace]))
+		for _, shortfall := range shortfalls[namespace] {
+			items = append(items, shortfall.String())
+		}
+		messages = append(messages, fmt.Sprintf("namespace %s: %s", namespace, strings.Join(items, ", ")))
+	}
+	return strings.Join(messages, "; ")
+}
+
+// checkResourceQuotas verifies that the pods run by the target workloads fit into the resource quotas of their
+// namespaces and returns a report of the exceeded quotas, or an empty string if they fit.
+func (m *appStateManager) checkResourceQuotas(destCluster *v1alpha1.Cluster, syncOp v1alpha1.SyncOperation, reconciliationResult sync.ReconciliationResult) (string, error) {
+	cluster, err := m.liveStateCache.GetClusterCache(destCluster)
+	if err != nil {
+		return "", fmt.Errorf("failed to get cluster cache: %w", err)
+	}
+	var namespaces []string
+	for _, obj := range append(slices.Clone(reconciliationResult.Target), reconciliationResult.Live...) {
+		if obj != nil && obj.GetNamespace() != "" && !slices.Contains(namespaces, obj.GetNamespace()) {
+			namespaces = append(namespaces, obj.GetNamespace())
+		}
+	}
+	quotas := getNamespaceQuotas(cluster, namespaces)
+	deltas, err := quotaUsageDelta(syncOp, reconciliationResult, quotas)
+	if err != nil {
+		return "", err
+	}
+	targetLimits, err := targetQuotaLimits(reconciliationResult)
+	if err != nil {
+		return "", err
+	}
+	return formatQuotaShortfalls(quotaShortfalls(deltas, quotas, targetLimits)), nil
+}
@@ -0,0 +1,205 @@
+package controller
+
+import (
+	"testing"
+
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/sync"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	statecache "github.com/argoproj/argo-cd/v3/controller/cache"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func newQuotaTestDeployment(name string, namespace string, replicas int64, resources map[string]any) *unstructured.Unstructured {
+	spec := map[string]any{
+		"template": map[string]any{
+			"spec": map[string]any{
+				"containers": []any{map[string]any{"name": "main", "image": "nginx", "resources": resources}},
+			},
+		},
+	}
+	if replicas >= 0 {
+		spec["replicas"] = replicas
+	}
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": name, "namespace": namespace},
+		"spec":       spec,
+	}}
+}
+
+func newQuotaTestResource(kind string, namespace string, name string, info *statecache.ResourceInfo) *clustercache.Resource {
+	return &clustercache.Resource{
+		Ref:  corev1.ObjectReference{APIVersion: "v1", Kind: kind, Namespace: namespace, Name: name},
+		Info: info,
+	}
+}
+
+func quotaTestQuantity(list corev1.ResourceList, name corev1.ResourceName) string {
+	quantity := list[name]
+	return quantity.String()
+}
+
+func TestPodQuotaUsage(t *testing.T) {
+	spec := &corev1.PodSpec{Containers: []corev1.Container{{
+		Name: "main",
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("250m")},
+			Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m"), corev1.ResourceMemory: resource.MustParse("256Mi")},
+		},
+	}, {
+		Name: "sidecar",
+	}}}
+
+	t.Run("NoDefaults", func(t *testing.T) {
+		usage := podQuotaUsage(spec, nil)
+		assert.Equal(t, "1", usage.Pods().String())
+		assert.Equal(t, "250m", usage.Cpu().String())
+		assert.Equal(t, "256Mi", usage.Memory().String())
+		assert.Equal(t, "250m", usage.Name(corev1.ResourceRequestsCPU, resource.DecimalSI).String())
+		assert.Equal(t, "500m", usage.Name(corev1.ResourceLimitsCPU, resource.DecimalSI).String())
+		assert.Equal(t, "256Mi", usage.Name(corev1.ResourceLimitsMemory, resource.BinarySI).String())
+	})
+	t.Run("LimitRangeDefaults", func(t *testing.T) {
+		usage := podQuotaUsage(spec, &statecache.LimitRangeInfo{
+			DefaultRequests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m"), corev1.ResourceMemory: resource.MustParse("64Mi")},
+			DefaultLimits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("200m")},
+		})
+		assert.Equal(t, "350m", usage.Cpu().String())
+		assert.Equal(t, "320Mi", usage.Memory().String())
+		assert.Equal(t, "700m", usage.Name(corev1.ResourceLimitsCPU, resource.DecimalSI).String())
+	})
+	// the original spec is left untouched
+	assert.Nil(t, spec.Containers[1].Resources.Requests)
+}
+
+func TestQuotaUsageDelta(t *testing.T) {
+	resources := map[string]any{"requests": map[string]any{"cpu": "500m"}}
+	scaled := newQuotaTestDeployment("scaled", "ns1", 4, resources)
+	autoscaled := newQuotaTestDeployment("autoscaled", "ns1", -1, map[string]any{"requests": map[string]any{"cpu": "1"}})
+	pruned := newQuotaTestDeployment("pruned", "ns2", 2, resources)
+	created := newQuotaTestDeployment("created", "ns2", -1, resources)
+	reconciliationResult := sync.ReconciliationResult{
+		Live: []*unstructured.Unstructured{
+			newQuotaTestDeployment("scaled", "ns1", 1, resources),
+			newQuotaTestDeployment("autoscaled", "ns1", 3, resources),
+			pruned,
+			nil,
+			newSnapshotTestConfigMap("config", "live"),
+		},
+		Target: []*unstructured.Unstructured{scaled, autoscaled, nil, created, newSnapshotTestConfigMap("config", "target")},
+	}
+
+	deltas, err := quotaUsageDelta(v1alpha1.SyncOperation{}, reconciliationResult, nil)
+	require.NoError(t, err)
+	require.Len(t, deltas, 2)
+	// 3 more pods of scaled and 3 pods of autoscaled requesting 500m more each
+	assert.Equal(t, "3", quotaTestQuantity(deltas["ns1"], corev1.ResourcePods))
+	assert.Equal(t, "3", quotaTestQuantity(deltas["ns1"], corev1.ResourceCPU))
+	assert.Equal(t, "1", quotaTestQuantity(deltas["ns2"], corev1.ResourcePods))
+	assert.Equal(t, "500m", quotaTestQuantity(deltas["ns2"], corev1.ResourceCPU))
+
+	deltas, err = quotaUsageDelta(v1alpha1.SyncOperation{Prune: true}, reconciliationResult, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "-1", quotaTestQuantity(deltas["ns2"], corev1.ResourcePods))
+	assert.Equal(t, "-500m", quotaTestQuantity(deltas["ns2"], corev1.ResourceCPU))
+
+	deltas, err = quotaUsageDelta(v1alpha1.SyncOperation{Resources: []v1alpha1.SyncOperationResource{{Group: "apps", Kind: "Deployment", Name: "created"}}}, reconciliationResult, nil)
+	require.NoError(t, err)
+	require.Len(t, deltas, 1)
+	assert.Equal(t, "1", quotaTestQuantity(deltas["ns2"], corev1.ResourcePods))
+}
+
+func TestQuotaShortfalls(t *testing.T) {
+	namespaces := map[string]*namespaceQuotas{
+		"ns1": {quotas: []namespaceQuota{{
+			name: "compute",
+			info: &statecache.ResourceQuotaInfo{
+				Hard: corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("2"), corev1.ResourcePods: resource.MustParse("10")},
+				Used: corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("1"), corev1.ResourcePods: resource.MustParse("2")},
+			},
+		}, {
+			name: "best-effort",
+			info: &statecache.ResourceQuotaInfo{
+				Hard:   corev1.ResourceList{corev1.ResourcePods: resource.MustParse("0")},
+				Scoped: true,
+			},
+		}}},
+		"ns2": {quotas: []namespaceQuota{{
+			name: "pods",
+			info: &statecache.ResourceQuotaInfo{
+				Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("2")},
+				Used: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("2")},
+			},
+		}}},
+	}
+	deltas := map[string]corev1.ResourceList{
+		"ns1": {corev1.ResourceRequestsCPU: resource.MustParse("1500m"), corev1.ResourcePods: resource.MustParse("3")},
+		"ns2": {corev1.ResourcePods: resource.MustParse("-1")},
+		"ns3": {corev1.ResourcePods: resource.MustParse("100")},
+	}
+
+	shortfalls := quotaShortfalls(deltas, namespaces, nil)
+	assert.Equal(t, "namespace ns1: quota compute requires 1500m more requests.cpu, only 1 of 2 available", formatQuotaShortfalls(shortfalls))
+
+	// the quota is raised by the sync
+	shortfalls = quotaShortfalls(deltas, namespaces, map[kube.ResourceKey]corev1.ResourceList{
+		kube.NewResourceKey("", "ResourceQuota", "ns1", "compute"): {corev1.ResourceRequestsCPU: resource.MustParse("4")},
+	})
+	assert.Empty(t, shortfalls)
+}
+
+func TestSyncAppStateQuotaPreflight(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	app.Status.History = nil
+	project := defaultProj.DeepCopy()
+	live := newQuotaTestDeployment("guestbook", test.FakeDestNamespace, 1, map[string]any{})
+	live.SetAnnotations(map[string]string{common.AnnotationKeyAppInstance: "my-app:apps/Deployment:" + test.FakeDestNamespace + "/guestbook"})
+	ctrl := newFakeController(&fakeData{
+		apps: []runtime.Object{app, project},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, newQuotaTestDeployment("guestbook", test.FakeDestNamespace, 5, map[string]any{}))},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			kube.GetResourceKey(live): live,
+		},
+		clusterResources: []*clustercache.Resource{
+			newQuotaTestResource("ResourceQuota", test.FakeDestNamespace, "compute", &statecache.ResourceInfo{ResourceQuotaInfo: &statecache.ResourceQuotaInfo{
+				Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("4"), corev1.ResourceRequestsMemory: resource.MustParse("1Gi")},
+				Used: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("1"), corev1.ResourceRequestsMemory: resource.MustParse("128Mi")},
+			}}),
+			newQuotaTestResource("LimitRange", test.FakeDestNamespace, "defaults", &statecache.ResourceInfo{LimitRangeInfo: &statecache.LimitRangeInfo{
+				DefaultRequests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi")},
+			}}),
+			newQuotaTestResource("ResourceQuota", "other", "compute", &statecache.ResourceInfo{ResourceQuotaInfo: &statecache.ResourceQuotaInfo{
+				Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("0")},
+			}}),
+		},
+	}, nil)
+
+	opState := &v1alpha1.OperationState{
+		Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{SyncOptions: v1alpha1.SyncOptions{"QuotaPreflight=true"}}},
+		StartedAt: metav1.Now(),
+	}
+	ctrl.appStateManager.SyncAppState(app, project, opState)
+	assert.Equal(t, synccommon.OperationFailed, opState.Phase)
+	assert.Equal(t, "Insufficient resource quota: namespace "+test.FakeDestNamespace+": quota compute requires 4 more pods, only 3 of 4 available", opState.Message)
+	assert.Empty(t, opState.SyncResult.Resources)
+}
@@ -0,0 +1,105 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/argoproj/gitops-engine/pkg/diff"
+	"github.com/argoproj/gitops-engine/pkg/sync"
+	"github.com/argoproj/gitops-engine/pkg/sync/hook"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/snapshot"
+)
+
+// syncOptionSnapshot enables taking a snapshot of the live state of the resources modified or pruned by a sync
+const syncOptionSnapshot = "Snapshot=true"
+
+// snapshotResources returns the live objects which are going to be modified or pruned by the sync operation
+func snapshotResources(syncOp v1alpha1.SyncOperation, reconciliationResult sync.ReconciliationResult, diffResultList *diff.DiffResultList) []*unstructured.Unstructured {
+	var lives []*unstructured.Unstructured
+	for i, live := range reconciliationResult.Live {
+		target := reconciliationResult.Target[i]
+		if live == nil || hook.IsHook(live) || (target != nil && hook.IsHook(target)) {
+			continue
+		}
+		if len(syncOp.Resources) > 0 && !argo.ContainsSyncResource(live.GetName(), live.GetNamespace(), live.GroupVersionKind(), syncOp.Resources) {
+			continue
+		}
+		if target == nil {
+			if syncOp.Prune {
+				lives = append(lives, live)
+			}
+			continue
+		}
+		if diffResultList == nil || i >= len(diffResultList.Diffs) || diffResultList.Diffs[i].Modified {
+			lives = append(lives, live)
+		}
+	}
+	return lives
+}
+
+// snapshotLiveState saves a snapshot of the live state of the resources which are going to be modified or pruned by
+// the sync operation and returns its ID. No snapshot is taken if the sync does not change any resource.
+func (m *appStateManager) snapshotLiveState(app *v1alpha1.Application, state *v1alpha1.OperationState, compareResult *comparisonResult, reconciliationResult sync.ReconciliationResult) (string, error) {
+	if m.snapshotStore == nil {
+		return "", errors.New("no snapshot store is configured")
+	}
+	lives := snapshotResources(*state.Operation.Sync, reconciliationResult, compareResult.diffResultList)
+	if len(lives) == 0 {
+		return "", nil
+	}
+	snap := snapshot.New(app.QualifiedName(), compareResult.syncStatus.Revision, state.StartedAt.Time, lives)
+	if len(snap.Resources) == 0 {
+		return "", nil
+	}
+	if err := snapshot.Save(context.Background(), m.snapshotStore, snap, m.snapshotRetention, snapshotOwner(app, m.namespace)); err != nil {
+		return "", err
+	}
+	return snap.ID, nil
+}
+
+// snapshotOwner returns the owner reference of the snapshots of the given application, stored in the given namespace.
+// Owners must be in the namespace of the objects they own, so snapshots of applications in other namespaces have no
+// owner.
+func snapshotOwner(app *v1alpha1.Application, namespace string) *metav1.OwnerReference {
+	if app.Namespace != namespace || app.UID == "" {
+		return nil
+	}
+	return &metav1.OwnerReference{
+		APIVersion: v1alpha1.SchemeGroupVersion.String(),
+		Kind:       application.ApplicationKind,
+		Name:       app.Name,
+		UID:        app.UID,
+	}
+}
+
+// loadSnapshot returns the resources of the snapshot restored by a sync operation as manifests, along with the keys
+// of the restored resources
+func (m *appStateManager) loadSnapshot(app *v1alpha1.Application, id string) ([]string, map[kube.ResourceKey]bool, error) {
+	if m.snapshotStore == nil {
+		return nil, nil, errors.New("no snapshot store is configured")
+	}
+	snap, err := snapshot.Load(context.Background(), m.snapshotStore, app.QualifiedName(), id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(snap.Resources) == 0 {
+		return nil, nil, fmt.Errorf("snapshot %s does not contain any resource", id)
+	}
+	manifests, err := snap.Manifests()
+	if err != nil {
+		return nil, nil, err
+	}
+	keys := make(map[kube.ResourceKey]bool, len(snap.Resources))
+	for _, res := range snap.Resources {
+		keys[kube.GetResourceKey(res)] = true
+	}
+	return manifests, keys, nil
+}
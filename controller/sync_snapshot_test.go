@@ -0,0 +1,244 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/diff"
+	"github.com/argoproj/gitops-engine/pkg/sync"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/test"
+	"github.com/argoproj/argo-cd/v3/util/snapshot"
+)
+
+func newSnapshotTestConfigMap(name string, value string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]any{"name": name, "namespace": test.FakeDestNamespace},
+		"data":       map[string]any{"key": value},
+	}}
+}
+
+func TestSnapshotResources(t *testing.T) {
+	modified := newSnapshotTestConfigMap("modified", "live")
+	unmodified := newSnapshotTestConfigMap("unmodified", "live")
+	pruned := newSnapshotTestConfigMap("pruned", "live")
+	hookObj := newSnapshotTestConfigMap("hook", "live")
+	hookObj.SetAnnotations(map[string]string{synccommon.AnnotationKeyHook: "PreSync"})
+	reconciliationResult := sync.ReconciliationResult{
+		Live:   []*unstructured.Unstructured{modified, unmodified, pruned, hookObj, nil},
+		Target: []*unstructured.Unstructured{newSnapshotTestConfigMap("modified", "target"), unmodified, nil, hookObj, newSnapshotTestConfigMap("created", "target")},
+	}
+	diffResultList := &diff.DiffResultList{Diffs: []diff.DiffResult{{Modified: true}, {Modified: false}, {Modified: true}, {Modified: true}, {Modified: true}}}
+
+	t.Run("Sync", func(t *testing.T) {
+		lives := snapshotResources(v1alpha1.SyncOperation{}, reconciliationResult, diffResultList)
+		assert.Equal(t, []*unstructured.Unstructured{modified}, lives)
+	})
+	t.Run("Prune", func(t *testing.T) {
+		lives := snapshotResources(v1alpha1.SyncOperation{Prune: true}, reconciliationResult, diffResultList)
+		assert.Equal(t, []*unstructured.Unstructured{modified, pruned}, lives)
+	})
+	t.Run("SelectiveSync", func(t *testing.T) {
+		lives := snapshotResources(v1alpha1.SyncOperation{Prune: true, Resources: []v1alpha1.SyncOperationResource{{Kind: "ConfigMap", Name: "pruned"}}}, reconciliationResult, diffResultList)
+		assert.Equal(t, []*unstructured.Unstructured{pruned}, lives)
+	})
+}
+
+func TestSnapshotOwner(t *testing.T) {
+	app := newFakeApp()
+	app.UID = "4b6f5d1c"
+	owner := snapshotOwner(app, test.FakeArgoCDNamespace)
+	require.NotNil(t, owner)
+	assert.Equal(t, metav1.OwnerReference{APIVersion: "argoproj.io/v1alpha1", Kind: "Application", Name: app.Name, UID: "4b6f5d1c"}, *owner)
+
+	assert.Nil(t, snapshotOwner(app, "other"))
+	app.UID = ""
+	assert.Nil(t, snapshotOwner(app, test.FakeArgoCDNamespace))
+}
+
+func TestSyncAppStateSnapshot(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	app.Status.History = nil
+	project := defaultProj.DeepCopy()
+	live := newSnapshotTestConfigMap("my-config", "hotfix")
+	live.SetResourceVersion("123")
+	live.SetAnnotations(map[string]string{common.AnnotationKeyAppInstance: "my-app:/ConfigMap:" + test.FakeDestNamespace + "/my-config"})
+	ctrl := newFakeController(&fakeData{
+		apps: []runtime.Object{app, project},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, newSnapshotTestConfigMap("my-config", "git"))},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			kube.GetResourceKey(live): live,
+		},
+	}, nil)
+	store := ctrl.appStateManager.(*appStateManager).snapshotStore
+
+	opState := &v1alpha1.OperationState{
+		Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{SyncOptions: v1alpha1.SyncOptions{"Snapshot=true"}}},
+		StartedAt: metav1.Now(),
+	}
+	ctrl.appStateManager.SyncAppState(app, project, opState)
+	// the fake cluster cannot apply resources, the snapshot is taken before anything is applied
+	require.NotEqual(t, synccommon.OperationError, opState.Phase, opState.Message)
+	require.Equal(t, snapshot.NewID(opState.StartedAt.Time), opState.SyncResult.Snapshot)
+
+	snap, err := snapshot.Load(t.Context(), store, app.QualifiedName(), opState.SyncResult.Snapshot)
+	require.NoError(t, err)
+	assert.Equal(t, "abc123", snap.Revision)
+	require.Len(t, snap.Resources, 1)
+	assert.Equal(t, map[string]any{"key": "hotfix"}, snap.Resources[0].Object["data"])
+	assert.Empty(t, snap.Resources[0].GetResourceVersion())
+
+	t.Run("Restore", func(t *testing.T) {
+		restoreState := &v1alpha1.OperationState{
+			Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{RestoreSnapshot: snap.ID}},
+			StartedAt: metav1.Now(),
+		}
+		ctrl.appStateManager.SyncAppState(app, project, restoreState)
+		require.NotEqual(t, synccommon.OperationError, restoreState.Phase, restoreState.Message)
+		require.Len(t, restoreState.SyncResult.Resources, 1)
+		assert.Equal(t, "my-config", restoreState.SyncResult.Resources[0].Name)
+		assert.Empty(t, restoreState.SyncResult.Snapshot)
+	})
+	t.Run("RestoreMissingSnapshot", func(t *testing.T) {
+		restoreState := &v1alpha1.OperationState{
+			Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{RestoreSnapshot: "20000101000000"}},
+		}
+		ctrl.appStateManager.SyncAppState(app, project, restoreState)
+		assert.Equal(t, synccommon.OperationError, restoreState.Phase)
+		assert.Equal(t, "Failed to load snapshot 20000101000000: snapshot not found", restoreState.Message)
+	})
+}
+
+// overwritingStore keeps the snapshots in memory and overwrites existing ones on save, like the S3 store
+type overwritingStore struct {
+	snapshot.Store
+	saves int
+	data  map[string][]byte
+}
+
+func (s *overwritingStore) Save(_ context.Context, app string, id string, data []byte, _ *metav1.OwnerReference) error {
+	s.saves++
+	s.data[app+"/"+id] = data
+	return nil
+}
+
+func (s *overwritingStore) Load(_ context.Context, app string, id string) ([]byte, error) {
+	data, ok := s.data[app+"/"+id]
+	if !ok {
+		return nil, snapshot.ErrNotFound
+	}
+	return data, nil
+}
+
+func (s *overwritingStore) List(_ context.Context, app string) ([]string, error) {
+	var ids []string
+	for key := range s.data {
+		if id, ok := strings.CutPrefix(key, app+"/"); ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func TestSyncAppStateSnapshot_Retry(t *testing.T) {
+	newController := func(t *testing.T) (*ApplicationController, *v1alpha1.Application, *v1alpha1.AppProject) {
+		t.Helper()
+		app := newFakeApp()
+		app.Status.OperationState = nil
+		app.Status.History = nil
+		project := defaultProj.DeepCopy()
+		live := newSnapshotTestConfigMap("my-config", "hotfix")
+		live.SetAnnotations(map[string]string{common.AnnotationKeyAppInstance: "my-app:/ConfigMap:" + test.FakeDestNamespace + "/my-config"})
+		manifestResponse := &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, newSnapshotTestConfigMap("my-config", "git"))},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		}
+		ctrl := newFakeController(&fakeData{
+			apps:              []runtime.Object{app, project},
+			manifestResponses: []*apiclient.ManifestResponse{manifestResponse, manifestResponse},
+			managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+				kube.GetResourceKey(live): live,
+			},
+		}, nil)
+		return ctrl, app, project
+	}
+	syncWithRetry := func(t *testing.T, ctrl *ApplicationController, app *v1alpha1.Application, project *v1alpha1.AppProject) *v1alpha1.OperationState {
+		t.Helper()
+		opState := &v1alpha1.OperationState{
+			Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{SyncOptions: v1alpha1.SyncOptions{"Snapshot=true"}}},
+			StartedAt: metav1.Now(),
+		}
+		ctrl.appStateManager.SyncAppState(app, project, opState)
+		require.NotEqual(t, synccommon.OperationError, opState.Phase, opState.Message)
+		snapshotID := opState.SyncResult.Snapshot
+		require.NotEmpty(t, snapshotID)
+
+		// the retry attempt keeps the start time of the operation, and so the ID the snapshot would be taken with
+		opState.Phase = synccommon.OperationRunning
+		opState.SyncResult = retrySyncResult(app, opState)
+		ctrl.appStateManager.SyncAppState(app, project, opState)
+		require.NotEqual(t, synccommon.OperationError, opState.Phase, opState.Message)
+		assert.Equal(t, snapshotID, opState.SyncResult.Snapshot)
+		return opState
+	}
+
+	t.Run("Secret", func(t *testing.T) {
+		ctrl, app, project := newController(t)
+		opState := syncWithRetry(t, ctrl, app, project)
+
+		snap, err := snapshot.Load(t.Context(), ctrl.appStateManager.(*appStateManager).snapshotStore, app.QualifiedName(), opState.SyncResult.Snapshot)
+		require.NoError(t, err)
+		require.Len(t, snap.Resources, 1)
+		assert.Equal(t, map[string]any{"key": "hotfix"}, snap.Resources[0].Object["data"])
+	})
+	t.Run("S3", func(t *testing.T) {
+		ctrl, app, project := newController(t)
+		store := &overwritingStore{data: map[string][]byte{}}
+		ctrl.appStateManager.(*appStateManager).snapshotStore = store
+		opState := syncWithRetry(t, ctrl, app, project)
+
+		// the snapshot of the first attempt is not overwritten by the retry
+		assert.Equal(t, 1, store.saves)
+		snap, err := snapshot.Load(t.Context(), store, app.QualifiedName(), opState.SyncResult.Snapshot)
+		require.NoError(t, err)
+		require.Len(t, snap.Resources, 1)
+		assert.Equal(t, map[string]any{"key": "hotfix"}, snap.Resources[0].Object["data"])
+	})
+}
+
+func TestRetrySyncResult(t *testing.T) {
+	app := newFakeApp()
+	state := &v1alpha1.OperationState{
+		Operation:  v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Revision: "HEAD"}},
+		SyncResult: &v1alpha1.SyncOperationResult{Revision: "abc123", Resources: v1alpha1.ResourceResults{{Name: "my-config"}}},
+	}
+	assert.Nil(t, retrySyncResult(app, state))
+
+	state.SyncResult.Snapshot = "20250304050607"
+	syncResult := retrySyncResult(app, state)
+	require.NotNil(t, syncResult)
+	assert.Equal(t, "20250304050607", syncResult.Snapshot)
+	assert.Equal(t, "HEAD", syncResult.Revision)
+	assert.Empty(t, syncResult.Resources)
+}
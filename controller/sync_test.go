@@ -2,6 +2,7 @@ package controller
 
 import (
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/argoproj/gitops-engine/pkg/sync"
@@ -21,6 +22,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/test"
 	"github.com/argoproj/argo-cd/v3/util/argo/diff"
 	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
+	"github.com/argoproj/argo-cd/v3/util/provenance"
 )
 
 func TestPersistRevisionHistory(t *testing.T) {
@@ -59,6 +61,9 @@ func TestPersistRevisionHistory(t *testing.T) {
 	require.Len(t, updatedApp.Status.History, 1)
 	assert.Equal(t, app.Spec.GetSource(), updatedApp.Status.History[0].Source)
 	assert.Equal(t, "abc123", updatedApp.Status.History[0].Revision)
+	// Ensure we record the digest of the manifests, which is the subject of their provenance attestation
+	assert.Equal(t, provenance.Digest([]string{}), opState.SyncResult.ManifestsDigest)
+	assert.Equal(t, provenance.Digest([]string{}), updatedApp.Status.History[0].ManifestsDigest)
 }
 
 func TestPersistManagedNamespaceMetadataState(t *testing.T) {
@@ -349,6 +354,56 @@ func TestSyncWindowDeniesSync(t *testing.T) {
 		assert.Equal(t, synccommon.OperationRunning, opState.Phase)
 		assert.Contains(t, opState.Message, opMessage)
 	})
+
+	t.Run("will show the reason of the deny window which prevents the sync", func(t *testing.T) {
+		// given a project with an active deny sync window with a description and an operation in progress
+		t.Parallel()
+		f := setup()
+		f.project.Spec.SyncWindows[0].Description = "Year-end freeze"
+
+		opState := &v1alpha1.OperationState{
+			Operation: v1alpha1.Operation{
+				Sync: &v1alpha1.SyncOperation{
+					Source: &v1alpha1.ApplicationSource{},
+				},
+			},
+			Phase: synccommon.OperationRunning,
+		}
+		// when
+		f.controller.appStateManager.SyncAppState(f.application, f.project, opState)
+
+		// then
+		assert.Equal(t, synccommon.OperationRunning, opState.Phase)
+		assert.Equal(t, "Sync operation blocked by sync window: Year-end freeze", opState.Message)
+	})
+
+	t.Run("will prevent the sync if a sync window of the application prevents it", func(t *testing.T) {
+		// given an application with an active deny sync window and an operation in progress
+		t.Parallel()
+		f := setup()
+		f.project.Spec.SyncWindows = nil
+		f.application.Spec.SyncWindows = v1alpha1.SyncWindows{{
+			Kind:        "deny",
+			Schedule:    "0 0 * * *",
+			Duration:    "24h",
+			Description: "Application maintenance",
+		}}
+
+		opState := &v1alpha1.OperationState{
+			Operation: v1alpha1.Operation{
+				Sync: &v1alpha1.SyncOperation{
+					Source: &v1alpha1.ApplicationSource{},
+				},
+			},
+			Phase: synccommon.OperationRunning,
+		}
+		// when
+		f.controller.appStateManager.SyncAppState(f.application, f.project, opState)
+
+		// then
+		assert.Equal(t, synccommon.OperationRunning, opState.Phase)
+		assert.Equal(t, "Sync operation blocked by sync window: Application maintenance", opState.Message)
+	})
 }
 
 func TestNormalizeTargetResources(t *testing.T) {
@@ -1653,3 +1708,96 @@ func dig(obj any, path ...any) any {
 
 	return i
 }
+
+func TestSelectHooks(t *testing.T) {
+	newHook := func(name string, generateName string, namespace string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("batch/v1")
+		obj.SetKind("Job")
+		obj.SetName(name)
+		obj.SetGenerateName(generateName)
+		obj.SetNamespace(namespace)
+		obj.SetAnnotations(map[string]string{synccommon.AnnotationKeyHook: string(synccommon.HookTypePreSync)})
+		return obj
+	}
+	migrate := newHook("migrate", "", "")
+	notify := newHook("", "notify-", "default")
+	cleanup := newHook("cleanup", "", "other")
+	hooks := []*unstructured.Unstructured{migrate, notify, cleanup}
+
+	t.Run("NoHookSelected", func(t *testing.T) {
+		assert.Empty(t, selectHooks(hooks, []v1alpha1.SyncOperationResource{{Kind: "ConfigMap", Name: "migrate"}}, "default"))
+		assert.Empty(t, selectHooks(hooks, []v1alpha1.SyncOperationResource{{Group: "batch", Kind: "Job", Name: "migrate", Namespace: "other"}}, "default"))
+	})
+	t.Run("Name", func(t *testing.T) {
+		selected := selectHooks(hooks, []v1alpha1.SyncOperationResource{{Group: "batch", Kind: "Job", Name: "migrate", Namespace: "default"}}, "default")
+		assert.Equal(t, []*unstructured.Unstructured{migrate}, selected)
+	})
+	t.Run("GenerateName", func(t *testing.T) {
+		// the hook is selected by the name it was created with by the previous operation
+		selected := selectHooks(hooks, []v1alpha1.SyncOperationResource{{Group: "batch", Kind: "Job", Name: "notify-abc1234-presync-1700000000", Namespace: "default"}}, "default")
+		assert.Equal(t, []*unstructured.Unstructured{notify}, selected)
+		assert.Empty(t, selectHooks(hooks, []v1alpha1.SyncOperationResource{{Kind: "Pod", Name: "notify-abc1234-presync-1700000000"}}, "default"))
+	})
+}
+
+func TestSyncAppState_RetryFailedResourcesHooks(t *testing.T) {
+	configMap := `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "guestbook"}}`
+	hook := `{"apiVersion": "v1", "kind": "Pod", "metadata": {"generateName": "migrate-", "annotations": {"argocd.argoproj.io/hook": "PreSync"}}}`
+	sync := func(t *testing.T, failedResourcesOnly bool, resources []v1alpha1.SyncOperationResource) *v1alpha1.OperationState {
+		t.Helper()
+		app := newFakeApp()
+		app.Status.OperationState = nil
+		app.Status.History = nil
+		project := &v1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Namespace: test.FakeArgoCDNamespace, Name: "default"},
+			Spec: v1alpha1.AppProjectSpec{
+				Destinations:               []v1alpha1.ApplicationDestination{{Namespace: "*", Server: "*"}},
+				ClusterResourceWhitelist:   []metav1.GroupKind{{Group: "*", Kind: "*"}},
+				NamespaceResourceWhitelist: []metav1.GroupKind{{Group: "*", Kind: "*"}},
+			},
+		}
+		ctrl := newFakeController(&fakeData{
+			apps: []runtime.Object{app, project},
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []string{configMap, hook},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{},
+		}, nil)
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+			Sync: &v1alpha1.SyncOperation{Source: &v1alpha1.ApplicationSource{}, Resources: resources, FailedResourcesOnly: failedResourcesOnly},
+		}}
+		ctrl.appStateManager.SyncAppState(app, project, opState)
+		return opState
+	}
+	hookResults := func(opState *v1alpha1.OperationState) []string {
+		var hooks []string
+		for _, res := range opState.SyncResult.Resources {
+			if res.HookType != "" {
+				hooks = append(hooks, res.Name)
+			}
+		}
+		return hooks
+	}
+
+	t.Run("SelectiveSyncSkipsHooks", func(t *testing.T) {
+		opState := sync(t, false, []v1alpha1.SyncOperationResource{{Kind: "ConfigMap", Name: "guestbook"}, {Kind: "Pod", Name: "migrate-abc123-presync-1700000000"}})
+		require.NotNil(t, opState.SyncResult)
+		assert.Empty(t, hookResults(opState))
+	})
+	t.Run("HooksSkipped", func(t *testing.T) {
+		opState := sync(t, true, []v1alpha1.SyncOperationResource{{Kind: "ConfigMap", Name: "guestbook"}})
+		require.NotNil(t, opState.SyncResult)
+		assert.Empty(t, hookResults(opState))
+	})
+	t.Run("HooksSelected", func(t *testing.T) {
+		opState := sync(t, true, []v1alpha1.SyncOperationResource{{Kind: "ConfigMap", Name: "guestbook"}, {Kind: "Pod", Name: "migrate-abc123-presync-1700000000"}})
+		require.NotNil(t, opState.SyncResult)
+		hooks := hookResults(opState)
+		require.Len(t, hooks, 1)
+		assert.True(t, strings.HasPrefix(hooks[0], "migrate-"))
+	})
+}
@@ -390,12 +390,14 @@ func (m *ApplicationResourceEventsQuery) GetProject() string {
 
 // ManifestQuery is a query for manifest resources
 type ApplicationManifestQuery struct {
-	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Revision             *string  `protobuf:"bytes,2,opt,name=revision" json:"revision,omitempty"`
-	AppNamespace         *string  `protobuf:"bytes,3,opt,name=appNamespace" json:"appNamespace,omitempty"`
-	Project              *string  `protobuf:"bytes,4,opt,name=project" json:"project,omitempty"`
-	SourcePositions      []int64  `protobuf:"varint,5,rep,name=sourcePositions" json:"sourcePositions,omitempty"`
-	Revisions            []string `protobuf:"bytes,6,rep,name=revisions" json:"revisions,omitempty"`
+	Name            *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Revision        *string  `protobuf:"bytes,2,opt,name=revision" json:"revision,omitempty"`
+	AppNamespace    *string  `protobuf:"bytes,3,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project         *string  `protobuf:"bytes,4,opt,name=project" json:"project,omitempty"`
+	SourcePositions []int64  `protobuf:"varint,5,rep,name=sourcePositions" json:"sourcePositions,omitempty"`
+	Revisions       []string `protobuf:"bytes,6,rep,name=revisions" json:"revisions,omitempty"`
+	// HistoryId generates the manifests of the sources and revisions of the given revision history entry instead of the current ones
+	HistoryId            *int64   `protobuf:"varint,7,opt,name=historyId" json:"historyId,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -476,6 +478,13 @@ func (m *ApplicationManifestQuery) GetRevisions() []string {
 	return nil
 }
 
+func (m *ApplicationManifestQuery) GetHistoryId() int64 {
+	if m != nil && m.HistoryId != nil {
+		return *m.HistoryId
+	}
+	return 0
+}
+
 type FileChunk struct {
 	Chunk                []byte   `protobuf:"bytes,1,req,name=chunk" json:"chunk,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -852,11 +861,13 @@ func (m *ApplicationUpdateRequest) GetProject() string {
 }
 
 type ApplicationDeleteRequest struct {
-	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Cascade              *bool    `protobuf:"varint,2,opt,name=cascade" json:"cascade,omitempty"`
-	PropagationPolicy    *string  `protobuf:"bytes,3,opt,name=propagationPolicy" json:"propagationPolicy,omitempty"`
-	AppNamespace         *string  `protobuf:"bytes,4,opt,name=appNamespace" json:"appNamespace,omitempty"`
-	Project              *string  `protobuf:"bytes,5,opt,name=project" json:"project,omitempty"`
+	Name              *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Cascade           *bool   `protobuf:"varint,2,opt,name=cascade" json:"cascade,omitempty"`
+	PropagationPolicy *string `protobuf:"bytes,3,opt,name=propagationPolicy" json:"propagationPolicy,omitempty"`
+	AppNamespace      *string `protobuf:"bytes,4,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project           *string `protobuf:"bytes,5,opt,name=project" json:"project,omitempty"`
+	// SkipPreDeleteHooks deletes the application without running its PreDelete hooks
+	SkipPreDeleteHooks   *bool    `protobuf:"varint,6,opt,name=skipPreDeleteHooks" json:"skipPreDeleteHooks,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -930,6 +941,13 @@ func (m *ApplicationDeleteRequest) GetProject() string {
 	return ""
 }
 
+func (m *ApplicationDeleteRequest) GetSkipPreDeleteHooks() bool {
+	if m != nil && m.SkipPreDeleteHooks != nil {
+		return *m.SkipPreDeleteHooks
+	}
+	return false
+}
+
 type SyncOptions struct {
 	Items                []string `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -979,23 +997,25 @@ func (m *SyncOptions) GetItems() []string {
 
 // ApplicationSyncRequest is a request to apply the config state to live state
 type ApplicationSyncRequest struct {
-	Name                 *string                           `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Revision             *string                           `protobuf:"bytes,2,opt,name=revision" json:"revision,omitempty"`
-	DryRun               *bool                             `protobuf:"varint,3,opt,name=dryRun" json:"dryRun,omitempty"`
-	Prune                *bool                             `protobuf:"varint,4,opt,name=prune" json:"prune,omitempty"`
-	Strategy             *v1alpha1.SyncStrategy            `protobuf:"bytes,5,opt,name=strategy" json:"strategy,omitempty"`
-	Resources            []*v1alpha1.SyncOperationResource `protobuf:"bytes,7,rep,name=resources" json:"resources,omitempty"`
-	Manifests            []string                          `protobuf:"bytes,8,rep,name=manifests" json:"manifests,omitempty"`
-	Infos                []*v1alpha1.Info                  `protobuf:"bytes,9,rep,name=infos" json:"infos,omitempty"`
-	RetryStrategy        *v1alpha1.RetryStrategy           `protobuf:"bytes,10,opt,name=retryStrategy" json:"retryStrategy,omitempty"`
-	SyncOptions          *SyncOptions                      `protobuf:"bytes,11,opt,name=syncOptions" json:"syncOptions,omitempty"`
-	AppNamespace         *string                           `protobuf:"bytes,12,opt,name=appNamespace" json:"appNamespace,omitempty"`
-	Project              *string                           `protobuf:"bytes,13,opt,name=project" json:"project,omitempty"`
-	SourcePositions      []int64                           `protobuf:"varint,14,rep,name=sourcePositions" json:"sourcePositions,omitempty"`
-	Revisions            []string                          `protobuf:"bytes,15,rep,name=revisions" json:"revisions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
-	XXX_unrecognized     []byte                            `json:"-"`
-	XXX_sizecache        int32                             `json:"-"`
+	Name            *string                           `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Revision        *string                           `protobuf:"bytes,2,opt,name=revision" json:"revision,omitempty"`
+	DryRun          *bool                             `protobuf:"varint,3,opt,name=dryRun" json:"dryRun,omitempty"`
+	Prune           *bool                             `protobuf:"varint,4,opt,name=prune" json:"prune,omitempty"`
+	Strategy        *v1alpha1.SyncStrategy            `protobuf:"bytes,5,opt,name=strategy" json:"strategy,omitempty"`
+	Resources       []*v1alpha1.SyncOperationResource `protobuf:"bytes,7,rep,name=resources" json:"resources,omitempty"`
+	Manifests       []string                          `protobuf:"bytes,8,rep,name=manifests" json:"manifests,omitempty"`
+	Infos           []*v1alpha1.Info                  `protobuf:"bytes,9,rep,name=infos" json:"infos,omitempty"`
+	RetryStrategy   *v1alpha1.RetryStrategy           `protobuf:"bytes,10,opt,name=retryStrategy" json:"retryStrategy,omitempty"`
+	SyncOptions     *SyncOptions                      `protobuf:"bytes,11,opt,name=syncOptions" json:"syncOptions,omitempty"`
+	AppNamespace    *string                           `protobuf:"bytes,12,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project         *string                           `protobuf:"bytes,13,opt,name=project" json:"project,omitempty"`
+	SourcePositions []int64                           `protobuf:"varint,14,rep,name=sourcePositions" json:"sourcePositions,omitempty"`
+	Revisions       []string                          `protobuf:"bytes,15,rep,name=revisions" json:"revisions,omitempty"`
+	// RetryFailed re-syncs only the resources which failed in the last sync operation, at the revision it was performed against
+	RetryFailed          *bool    `protobuf:"varint,16,opt,name=retryFailed" json:"retryFailed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ApplicationSyncRequest) Reset()         { *m = ApplicationSyncRequest{} }
@@ -1129,6 +1149,13 @@ func (m *ApplicationSyncRequest) GetRevisions() []string {
 	return nil
 }
 
+func (m *ApplicationSyncRequest) GetRetryFailed() bool {
+	if m != nil && m.RetryFailed != nil {
+		return *m.RetryFailed
+	}
+	return false
+}
+
 // ApplicationUpdateSpecRequest is a request to update application spec
 type ApplicationUpdateSpecRequest struct {
 	Name                 *string                   `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
@@ -1479,6 +1506,72 @@ func (m *ApplicationResourceRequest) GetProject() string {
 	return ""
 }
 
+type OrphanedResourceExportResponse struct {
+	// Manifest is the live manifest of the orphaned resource without its server populated fields
+	Manifest *string `protobuf:"bytes,1,req,name=manifest" json:"manifest,omitempty"`
+	// Path is the path of the manifest in the application source repository
+	Path *string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
+	// Patch is a git patch adding the manifest to the application source repository
+	Patch                *string  `protobuf:"bytes,3,opt,name=patch" json:"patch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrphanedResourceExportResponse) Reset()         { *m = OrphanedResourceExportResponse{} }
+func (m *OrphanedResourceExportResponse) String() string { return proto.CompactTextString(m) }
+func (*OrphanedResourceExportResponse) ProtoMessage()    {}
+func (*OrphanedResourceExportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{18}
+}
+func (m *OrphanedResourceExportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrphanedResourceExportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrphanedResourceExportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrphanedResourceExportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrphanedResourceExportResponse.Merge(m, src)
+}
+func (m *OrphanedResourceExportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrphanedResourceExportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrphanedResourceExportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrphanedResourceExportResponse proto.InternalMessageInfo
+
+func (m *OrphanedResourceExportResponse) GetManifest() string {
+	if m != nil && m.Manifest != nil {
+		return *m.Manifest
+	}
+	return ""
+}
+
+func (m *OrphanedResourceExportResponse) GetPath() string {
+	if m != nil && m.Path != nil {
+		return *m.Path
+	}
+	return ""
+}
+
+func (m *OrphanedResourceExportResponse) GetPatch() string {
+	if m != nil && m.Patch != nil {
+		return *m.Patch
+	}
+	return ""
+}
+
 type ApplicationResourcePatchRequest struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
 	Namespace            *string  `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
@@ -1499,7 +1592,7 @@ func (m *ApplicationResourcePatchRequest) Reset()         { *m = ApplicationReso
 func (m *ApplicationResourcePatchRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourcePatchRequest) ProtoMessage()    {}
 func (*ApplicationResourcePatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{18}
+	return fileDescriptor_df6e82b174b5eaec, []int{19}
 }
 func (m *ApplicationResourcePatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1618,7 +1711,7 @@ func (m *ApplicationResourceDeleteRequest) Reset()         { *m = ApplicationRes
 func (m *ApplicationResourceDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourceDeleteRequest) ProtoMessage()    {}
 func (*ApplicationResourceDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{19}
+	return fileDescriptor_df6e82b174b5eaec, []int{20}
 }
 func (m *ApplicationResourceDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1729,7 +1822,7 @@ func (m *ResourceActionParameters) Reset()         { *m = ResourceActionParamete
 func (m *ResourceActionParameters) String() string { return proto.CompactTextString(m) }
 func (*ResourceActionParameters) ProtoMessage()    {}
 func (*ResourceActionParameters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{20}
+	return fileDescriptor_df6e82b174b5eaec, []int{21}
 }
 func (m *ResourceActionParameters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1795,7 +1888,7 @@ func (m *ResourceActionRunRequest) Reset()         { *m = ResourceActionRunReque
 func (m *ResourceActionRunRequest) String() string { return proto.CompactTextString(m) }
 func (*ResourceActionRunRequest) ProtoMessage()    {}
 func (*ResourceActionRunRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{21}
+	return fileDescriptor_df6e82b174b5eaec, []int{22}
 }
 func (m *ResourceActionRunRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1907,7 +2000,7 @@ func (m *ResourceActionRunRequestV2) Reset()         { *m = ResourceActionRunReq
 func (m *ResourceActionRunRequestV2) String() string { return proto.CompactTextString(m) }
 func (*ResourceActionRunRequestV2) ProtoMessage()    {}
 func (*ResourceActionRunRequestV2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{22}
+	return fileDescriptor_df6e82b174b5eaec, []int{23}
 }
 func (m *ResourceActionRunRequestV2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2017,7 +2110,7 @@ func (m *ResourceActionsListResponse) Reset()         { *m = ResourceActionsList
 func (m *ResourceActionsListResponse) String() string { return proto.CompactTextString(m) }
 func (*ResourceActionsListResponse) ProtoMessage()    {}
 func (*ResourceActionsListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{23}
+	return fileDescriptor_df6e82b174b5eaec, []int{24}
 }
 func (m *ResourceActionsListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2064,7 +2157,7 @@ func (m *ApplicationResourceResponse) Reset()         { *m = ApplicationResource
 func (m *ApplicationResourceResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourceResponse) ProtoMessage()    {}
 func (*ApplicationResourceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{24}
+	return fileDescriptor_df6e82b174b5eaec, []int{25}
 }
 func (m *ApplicationResourceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2127,7 +2220,7 @@ func (m *ApplicationPodLogsQuery) Reset()         { *m = ApplicationPodLogsQuery
 func (m *ApplicationPodLogsQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationPodLogsQuery) ProtoMessage()    {}
 func (*ApplicationPodLogsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{25}
+	return fileDescriptor_df6e82b174b5eaec, []int{26}
 }
 func (m *ApplicationPodLogsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2291,7 +2384,7 @@ func (m *LogEntry) Reset()         { *m = LogEntry{} }
 func (m *LogEntry) String() string { return proto.CompactTextString(m) }
 func (*LogEntry) ProtoMessage()    {}
 func (*LogEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{26}
+	return fileDescriptor_df6e82b174b5eaec, []int{27}
 }
 func (m *LogEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2368,7 +2461,7 @@ func (m *OperationTerminateRequest) Reset()         { *m = OperationTerminateReq
 func (m *OperationTerminateRequest) String() string { return proto.CompactTextString(m) }
 func (*OperationTerminateRequest) ProtoMessage()    {}
 func (*OperationTerminateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{27}
+	return fileDescriptor_df6e82b174b5eaec, []int{28}
 }
 func (m *OperationTerminateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2418,6 +2511,232 @@ func (m *OperationTerminateRequest) GetProject() string {
 	return ""
 }
 
+// ApplicationPauseRequest is a request to pause the automated sync of an application
+type ApplicationPauseRequest struct {
+	Name         *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	AppNamespace *string `protobuf:"bytes,2,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project      *string `protobuf:"bytes,3,opt,name=project" json:"project,omitempty"`
+	// Until is the RFC3339 time at which automated sync is resumed
+	Until                *string  `protobuf:"bytes,4,req,name=until" json:"until,omitempty"`
+	Reason               *string  `protobuf:"bytes,5,opt,name=reason" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationPauseRequest) Reset()         { *m = ApplicationPauseRequest{} }
+func (m *ApplicationPauseRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationPauseRequest) ProtoMessage()    {}
+func (*ApplicationPauseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{29}
+}
+func (m *ApplicationPauseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationPauseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationPauseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationPauseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationPauseRequest.Merge(m, src)
+}
+func (m *ApplicationPauseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationPauseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationPauseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationPauseRequest proto.InternalMessageInfo
+
+func (m *ApplicationPauseRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationPauseRequest) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
+func (m *ApplicationPauseRequest) GetProject() string {
+	if m != nil && m.Project != nil {
+		return *m.Project
+	}
+	return ""
+}
+
+func (m *ApplicationPauseRequest) GetUntil() string {
+	if m != nil && m.Until != nil {
+		return *m.Until
+	}
+	return ""
+}
+
+func (m *ApplicationPauseRequest) GetReason() string {
+	if m != nil && m.Reason != nil {
+		return *m.Reason
+	}
+	return ""
+}
+
+// ApplicationResumeRequest is a request to resume the automated sync of a paused application
+type ApplicationResumeRequest struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	AppNamespace         *string  `protobuf:"bytes,2,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project              *string  `protobuf:"bytes,3,opt,name=project" json:"project,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationResumeRequest) Reset()         { *m = ApplicationResumeRequest{} }
+func (m *ApplicationResumeRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationResumeRequest) ProtoMessage()    {}
+func (*ApplicationResumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{30}
+}
+func (m *ApplicationResumeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationResumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationResumeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationResumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationResumeRequest.Merge(m, src)
+}
+func (m *ApplicationResumeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationResumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationResumeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationResumeRequest proto.InternalMessageInfo
+
+func (m *ApplicationResumeRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationResumeRequest) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
+func (m *ApplicationResumeRequest) GetProject() string {
+	if m != nil && m.Project != nil {
+		return *m.Project
+	}
+	return ""
+}
+
+// ApplicationRestoreSnapshotRequest is a request to restore the live state of an application from a snapshot
+type ApplicationRestoreSnapshotRequest struct {
+	Name         *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	AppNamespace *string `protobuf:"bytes,2,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project      *string `protobuf:"bytes,3,opt,name=project" json:"project,omitempty"`
+	// ID is the ID of the snapshot to restore, defaults to the snapshot taken by the last sync operation
+	Id                   *string  `protobuf:"bytes,4,opt,name=id" json:"id,omitempty"`
+	DryRun               *bool    `protobuf:"varint,5,opt,name=dryRun" json:"dryRun,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationRestoreSnapshotRequest) Reset()         { *m = ApplicationRestoreSnapshotRequest{} }
+func (m *ApplicationRestoreSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationRestoreSnapshotRequest) ProtoMessage()    {}
+func (*ApplicationRestoreSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{31}
+}
+func (m *ApplicationRestoreSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationRestoreSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationRestoreSnapshotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationRestoreSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationRestoreSnapshotRequest.Merge(m, src)
+}
+func (m *ApplicationRestoreSnapshotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationRestoreSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationRestoreSnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationRestoreSnapshotRequest proto.InternalMessageInfo
+
+func (m *ApplicationRestoreSnapshotRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationRestoreSnapshotRequest) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
+func (m *ApplicationRestoreSnapshotRequest) GetProject() string {
+	if m != nil && m.Project != nil {
+		return *m.Project
+	}
+	return ""
+}
+
+func (m *ApplicationRestoreSnapshotRequest) GetId() string {
+	if m != nil && m.Id != nil {
+		return *m.Id
+	}
+	return ""
+}
+
+func (m *ApplicationRestoreSnapshotRequest) GetDryRun() bool {
+	if m != nil && m.DryRun != nil {
+		return *m.DryRun
+	}
+	return false
+}
+
 type ApplicationSyncWindowsQuery struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
 	AppNamespace         *string  `protobuf:"bytes,2,opt,name=appNamespace" json:"appNamespace,omitempty"`
@@ -2431,7 +2750,7 @@ func (m *ApplicationSyncWindowsQuery) Reset()         { *m = ApplicationSyncWind
 func (m *ApplicationSyncWindowsQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindowsQuery) ProtoMessage()    {}
 func (*ApplicationSyncWindowsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{28}
+	return fileDescriptor_df6e82b174b5eaec, []int{32}
 }
 func (m *ApplicationSyncWindowsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2494,7 +2813,7 @@ func (m *ApplicationSyncWindowsResponse) Reset()         { *m = ApplicationSyncW
 func (m *ApplicationSyncWindowsResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindowsResponse) ProtoMessage()    {}
 func (*ApplicationSyncWindowsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{29}
+	return fileDescriptor_df6e82b174b5eaec, []int{33}
 }
 func (m *ApplicationSyncWindowsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2549,6 +2868,7 @@ type ApplicationSyncWindow struct {
 	Schedule             *string  `protobuf:"bytes,2,req,name=schedule" json:"schedule,omitempty"`
 	Duration             *string  `protobuf:"bytes,3,req,name=duration" json:"duration,omitempty"`
 	ManualSync           *bool    `protobuf:"varint,4,req,name=manualSync" json:"manualSync,omitempty"`
+	Description          *string  `protobuf:"bytes,5,opt,name=description" json:"description,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2558,7 +2878,7 @@ func (m *ApplicationSyncWindow) Reset()         { *m = ApplicationSyncWindow{} }
 func (m *ApplicationSyncWindow) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindow) ProtoMessage()    {}
 func (*ApplicationSyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{30}
+	return fileDescriptor_df6e82b174b5eaec, []int{34}
 }
 func (m *ApplicationSyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2615,6 +2935,13 @@ func (m *ApplicationSyncWindow) GetManualSync() bool {
 	return false
 }
 
+func (m *ApplicationSyncWindow) GetDescription() string {
+	if m != nil && m.Description != nil {
+		return *m.Description
+	}
+	return ""
+}
+
 type OperationTerminateResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -2625,7 +2952,7 @@ func (m *OperationTerminateResponse) Reset()         { *m = OperationTerminateRe
 func (m *OperationTerminateResponse) String() string { return proto.CompactTextString(m) }
 func (*OperationTerminateResponse) ProtoMessage()    {}
 func (*OperationTerminateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{31}
+	return fileDescriptor_df6e82b174b5eaec, []int{35}
 }
 func (m *OperationTerminateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2672,7 +2999,7 @@ func (m *ResourcesQuery) Reset()         { *m = ResourcesQuery{} }
 func (m *ResourcesQuery) String() string { return proto.CompactTextString(m) }
 func (*ResourcesQuery) ProtoMessage()    {}
 func (*ResourcesQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{32}
+	return fileDescriptor_df6e82b174b5eaec, []int{36}
 }
 func (m *ResourcesQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2768,7 +3095,7 @@ func (m *ManagedResourcesResponse) Reset()         { *m = ManagedResourcesRespon
 func (m *ManagedResourcesResponse) String() string { return proto.CompactTextString(m) }
 func (*ManagedResourcesResponse) ProtoMessage()    {}
 func (*ManagedResourcesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{33}
+	return fileDescriptor_df6e82b174b5eaec, []int{37}
 }
 func (m *ManagedResourcesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2804,13 +3131,60 @@ func (m *ManagedResourcesResponse) GetItems() []*v1alpha1.ResourceDiff {
 	return nil
 }
 
-type LinkInfo struct {
-	Title                *string  `protobuf:"bytes,1,req,name=title" json:"title,omitempty"`
-	Url                  *string  `protobuf:"bytes,2,req,name=url" json:"url,omitempty"`
-	Description          *string  `protobuf:"bytes,3,opt,name=description" json:"description,omitempty"`
-	IconClass            *string  `protobuf:"bytes,4,opt,name=iconClass" json:"iconClass,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
+type ResourceDriftHistoryResponse struct {
+	Items                []*v1alpha1.ResourceDriftEpisode `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *ResourceDriftHistoryResponse) Reset()         { *m = ResourceDriftHistoryResponse{} }
+func (m *ResourceDriftHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*ResourceDriftHistoryResponse) ProtoMessage()    {}
+func (*ResourceDriftHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{38}
+}
+func (m *ResourceDriftHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceDriftHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceDriftHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResourceDriftHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceDriftHistoryResponse.Merge(m, src)
+}
+func (m *ResourceDriftHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceDriftHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceDriftHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceDriftHistoryResponse proto.InternalMessageInfo
+
+func (m *ResourceDriftHistoryResponse) GetItems() []*v1alpha1.ResourceDriftEpisode {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type LinkInfo struct {
+	Title                *string  `protobuf:"bytes,1,req,name=title" json:"title,omitempty"`
+	Url                  *string  `protobuf:"bytes,2,req,name=url" json:"url,omitempty"`
+	Description          *string  `protobuf:"bytes,3,opt,name=description" json:"description,omitempty"`
+	IconClass            *string  `protobuf:"bytes,4,opt,name=iconClass" json:"iconClass,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
@@ -2818,7 +3192,7 @@ func (m *LinkInfo) Reset()         { *m = LinkInfo{} }
 func (m *LinkInfo) String() string { return proto.CompactTextString(m) }
 func (*LinkInfo) ProtoMessage()    {}
 func (*LinkInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{34}
+	return fileDescriptor_df6e82b174b5eaec, []int{39}
 }
 func (m *LinkInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2886,7 +3260,7 @@ func (m *LinksResponse) Reset()         { *m = LinksResponse{} }
 func (m *LinksResponse) String() string { return proto.CompactTextString(m) }
 func (*LinksResponse) ProtoMessage()    {}
 func (*LinksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{35}
+	return fileDescriptor_df6e82b174b5eaec, []int{40}
 }
 func (m *LinksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2935,7 +3309,7 @@ func (m *ListAppLinksRequest) Reset()         { *m = ListAppLinksRequest{} }
 func (m *ListAppLinksRequest) String() string { return proto.CompactTextString(m) }
 func (*ListAppLinksRequest) ProtoMessage()    {}
 func (*ListAppLinksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{36}
+	return fileDescriptor_df6e82b174b5eaec, []int{41}
 }
 func (m *ListAppLinksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3004,6 +3378,7 @@ func init() {
 	proto.RegisterType((*ApplicationPatchRequest)(nil), "application.ApplicationPatchRequest")
 	proto.RegisterType((*ApplicationRollbackRequest)(nil), "application.ApplicationRollbackRequest")
 	proto.RegisterType((*ApplicationResourceRequest)(nil), "application.ApplicationResourceRequest")
+	proto.RegisterType((*OrphanedResourceExportResponse)(nil), "application.OrphanedResourceExportResponse")
 	proto.RegisterType((*ApplicationResourcePatchRequest)(nil), "application.ApplicationResourcePatchRequest")
 	proto.RegisterType((*ApplicationResourceDeleteRequest)(nil), "application.ApplicationResourceDeleteRequest")
 	proto.RegisterType((*ResourceActionParameters)(nil), "application.ResourceActionParameters")
@@ -3014,12 +3389,16 @@ func init() {
 	proto.RegisterType((*ApplicationPodLogsQuery)(nil), "application.ApplicationPodLogsQuery")
 	proto.RegisterType((*LogEntry)(nil), "application.LogEntry")
 	proto.RegisterType((*OperationTerminateRequest)(nil), "application.OperationTerminateRequest")
+	proto.RegisterType((*ApplicationPauseRequest)(nil), "application.ApplicationPauseRequest")
+	proto.RegisterType((*ApplicationResumeRequest)(nil), "application.ApplicationResumeRequest")
+	proto.RegisterType((*ApplicationRestoreSnapshotRequest)(nil), "application.ApplicationRestoreSnapshotRequest")
 	proto.RegisterType((*ApplicationSyncWindowsQuery)(nil), "application.ApplicationSyncWindowsQuery")
 	proto.RegisterType((*ApplicationSyncWindowsResponse)(nil), "application.ApplicationSyncWindowsResponse")
 	proto.RegisterType((*ApplicationSyncWindow)(nil), "application.ApplicationSyncWindow")
 	proto.RegisterType((*OperationTerminateResponse)(nil), "application.OperationTerminateResponse")
 	proto.RegisterType((*ResourcesQuery)(nil), "application.ResourcesQuery")
 	proto.RegisterType((*ManagedResourcesResponse)(nil), "application.ManagedResourcesResponse")
+	proto.RegisterType((*ResourceDriftHistoryResponse)(nil), "application.ResourceDriftHistoryResponse")
 	proto.RegisterType((*LinkInfo)(nil), "application.LinkInfo")
 	proto.RegisterType((*LinksResponse)(nil), "application.LinksResponse")
 	proto.RegisterType((*ListAppLinksRequest)(nil), "application.ListAppLinksRequest")
@@ -3030,188 +3409,208 @@ func init() {
 }
 
 var fileDescriptor_df6e82b174b5eaec = []byte{
-	// 2885 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0xcf, 0x8f, 0x1c, 0x47,
-	0xf5, 0xff, 0xd6, 0xcc, 0xce, 0xee, 0xec, 0x1b, 0xff, 0xac, 0xd8, 0xfe, 0x76, 0xc6, 0x1b, 0x33,
-	0x69, 0xff, 0x9a, 0xac, 0xbd, 0x33, 0xf6, 0xc4, 0xa0, 0x64, 0x93, 0x10, 0x9c, 0xb5, 0xe3, 0x2c,
-	0xac, 0x1d, 0xd3, 0xeb, 0xc4, 0x28, 0x1c, 0xa0, 0xd2, 0x5d, 0x3b, 0xd3, 0x6c, 0x4f, 0x77, 0xbb,
-	0xbb, 0x67, 0xc2, 0x2a, 0xe4, 0x12, 0x84, 0xc4, 0x21, 0x0a, 0x02, 0x72, 0xe0, 0xc0, 0x2f, 0x25,
-	0x8a, 0x84, 0x10, 0x88, 0x0b, 0x42, 0x48, 0x08, 0x09, 0x0e, 0x41, 0x70, 0x40, 0x8a, 0xe0, 0x1f,
-	0x40, 0x11, 0xe2, 0x48, 0x2e, 0x39, 0x23, 0x54, 0xd5, 0x55, 0xdd, 0xd5, 0xf3, 0xa3, 0x67, 0x96,
-	0x19, 0x14, 0x4b, 0xdc, 0xfa, 0xd5, 0x54, 0xbd, 0xf7, 0x79, 0xaf, 0x5e, 0xbd, 0x57, 0xf5, 0xde,
-	0xc0, 0x99, 0x90, 0x06, 0x7d, 0x1a, 0x34, 0x89, 0xef, 0x3b, 0xb6, 0x49, 0x22, 0xdb, 0x73, 0xd5,
-	0xef, 0x86, 0x1f, 0x78, 0x91, 0x87, 0x2b, 0xca, 0x50, 0x75, 0xa5, 0xed, 0x79, 0x6d, 0x87, 0x36,
-	0x89, 0x6f, 0x37, 0x89, 0xeb, 0x7a, 0x11, 0x1f, 0x0e, 0xe3, 0xa9, 0x55, 0x7d, 0xf7, 0xb1, 0xb0,
-	0x61, 0x7b, 0xfc, 0x57, 0xd3, 0x0b, 0x68, 0xb3, 0x7f, 0xb9, 0xd9, 0xa6, 0x2e, 0x0d, 0x48, 0x44,
-	0x2d, 0x31, 0xe7, 0x4a, 0x3a, 0xa7, 0x4b, 0xcc, 0x8e, 0xed, 0xd2, 0x60, 0xaf, 0xe9, 0xef, 0xb6,
-	0xd9, 0x40, 0xd8, 0xec, 0xd2, 0x88, 0x8c, 0x5a, 0xb5, 0xd5, 0xb6, 0xa3, 0x4e, 0xef, 0xe5, 0x86,
-	0xe9, 0x75, 0x9b, 0x24, 0x68, 0x7b, 0x7e, 0xe0, 0x7d, 0x85, 0x7f, 0xac, 0x99, 0x56, 0xb3, 0xff,
-	0x68, 0xca, 0x40, 0xd5, 0xa5, 0x7f, 0x99, 0x38, 0x7e, 0x87, 0x0c, 0x73, 0xbb, 0x3e, 0x81, 0x5b,
-	0x40, 0x7d, 0x4f, 0xd8, 0x86, 0x7f, 0xda, 0x91, 0x17, 0xec, 0x29, 0x9f, 0x31, 0x1b, 0xfd, 0x23,
-	0x04, 0x47, 0xae, 0xa6, 0xf2, 0x3e, 0xdf, 0xa3, 0xc1, 0x1e, 0xc6, 0xb0, 0xe0, 0x92, 0x2e, 0xd5,
-	0x50, 0x0d, 0xd5, 0x97, 0x0d, 0xfe, 0x8d, 0x35, 0x58, 0x0a, 0xe8, 0x4e, 0x40, 0xc3, 0x8e, 0x56,
-	0xe0, 0xc3, 0x92, 0xc4, 0x55, 0x28, 0x33, 0xe1, 0xd4, 0x8c, 0x42, 0xad, 0x58, 0x2b, 0xd6, 0x97,
-	0x8d, 0x84, 0xc6, 0x75, 0x38, 0x1c, 0xd0, 0xd0, 0xeb, 0x05, 0x26, 0x7d, 0x91, 0x06, 0xa1, 0xed,
-	0xb9, 0xda, 0x02, 0x5f, 0x3d, 0x38, 0xcc, 0xb8, 0x84, 0xd4, 0xa1, 0x66, 0xe4, 0x05, 0x5a, 0x89,
-	0x4f, 0x49, 0x68, 0x86, 0x87, 0x01, 0xd7, 0x16, 0x63, 0x3c, 0xec, 0x1b, 0xeb, 0x70, 0x80, 0xf8,
-	0xfe, 0x2d, 0xd2, 0xa5, 0xa1, 0x4f, 0x4c, 0xaa, 0x2d, 0xf1, 0xdf, 0x32, 0x63, 0x0c, 0xb3, 0x40,
-	0xa2, 0x95, 0x39, 0x30, 0x49, 0xea, 0x1b, 0xb0, 0x7c, 0xcb, 0xb3, 0xe8, 0x78, 0x75, 0x07, 0xd9,
-	0x17, 0x86, 0xd9, 0xeb, 0xef, 0x21, 0x38, 0x6e, 0xd0, 0xbe, 0xcd, 0xf0, 0xdf, 0xa4, 0x11, 0xb1,
-	0x48, 0x44, 0x06, 0x39, 0x16, 0x12, 0x8e, 0x55, 0x28, 0x07, 0x62, 0xb2, 0x56, 0xe0, 0xe3, 0x09,
-	0x3d, 0x24, 0xad, 0x98, 0xaf, 0x4c, 0x6c, 0x42, 0x49, 0xe2, 0x1a, 0x54, 0x62, 0x5b, 0x6e, 0xba,
-	0x16, 0xfd, 0x2a, 0xb7, 0x5e, 0xc9, 0x50, 0x87, 0xf0, 0x0a, 0x2c, 0xf7, 0x63, 0x3b, 0x6f, 0x5a,
-	0xdc, 0x8a, 0x25, 0x23, 0x1d, 0xd0, 0xff, 0x81, 0xe0, 0x94, 0xe2, 0x03, 0x86, 0xd8, 0x99, 0xeb,
-	0x7d, 0xea, 0x46, 0xe1, 0x78, 0x85, 0x2e, 0xc2, 0x51, 0xb9, 0x89, 0x83, 0x76, 0x1a, 0xfe, 0x81,
-	0xa9, 0xa8, 0x0e, 0x4a, 0x15, 0xd5, 0x31, 0xa6, 0x88, 0xa4, 0x5f, 0xd8, 0xbc, 0x26, 0xd4, 0x54,
-	0x87, 0x86, 0x0c, 0x55, 0xca, 0x37, 0xd4, 0x62, 0xc6, 0x50, 0xfa, 0xfb, 0x08, 0x34, 0x45, 0xd1,
-	0x9b, 0xc4, 0xb5, 0x77, 0x68, 0x18, 0x4d, 0xbb, 0x67, 0x68, 0x8e, 0x7b, 0x56, 0x87, 0xc3, 0xb1,
-	0x56, 0xb7, 0xd9, 0x79, 0x64, 0xf1, 0x47, 0x2b, 0xd5, 0x8a, 0xf5, 0xa2, 0x31, 0x38, 0xcc, 0xf6,
-	0x4e, 0xca, 0x0c, 0xb5, 0x45, 0xee, 0xc6, 0xe9, 0x80, 0xfe, 0x30, 0x2c, 0x3f, 0x6b, 0x3b, 0x74,
-	0xa3, 0xd3, 0x73, 0x77, 0xf1, 0x31, 0x28, 0x99, 0xec, 0x83, 0xeb, 0x70, 0xc0, 0x88, 0x09, 0xfd,
-	0xdb, 0x08, 0x1e, 0x1e, 0xa7, 0xf5, 0x5d, 0x3b, 0xea, 0xb0, 0xf5, 0xe1, 0x38, 0xf5, 0xcd, 0x0e,
-	0x35, 0x77, 0xc3, 0x5e, 0x57, 0xba, 0xac, 0xa4, 0x67, 0x53, 0x5f, 0xff, 0x29, 0x82, 0xfa, 0x44,
-	0x4c, 0x77, 0x03, 0xe2, 0xfb, 0x34, 0xc0, 0xcf, 0x42, 0xe9, 0x1e, 0xfb, 0x81, 0x1f, 0xd0, 0x4a,
-	0xab, 0xd1, 0x50, 0x03, 0xfc, 0x44, 0x2e, 0xcf, 0xfd, 0x9f, 0x11, 0x2f, 0xc7, 0x0d, 0x69, 0x9e,
-	0x02, 0xe7, 0x73, 0x22, 0xc3, 0x27, 0xb1, 0x22, 0x9b, 0xcf, 0xa7, 0x3d, 0xb3, 0x08, 0x0b, 0x3e,
-	0x09, 0x22, 0xfd, 0x38, 0x3c, 0x90, 0x3d, 0x1e, 0xbe, 0xe7, 0x86, 0x54, 0xff, 0x4d, 0xd6, 0x9b,
-	0x36, 0x02, 0x4a, 0x22, 0x6a, 0xd0, 0x7b, 0x3d, 0x1a, 0x46, 0x78, 0x17, 0xd4, 0x9c, 0xc3, 0xad,
-	0x5a, 0x69, 0x6d, 0x36, 0xd2, 0xa0, 0xdd, 0x90, 0x41, 0x9b, 0x7f, 0x7c, 0xc9, 0xb4, 0x1a, 0xfd,
-	0x47, 0x1b, 0xfe, 0x6e, 0xbb, 0xc1, 0x52, 0x40, 0x06, 0x99, 0x4c, 0x01, 0xaa, 0xaa, 0x86, 0xca,
-	0x1d, 0x9f, 0x80, 0xc5, 0x9e, 0x1f, 0xd2, 0x20, 0xe2, 0x9a, 0x95, 0x0d, 0x41, 0xb1, 0xfd, 0xeb,
-	0x13, 0xc7, 0xb6, 0x48, 0x14, 0xef, 0x4f, 0xd9, 0x48, 0x68, 0xfd, 0xb7, 0x59, 0xf4, 0x2f, 0xf8,
-	0xd6, 0xc7, 0x85, 0x5e, 0x45, 0x59, 0xc8, 0xa2, 0x54, 0x3d, 0xa8, 0x98, 0xf5, 0xa0, 0x5f, 0x66,
-	0xf1, 0x5f, 0xa3, 0x0e, 0x4d, 0xf1, 0x8f, 0x72, 0x66, 0x0d, 0x96, 0x4c, 0x12, 0x9a, 0xc4, 0x92,
-	0x52, 0x24, 0xc9, 0x02, 0x99, 0x1f, 0x78, 0x3e, 0x69, 0x73, 0x4e, 0xb7, 0x3d, 0xc7, 0x36, 0xf7,
-	0x84, 0xb8, 0xe1, 0x1f, 0x86, 0x1c, 0x7f, 0x21, 0xdf, 0xf1, 0x4b, 0x59, 0xd8, 0xa7, 0xa1, 0xb2,
-	0xbd, 0xe7, 0x9a, 0xcf, 0xfb, 0xf1, 0xe1, 0x3e, 0x06, 0x25, 0x3b, 0xa2, 0xdd, 0x50, 0x43, 0xfc,
-	0x60, 0xc7, 0x84, 0xfe, 0xaf, 0x12, 0x9c, 0x50, 0x74, 0x63, 0x0b, 0xf2, 0x34, 0xcb, 0x8b, 0x52,
-	0x27, 0x60, 0xd1, 0x0a, 0xf6, 0x8c, 0x9e, 0x2b, 0x1c, 0x40, 0x50, 0x4c, 0xb0, 0x1f, 0xf4, 0xdc,
-	0x18, 0x7e, 0xd9, 0x88, 0x09, 0xbc, 0x03, 0xe5, 0x30, 0x62, 0xb7, 0x8c, 0xf6, 0x1e, 0x07, 0x5e,
-	0x69, 0x7d, 0x76, 0xb6, 0x4d, 0x67, 0xd0, 0xb7, 0x05, 0x47, 0x23, 0xe1, 0x8d, 0xef, 0xb1, 0x98,
-	0x16, 0x07, 0xba, 0x50, 0x5b, 0xaa, 0x15, 0xeb, 0x95, 0xd6, 0xf6, 0xec, 0x82, 0x9e, 0xf7, 0xd9,
-	0x0d, 0x49, 0xc9, 0x60, 0x46, 0x2a, 0x85, 0x85, 0xd1, 0xae, 0x88, 0x0f, 0xa1, 0xb8, 0x0d, 0xa4,
-	0x03, 0xf8, 0x0b, 0x50, 0xb2, 0xdd, 0x1d, 0x2f, 0xd4, 0x96, 0x39, 0x98, 0x67, 0x66, 0x03, 0xb3,
-	0xe9, 0xee, 0x78, 0x46, 0xcc, 0x10, 0xdf, 0x83, 0x83, 0x01, 0x8d, 0x82, 0x3d, 0x69, 0x05, 0x0d,
-	0xb8, 0x5d, 0x3f, 0x37, 0x9b, 0x04, 0x43, 0x65, 0x69, 0x64, 0x25, 0xe0, 0x75, 0xa8, 0x84, 0xa9,
-	0x8f, 0x69, 0x15, 0x2e, 0x50, 0xcb, 0x30, 0x52, 0x7c, 0xd0, 0x50, 0x27, 0x0f, 0x79, 0xf7, 0x81,
-	0x7c, 0xef, 0x3e, 0x38, 0x31, 0xab, 0x1d, 0x9a, 0x22, 0xab, 0x1d, 0x1e, 0xcc, 0x6a, 0x1f, 0x22,
-	0x58, 0x19, 0x0a, 0x4e, 0xdb, 0x3e, 0xcd, 0x3d, 0x06, 0x04, 0x16, 0x42, 0x9f, 0x9a, 0x3c, 0x53,
-	0x55, 0x5a, 0x37, 0xe7, 0x16, 0xad, 0xb8, 0x5c, 0xce, 0x3a, 0x2f, 0xa0, 0xce, 0x18, 0x17, 0x7e,
-	0x84, 0xe0, 0xff, 0x15, 0x99, 0xb7, 0x49, 0x64, 0x76, 0xf2, 0x94, 0x65, 0xe7, 0x97, 0xcd, 0x11,
-	0x79, 0x39, 0x26, 0x98, 0x55, 0xf9, 0xc7, 0x9d, 0x3d, 0x9f, 0x01, 0x64, 0xbf, 0xa4, 0x03, 0x33,
-	0x5e, 0x9e, 0x7e, 0x86, 0xa0, 0xaa, 0xc6, 0x70, 0xcf, 0x71, 0x5e, 0x26, 0xe6, 0x6e, 0x1e, 0xc8,
-	0x43, 0x50, 0xb0, 0x2d, 0x8e, 0xb0, 0x68, 0x14, 0x6c, 0x6b, 0x9f, 0xc1, 0x68, 0x10, 0xee, 0x62,
-	0x3e, 0xdc, 0xa5, 0x2c, 0xdc, 0x8f, 0x06, 0xe0, 0xca, 0x90, 0x90, 0x03, 0x77, 0x05, 0x96, 0xdd,
-	0x81, 0x8b, 0x6c, 0x3a, 0x30, 0xe2, 0x02, 0x5b, 0x18, 0xba, 0xc0, 0x6a, 0xb0, 0xd4, 0x4f, 0x9e,
-	0x39, 0xec, 0x67, 0x49, 0x32, 0x15, 0xdb, 0x81, 0xd7, 0xf3, 0x85, 0xd1, 0x63, 0x82, 0xa1, 0xd8,
-	0xb5, 0x5d, 0x76, 0x25, 0xe7, 0x28, 0xd8, 0xf7, 0xfe, 0x1f, 0x36, 0x19, 0xb5, 0x7f, 0x5e, 0x80,
-	0x4f, 0x8c, 0x50, 0x7b, 0xa2, 0x3f, 0xdd, 0x1f, 0xba, 0x27, 0x5e, 0xbd, 0x34, 0xd6, 0xab, 0xcb,
-	0x93, 0xbc, 0x7a, 0x39, 0xdf, 0x5e, 0x90, 0xb5, 0xd7, 0x4f, 0x0a, 0x50, 0x1b, 0x61, 0xaf, 0xc9,
-	0xd7, 0x89, 0xfb, 0xc6, 0x60, 0x3b, 0x5e, 0x20, 0xbc, 0xa4, 0x6c, 0xc4, 0x04, 0x3b, 0x67, 0x5e,
-	0xe0, 0x77, 0x88, 0xcb, 0xbd, 0xa3, 0x6c, 0x08, 0x6a, 0x46, 0x53, 0x5d, 0x03, 0x4d, 0x9a, 0xe7,
-	0xaa, 0x19, 0x07, 0xa9, 0x80, 0x74, 0x69, 0x44, 0x83, 0x70, 0x5c, 0x88, 0xea, 0x13, 0xa7, 0x47,
-	0x65, 0x88, 0xe2, 0x84, 0xfe, 0x66, 0x61, 0x90, 0x8d, 0xd1, 0x73, 0xef, 0x7f, 0x43, 0x9f, 0x80,
-	0x45, 0xc2, 0xd1, 0x0a, 0xd7, 0x14, 0xd4, 0x90, 0x49, 0xcb, 0xf9, 0x26, 0x5d, 0xce, 0x98, 0x74,
-	0xbd, 0xa0, 0x21, 0xfd, 0xc3, 0x02, 0x54, 0xc7, 0x19, 0xe4, 0xc5, 0xd6, 0xff, 0x9a, 0x49, 0x30,
-	0x01, 0x2d, 0x18, 0xe3, 0x65, 0x1a, 0xf0, 0xcb, 0xd9, 0xd9, 0x4c, 0xc6, 0x1e, 0xe7, 0x92, 0xc6,
-	0x58, 0x36, 0xfa, 0x37, 0x10, 0x9c, 0xcc, 0x2e, 0x0b, 0xb7, 0xec, 0x30, 0x92, 0x0f, 0x3b, 0xbc,
-	0x03, 0x4b, 0xb1, 0x2a, 0xf1, 0xb5, 0xbc, 0xd2, 0xda, 0x9a, 0xf5, 0xb2, 0x96, 0xd9, 0x5d, 0xc9,
-	0x5c, 0x7f, 0x1c, 0x4e, 0x8e, 0xcc, 0x50, 0x02, 0x46, 0x15, 0xca, 0xf2, 0x82, 0x2a, 0x76, 0x3f,
-	0xa1, 0xf5, 0x77, 0x16, 0xb2, 0xd7, 0x05, 0xcf, 0xda, 0xf2, 0xda, 0x39, 0xb5, 0x9a, 0x7c, 0x8f,
-	0x61, 0xbb, 0xe1, 0x59, 0x4a, 0x59, 0x46, 0x92, 0x6c, 0x9d, 0xe9, 0xb9, 0x11, 0xb1, 0x5d, 0x1a,
-	0x88, 0x1b, 0x4d, 0x3a, 0xc0, 0x76, 0x3a, 0xb4, 0x5d, 0x93, 0x6e, 0x53, 0xd3, 0x73, 0xad, 0x90,
-	0xbb, 0x4c, 0xd1, 0xc8, 0x8c, 0xe1, 0xe7, 0x60, 0x99, 0xd3, 0x77, 0xec, 0x6e, 0x9c, 0xc2, 0x2b,
-	0xad, 0xd5, 0x46, 0x5c, 0x3f, 0x6d, 0xa8, 0xf5, 0xd3, 0xd4, 0x86, 0x5d, 0x1a, 0x91, 0x46, 0xff,
-	0x72, 0x83, 0xad, 0x30, 0xd2, 0xc5, 0x0c, 0x4b, 0x44, 0x6c, 0x67, 0xcb, 0x76, 0xf9, 0xa3, 0x81,
-	0x89, 0x4a, 0x07, 0x98, 0x37, 0xee, 0x78, 0x8e, 0xe3, 0xbd, 0x22, 0x63, 0x5e, 0x4c, 0xb1, 0x55,
-	0x3d, 0x37, 0xb2, 0x1d, 0x2e, 0x3f, 0xf6, 0xb5, 0x74, 0x80, 0xaf, 0xb2, 0x9d, 0x88, 0x06, 0x22,
-	0xd8, 0x09, 0x2a, 0xf1, 0xf7, 0x4a, 0x5c, 0x12, 0x94, 0xb1, 0x36, 0x3e, 0x19, 0x07, 0xd4, 0x93,
-	0x31, 0x78, 0xda, 0x0e, 0x8e, 0xa8, 0x6b, 0xf1, 0x0a, 0x29, 0xed, 0xdb, 0x5e, 0x8f, 0xdd, 0x87,
-	0xf9, 0xb5, 0x51, 0xd2, 0x43, 0xa7, 0xe5, 0x70, 0xfe, 0x69, 0x39, 0x92, 0x3d, 0x2d, 0xfc, 0x55,
-	0x13, 0x99, 0x9d, 0x0d, 0x12, 0x52, 0xed, 0x28, 0x67, 0x9d, 0x0e, 0xe8, 0xbf, 0x43, 0x50, 0xde,
-	0xf2, 0xda, 0xd7, 0xdd, 0x28, 0xd8, 0xe3, 0xef, 0x5f, 0xcf, 0x8d, 0xa8, 0x2b, 0xbd, 0x49, 0x92,
-	0x6c, 0x8b, 0x22, 0xbb, 0x4b, 0xb7, 0x23, 0xd2, 0xf5, 0xc5, 0xed, 0x79, 0x5f, 0x5b, 0x94, 0x2c,
-	0x66, 0x66, 0x73, 0x48, 0x18, 0xf1, 0x90, 0x53, 0x36, 0xf8, 0x37, 0x53, 0x30, 0x99, 0xb0, 0x1d,
-	0x05, 0x22, 0xde, 0x64, 0xc6, 0x54, 0x07, 0x2c, 0xc5, 0xd8, 0x04, 0xa9, 0x77, 0xe1, 0xc1, 0xe4,
-	0x59, 0x77, 0x87, 0x06, 0x5d, 0xdb, 0x25, 0xf9, 0x79, 0x79, 0x8a, 0xc2, 0x6d, 0x4e, 0x55, 0xc1,
-	0xcb, 0x1c, 0x49, 0xf6, 0x4a, 0xba, 0x6b, 0xbb, 0x96, 0xf7, 0x4a, 0xce, 0xd1, 0x9a, 0x4d, 0xe0,
-	0x5f, 0xb2, 0xb5, 0x57, 0x45, 0x62, 0x12, 0x07, 0x9e, 0x83, 0x83, 0x2c, 0x62, 0xf4, 0xa9, 0xf8,
-	0x41, 0x04, 0x25, 0x7d, 0x5c, 0x19, 0x2c, 0xe5, 0x61, 0x64, 0x17, 0xe2, 0x2d, 0x38, 0x4c, 0xc2,
-	0xd0, 0x6e, 0xbb, 0xd4, 0x92, 0xbc, 0x0a, 0x53, 0xf3, 0x1a, 0x5c, 0x1a, 0x17, 0x54, 0xf8, 0x0c,
-	0xb1, 0xdf, 0x92, 0xd4, 0xbf, 0x8e, 0xe0, 0xf8, 0x48, 0x26, 0xc9, 0xb9, 0x42, 0x4a, 0x1e, 0xa9,
-	0x42, 0x39, 0x34, 0x3b, 0xd4, 0xea, 0x39, 0xf2, 0xaa, 0x90, 0xd0, 0xec, 0x37, 0xab, 0x17, 0xef,
-	0xbe, 0xc8, 0x63, 0x09, 0x8d, 0x4f, 0x01, 0x74, 0x89, 0xdb, 0x23, 0x0e, 0x87, 0xb0, 0xc0, 0x21,
-	0x28, 0x23, 0xfa, 0x0a, 0x54, 0x47, 0xb9, 0x8e, 0xa8, 0xde, 0xfd, 0x13, 0xc1, 0x21, 0x19, 0x72,
-	0xc5, 0xee, 0xd6, 0xe1, 0xb0, 0x62, 0x86, 0x5b, 0xe9, 0x46, 0x0f, 0x0e, 0x4f, 0x08, 0xa7, 0xd2,
-	0x4b, 0x8a, 0xd9, 0xf6, 0x49, 0x3f, 0xd3, 0x00, 0x99, 0x3a, 0xe1, 0xa2, 0x39, 0xbd, 0x0c, 0xbe,
-	0x06, 0xda, 0x4d, 0xe2, 0x92, 0x36, 0xb5, 0x12, 0xb5, 0x13, 0x17, 0xfb, 0xb2, 0x5a, 0x86, 0x9a,
-	0xb9, 0xe8, 0x93, 0x5c, 0xa2, 0xed, 0x9d, 0x1d, 0x59, 0xd2, 0x0a, 0xa0, 0xbc, 0x65, 0xbb, 0xbb,
-	0x9b, 0xee, 0x8e, 0xc7, 0x34, 0x8e, 0xec, 0xc8, 0x91, 0xd6, 0x8d, 0x09, 0x7c, 0x04, 0x8a, 0xbd,
-	0xc0, 0x11, 0x1e, 0xc0, 0x3e, 0x71, 0x0d, 0x2a, 0x16, 0x0d, 0xcd, 0xc0, 0xf6, 0xc5, 0xfe, 0xf3,
-	0x76, 0x80, 0x32, 0xc4, 0xf6, 0xc1, 0x36, 0x3d, 0x77, 0xc3, 0x21, 0x61, 0x28, 0xd3, 0x53, 0x32,
-	0xa0, 0x3f, 0x09, 0x07, 0x99, 0xcc, 0x54, 0xcd, 0x0b, 0x59, 0x35, 0x8f, 0x67, 0xe0, 0x4b, 0x78,
-	0x12, 0x31, 0x81, 0x07, 0xd8, 0xad, 0xe0, 0xaa, 0xef, 0x0b, 0x26, 0x53, 0x5e, 0x51, 0x8b, 0xa3,
-	0xb2, 0xeb, 0xc8, 0x2a, 0x78, 0xeb, 0xbd, 0x73, 0x80, 0xd5, 0x73, 0x42, 0x83, 0xbe, 0x6d, 0x52,
-	0xfc, 0x1d, 0x04, 0x0b, 0x4c, 0x34, 0x7e, 0x68, 0xdc, 0xb1, 0xe4, 0xfe, 0x5a, 0x9d, 0x5f, 0x89,
-	0x83, 0x49, 0xd3, 0x57, 0x5e, 0xff, 0xeb, 0xdf, 0xbf, 0x5b, 0x38, 0x81, 0x8f, 0xf1, 0xde, 0x67,
-	0xff, 0xb2, 0xda, 0x87, 0x0c, 0xf1, 0x1b, 0x08, 0xb0, 0xb8, 0x25, 0x29, 0xdd, 0x21, 0x7c, 0x61,
-	0x1c, 0xc4, 0x11, 0x5d, 0xa4, 0xea, 0x43, 0x4a, 0x56, 0x69, 0x98, 0x5e, 0x40, 0x59, 0x0e, 0xe1,
-	0x13, 0x38, 0x80, 0x55, 0x0e, 0xe0, 0x0c, 0xd6, 0x47, 0x01, 0x68, 0xbe, 0xca, 0x2c, 0xfa, 0x5a,
-	0x93, 0xc6, 0x72, 0xdf, 0x46, 0x50, 0xba, 0xcb, 0x5f, 0x87, 0x13, 0x8c, 0xb4, 0x3d, 0x37, 0x23,
-	0x71, 0x71, 0x1c, 0xad, 0x7e, 0x9a, 0x23, 0x7d, 0x08, 0x9f, 0x94, 0x48, 0xc3, 0x28, 0xa0, 0xa4,
-	0x9b, 0x01, 0x7c, 0x09, 0xe1, 0x77, 0x11, 0x2c, 0xc6, 0x6d, 0x01, 0x7c, 0x76, 0x1c, 0xca, 0x4c,
-	0xdb, 0xa0, 0x3a, 0xbf, 0x1a, 0xbb, 0xfe, 0x08, 0xc7, 0x78, 0x5a, 0x1f, 0xb9, 0x9d, 0xeb, 0x99,
-	0x0a, 0xfc, 0x5b, 0x08, 0x8a, 0x37, 0xe8, 0x44, 0x7f, 0x9b, 0x23, 0xb8, 0x21, 0x03, 0x8e, 0xd8,
-	0x6a, 0xfc, 0x0e, 0x82, 0x07, 0x6f, 0xd0, 0x68, 0x74, 0x7a, 0xc4, 0xf5, 0xc9, 0x39, 0x4b, 0xb8,
-	0xdd, 0x85, 0x29, 0x66, 0x26, 0x79, 0xa1, 0xc9, 0x91, 0x3d, 0x82, 0xcf, 0xe7, 0x39, 0x61, 0xb8,
-	0xe7, 0x9a, 0xaf, 0x08, 0x1c, 0x7f, 0x42, 0x70, 0x64, 0xb0, 0x0b, 0x8c, 0xf5, 0x81, 0x37, 0xca,
-	0x88, 0x26, 0x71, 0xf5, 0xd6, 0xac, 0x51, 0x36, 0xcb, 0x54, 0xbf, 0xca, 0x91, 0x3f, 0x81, 0x1f,
-	0xcf, 0x43, 0x9e, 0xd4, 0x58, 0x9b, 0xaf, 0xca, 0xcf, 0xd7, 0xf8, 0x3f, 0x16, 0x38, 0xec, 0x3f,
-	0x23, 0x38, 0x26, 0xf9, 0x6e, 0x74, 0x48, 0x10, 0x5d, 0xa3, 0xec, 0x86, 0x1d, 0x4e, 0xa5, 0xcf,
-	0x8c, 0x59, 0x43, 0x95, 0xa7, 0x5f, 0xe7, 0xba, 0x3c, 0x8d, 0x9f, 0xda, 0xb7, 0x2e, 0x26, 0x63,
-	0x63, 0x09, 0xd8, 0xef, 0x21, 0x38, 0x74, 0x83, 0x46, 0xcf, 0x6f, 0x6c, 0xee, 0x6b, 0x67, 0x66,
-	0x74, 0x74, 0x45, 0x9c, 0x7e, 0x8d, 0x2b, 0xf2, 0x69, 0xfc, 0xe4, 0xbe, 0x15, 0xf1, 0x4c, 0x3b,
-	0xd9, 0x97, 0xd7, 0x11, 0x1c, 0xb8, 0x41, 0xa3, 0x9b, 0x49, 0xbf, 0xe2, 0xec, 0x54, 0x3d, 0xd0,
-	0xea, 0x4a, 0x43, 0xf9, 0xc3, 0x87, 0xfc, 0x29, 0x71, 0xf5, 0x35, 0x8e, 0xed, 0x3c, 0x3e, 0x9b,
-	0x87, 0x2d, 0xed, 0x91, 0xbc, 0x8d, 0xe0, 0xb8, 0x0a, 0x22, 0xed, 0x1d, 0x7f, 0x72, 0x7f, 0x1d,
-	0x59, 0xd1, 0xd7, 0x9d, 0x80, 0xae, 0xc5, 0xd1, 0x5d, 0xd4, 0x47, 0x1f, 0xc4, 0xee, 0x10, 0x8a,
-	0x75, 0xb4, 0x5a, 0x47, 0xf8, 0xf7, 0x08, 0x16, 0xe3, 0x76, 0xc1, 0x78, 0x1b, 0x65, 0x7a, 0x9d,
-	0xf3, 0x8c, 0x6a, 0xc2, 0x6b, 0xab, 0x97, 0x46, 0x1b, 0x54, 0x5d, 0x2f, 0xb7, 0xb6, 0xc1, 0xad,
-	0x9c, 0x0d, 0xc7, 0xbf, 0x42, 0x00, 0x69, 0xcb, 0x03, 0x3f, 0x92, 0xaf, 0x87, 0xd2, 0x16, 0xa9,
-	0xce, 0xb7, 0xe9, 0xa1, 0x37, 0xb8, 0x3e, 0xf5, 0x6a, 0x2d, 0x37, 0x16, 0xfa, 0xd4, 0x5c, 0x8f,
-	0xdb, 0x23, 0x3f, 0x46, 0x50, 0xe2, 0x95, 0x66, 0x7c, 0x66, 0x1c, 0x66, 0xb5, 0x10, 0x3d, 0x4f,
-	0xd3, 0x9f, 0xe3, 0x50, 0x6b, 0xad, 0xbc, 0x84, 0xb2, 0x8e, 0x56, 0x71, 0x1f, 0x16, 0xe3, 0xda,
-	0xee, 0x78, 0xf7, 0xc8, 0xd4, 0x7e, 0xab, 0xb5, 0x9c, 0x0b, 0x4e, 0xec, 0xa8, 0x22, 0x97, 0xad,
-	0x4e, 0xca, 0x65, 0x0b, 0x2c, 0xdd, 0xe0, 0xd3, 0x79, 0xc9, 0xe8, 0xbf, 0x60, 0x98, 0x0b, 0x1c,
-	0xdd, 0x59, 0xbd, 0x36, 0x29, 0x9f, 0x31, 0xeb, 0x7c, 0x0f, 0xc1, 0x91, 0xc1, 0x47, 0x02, 0x3e,
-	0x39, 0xb2, 0xde, 0x26, 0x72, 0x6b, 0xd6, 0x8a, 0xe3, 0x1e, 0x18, 0xfa, 0x67, 0x38, 0x8a, 0x75,
-	0xfc, 0xd8, 0xc4, 0x93, 0x71, 0x4b, 0x46, 0x1d, 0xc6, 0x68, 0x2d, 0xed, 0xdf, 0xfe, 0x1a, 0xc1,
-	0x01, 0xc9, 0xf7, 0x4e, 0x40, 0x69, 0x3e, 0xac, 0xf9, 0x1d, 0x04, 0x26, 0x4b, 0x7f, 0x92, 0xc3,
-	0xff, 0x14, 0xbe, 0x32, 0x25, 0x7c, 0x09, 0x7b, 0x2d, 0x62, 0x48, 0xff, 0x80, 0xe0, 0xe8, 0xdd,
-	0xd8, 0xef, 0x3f, 0x26, 0xfc, 0x1b, 0x1c, 0xff, 0x53, 0xf8, 0x89, 0x9c, 0xfb, 0xea, 0x24, 0x35,
-	0x2e, 0x21, 0xfc, 0x0b, 0x04, 0x65, 0xd9, 0xf7, 0xc3, 0xe7, 0xc7, 0x1e, 0x8c, 0x6c, 0x67, 0x70,
-	0x9e, 0xce, 0x2c, 0x2e, 0x67, 0xfa, 0x99, 0xdc, 0x6c, 0x2a, 0xe4, 0x33, 0x87, 0x7e, 0x0b, 0x01,
-	0x4e, 0xde, 0xfe, 0x49, 0x35, 0x00, 0x9f, 0xcb, 0x88, 0x1a, 0x5b, 0x60, 0xaa, 0x9e, 0x9f, 0x38,
-	0x2f, 0x9b, 0x4a, 0x57, 0x73, 0x53, 0xa9, 0x97, 0xc8, 0x7f, 0x13, 0x41, 0xe5, 0x06, 0x4d, 0xde,
-	0x52, 0x39, 0xb6, 0xcc, 0xb6, 0x2d, 0xab, 0xf5, 0xc9, 0x13, 0x05, 0xa2, 0x8b, 0x1c, 0xd1, 0x39,
-	0x9c, 0x6f, 0x2a, 0x09, 0xe0, 0xfb, 0x08, 0x0e, 0xde, 0x56, 0x5d, 0x14, 0x5f, 0x9c, 0x24, 0x29,
-	0x13, 0xc9, 0xa7, 0xc7, 0xf5, 0x28, 0xc7, 0xb5, 0xa6, 0x4f, 0x85, 0x6b, 0x5d, 0x74, 0x00, 0x7f,
-	0x88, 0xe2, 0xc7, 0xf8, 0x40, 0xd5, 0xfe, 0x3f, 0xb5, 0x5b, 0x4e, 0xf1, 0x5f, 0xbf, 0xc2, 0xf1,
-	0x35, 0xf0, 0xc5, 0x69, 0xf0, 0x35, 0x45, 0x29, 0x1f, 0xff, 0x00, 0xc1, 0x51, 0xde, 0xb6, 0x51,
-	0x19, 0xe3, 0xbc, 0x4e, 0x45, 0xda, 0xe4, 0x99, 0x22, 0xc5, 0x3c, 0x1d, 0xc7, 0x1f, 0x7d, 0x5f,
-	0xa0, 0xd6, 0x45, 0x43, 0xe6, 0x9b, 0x05, 0xc4, 0xf6, 0xf7, 0x81, 0x21, 0x7c, 0x2f, 0xb6, 0x06,
-	0x0c, 0x38, 0xbe, 0x0d, 0x35, 0x05, 0xc6, 0x75, 0x8e, 0xf1, 0x8a, 0xde, 0xdc, 0x0f, 0xc6, 0x66,
-	0xbf, 0xc5, 0x8e, 0xe9, 0xb7, 0x10, 0x1c, 0x92, 0x69, 0x57, 0xf8, 0xdf, 0xda, 0xa4, 0xad, 0xdd,
-	0x6f, 0x9a, 0x16, 0x07, 0x62, 0x75, 0xba, 0x03, 0xf1, 0x2e, 0x82, 0x25, 0xd1, 0x55, 0xc9, 0xb9,
-	0xcc, 0x28, 0x6d, 0x97, 0xea, 0x40, 0x35, 0x49, 0x94, 0xdd, 0xf5, 0x2f, 0x72, 0xb1, 0x2f, 0xe0,
-	0x5c, 0xb3, 0xf8, 0x9e, 0x15, 0x36, 0x5f, 0x15, 0x35, 0xef, 0xd7, 0x9a, 0x8e, 0xd7, 0x0e, 0x5f,
-	0xd2, 0x71, 0x6e, 0xca, 0x66, 0x73, 0x2e, 0x21, 0x1c, 0xc1, 0x32, 0x73, 0x5f, 0x5e, 0xa2, 0xc2,
-	0xb5, 0x81, 0x82, 0xd6, 0x50, 0xf5, 0xaa, 0x5a, 0x1d, 0x2a, 0x79, 0xa5, 0x39, 0x5a, 0x14, 0x0c,
-	0xf0, 0xc3, 0xb9, 0x62, 0xb9, 0xa0, 0x37, 0x10, 0x1c, 0x55, 0xcf, 0x63, 0x2c, 0x7e, 0xea, 0xd3,
-	0x98, 0x87, 0x42, 0x5c, 0xfb, 0xf1, 0xea, 0x54, 0x6e, 0xc4, 0xe1, 0x3c, 0xf3, 0xec, 0x1f, 0x3f,
-	0x38, 0x85, 0xde, 0xff, 0xe0, 0x14, 0xfa, 0xdb, 0x07, 0xa7, 0xd0, 0x4b, 0x8f, 0x4d, 0xf7, 0x3f,
-	0x7b, 0xd3, 0xb1, 0xa9, 0x1b, 0xa9, 0xec, 0xff, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x81, 0x66, 0x91,
-	0xb9, 0x4d, 0x30, 0x00, 0x00,
+	// 3203 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5b, 0xcd, 0x8f, 0x1c, 0x47,
+	0x15, 0xa7, 0x66, 0x76, 0x76, 0x67, 0x6b, 0x6c, 0xaf, 0x5d, 0xb1, 0x97, 0xce, 0x78, 0x63, 0x36,
+	0x65, 0x3b, 0x5e, 0xaf, 0xbd, 0x33, 0xf6, 0xc6, 0x81, 0x64, 0x93, 0x10, 0x9c, 0xf5, 0xd7, 0xc2,
+	0xfa, 0x83, 0x5e, 0x27, 0x46, 0xe1, 0x00, 0x95, 0xee, 0xda, 0x99, 0x66, 0x7b, 0xba, 0xdb, 0x55,
+	0x35, 0x93, 0xac, 0x42, 0x2e, 0x91, 0x90, 0x72, 0x88, 0x82, 0x80, 0x1c, 0x40, 0x22, 0x10, 0x25,
+	0x0a, 0x22, 0x08, 0xc4, 0x0d, 0x21, 0x21, 0x24, 0x38, 0x04, 0xc1, 0x01, 0x09, 0xc1, 0x3f, 0x80,
+	0xa2, 0x88, 0x63, 0x72, 0xc9, 0x1f, 0x80, 0xaa, 0xba, 0xfa, 0x6b, 0x3e, 0x7a, 0x66, 0x99, 0xb1,
+	0x12, 0x89, 0x5b, 0xbf, 0x9a, 0xee, 0x57, 0xbf, 0x7a, 0xf5, 0xbe, 0xea, 0xbd, 0x1a, 0x78, 0x82,
+	0x53, 0xd6, 0xa1, 0xac, 0x4e, 0x82, 0xc0, 0x75, 0x2c, 0x22, 0x1c, 0xdf, 0x4b, 0x3f, 0xd7, 0x02,
+	0xe6, 0x0b, 0x1f, 0x55, 0x52, 0x43, 0xd5, 0x85, 0x86, 0xef, 0x37, 0x5c, 0x5a, 0x27, 0x81, 0x53,
+	0x27, 0x9e, 0xe7, 0x0b, 0x35, 0xcc, 0xc3, 0x57, 0xab, 0x78, 0xe7, 0x51, 0x5e, 0x73, 0x7c, 0xf5,
+	0xab, 0xe5, 0x33, 0x5a, 0xef, 0x9c, 0xaf, 0x37, 0xa8, 0x47, 0x19, 0x11, 0xd4, 0xd6, 0xef, 0x5c,
+	0x48, 0xde, 0x69, 0x11, 0xab, 0xe9, 0x78, 0x94, 0xed, 0xd6, 0x83, 0x9d, 0x86, 0x1c, 0xe0, 0xf5,
+	0x16, 0x15, 0xa4, 0xdf, 0x57, 0x9b, 0x0d, 0x47, 0x34, 0xdb, 0xcf, 0xd7, 0x2c, 0xbf, 0x55, 0x27,
+	0xac, 0xe1, 0x07, 0xcc, 0xff, 0x8e, 0x7a, 0x58, 0xb1, 0xec, 0x7a, 0xe7, 0xe1, 0x84, 0x41, 0x7a,
+	0x2d, 0x9d, 0xf3, 0xc4, 0x0d, 0x9a, 0xa4, 0x97, 0xdb, 0xe5, 0x21, 0xdc, 0x18, 0x0d, 0x7c, 0x2d,
+	0x1b, 0xf5, 0xe8, 0x08, 0x9f, 0xed, 0xa6, 0x1e, 0x43, 0x36, 0xf8, 0x13, 0x00, 0x0f, 0x5e, 0x4c,
+	0xe6, 0xfb, 0x7a, 0x9b, 0xb2, 0x5d, 0x84, 0xe0, 0x94, 0x47, 0x5a, 0xd4, 0x00, 0x8b, 0x60, 0x69,
+	0xd6, 0x54, 0xcf, 0xc8, 0x80, 0x33, 0x8c, 0x6e, 0x33, 0xca, 0x9b, 0x46, 0x41, 0x0d, 0x47, 0x24,
+	0xaa, 0xc2, 0xb2, 0x9c, 0x9c, 0x5a, 0x82, 0x1b, 0xc5, 0xc5, 0xe2, 0xd2, 0xac, 0x19, 0xd3, 0x68,
+	0x09, 0xce, 0x31, 0xca, 0xfd, 0x36, 0xb3, 0xe8, 0xb3, 0x94, 0x71, 0xc7, 0xf7, 0x8c, 0x29, 0xf5,
+	0x75, 0xf7, 0xb0, 0xe4, 0xc2, 0xa9, 0x4b, 0x2d, 0xe1, 0x33, 0xa3, 0xa4, 0x5e, 0x89, 0x69, 0x89,
+	0x47, 0x02, 0x37, 0xa6, 0x43, 0x3c, 0xf2, 0x19, 0x61, 0xb8, 0x8f, 0x04, 0xc1, 0x0d, 0xd2, 0xa2,
+	0x3c, 0x20, 0x16, 0x35, 0x66, 0xd4, 0x6f, 0x99, 0x31, 0x89, 0x59, 0x23, 0x31, 0xca, 0x0a, 0x58,
+	0x44, 0xe2, 0x75, 0x38, 0x7b, 0xc3, 0xb7, 0xe9, 0xe0, 0xe5, 0x76, 0xb3, 0x2f, 0xf4, 0xb2, 0xc7,
+	0xef, 0x03, 0x78, 0xc4, 0xa4, 0x1d, 0x47, 0xe2, 0xbf, 0x4e, 0x05, 0xb1, 0x89, 0x20, 0xdd, 0x1c,
+	0x0b, 0x31, 0xc7, 0x2a, 0x2c, 0x33, 0xfd, 0xb2, 0x51, 0x50, 0xe3, 0x31, 0xdd, 0x33, 0x5b, 0x31,
+	0x7f, 0x31, 0xa1, 0x08, 0x23, 0x12, 0x2d, 0xc2, 0x4a, 0x28, 0xcb, 0x0d, 0xcf, 0xa6, 0x2f, 0x2a,
+	0xe9, 0x95, 0xcc, 0xf4, 0x10, 0x5a, 0x80, 0xb3, 0x9d, 0x50, 0xce, 0x1b, 0xb6, 0x92, 0x62, 0xc9,
+	0x4c, 0x06, 0xf0, 0x7f, 0x00, 0x3c, 0x96, 0xd2, 0x01, 0x53, 0xef, 0xcc, 0xe5, 0x0e, 0xf5, 0x04,
+	0x1f, 0xbc, 0xa0, 0xb3, 0xf0, 0x50, 0xb4, 0x89, 0xdd, 0x72, 0xea, 0xfd, 0x41, 0x2e, 0x31, 0x3d,
+	0x18, 0x2d, 0x31, 0x3d, 0x26, 0x17, 0x12, 0xd1, 0xcf, 0x6c, 0x5c, 0xd2, 0xcb, 0x4c, 0x0f, 0xf5,
+	0x08, 0xaa, 0x94, 0x2f, 0xa8, 0xe9, 0x8c, 0xa0, 0xf0, 0x47, 0x00, 0x1a, 0xa9, 0x85, 0x5e, 0x27,
+	0x9e, 0xb3, 0x4d, 0xb9, 0x18, 0x75, 0xcf, 0xc0, 0x04, 0xf7, 0x6c, 0x09, 0xce, 0x85, 0xab, 0xba,
+	0x25, 0xed, 0x51, 0xfa, 0x1f, 0xa3, 0xb4, 0x58, 0x5c, 0x2a, 0x9a, 0xdd, 0xc3, 0x72, 0xef, 0xa2,
+	0x39, 0xb9, 0x31, 0xad, 0xd4, 0x38, 0x19, 0x90, 0xbf, 0x36, 0x1d, 0x2e, 0x0d, 0x7a, 0xc3, 0x56,
+	0x36, 0x50, 0x34, 0x93, 0x01, 0xfc, 0x20, 0x9c, 0xbd, 0xe2, 0xb8, 0x74, 0xbd, 0xd9, 0xf6, 0x76,
+	0xd0, 0x61, 0x58, 0xb2, 0xe4, 0x83, 0x5a, 0xe1, 0x3e, 0x33, 0x24, 0xf0, 0x0f, 0x00, 0x7c, 0x70,
+	0x90, 0x4c, 0xee, 0x38, 0xa2, 0x29, 0xbf, 0xe7, 0x83, 0x84, 0x63, 0x35, 0xa9, 0xb5, 0xc3, 0xdb,
+	0xad, 0x48, 0xa1, 0x23, 0x7a, 0x3c, 0xe1, 0xe0, 0x5f, 0x01, 0xb8, 0x34, 0x14, 0xd3, 0x1d, 0x46,
+	0x82, 0x80, 0x32, 0x74, 0x05, 0x96, 0xee, 0xca, 0x1f, 0x94, 0xf9, 0x56, 0x56, 0x6b, 0xb5, 0xb4,
+	0xfb, 0x1f, 0xca, 0xe5, 0xda, 0xe7, 0xcc, 0xf0, 0x73, 0x54, 0x8b, 0xc4, 0x53, 0x50, 0x7c, 0xe6,
+	0x33, 0x7c, 0x62, 0x29, 0xca, 0xf7, 0xd5, 0x6b, 0x4f, 0x4f, 0xc3, 0xa9, 0x80, 0x30, 0x81, 0x8f,
+	0xc0, 0xfb, 0xb2, 0xc6, 0x13, 0xf8, 0x1e, 0xa7, 0xf8, 0x0f, 0x59, 0x5d, 0x5b, 0x67, 0x94, 0x08,
+	0x6a, 0xd2, 0xbb, 0x6d, 0xca, 0x05, 0xda, 0x81, 0xe9, 0x88, 0xa4, 0xa4, 0x5a, 0x59, 0xdd, 0xa8,
+	0x25, 0x2e, 0xbd, 0x16, 0xb9, 0x74, 0xf5, 0xf0, 0x2d, 0xcb, 0xae, 0x75, 0x1e, 0xae, 0x05, 0x3b,
+	0x8d, 0x9a, 0x0c, 0x10, 0x19, 0x64, 0x51, 0x80, 0x48, 0x2f, 0xd5, 0x4c, 0x73, 0x47, 0xf3, 0x70,
+	0xba, 0x1d, 0x70, 0xca, 0x84, 0x5a, 0x59, 0xd9, 0xd4, 0x94, 0xdc, 0xbf, 0x0e, 0x71, 0x1d, 0x9b,
+	0x88, 0x70, 0x7f, 0xca, 0x66, 0x4c, 0xe3, 0x3f, 0x66, 0xd1, 0x3f, 0x13, 0xd8, 0x9f, 0x16, 0xfa,
+	0x34, 0xca, 0x42, 0x16, 0x65, 0x5a, 0x83, 0x8a, 0x59, 0x0d, 0xfa, 0x30, 0x8b, 0xff, 0x12, 0x75,
+	0x69, 0x82, 0xbf, 0x9f, 0x32, 0x1b, 0x70, 0xc6, 0x22, 0xdc, 0x22, 0x76, 0x34, 0x4b, 0x44, 0x4a,
+	0x37, 0x17, 0x30, 0x3f, 0x20, 0x0d, 0xc5, 0xe9, 0x96, 0xef, 0x3a, 0xd6, 0xae, 0x9e, 0xae, 0xf7,
+	0x87, 0x1e, 0xc5, 0x9f, 0xca, 0x57, 0xfc, 0x52, 0xd6, 0x2b, 0xd4, 0x20, 0xe2, 0x3b, 0x4e, 0x70,
+	0x8b, 0xd1, 0x10, 0xf1, 0x35, 0xdf, 0xdf, 0xe1, 0xca, 0x8b, 0x95, 0xcd, 0x3e, 0xbf, 0xe0, 0xe3,
+	0xb0, 0xb2, 0xb5, 0xeb, 0x59, 0x37, 0x83, 0xd0, 0x55, 0x1c, 0x86, 0x25, 0x47, 0xd0, 0x16, 0x37,
+	0x80, 0x72, 0x13, 0x21, 0x81, 0xdf, 0x9a, 0x86, 0xf3, 0x29, 0x59, 0xc8, 0x0f, 0xf2, 0x24, 0x91,
+	0xe7, 0xf3, 0xe6, 0xe1, 0xb4, 0xcd, 0x76, 0xcd, 0xb6, 0xa7, 0x15, 0x46, 0x53, 0x72, 0xe2, 0x80,
+	0xb5, 0xbd, 0x70, 0xb9, 0x65, 0x33, 0x24, 0xd0, 0x36, 0x2c, 0x73, 0xc1, 0x88, 0xa0, 0x8d, 0x5d,
+	0xb5, 0xd0, 0xca, 0xea, 0x57, 0xc7, 0x53, 0x12, 0x09, 0x7d, 0x4b, 0x73, 0x34, 0x63, 0xde, 0xe8,
+	0xae, 0xf4, 0x90, 0xa1, 0xdb, 0xe4, 0xc6, 0xcc, 0x62, 0x71, 0xa9, 0xb2, 0xba, 0x35, 0xfe, 0x44,
+	0x37, 0x03, 0xca, 0x32, 0xf1, 0xd0, 0x4c, 0x66, 0x91, 0x6e, 0xb7, 0xa5, 0xfd, 0x09, 0xd7, 0xb9,
+	0x45, 0x32, 0x80, 0xbe, 0x01, 0x4b, 0x8e, 0xb7, 0xed, 0x73, 0x63, 0x56, 0x81, 0x79, 0x7a, 0x3c,
+	0x30, 0x1b, 0xde, 0xb6, 0x6f, 0x86, 0x0c, 0xd1, 0x5d, 0xb8, 0x9f, 0x51, 0xc1, 0x76, 0x23, 0x29,
+	0x18, 0x50, 0xc9, 0xf5, 0x6b, 0xe3, 0xcd, 0x60, 0xa6, 0x59, 0x9a, 0xd9, 0x19, 0xd0, 0x1a, 0xac,
+	0xf0, 0x44, 0xc7, 0x8c, 0x8a, 0x9a, 0xd0, 0xc8, 0x30, 0x4a, 0xe9, 0xa0, 0x99, 0x7e, 0xb9, 0xc7,
+	0x1a, 0xf6, 0xe5, 0x5b, 0xc3, 0xfe, 0xa1, 0x31, 0xf2, 0xc0, 0x08, 0x31, 0x72, 0xae, 0x3b, 0x46,
+	0xaa, 0xb4, 0x42, 0xb0, 0xdd, 0x2b, 0xc4, 0x71, 0xa9, 0x6d, 0x1c, 0x54, 0x3a, 0x9a, 0x1e, 0xc2,
+	0x1f, 0x03, 0xb8, 0xd0, 0xe3, 0xee, 0xb6, 0x02, 0x9a, 0x6b, 0x28, 0x04, 0x4e, 0xf1, 0x80, 0x5a,
+	0x2a, 0xf6, 0x55, 0x56, 0xaf, 0x4f, 0xcc, 0xff, 0xa9, 0x79, 0x15, 0xeb, 0x3c, 0x17, 0x3d, 0x9e,
+	0xa7, 0xc1, 0x3f, 0x07, 0xf0, 0xf3, 0xa9, 0x39, 0x6f, 0x11, 0x61, 0x35, 0xf3, 0x16, 0x2b, 0x2d,
+	0x5c, 0xbe, 0xa3, 0x23, 0x7d, 0x48, 0x48, 0xb9, 0xab, 0x87, 0xdb, 0xbb, 0x81, 0x04, 0x28, 0x7f,
+	0x49, 0x06, 0xc6, 0x4c, 0xd6, 0x7e, 0x0d, 0x60, 0x35, 0x1d, 0x15, 0x7c, 0xd7, 0x7d, 0x9e, 0x58,
+	0x3b, 0x79, 0x20, 0x0f, 0xc0, 0x82, 0x63, 0x2b, 0x84, 0x45, 0xb3, 0xe0, 0xd8, 0x7b, 0x74, 0x57,
+	0xdd, 0x70, 0xa7, 0xf3, 0xe1, 0xce, 0x64, 0xe1, 0x7e, 0xd2, 0x05, 0x37, 0x72, 0x1a, 0x39, 0x70,
+	0x17, 0xe0, 0xac, 0xd7, 0x95, 0x38, 0x27, 0x03, 0x7d, 0x12, 0xe6, 0x42, 0x4f, 0xc2, 0x6c, 0xc0,
+	0x99, 0x4e, 0x7c, 0xac, 0x92, 0x3f, 0x47, 0xa4, 0x5c, 0x62, 0x83, 0xf9, 0xed, 0x40, 0x0b, 0x3d,
+	0x24, 0x24, 0x8a, 0x1d, 0xc7, 0x93, 0x47, 0x00, 0x85, 0x42, 0x3e, 0xef, 0xfd, 0x20, 0x95, 0x59,
+	0xf6, 0x36, 0x3c, 0x76, 0x93, 0x05, 0x4d, 0xe2, 0x51, 0x3b, 0x3e, 0x37, 0xbc, 0x18, 0xf8, 0x4c,
+	0x44, 0x89, 0x90, 0xd4, 0xe1, 0xc8, 0x33, 0xea, 0xd5, 0xc7, 0xb4, 0xc4, 0x13, 0x10, 0x11, 0x9d,
+	0x28, 0xd5, 0x73, 0xa2, 0x69, 0x61, 0x8c, 0x0d, 0x09, 0xfc, 0x9b, 0x02, 0xfc, 0x42, 0x1f, 0xf1,
+	0x0e, 0xd5, 0xdb, 0xcf, 0x86, 0x8c, 0xe3, 0x35, 0xcd, 0x0c, 0xb4, 0x9e, 0xf2, 0x30, 0xeb, 0x99,
+	0xcd, 0xdf, 0x17, 0x98, 0xdd, 0x97, 0x5f, 0x16, 0xe0, 0x62, 0x1f, 0x79, 0x0d, 0x4f, 0x84, 0x3e,
+	0x33, 0x02, 0xdb, 0xf6, 0x99, 0xd6, 0xc6, 0xb2, 0x19, 0x12, 0xd2, 0x9e, 0x7d, 0xa5, 0x6c, 0x4a,
+	0x0b, 0xcb, 0xa6, 0xa6, 0xc6, 0x14, 0xd5, 0x25, 0x68, 0x44, 0xe2, 0xb9, 0x68, 0x85, 0xce, 0x90,
+	0x91, 0x16, 0x15, 0x94, 0xf1, 0x41, 0xae, 0xb0, 0x43, 0xdc, 0x36, 0x8d, 0x5c, 0xa1, 0x22, 0xf0,
+	0xeb, 0x85, 0x6e, 0x36, 0x66, 0xdb, 0xfb, 0xec, 0x0b, 0x7a, 0x1e, 0x4e, 0x13, 0x85, 0x56, 0xab,
+	0xa6, 0xa6, 0x7a, 0x44, 0x5a, 0xce, 0x17, 0xe9, 0x6c, 0x46, 0xa4, 0x6b, 0x05, 0x03, 0xe0, 0x8f,
+	0x0b, 0xb0, 0x3a, 0x48, 0x20, 0xcf, 0xae, 0xfe, 0xbf, 0x89, 0x04, 0x11, 0x68, 0xb0, 0x01, 0x5a,
+	0x66, 0x40, 0x95, 0x26, 0x9e, 0xcc, 0x64, 0x06, 0x83, 0x54, 0xd2, 0x1c, 0xc8, 0x06, 0x7f, 0x0f,
+	0xc0, 0xa3, 0xd9, 0xcf, 0xf8, 0xa6, 0xc3, 0x13, 0x4f, 0xbc, 0x0d, 0x67, 0xc2, 0xa5, 0x84, 0x07,
+	0x84, 0xca, 0xea, 0xe6, 0xb8, 0x69, 0x63, 0x66, 0x77, 0x23, 0xe6, 0xf8, 0x31, 0x78, 0xb4, 0x6f,
+	0x24, 0x1c, 0x1e, 0x10, 0xf0, 0x3b, 0x53, 0xd9, 0xb4, 0xc4, 0xb7, 0x37, 0xfd, 0x46, 0x4e, 0x0d,
+	0x2a, 0x5f, 0x63, 0xe4, 0x6e, 0xf8, 0x76, 0xaa, 0xdc, 0x14, 0x91, 0xf2, 0x3b, 0xcb, 0xf7, 0x04,
+	0x71, 0x3c, 0xca, 0x74, 0xe6, 0x94, 0x0c, 0xc8, 0x9d, 0xe6, 0x8e, 0x67, 0xd1, 0x2d, 0x6a, 0xf9,
+	0x9e, 0xcd, 0x95, 0xca, 0x14, 0xcd, 0xcc, 0x18, 0xba, 0x06, 0x67, 0x15, 0x7d, 0xdb, 0x69, 0x85,
+	0xa9, 0x42, 0x65, 0x75, 0xb9, 0x16, 0xd6, 0x85, 0x6b, 0xe9, 0xba, 0x70, 0x22, 0xc3, 0x16, 0x15,
+	0xa4, 0xd6, 0x39, 0x5f, 0x93, 0x5f, 0x98, 0xc9, 0xc7, 0x12, 0x8b, 0x20, 0x8e, 0xbb, 0xe9, 0x78,
+	0xea, 0xf8, 0x22, 0xa7, 0x4a, 0x06, 0xa4, 0x36, 0x6e, 0xfb, 0xae, 0xeb, 0xbf, 0x10, 0xf9, 0xbc,
+	0x90, 0x92, 0x5f, 0xb5, 0x3d, 0xe1, 0xb8, 0x6a, 0xfe, 0x50, 0xd7, 0x92, 0x01, 0xf5, 0x95, 0xe3,
+	0x0a, 0xca, 0xb4, 0xb3, 0xd3, 0x54, 0xac, 0xef, 0x95, 0x30, 0xe0, 0x46, 0xbe, 0x36, 0xb4, 0x8c,
+	0x7d, 0x69, 0xcb, 0xe8, 0xb6, 0xb6, 0xfd, 0x7d, 0xea, 0x75, 0xaa, 0xf2, 0x4b, 0x3b, 0x8e, 0xdf,
+	0x96, 0x99, 0xb9, 0x4a, 0x4f, 0x23, 0xba, 0xc7, 0x5a, 0xe6, 0xf2, 0xad, 0xe5, 0x60, 0xd6, 0x5a,
+	0xd4, 0xf9, 0x4a, 0x58, 0xcd, 0x75, 0xc2, 0xa9, 0x71, 0x48, 0xb1, 0x4e, 0x06, 0xf0, 0x9f, 0x00,
+	0x2c, 0x6f, 0xfa, 0x8d, 0xcb, 0x9e, 0x60, 0xbb, 0x92, 0x89, 0xdc, 0x39, 0xea, 0x45, 0xda, 0x14,
+	0x91, 0x72, 0x8b, 0x84, 0xd3, 0xa2, 0x5b, 0x82, 0xb4, 0x02, 0x9d, 0xa5, 0xef, 0x69, 0x8b, 0xe2,
+	0x8f, 0xa5, 0xd8, 0x5c, 0xc2, 0x85, 0x72, 0x39, 0x65, 0x53, 0x3d, 0xcb, 0x05, 0xc6, 0x2f, 0x6c,
+	0x09, 0xa6, 0xfd, 0x4d, 0x66, 0x2c, 0xad, 0x80, 0xa5, 0x10, 0x9b, 0x26, 0x71, 0x0b, 0xde, 0x1f,
+	0x1f, 0x30, 0x6f, 0x53, 0xd6, 0x72, 0x3c, 0x92, 0x1f, 0x97, 0x47, 0x28, 0x48, 0xe7, 0xd4, 0x43,
+	0x7e, 0xd2, 0x9d, 0xee, 0xb7, 0xf9, 0xbd, 0x9b, 0x4d, 0x6a, 0x94, 0x52, 0x45, 0x2d, 0x93, 0x90,
+	0x90, 0x3a, 0xc9, 0x28, 0xe1, 0xbe, 0xa7, 0x5d, 0xb0, 0xa6, 0xb0, 0x9b, 0x29, 0xd5, 0x98, 0x94,
+	0xb7, 0x5b, 0xf7, 0x50, 0x12, 0x6f, 0x66, 0xeb, 0x9d, 0x26, 0xe5, 0xc2, 0x67, 0x74, 0xcb, 0x23,
+	0x01, 0x6f, 0xfa, 0xe2, 0xde, 0xc9, 0x24, 0x3c, 0x9b, 0x84, 0xae, 0x26, 0x7b, 0x36, 0x29, 0xa5,
+	0xcf, 0x26, 0xd8, 0xcf, 0x38, 0x4f, 0x79, 0xb2, 0xbe, 0xe3, 0x78, 0xb6, 0xff, 0x42, 0x8e, 0x13,
+	0x1c, 0x4f, 0x20, 0xff, 0xcc, 0x56, 0xff, 0x53, 0x33, 0xc6, 0x1e, 0xfb, 0x1a, 0xdc, 0x2f, 0x7d,
+	0x7b, 0x87, 0xea, 0x1f, 0x74, 0xf8, 0xc0, 0x83, 0x4a, 0xad, 0x09, 0x0f, 0x33, 0xfb, 0x21, 0xda,
+	0x84, 0x73, 0x84, 0x73, 0xa7, 0xe1, 0x51, 0x3b, 0xe2, 0x55, 0x18, 0x99, 0x57, 0xf7, 0xa7, 0x61,
+	0xd1, 0x4e, 0xbd, 0xa1, 0x2d, 0x33, 0x22, 0xf1, 0xbb, 0x00, 0x1e, 0xe9, 0xcb, 0x24, 0xf6, 0x80,
+	0x20, 0x15, 0xf1, 0x65, 0xef, 0xc9, 0x6a, 0x52, 0xbb, 0xed, 0x46, 0x49, 0x5d, 0x4c, 0xcb, 0xdf,
+	0xec, 0x76, 0x68, 0xa7, 0x3a, 0xe3, 0x88, 0x69, 0x74, 0x0c, 0xc2, 0x16, 0xf1, 0xda, 0xc4, 0x55,
+	0x10, 0xa6, 0x14, 0x84, 0xd4, 0x88, 0x2c, 0x3c, 0xd8, 0x94, 0x5b, 0xcc, 0x51, 0xe5, 0x10, 0xad,
+	0xf6, 0xe9, 0x21, 0xbc, 0x00, 0xab, 0xfd, 0xdc, 0x80, 0xae, 0x21, 0x7f, 0x04, 0xe0, 0x81, 0x28,
+	0x7c, 0xea, 0xfd, 0x5f, 0x82, 0x73, 0x29, 0x41, 0xdd, 0x48, 0x54, 0xa1, 0x7b, 0x78, 0x48, 0x68,
+	0x8c, 0xf4, 0xa8, 0x98, 0x6d, 0xf1, 0x75, 0x32, 0x4d, 0xba, 0x91, 0x93, 0x27, 0x30, 0xa1, 0xd3,
+	0xe4, 0x77, 0xa1, 0x71, 0x9d, 0x78, 0xa4, 0x91, 0x1c, 0x26, 0x13, 0x25, 0xfc, 0x76, 0xba, 0xb8,
+	0x39, 0x76, 0x29, 0x31, 0x3e, 0x10, 0x39, 0xdb, 0xdb, 0x51, 0xa1, 0xf4, 0x55, 0x00, 0x17, 0xe2,
+	0x71, 0xe6, 0x6c, 0x8b, 0x6b, 0x61, 0x23, 0x25, 0x86, 0xd0, 0xcc, 0x42, 0x30, 0x27, 0x04, 0x41,
+	0x4e, 0x75, 0x39, 0x70, 0xb8, 0x6f, 0xd3, 0x08, 0x0a, 0x83, 0xe5, 0x4d, 0xc7, 0xdb, 0x91, 0xa5,
+	0x3f, 0x29, 0x7c, 0xe1, 0x08, 0x37, 0xda, 0xe8, 0x90, 0x40, 0x07, 0x61, 0xb1, 0xcd, 0x5c, 0xad,
+	0xae, 0xf2, 0xb1, 0x5b, 0xdb, 0x8a, 0x3d, 0xda, 0x26, 0x55, 0xc2, 0xb1, 0x7c, 0x6f, 0xdd, 0x25,
+	0x9c, 0x47, 0x59, 0x4f, 0x3c, 0x80, 0x9f, 0x80, 0xfb, 0xe5, 0x9c, 0x89, 0xc4, 0xcf, 0x64, 0x97,
+	0x7b, 0x24, 0xb3, 0x8c, 0x08, 0x5e, 0x84, 0x98, 0xc0, 0xfb, 0x64, 0xb2, 0x79, 0x31, 0x08, 0x34,
+	0x93, 0x11, 0x4f, 0x3e, 0xc5, 0x7e, 0x49, 0x5b, 0xdf, 0xb6, 0xd0, 0xea, 0x7b, 0x2b, 0x10, 0xa5,
+	0x8d, 0x9a, 0xb2, 0x8e, 0x63, 0x51, 0xf4, 0x43, 0x00, 0xa7, 0xe4, 0xd4, 0xe8, 0x81, 0x41, 0x3e,
+	0x44, 0x99, 0x4e, 0x75, 0x72, 0x15, 0x3a, 0x39, 0x1b, 0x5e, 0x78, 0xe5, 0x5f, 0x1f, 0xfe, 0xa8,
+	0x30, 0x8f, 0x0e, 0xab, 0xab, 0x02, 0x9d, 0xf3, 0xe9, 0xb6, 0x3d, 0x47, 0xaf, 0x01, 0x88, 0x74,
+	0xf2, 0x9d, 0x6a, 0xa6, 0xa2, 0x33, 0x83, 0x20, 0xf6, 0x69, 0xba, 0x56, 0x1f, 0x48, 0x25, 0x2b,
+	0x35, 0xcb, 0x67, 0x54, 0xa6, 0x26, 0xea, 0x05, 0x05, 0x60, 0x59, 0x01, 0x38, 0x81, 0x70, 0x3f,
+	0x00, 0xf5, 0x97, 0xa4, 0x44, 0x5f, 0xae, 0xd3, 0x70, 0xde, 0xb7, 0x01, 0x2c, 0xdd, 0x51, 0x45,
+	0x87, 0x21, 0x42, 0xda, 0x9a, 0x98, 0x90, 0xd4, 0x74, 0x0a, 0x2d, 0x3e, 0xae, 0x90, 0x3e, 0x80,
+	0x8e, 0x46, 0x48, 0xb9, 0x60, 0x94, 0xb4, 0x32, 0x80, 0xcf, 0x01, 0xf4, 0x2e, 0x80, 0xd3, 0x61,
+	0x9f, 0x0c, 0x9d, 0x1c, 0x84, 0x32, 0xd3, 0x47, 0xab, 0x4e, 0xae, 0xe9, 0x84, 0x4f, 0x2b, 0x8c,
+	0xc7, 0x71, 0xdf, 0xed, 0x5c, 0xcb, 0xb4, 0xa4, 0xde, 0x00, 0xb0, 0x78, 0x95, 0x0e, 0xd5, 0xb7,
+	0x09, 0x82, 0xeb, 0x11, 0x60, 0x9f, 0xad, 0x46, 0xef, 0x00, 0x78, 0xff, 0x55, 0x2a, 0xfa, 0xc7,
+	0x72, 0xb4, 0x34, 0x3c, 0xc0, 0x6a, 0xb5, 0x3b, 0x33, 0xc2, 0x9b, 0x71, 0x88, 0xaa, 0x2b, 0x64,
+	0xa7, 0xd1, 0xa9, 0x3c, 0x25, 0x94, 0x2d, 0x81, 0x17, 0x34, 0x8e, 0xbf, 0x01, 0x78, 0xb0, 0xfb,
+	0xd2, 0x04, 0xc2, 0x5d, 0x47, 0xdf, 0x3e, 0x77, 0x2a, 0xaa, 0x37, 0xc6, 0xf5, 0xb6, 0x59, 0xa6,
+	0xf8, 0xa2, 0x42, 0xfe, 0x38, 0x7a, 0x2c, 0x0f, 0x79, 0xdc, 0x44, 0xa8, 0xbf, 0x14, 0x3d, 0xbe,
+	0x5c, 0x6f, 0x69, 0x16, 0xe8, 0xef, 0x00, 0x1e, 0x8e, 0xf8, 0xae, 0x37, 0x09, 0x13, 0x97, 0xa8,
+	0x20, 0x8e, 0xcb, 0x47, 0x5a, 0xcf, 0x98, 0x01, 0x2c, 0x3d, 0x1f, 0xbe, 0xac, 0xd6, 0xf2, 0x14,
+	0x7a, 0x72, 0xcf, 0x6b, 0xb1, 0x24, 0x1b, 0x5b, 0xc3, 0x7e, 0x1f, 0xc0, 0x03, 0x57, 0xa9, 0xb8,
+	0xb9, 0xbe, 0xb1, 0xa7, 0x9d, 0x19, 0x53, 0xd1, 0x53, 0xd3, 0xe1, 0x4b, 0x6a, 0x21, 0x5f, 0x46,
+	0x4f, 0xec, 0x79, 0x21, 0xbe, 0xe5, 0xc4, 0xfb, 0xf2, 0x0a, 0x80, 0xfb, 0xae, 0x52, 0x71, 0x3d,
+	0x6e, 0xc8, 0x9d, 0x1c, 0xe9, 0x52, 0x40, 0x75, 0xa1, 0x96, 0xba, 0x1f, 0x15, 0xfd, 0x14, 0xab,
+	0xfa, 0x8a, 0xc2, 0x76, 0x0a, 0x9d, 0xcc, 0xc3, 0x96, 0x34, 0x01, 0xdf, 0x06, 0xf0, 0x48, 0x1a,
+	0x44, 0x72, 0x99, 0xe2, 0x91, 0xbd, 0x5d, 0x51, 0xd0, 0x17, 0x1d, 0x86, 0xa0, 0x5b, 0x55, 0xe8,
+	0xce, 0xe2, 0xfe, 0x86, 0xd8, 0xea, 0x41, 0xb1, 0x06, 0x96, 0x97, 0x00, 0xfa, 0x33, 0x80, 0xd3,
+	0x61, 0xb7, 0x6b, 0xb0, 0x8c, 0x32, 0xcd, 0xff, 0x49, 0x7a, 0x35, 0xad, 0xb5, 0xd5, 0x73, 0xfd,
+	0x05, 0x9a, 0xfe, 0x3e, 0xda, 0xda, 0x9a, 0x92, 0x72, 0xd6, 0x1d, 0xff, 0x0e, 0x40, 0x98, 0x74,
+	0xec, 0xd0, 0xe9, 0xfc, 0x75, 0xa4, 0xba, 0x7a, 0xd5, 0xc9, 0xf6, 0xec, 0x70, 0x4d, 0xad, 0x67,
+	0xa9, 0xba, 0x98, 0xeb, 0x0b, 0x03, 0x6a, 0xad, 0x85, 0xdd, 0xbd, 0xb7, 0x00, 0x2c, 0xa9, 0x06,
+	0x06, 0x3a, 0x31, 0x08, 0x73, 0xba, 0xbf, 0x31, 0x49, 0xd1, 0x3f, 0xa4, 0xa0, 0x2e, 0xae, 0xe6,
+	0x05, 0x94, 0x35, 0xb0, 0x8c, 0x3a, 0x70, 0x3a, 0x6c, 0x19, 0x0c, 0x56, 0x8f, 0x4c, 0x4b, 0xa1,
+	0xba, 0x98, 0x93, 0xe0, 0x84, 0x8a, 0xaa, 0x63, 0xd9, 0xf2, 0xb0, 0x58, 0x36, 0xa5, 0x8e, 0x50,
+	0xc7, 0xf3, 0x82, 0xd1, 0x3d, 0x10, 0xcc, 0x19, 0x85, 0xee, 0x24, 0x5e, 0x1c, 0x16, 0xcf, 0xa4,
+	0x74, 0x7e, 0x0c, 0xe0, 0xc1, 0xee, 0xf3, 0x0a, 0x3a, 0xda, 0xb7, 0x8c, 0xab, 0x63, 0x6b, 0x56,
+	0x8a, 0x83, 0xce, 0x3a, 0xf8, 0x2b, 0x0a, 0xc5, 0x1a, 0x7a, 0x74, 0xa8, 0x65, 0xdc, 0x88, 0xbc,
+	0x8e, 0x64, 0xb4, 0x92, 0x5c, 0x50, 0xf8, 0xa9, 0x0a, 0x4d, 0xbd, 0x67, 0x99, 0x7c, 0x78, 0xa7,
+	0xfb, 0xfe, 0xd8, 0xef, 0x2c, 0x84, 0x9f, 0x50, 0x10, 0xbf, 0x88, 0x2e, 0x8c, 0x08, 0xd1, 0x96,
+	0x4c, 0x56, 0xf4, 0xd5, 0x34, 0xf4, 0x7b, 0x00, 0xf7, 0x45, 0xec, 0x6f, 0x33, 0x4a, 0xf3, 0x61,
+	0x4d, 0xce, 0x4e, 0xe5, 0x5c, 0x7b, 0x86, 0x1e, 0x49, 0x75, 0x45, 0x48, 0xa4, 0x7f, 0x01, 0xf0,
+	0xd0, 0x9d, 0xd0, 0x2c, 0x3f, 0x25, 0xfc, 0xeb, 0x0a, 0xff, 0x93, 0xe8, 0xf1, 0x9c, 0x74, 0x7a,
+	0xd8, 0x32, 0xce, 0x01, 0xf4, 0x5b, 0x00, 0xcb, 0x51, 0x57, 0x1d, 0x9d, 0x1a, 0x68, 0xb7, 0xd9,
+	0xbe, 0xfb, 0x24, 0x6d, 0x4d, 0xe7, 0x8e, 0xf8, 0x44, 0x6e, 0xb0, 0xd7, 0xf3, 0x4b, 0x7b, 0x7b,
+	0x03, 0x40, 0x14, 0x57, 0x49, 0xe2, 0xba, 0x09, 0x7a, 0x28, 0x33, 0xd5, 0xc0, 0xb2, 0x6a, 0xf5,
+	0xd4, 0xd0, 0xf7, 0xb2, 0x91, 0x7e, 0x39, 0x37, 0xd2, 0xfb, 0xf1, 0xfc, 0xbf, 0x50, 0x7e, 0xbc,
+	0xcd, 0x69, 0x9e, 0x1f, 0x4f, 0x0a, 0xae, 0x93, 0x14, 0xe1, 0x59, 0x85, 0xf4, 0xa1, 0x35, 0xb0,
+	0x8c, 0x1f, 0xcc, 0x03, 0x1b, 0x28, 0x78, 0xef, 0x01, 0x38, 0x1d, 0xd6, 0x57, 0x07, 0xbb, 0xf3,
+	0x4c, 0xfd, 0x75, 0x92, 0x50, 0xb5, 0x50, 0x31, 0xce, 0x4f, 0xed, 0xe4, 0xec, 0x72, 0xaf, 0xdf,
+	0x07, 0x70, 0xae, 0xab, 0x38, 0x8b, 0x6a, 0x39, 0xa0, 0xfb, 0x54, 0x71, 0x27, 0x89, 0xfe, 0x4b,
+	0x0a, 0xfd, 0x79, 0x7c, 0x76, 0x08, 0x7a, 0x09, 0x63, 0x85, 0x6b, 0x1c, 0x72, 0x1d, 0xaf, 0x03,
+	0x58, 0xb9, 0x4a, 0xe3, 0x3a, 0x40, 0x8e, 0xa1, 0x65, 0x6f, 0x8c, 0x54, 0x97, 0x86, 0xbf, 0xa8,
+	0xd5, 0x55, 0x2b, 0x01, 0x3a, 0x31, 0x04, 0x5b, 0x08, 0xe0, 0x5d, 0x00, 0xe7, 0xc3, 0x2b, 0x1a,
+	0xdd, 0x17, 0x37, 0x46, 0xc7, 0x96, 0x3d, 0x22, 0xe6, 0x5f, 0x00, 0xc1, 0x8f, 0x28, 0x78, 0x75,
+	0xb4, 0x92, 0x6b, 0x4d, 0x9a, 0x47, 0x1c, 0xc2, 0x64, 0x04, 0xdb, 0x7f, 0x2b, 0xed, 0x67, 0xd1,
+	0xd9, 0x61, 0xf0, 0x32, 0xd9, 0xd2, 0xe8, 0xf2, 0x7b, 0x58, 0x01, 0x5c, 0x59, 0xd3, 0x57, 0x4d,
+	0x46, 0x13, 0xe3, 0xcf, 0x40, 0x58, 0xf0, 0xea, 0x6a, 0xb8, 0xfe, 0xaf, 0xfb, 0x9b, 0xd3, 0xb7,
+	0xc5, 0x17, 0x14, 0xbe, 0x1a, 0x3a, 0x3b, 0x0a, 0xb0, 0xba, 0xee, 0xc2, 0xa2, 0x37, 0x01, 0x3c,
+	0xa4, 0x3a, 0xee, 0x69, 0xc6, 0x28, 0xaf, 0xc9, 0x9c, 0xf4, 0xe7, 0x47, 0x48, 0xe3, 0x9e, 0x0a,
+	0x83, 0x28, 0xde, 0x13, 0xa8, 0x35, 0xdd, 0x4b, 0x7f, 0xb5, 0x00, 0xe4, 0xfe, 0xde, 0xd7, 0x83,
+	0xef, 0xd9, 0xd5, 0x2e, 0x01, 0x0e, 0xbe, 0x41, 0x30, 0x02, 0xc6, 0x35, 0x85, 0xf1, 0x82, 0xf4,
+	0x8e, 0xf5, 0xbd, 0xc0, 0xac, 0x77, 0x56, 0xd1, 0xf7, 0x01, 0x3c, 0x10, 0xa5, 0xb6, 0x7a, 0xcb,
+	0x57, 0x86, 0x6d, 0xed, 0x5e, 0x53, 0x61, 0x6d, 0xb8, 0xcb, 0x23, 0x1b, 0xee, 0x8c, 0x6e, 0x88,
+	0xe7, 0x04, 0x9a, 0x54, 0xc7, 0xbc, 0xda, 0x55, 0xb1, 0xd5, 0x1d, 0x53, 0xfc, 0x4d, 0x35, 0xed,
+	0x33, 0x28, 0x57, 0x26, 0x81, 0x6f, 0xf3, 0xfa, 0x4b, 0xba, 0x5d, 0xf9, 0x72, 0xdd, 0xf5, 0x1b,
+	0xfc, 0x39, 0x8c, 0x72, 0xd3, 0x62, 0xf9, 0xce, 0x39, 0x80, 0x04, 0x9c, 0x95, 0xea, 0xab, 0xca,
+	0xc0, 0x28, 0x2b, 0x84, 0x3e, 0x15, 0xe2, 0x6a, 0xb5, 0xa7, 0xac, 0x9c, 0xe4, 0xc1, 0xba, 0x28,
+	0x87, 0x72, 0x63, 0x9b, 0xab, 0x26, 0x7a, 0x0d, 0xc0, 0x43, 0x69, 0x7b, 0x0c, 0xa7, 0x1f, 0xd9,
+	0x1a, 0xf3, 0x50, 0xe8, 0xa3, 0x35, 0x5a, 0x1e, 0x49, 0x87, 0x14, 0x9c, 0xa7, 0xaf, 0xfc, 0xf5,
+	0x83, 0x63, 0xe0, 0x1f, 0x1f, 0x1c, 0x03, 0xff, 0xfe, 0xe0, 0x18, 0x78, 0xee, 0xd1, 0xd1, 0xfe,
+	0xfa, 0x65, 0xb9, 0x0e, 0xf5, 0x44, 0x9a, 0xfd, 0x7f, 0x07, 0x00, 0x71, 0xf5, 0x94, 0x54, 0xe0,
+	0x36, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3260,6 +3659,8 @@ type ApplicationServiceClient interface {
 	Sync(ctx context.Context, in *ApplicationSyncRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
 	// ManagedResources returns list of managed resources
 	ManagedResources(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*ManagedResourcesResponse, error)
+	// ResourceDriftHistory returns the drift episodes recorded for the application resources
+	ResourceDriftHistory(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*ResourceDriftHistoryResponse, error)
 	// ResourceTree returns resource tree
 	ResourceTree(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationTree, error)
 	// Watch returns stream of application resource tree
@@ -3268,8 +3669,16 @@ type ApplicationServiceClient interface {
 	Rollback(ctx context.Context, in *ApplicationRollbackRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
 	// TerminateOperation terminates the currently running operation
 	TerminateOperation(ctx context.Context, in *OperationTerminateRequest, opts ...grpc.CallOption) (*OperationTerminateResponse, error)
+	// Pause suspends the automated sync and self-heal of an application until the given time
+	Pause(ctx context.Context, in *ApplicationPauseRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
+	// Resume resumes the automated sync of a paused application
+	Resume(ctx context.Context, in *ApplicationResumeRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
+	// RestoreSnapshot syncs the live state of the resources captured by a snapshot taken before a previous sync operation
+	RestoreSnapshot(ctx context.Context, in *ApplicationRestoreSnapshotRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
 	// GetResource returns single application resource
 	GetResource(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ApplicationResourceResponse, error)
+	// ExportOrphanedResource returns the manifest of an orphaned resource to be adopted by the application
+	ExportOrphanedResource(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*OrphanedResourceExportResponse, error)
 	// PatchResource patch single application resource
 	PatchResource(ctx context.Context, in *ApplicationResourcePatchRequest, opts ...grpc.CallOption) (*ApplicationResourceResponse, error)
 	// ListResourceActions returns list of resource actions
@@ -3500,6 +3909,15 @@ func (c *applicationServiceClient) ManagedResources(ctx context.Context, in *Res
 	return out, nil
 }
 
+func (c *applicationServiceClient) ResourceDriftHistory(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*ResourceDriftHistoryResponse, error) {
+	out := new(ResourceDriftHistoryResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/ResourceDriftHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationServiceClient) ResourceTree(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationTree, error) {
 	out := new(v1alpha1.ApplicationTree)
 	err := c.cc.Invoke(ctx, "/application.ApplicationService/ResourceTree", in, out, opts...)
@@ -3559,6 +3977,33 @@ func (c *applicationServiceClient) TerminateOperation(ctx context.Context, in *O
 	return out, nil
 }
 
+func (c *applicationServiceClient) Pause(ctx context.Context, in *ApplicationPauseRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	out := new(v1alpha1.Application)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/Pause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) Resume(ctx context.Context, in *ApplicationResumeRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	out := new(v1alpha1.Application)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/Resume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) RestoreSnapshot(ctx context.Context, in *ApplicationRestoreSnapshotRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	out := new(v1alpha1.Application)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/RestoreSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationServiceClient) GetResource(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ApplicationResourceResponse, error) {
 	out := new(ApplicationResourceResponse)
 	err := c.cc.Invoke(ctx, "/application.ApplicationService/GetResource", in, out, opts...)
@@ -3568,6 +4013,15 @@ func (c *applicationServiceClient) GetResource(ctx context.Context, in *Applicat
 	return out, nil
 }
 
+func (c *applicationServiceClient) ExportOrphanedResource(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*OrphanedResourceExportResponse, error) {
+	out := new(OrphanedResourceExportResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/ExportOrphanedResource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationServiceClient) PatchResource(ctx context.Context, in *ApplicationResourcePatchRequest, opts ...grpc.CallOption) (*ApplicationResourceResponse, error) {
 	out := new(ApplicationResourceResponse)
 	err := c.cc.Invoke(ctx, "/application.ApplicationService/PatchResource", in, out, opts...)
@@ -3700,6 +4154,8 @@ type ApplicationServiceServer interface {
 	Sync(context.Context, *ApplicationSyncRequest) (*v1alpha1.Application, error)
 	// ManagedResources returns list of managed resources
 	ManagedResources(context.Context, *ResourcesQuery) (*ManagedResourcesResponse, error)
+	// ResourceDriftHistory returns the drift episodes recorded for the application resources
+	ResourceDriftHistory(context.Context, *ResourcesQuery) (*ResourceDriftHistoryResponse, error)
 	// ResourceTree returns resource tree
 	ResourceTree(context.Context, *ResourcesQuery) (*v1alpha1.ApplicationTree, error)
 	// Watch returns stream of application resource tree
@@ -3708,8 +4164,16 @@ type ApplicationServiceServer interface {
 	Rollback(context.Context, *ApplicationRollbackRequest) (*v1alpha1.Application, error)
 	// TerminateOperation terminates the currently running operation
 	TerminateOperation(context.Context, *OperationTerminateRequest) (*OperationTerminateResponse, error)
+	// Pause suspends the automated sync and self-heal of an application until the given time
+	Pause(context.Context, *ApplicationPauseRequest) (*v1alpha1.Application, error)
+	// Resume resumes the automated sync of a paused application
+	Resume(context.Context, *ApplicationResumeRequest) (*v1alpha1.Application, error)
+	// RestoreSnapshot syncs the live state of the resources captured by a snapshot taken before a previous sync operation
+	RestoreSnapshot(context.Context, *ApplicationRestoreSnapshotRequest) (*v1alpha1.Application, error)
 	// GetResource returns single application resource
 	GetResource(context.Context, *ApplicationResourceRequest) (*ApplicationResourceResponse, error)
+	// ExportOrphanedResource returns the manifest of an orphaned resource to be adopted by the application
+	ExportOrphanedResource(context.Context, *ApplicationResourceRequest) (*OrphanedResourceExportResponse, error)
 	// PatchResource patch single application resource
 	PatchResource(context.Context, *ApplicationResourcePatchRequest) (*ApplicationResourceResponse, error)
 	// ListResourceActions returns list of resource actions
@@ -3786,6 +4250,9 @@ func (*UnimplementedApplicationServiceServer) Sync(ctx context.Context, req *App
 func (*UnimplementedApplicationServiceServer) ManagedResources(ctx context.Context, req *ResourcesQuery) (*ManagedResourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ManagedResources not implemented")
 }
+func (*UnimplementedApplicationServiceServer) ResourceDriftHistory(ctx context.Context, req *ResourcesQuery) (*ResourceDriftHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceDriftHistory not implemented")
+}
 func (*UnimplementedApplicationServiceServer) ResourceTree(ctx context.Context, req *ResourcesQuery) (*v1alpha1.ApplicationTree, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResourceTree not implemented")
 }
@@ -3798,9 +4265,21 @@ func (*UnimplementedApplicationServiceServer) Rollback(ctx context.Context, req
 func (*UnimplementedApplicationServiceServer) TerminateOperation(ctx context.Context, req *OperationTerminateRequest) (*OperationTerminateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TerminateOperation not implemented")
 }
+func (*UnimplementedApplicationServiceServer) Pause(ctx context.Context, req *ApplicationPauseRequest) (*v1alpha1.Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (*UnimplementedApplicationServiceServer) Resume(ctx context.Context, req *ApplicationResumeRequest) (*v1alpha1.Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resume not implemented")
+}
+func (*UnimplementedApplicationServiceServer) RestoreSnapshot(ctx context.Context, req *ApplicationRestoreSnapshotRequest) (*v1alpha1.Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSnapshot not implemented")
+}
 func (*UnimplementedApplicationServiceServer) GetResource(ctx context.Context, req *ApplicationResourceRequest) (*ApplicationResourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetResource not implemented")
 }
+func (*UnimplementedApplicationServiceServer) ExportOrphanedResource(ctx context.Context, req *ApplicationResourceRequest) (*OrphanedResourceExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportOrphanedResource not implemented")
+}
 func (*UnimplementedApplicationServiceServer) PatchResource(ctx context.Context, req *ApplicationResourcePatchRequest) (*ApplicationResourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PatchResource not implemented")
 }
@@ -4147,6 +4626,24 @@ func _ApplicationService_ManagedResources_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationService_ResourceDriftHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourcesQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ResourceDriftHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/ResourceDriftHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ResourceDriftHistory(ctx, req.(*ResourcesQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationService_ResourceTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResourcesQuery)
 	if err := dec(in); err != nil {
@@ -4222,6 +4719,60 @@ func _ApplicationService_TerminateOperation_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationService_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/Pause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).Pause(ctx, req.(*ApplicationPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_Resume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).Resume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/Resume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).Resume(ctx, req.(*ApplicationResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_RestoreSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationRestoreSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).RestoreSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/RestoreSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).RestoreSnapshot(ctx, req.(*ApplicationRestoreSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationService_GetResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApplicationResourceRequest)
 	if err := dec(in); err != nil {
@@ -4240,6 +4791,24 @@ func _ApplicationService_GetResource_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationService_ExportOrphanedResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ExportOrphanedResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/ExportOrphanedResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ExportOrphanedResource(ctx, req.(*ApplicationResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationService_PatchResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApplicationResourcePatchRequest)
 	if err := dec(in); err != nil {
@@ -4451,6 +5020,10 @@ var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ManagedResources",
 			Handler:    _ApplicationService_ManagedResources_Handler,
 		},
+		{
+			MethodName: "ResourceDriftHistory",
+			Handler:    _ApplicationService_ResourceDriftHistory_Handler,
+		},
 		{
 			MethodName: "ResourceTree",
 			Handler:    _ApplicationService_ResourceTree_Handler,
@@ -4463,10 +5036,26 @@ var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TerminateOperation",
 			Handler:    _ApplicationService_TerminateOperation_Handler,
 		},
+		{
+			MethodName: "Pause",
+			Handler:    _ApplicationService_Pause_Handler,
+		},
+		{
+			MethodName: "Resume",
+			Handler:    _ApplicationService_Resume_Handler,
+		},
+		{
+			MethodName: "RestoreSnapshot",
+			Handler:    _ApplicationService_RestoreSnapshot_Handler,
+		},
 		{
 			MethodName: "GetResource",
 			Handler:    _ApplicationService_GetResource_Handler,
 		},
+		{
+			MethodName: "ExportOrphanedResource",
+			Handler:    _ApplicationService_ExportOrphanedResource_Handler,
+		},
 		{
 			MethodName: "PatchResource",
 			Handler:    _ApplicationService_PatchResource_Handler,
@@ -4813,6 +5402,11 @@ func (m *ApplicationManifestQuery) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.HistoryId != nil {
+		i = encodeVarintApplication(dAtA, i, uint64(*m.HistoryId))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.Revisions) > 0 {
 		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Revisions[iNdEx])
@@ -5205,6 +5799,16 @@ func (m *ApplicationDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.SkipPreDeleteHooks != nil {
+		i--
+		if *m.SkipPreDeleteHooks {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Project != nil {
 		i -= len(*m.Project)
 		copy(dAtA[i:], *m.Project)
@@ -5308,6 +5912,18 @@ func (m *ApplicationSyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.RetryFailed != nil {
+		i--
+		if *m.RetryFailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if len(m.Revisions) > 0 {
 		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Revisions[iNdEx])
@@ -5760,6 +6376,56 @@ func (m *ApplicationResourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *OrphanedResourceExportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrphanedResourceExportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrphanedResourceExportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Patch != nil {
+		i -= len(*m.Patch)
+		copy(dAtA[i:], *m.Patch)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Patch)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Path != nil {
+		i -= len(*m.Path)
+		copy(dAtA[i:], *m.Path)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Path)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Manifest == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("manifest")
+	} else {
+		i -= len(*m.Manifest)
+		copy(dAtA[i:], *m.Manifest)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Manifest)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationResourcePatchRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6608,7 +7274,7 @@ func (m *OperationTerminateRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationSyncWindowsQuery) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationPauseRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6618,12 +7284,12 @@ func (m *ApplicationSyncWindowsQuery) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationSyncWindowsQuery) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationPauseRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationSyncWindowsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationPauseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6632,6 +7298,22 @@ func (m *ApplicationSyncWindowsQuery) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Reason != nil {
+		i -= len(*m.Reason)
+		copy(dAtA[i:], *m.Reason)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Until == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("until")
+	} else {
+		i -= len(*m.Until)
+		copy(dAtA[i:], *m.Until)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Until)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Project != nil {
 		i -= len(*m.Project)
 		copy(dAtA[i:], *m.Project)
@@ -6658,7 +7340,7 @@ func (m *ApplicationSyncWindowsQuery) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationSyncWindowsResponse) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationResumeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6668,12 +7350,12 @@ func (m *ApplicationSyncWindowsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationSyncWindowsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationResumeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationSyncWindowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationResumeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6682,26 +7364,193 @@ func (m *ApplicationSyncWindowsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CanSync == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("canSync")
-	} else {
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
 		i--
-		if *m.CanSync {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x1a
+	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x12
 	}
-	if len(m.AssignedWindows) > 0 {
-		for iNdEx := len(m.AssignedWindows) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AssignedWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationRestoreSnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationRestoreSnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationRestoreSnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DryRun != nil {
+		i--
+		if *m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Id != nil {
+		i -= len(*m.Id)
+		copy(dAtA[i:], *m.Id)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Id)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationSyncWindowsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSyncWindowsQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSyncWindowsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationSyncWindowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSyncWindowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSyncWindowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CanSync == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("canSync")
+	} else {
+		i--
+		if *m.CanSync {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AssignedWindows) > 0 {
+		for iNdEx := len(m.AssignedWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssignedWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
 				i = encodeVarintApplication(dAtA, i, uint64(size))
 			}
 			i--
@@ -6749,6 +7598,13 @@ func (m *ApplicationSyncWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Description != nil {
+		i -= len(*m.Description)
+		copy(dAtA[i:], *m.Description)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Description)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ManualSync == nil {
 		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("manualSync")
 	} else {
@@ -6944,6 +7800,47 @@ func (m *ManagedResourcesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *ResourceDriftHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceDriftHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceDriftHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LinkInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7276,6 +8173,9 @@ func (m *ApplicationManifestQuery) Size() (n int) {
 			n += 1 + l + sovApplication(uint64(l))
 		}
 	}
+	if m.HistoryId != nil {
+		n += 1 + sovApplication(uint64(*m.HistoryId))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7447,6 +8347,9 @@ func (m *ApplicationDeleteRequest) Size() (n int) {
 		l = len(*m.Project)
 		n += 1 + l + sovApplication(uint64(l))
 	}
+	if m.SkipPreDeleteHooks != nil {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7540,6 +8443,9 @@ func (m *ApplicationSyncRequest) Size() (n int) {
 			n += 1 + l + sovApplication(uint64(l))
 		}
 	}
+	if m.RetryFailed != nil {
+		n += 3
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7686,6 +8592,30 @@ func (m *ApplicationResourceRequest) Size() (n int) {
 	return n
 }
 
+func (m *OrphanedResourceExportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Manifest != nil {
+		l = len(*m.Manifest)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Path != nil {
+		l = len(*m.Path)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Patch != nil {
+		l = len(*m.Patch)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ApplicationResourcePatchRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8074,7 +9004,7 @@ func (m *OperationTerminateRequest) Size() (n int) {
 	return n
 }
 
-func (m *ApplicationSyncWindowsQuery) Size() (n int) {
+func (m *ApplicationPauseRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -8092,28 +9022,115 @@ func (m *ApplicationSyncWindowsQuery) Size() (n int) {
 		l = len(*m.Project)
 		n += 1 + l + sovApplication(uint64(l))
 	}
+	if m.Until != nil {
+		l = len(*m.Until)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Reason != nil {
+		l = len(*m.Reason)
+		n += 1 + l + sovApplication(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ApplicationSyncWindowsResponse) Size() (n int) {
+func (m *ApplicationResumeRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ActiveWindows) > 0 {
-		for _, e := range m.ActiveWindows {
-			l = e.Size()
-			n += 1 + l + sovApplication(uint64(l))
-		}
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
 	}
-	if len(m.AssignedWindows) > 0 {
-		for _, e := range m.AssignedWindows {
-			l = e.Size()
-			n += 1 + l + sovApplication(uint64(l))
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationRestoreSnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Id != nil {
+		l = len(*m.Id)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.DryRun != nil {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSyncWindowsQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSyncWindowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ActiveWindows) > 0 {
+		for _, e := range m.ActiveWindows {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if len(m.AssignedWindows) > 0 {
+		for _, e := range m.AssignedWindows {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
 		}
 	}
 	if m.CanSync != nil {
@@ -8146,6 +9163,10 @@ func (m *ApplicationSyncWindow) Size() (n int) {
 	if m.ManualSync != nil {
 		n += 2
 	}
+	if m.Description != nil {
+		l = len(*m.Description)
+		n += 1 + l + sovApplication(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -8226,6 +9247,24 @@ func (m *ManagedResourcesResponse) Size() (n int) {
 	return n
 }
 
+func (m *ResourceDriftHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *LinkInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9489,6 +10528,26 @@ func (m *ApplicationManifestQuery) Unmarshal(dAtA []byte) error {
 			}
 			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryId", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HistoryId = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -10432,6 +11491,27 @@ func (m *ApplicationDeleteRequest) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.Project = &s
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipPreDeleteHooks", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.SkipPreDeleteHooks = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -11061,6 +12141,27 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryFailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.RetryFailed = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -12084,7 +13185,7 @@ func (m *ApplicationResourceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
+func (m *OrphanedResourceExportResponse) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -12108,15 +13209,15 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationResourcePatchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrphanedResourceExportResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationResourcePatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrphanedResourceExportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12145,12 +13246,12 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.Manifest = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12179,11 +13280,11 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Namespace = &s
+			m.Path = &s
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12212,12 +13313,66 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.ResourceName = &s
+			m.Patch = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("manifest")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationResourcePatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationResourcePatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12246,12 +13401,12 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Version = &s
+			m.Name = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000004)
-		case 5:
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12280,11 +13435,11 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Group = &s
+			m.Namespace = &s
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12313,12 +13468,12 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Kind = &s
+			m.ResourceName = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000008)
-		case 7:
+			hasFields[0] |= uint64(0x00000002)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12347,7 +13502,108 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Patch = &s
+			m.Version = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Group = &s
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Kind = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000008)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Patch = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000010)
 		case 8:
@@ -14877,7 +16133,7 @@ func (m *OperationTerminateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSyncWindowsQuery) Unmarshal(dAtA []byte) error {
+func (m *ApplicationPauseRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -14901,10 +16157,10 @@ func (m *ApplicationSyncWindowsQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSyncWindowsQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationPauseRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSyncWindowsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationPauseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15007,6 +16263,73 @@ func (m *ApplicationSyncWindowsQuery) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.Project = &s
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Until = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Reason = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -15026,13 +16349,16 @@ func (m *ApplicationSyncWindowsQuery) Unmarshal(dAtA []byte) error {
 	if hasFields[0]&uint64(0x00000001) == 0 {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("until")
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *ApplicationSyncWindowsResponse) Unmarshal(dAtA []byte) error {
+func (m *ApplicationResumeRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -15056,17 +16382,17 @@ func (m *ApplicationSyncWindowsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSyncWindowsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationResumeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSyncWindowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationResumeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveWindows", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -15076,31 +16402,31 @@ func (m *ApplicationSyncWindowsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActiveWindows = append(m.ActiveWindows, &ApplicationSyncWindow{})
-			if err := m.ActiveWindows[len(m.ActiveWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
 			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssignedWindows", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -15110,31 +16436,30 @@ func (m *ApplicationSyncWindowsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssignedWindows = append(m.AssignedWindows, &ApplicationSyncWindow{})
-			if err := m.AssignedWindows[len(m.AssignedWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanSync", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -15144,14 +16469,25 @@ func (m *ApplicationSyncWindowsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.CanSync = &b
-			hasFields[0] |= uint64(0x00000001)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -15169,7 +16505,7 @@ func (m *ApplicationSyncWindowsResponse) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("canSync")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	}
 
 	if iNdEx > l {
@@ -15177,7 +16513,7 @@ func (m *ApplicationSyncWindowsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
+func (m *ApplicationRestoreSnapshotRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -15201,15 +16537,15 @@ func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSyncWindow: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationRestoreSnapshotRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSyncWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationRestoreSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15238,12 +16574,12 @@ func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Kind = &s
+			m.Name = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15272,12 +16608,11 @@ func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Schedule = &s
+			m.AppNamespace = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15306,12 +16641,522 @@ func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Duration = &s
+			m.Project = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000004)
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManualSync", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Id = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.DryRun = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSyncWindowsQuery) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSyncWindowsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSyncWindowsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSyncWindowsResponse) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSyncWindowsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSyncWindowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveWindows = append(m.ActiveWindows, &ApplicationSyncWindow{})
+			if err := m.ActiveWindows[len(m.ActiveWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignedWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssignedWindows = append(m.AssignedWindows, &ApplicationSyncWindow{})
+			if err := m.AssignedWindows[len(m.AssignedWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanSync", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.CanSync = &b
+			hasFields[0] |= uint64(0x00000001)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("canSync")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSyncWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSyncWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Kind = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Schedule = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Duration = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManualSync", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -15331,6 +17176,39 @@ func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
 			b := bool(v != 0)
 			m.ManualSync = &b
 			hasFields[0] |= uint64(0x00000008)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Description = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -15821,6 +17699,91 @@ func (m *ManagedResourcesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ResourceDriftHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceDriftHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceDriftHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &v1alpha1.ResourceDriftEpisode{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *LinkInfo) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
@@ -425,6 +425,95 @@ func (m *EmptyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EmptyResponse proto.InternalMessageInfo
 
+// ProjectFreezeRequest is a request to block the syncs of a project until a given time
+type ProjectFreezeRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Until is the RFC3339 time until which the syncs are blocked
+	Until                string   `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Applications         []string `protobuf:"bytes,4,rep,name=applications,proto3" json:"applications,omitempty"`
+	Namespaces           []string `protobuf:"bytes,5,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	Clusters             []string `protobuf:"bytes,6,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProjectFreezeRequest) Reset()         { *m = ProjectFreezeRequest{} }
+func (m *ProjectFreezeRequest) String() string { return proto.CompactTextString(m) }
+func (*ProjectFreezeRequest) ProtoMessage()    {}
+func (*ProjectFreezeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f0a51496972c9e2, []int{7}
+}
+func (m *ProjectFreezeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectFreezeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectFreezeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProjectFreezeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectFreezeRequest.Merge(m, src)
+}
+func (m *ProjectFreezeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectFreezeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectFreezeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectFreezeRequest proto.InternalMessageInfo
+
+func (m *ProjectFreezeRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProjectFreezeRequest) GetUntil() string {
+	if m != nil {
+		return m.Until
+	}
+	return ""
+}
+
+func (m *ProjectFreezeRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ProjectFreezeRequest) GetApplications() []string {
+	if m != nil {
+		return m.Applications
+	}
+	return nil
+}
+
+func (m *ProjectFreezeRequest) GetNamespaces() []string {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+func (m *ProjectFreezeRequest) GetClusters() []string {
+	if m != nil {
+		return m.Clusters
+	}
+	return nil
+}
+
 type SyncWindowsQuery struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -436,7 +525,7 @@ func (m *SyncWindowsQuery) Reset()         { *m = SyncWindowsQuery{} }
 func (m *SyncWindowsQuery) String() string { return proto.CompactTextString(m) }
 func (*SyncWindowsQuery) ProtoMessage()    {}
 func (*SyncWindowsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{7}
+	return fileDescriptor_5f0a51496972c9e2, []int{8}
 }
 func (m *SyncWindowsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -483,7 +572,7 @@ func (m *SyncWindowsResponse) Reset()         { *m = SyncWindowsResponse{} }
 func (m *SyncWindowsResponse) String() string { return proto.CompactTextString(m) }
 func (*SyncWindowsResponse) ProtoMessage()    {}
 func (*SyncWindowsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{8}
+	return fileDescriptor_5f0a51496972c9e2, []int{9}
 }
 func (m *SyncWindowsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -530,7 +619,7 @@ func (m *GlobalProjectsResponse) Reset()         { *m = GlobalProjectsResponse{}
 func (m *GlobalProjectsResponse) String() string { return proto.CompactTextString(m) }
 func (*GlobalProjectsResponse) ProtoMessage()    {}
 func (*GlobalProjectsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{9}
+	return fileDescriptor_5f0a51496972c9e2, []int{10}
 }
 func (m *GlobalProjectsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -580,7 +669,7 @@ func (m *DetailedProjectsResponse) Reset()         { *m = DetailedProjectsRespon
 func (m *DetailedProjectsResponse) String() string { return proto.CompactTextString(m) }
 func (*DetailedProjectsResponse) ProtoMessage()    {}
 func (*DetailedProjectsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{10}
+	return fileDescriptor_5f0a51496972c9e2, []int{11}
 }
 func (m *DetailedProjectsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -648,7 +737,7 @@ func (m *ListProjectLinksRequest) Reset()         { *m = ListProjectLinksRequest
 func (m *ListProjectLinksRequest) String() string { return proto.CompactTextString(m) }
 func (*ListProjectLinksRequest) ProtoMessage()    {}
 func (*ListProjectLinksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{11}
+	return fileDescriptor_5f0a51496972c9e2, []int{12}
 }
 func (m *ListProjectLinksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -692,6 +781,7 @@ func init() {
 	proto.RegisterType((*ProjectQuery)(nil), "project.ProjectQuery")
 	proto.RegisterType((*ProjectUpdateRequest)(nil), "project.ProjectUpdateRequest")
 	proto.RegisterType((*EmptyResponse)(nil), "project.EmptyResponse")
+	proto.RegisterType((*ProjectFreezeRequest)(nil), "project.ProjectFreezeRequest")
 	proto.RegisterType((*SyncWindowsQuery)(nil), "project.SyncWindowsQuery")
 	proto.RegisterType((*SyncWindowsResponse)(nil), "project.SyncWindowsResponse")
 	proto.RegisterType((*GlobalProjectsResponse)(nil), "project.GlobalProjectsResponse")
@@ -702,70 +792,76 @@ func init() {
 func init() { proto.RegisterFile("server/project/project.proto", fileDescriptor_5f0a51496972c9e2) }
 
 var fileDescriptor_5f0a51496972c9e2 = []byte{
-	// 1007 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x96, 0x93, 0x36, 0xbb, 0x7d, 0x2d, 0xa5, 0xcc, 0xee, 0x76, 0xdd, 0xd0, 0x1f, 0x61, 0xd0,
-	0x56, 0x51, 0xa1, 0xb6, 0xda, 0x80, 0xb4, 0x82, 0x13, 0xdb, 0xad, 0x02, 0x52, 0x0f, 0xe0, 0x82,
-	0x40, 0x1c, 0x40, 0x8e, 0xfd, 0x94, 0x9d, 0x8d, 0x63, 0x1b, 0xcf, 0x24, 0xdb, 0x10, 0xf5, 0x82,
-	0x04, 0x48, 0x1c, 0x38, 0xc0, 0x89, 0x0b, 0x47, 0xfe, 0x0f, 0x6e, 0x1c, 0x91, 0xf8, 0x07, 0x50,
-	0xc5, 0x1f, 0x82, 0x66, 0x3c, 0x76, 0xe2, 0xa4, 0xe6, 0x87, 0x1a, 0x38, 0x65, 0x3c, 0x7e, 0xfe,
-	0xbe, 0xef, 0xbd, 0x79, 0xf3, 0xcd, 0x04, 0xb6, 0x39, 0x26, 0x43, 0x4c, 0xec, 0x38, 0x89, 0x9e,
-	0xa2, 0x27, 0xb2, 0x5f, 0x2b, 0x4e, 0x22, 0x11, 0x91, 0x5b, 0xfa, 0xb1, 0xbe, 0xdd, 0x8d, 0xa2,
-	0x6e, 0x80, 0xb6, 0x1b, 0x33, 0xdb, 0x0d, 0xc3, 0x48, 0xb8, 0x82, 0x45, 0x21, 0x4f, 0xc3, 0xea,
-	0xb4, 0xf7, 0x90, 0x5b, 0x2c, 0x52, 0x6f, 0xbd, 0x28, 0x41, 0x7b, 0x78, 0x64, 0x77, 0x31, 0xc4,
-	0xc4, 0x15, 0xe8, 0xeb, 0x98, 0xb3, 0x2e, 0x13, 0x4f, 0x06, 0x1d, 0xcb, 0x8b, 0xfa, 0xb6, 0x9b,
-	0x74, 0x23, 0x89, 0xac, 0x06, 0x87, 0x9e, 0x6f, 0x0f, 0x5b, 0x76, 0xdc, 0xeb, 0xca, 0xef, 0xb9,
-	0xed, 0xc6, 0x71, 0xc0, 0x3c, 0x85, 0x6f, 0x0f, 0x8f, 0xdc, 0x20, 0x7e, 0xe2, 0xce, 0xa3, 0x9d,
-	0xfc, 0x0d, 0x9a, 0xce, 0x6a, 0x1a, 0x6b, 0x6a, 0x9c, 0x82, 0xd0, 0xef, 0x0c, 0xb8, 0xfb, 0x6e,
-	0x9a, 0xe0, 0x49, 0x82, 0xae, 0x40, 0x07, 0x3f, 0x1b, 0x20, 0x17, 0xa4, 0x03, 0x59, 0xe2, 0xa6,
-	0xd1, 0x30, 0x9a, 0xab, 0xc7, 0x6f, 0x5b, 0x13, 0x3e, 0x2b, 0xe3, 0x53, 0x83, 0x4f, 0x3d, 0xdf,
-	0x1a, 0xb6, 0xac, 0xb8, 0xd7, 0xb5, 0xa4, 0x7a, 0x6b, 0x9a, 0x25, 0x53, 0x6f, 0xbd, 0x15, 0xc7,
-	0x9a, 0xc7, 0xc9, 0x80, 0xc9, 0x26, 0xd4, 0x06, 0x31, 0xc7, 0x44, 0x98, 0x95, 0x86, 0xd1, 0xbc,
-	0xed, 0xe8, 0x27, 0xda, 0x83, 0x2d, 0x1d, 0xfb, 0x7e, 0xd4, 0xc3, 0xf0, 0x31, 0x06, 0x38, 0x11,
-	0x66, 0x16, 0x85, 0xad, 0x4c, 0xe0, 0x08, 0x2c, 0x25, 0x51, 0x80, 0x0a, 0x6c, 0xc5, 0x51, 0x63,
-	0xb2, 0x01, 0x55, 0xe6, 0x0a, 0xb3, 0xda, 0x30, 0x9a, 0x55, 0x47, 0x0e, 0xc9, 0x3a, 0x54, 0x98,
-	0x6f, 0x2e, 0xa9, 0x98, 0x0a, 0xf3, 0xe9, 0x0f, 0x46, 0x91, 0xad, 0x58, 0x86, 0x72, 0xb6, 0x06,
-	0xac, 0xfa, 0xc8, 0xbd, 0x84, 0xc5, 0x32, 0x51, 0x4d, 0x3a, 0x3d, 0x95, 0xeb, 0xa9, 0x4e, 0xe9,
-	0xd9, 0x86, 0x15, 0xbc, 0x88, 0x59, 0x82, 0xfc, 0x9d, 0x50, 0x89, 0xa8, 0x3a, 0x93, 0x09, 0xad,
-	0x6d, 0x39, 0xd7, 0xf6, 0x6a, 0xbe, 0x38, 0x4a, 0x9a, 0x83, 0x3c, 0x8e, 0x42, 0x8e, 0xe4, 0x2e,
-	0x2c, 0x0b, 0x39, 0xa1, 0x35, 0xa5, 0x0f, 0x94, 0xc2, 0x9a, 0x8e, 0x7e, 0x6f, 0x80, 0xc9, 0x48,
-	0xf2, 0x87, 0x6e, 0x1f, 0x75, 0x90, 0x1a, 0xd3, 0xcf, 0x73, 0xc4, 0x0f, 0x62, 0xff, 0xff, 0x5d,
-	0x6e, 0xfa, 0x3c, 0x3c, 0x77, 0xda, 0x8f, 0xc5, 0x28, 0x4b, 0x83, 0xee, 0xc3, 0xc6, 0xf9, 0x28,
-	0xf4, 0x3e, 0x64, 0xa1, 0x1f, 0x3d, 0xe3, 0xe5, 0xa2, 0x47, 0x70, 0x67, 0x2a, 0x2e, 0xaf, 0x42,
-	0x07, 0x6e, 0x3d, 0x4b, 0xa7, 0x4c, 0xa3, 0x51, 0xbd, 0xb9, 0xe6, 0x09, 0x87, 0x93, 0x01, 0xd3,
-	0x0b, 0xd8, 0x6c, 0x07, 0x51, 0xc7, 0x0d, 0x74, 0x36, 0x13, 0xf6, 0x4f, 0x60, 0x99, 0x09, 0xec,
-	0x2f, 0x88, 0x7b, 0xaa, 0x5e, 0x29, 0x2c, 0xfd, 0xb9, 0x0a, 0xe6, 0x63, 0x14, 0x2e, 0x0b, 0xd0,
-	0x9f, 0x23, 0x8f, 0x61, 0xbd, 0x5b, 0x90, 0xb5, 0x70, 0x15, 0x33, 0xf8, 0xd3, 0x0d, 0x52, 0xf9,
-	0xaf, 0xfc, 0x20, 0x80, 0xb5, 0x04, 0xe3, 0x88, 0x33, 0x11, 0x25, 0x0c, 0xb9, 0x59, 0x5d, 0x44,
-	0x4e, 0x4e, 0x86, 0x38, 0x72, 0x0a, 0xe8, 0xc4, 0x85, 0xdb, 0x5e, 0x30, 0xe0, 0x02, 0x13, 0x6e,
-	0x2e, 0x29, 0xa6, 0xd3, 0x9b, 0x31, 0x9d, 0xa4, 0x68, 0x4e, 0x0e, 0x4b, 0x0f, 0xe1, 0xfe, 0x19,
-	0xe3, 0x42, 0x27, 0x7a, 0xc6, 0xc2, 0x1e, 0xcf, 0x36, 0xdc, 0x35, 0x7d, 0x7e, 0xfc, 0xe3, 0x1a,
-	0xac, 0xeb, 0xd8, 0x73, 0x4c, 0x86, 0xcc, 0x43, 0xf2, 0x8d, 0x01, 0xab, 0xa9, 0x23, 0x29, 0x07,
-	0x20, 0xd4, 0xca, 0x4e, 0xa7, 0x52, 0xcf, 0xaa, 0xef, 0x5c, 0x1b, 0x93, 0xef, 0xba, 0x87, 0x5f,
-	0xfc, 0xf6, 0xc7, 0xf7, 0x95, 0x63, 0x7a, 0xa8, 0xce, 0xaa, 0xe1, 0x51, 0x76, 0xde, 0x71, 0x7b,
-	0xac, 0x47, 0x97, 0xb6, 0xf4, 0x2a, 0x6e, 0x8f, 0xe5, 0xcf, 0xa5, 0xad, 0xdc, 0xe5, 0x0d, 0xe3,
-	0x80, 0x7c, 0x65, 0xc0, 0x6a, 0x6a, 0xc6, 0x7f, 0x25, 0xa6, 0x60, 0xd7, 0xf5, 0xcd, 0x3c, 0xa6,
-	0xb8, 0xf7, 0xdf, 0x54, 0x2a, 0x5e, 0x3f, 0x68, 0xfd, 0x2b, 0x15, 0xf6, 0x98, 0xb9, 0xe2, 0x92,
-	0x7c, 0x6b, 0x40, 0x2d, 0xcd, 0x99, 0xcc, 0x25, 0x5b, 0xac, 0xc5, 0xc2, 0xba, 0x94, 0xbe, 0xa8,
-	0x04, 0xdf, 0xa3, 0x1b, 0xb3, 0x82, 0x65, 0x65, 0xbe, 0x34, 0x60, 0x49, 0xae, 0x34, 0xb9, 0x37,
-	0x2b, 0x47, 0xb9, 0x5a, 0xfd, 0x6c, 0x51, 0x32, 0x24, 0x09, 0x35, 0x95, 0x14, 0x42, 0xe6, 0xa4,
-	0x90, 0x0b, 0x20, 0x6d, 0x14, 0x33, 0xb6, 0x51, 0x26, 0xea, 0xa5, 0x7c, 0xba, 0xcc, 0x67, 0x68,
-	0x53, 0x31, 0x51, 0xd2, 0x98, 0x5f, 0x25, 0xd9, 0xb1, 0x97, 0xb6, 0xaf, 0xbf, 0x24, 0x5f, 0x1b,
-	0x50, 0x6d, 0x63, 0x29, 0xd7, 0xe2, 0xd6, 0x61, 0x4f, 0x49, 0xda, 0x22, 0xf7, 0x4b, 0x24, 0x91,
-	0x31, 0xbc, 0xd0, 0x46, 0x51, 0x74, 0xed, 0x32, 0x59, 0x7b, 0xf9, 0xf4, 0xf5, 0x2e, 0x4f, 0x2d,
-	0xc5, 0xd6, 0x24, 0xfb, 0x65, 0x05, 0x48, 0x6d, 0x32, 0x5f, 0x80, 0x9f, 0x0c, 0xa8, 0xa5, 0x27,
-	0xeb, 0x7c, 0x67, 0x16, 0x4e, 0xdc, 0x05, 0x56, 0xa4, 0xa5, 0x34, 0x1e, 0xd6, 0x9b, 0xa5, 0x5b,
-	0xc9, 0xea, 0xa3, 0x70, 0x7d, 0x57, 0xb8, 0x96, 0x12, 0x2d, 0x3b, 0xf6, 0x23, 0xa8, 0xa5, 0x1b,
-	0xb5, 0xac, 0x34, 0x65, 0x1b, 0x57, 0xd7, 0xff, 0xa0, 0xb4, 0xfe, 0x4f, 0x01, 0x64, 0x97, 0x9e,
-	0x0e, 0x31, 0x2c, 0x2f, 0xfc, 0x8e, 0x95, 0xde, 0x97, 0x65, 0x86, 0x96, 0xbc, 0x2f, 0x5b, 0xc3,
-	0x23, 0x4b, 0x7d, 0xa2, 0x3a, 0x7c, 0x5f, 0x91, 0x34, 0xc8, 0x6e, 0x59, 0xd9, 0x31, 0x45, 0x1f,
-	0xc3, 0x9d, 0x36, 0x8a, 0xa9, 0xcb, 0xc1, 0xb9, 0x90, 0xa5, 0xdf, 0xca, 0x49, 0x67, 0xef, 0x17,
-	0xf5, 0xed, 0xeb, 0x5e, 0xe5, 0xc9, 0xbd, 0xa2, 0x78, 0x1f, 0x90, 0x97, 0xcb, 0x78, 0xf9, 0x28,
-	0xf4, 0xf4, 0xdd, 0x80, 0xc4, 0xb0, 0x22, 0xc5, 0x2a, 0x5b, 0x27, 0x8d, 0x1c, 0xb7, 0xc4, 0xf1,
-	0xeb, 0xf5, 0xc2, 0x42, 0xea, 0x57, 0x9a, 0xf7, 0x81, 0xe2, 0xdd, 0x23, 0x3b, 0x65, 0xbc, 0x81,
-	0x0c, 0x7f, 0xf4, 0xe8, 0x97, 0xab, 0x5d, 0xe3, 0xd7, 0xab, 0x5d, 0xe3, 0xf7, 0xab, 0x5d, 0xe3,
-	0xe3, 0xd7, 0xfe, 0xd9, 0xdf, 0x09, 0x2f, 0x60, 0x18, 0xe6, 0xff, 0x6a, 0x3a, 0x35, 0x75, 0xf1,
-	0x6f, 0xfd, 0x19, 0x00, 0x00, 0xff, 0xff, 0xae, 0xc9, 0xe8, 0xa8, 0xf6, 0x0c, 0x00, 0x00,
+	// 1100 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x97, 0xb3, 0xc9, 0xb6, 0x79, 0x69, 0x43, 0x98, 0xa6, 0xa9, 0xb3, 0xe4, 0xcf, 0x32, 0x55,
+	0xa3, 0x25, 0x10, 0x5b, 0x49, 0x40, 0xaa, 0xca, 0x89, 0xa6, 0x21, 0x20, 0xe5, 0x00, 0x0e, 0x08,
+	0xc4, 0x01, 0xe4, 0xd8, 0x8f, 0xed, 0x74, 0x1d, 0xdb, 0xcc, 0xcc, 0x6e, 0xb3, 0x8d, 0x72, 0x41,
+	0x02, 0x24, 0x0e, 0x1c, 0xe0, 0x84, 0xc4, 0x99, 0xcf, 0xc0, 0x85, 0x03, 0x37, 0x8e, 0x48, 0x7c,
+	0x01, 0x14, 0xf1, 0x41, 0xd0, 0x8c, 0x67, 0xbd, 0xf6, 0x6e, 0x5c, 0x40, 0x5d, 0x38, 0x79, 0x66,
+	0xfc, 0xfc, 0x7e, 0xbf, 0xf7, 0x7b, 0x6f, 0xde, 0x8c, 0x61, 0x45, 0x20, 0xef, 0x21, 0x77, 0x53,
+	0x9e, 0x3c, 0xc2, 0x40, 0x0e, 0x9e, 0x4e, 0xca, 0x13, 0x99, 0x90, 0x2b, 0x66, 0xda, 0x58, 0x69,
+	0x27, 0x49, 0x3b, 0x42, 0xd7, 0x4f, 0x99, 0xeb, 0xc7, 0x71, 0x22, 0x7d, 0xc9, 0x92, 0x58, 0x64,
+	0x66, 0x0d, 0xda, 0xb9, 0x2b, 0x1c, 0x96, 0xe8, 0xb7, 0x41, 0xc2, 0xd1, 0xed, 0x6d, 0xbb, 0x6d,
+	0x8c, 0x91, 0xfb, 0x12, 0x43, 0x63, 0x73, 0xd8, 0x66, 0xf2, 0x61, 0xf7, 0xd8, 0x09, 0x92, 0x13,
+	0xd7, 0xe7, 0xed, 0x44, 0x79, 0xd6, 0x83, 0xad, 0x20, 0x74, 0x7b, 0xbb, 0x6e, 0xda, 0x69, 0xab,
+	0xef, 0x85, 0xeb, 0xa7, 0x69, 0xc4, 0x02, 0xed, 0xdf, 0xed, 0x6d, 0xfb, 0x51, 0xfa, 0xd0, 0x1f,
+	0xf7, 0xb6, 0xf7, 0x37, 0xde, 0x4c, 0x54, 0x45, 0x5f, 0x85, 0x71, 0xe6, 0x84, 0x7e, 0x6b, 0xc1,
+	0xe2, 0x3b, 0x59, 0x80, 0x7b, 0x1c, 0x7d, 0x89, 0x1e, 0x7e, 0xd6, 0x45, 0x21, 0xc9, 0x31, 0x0c,
+	0x02, 0xb7, 0xad, 0xa6, 0xd5, 0x9a, 0xdb, 0x79, 0xcb, 0x19, 0xe2, 0x39, 0x03, 0x3c, 0x3d, 0xf8,
+	0x24, 0x08, 0x9d, 0xde, 0xae, 0x93, 0x76, 0xda, 0x8e, 0x62, 0xef, 0x14, 0x51, 0x06, 0xec, 0x9d,
+	0x37, 0xd2, 0xd4, 0xe0, 0x78, 0x03, 0xc7, 0x64, 0x09, 0xea, 0xdd, 0x54, 0x20, 0x97, 0xf6, 0x54,
+	0xd3, 0x6a, 0x5d, 0xf5, 0xcc, 0x8c, 0x76, 0x60, 0xd9, 0xd8, 0xbe, 0x97, 0x74, 0x30, 0x7e, 0x80,
+	0x11, 0x0e, 0x89, 0xd9, 0x65, 0x62, 0xb3, 0x43, 0x77, 0x04, 0xa6, 0x79, 0x12, 0xa1, 0x76, 0x36,
+	0xeb, 0xe9, 0x31, 0x59, 0x80, 0x1a, 0xf3, 0xa5, 0x5d, 0x6b, 0x5a, 0xad, 0x9a, 0xa7, 0x86, 0x64,
+	0x1e, 0xa6, 0x58, 0x68, 0x4f, 0x6b, 0x9b, 0x29, 0x16, 0xd2, 0xef, 0xad, 0x32, 0x5a, 0x59, 0x86,
+	0x6a, 0xb4, 0x26, 0xcc, 0x85, 0x28, 0x02, 0xce, 0x52, 0x15, 0xa8, 0x01, 0x2d, 0x2e, 0xe5, 0x7c,
+	0x6a, 0x05, 0x3e, 0x2b, 0x30, 0x8b, 0xa7, 0x29, 0xe3, 0x28, 0xde, 0x8e, 0x35, 0x89, 0x9a, 0x37,
+	0x5c, 0x30, 0xdc, 0x66, 0x72, 0x6e, 0xaf, 0xc0, 0x62, 0x91, 0x9a, 0x87, 0x22, 0x4d, 0x62, 0x81,
+	0x64, 0x11, 0x66, 0xa4, 0x5a, 0x30, 0x9c, 0xb2, 0x09, 0xa5, 0x70, 0xcd, 0x58, 0xbf, 0xdb, 0x45,
+	0xde, 0x57, 0xf8, 0xb1, 0x7f, 0x82, 0xc6, 0x48, 0x8f, 0xe9, 0x93, 0xdc, 0xe3, 0xfb, 0x69, 0xf8,
+	0xff, 0xa6, 0x9b, 0x3e, 0x07, 0xd7, 0xf7, 0x4f, 0x52, 0xd9, 0x1f, 0x84, 0x41, 0x7f, 0x1a, 0x16,
+	0xdf, 0x9b, 0x1c, 0xf1, 0x49, 0xce, 0xe6, 0x12, 0xe6, 0x2a, 0xe6, 0x6e, 0x2c, 0x59, 0x64, 0x94,
+	0xce, 0x26, 0xaa, 0x84, 0x38, 0xfa, 0x22, 0x89, 0x8d, 0xca, 0x66, 0x46, 0x28, 0x5c, 0x2b, 0xf0,
+	0x12, 0xf6, 0x74, 0xb3, 0xd6, 0x9a, 0xf5, 0x4a, 0x6b, 0x64, 0x0d, 0x40, 0x79, 0x16, 0xa9, 0x1f,
+	0xa0, 0xb0, 0x67, 0xb4, 0x45, 0x61, 0x85, 0x34, 0xe0, 0x6a, 0x10, 0x75, 0x85, 0x44, 0x2e, 0xec,
+	0xba, 0x7e, 0x9b, 0xcf, 0xe9, 0x06, 0x2c, 0x1c, 0xf5, 0xe3, 0xe0, 0x03, 0x16, 0x87, 0xc9, 0x63,
+	0x51, 0xad, 0x77, 0x1f, 0x6e, 0x14, 0xec, 0xf2, 0x04, 0x1e, 0xc3, 0x95, 0xc7, 0xd9, 0x92, 0x6d,
+	0x35, 0x6b, 0xcf, 0x2e, 0xf7, 0x10, 0xc3, 0x1b, 0x38, 0xa6, 0xa7, 0xb0, 0x74, 0x10, 0x25, 0xc7,
+	0x7e, 0x64, 0x24, 0x1e, 0xa2, 0x7f, 0x0c, 0x33, 0x4c, 0xe2, 0xc9, 0x84, 0xb0, 0x0b, 0xa9, 0xce,
+	0xdc, 0xd2, 0x5f, 0x6a, 0x60, 0x3f, 0x40, 0xe9, 0xb3, 0x08, 0xc3, 0x31, 0xf0, 0x14, 0xe6, 0xdb,
+	0x25, 0x5a, 0x13, 0x67, 0x31, 0xe2, 0xbf, 0x58, 0xdb, 0x53, 0xff, 0x55, 0x2b, 0x8b, 0xe0, 0x1a,
+	0xc7, 0x34, 0x11, 0x4c, 0x26, 0x9c, 0xa1, 0xb0, 0x6b, 0x93, 0x88, 0xc9, 0x1b, 0x78, 0xec, 0x7b,
+	0x25, 0xef, 0xc4, 0x2f, 0x54, 0xe6, 0xb4, 0x46, 0xda, 0x7f, 0x36, 0xa4, 0xbd, 0xcc, 0x5b, 0xa1,
+	0xc0, 0xb7, 0xe0, 0xd6, 0x21, 0x13, 0xd2, 0x04, 0x7a, 0xc8, 0xe2, 0x8e, 0x78, 0xca, 0xee, 0xdc,
+	0xf9, 0xf9, 0x3a, 0xcc, 0x1b, 0xdb, 0x23, 0xe4, 0x3d, 0x16, 0x20, 0xf9, 0xda, 0x82, 0xb9, 0xac,
+	0x99, 0xea, 0xe6, 0x45, 0xa8, 0x33, 0x38, 0x58, 0x2b, 0xdb, 0x6d, 0x63, 0xf5, 0x52, 0x9b, 0xbc,
+	0x61, 0xdc, 0xfd, 0xfc, 0xf7, 0x3f, 0xbf, 0x9b, 0xda, 0xa1, 0x5b, 0xfa, 0x98, 0xed, 0x6d, 0x0f,
+	0x8e, 0x6a, 0xe1, 0x9e, 0x99, 0xd1, 0xb9, 0xab, 0xda, 0xac, 0x70, 0xcf, 0xd4, 0xe3, 0xdc, 0xd5,
+	0x8d, 0xf1, 0x9e, 0xb5, 0x49, 0xbe, 0xb4, 0x60, 0x2e, 0x3b, 0x47, 0x9e, 0x46, 0xa6, 0x74, 0xd2,
+	0x34, 0x96, 0x72, 0x9b, 0x72, 0xdb, 0x7a, 0x5d, 0xb3, 0x78, 0x6d, 0x73, 0xf7, 0x5f, 0xb1, 0x70,
+	0xcf, 0x98, 0x2f, 0xcf, 0xc9, 0x37, 0x16, 0xd4, 0xb3, 0x98, 0xc9, 0x58, 0xb0, 0x65, 0x2d, 0x26,
+	0x56, 0xa5, 0xf4, 0x05, 0x4d, 0xf8, 0xe6, 0x3d, 0x6b, 0x93, 0x2e, 0x8c, 0x72, 0x26, 0x5f, 0x58,
+	0x30, 0xad, 0x32, 0x4d, 0x6e, 0x8e, 0xd2, 0xd1, 0x5d, 0xad, 0x71, 0x38, 0x29, 0x1a, 0x0a, 0x84,
+	0xda, 0x9a, 0x0a, 0x21, 0xe3, 0x3c, 0x4e, 0x81, 0x1c, 0xa0, 0x1c, 0x69, 0x1b, 0x55, 0xa4, 0x5e,
+	0xcc, 0x97, 0xab, 0xfa, 0x0c, 0x6d, 0x69, 0x24, 0x4a, 0x9a, 0xe3, 0x59, 0x52, 0x15, 0x7b, 0xee,
+	0x86, 0xe6, 0x4b, 0xf2, 0x95, 0x05, 0xb5, 0x03, 0xac, 0xc4, 0x9a, 0x5c, 0x1e, 0xd6, 0x35, 0xa5,
+	0x65, 0x72, 0xab, 0x82, 0x12, 0x39, 0x83, 0xe7, 0x0f, 0x50, 0x96, 0xbb, 0x76, 0x15, 0xad, 0xf5,
+	0x7c, 0xf9, 0xf2, 0x2e, 0x4f, 0x1d, 0x8d, 0xd6, 0x22, 0x1b, 0x55, 0x02, 0x64, 0x6d, 0x32, 0x4f,
+	0xc0, 0x8f, 0x16, 0xd4, 0xb3, 0x4b, 0xc1, 0x78, 0x65, 0x96, 0x2e, 0x0b, 0x13, 0x54, 0x64, 0x57,
+	0x73, 0xdc, 0x6a, 0xb4, 0x2a, 0xb7, 0x92, 0x73, 0x82, 0xd2, 0x0f, 0x7d, 0xe9, 0x3b, 0x9a, 0xb4,
+	0xda, 0xcb, 0x1f, 0x42, 0x3d, 0xdb, 0xa8, 0x55, 0xd2, 0x54, 0x6d, 0x5c, 0xa3, 0xff, 0x66, 0xa5,
+	0xfe, 0x8f, 0x00, 0x54, 0x95, 0xee, 0xf7, 0x30, 0xae, 0x16, 0x7e, 0xd5, 0xc9, 0xae, 0xfa, 0x2a,
+	0x42, 0x27, 0x48, 0x38, 0x3a, 0xbd, 0x6d, 0x47, 0x7f, 0xa2, 0x2b, 0x7c, 0x43, 0x83, 0x34, 0xc9,
+	0x5a, 0x95, 0xec, 0x98, 0x79, 0x3f, 0x83, 0x1b, 0x07, 0x28, 0x0b, 0x97, 0x83, 0x23, 0xa9, 0xa4,
+	0x5f, 0xce, 0x41, 0x47, 0xef, 0x17, 0x8d, 0x95, 0xcb, 0x5e, 0xe5, 0xc1, 0xbd, 0xac, 0x71, 0xef,
+	0x90, 0xdb, 0x55, 0xb8, 0xa2, 0x1f, 0x07, 0xe6, 0x6e, 0x40, 0x7e, 0xb0, 0xa0, 0x9e, 0x5d, 0xb9,
+	0xc6, 0x73, 0x5d, 0xba, 0x8a, 0x4d, 0x30, 0xd7, 0x2f, 0x69, 0x82, 0xb7, 0x55, 0x17, 0xaa, 0xd4,
+	0xe6, 0xd3, 0x8c, 0x53, 0x0a, 0xb3, 0x4a, 0x4b, 0x7d, 0xea, 0x90, 0x66, 0x4e, 0xb0, 0xe2, 0x40,
+	0x6a, 0x34, 0x4a, 0xd8, 0xe6, 0x95, 0x91, 0xe5, 0x8e, 0x46, 0x5d, 0x27, 0xab, 0x55, 0x90, 0x91,
+	0x32, 0xbf, 0x7f, 0xff, 0xd7, 0x8b, 0x35, 0xeb, 0xb7, 0x8b, 0x35, 0xeb, 0x8f, 0x8b, 0x35, 0xeb,
+	0xa3, 0x57, 0xff, 0xd9, 0x8f, 0x5a, 0x10, 0x31, 0x8c, 0xf3, 0xff, 0xc5, 0xe3, 0xba, 0xfe, 0xa5,
+	0xda, 0xfd, 0x6b, 0x00, 0x87, 0x8a, 0x75, 0x50, 0x50, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -802,6 +898,8 @@ type ProjectServiceClient interface {
 	ListEvents(ctx context.Context, in *ProjectQuery, opts ...grpc.CallOption) (*v1.EventList, error)
 	// GetSchedulesState returns true if there are any active sync syncWindows
 	GetSyncWindowsState(ctx context.Context, in *SyncWindowsQuery, opts ...grpc.CallOption) (*SyncWindowsResponse, error)
+	// Freeze adds a deny sync window to a project, which blocks the syncs until the given time
+	Freeze(ctx context.Context, in *ProjectFreezeRequest, opts ...grpc.CallOption) (*v1alpha1.AppProject, error)
 	// ListLinks returns all deep links for the particular project
 	ListLinks(ctx context.Context, in *ListProjectLinksRequest, opts ...grpc.CallOption) (*application.LinksResponse, error)
 }
@@ -913,6 +1011,15 @@ func (c *projectServiceClient) GetSyncWindowsState(ctx context.Context, in *Sync
 	return out, nil
 }
 
+func (c *projectServiceClient) Freeze(ctx context.Context, in *ProjectFreezeRequest, opts ...grpc.CallOption) (*v1alpha1.AppProject, error) {
+	out := new(v1alpha1.AppProject)
+	err := c.cc.Invoke(ctx, "/project.ProjectService/Freeze", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *projectServiceClient) ListLinks(ctx context.Context, in *ListProjectLinksRequest, opts ...grpc.CallOption) (*application.LinksResponse, error) {
 	out := new(application.LinksResponse)
 	err := c.cc.Invoke(ctx, "/project.ProjectService/ListLinks", in, out, opts...)
@@ -946,6 +1053,8 @@ type ProjectServiceServer interface {
 	ListEvents(context.Context, *ProjectQuery) (*v1.EventList, error)
 	// GetSchedulesState returns true if there are any active sync syncWindows
 	GetSyncWindowsState(context.Context, *SyncWindowsQuery) (*SyncWindowsResponse, error)
+	// Freeze adds a deny sync window to a project, which blocks the syncs until the given time
+	Freeze(context.Context, *ProjectFreezeRequest) (*v1alpha1.AppProject, error)
 	// ListLinks returns all deep links for the particular project
 	ListLinks(context.Context, *ListProjectLinksRequest) (*application.LinksResponse, error)
 }
@@ -987,6 +1096,9 @@ func (*UnimplementedProjectServiceServer) ListEvents(ctx context.Context, req *P
 func (*UnimplementedProjectServiceServer) GetSyncWindowsState(ctx context.Context, req *SyncWindowsQuery) (*SyncWindowsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSyncWindowsState not implemented")
 }
+func (*UnimplementedProjectServiceServer) Freeze(ctx context.Context, req *ProjectFreezeRequest) (*v1alpha1.AppProject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Freeze not implemented")
+}
 func (*UnimplementedProjectServiceServer) ListLinks(ctx context.Context, req *ListProjectLinksRequest) (*application.LinksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListLinks not implemented")
 }
@@ -1193,6 +1305,24 @@ func _ProjectService_GetSyncWindowsState_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_Freeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectFreezeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).Freeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.ProjectService/Freeze",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).Freeze(ctx, req.(*ProjectFreezeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProjectService_ListLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListProjectLinksRequest)
 	if err := dec(in); err != nil {
@@ -1259,6 +1389,10 @@ var _ProjectService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSyncWindowsState",
 			Handler:    _ProjectService_GetSyncWindowsState_Handler,
 		},
+		{
+			MethodName: "Freeze",
+			Handler:    _ProjectService_Freeze_Handler,
+		},
 		{
 			MethodName: "ListLinks",
 			Handler:    _ProjectService_ListLinks_Handler,
@@ -1564,6 +1698,81 @@ func (m *EmptyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProjectFreezeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectFreezeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectFreezeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Clusters) > 0 {
+		for iNdEx := len(m.Clusters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Clusters[iNdEx])
+			copy(dAtA[i:], m.Clusters[iNdEx])
+			i = encodeVarintProject(dAtA, i, uint64(len(m.Clusters[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Namespaces[iNdEx])
+			copy(dAtA[i:], m.Namespaces[iNdEx])
+			i = encodeVarintProject(dAtA, i, uint64(len(m.Namespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Applications) > 0 {
+		for iNdEx := len(m.Applications) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Applications[iNdEx])
+			copy(dAtA[i:], m.Applications[iNdEx])
+			i = encodeVarintProject(dAtA, i, uint64(len(m.Applications[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Until) > 0 {
+		i -= len(m.Until)
+		copy(dAtA[i:], m.Until)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Until)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SyncWindowsQuery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1943,6 +2152,48 @@ func (m *EmptyResponse) Size() (n int) {
 	return n
 }
 
+func (m *ProjectFreezeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Until)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if len(m.Applications) > 0 {
+		for _, s := range m.Applications {
+			l = len(s)
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			l = len(s)
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
+	if len(m.Clusters) > 0 {
+		for _, s := range m.Clusters {
+			l = len(s)
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *SyncWindowsQuery) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2826,6 +3077,249 @@ func (m *EmptyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProjectFreezeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectFreezeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectFreezeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Until = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applications", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Applications = append(m.Applications, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Clusters = append(m.Clusters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SyncWindowsQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -257,7 +257,11 @@ func (proj *AppProject) ValidateProject() error {
 			if window == nil {
 				continue
 			}
-			if _, ok := existingWindows[window.Kind+window.Schedule+window.Duration]; ok {
+			windowKey := window.Kind + window.Schedule + window.Duration
+			if window.ExpiresAt != nil {
+				windowKey += window.ExpiresAt.String()
+			}
+			if _, ok := existingWindows[windowKey]; ok {
 				return status.Errorf(codes.AlreadyExists, "window '%s':'%s':'%s' already exists, update or edit", window.Kind, window.Schedule, window.Duration)
 			}
 			err := window.Validate()
@@ -267,7 +271,19 @@ func (proj *AppProject) ValidateProject() error {
 			if len(window.Applications) == 0 && len(window.Namespaces) == 0 && len(window.Clusters) == 0 {
 				return status.Errorf(codes.OutOfRange, "window '%s':'%s':'%s' requires one of application, cluster or namespace", window.Kind, window.Schedule, window.Duration)
 			}
-			existingWindows[window.Kind+window.Schedule+window.Duration] = true
+			existingWindows[windowKey] = true
+		}
+	}
+
+	if proj.Spec.OrphanedResources != nil && proj.Spec.OrphanedResources.Cleanup != nil {
+		cleanup := proj.Spec.OrphanedResources.Cleanup
+		if _, err := cleanup.GetGracePeriod(); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		if cleanup.Selector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(cleanup.Selector); err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid orphaned resources cleanup selector: %v", err)
+			}
 		}
 	}
 
@@ -303,6 +319,12 @@ func (proj *AppProject) ValidateProject() error {
 		destServiceAccts[key] = true
 	}
 
+	if proj.Spec.OCISignatureVerification != nil {
+		if _, err := proj.Spec.OCISignatureVerification.CosignPolicy(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid OCI signature verification: %v", err)
+		}
+	}
+
 	return nil
 }
 
@@ -909,10 +909,38 @@ func (m *ApplicationSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationSource proto.InternalMessageInfo
 
+func (m *ApplicationSourceCUE) Reset()      { *m = ApplicationSourceCUE{} }
+func (*ApplicationSourceCUE) ProtoMessage() {}
+func (*ApplicationSourceCUE) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{31}
+}
+func (m *ApplicationSourceCUE) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSourceCUE) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationSourceCUE) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSourceCUE.Merge(m, src)
+}
+func (m *ApplicationSourceCUE) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSourceCUE) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSourceCUE.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSourceCUE proto.InternalMessageInfo
+
 func (m *ApplicationSourceDirectory) Reset()      { *m = ApplicationSourceDirectory{} }
 func (*ApplicationSourceDirectory) ProtoMessage() {}
 func (*ApplicationSourceDirectory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{31}
+	return fileDescriptor_c078c3c476799f44, []int{32}
 }
 func (m *ApplicationSourceDirectory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -940,7 +968,7 @@ var xxx_messageInfo_ApplicationSourceDirectory proto.InternalMessageInfo
 func (m *ApplicationSourceHelm) Reset()      { *m = ApplicationSourceHelm{} }
 func (*ApplicationSourceHelm) ProtoMessage() {}
 func (*ApplicationSourceHelm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{32}
+	return fileDescriptor_c078c3c476799f44, []int{33}
 }
 func (m *ApplicationSourceHelm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -968,7 +996,7 @@ var xxx_messageInfo_ApplicationSourceHelm proto.InternalMessageInfo
 func (m *ApplicationSourceJsonnet) Reset()      { *m = ApplicationSourceJsonnet{} }
 func (*ApplicationSourceJsonnet) ProtoMessage() {}
 func (*ApplicationSourceJsonnet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{33}
+	return fileDescriptor_c078c3c476799f44, []int{34}
 }
 func (m *ApplicationSourceJsonnet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -996,7 +1024,7 @@ var xxx_messageInfo_ApplicationSourceJsonnet proto.InternalMessageInfo
 func (m *ApplicationSourceKustomize) Reset()      { *m = ApplicationSourceKustomize{} }
 func (*ApplicationSourceKustomize) ProtoMessage() {}
 func (*ApplicationSourceKustomize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{34}
+	return fileDescriptor_c078c3c476799f44, []int{35}
 }
 func (m *ApplicationSourceKustomize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1024,7 +1052,7 @@ var xxx_messageInfo_ApplicationSourceKustomize proto.InternalMessageInfo
 func (m *ApplicationSourcePlugin) Reset()      { *m = ApplicationSourcePlugin{} }
 func (*ApplicationSourcePlugin) ProtoMessage() {}
 func (*ApplicationSourcePlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{35}
+	return fileDescriptor_c078c3c476799f44, []int{36}
 }
 func (m *ApplicationSourcePlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1052,7 +1080,7 @@ var xxx_messageInfo_ApplicationSourcePlugin proto.InternalMessageInfo
 func (m *ApplicationSourcePluginParameter) Reset()      { *m = ApplicationSourcePluginParameter{} }
 func (*ApplicationSourcePluginParameter) ProtoMessage() {}
 func (*ApplicationSourcePluginParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{36}
+	return fileDescriptor_c078c3c476799f44, []int{37}
 }
 func (m *ApplicationSourcePluginParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1080,7 +1108,7 @@ var xxx_messageInfo_ApplicationSourcePluginParameter proto.InternalMessageInfo
 func (m *ApplicationSpec) Reset()      { *m = ApplicationSpec{} }
 func (*ApplicationSpec) ProtoMessage() {}
 func (*ApplicationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{37}
+	return fileDescriptor_c078c3c476799f44, []int{38}
 }
 func (m *ApplicationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1108,7 +1136,7 @@ var xxx_messageInfo_ApplicationSpec proto.InternalMessageInfo
 func (m *ApplicationStatus) Reset()      { *m = ApplicationStatus{} }
 func (*ApplicationStatus) ProtoMessage() {}
 func (*ApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{38}
+	return fileDescriptor_c078c3c476799f44, []int{39}
 }
 func (m *ApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1136,7 +1164,7 @@ var xxx_messageInfo_ApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSummary) Reset()      { *m = ApplicationSummary{} }
 func (*ApplicationSummary) ProtoMessage() {}
 func (*ApplicationSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{39}
+	return fileDescriptor_c078c3c476799f44, []int{40}
 }
 func (m *ApplicationSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1164,7 +1192,7 @@ var xxx_messageInfo_ApplicationSummary proto.InternalMessageInfo
 func (m *ApplicationTree) Reset()      { *m = ApplicationTree{} }
 func (*ApplicationTree) ProtoMessage() {}
 func (*ApplicationTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{40}
+	return fileDescriptor_c078c3c476799f44, []int{41}
 }
 func (m *ApplicationTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1192,7 +1220,7 @@ var xxx_messageInfo_ApplicationTree proto.InternalMessageInfo
 func (m *ApplicationWatchEvent) Reset()      { *m = ApplicationWatchEvent{} }
 func (*ApplicationWatchEvent) ProtoMessage() {}
 func (*ApplicationWatchEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{41}
+	return fileDescriptor_c078c3c476799f44, []int{42}
 }
 func (m *ApplicationWatchEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1220,7 +1248,7 @@ var xxx_messageInfo_ApplicationWatchEvent proto.InternalMessageInfo
 func (m *Backoff) Reset()      { *m = Backoff{} }
 func (*Backoff) ProtoMessage() {}
 func (*Backoff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{42}
+	return fileDescriptor_c078c3c476799f44, []int{43}
 }
 func (m *Backoff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1248,7 +1276,7 @@ var xxx_messageInfo_Backoff proto.InternalMessageInfo
 func (m *BasicAuthBitbucketServer) Reset()      { *m = BasicAuthBitbucketServer{} }
 func (*BasicAuthBitbucketServer) ProtoMessage() {}
 func (*BasicAuthBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{43}
+	return fileDescriptor_c078c3c476799f44, []int{44}
 }
 func (m *BasicAuthBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1276,7 +1304,7 @@ var xxx_messageInfo_BasicAuthBitbucketServer proto.InternalMessageInfo
 func (m *BearerTokenBitbucket) Reset()      { *m = BearerTokenBitbucket{} }
 func (*BearerTokenBitbucket) ProtoMessage() {}
 func (*BearerTokenBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{44}
+	return fileDescriptor_c078c3c476799f44, []int{45}
 }
 func (m *BearerTokenBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1304,7 +1332,7 @@ var xxx_messageInfo_BearerTokenBitbucket proto.InternalMessageInfo
 func (m *BearerTokenBitbucketCloud) Reset()      { *m = BearerTokenBitbucketCloud{} }
 func (*BearerTokenBitbucketCloud) ProtoMessage() {}
 func (*BearerTokenBitbucketCloud) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{45}
+	return fileDescriptor_c078c3c476799f44, []int{46}
 }
 func (m *BearerTokenBitbucketCloud) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1329,10 +1357,38 @@ func (m *BearerTokenBitbucketCloud) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BearerTokenBitbucketCloud proto.InternalMessageInfo
 
+func (m *CUETag) Reset()      { *m = CUETag{} }
+func (*CUETag) ProtoMessage() {}
+func (*CUETag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{47}
+}
+func (m *CUETag) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CUETag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CUETag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CUETag.Merge(m, src)
+}
+func (m *CUETag) XXX_Size() int {
+	return m.Size()
+}
+func (m *CUETag) XXX_DiscardUnknown() {
+	xxx_messageInfo_CUETag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CUETag proto.InternalMessageInfo
+
 func (m *ChartDetails) Reset()      { *m = ChartDetails{} }
 func (*ChartDetails) ProtoMessage() {}
 func (*ChartDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{46}
+	return fileDescriptor_c078c3c476799f44, []int{48}
 }
 func (m *ChartDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1360,7 +1416,7 @@ var xxx_messageInfo_ChartDetails proto.InternalMessageInfo
 func (m *Cluster) Reset()      { *m = Cluster{} }
 func (*Cluster) ProtoMessage() {}
 func (*Cluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{47}
+	return fileDescriptor_c078c3c476799f44, []int{49}
 }
 func (m *Cluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1388,7 +1444,7 @@ var xxx_messageInfo_Cluster proto.InternalMessageInfo
 func (m *ClusterCacheInfo) Reset()      { *m = ClusterCacheInfo{} }
 func (*ClusterCacheInfo) ProtoMessage() {}
 func (*ClusterCacheInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{48}
+	return fileDescriptor_c078c3c476799f44, []int{50}
 }
 func (m *ClusterCacheInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1416,7 +1472,7 @@ var xxx_messageInfo_ClusterCacheInfo proto.InternalMessageInfo
 func (m *ClusterConfig) Reset()      { *m = ClusterConfig{} }
 func (*ClusterConfig) ProtoMessage() {}
 func (*ClusterConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{49}
+	return fileDescriptor_c078c3c476799f44, []int{51}
 }
 func (m *ClusterConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1444,7 +1500,7 @@ var xxx_messageInfo_ClusterConfig proto.InternalMessageInfo
 func (m *ClusterGenerator) Reset()      { *m = ClusterGenerator{} }
 func (*ClusterGenerator) ProtoMessage() {}
 func (*ClusterGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{50}
+	return fileDescriptor_c078c3c476799f44, []int{52}
 }
 func (m *ClusterGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1472,7 +1528,7 @@ var xxx_messageInfo_ClusterGenerator proto.InternalMessageInfo
 func (m *ClusterInfo) Reset()      { *m = ClusterInfo{} }
 func (*ClusterInfo) ProtoMessage() {}
 func (*ClusterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{51}
+	return fileDescriptor_c078c3c476799f44, []int{53}
 }
 func (m *ClusterInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1500,7 +1556,7 @@ var xxx_messageInfo_ClusterInfo proto.InternalMessageInfo
 func (m *ClusterList) Reset()      { *m = ClusterList{} }
 func (*ClusterList) ProtoMessage() {}
 func (*ClusterList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{52}
+	return fileDescriptor_c078c3c476799f44, []int{54}
 }
 func (m *ClusterList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1528,7 +1584,7 @@ var xxx_messageInfo_ClusterList proto.InternalMessageInfo
 func (m *Command) Reset()      { *m = Command{} }
 func (*Command) ProtoMessage() {}
 func (*Command) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{53}
+	return fileDescriptor_c078c3c476799f44, []int{55}
 }
 func (m *Command) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1556,7 +1612,7 @@ var xxx_messageInfo_Command proto.InternalMessageInfo
 func (m *CommitMetadata) Reset()      { *m = CommitMetadata{} }
 func (*CommitMetadata) ProtoMessage() {}
 func (*CommitMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{54}
+	return fileDescriptor_c078c3c476799f44, []int{56}
 }
 func (m *CommitMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1584,7 +1640,7 @@ var xxx_messageInfo_CommitMetadata proto.InternalMessageInfo
 func (m *ComparedTo) Reset()      { *m = ComparedTo{} }
 func (*ComparedTo) ProtoMessage() {}
 func (*ComparedTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{55}
+	return fileDescriptor_c078c3c476799f44, []int{57}
 }
 func (m *ComparedTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1612,7 +1668,7 @@ var xxx_messageInfo_ComparedTo proto.InternalMessageInfo
 func (m *ComponentParameter) Reset()      { *m = ComponentParameter{} }
 func (*ComponentParameter) ProtoMessage() {}
 func (*ComponentParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{56}
+	return fileDescriptor_c078c3c476799f44, []int{58}
 }
 func (m *ComponentParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1640,7 +1696,7 @@ var xxx_messageInfo_ComponentParameter proto.InternalMessageInfo
 func (m *ConfigManagementPlugin) Reset()      { *m = ConfigManagementPlugin{} }
 func (*ConfigManagementPlugin) ProtoMessage() {}
 func (*ConfigManagementPlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{57}
+	return fileDescriptor_c078c3c476799f44, []int{59}
 }
 func (m *ConfigManagementPlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1668,7 +1724,7 @@ var xxx_messageInfo_ConfigManagementPlugin proto.InternalMessageInfo
 func (m *ConfigMapKeyRef) Reset()      { *m = ConfigMapKeyRef{} }
 func (*ConfigMapKeyRef) ProtoMessage() {}
 func (*ConfigMapKeyRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{58}
+	return fileDescriptor_c078c3c476799f44, []int{60}
 }
 func (m *ConfigMapKeyRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1696,7 +1752,7 @@ var xxx_messageInfo_ConfigMapKeyRef proto.InternalMessageInfo
 func (m *ConnectionState) Reset()      { *m = ConnectionState{} }
 func (*ConnectionState) ProtoMessage() {}
 func (*ConnectionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{59}
+	return fileDescriptor_c078c3c476799f44, []int{61}
 }
 func (m *ConnectionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1724,7 +1780,7 @@ var xxx_messageInfo_ConnectionState proto.InternalMessageInfo
 func (m *DrySource) Reset()      { *m = DrySource{} }
 func (*DrySource) ProtoMessage() {}
 func (*DrySource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{60}
+	return fileDescriptor_c078c3c476799f44, []int{62}
 }
 func (m *DrySource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1752,7 +1808,7 @@ var xxx_messageInfo_DrySource proto.InternalMessageInfo
 func (m *DuckTypeGenerator) Reset()      { *m = DuckTypeGenerator{} }
 func (*DuckTypeGenerator) ProtoMessage() {}
 func (*DuckTypeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{61}
+	return fileDescriptor_c078c3c476799f44, []int{63}
 }
 func (m *DuckTypeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1780,7 +1836,7 @@ var xxx_messageInfo_DuckTypeGenerator proto.InternalMessageInfo
 func (m *EnvEntry) Reset()      { *m = EnvEntry{} }
 func (*EnvEntry) ProtoMessage() {}
 func (*EnvEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{62}
+	return fileDescriptor_c078c3c476799f44, []int{64}
 }
 func (m *EnvEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1808,7 +1864,7 @@ var xxx_messageInfo_EnvEntry proto.InternalMessageInfo
 func (m *ErrApplicationNotAllowedToUseProject) Reset()      { *m = ErrApplicationNotAllowedToUseProject{} }
 func (*ErrApplicationNotAllowedToUseProject) ProtoMessage() {}
 func (*ErrApplicationNotAllowedToUseProject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{63}
+	return fileDescriptor_c078c3c476799f44, []int{65}
 }
 func (m *ErrApplicationNotAllowedToUseProject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1836,7 +1892,7 @@ var xxx_messageInfo_ErrApplicationNotAllowedToUseProject proto.InternalMessageIn
 func (m *ExecProviderConfig) Reset()      { *m = ExecProviderConfig{} }
 func (*ExecProviderConfig) ProtoMessage() {}
 func (*ExecProviderConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{64}
+	return fileDescriptor_c078c3c476799f44, []int{66}
 }
 func (m *ExecProviderConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1861,10 +1917,38 @@ func (m *ExecProviderConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExecProviderConfig proto.InternalMessageInfo
 
+func (m *FieldManagerConflict) Reset()      { *m = FieldManagerConflict{} }
+func (*FieldManagerConflict) ProtoMessage() {}
+func (*FieldManagerConflict) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{67}
+}
+func (m *FieldManagerConflict) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FieldManagerConflict) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FieldManagerConflict) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldManagerConflict.Merge(m, src)
+}
+func (m *FieldManagerConflict) XXX_Size() int {
+	return m.Size()
+}
+func (m *FieldManagerConflict) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldManagerConflict.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldManagerConflict proto.InternalMessageInfo
+
 func (m *GitDirectoryGeneratorItem) Reset()      { *m = GitDirectoryGeneratorItem{} }
 func (*GitDirectoryGeneratorItem) ProtoMessage() {}
 func (*GitDirectoryGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{65}
+	return fileDescriptor_c078c3c476799f44, []int{68}
 }
 func (m *GitDirectoryGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1892,7 +1976,7 @@ var xxx_messageInfo_GitDirectoryGeneratorItem proto.InternalMessageInfo
 func (m *GitFileGeneratorItem) Reset()      { *m = GitFileGeneratorItem{} }
 func (*GitFileGeneratorItem) ProtoMessage() {}
 func (*GitFileGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{66}
+	return fileDescriptor_c078c3c476799f44, []int{69}
 }
 func (m *GitFileGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1920,7 +2004,7 @@ var xxx_messageInfo_GitFileGeneratorItem proto.InternalMessageInfo
 func (m *GitGenerator) Reset()      { *m = GitGenerator{} }
 func (*GitGenerator) ProtoMessage() {}
 func (*GitGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{67}
+	return fileDescriptor_c078c3c476799f44, []int{70}
 }
 func (m *GitGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1948,7 +2032,7 @@ var xxx_messageInfo_GitGenerator proto.InternalMessageInfo
 func (m *GnuPGPublicKey) Reset()      { *m = GnuPGPublicKey{} }
 func (*GnuPGPublicKey) ProtoMessage() {}
 func (*GnuPGPublicKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{68}
+	return fileDescriptor_c078c3c476799f44, []int{71}
 }
 func (m *GnuPGPublicKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1976,7 +2060,7 @@ var xxx_messageInfo_GnuPGPublicKey proto.InternalMessageInfo
 func (m *GnuPGPublicKeyList) Reset()      { *m = GnuPGPublicKeyList{} }
 func (*GnuPGPublicKeyList) ProtoMessage() {}
 func (*GnuPGPublicKeyList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{69}
+	return fileDescriptor_c078c3c476799f44, []int{72}
 }
 func (m *GnuPGPublicKeyList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2004,7 +2088,7 @@ var xxx_messageInfo_GnuPGPublicKeyList proto.InternalMessageInfo
 func (m *HealthStatus) Reset()      { *m = HealthStatus{} }
 func (*HealthStatus) ProtoMessage() {}
 func (*HealthStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{70}
+	return fileDescriptor_c078c3c476799f44, []int{73}
 }
 func (m *HealthStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2032,7 +2116,7 @@ var xxx_messageInfo_HealthStatus proto.InternalMessageInfo
 func (m *HelmFileParameter) Reset()      { *m = HelmFileParameter{} }
 func (*HelmFileParameter) ProtoMessage() {}
 func (*HelmFileParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{71}
+	return fileDescriptor_c078c3c476799f44, []int{74}
 }
 func (m *HelmFileParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2060,7 +2144,7 @@ var xxx_messageInfo_HelmFileParameter proto.InternalMessageInfo
 func (m *HelmOptions) Reset()      { *m = HelmOptions{} }
 func (*HelmOptions) ProtoMessage() {}
 func (*HelmOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{72}
+	return fileDescriptor_c078c3c476799f44, []int{75}
 }
 func (m *HelmOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2088,7 +2172,7 @@ var xxx_messageInfo_HelmOptions proto.InternalMessageInfo
 func (m *HelmParameter) Reset()      { *m = HelmParameter{} }
 func (*HelmParameter) ProtoMessage() {}
 func (*HelmParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{73}
+	return fileDescriptor_c078c3c476799f44, []int{76}
 }
 func (m *HelmParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2113,10 +2197,38 @@ func (m *HelmParameter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HelmParameter proto.InternalMessageInfo
 
+func (m *HelmValuesFromSource) Reset()      { *m = HelmValuesFromSource{} }
+func (*HelmValuesFromSource) ProtoMessage() {}
+func (*HelmValuesFromSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{77}
+}
+func (m *HelmValuesFromSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HelmValuesFromSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HelmValuesFromSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HelmValuesFromSource.Merge(m, src)
+}
+func (m *HelmValuesFromSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *HelmValuesFromSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_HelmValuesFromSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HelmValuesFromSource proto.InternalMessageInfo
+
 func (m *HostInfo) Reset()      { *m = HostInfo{} }
 func (*HostInfo) ProtoMessage() {}
 func (*HostInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{74}
+	return fileDescriptor_c078c3c476799f44, []int{78}
 }
 func (m *HostInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2144,7 +2256,7 @@ var xxx_messageInfo_HostInfo proto.InternalMessageInfo
 func (m *HostResourceInfo) Reset()      { *m = HostResourceInfo{} }
 func (*HostResourceInfo) ProtoMessage() {}
 func (*HostResourceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{75}
+	return fileDescriptor_c078c3c476799f44, []int{79}
 }
 func (m *HostResourceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2172,7 +2284,7 @@ var xxx_messageInfo_HostResourceInfo proto.InternalMessageInfo
 func (m *HydrateOperation) Reset()      { *m = HydrateOperation{} }
 func (*HydrateOperation) ProtoMessage() {}
 func (*HydrateOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{76}
+	return fileDescriptor_c078c3c476799f44, []int{80}
 }
 func (m *HydrateOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2200,7 +2312,7 @@ var xxx_messageInfo_HydrateOperation proto.InternalMessageInfo
 func (m *HydrateTo) Reset()      { *m = HydrateTo{} }
 func (*HydrateTo) ProtoMessage() {}
 func (*HydrateTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{77}
+	return fileDescriptor_c078c3c476799f44, []int{81}
 }
 func (m *HydrateTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2228,7 +2340,7 @@ var xxx_messageInfo_HydrateTo proto.InternalMessageInfo
 func (m *Info) Reset()      { *m = Info{} }
 func (*Info) ProtoMessage() {}
 func (*Info) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{78}
+	return fileDescriptor_c078c3c476799f44, []int{82}
 }
 func (m *Info) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2256,7 +2368,7 @@ var xxx_messageInfo_Info proto.InternalMessageInfo
 func (m *InfoItem) Reset()      { *m = InfoItem{} }
 func (*InfoItem) ProtoMessage() {}
 func (*InfoItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{79}
+	return fileDescriptor_c078c3c476799f44, []int{83}
 }
 func (m *InfoItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2284,7 +2396,7 @@ var xxx_messageInfo_InfoItem proto.InternalMessageInfo
 func (m *JWTToken) Reset()      { *m = JWTToken{} }
 func (*JWTToken) ProtoMessage() {}
 func (*JWTToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{80}
+	return fileDescriptor_c078c3c476799f44, []int{84}
 }
 func (m *JWTToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2312,7 +2424,7 @@ var xxx_messageInfo_JWTToken proto.InternalMessageInfo
 func (m *JWTTokens) Reset()      { *m = JWTTokens{} }
 func (*JWTTokens) ProtoMessage() {}
 func (*JWTTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{81}
+	return fileDescriptor_c078c3c476799f44, []int{85}
 }
 func (m *JWTTokens) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2340,7 +2452,7 @@ var xxx_messageInfo_JWTTokens proto.InternalMessageInfo
 func (m *JsonnetVar) Reset()      { *m = JsonnetVar{} }
 func (*JsonnetVar) ProtoMessage() {}
 func (*JsonnetVar) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{82}
+	return fileDescriptor_c078c3c476799f44, []int{86}
 }
 func (m *JsonnetVar) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2368,7 +2480,7 @@ var xxx_messageInfo_JsonnetVar proto.InternalMessageInfo
 func (m *KnownTypeField) Reset()      { *m = KnownTypeField{} }
 func (*KnownTypeField) ProtoMessage() {}
 func (*KnownTypeField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{83}
+	return fileDescriptor_c078c3c476799f44, []int{87}
 }
 func (m *KnownTypeField) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2396,7 +2508,7 @@ var xxx_messageInfo_KnownTypeField proto.InternalMessageInfo
 func (m *KustomizeGvk) Reset()      { *m = KustomizeGvk{} }
 func (*KustomizeGvk) ProtoMessage() {}
 func (*KustomizeGvk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{84}
+	return fileDescriptor_c078c3c476799f44, []int{88}
 }
 func (m *KustomizeGvk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2424,7 +2536,7 @@ var xxx_messageInfo_KustomizeGvk proto.InternalMessageInfo
 func (m *KustomizeOptions) Reset()      { *m = KustomizeOptions{} }
 func (*KustomizeOptions) ProtoMessage() {}
 func (*KustomizeOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{85}
+	return fileDescriptor_c078c3c476799f44, []int{89}
 }
 func (m *KustomizeOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2452,7 +2564,7 @@ var xxx_messageInfo_KustomizeOptions proto.InternalMessageInfo
 func (m *KustomizePatch) Reset()      { *m = KustomizePatch{} }
 func (*KustomizePatch) ProtoMessage() {}
 func (*KustomizePatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{86}
+	return fileDescriptor_c078c3c476799f44, []int{90}
 }
 func (m *KustomizePatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2480,7 +2592,7 @@ var xxx_messageInfo_KustomizePatch proto.InternalMessageInfo
 func (m *KustomizeReplica) Reset()      { *m = KustomizeReplica{} }
 func (*KustomizeReplica) ProtoMessage() {}
 func (*KustomizeReplica) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{87}
+	return fileDescriptor_c078c3c476799f44, []int{91}
 }
 func (m *KustomizeReplica) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2508,7 +2620,7 @@ var xxx_messageInfo_KustomizeReplica proto.InternalMessageInfo
 func (m *KustomizeResId) Reset()      { *m = KustomizeResId{} }
 func (*KustomizeResId) ProtoMessage() {}
 func (*KustomizeResId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{88}
+	return fileDescriptor_c078c3c476799f44, []int{92}
 }
 func (m *KustomizeResId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2536,7 +2648,7 @@ var xxx_messageInfo_KustomizeResId proto.InternalMessageInfo
 func (m *KustomizeSelector) Reset()      { *m = KustomizeSelector{} }
 func (*KustomizeSelector) ProtoMessage() {}
 func (*KustomizeSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{89}
+	return fileDescriptor_c078c3c476799f44, []int{93}
 }
 func (m *KustomizeSelector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2564,7 +2676,7 @@ var xxx_messageInfo_KustomizeSelector proto.InternalMessageInfo
 func (m *KustomizeVersion) Reset()      { *m = KustomizeVersion{} }
 func (*KustomizeVersion) ProtoMessage() {}
 func (*KustomizeVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{90}
+	return fileDescriptor_c078c3c476799f44, []int{94}
 }
 func (m *KustomizeVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2592,7 +2704,7 @@ var xxx_messageInfo_KustomizeVersion proto.InternalMessageInfo
 func (m *ListGenerator) Reset()      { *m = ListGenerator{} }
 func (*ListGenerator) ProtoMessage() {}
 func (*ListGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{91}
+	return fileDescriptor_c078c3c476799f44, []int{95}
 }
 func (m *ListGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2620,7 +2732,7 @@ var xxx_messageInfo_ListGenerator proto.InternalMessageInfo
 func (m *ManagedNamespaceMetadata) Reset()      { *m = ManagedNamespaceMetadata{} }
 func (*ManagedNamespaceMetadata) ProtoMessage() {}
 func (*ManagedNamespaceMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{92}
+	return fileDescriptor_c078c3c476799f44, []int{96}
 }
 func (m *ManagedNamespaceMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2648,7 +2760,7 @@ var xxx_messageInfo_ManagedNamespaceMetadata proto.InternalMessageInfo
 func (m *MatrixGenerator) Reset()      { *m = MatrixGenerator{} }
 func (*MatrixGenerator) ProtoMessage() {}
 func (*MatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{93}
+	return fileDescriptor_c078c3c476799f44, []int{97}
 }
 func (m *MatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2676,7 +2788,7 @@ var xxx_messageInfo_MatrixGenerator proto.InternalMessageInfo
 func (m *MergeGenerator) Reset()      { *m = MergeGenerator{} }
 func (*MergeGenerator) ProtoMessage() {}
 func (*MergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{94}
+	return fileDescriptor_c078c3c476799f44, []int{98}
 }
 func (m *MergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2704,7 +2816,7 @@ var xxx_messageInfo_MergeGenerator proto.InternalMessageInfo
 func (m *NestedMatrixGenerator) Reset()      { *m = NestedMatrixGenerator{} }
 func (*NestedMatrixGenerator) ProtoMessage() {}
 func (*NestedMatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{95}
+	return fileDescriptor_c078c3c476799f44, []int{99}
 }
 func (m *NestedMatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2732,7 +2844,7 @@ var xxx_messageInfo_NestedMatrixGenerator proto.InternalMessageInfo
 func (m *NestedMergeGenerator) Reset()      { *m = NestedMergeGenerator{} }
 func (*NestedMergeGenerator) ProtoMessage() {}
 func (*NestedMergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{96}
+	return fileDescriptor_c078c3c476799f44, []int{100}
 }
 func (m *NestedMergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2760,7 +2872,7 @@ var xxx_messageInfo_NestedMergeGenerator proto.InternalMessageInfo
 func (m *OCIMetadata) Reset()      { *m = OCIMetadata{} }
 func (*OCIMetadata) ProtoMessage() {}
 func (*OCIMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{97}
+	return fileDescriptor_c078c3c476799f44, []int{101}
 }
 func (m *OCIMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2785,10 +2897,66 @@ func (m *OCIMetadata) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OCIMetadata proto.InternalMessageInfo
 
+func (m *OCISignatureVerification) Reset()      { *m = OCISignatureVerification{} }
+func (*OCISignatureVerification) ProtoMessage() {}
+func (*OCISignatureVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{102}
+}
+func (m *OCISignatureVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OCISignatureVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OCISignatureVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCISignatureVerification.Merge(m, src)
+}
+func (m *OCISignatureVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *OCISignatureVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCISignatureVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OCISignatureVerification proto.InternalMessageInfo
+
+func (m *OCISigningIdentity) Reset()      { *m = OCISigningIdentity{} }
+func (*OCISigningIdentity) ProtoMessage() {}
+func (*OCISigningIdentity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{103}
+}
+func (m *OCISigningIdentity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OCISigningIdentity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OCISigningIdentity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCISigningIdentity.Merge(m, src)
+}
+func (m *OCISigningIdentity) XXX_Size() int {
+	return m.Size()
+}
+func (m *OCISigningIdentity) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCISigningIdentity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OCISigningIdentity proto.InternalMessageInfo
+
 func (m *Operation) Reset()      { *m = Operation{} }
 func (*Operation) ProtoMessage() {}
 func (*Operation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{98}
+	return fileDescriptor_c078c3c476799f44, []int{104}
 }
 func (m *Operation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2816,7 +2984,7 @@ var xxx_messageInfo_Operation proto.InternalMessageInfo
 func (m *OperationInitiator) Reset()      { *m = OperationInitiator{} }
 func (*OperationInitiator) ProtoMessage() {}
 func (*OperationInitiator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{99}
+	return fileDescriptor_c078c3c476799f44, []int{105}
 }
 func (m *OperationInitiator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2844,7 +3012,7 @@ var xxx_messageInfo_OperationInitiator proto.InternalMessageInfo
 func (m *OperationState) Reset()      { *m = OperationState{} }
 func (*OperationState) ProtoMessage() {}
 func (*OperationState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{100}
+	return fileDescriptor_c078c3c476799f44, []int{106}
 }
 func (m *OperationState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2872,7 +3040,7 @@ var xxx_messageInfo_OperationState proto.InternalMessageInfo
 func (m *OptionalArray) Reset()      { *m = OptionalArray{} }
 func (*OptionalArray) ProtoMessage() {}
 func (*OptionalArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{101}
+	return fileDescriptor_c078c3c476799f44, []int{107}
 }
 func (m *OptionalArray) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2900,7 +3068,7 @@ var xxx_messageInfo_OptionalArray proto.InternalMessageInfo
 func (m *OptionalMap) Reset()      { *m = OptionalMap{} }
 func (*OptionalMap) ProtoMessage() {}
 func (*OptionalMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{102}
+	return fileDescriptor_c078c3c476799f44, []int{108}
 }
 func (m *OptionalMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2928,7 +3096,7 @@ var xxx_messageInfo_OptionalMap proto.InternalMessageInfo
 func (m *OrphanedResourceKey) Reset()      { *m = OrphanedResourceKey{} }
 func (*OrphanedResourceKey) ProtoMessage() {}
 func (*OrphanedResourceKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{103}
+	return fileDescriptor_c078c3c476799f44, []int{109}
 }
 func (m *OrphanedResourceKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2953,10 +3121,38 @@ func (m *OrphanedResourceKey) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OrphanedResourceKey proto.InternalMessageInfo
 
+func (m *OrphanedResourcesCleanup) Reset()      { *m = OrphanedResourcesCleanup{} }
+func (*OrphanedResourcesCleanup) ProtoMessage() {}
+func (*OrphanedResourcesCleanup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{110}
+}
+func (m *OrphanedResourcesCleanup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrphanedResourcesCleanup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OrphanedResourcesCleanup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrphanedResourcesCleanup.Merge(m, src)
+}
+func (m *OrphanedResourcesCleanup) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrphanedResourcesCleanup) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrphanedResourcesCleanup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrphanedResourcesCleanup proto.InternalMessageInfo
+
 func (m *OrphanedResourcesMonitorSettings) Reset()      { *m = OrphanedResourcesMonitorSettings{} }
 func (*OrphanedResourcesMonitorSettings) ProtoMessage() {}
 func (*OrphanedResourcesMonitorSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{104}
+	return fileDescriptor_c078c3c476799f44, []int{111}
 }
 func (m *OrphanedResourcesMonitorSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2984,7 +3180,7 @@ var xxx_messageInfo_OrphanedResourcesMonitorSettings proto.InternalMessageInfo
 func (m *OverrideIgnoreDiff) Reset()      { *m = OverrideIgnoreDiff{} }
 func (*OverrideIgnoreDiff) ProtoMessage() {}
 func (*OverrideIgnoreDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{105}
+	return fileDescriptor_c078c3c476799f44, []int{112}
 }
 func (m *OverrideIgnoreDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3012,7 +3208,7 @@ var xxx_messageInfo_OverrideIgnoreDiff proto.InternalMessageInfo
 func (m *PluginConfigMapRef) Reset()      { *m = PluginConfigMapRef{} }
 func (*PluginConfigMapRef) ProtoMessage() {}
 func (*PluginConfigMapRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{106}
+	return fileDescriptor_c078c3c476799f44, []int{113}
 }
 func (m *PluginConfigMapRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3040,7 +3236,7 @@ var xxx_messageInfo_PluginConfigMapRef proto.InternalMessageInfo
 func (m *PluginGenerator) Reset()      { *m = PluginGenerator{} }
 func (*PluginGenerator) ProtoMessage() {}
 func (*PluginGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{107}
+	return fileDescriptor_c078c3c476799f44, []int{114}
 }
 func (m *PluginGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3068,7 +3264,7 @@ var xxx_messageInfo_PluginGenerator proto.InternalMessageInfo
 func (m *PluginInput) Reset()      { *m = PluginInput{} }
 func (*PluginInput) ProtoMessage() {}
 func (*PluginInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{108}
+	return fileDescriptor_c078c3c476799f44, []int{115}
 }
 func (m *PluginInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3096,7 +3292,7 @@ var xxx_messageInfo_PluginInput proto.InternalMessageInfo
 func (m *ProjectRole) Reset()      { *m = ProjectRole{} }
 func (*ProjectRole) ProtoMessage() {}
 func (*ProjectRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{109}
+	return fileDescriptor_c078c3c476799f44, []int{116}
 }
 func (m *ProjectRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3124,7 +3320,7 @@ var xxx_messageInfo_ProjectRole proto.InternalMessageInfo
 func (m *PullRequestGenerator) Reset()      { *m = PullRequestGenerator{} }
 func (*PullRequestGenerator) ProtoMessage() {}
 func (*PullRequestGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{110}
+	return fileDescriptor_c078c3c476799f44, []int{117}
 }
 func (m *PullRequestGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3152,7 +3348,7 @@ var xxx_messageInfo_PullRequestGenerator proto.InternalMessageInfo
 func (m *PullRequestGeneratorAzureDevOps) Reset()      { *m = PullRequestGeneratorAzureDevOps{} }
 func (*PullRequestGeneratorAzureDevOps) ProtoMessage() {}
 func (*PullRequestGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{111}
+	return fileDescriptor_c078c3c476799f44, []int{118}
 }
 func (m *PullRequestGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3180,7 +3376,7 @@ var xxx_messageInfo_PullRequestGeneratorAzureDevOps proto.InternalMessageInfo
 func (m *PullRequestGeneratorBitbucket) Reset()      { *m = PullRequestGeneratorBitbucket{} }
 func (*PullRequestGeneratorBitbucket) ProtoMessage() {}
 func (*PullRequestGeneratorBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{112}
+	return fileDescriptor_c078c3c476799f44, []int{119}
 }
 func (m *PullRequestGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3208,7 +3404,7 @@ var xxx_messageInfo_PullRequestGeneratorBitbucket proto.InternalMessageInfo
 func (m *PullRequestGeneratorBitbucketServer) Reset()      { *m = PullRequestGeneratorBitbucketServer{} }
 func (*PullRequestGeneratorBitbucketServer) ProtoMessage() {}
 func (*PullRequestGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{113}
+	return fileDescriptor_c078c3c476799f44, []int{120}
 }
 func (m *PullRequestGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3236,7 +3432,7 @@ var xxx_messageInfo_PullRequestGeneratorBitbucketServer proto.InternalMessageInf
 func (m *PullRequestGeneratorFilter) Reset()      { *m = PullRequestGeneratorFilter{} }
 func (*PullRequestGeneratorFilter) ProtoMessage() {}
 func (*PullRequestGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{114}
+	return fileDescriptor_c078c3c476799f44, []int{121}
 }
 func (m *PullRequestGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3264,7 +3460,7 @@ var xxx_messageInfo_PullRequestGeneratorFilter proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitLab) Reset()      { *m = PullRequestGeneratorGitLab{} }
 func (*PullRequestGeneratorGitLab) ProtoMessage() {}
 func (*PullRequestGeneratorGitLab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{115}
+	return fileDescriptor_c078c3c476799f44, []int{122}
 }
 func (m *PullRequestGeneratorGitLab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3292,7 +3488,7 @@ var xxx_messageInfo_PullRequestGeneratorGitLab proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitea) Reset()      { *m = PullRequestGeneratorGitea{} }
 func (*PullRequestGeneratorGitea) ProtoMessage() {}
 func (*PullRequestGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{116}
+	return fileDescriptor_c078c3c476799f44, []int{123}
 }
 func (m *PullRequestGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3320,7 +3516,7 @@ var xxx_messageInfo_PullRequestGeneratorGitea proto.InternalMessageInfo
 func (m *PullRequestGeneratorGithub) Reset()      { *m = PullRequestGeneratorGithub{} }
 func (*PullRequestGeneratorGithub) ProtoMessage() {}
 func (*PullRequestGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{117}
+	return fileDescriptor_c078c3c476799f44, []int{124}
 }
 func (m *PullRequestGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3348,7 +3544,7 @@ var xxx_messageInfo_PullRequestGeneratorGithub proto.InternalMessageInfo
 func (m *RefTarget) Reset()      { *m = RefTarget{} }
 func (*RefTarget) ProtoMessage() {}
 func (*RefTarget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{118}
+	return fileDescriptor_c078c3c476799f44, []int{125}
 }
 func (m *RefTarget) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3376,7 +3572,7 @@ var xxx_messageInfo_RefTarget proto.InternalMessageInfo
 func (m *RepoCreds) Reset()      { *m = RepoCreds{} }
 func (*RepoCreds) ProtoMessage() {}
 func (*RepoCreds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{119}
+	return fileDescriptor_c078c3c476799f44, []int{126}
 }
 func (m *RepoCreds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3404,7 +3600,7 @@ var xxx_messageInfo_RepoCreds proto.InternalMessageInfo
 func (m *RepoCredsList) Reset()      { *m = RepoCredsList{} }
 func (*RepoCredsList) ProtoMessage() {}
 func (*RepoCredsList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{120}
+	return fileDescriptor_c078c3c476799f44, []int{127}
 }
 func (m *RepoCredsList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3432,7 +3628,7 @@ var xxx_messageInfo_RepoCredsList proto.InternalMessageInfo
 func (m *Repository) Reset()      { *m = Repository{} }
 func (*Repository) ProtoMessage() {}
 func (*Repository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{121}
+	return fileDescriptor_c078c3c476799f44, []int{128}
 }
 func (m *Repository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3460,7 +3656,7 @@ var xxx_messageInfo_Repository proto.InternalMessageInfo
 func (m *RepositoryCertificate) Reset()      { *m = RepositoryCertificate{} }
 func (*RepositoryCertificate) ProtoMessage() {}
 func (*RepositoryCertificate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{122}
+	return fileDescriptor_c078c3c476799f44, []int{129}
 }
 func (m *RepositoryCertificate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3488,7 +3684,7 @@ var xxx_messageInfo_RepositoryCertificate proto.InternalMessageInfo
 func (m *RepositoryCertificateList) Reset()      { *m = RepositoryCertificateList{} }
 func (*RepositoryCertificateList) ProtoMessage() {}
 func (*RepositoryCertificateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{123}
+	return fileDescriptor_c078c3c476799f44, []int{130}
 }
 func (m *RepositoryCertificateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3516,7 +3712,7 @@ var xxx_messageInfo_RepositoryCertificateList proto.InternalMessageInfo
 func (m *RepositoryList) Reset()      { *m = RepositoryList{} }
 func (*RepositoryList) ProtoMessage() {}
 func (*RepositoryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{124}
+	return fileDescriptor_c078c3c476799f44, []int{131}
 }
 func (m *RepositoryList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3544,7 +3740,7 @@ var xxx_messageInfo_RepositoryList proto.InternalMessageInfo
 func (m *ResourceAction) Reset()      { *m = ResourceAction{} }
 func (*ResourceAction) ProtoMessage() {}
 func (*ResourceAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{125}
+	return fileDescriptor_c078c3c476799f44, []int{132}
 }
 func (m *ResourceAction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3572,7 +3768,7 @@ var xxx_messageInfo_ResourceAction proto.InternalMessageInfo
 func (m *ResourceActionDefinition) Reset()      { *m = ResourceActionDefinition{} }
 func (*ResourceActionDefinition) ProtoMessage() {}
 func (*ResourceActionDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{126}
+	return fileDescriptor_c078c3c476799f44, []int{133}
 }
 func (m *ResourceActionDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3600,7 +3796,7 @@ var xxx_messageInfo_ResourceActionDefinition proto.InternalMessageInfo
 func (m *ResourceActionParam) Reset()      { *m = ResourceActionParam{} }
 func (*ResourceActionParam) ProtoMessage() {}
 func (*ResourceActionParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{127}
+	return fileDescriptor_c078c3c476799f44, []int{134}
 }
 func (m *ResourceActionParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3628,7 +3824,7 @@ var xxx_messageInfo_ResourceActionParam proto.InternalMessageInfo
 func (m *ResourceActions) Reset()      { *m = ResourceActions{} }
 func (*ResourceActions) ProtoMessage() {}
 func (*ResourceActions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{128}
+	return fileDescriptor_c078c3c476799f44, []int{135}
 }
 func (m *ResourceActions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3656,7 +3852,7 @@ var xxx_messageInfo_ResourceActions proto.InternalMessageInfo
 func (m *ResourceDiff) Reset()      { *m = ResourceDiff{} }
 func (*ResourceDiff) ProtoMessage() {}
 func (*ResourceDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{129}
+	return fileDescriptor_c078c3c476799f44, []int{136}
 }
 func (m *ResourceDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3681,10 +3877,38 @@ func (m *ResourceDiff) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResourceDiff proto.InternalMessageInfo
 
+func (m *ResourceDriftEpisode) Reset()      { *m = ResourceDriftEpisode{} }
+func (*ResourceDriftEpisode) ProtoMessage() {}
+func (*ResourceDriftEpisode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{137}
+}
+func (m *ResourceDriftEpisode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceDriftEpisode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ResourceDriftEpisode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceDriftEpisode.Merge(m, src)
+}
+func (m *ResourceDriftEpisode) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceDriftEpisode) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceDriftEpisode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceDriftEpisode proto.InternalMessageInfo
+
 func (m *ResourceIgnoreDifferences) Reset()      { *m = ResourceIgnoreDifferences{} }
 func (*ResourceIgnoreDifferences) ProtoMessage() {}
 func (*ResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{130}
+	return fileDescriptor_c078c3c476799f44, []int{138}
 }
 func (m *ResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3712,7 +3936,7 @@ var xxx_messageInfo_ResourceIgnoreDifferences proto.InternalMessageInfo
 func (m *ResourceNetworkingInfo) Reset()      { *m = ResourceNetworkingInfo{} }
 func (*ResourceNetworkingInfo) ProtoMessage() {}
 func (*ResourceNetworkingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{131}
+	return fileDescriptor_c078c3c476799f44, []int{139}
 }
 func (m *ResourceNetworkingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3740,7 +3964,7 @@ var xxx_messageInfo_ResourceNetworkingInfo proto.InternalMessageInfo
 func (m *ResourceNode) Reset()      { *m = ResourceNode{} }
 func (*ResourceNode) ProtoMessage() {}
 func (*ResourceNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{132}
+	return fileDescriptor_c078c3c476799f44, []int{140}
 }
 func (m *ResourceNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3768,7 +3992,7 @@ var xxx_messageInfo_ResourceNode proto.InternalMessageInfo
 func (m *ResourceOverride) Reset()      { *m = ResourceOverride{} }
 func (*ResourceOverride) ProtoMessage() {}
 func (*ResourceOverride) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{133}
+	return fileDescriptor_c078c3c476799f44, []int{141}
 }
 func (m *ResourceOverride) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3796,7 +4020,7 @@ var xxx_messageInfo_ResourceOverride proto.InternalMessageInfo
 func (m *ResourceRef) Reset()      { *m = ResourceRef{} }
 func (*ResourceRef) ProtoMessage() {}
 func (*ResourceRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{134}
+	return fileDescriptor_c078c3c476799f44, []int{142}
 }
 func (m *ResourceRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3824,7 +4048,7 @@ var xxx_messageInfo_ResourceRef proto.InternalMessageInfo
 func (m *ResourceResult) Reset()      { *m = ResourceResult{} }
 func (*ResourceResult) ProtoMessage() {}
 func (*ResourceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{135}
+	return fileDescriptor_c078c3c476799f44, []int{143}
 }
 func (m *ResourceResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3852,7 +4076,7 @@ var xxx_messageInfo_ResourceResult proto.InternalMessageInfo
 func (m *ResourceStatus) Reset()      { *m = ResourceStatus{} }
 func (*ResourceStatus) ProtoMessage() {}
 func (*ResourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{136}
+	return fileDescriptor_c078c3c476799f44, []int{144}
 }
 func (m *ResourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3880,7 +4104,7 @@ var xxx_messageInfo_ResourceStatus proto.InternalMessageInfo
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{137}
+	return fileDescriptor_c078c3c476799f44, []int{145}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3908,7 +4132,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *RevisionHistory) Reset()      { *m = RevisionHistory{} }
 func (*RevisionHistory) ProtoMessage() {}
 func (*RevisionHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{138}
+	return fileDescriptor_c078c3c476799f44, []int{146}
 }
 func (m *RevisionHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3936,7 +4160,7 @@ var xxx_messageInfo_RevisionHistory proto.InternalMessageInfo
 func (m *RevisionMetadata) Reset()      { *m = RevisionMetadata{} }
 func (*RevisionMetadata) ProtoMessage() {}
 func (*RevisionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{139}
+	return fileDescriptor_c078c3c476799f44, []int{147}
 }
 func (m *RevisionMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3964,7 +4188,7 @@ var xxx_messageInfo_RevisionMetadata proto.InternalMessageInfo
 func (m *RevisionReference) Reset()      { *m = RevisionReference{} }
 func (*RevisionReference) ProtoMessage() {}
 func (*RevisionReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{140}
+	return fileDescriptor_c078c3c476799f44, []int{148}
 }
 func (m *RevisionReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3992,7 +4216,7 @@ var xxx_messageInfo_RevisionReference proto.InternalMessageInfo
 func (m *SCMProviderGenerator) Reset()      { *m = SCMProviderGenerator{} }
 func (*SCMProviderGenerator) ProtoMessage() {}
 func (*SCMProviderGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{141}
+	return fileDescriptor_c078c3c476799f44, []int{149}
 }
 func (m *SCMProviderGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4020,7 +4244,7 @@ var xxx_messageInfo_SCMProviderGenerator proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAWSCodeCommit) Reset()      { *m = SCMProviderGeneratorAWSCodeCommit{} }
 func (*SCMProviderGeneratorAWSCodeCommit) ProtoMessage() {}
 func (*SCMProviderGeneratorAWSCodeCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{142}
+	return fileDescriptor_c078c3c476799f44, []int{150}
 }
 func (m *SCMProviderGeneratorAWSCodeCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4048,7 +4272,7 @@ var xxx_messageInfo_SCMProviderGeneratorAWSCodeCommit proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAzureDevOps) Reset()      { *m = SCMProviderGeneratorAzureDevOps{} }
 func (*SCMProviderGeneratorAzureDevOps) ProtoMessage() {}
 func (*SCMProviderGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{143}
+	return fileDescriptor_c078c3c476799f44, []int{151}
 }
 func (m *SCMProviderGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4076,7 +4300,7 @@ var xxx_messageInfo_SCMProviderGeneratorAzureDevOps proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucket) Reset()      { *m = SCMProviderGeneratorBitbucket{} }
 func (*SCMProviderGeneratorBitbucket) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{144}
+	return fileDescriptor_c078c3c476799f44, []int{152}
 }
 func (m *SCMProviderGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4104,7 +4328,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucket proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucketServer) Reset()      { *m = SCMProviderGeneratorBitbucketServer{} }
 func (*SCMProviderGeneratorBitbucketServer) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{145}
+	return fileDescriptor_c078c3c476799f44, []int{153}
 }
 func (m *SCMProviderGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4132,7 +4356,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucketServer proto.InternalMessageInf
 func (m *SCMProviderGeneratorFilter) Reset()      { *m = SCMProviderGeneratorFilter{} }
 func (*SCMProviderGeneratorFilter) ProtoMessage() {}
 func (*SCMProviderGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{146}
+	return fileDescriptor_c078c3c476799f44, []int{154}
 }
 func (m *SCMProviderGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4160,7 +4384,7 @@ var xxx_messageInfo_SCMProviderGeneratorFilter proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitea) Reset()      { *m = SCMProviderGeneratorGitea{} }
 func (*SCMProviderGeneratorGitea) ProtoMessage() {}
 func (*SCMProviderGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{147}
+	return fileDescriptor_c078c3c476799f44, []int{155}
 }
 func (m *SCMProviderGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4188,7 +4412,7 @@ var xxx_messageInfo_SCMProviderGeneratorGitea proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGithub) Reset()      { *m = SCMProviderGeneratorGithub{} }
 func (*SCMProviderGeneratorGithub) ProtoMessage() {}
 func (*SCMProviderGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{148}
+	return fileDescriptor_c078c3c476799f44, []int{156}
 }
 func (m *SCMProviderGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4216,7 +4440,7 @@ var xxx_messageInfo_SCMProviderGeneratorGithub proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitlab) Reset()      { *m = SCMProviderGeneratorGitlab{} }
 func (*SCMProviderGeneratorGitlab) ProtoMessage() {}
 func (*SCMProviderGeneratorGitlab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{149}
+	return fileDescriptor_c078c3c476799f44, []int{157}
 }
 func (m *SCMProviderGeneratorGitlab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4244,7 +4468,7 @@ var xxx_messageInfo_SCMProviderGeneratorGitlab proto.InternalMessageInfo
 func (m *SecretRef) Reset()      { *m = SecretRef{} }
 func (*SecretRef) ProtoMessage() {}
 func (*SecretRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{150}
+	return fileDescriptor_c078c3c476799f44, []int{158}
 }
 func (m *SecretRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4269,10 +4493,38 @@ func (m *SecretRef) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SecretRef proto.InternalMessageInfo
 
+func (m *ServerSideApplyConflictPolicy) Reset()      { *m = ServerSideApplyConflictPolicy{} }
+func (*ServerSideApplyConflictPolicy) ProtoMessage() {}
+func (*ServerSideApplyConflictPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{159}
+}
+func (m *ServerSideApplyConflictPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServerSideApplyConflictPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ServerSideApplyConflictPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServerSideApplyConflictPolicy.Merge(m, src)
+}
+func (m *ServerSideApplyConflictPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServerSideApplyConflictPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServerSideApplyConflictPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServerSideApplyConflictPolicy proto.InternalMessageInfo
+
 func (m *SignatureKey) Reset()      { *m = SignatureKey{} }
 func (*SignatureKey) ProtoMessage() {}
 func (*SignatureKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{151}
+	return fileDescriptor_c078c3c476799f44, []int{160}
 }
 func (m *SignatureKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4300,7 +4552,7 @@ var xxx_messageInfo_SignatureKey proto.InternalMessageInfo
 func (m *SourceHydrator) Reset()      { *m = SourceHydrator{} }
 func (*SourceHydrator) ProtoMessage() {}
 func (*SourceHydrator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{152}
+	return fileDescriptor_c078c3c476799f44, []int{161}
 }
 func (m *SourceHydrator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4328,7 +4580,7 @@ var xxx_messageInfo_SourceHydrator proto.InternalMessageInfo
 func (m *SourceHydratorStatus) Reset()      { *m = SourceHydratorStatus{} }
 func (*SourceHydratorStatus) ProtoMessage() {}
 func (*SourceHydratorStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{153}
+	return fileDescriptor_c078c3c476799f44, []int{162}
 }
 func (m *SourceHydratorStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4356,7 +4608,7 @@ var xxx_messageInfo_SourceHydratorStatus proto.InternalMessageInfo
 func (m *SuccessfulHydrateOperation) Reset()      { *m = SuccessfulHydrateOperation{} }
 func (*SuccessfulHydrateOperation) ProtoMessage() {}
 func (*SuccessfulHydrateOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{154}
+	return fileDescriptor_c078c3c476799f44, []int{163}
 }
 func (m *SuccessfulHydrateOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4384,7 +4636,7 @@ var xxx_messageInfo_SuccessfulHydrateOperation proto.InternalMessageInfo
 func (m *SyncOperation) Reset()      { *m = SyncOperation{} }
 func (*SyncOperation) ProtoMessage() {}
 func (*SyncOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{155}
+	return fileDescriptor_c078c3c476799f44, []int{164}
 }
 func (m *SyncOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4412,7 +4664,7 @@ var xxx_messageInfo_SyncOperation proto.InternalMessageInfo
 func (m *SyncOperationResource) Reset()      { *m = SyncOperationResource{} }
 func (*SyncOperationResource) ProtoMessage() {}
 func (*SyncOperationResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{156}
+	return fileDescriptor_c078c3c476799f44, []int{165}
 }
 func (m *SyncOperationResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4440,7 +4692,7 @@ var xxx_messageInfo_SyncOperationResource proto.InternalMessageInfo
 func (m *SyncOperationResult) Reset()      { *m = SyncOperationResult{} }
 func (*SyncOperationResult) ProtoMessage() {}
 func (*SyncOperationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{157}
+	return fileDescriptor_c078c3c476799f44, []int{166}
 }
 func (m *SyncOperationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4465,10 +4717,38 @@ func (m *SyncOperationResult) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SyncOperationResult proto.InternalMessageInfo
 
+func (m *SyncPause) Reset()      { *m = SyncPause{} }
+func (*SyncPause) ProtoMessage() {}
+func (*SyncPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{167}
+}
+func (m *SyncPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncPause.Merge(m, src)
+}
+func (m *SyncPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncPause proto.InternalMessageInfo
+
 func (m *SyncPolicy) Reset()      { *m = SyncPolicy{} }
 func (*SyncPolicy) ProtoMessage() {}
 func (*SyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{158}
+	return fileDescriptor_c078c3c476799f44, []int{168}
 }
 func (m *SyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4496,7 +4776,7 @@ var xxx_messageInfo_SyncPolicy proto.InternalMessageInfo
 func (m *SyncPolicyAutomated) Reset()      { *m = SyncPolicyAutomated{} }
 func (*SyncPolicyAutomated) ProtoMessage() {}
 func (*SyncPolicyAutomated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{159}
+	return fileDescriptor_c078c3c476799f44, []int{169}
 }
 func (m *SyncPolicyAutomated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4524,7 +4804,7 @@ var xxx_messageInfo_SyncPolicyAutomated proto.InternalMessageInfo
 func (m *SyncSource) Reset()      { *m = SyncSource{} }
 func (*SyncSource) ProtoMessage() {}
 func (*SyncSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{160}
+	return fileDescriptor_c078c3c476799f44, []int{170}
 }
 func (m *SyncSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4552,7 +4832,7 @@ var xxx_messageInfo_SyncSource proto.InternalMessageInfo
 func (m *SyncStatus) Reset()      { *m = SyncStatus{} }
 func (*SyncStatus) ProtoMessage() {}
 func (*SyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{161}
+	return fileDescriptor_c078c3c476799f44, []int{171}
 }
 func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4580,7 +4860,7 @@ var xxx_messageInfo_SyncStatus proto.InternalMessageInfo
 func (m *SyncStrategy) Reset()      { *m = SyncStrategy{} }
 func (*SyncStrategy) ProtoMessage() {}
 func (*SyncStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{162}
+	return fileDescriptor_c078c3c476799f44, []int{172}
 }
 func (m *SyncStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4608,7 +4888,7 @@ var xxx_messageInfo_SyncStrategy proto.InternalMessageInfo
 func (m *SyncStrategyApply) Reset()      { *m = SyncStrategyApply{} }
 func (*SyncStrategyApply) ProtoMessage() {}
 func (*SyncStrategyApply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{163}
+	return fileDescriptor_c078c3c476799f44, []int{173}
 }
 func (m *SyncStrategyApply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4636,7 +4916,7 @@ var xxx_messageInfo_SyncStrategyApply proto.InternalMessageInfo
 func (m *SyncStrategyHook) Reset()      { *m = SyncStrategyHook{} }
 func (*SyncStrategyHook) ProtoMessage() {}
 func (*SyncStrategyHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{164}
+	return fileDescriptor_c078c3c476799f44, []int{174}
 }
 func (m *SyncStrategyHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4664,7 +4944,7 @@ var xxx_messageInfo_SyncStrategyHook proto.InternalMessageInfo
 func (m *SyncWindow) Reset()      { *m = SyncWindow{} }
 func (*SyncWindow) ProtoMessage() {}
 func (*SyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{165}
+	return fileDescriptor_c078c3c476799f44, []int{175}
 }
 func (m *SyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4689,10 +4969,38 @@ func (m *SyncWindow) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SyncWindow proto.InternalMessageInfo
 
+func (m *SyncWindowCalendar) Reset()      { *m = SyncWindowCalendar{} }
+func (*SyncWindowCalendar) ProtoMessage() {}
+func (*SyncWindowCalendar) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{176}
+}
+func (m *SyncWindowCalendar) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWindowCalendar) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncWindowCalendar) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWindowCalendar.Merge(m, src)
+}
+func (m *SyncWindowCalendar) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWindowCalendar) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWindowCalendar.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWindowCalendar proto.InternalMessageInfo
+
 func (m *TLSClientConfig) Reset()      { *m = TLSClientConfig{} }
 func (*TLSClientConfig) ProtoMessage() {}
 func (*TLSClientConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{166}
+	return fileDescriptor_c078c3c476799f44, []int{177}
 }
 func (m *TLSClientConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4720,7 +5028,7 @@ var xxx_messageInfo_TLSClientConfig proto.InternalMessageInfo
 func (m *TagFilter) Reset()      { *m = TagFilter{} }
 func (*TagFilter) ProtoMessage() {}
 func (*TagFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{167}
+	return fileDescriptor_c078c3c476799f44, []int{178}
 }
 func (m *TagFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4780,6 +5088,7 @@ func init() {
 	proto.RegisterType((*ApplicationSetTerminalGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetTerminalGenerator")
 	proto.RegisterType((*ApplicationSetTree)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetTree")
 	proto.RegisterType((*ApplicationSource)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSource")
+	proto.RegisterType((*ApplicationSourceCUE)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSourceCUE")
 	proto.RegisterType((*ApplicationSourceDirectory)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSourceDirectory")
 	proto.RegisterType((*ApplicationSourceHelm)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSourceHelm")
 	proto.RegisterType((*ApplicationSourceJsonnet)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSourceJsonnet")
@@ -4797,6 +5106,7 @@ func init() {
 	proto.RegisterType((*BasicAuthBitbucketServer)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.BasicAuthBitbucketServer")
 	proto.RegisterType((*BearerTokenBitbucket)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.BearerTokenBitbucket")
 	proto.RegisterType((*BearerTokenBitbucketCloud)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.BearerTokenBitbucketCloud")
+	proto.RegisterType((*CUETag)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.CUETag")
 	proto.RegisterType((*ChartDetails)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ChartDetails")
 	proto.RegisterType((*Cluster)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Cluster")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Cluster.AnnotationsEntry")
@@ -4821,6 +5131,7 @@ func init() {
 	proto.RegisterType((*ErrApplicationNotAllowedToUseProject)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ErrApplicationNotAllowedToUseProject")
 	proto.RegisterType((*ExecProviderConfig)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ExecProviderConfig")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ExecProviderConfig.EnvEntry")
+	proto.RegisterType((*FieldManagerConflict)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.FieldManagerConflict")
 	proto.RegisterType((*GitDirectoryGeneratorItem)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.GitDirectoryGeneratorItem")
 	proto.RegisterType((*GitFileGeneratorItem)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.GitFileGeneratorItem")
 	proto.RegisterType((*GitGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.GitGenerator")
@@ -4831,6 +5142,7 @@ func init() {
 	proto.RegisterType((*HelmFileParameter)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HelmFileParameter")
 	proto.RegisterType((*HelmOptions)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HelmOptions")
 	proto.RegisterType((*HelmParameter)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HelmParameter")
+	proto.RegisterType((*HelmValuesFromSource)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HelmValuesFromSource")
 	proto.RegisterType((*HostInfo)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HostInfo")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HostInfo.LabelsEntry")
 	proto.RegisterType((*HostResourceInfo)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HostResourceInfo")
@@ -4859,6 +5171,8 @@ func init() {
 	proto.RegisterType((*NestedMatrixGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.NestedMatrixGenerator")
 	proto.RegisterType((*NestedMergeGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.NestedMergeGenerator")
 	proto.RegisterType((*OCIMetadata)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OCIMetadata")
+	proto.RegisterType((*OCISignatureVerification)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OCISignatureVerification")
+	proto.RegisterType((*OCISigningIdentity)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OCISigningIdentity")
 	proto.RegisterType((*Operation)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Operation")
 	proto.RegisterType((*OperationInitiator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OperationInitiator")
 	proto.RegisterType((*OperationState)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OperationState")
@@ -4866,6 +5180,7 @@ func init() {
 	proto.RegisterType((*OptionalMap)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OptionalMap")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OptionalMap.MapEntry")
 	proto.RegisterType((*OrphanedResourceKey)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OrphanedResourceKey")
+	proto.RegisterType((*OrphanedResourcesCleanup)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OrphanedResourcesCleanup")
 	proto.RegisterType((*OrphanedResourcesMonitorSettings)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OrphanedResourcesMonitorSettings")
 	proto.RegisterType((*OverrideIgnoreDiff)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OverrideIgnoreDiff")
 	proto.RegisterType((*PluginConfigMapRef)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.PluginConfigMapRef")
@@ -4895,6 +5210,7 @@ func init() {
 	proto.RegisterType((*ResourceActionParam)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceActionParam")
 	proto.RegisterType((*ResourceActions)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceActions")
 	proto.RegisterType((*ResourceDiff)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceDiff")
+	proto.RegisterType((*ResourceDriftEpisode)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceDriftEpisode")
 	proto.RegisterType((*ResourceIgnoreDifferences)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceIgnoreDifferences")
 	proto.RegisterType((*ResourceNetworkingInfo)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceNetworkingInfo")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceNetworkingInfo.LabelsEntry")
@@ -4919,6 +5235,7 @@ func init() {
 	proto.RegisterType((*SCMProviderGeneratorGithub)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SCMProviderGeneratorGithub")
 	proto.RegisterType((*SCMProviderGeneratorGitlab)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SCMProviderGeneratorGitlab")
 	proto.RegisterType((*SecretRef)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SecretRef")
+	proto.RegisterType((*ServerSideApplyConflictPolicy)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ServerSideApplyConflictPolicy")
 	proto.RegisterType((*SignatureKey)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SignatureKey")
 	proto.RegisterType((*SourceHydrator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SourceHydrator")
 	proto.RegisterType((*SourceHydratorStatus)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SourceHydratorStatus")
@@ -4926,6 +5243,7 @@ func init() {
 	proto.RegisterType((*SyncOperation)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncOperation")
 	proto.RegisterType((*SyncOperationResource)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncOperationResource")
 	proto.RegisterType((*SyncOperationResult)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncOperationResult")
+	proto.RegisterType((*SyncPause)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncPause")
 	proto.RegisterType((*SyncPolicy)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncPolicy")
 	proto.RegisterType((*SyncPolicyAutomated)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncPolicyAutomated")
 	proto.RegisterType((*SyncSource)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncSource")
@@ -4934,6 +5252,7 @@ func init() {
 	proto.RegisterType((*SyncStrategyApply)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncStrategyApply")
 	proto.RegisterType((*SyncStrategyHook)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncStrategyHook")
 	proto.RegisterType((*SyncWindow)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncWindow")
+	proto.RegisterType((*SyncWindowCalendar)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncWindowCalendar")
 	proto.RegisterType((*TLSClientConfig)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.TLSClientConfig")
 	proto.RegisterType((*TagFilter)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.TagFilter")
 }
@@ -4943,783 +5262,839 @@ func init() {
 }
 
 var fileDescriptor_c078c3c476799f44 = []byte{
-	// 12403 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x70, 0x24, 0x49,
-	0x56, 0xd8, 0x55, 0xb7, 0x5a, 0xea, 0x7e, 0xfa, 0x1a, 0xe5, 0xcc, 0xec, 0x6a, 0x66, 0x3f, 0x34,
-	0xd4, 0x1e, 0x7b, 0x87, 0x6f, 0x4f, 0x62, 0x67, 0x77, 0x8f, 0x35, 0x0b, 0x07, 0xfa, 0x98, 0x0f,
-	0xcd, 0x48, 0x23, 0x6d, 0xb6, 0x66, 0x86, 0xdb, 0x63, 0x6f, 0xaf, 0x54, 0x9d, 0x92, 0x6a, 0x54,
-	0x5d, 0xd5, 0x5b, 0x55, 0xad, 0x51, 0x2f, 0xcb, 0x71, 0xc7, 0xdd, 0xc1, 0xc1, 0x7d, 0xad, 0xc1,
-	0x61, 0x16, 0xdb, 0xe0, 0xc3, 0xe0, 0xaf, 0x70, 0x5c, 0x80, 0xcd, 0x0f, 0x13, 0x01, 0x04, 0xc1,
-	0x47, 0x10, 0xe0, 0x2f, 0x30, 0x81, 0x01, 0x1b, 0x90, 0xef, 0xc6, 0x76, 0x40, 0x38, 0xc2, 0x44,
-	0x80, 0xfd, 0xc3, 0x31, 0x76, 0x10, 0x8e, 0xfc, 0xce, 0xaa, 0xae, 0x96, 0x5a, 0xa3, 0xd2, 0xcc,
-	0x1c, 0xec, 0xbf, 0xee, 0x7c, 0xaf, 0xde, 0xcb, 0xca, 0xca, 0x7c, 0xef, 0xe5, 0xcb, 0xf7, 0x5e,
-	0xc2, 0xd2, 0xa6, 0x97, 0x6c, 0xb5, 0xd7, 0xa7, 0xdd, 0xb0, 0x39, 0xe3, 0x44, 0x9b, 0x61, 0x2b,
-	0x0a, 0x6f, 0xb1, 0x1f, 0xef, 0x77, 0x1b, 0x33, 0x3b, 0xcf, 0xcd, 0xb4, 0xb6, 0x37, 0x67, 0x9c,
-	0x96, 0x17, 0xcf, 0x38, 0xad, 0x96, 0xef, 0xb9, 0x4e, 0xe2, 0x85, 0xc1, 0xcc, 0xce, 0xb3, 0x8e,
-	0xdf, 0xda, 0x72, 0x9e, 0x9d, 0xd9, 0x24, 0x01, 0x89, 0x9c, 0x84, 0x34, 0xa6, 0x5b, 0x51, 0x98,
-	0x84, 0xe8, 0x5b, 0x34, 0xb5, 0x69, 0x49, 0x8d, 0xfd, 0x78, 0xcd, 0x6d, 0x4c, 0xef, 0x3c, 0x37,
-	0xdd, 0xda, 0xde, 0x9c, 0xa6, 0xd4, 0xa6, 0x0d, 0x6a, 0xd3, 0x92, 0xda, 0xd9, 0xf7, 0x1b, 0x7d,
-	0xd9, 0x0c, 0x37, 0xc3, 0x19, 0x46, 0x74, 0xbd, 0xbd, 0xc1, 0xfe, 0xb1, 0x3f, 0xec, 0x17, 0x67,
-	0x76, 0xd6, 0xde, 0x7e, 0x31, 0x9e, 0xf6, 0x42, 0xda, 0xbd, 0x19, 0x37, 0x8c, 0xc8, 0xcc, 0x4e,
-	0x57, 0x87, 0xce, 0x5e, 0xd6, 0x38, 0x64, 0x37, 0x21, 0x41, 0xec, 0x85, 0x41, 0xfc, 0x7e, 0xda,
-	0x05, 0x12, 0xed, 0x90, 0xc8, 0x7c, 0x3d, 0x03, 0x21, 0x8f, 0xd2, 0xf3, 0x9a, 0x52, 0xd3, 0x71,
-	0xb7, 0xbc, 0x80, 0x44, 0x1d, 0xfd, 0x78, 0x93, 0x24, 0x4e, 0xde, 0x53, 0x33, 0xbd, 0x9e, 0x8a,
-	0xda, 0x41, 0xe2, 0x35, 0x49, 0xd7, 0x03, 0x1f, 0x38, 0xe8, 0x81, 0xd8, 0xdd, 0x22, 0x4d, 0xa7,
-	0xeb, 0xb9, 0xe7, 0x7a, 0x3d, 0xd7, 0x4e, 0x3c, 0x7f, 0xc6, 0x0b, 0x92, 0x38, 0x89, 0xb2, 0x0f,
-	0xd9, 0x7f, 0xdf, 0x82, 0xd1, 0xd9, 0x9b, 0xf5, 0xd9, 0x76, 0xb2, 0x35, 0x1f, 0x06, 0x1b, 0xde,
-	0x26, 0x7a, 0x01, 0x86, 0x5d, 0xbf, 0x1d, 0x27, 0x24, 0xba, 0xe6, 0x34, 0xc9, 0xa4, 0x75, 0xce,
-	0x7a, 0x6f, 0x6d, 0xee, 0xe4, 0x6f, 0xec, 0x4d, 0xbd, 0xeb, 0xce, 0xde, 0xd4, 0xf0, 0xbc, 0x06,
-	0x61, 0x13, 0x0f, 0x7d, 0x03, 0x0c, 0x45, 0xa1, 0x4f, 0x66, 0xf1, 0xb5, 0xc9, 0x12, 0x7b, 0x64,
-	0x5c, 0x3c, 0x32, 0x84, 0x79, 0x33, 0x96, 0x70, 0x8a, 0xda, 0x8a, 0xc2, 0x0d, 0xcf, 0x27, 0x93,
-	0xe5, 0x34, 0xea, 0x2a, 0x6f, 0xc6, 0x12, 0x6e, 0xff, 0x68, 0x09, 0xc6, 0x67, 0x5b, 0xad, 0xcb,
-	0xc4, 0xf1, 0x93, 0xad, 0x7a, 0xe2, 0x24, 0xed, 0x18, 0x6d, 0xc2, 0x60, 0xcc, 0x7e, 0x89, 0xbe,
-	0xad, 0x88, 0xa7, 0x07, 0x39, 0xfc, 0xee, 0xde, 0xd4, 0xb7, 0xe6, 0xcd, 0xe8, 0x4d, 0x2f, 0x09,
-	0x5b, 0xf1, 0xfb, 0x49, 0xb0, 0xe9, 0x05, 0x84, 0x8d, 0xcb, 0x16, 0xa3, 0x3a, 0x6d, 0x12, 0x9f,
-	0x0f, 0x1b, 0x04, 0x0b, 0xf2, 0xb4, 0x9f, 0x4d, 0x12, 0xc7, 0xce, 0x26, 0xc9, 0xbe, 0xd2, 0x32,
-	0x6f, 0xc6, 0x12, 0x8e, 0x22, 0x40, 0xbe, 0x13, 0x27, 0x6b, 0x91, 0x13, 0xc4, 0x1e, 0x9d, 0xd2,
-	0x6b, 0x5e, 0x93, 0xbf, 0xdd, 0xf0, 0xf9, 0xbf, 0x31, 0xcd, 0x3f, 0xcc, 0xb4, 0xf9, 0x61, 0xf4,
-	0x3a, 0xa0, 0xf3, 0x66, 0x7a, 0xe7, 0xd9, 0x69, 0xfa, 0xc4, 0xdc, 0x23, 0x77, 0xf6, 0xa6, 0xd0,
-	0x52, 0x17, 0x25, 0x9c, 0x43, 0xdd, 0xfe, 0xbd, 0x12, 0xc0, 0x6c, 0xab, 0xb5, 0x1a, 0x85, 0xb7,
-	0x88, 0x9b, 0xa0, 0x8f, 0x42, 0x95, 0x92, 0x6a, 0x38, 0x89, 0xc3, 0x06, 0x66, 0xf8, 0xfc, 0x37,
-	0xf6, 0xc7, 0x78, 0x65, 0x9d, 0x3e, 0xbf, 0x4c, 0x12, 0x67, 0x0e, 0x89, 0x17, 0x04, 0xdd, 0x86,
-	0x15, 0x55, 0x14, 0xc0, 0x40, 0xdc, 0x22, 0x2e, 0x1b, 0x8c, 0xe1, 0xf3, 0x4b, 0xd3, 0x47, 0x59,
-	0xe9, 0xd3, 0xba, 0xe7, 0xf5, 0x16, 0x71, 0xe7, 0x46, 0x04, 0xe7, 0x01, 0xfa, 0x0f, 0x33, 0x3e,
-	0x68, 0x47, 0x7d, 0x68, 0x3e, 0x90, 0xd7, 0x0a, 0xe3, 0xc8, 0xa8, 0xce, 0x8d, 0xa5, 0x27, 0x8e,
-	0xfc, 0xee, 0xf6, 0x1f, 0x5b, 0x30, 0xa6, 0x91, 0x97, 0xbc, 0x38, 0x41, 0xdf, 0xd9, 0x35, 0xb8,
-	0xd3, 0xfd, 0x0d, 0x2e, 0x7d, 0x9a, 0x0d, 0xed, 0x09, 0xc1, 0xac, 0x2a, 0x5b, 0x8c, 0x81, 0x6d,
-	0x42, 0xc5, 0x4b, 0x48, 0x33, 0x9e, 0x2c, 0x9d, 0x2b, 0xbf, 0x77, 0xf8, 0xfc, 0xe5, 0xa2, 0xde,
-	0x73, 0x6e, 0x54, 0x30, 0xad, 0x2c, 0x52, 0xf2, 0x98, 0x73, 0xb1, 0xff, 0x62, 0xd4, 0x7c, 0x3f,
-	0x3a, 0xe0, 0xe8, 0x59, 0x18, 0x8e, 0xc3, 0x76, 0xe4, 0x12, 0x4c, 0x5a, 0x21, 0x5d, 0x58, 0x65,
-	0x3a, 0xdd, 0xe9, 0x82, 0xaf, 0xeb, 0x66, 0x6c, 0xe2, 0xa0, 0x2f, 0x58, 0x30, 0xd2, 0x20, 0x71,
-	0xe2, 0x05, 0x8c, 0xbf, 0xec, 0xfc, 0xda, 0x91, 0x3b, 0x2f, 0x1b, 0x17, 0x34, 0xf1, 0xb9, 0x53,
-	0xe2, 0x45, 0x46, 0x8c, 0xc6, 0x18, 0xa7, 0xf8, 0x53, 0xc1, 0xd5, 0x20, 0xb1, 0x1b, 0x79, 0x2d,
-	0xfa, 0x5f, 0x88, 0x16, 0x25, 0xb8, 0x16, 0x34, 0x08, 0x9b, 0x78, 0x28, 0x80, 0x0a, 0x15, 0x4c,
-	0xf1, 0xe4, 0x00, 0xeb, 0xff, 0xe2, 0xd1, 0xfa, 0x2f, 0x06, 0x95, 0xca, 0x3c, 0x3d, 0xfa, 0xf4,
-	0x5f, 0x8c, 0x39, 0x1b, 0xf4, 0x79, 0x0b, 0x26, 0x85, 0xe0, 0xc4, 0x84, 0x0f, 0xe8, 0xcd, 0x2d,
-	0x2f, 0x21, 0xbe, 0x17, 0x27, 0x93, 0x15, 0xd6, 0x87, 0x99, 0xfe, 0xe6, 0xd6, 0xa5, 0x28, 0x6c,
-	0xb7, 0xae, 0x7a, 0x41, 0x63, 0xee, 0x9c, 0xe0, 0x34, 0x39, 0xdf, 0x83, 0x30, 0xee, 0xc9, 0x12,
-	0xfd, 0xb0, 0x05, 0x67, 0x03, 0xa7, 0x49, 0xe2, 0x96, 0x43, 0x3f, 0x2d, 0x07, 0xcf, 0xf9, 0x8e,
-	0xbb, 0xcd, 0x7a, 0x34, 0x78, 0x6f, 0x3d, 0xb2, 0x45, 0x8f, 0xce, 0x5e, 0xeb, 0x49, 0x1a, 0xef,
-	0xc3, 0x16, 0xfd, 0xa4, 0x05, 0x13, 0x61, 0xd4, 0xda, 0x72, 0x02, 0xd2, 0x90, 0xd0, 0x78, 0x72,
-	0x88, 0x2d, 0xbd, 0x8f, 0x1c, 0xed, 0x13, 0xad, 0x64, 0xc9, 0x2e, 0x87, 0x81, 0x97, 0x84, 0x51,
-	0x9d, 0x24, 0x89, 0x17, 0x6c, 0xc6, 0x73, 0xa7, 0xef, 0xec, 0x4d, 0x4d, 0x74, 0x61, 0xe1, 0xee,
-	0xfe, 0xa0, 0xef, 0x82, 0xe1, 0xb8, 0x13, 0xb8, 0x37, 0xbd, 0xa0, 0x11, 0xde, 0x8e, 0x27, 0xab,
-	0x45, 0x2c, 0xdf, 0xba, 0x22, 0x28, 0x16, 0xa0, 0x66, 0x80, 0x4d, 0x6e, 0xf9, 0x1f, 0x4e, 0x4f,
-	0xa5, 0x5a, 0xd1, 0x1f, 0x4e, 0x4f, 0xa6, 0x7d, 0xd8, 0xa2, 0xef, 0xb7, 0x60, 0x34, 0xf6, 0x36,
-	0x03, 0x27, 0x69, 0x47, 0xe4, 0x2a, 0xe9, 0xc4, 0x93, 0xc0, 0x3a, 0x72, 0xe5, 0x88, 0xa3, 0x62,
-	0x90, 0x9c, 0x3b, 0x2d, 0xfa, 0x38, 0x6a, 0xb6, 0xc6, 0x38, 0xcd, 0x37, 0x6f, 0xa1, 0xe9, 0x69,
-	0x3d, 0x5c, 0xec, 0x42, 0xd3, 0x93, 0xba, 0x27, 0x4b, 0xf4, 0xed, 0x70, 0x82, 0x37, 0xa9, 0x91,
-	0x8d, 0x27, 0x47, 0x98, 0xa0, 0x3d, 0x75, 0x67, 0x6f, 0xea, 0x44, 0x3d, 0x03, 0xc3, 0x5d, 0xd8,
-	0xe8, 0x75, 0x98, 0x6a, 0x91, 0xa8, 0xe9, 0x25, 0x2b, 0x81, 0xdf, 0x91, 0xe2, 0xdb, 0x0d, 0x5b,
-	0xa4, 0x21, 0xba, 0x13, 0x4f, 0x8e, 0x9e, 0xb3, 0xde, 0x5b, 0x9d, 0x7b, 0x8f, 0xe8, 0xe6, 0xd4,
-	0xea, 0xfe, 0xe8, 0xf8, 0x20, 0x7a, 0xe8, 0xd7, 0x2d, 0x38, 0x6b, 0x48, 0xd9, 0x3a, 0x89, 0x76,
-	0x3c, 0x97, 0xcc, 0xba, 0x6e, 0xd8, 0x0e, 0x92, 0x78, 0x72, 0x8c, 0x0d, 0xe3, 0xfa, 0x71, 0xc8,
-	0xfc, 0x34, 0x2b, 0x3d, 0x2f, 0x7b, 0xa2, 0xc4, 0x78, 0x9f, 0x9e, 0xda, 0xbf, 0x59, 0x82, 0x13,
-	0x59, 0x0b, 0x00, 0xfd, 0x63, 0x0b, 0xc6, 0x6f, 0xdd, 0x4e, 0xd6, 0xc2, 0x6d, 0x12, 0xc4, 0x73,
-	0x1d, 0x2a, 0xa7, 0x99, 0xee, 0x1b, 0x3e, 0xef, 0x16, 0x6b, 0x6b, 0x4c, 0x5f, 0x49, 0x73, 0xb9,
-	0x10, 0x24, 0x51, 0x67, 0xee, 0x51, 0xf1, 0x4e, 0xe3, 0x57, 0x6e, 0xae, 0x99, 0x50, 0x9c, 0xed,
-	0xd4, 0xd9, 0xcf, 0x5a, 0x70, 0x2a, 0x8f, 0x04, 0x3a, 0x01, 0xe5, 0x6d, 0xd2, 0xe1, 0x96, 0x30,
-	0xa6, 0x3f, 0xd1, 0xab, 0x50, 0xd9, 0x71, 0xfc, 0x36, 0x11, 0x66, 0xda, 0xa5, 0xa3, 0xbd, 0x88,
-	0xea, 0x19, 0xe6, 0x54, 0xbf, 0xb9, 0xf4, 0xa2, 0x65, 0xff, 0x56, 0x19, 0x86, 0x8d, 0x8f, 0x76,
-	0x1f, 0x4c, 0xcf, 0x30, 0x65, 0x7a, 0x2e, 0x17, 0x36, 0xdf, 0x7a, 0xda, 0x9e, 0xb7, 0x33, 0xb6,
-	0xe7, 0x4a, 0x71, 0x2c, 0xf7, 0x35, 0x3e, 0x51, 0x02, 0xb5, 0xb0, 0x45, 0xb7, 0x68, 0xd4, 0x86,
-	0x19, 0x28, 0xe2, 0x13, 0xae, 0x48, 0x72, 0x73, 0xa3, 0x77, 0xf6, 0xa6, 0x6a, 0xea, 0x2f, 0xd6,
-	0x8c, 0xec, 0xdf, 0xb7, 0xe0, 0x94, 0xd1, 0xc7, 0xf9, 0x30, 0x68, 0xb0, 0x8d, 0x06, 0x3a, 0x07,
-	0x03, 0x49, 0xa7, 0x25, 0xb7, 0x81, 0x6a, 0xa4, 0xd6, 0x3a, 0x2d, 0x82, 0x19, 0xe4, 0x61, 0xdf,
-	0x25, 0xfd, 0xb0, 0x05, 0x8f, 0xe4, 0x0b, 0x18, 0xf4, 0x34, 0x0c, 0x72, 0x1f, 0x80, 0x78, 0x3b,
-	0xfd, 0x49, 0x58, 0x2b, 0x16, 0x50, 0x34, 0x03, 0x35, 0xa5, 0xf0, 0xc4, 0x3b, 0x4e, 0x08, 0xd4,
-	0x9a, 0xd6, 0x92, 0x1a, 0x87, 0x0e, 0x1a, 0xfd, 0x23, 0x4c, 0x50, 0x35, 0x68, 0x6c, 0xd3, 0xcc,
-	0x20, 0xf6, 0xef, 0x5a, 0xf0, 0xee, 0x7e, 0xc4, 0xde, 0xf1, 0xf5, 0xb1, 0x0e, 0xa7, 0x1b, 0x64,
-	0xc3, 0x69, 0xfb, 0x49, 0x9a, 0xa3, 0xe8, 0xf4, 0x13, 0xe2, 0xe1, 0xd3, 0x0b, 0x79, 0x48, 0x38,
-	0xff, 0x59, 0xfb, 0xbf, 0x58, 0x6c, 0xbb, 0x2e, 0x5f, 0xeb, 0x3e, 0x6c, 0x9d, 0x82, 0xf4, 0xd6,
-	0x69, 0xb1, 0xb0, 0x65, 0xda, 0x63, 0xef, 0xf4, 0x79, 0x0b, 0xce, 0x1a, 0x58, 0xcb, 0x4e, 0xe2,
-	0x6e, 0x5d, 0xd8, 0x6d, 0x45, 0x24, 0x8e, 0xe9, 0x94, 0x7a, 0xc2, 0x10, 0xc7, 0x73, 0xc3, 0x82,
-	0x42, 0xf9, 0x2a, 0xe9, 0x70, 0xd9, 0xfc, 0x0c, 0x54, 0xf9, 0x9a, 0x0b, 0x23, 0xf1, 0x91, 0xd4,
-	0xbb, 0xad, 0x88, 0x76, 0xac, 0x30, 0x90, 0x0d, 0x83, 0x4c, 0xe6, 0x52, 0x19, 0x44, 0xcd, 0x04,
-	0xa0, 0xdf, 0xfd, 0x06, 0x6b, 0xc1, 0x02, 0x62, 0xc7, 0xa9, 0xee, 0xac, 0x46, 0x84, 0xcd, 0x87,
-	0xc6, 0x45, 0x8f, 0xf8, 0x8d, 0x98, 0x6e, 0xeb, 0x9c, 0x20, 0x08, 0x13, 0xb1, 0x43, 0x33, 0xb6,
-	0x75, 0xb3, 0xba, 0x19, 0x9b, 0x38, 0x94, 0xa9, 0xef, 0xac, 0x13, 0x9f, 0x8f, 0xa8, 0x60, 0xba,
-	0xc4, 0x5a, 0xb0, 0x80, 0xd8, 0x77, 0x4a, 0x6c, 0x03, 0xa9, 0x24, 0x1a, 0xb9, 0x1f, 0xde, 0x87,
-	0x28, 0xa5, 0x02, 0x56, 0x8b, 0x93, 0xc7, 0xa4, 0xb7, 0x07, 0xe2, 0x8d, 0x8c, 0x16, 0xc0, 0x85,
-	0x72, 0xdd, 0xdf, 0x0b, 0xf1, 0xf1, 0x32, 0x4c, 0xa5, 0x1f, 0xe8, 0x52, 0x22, 0x74, 0xcb, 0x6b,
-	0x30, 0xca, 0xfa, 0xea, 0x0c, 0x7c, 0x6c, 0xe2, 0xf5, 0x90, 0xc3, 0xa5, 0xe3, 0x94, 0xc3, 0xa6,
-	0x9a, 0x28, 0x1f, 0xa0, 0x26, 0x9e, 0x56, 0xa3, 0x3e, 0x90, 0x91, 0x79, 0x69, 0x55, 0x79, 0x0e,
-	0x06, 0xe2, 0x84, 0xb4, 0x26, 0x2b, 0x69, 0x31, 0x5b, 0x4f, 0x48, 0x0b, 0x33, 0x08, 0xfa, 0x56,
-	0x18, 0x4f, 0x9c, 0x68, 0x93, 0x24, 0x11, 0xd9, 0xf1, 0x98, 0x5f, 0x97, 0xed, 0x67, 0x6b, 0x73,
-	0x27, 0xa9, 0xd5, 0xb5, 0xc6, 0x40, 0x58, 0x82, 0x70, 0x16, 0xd7, 0xfe, 0x1f, 0x25, 0x78, 0x34,
-	0xfd, 0x09, 0xb4, 0x62, 0xfc, 0xb6, 0x94, 0x62, 0x7c, 0x9f, 0xa9, 0x18, 0xef, 0xee, 0x4d, 0x3d,
-	0xd6, 0xe3, 0xb1, 0xaf, 0x19, 0xbd, 0x89, 0x2e, 0x65, 0x3e, 0xc2, 0x4c, 0x97, 0x97, 0xf5, 0x89,
-	0x1e, 0xef, 0x98, 0xf9, 0x4a, 0x4f, 0xc3, 0x60, 0x44, 0x9c, 0x38, 0x0c, 0xc4, 0x77, 0x52, 0x5f,
-	0x13, 0xb3, 0x56, 0x2c, 0xa0, 0xf6, 0xef, 0xd4, 0xb2, 0x83, 0x7d, 0x89, 0xfb, 0xaa, 0xc3, 0x08,
-	0x79, 0x30, 0xc0, 0x76, 0x6d, 0x5c, 0xb2, 0x5c, 0x3d, 0xda, 0x2a, 0xa4, 0x5a, 0x44, 0x91, 0x9e,
-	0xab, 0xd2, 0xaf, 0x46, 0x9b, 0x30, 0x63, 0x81, 0x76, 0xa1, 0xea, 0xca, 0xcd, 0x54, 0xa9, 0x08,
-	0xb7, 0xa3, 0xd8, 0x4a, 0x69, 0x8e, 0x23, 0x54, 0xdc, 0xab, 0x1d, 0x98, 0xe2, 0x86, 0x08, 0x94,
-	0x37, 0xbd, 0x44, 0x7c, 0xd6, 0x23, 0x6e, 0x97, 0x2f, 0x79, 0xc6, 0x2b, 0x0e, 0x51, 0x1d, 0x74,
-	0xc9, 0x4b, 0x30, 0xa5, 0x8f, 0x3e, 0x6d, 0xc1, 0x70, 0xec, 0x36, 0x57, 0xa3, 0x70, 0xc7, 0x6b,
-	0x90, 0x48, 0xd8, 0x98, 0x47, 0x94, 0x6c, 0xf5, 0xf9, 0x65, 0x49, 0x50, 0xf3, 0xe5, 0xee, 0x0b,
-	0x0d, 0xc1, 0x26, 0x5f, 0xba, 0xf7, 0x7a, 0x54, 0xbc, 0xfb, 0x02, 0x71, 0xd9, 0x8a, 0x93, 0x7b,
-	0x66, 0x36, 0x53, 0x8e, 0x6c, 0x73, 0x2f, 0xb4, 0xdd, 0x6d, 0xba, 0xde, 0x74, 0x87, 0x1e, 0xbb,
-	0xb3, 0x37, 0xf5, 0xe8, 0x7c, 0x3e, 0x4f, 0xdc, 0xab, 0x33, 0x6c, 0xc0, 0x5a, 0x6d, 0xdf, 0xc7,
-	0xe4, 0xf5, 0x36, 0x61, 0x1e, 0xb1, 0x02, 0x06, 0x6c, 0x55, 0x13, 0xcc, 0x0c, 0x98, 0x01, 0xc1,
-	0x26, 0x5f, 0xf4, 0x3a, 0x0c, 0x36, 0x9d, 0x24, 0xf2, 0x76, 0x85, 0x1b, 0xec, 0x88, 0xbb, 0xa0,
-	0x65, 0x46, 0x4b, 0x33, 0x67, 0x8a, 0x9e, 0x37, 0x62, 0xc1, 0x08, 0x35, 0xa1, 0xd2, 0x24, 0xd1,
-	0x26, 0x99, 0xac, 0x16, 0xe1, 0xf2, 0x5f, 0xa6, 0xa4, 0x34, 0xc3, 0x1a, 0x35, 0xae, 0x58, 0x1b,
-	0xe6, 0x5c, 0xd0, 0xab, 0x50, 0x8d, 0x89, 0x4f, 0x5c, 0x6a, 0x1e, 0xd5, 0x18, 0xc7, 0xe7, 0xfa,
-	0x34, 0x15, 0xa9, 0x5d, 0x52, 0x17, 0x8f, 0xf2, 0x05, 0x26, 0xff, 0x61, 0x45, 0x92, 0x0e, 0x60,
-	0xcb, 0x6f, 0x6f, 0x7a, 0xc1, 0x24, 0x14, 0x31, 0x80, 0xab, 0x8c, 0x56, 0x66, 0x00, 0x79, 0x23,
-	0x16, 0x8c, 0xec, 0xff, 0x6e, 0x01, 0x4a, 0x0b, 0xb5, 0xfb, 0x60, 0x13, 0xbf, 0x9e, 0xb6, 0x89,
-	0x97, 0x8a, 0x34, 0x5a, 0x7a, 0x98, 0xc5, 0x3f, 0x5f, 0x83, 0x8c, 0x3a, 0xb8, 0x46, 0xe2, 0x84,
-	0x34, 0xde, 0x11, 0xe1, 0xef, 0x88, 0xf0, 0x77, 0x44, 0xb8, 0x12, 0xe1, 0xeb, 0x19, 0x11, 0xfe,
-	0x41, 0x63, 0xd5, 0xeb, 0xd8, 0x83, 0xd7, 0x54, 0x70, 0x82, 0xd9, 0x03, 0x03, 0x81, 0x4a, 0x82,
-	0x2b, 0xf5, 0x95, 0x6b, 0xb9, 0x32, 0xfb, 0xb5, 0xb4, 0xcc, 0x3e, 0x2a, 0x8b, 0xbf, 0x0e, 0x52,
-	0xfa, 0xd7, 0x2d, 0x78, 0x4f, 0x5a, 0x7a, 0xc9, 0x99, 0xb3, 0xb8, 0x19, 0x84, 0x11, 0x59, 0xf0,
-	0x36, 0x36, 0x48, 0x44, 0x02, 0x97, 0xc4, 0xca, 0xb7, 0x63, 0xf5, 0xf2, 0xed, 0xa0, 0xe7, 0x61,
-	0xe4, 0x56, 0x1c, 0x06, 0xab, 0xa1, 0x17, 0x08, 0x11, 0x44, 0x77, 0x1c, 0x27, 0xee, 0xec, 0x4d,
-	0x8d, 0xd0, 0x11, 0x95, 0xed, 0x38, 0x85, 0x85, 0xe6, 0x61, 0xe2, 0xd6, 0xeb, 0xab, 0x4e, 0x62,
-	0x78, 0x13, 0xe4, 0xbe, 0x9f, 0x9d, 0x47, 0x5d, 0x79, 0x39, 0x03, 0xc4, 0xdd, 0xf8, 0xf6, 0xdf,
-	0x2b, 0xc1, 0x99, 0xcc, 0x8b, 0x84, 0xbe, 0x1f, 0xb6, 0x13, 0xba, 0x27, 0x42, 0x3f, 0x6e, 0xc1,
-	0x89, 0x66, 0xda, 0x61, 0x11, 0x0b, 0x77, 0xf7, 0x77, 0x14, 0xa6, 0x23, 0x32, 0x1e, 0x91, 0xb9,
-	0x49, 0x31, 0x42, 0x27, 0x32, 0x80, 0x18, 0x77, 0xf5, 0x05, 0xbd, 0x0a, 0xb5, 0xa6, 0xb3, 0x7b,
-	0xbd, 0xd5, 0x70, 0x12, 0xb9, 0x1d, 0xed, 0xed, 0x45, 0x68, 0x27, 0x9e, 0x3f, 0xcd, 0xa3, 0x5a,
-	0xa6, 0x17, 0x83, 0x64, 0x25, 0xaa, 0x27, 0x91, 0x17, 0x6c, 0x72, 0x27, 0xe7, 0xb2, 0x24, 0x83,
-	0x35, 0x45, 0xfb, 0xc7, 0xac, 0xac, 0x92, 0x52, 0xa3, 0x13, 0x39, 0x09, 0xd9, 0xec, 0xa0, 0x37,
-	0xa1, 0x42, 0xf7, 0x8d, 0x72, 0x54, 0x6e, 0x16, 0xa9, 0x39, 0x8d, 0x2f, 0xa1, 0x95, 0x28, 0xfd,
-	0x17, 0x63, 0xce, 0xd4, 0xfe, 0xf1, 0x5a, 0xd6, 0x58, 0x60, 0x67, 0xf3, 0xe7, 0x01, 0x36, 0xc3,
-	0x35, 0xd2, 0x6c, 0xf9, 0x74, 0x58, 0x2c, 0x76, 0xc0, 0xa3, 0x5c, 0x25, 0x97, 0x14, 0x04, 0x1b,
-	0x58, 0xe8, 0x07, 0x2c, 0x80, 0x4d, 0x39, 0xe7, 0xa5, 0x21, 0x70, 0xbd, 0xc8, 0xd7, 0xd1, 0x2b,
-	0x4a, 0xf7, 0x45, 0x31, 0xc4, 0x06, 0x73, 0xf4, 0xbd, 0x16, 0x54, 0x13, 0xd9, 0x7d, 0xae, 0x1a,
-	0xd7, 0x8a, 0xec, 0x89, 0x7c, 0x69, 0x6d, 0x13, 0xa9, 0x21, 0x51, 0x7c, 0xd1, 0xf7, 0x59, 0x00,
-	0x71, 0x27, 0x70, 0x57, 0x43, 0xdf, 0x73, 0x3b, 0x42, 0x63, 0xde, 0x28, 0xd4, 0x9d, 0xa3, 0xa8,
-	0xcf, 0x8d, 0xd1, 0xd1, 0xd0, 0xff, 0xb1, 0xc1, 0x19, 0x7d, 0x0c, 0xaa, 0xb1, 0x98, 0x6e, 0x42,
-	0x47, 0xae, 0x15, 0xeb, 0x54, 0xe2, 0xb4, 0x85, 0x78, 0x15, 0xff, 0xb0, 0xe2, 0x89, 0x7e, 0xc4,
-	0x82, 0xf1, 0x56, 0xda, 0x4d, 0x28, 0xd4, 0x61, 0x71, 0x32, 0x20, 0xe3, 0x86, 0xe4, 0xde, 0x96,
-	0x4c, 0x23, 0xce, 0xf6, 0x82, 0x4a, 0x40, 0x3d, 0x83, 0x57, 0x5a, 0xdc, 0x65, 0x39, 0xa4, 0x25,
-	0xe0, 0xa5, 0x2c, 0x10, 0x77, 0xe3, 0xa3, 0x55, 0x38, 0x45, 0x7b, 0xd7, 0xe1, 0xe6, 0xa7, 0x54,
-	0x2f, 0x31, 0x53, 0x86, 0xd5, 0xb9, 0xc7, 0xc5, 0x0c, 0x61, 0x67, 0x1d, 0x59, 0x1c, 0x9c, 0xfb,
-	0x24, 0xfa, 0x2d, 0x0b, 0x1e, 0xf7, 0x98, 0x1a, 0x30, 0x1d, 0xf6, 0x5a, 0x23, 0x88, 0x83, 0x76,
-	0x52, 0xa8, 0xac, 0xe8, 0xa5, 0x7e, 0xe6, 0xde, 0x2d, 0xde, 0xe0, 0xf1, 0xc5, 0x7d, 0xba, 0x84,
-	0xf7, 0xed, 0x30, 0xfa, 0x26, 0x18, 0x95, 0xeb, 0x62, 0x95, 0x8a, 0x60, 0xa6, 0x68, 0x6b, 0x73,
-	0x13, 0x77, 0xf6, 0xa6, 0x46, 0xd7, 0x4c, 0x00, 0x4e, 0xe3, 0xd9, 0xff, 0xba, 0x9c, 0x3a, 0x25,
-	0x52, 0x3e, 0x4c, 0x26, 0x6e, 0x5c, 0xe9, 0xff, 0x91, 0xd2, 0xb3, 0x50, 0x71, 0xa3, 0xbc, 0x4b,
-	0x5a, 0xdc, 0xa8, 0xa6, 0x18, 0x1b, 0xcc, 0xa9, 0x51, 0x3a, 0xe1, 0x64, 0x3d, 0xa5, 0x42, 0x02,
-	0xbe, 0x5a, 0x64, 0x97, 0xba, 0xcf, 0xf4, 0xce, 0x88, 0xae, 0x4d, 0x74, 0x81, 0x70, 0x77, 0x97,
-	0xd0, 0x77, 0x43, 0x2d, 0x52, 0x91, 0x2d, 0xe5, 0x22, 0xb6, 0x6a, 0x72, 0xda, 0x88, 0xee, 0xa8,
-	0x03, 0x20, 0x1d, 0xc3, 0xa2, 0x39, 0xda, 0x9f, 0x29, 0xa5, 0x0e, 0xc6, 0x0c, 0xd9, 0xd1, 0xc7,
-	0xa1, 0xdf, 0x17, 0x2c, 0x18, 0x8e, 0x42, 0xdf, 0xf7, 0x82, 0x4d, 0x2a, 0xe7, 0x84, 0xb2, 0xfe,
-	0xf0, 0xb1, 0xe8, 0x4b, 0x21, 0xd0, 0x98, 0x65, 0x8d, 0x35, 0x4f, 0x6c, 0x76, 0x00, 0xbd, 0x04,
-	0xa3, 0x0d, 0xe2, 0x13, 0xfa, 0xec, 0x4a, 0x44, 0xf7, 0x44, 0xdc, 0xc9, 0xac, 0x22, 0x45, 0x16,
-	0x4c, 0x20, 0x4e, 0xe3, 0xda, 0x7f, 0x6c, 0xc1, 0x64, 0x2f, 0x61, 0x8e, 0x08, 0x3c, 0x26, 0x25,
-	0x95, 0x1a, 0xc7, 0x95, 0x40, 0xd2, 0x13, 0xfa, 0xf8, 0x29, 0xc1, 0xe7, 0xb1, 0xd5, 0xde, 0xa8,
-	0x78, 0x3f, 0x3a, 0xe8, 0x15, 0x38, 0x61, 0x0c, 0x4a, 0xac, 0x46, 0xb5, 0x36, 0x37, 0x4d, 0xad,
-	0xa7, 0xd9, 0x0c, 0xec, 0xee, 0xde, 0xd4, 0x23, 0xd9, 0x36, 0xa1, 0x6d, 0xba, 0xe8, 0xd8, 0x3f,
-	0xd5, 0xf5, 0xa9, 0x95, 0xa1, 0xf0, 0xb6, 0xd5, 0xe5, 0x8a, 0xf8, 0x8e, 0xe3, 0x50, 0xce, 0xcc,
-	0x69, 0xa1, 0x62, 0x38, 0x7a, 0xe3, 0x3c, 0xc0, 0x33, 0x7f, 0xfb, 0xdf, 0x0e, 0xc0, 0x3e, 0x3d,
-	0xeb, 0xc3, 0xf2, 0x3f, 0xf4, 0x21, 0xec, 0xe7, 0x2c, 0x75, 0xda, 0xc6, 0x05, 0x40, 0xe3, 0xb8,
-	0xc6, 0x9e, 0x6f, 0xbe, 0x62, 0x1e, 0x77, 0xa2, 0x5c, 0xf0, 0xe9, 0x73, 0x3d, 0xf4, 0x25, 0x2b,
-	0x7d, 0x5e, 0xc8, 0x23, 0x22, 0xbd, 0x63, 0xeb, 0x93, 0x71, 0x08, 0xc9, 0x3b, 0xa6, 0x8f, 0xae,
-	0x7a, 0x1d, 0x4f, 0x4e, 0x03, 0x6c, 0x78, 0x81, 0xe3, 0x7b, 0x6f, 0xd0, 0xad, 0x55, 0x85, 0x59,
-	0x07, 0xcc, 0xdc, 0xba, 0xa8, 0x5a, 0xb1, 0x81, 0x71, 0xf6, 0x6f, 0xc2, 0xb0, 0xf1, 0xe6, 0x39,
-	0xe1, 0x32, 0xa7, 0xcc, 0x70, 0x99, 0x9a, 0x11, 0xe5, 0x72, 0xf6, 0x83, 0x70, 0x22, 0xdb, 0xc1,
-	0xc3, 0x3c, 0x6f, 0xff, 0x9f, 0xa1, 0xec, 0x01, 0xde, 0x1a, 0x89, 0x9a, 0xb4, 0x6b, 0xef, 0x78,
-	0xc5, 0xde, 0xf1, 0x8a, 0xbd, 0xe3, 0x15, 0x33, 0x0f, 0x36, 0x84, 0xc7, 0x67, 0xe8, 0x3e, 0x79,
-	0x7c, 0x52, 0x3e, 0xac, 0x6a, 0xe1, 0x3e, 0x2c, 0xfb, 0xd3, 0x5d, 0x6e, 0xff, 0xb5, 0x88, 0x10,
-	0x14, 0x42, 0x25, 0x08, 0x1b, 0x44, 0x1a, 0xc8, 0x57, 0x8a, 0xb1, 0xf6, 0xae, 0x85, 0x0d, 0x23,
-	0xd6, 0x9c, 0xfe, 0x8b, 0x31, 0xe7, 0x63, 0x7f, 0x6a, 0x10, 0x52, 0xb6, 0x28, 0xff, 0xee, 0xdf,
-	0x00, 0x43, 0x11, 0x69, 0x85, 0xd7, 0xf1, 0x92, 0xd0, 0x65, 0x3a, 0x55, 0x87, 0x37, 0x63, 0x09,
-	0xa7, 0x3a, 0xaf, 0xe5, 0x24, 0x5b, 0x42, 0x99, 0x29, 0x9d, 0xb7, 0xea, 0x24, 0x5b, 0x98, 0x41,
-	0xd0, 0x07, 0x61, 0x2c, 0x49, 0x9d, 0xa3, 0x8b, 0xf3, 0xe2, 0x47, 0x04, 0xee, 0x58, 0xfa, 0x94,
-	0x1d, 0x67, 0xb0, 0xd1, 0xeb, 0x30, 0xb0, 0x45, 0xfc, 0xa6, 0xf8, 0xf4, 0xf5, 0xe2, 0x74, 0x0d,
-	0x7b, 0xd7, 0xcb, 0xc4, 0x6f, 0x72, 0x49, 0x48, 0x7f, 0x61, 0xc6, 0x8a, 0xce, 0xfb, 0xda, 0x76,
-	0x3b, 0x4e, 0xc2, 0xa6, 0xf7, 0x86, 0x74, 0x93, 0x7e, 0x47, 0xc1, 0x8c, 0xaf, 0x4a, 0xfa, 0xdc,
-	0x1f, 0xa5, 0xfe, 0x62, 0xcd, 0x99, 0xf5, 0xa3, 0xe1, 0x45, 0x6c, 0xca, 0x74, 0x84, 0xb7, 0xb3,
-	0xe8, 0x7e, 0x2c, 0x48, 0xfa, 0xbc, 0x1f, 0xea, 0x2f, 0xd6, 0x9c, 0x51, 0x47, 0xad, 0xbf, 0x61,
-	0xd6, 0x87, 0xeb, 0x05, 0xf7, 0x81, 0xaf, 0xbd, 0xdc, 0x75, 0xf8, 0x14, 0x54, 0xdc, 0x2d, 0x27,
-	0x4a, 0x26, 0x47, 0xd8, 0xa4, 0x51, 0xb3, 0x78, 0x9e, 0x36, 0x62, 0x0e, 0x43, 0x4f, 0x40, 0x39,
-	0x22, 0x1b, 0x2c, 0xb4, 0xd9, 0x08, 0xaa, 0xc2, 0x64, 0x03, 0xd3, 0x76, 0x65, 0x97, 0x8d, 0xf5,
-	0x8c, 0xb6, 0xfb, 0x89, 0x52, 0xda, 0xb0, 0x4b, 0x8f, 0x0c, 0x5f, 0x0f, 0x6e, 0x3b, 0x8a, 0xa5,
-	0x77, 0xcd, 0x58, 0x0f, 0xac, 0x19, 0x4b, 0x38, 0xfa, 0x84, 0x05, 0x43, 0xb7, 0xe2, 0x30, 0x08,
-	0x48, 0x22, 0x94, 0xe8, 0x8d, 0x82, 0x07, 0xeb, 0x0a, 0xa7, 0xae, 0xfb, 0x20, 0x1a, 0xb0, 0xe4,
-	0x4b, 0xbb, 0x4b, 0x76, 0x5d, 0xbf, 0xdd, 0xe8, 0x8a, 0xa4, 0xb9, 0xc0, 0x9b, 0xb1, 0x84, 0x53,
-	0x54, 0x2f, 0xe0, 0xa8, 0x03, 0x69, 0xd4, 0xc5, 0x40, 0xa0, 0x0a, 0xb8, 0xfd, 0xb3, 0x55, 0x38,
-	0x9d, 0xbb, 0x7c, 0xa8, 0xc9, 0xc5, 0x8c, 0x9a, 0x8b, 0x9e, 0x4f, 0x64, 0x0c, 0x19, 0x33, 0xb9,
-	0x6e, 0xa8, 0x56, 0x6c, 0x60, 0xa0, 0xef, 0x01, 0x68, 0x39, 0x91, 0xd3, 0x24, 0xca, 0xfb, 0x7d,
-	0x64, 0xcb, 0x86, 0xf6, 0x63, 0x55, 0xd2, 0xd4, 0x1e, 0x00, 0xd5, 0x14, 0x63, 0x83, 0x25, 0x7a,
-	0x01, 0x86, 0x23, 0xe2, 0x13, 0x27, 0x66, 0xb1, 0xf3, 0xd9, 0x44, 0x20, 0xac, 0x41, 0xd8, 0xc4,
-	0x43, 0x4f, 0xab, 0x70, 0xbb, 0x4c, 0xd8, 0x51, 0x3a, 0xe4, 0x0e, 0x7d, 0xd1, 0x82, 0xb1, 0x0d,
-	0xcf, 0x27, 0x9a, 0xbb, 0x48, 0xdb, 0x59, 0x39, 0xfa, 0x4b, 0x5e, 0x34, 0xe9, 0x6a, 0x19, 0x9a,
-	0x6a, 0x8e, 0x71, 0x86, 0x3d, 0xfd, 0xcc, 0x3b, 0x24, 0x62, 0xc2, 0x77, 0x30, 0xfd, 0x99, 0x6f,
-	0xf0, 0x66, 0x2c, 0xe1, 0x68, 0x16, 0xc6, 0x5b, 0x4e, 0x1c, 0xcf, 0x47, 0xa4, 0x41, 0x82, 0xc4,
-	0x73, 0x7c, 0x9e, 0x54, 0x53, 0xd5, 0xb1, 0xe8, 0xab, 0x69, 0x30, 0xce, 0xe2, 0xa3, 0x0f, 0xc1,
-	0xa3, 0xdc, 0xbd, 0xb4, 0xec, 0xc5, 0xb1, 0x17, 0x6c, 0xea, 0x69, 0x20, 0xbc, 0x6c, 0x53, 0x82,
-	0xd4, 0xa3, 0x8b, 0xf9, 0x68, 0xb8, 0xd7, 0xf3, 0xe8, 0x19, 0xa8, 0xc6, 0xdb, 0x5e, 0x6b, 0x3e,
-	0x6a, 0xc4, 0xec, 0x68, 0xa9, 0xaa, 0x7d, 0xba, 0x75, 0xd1, 0x8e, 0x15, 0x06, 0x72, 0x61, 0x84,
-	0x7f, 0x12, 0x1e, 0x2f, 0x28, 0x24, 0xe8, 0xfb, 0x7b, 0x2a, 0x72, 0x91, 0x3f, 0x3b, 0x8d, 0x9d,
-	0xdb, 0x17, 0xe4, 0x41, 0x17, 0x3f, 0x97, 0xb9, 0x61, 0x90, 0xc1, 0x29, 0xa2, 0xe9, 0x3d, 0xdd,
-	0x70, 0x1f, 0x7b, 0xba, 0x17, 0x60, 0x78, 0xbb, 0xbd, 0x4e, 0xc4, 0xc8, 0x0b, 0xc1, 0xa6, 0x66,
-	0xdf, 0x55, 0x0d, 0xc2, 0x26, 0x1e, 0x0b, 0xd5, 0x6c, 0x79, 0xe2, 0x5f, 0x3c, 0x39, 0x6a, 0x84,
-	0x6a, 0xae, 0x2e, 0xca, 0x66, 0x6c, 0xe2, 0xd0, 0xae, 0xd1, 0xb1, 0x58, 0x23, 0x31, 0xcb, 0xc4,
-	0xa0, 0xc3, 0xa5, 0xba, 0x56, 0x97, 0x00, 0xac, 0x71, 0xd0, 0x2a, 0x9c, 0xa2, 0x7f, 0xea, 0x2c,
-	0x7f, 0xf8, 0x86, 0xe3, 0x7b, 0x0d, 0x1e, 0x37, 0x38, 0x9e, 0x76, 0x8e, 0xd6, 0x73, 0x70, 0x70,
-	0xee, 0x93, 0xf6, 0x8f, 0x96, 0xd2, 0x9e, 0x13, 0x53, 0x84, 0xa1, 0x98, 0x0a, 0xaa, 0xe4, 0x86,
-	0x13, 0x49, 0x83, 0xe7, 0x88, 0x99, 0x51, 0x82, 0xee, 0x0d, 0x27, 0x32, 0x45, 0x1e, 0x63, 0x80,
-	0x25, 0x27, 0x74, 0x0b, 0x06, 0x12, 0xdf, 0x29, 0x28, 0x95, 0xd2, 0xe0, 0xa8, 0xbd, 0x60, 0x4b,
-	0xb3, 0x31, 0x66, 0x3c, 0xd0, 0xe3, 0x74, 0xf7, 0xb6, 0x2e, 0x8f, 0xe9, 0xc4, 0x86, 0x6b, 0x3d,
-	0xc6, 0xac, 0xd5, 0xfe, 0xdb, 0xa3, 0x39, 0x5a, 0x47, 0x19, 0x02, 0xe8, 0x3c, 0x00, 0x9d, 0x34,
-	0xab, 0x11, 0xd9, 0xf0, 0x76, 0x85, 0x21, 0xa6, 0x24, 0xdb, 0x35, 0x05, 0xc1, 0x06, 0x96, 0x7c,
-	0xa6, 0xde, 0xde, 0xa0, 0xcf, 0x94, 0xba, 0x9f, 0xe1, 0x10, 0x6c, 0x60, 0xa1, 0xe7, 0x61, 0xd0,
-	0x6b, 0x3a, 0x9b, 0x2a, 0x8a, 0xf8, 0x71, 0x2a, 0xd2, 0x16, 0x59, 0xcb, 0xdd, 0xbd, 0xa9, 0x31,
-	0xd5, 0x21, 0xd6, 0x84, 0x05, 0x2e, 0xfa, 0x29, 0x0b, 0x46, 0xdc, 0xb0, 0xd9, 0x0c, 0x03, 0xbe,
-	0x7d, 0x16, 0xbe, 0x80, 0x5b, 0xc7, 0x65, 0x26, 0x4d, 0xcf, 0x1b, 0xcc, 0xb8, 0x33, 0x40, 0xe5,
-	0x7c, 0x9a, 0x20, 0x9c, 0xea, 0x95, 0x29, 0xf9, 0x2a, 0x07, 0x48, 0xbe, 0x9f, 0xb3, 0x60, 0x82,
-	0x3f, 0x6b, 0xec, 0xea, 0x45, 0x7a, 0x63, 0x78, 0xcc, 0xaf, 0xd5, 0xe5, 0xe8, 0x50, 0x9e, 0xe2,
-	0x2e, 0x38, 0xee, 0xee, 0x24, 0xba, 0x04, 0x13, 0x1b, 0x61, 0xe4, 0x12, 0x73, 0x20, 0x84, 0xd8,
-	0x56, 0x84, 0x2e, 0x66, 0x11, 0x70, 0xf7, 0x33, 0xe8, 0x06, 0x3c, 0x62, 0x34, 0x9a, 0xe3, 0xc0,
-	0x25, 0xf7, 0x93, 0x82, 0xda, 0x23, 0x17, 0x73, 0xb1, 0x70, 0x8f, 0xa7, 0xd3, 0x42, 0xb2, 0xd6,
-	0x87, 0x90, 0x7c, 0x0d, 0xce, 0xb8, 0xdd, 0x23, 0xb3, 0x13, 0xb7, 0xd7, 0x63, 0x2e, 0xc7, 0xab,
-	0x73, 0x5f, 0x27, 0x08, 0x9c, 0x99, 0xef, 0x85, 0x88, 0x7b, 0xd3, 0x40, 0x6f, 0x42, 0x35, 0x22,
-	0xec, 0xab, 0xc4, 0x22, 0xd7, 0xef, 0x88, 0xde, 0x0e, 0x6d, 0xc1, 0x73, 0xb2, 0x5a, 0x33, 0x89,
-	0x86, 0x18, 0x2b, 0x8e, 0xe8, 0x36, 0x0c, 0xb5, 0x9c, 0xc4, 0xdd, 0x12, 0x19, 0x7e, 0x47, 0x76,
-	0xec, 0x2b, 0xe6, 0xec, 0x1c, 0xc6, 0xa8, 0x97, 0xc0, 0x99, 0x60, 0xc9, 0x8d, 0xda, 0x6a, 0x6e,
-	0xd8, 0x6c, 0x85, 0x01, 0x09, 0x12, 0xa9, 0x44, 0xc6, 0xf8, 0x61, 0x89, 0x6c, 0xc5, 0x06, 0x46,
-	0x97, 0x2e, 0xd7, 0x68, 0x93, 0x13, 0xfb, 0xe8, 0x72, 0x83, 0x5a, 0xaf, 0xe7, 0xa9, 0xb2, 0x61,
-	0x6e, 0xc5, 0x9b, 0x5e, 0xb2, 0x15, 0xb6, 0x13, 0xb9, 0x4b, 0x16, 0x8a, 0x4a, 0x29, 0x9b, 0xa5,
-	0x1c, 0x1c, 0x9c, 0xfb, 0x64, 0x56, 0xb3, 0x8e, 0xdf, 0x9b, 0x66, 0x3d, 0xd1, 0x87, 0x66, 0xad,
-	0xc3, 0x69, 0xd6, 0x03, 0x61, 0x25, 0x4b, 0xa7, 0x65, 0x3c, 0x89, 0x58, 0xe7, 0x55, 0x72, 0xcc,
-	0x52, 0x1e, 0x12, 0xce, 0x7f, 0xf6, 0xec, 0xb7, 0xc1, 0x44, 0x97, 0x90, 0x3b, 0x94, 0x43, 0x72,
-	0x01, 0x1e, 0xc9, 0x17, 0x27, 0x87, 0x72, 0x4b, 0xfe, 0x6c, 0x26, 0xa8, 0xdd, 0xd8, 0xa2, 0xf5,
-	0xe1, 0xe2, 0x76, 0xa0, 0x4c, 0x82, 0x1d, 0xa1, 0x5d, 0x2f, 0x1e, 0x6d, 0x56, 0x5f, 0x08, 0x76,
-	0xb8, 0x34, 0x64, 0x7e, 0xbc, 0x0b, 0xc1, 0x0e, 0xa6, 0xb4, 0xd1, 0x0f, 0x59, 0xa9, 0x0d, 0x04,
-	0x77, 0x8c, 0x7f, 0xe4, 0x58, 0xf6, 0xa4, 0x7d, 0xef, 0x29, 0xec, 0x7f, 0x57, 0x82, 0x73, 0x07,
-	0x11, 0xe9, 0x63, 0xf8, 0x9e, 0x82, 0xc1, 0x98, 0x85, 0xa9, 0x08, 0x75, 0x35, 0x4c, 0x57, 0x31,
-	0x0f, 0x5c, 0x79, 0x0d, 0x0b, 0x10, 0xf2, 0xa1, 0xdc, 0x74, 0x5a, 0xc2, 0x5f, 0xba, 0x78, 0xd4,
-	0xe4, 0x3f, 0xfa, 0xdf, 0xf1, 0x97, 0x9d, 0x16, 0x9f, 0xf3, 0x46, 0x03, 0xa6, 0x6c, 0x50, 0x02,
-	0x15, 0x27, 0x8a, 0x1c, 0x19, 0x13, 0x71, 0xb5, 0x18, 0x7e, 0xb3, 0x94, 0x24, 0x3f, 0x52, 0x4e,
-	0x35, 0x61, 0xce, 0xcc, 0xfe, 0x91, 0x6a, 0x2a, 0x53, 0x8c, 0x05, 0xba, 0xc4, 0x30, 0x28, 0xdc,
-	0xa4, 0x56, 0xd1, 0x39, 0x97, 0x3c, 0x15, 0x9b, 0x79, 0x20, 0x44, 0x41, 0x0b, 0xc1, 0x0a, 0x7d,
-	0xd6, 0x62, 0x65, 0x23, 0x64, 0xfa, 0x9d, 0xd8, 0xd5, 0x1f, 0x4f, 0x15, 0x0b, 0xb3, 0x18, 0x85,
-	0x6c, 0xc4, 0x26, 0x77, 0x51, 0x1a, 0x87, 0xed, 0x66, 0xba, 0x4b, 0xe3, 0xb0, 0xdd, 0x89, 0x84,
-	0xa3, 0xdd, 0x9c, 0x80, 0x96, 0x02, 0x4a, 0x0f, 0xf4, 0x11, 0xc2, 0xf2, 0x25, 0x0b, 0x26, 0xbc,
-	0x6c, 0x64, 0x82, 0xd8, 0x03, 0xdf, 0x2c, 0xc6, 0xa7, 0xd9, 0x1d, 0xf8, 0xa0, 0x0c, 0x9d, 0x2e,
-	0x10, 0xee, 0xee, 0x0c, 0x6a, 0xc0, 0x80, 0x17, 0x6c, 0x84, 0xc2, 0xbc, 0x9b, 0x3b, 0x5a, 0xa7,
-	0x16, 0x83, 0x8d, 0x50, 0xaf, 0x66, 0xfa, 0x0f, 0x33, 0xea, 0x68, 0x09, 0x4e, 0xc9, 0x64, 0xa1,
-	0xcb, 0x5e, 0x9c, 0x84, 0x51, 0x67, 0xc9, 0x6b, 0x7a, 0x09, 0x33, 0xcd, 0xca, 0x73, 0x93, 0x54,
-	0xbd, 0xe1, 0x1c, 0x38, 0xce, 0x7d, 0x0a, 0xbd, 0x01, 0x43, 0x32, 0x1a, 0xa0, 0x5a, 0x84, 0x3f,
-	0xa1, 0x7b, 0xfe, 0xab, 0xc9, 0x54, 0x17, 0xe1, 0x00, 0x92, 0x21, 0xfa, 0x8c, 0x05, 0x63, 0xfc,
-	0xf7, 0xe5, 0x4e, 0x83, 0xe7, 0x27, 0xd6, 0x8a, 0x08, 0xf9, 0xaf, 0xa7, 0x68, 0xce, 0xa1, 0x3b,
-	0x7b, 0x53, 0x63, 0xe9, 0x36, 0x9c, 0xe1, 0x6b, 0xff, 0x93, 0x11, 0xe8, 0x8e, 0x9f, 0x48, 0x07,
-	0x4b, 0x58, 0xf7, 0x3b, 0x58, 0x82, 0xee, 0x2a, 0x63, 0x1d, 0xe7, 0x50, 0xc0, 0x32, 0x13, 0x5c,
-	0xf5, 0x31, 0x74, 0x27, 0x70, 0x31, 0xe3, 0x81, 0xda, 0x30, 0xc8, 0x2b, 0x53, 0x09, 0x0d, 0x70,
-	0xf4, 0x93, 0x6f, 0xb3, 0xc2, 0x95, 0x76, 0x6b, 0xf1, 0x56, 0x2c, 0x98, 0xa1, 0x5d, 0x18, 0xda,
-	0xe2, 0xd3, 0x51, 0xec, 0xf5, 0x96, 0x8f, 0x3a, 0xbe, 0xa9, 0x39, 0xae, 0x27, 0x9f, 0x68, 0xc0,
-	0x92, 0x1d, 0x8b, 0xcd, 0x33, 0xa2, 0x87, 0xb8, 0x20, 0x29, 0x2e, 0xd5, 0xb2, 0xff, 0xd0, 0xa1,
-	0x8f, 0xc2, 0x48, 0x44, 0xdc, 0x30, 0x70, 0x3d, 0x9f, 0x34, 0x66, 0xe5, 0x81, 0xd8, 0x61, 0x32,
-	0xec, 0x98, 0x37, 0x09, 0x1b, 0x34, 0x70, 0x8a, 0x22, 0x5b, 0x67, 0x2a, 0xeb, 0x9e, 0x7e, 0x10,
-	0x22, 0x0e, 0x3e, 0x96, 0x0a, 0xca, 0xf1, 0x67, 0x34, 0xf9, 0x3a, 0x4b, 0xb7, 0xe1, 0x0c, 0x5f,
-	0xf4, 0x0a, 0x40, 0xb8, 0xce, 0x03, 0xf0, 0x66, 0x13, 0x71, 0x0a, 0x72, 0x98, 0x57, 0x1d, 0xe3,
-	0x99, 0xba, 0x92, 0x02, 0x36, 0xa8, 0xa1, 0xab, 0x00, 0x7c, 0xe5, 0xac, 0x75, 0x5a, 0x72, 0x43,
-	0x28, 0x53, 0x24, 0xa1, 0xae, 0x20, 0x77, 0xf7, 0xa6, 0xba, 0x7d, 0xce, 0x2c, 0xca, 0xc8, 0x78,
-	0x1c, 0x7d, 0x17, 0x0c, 0xc5, 0xed, 0x66, 0xd3, 0x51, 0x67, 0x24, 0x05, 0xe6, 0xfe, 0x72, 0xba,
-	0x86, 0x60, 0xe4, 0x0d, 0x58, 0x72, 0x44, 0xb7, 0xa8, 0x88, 0x17, 0x12, 0x8a, 0xaf, 0x22, 0x6e,
-	0xa1, 0x70, 0x4f, 0xe0, 0x07, 0xe4, 0x2e, 0x06, 0xe7, 0xe0, 0xdc, 0xdd, 0x9b, 0x7a, 0x24, 0xdd,
-	0xbe, 0x14, 0x8a, 0x6c, 0xdc, 0x5c, 0x9a, 0xe8, 0x8a, 0x2c, 0xc2, 0x45, 0x5f, 0x5b, 0xd6, 0x86,
-	0x79, 0xaf, 0x2e, 0xc2, 0xc5, 0x9a, 0x7b, 0x8f, 0x99, 0xf9, 0x30, 0x5a, 0x86, 0x93, 0x6e, 0x18,
-	0x24, 0x51, 0xe8, 0xfb, 0xbc, 0x40, 0x1f, 0xdf, 0x9b, 0xf3, 0x33, 0x94, 0xc7, 0x44, 0xb7, 0x4f,
-	0xce, 0x77, 0xa3, 0xe0, 0xbc, 0xe7, 0xa8, 0x4d, 0x9e, 0xd5, 0x0f, 0x63, 0x85, 0x1c, 0xaf, 0xa7,
-	0x68, 0x0a, 0x09, 0xa5, 0xdc, 0xde, 0x07, 0x68, 0x8a, 0x20, 0x7d, 0xc8, 0x2a, 0xbe, 0xd8, 0xf3,
-	0x30, 0x42, 0x76, 0x13, 0x12, 0x05, 0x8e, 0x7f, 0x1d, 0x2f, 0xc9, 0x03, 0x0b, 0xb6, 0x30, 0x2f,
-	0x18, 0xed, 0x38, 0x85, 0x85, 0x6c, 0xe5, 0x25, 0x33, 0xd2, 0xde, 0xb9, 0x97, 0x4c, 0xfa, 0xc4,
-	0xec, 0x9f, 0x29, 0xa7, 0x6c, 0xd6, 0x07, 0x72, 0xa4, 0xcb, 0xea, 0x2b, 0xc9, 0x42, 0x54, 0x0c,
-	0x20, 0xf6, 0x62, 0x45, 0x72, 0x56, 0x51, 0x73, 0x2b, 0x26, 0x23, 0x9c, 0xe6, 0x8b, 0xb6, 0xa1,
-	0xb2, 0x15, 0xc6, 0x89, 0xdc, 0xa1, 0x1d, 0x71, 0x33, 0x78, 0x39, 0x8c, 0x13, 0x66, 0x68, 0xa9,
-	0xd7, 0xa6, 0x2d, 0x31, 0xe6, 0x3c, 0xe8, 0xde, 0x3f, 0xde, 0x72, 0xa2, 0x46, 0x3c, 0xcf, 0x8a,
-	0x54, 0x0c, 0x30, 0x0b, 0x4b, 0xd9, 0xd3, 0x75, 0x0d, 0xc2, 0x26, 0x9e, 0xfd, 0x27, 0x56, 0xea,
-	0x54, 0xeb, 0x26, 0xcb, 0x38, 0xd8, 0x21, 0x01, 0x15, 0x51, 0x66, 0x8c, 0xe3, 0x37, 0x65, 0xf2,
-	0xb7, 0xdf, 0xd3, 0xab, 0x96, 0xe6, 0x6d, 0x4a, 0x61, 0x9a, 0x91, 0x30, 0xc2, 0x21, 0x3f, 0x6e,
-	0xa5, 0x13, 0xf1, 0x4b, 0x45, 0x6c, 0xdd, 0xcc, 0x62, 0x14, 0x07, 0xe6, 0xf4, 0xdb, 0x3f, 0x64,
-	0xc1, 0xd0, 0x9c, 0xe3, 0x6e, 0x87, 0x1b, 0x1b, 0xe8, 0x19, 0xa8, 0x36, 0xda, 0x91, 0x59, 0x13,
-	0x40, 0x39, 0xab, 0x16, 0x44, 0x3b, 0x56, 0x18, 0x74, 0xea, 0x6f, 0x38, 0xae, 0x2c, 0x49, 0x51,
-	0xe6, 0x53, 0xff, 0x22, 0x6b, 0xc1, 0x02, 0x42, 0x87, 0xbf, 0xe9, 0xec, 0xca, 0x87, 0xb3, 0x47,
-	0x6a, 0xcb, 0x1a, 0x84, 0x4d, 0x3c, 0xfb, 0xd7, 0x2c, 0x98, 0x9c, 0x73, 0x62, 0xcf, 0x9d, 0x6d,
-	0x27, 0x5b, 0x73, 0x5e, 0xb2, 0xde, 0x76, 0xb7, 0x49, 0xc2, 0x4b, 0x97, 0xd0, 0x5e, 0xb6, 0x63,
-	0xba, 0x02, 0xd5, 0x8e, 0x59, 0xf5, 0xf2, 0xba, 0x68, 0xc7, 0x0a, 0x03, 0xbd, 0x01, 0xc3, 0x2d,
-	0x27, 0x8e, 0x6f, 0x87, 0x51, 0x03, 0x93, 0x8d, 0x62, 0x8a, 0x1b, 0xd5, 0x89, 0x1b, 0x91, 0x04,
-	0x93, 0x0d, 0x11, 0xa0, 0xa2, 0xe9, 0x63, 0x93, 0x99, 0xfd, 0x03, 0x16, 0x9c, 0x9a, 0x23, 0x4e,
-	0x44, 0x22, 0x56, 0x0b, 0x49, 0xbd, 0x08, 0x7a, 0x1d, 0xaa, 0x09, 0x6d, 0xa1, 0x3d, 0xb2, 0x8a,
-	0xed, 0x11, 0x0b, 0x2d, 0x59, 0x13, 0xc4, 0xb1, 0x62, 0x63, 0x7f, 0xc1, 0x82, 0x33, 0x79, 0x7d,
-	0x99, 0xf7, 0xc3, 0x76, 0xe3, 0x41, 0x74, 0xe8, 0xef, 0x5a, 0x30, 0xc2, 0x8e, 0xeb, 0x17, 0x48,
-	0xe2, 0x78, 0x7e, 0x57, 0x1d, 0x46, 0xab, 0xcf, 0x3a, 0x8c, 0xe7, 0x60, 0x60, 0x2b, 0x6c, 0x92,
-	0x6c, 0xa8, 0xc9, 0xe5, 0xb0, 0x49, 0x30, 0x83, 0xa0, 0x67, 0xe9, 0x24, 0xf4, 0x82, 0xc4, 0xa1,
-	0xcb, 0x51, 0x1e, 0x67, 0x8c, 0xf3, 0x09, 0xa8, 0x9a, 0xb1, 0x89, 0x63, 0xff, 0x72, 0x0d, 0x86,
-	0x44, 0x5c, 0x54, 0xdf, 0xa5, 0x74, 0xa4, 0x17, 0xa7, 0xd4, 0xd3, 0x8b, 0x13, 0xc3, 0xa0, 0xcb,
-	0x8a, 0xe5, 0x0a, 0x0b, 0xfd, 0x6a, 0x21, 0x81, 0x74, 0xbc, 0xfe, 0xae, 0xee, 0x16, 0xff, 0x8f,
-	0x05, 0x2b, 0xf4, 0x96, 0x05, 0xe3, 0x6e, 0x18, 0x04, 0xc4, 0xd5, 0xb6, 0xe3, 0x40, 0x11, 0x1b,
-	0x84, 0xf9, 0x34, 0x51, 0x7d, 0x12, 0x9c, 0x01, 0xe0, 0x2c, 0x7b, 0xf4, 0x12, 0x8c, 0xf2, 0x31,
-	0xbb, 0x91, 0x3a, 0x83, 0xd1, 0xe5, 0xf9, 0x4c, 0x20, 0x4e, 0xe3, 0xa2, 0x69, 0x7e, 0x96, 0x25,
-	0x0a, 0xe1, 0x0d, 0x6a, 0x57, 0xb5, 0x51, 0x02, 0xcf, 0xc0, 0x40, 0x11, 0xa0, 0x88, 0x6c, 0x44,
-	0x24, 0xde, 0x12, 0x71, 0x63, 0xcc, 0x6e, 0x1d, 0xba, 0xb7, 0x22, 0x18, 0xb8, 0x8b, 0x12, 0xce,
-	0xa1, 0x8e, 0xb6, 0x85, 0x1b, 0xa1, 0x5a, 0x84, 0x3c, 0x17, 0x9f, 0xb9, 0xa7, 0x37, 0x61, 0x0a,
-	0x2a, 0x4c, 0x75, 0x31, 0x7b, 0xb9, 0xcc, 0x13, 0x2f, 0x99, 0x62, 0xc3, 0xbc, 0x1d, 0x2d, 0xc0,
-	0x89, 0x4c, 0x71, 0xc1, 0x58, 0x9c, 0x95, 0xa8, 0x24, 0xbb, 0x4c, 0x59, 0xc2, 0x18, 0x77, 0x3d,
-	0x61, 0xba, 0x98, 0x86, 0x0f, 0x70, 0x31, 0x75, 0x54, 0x74, 0x32, 0x3f, 0xc5, 0x78, 0xb9, 0x90,
-	0x01, 0xe8, 0x2b, 0x14, 0xf9, 0xf3, 0x99, 0x50, 0xe4, 0x51, 0xd6, 0x81, 0x1b, 0xc5, 0x74, 0xe0,
-	0xf0, 0x71, 0xc7, 0x0f, 0x32, 0x8e, 0xf8, 0x7f, 0x5b, 0x20, 0xbf, 0xeb, 0xbc, 0xe3, 0x6e, 0x11,
-	0x3a, 0x65, 0xd0, 0x07, 0x61, 0x4c, 0x79, 0x27, 0xb8, 0x49, 0x64, 0xb1, 0x59, 0xa3, 0x6c, 0x67,
-	0x9c, 0x82, 0xe2, 0x0c, 0x36, 0x9a, 0x81, 0x1a, 0x1d, 0x27, 0xfe, 0x28, 0xd7, 0xfb, 0xca, 0x03,
-	0x32, 0xbb, 0xba, 0x28, 0x9e, 0xd2, 0x38, 0x28, 0x84, 0x09, 0xdf, 0x89, 0x13, 0xd6, 0x83, 0x7a,
-	0x27, 0x70, 0xef, 0xb1, 0x04, 0x0d, 0xcb, 0xe4, 0x5a, 0xca, 0x12, 0xc2, 0xdd, 0xb4, 0xed, 0xff,
-	0x50, 0x81, 0xd1, 0x94, 0x64, 0x3c, 0xa4, 0xc1, 0xf0, 0x0c, 0x54, 0xa5, 0x0e, 0xcf, 0xd6, 0xda,
-	0x52, 0x8a, 0x5e, 0x61, 0x50, 0xa5, 0xb5, 0xae, 0xb5, 0x6a, 0xd6, 0xc0, 0x31, 0x14, 0x2e, 0x36,
-	0xf1, 0x98, 0x50, 0x4e, 0xfc, 0x78, 0xde, 0xf7, 0x48, 0x90, 0xf0, 0x6e, 0x16, 0x23, 0x94, 0xd7,
-	0x96, 0xea, 0x26, 0x51, 0x2d, 0x94, 0x33, 0x00, 0x9c, 0x65, 0x8f, 0x3e, 0x65, 0xc1, 0xa8, 0x73,
-	0x3b, 0xd6, 0x15, 0xdd, 0x45, 0xd0, 0xf1, 0x11, 0x95, 0x54, 0xaa, 0x48, 0x3c, 0x77, 0xec, 0xa7,
-	0x9a, 0x70, 0x9a, 0x29, 0x7a, 0xdb, 0x02, 0x44, 0x76, 0x89, 0x2b, 0xc3, 0xa2, 0x45, 0x5f, 0x06,
-	0x8b, 0xd8, 0xc1, 0x5f, 0xe8, 0xa2, 0xcb, 0xa5, 0x7a, 0x77, 0x3b, 0xce, 0xe9, 0x03, 0xba, 0x02,
-	0xa8, 0xe1, 0xc5, 0xce, 0xba, 0x4f, 0xe6, 0xc3, 0xa6, 0xcc, 0x3e, 0x16, 0xe7, 0xe9, 0x67, 0xc5,
-	0x38, 0xa3, 0x85, 0x2e, 0x0c, 0x9c, 0xf3, 0x14, 0x9b, 0x65, 0x51, 0xb8, 0xdb, 0xb9, 0x1e, 0xf9,
-	0x4c, 0x4b, 0x98, 0xb3, 0x4c, 0xb4, 0x63, 0x85, 0x61, 0xff, 0x69, 0x59, 0x2d, 0x65, 0x9d, 0x03,
-	0xe0, 0x18, 0xb1, 0xc8, 0xd6, 0xbd, 0xc7, 0x22, 0xeb, 0x48, 0xa9, 0xee, 0x9c, 0xfa, 0x54, 0x0a,
-	0x6e, 0xe9, 0x01, 0xa5, 0xe0, 0x7e, 0xaf, 0x95, 0xaa, 0x67, 0x37, 0x7c, 0xfe, 0x95, 0x62, 0xf3,
-	0x0f, 0xa6, 0x79, 0x14, 0x57, 0x46, 0xaf, 0x64, 0x82, 0xf7, 0x9e, 0x81, 0xea, 0x86, 0xef, 0xb0,
-	0x2a, 0x2c, 0x6c, 0xa1, 0x1a, 0x11, 0x66, 0x17, 0x45, 0x3b, 0x56, 0x18, 0x54, 0xea, 0x1b, 0x44,
-	0x0f, 0x25, 0xb5, 0xff, 0x73, 0x19, 0x86, 0x0d, 0x8d, 0x9f, 0x6b, 0xbe, 0x59, 0x0f, 0x99, 0xf9,
-	0x56, 0x3a, 0x84, 0xf9, 0xf6, 0x3d, 0x50, 0x73, 0xa5, 0x36, 0x2a, 0xa6, 0x3e, 0x7f, 0x56, 0xc7,
-	0x69, 0x85, 0xa4, 0x9a, 0xb0, 0xe6, 0x89, 0x2e, 0xa5, 0xd2, 0x3c, 0x53, 0x7e, 0x81, 0xbc, 0x3c,
-	0x4c, 0xa1, 0xd1, 0xba, 0x9f, 0xc9, 0xc6, 0x07, 0x54, 0x0e, 0x8e, 0x0f, 0xb0, 0x7f, 0xdf, 0x52,
-	0x1f, 0xf7, 0x3e, 0xd4, 0xf3, 0xb9, 0x95, 0xae, 0xe7, 0x73, 0xa1, 0x90, 0x61, 0xee, 0x51, 0xc8,
-	0xe7, 0x1a, 0x0c, 0xcd, 0x87, 0xcd, 0xa6, 0x13, 0x34, 0xd0, 0xd7, 0xc3, 0x90, 0xcb, 0x7f, 0x0a,
-	0x1f, 0x1a, 0x3b, 0xac, 0x16, 0x50, 0x2c, 0x61, 0xe8, 0x71, 0x18, 0x70, 0xa2, 0x4d, 0xe9, 0x37,
-	0x63, 0x41, 0x70, 0xb3, 0xd1, 0x66, 0x8c, 0x59, 0xab, 0xfd, 0xe7, 0x16, 0x8c, 0xd1, 0x47, 0x3c,
-	0xf6, 0x52, 0xec, 0x75, 0x9e, 0x86, 0x41, 0xa7, 0x9d, 0x6c, 0x85, 0x5d, 0xfb, 0xb0, 0x59, 0xd6,
-	0x8a, 0x05, 0x94, 0xee, 0xc3, 0x54, 0x21, 0x08, 0x63, 0x1f, 0xb6, 0x40, 0xe7, 0x32, 0x83, 0x50,
-	0x53, 0x36, 0x6e, 0xaf, 0xe7, 0x9d, 0x96, 0xd6, 0x79, 0x33, 0x96, 0x70, 0x4a, 0x6c, 0x3d, 0x6c,
-	0x74, 0x44, 0x68, 0xaf, 0x22, 0x36, 0x17, 0x36, 0x3a, 0x98, 0x41, 0xd0, 0x13, 0x50, 0x8e, 0xb7,
-	0x1c, 0x79, 0x2e, 0x2f, 0xa3, 0xcc, 0xeb, 0x97, 0x67, 0x31, 0x6d, 0x57, 0x49, 0x13, 0x91, 0x9f,
-	0x8d, 0xb1, 0x4d, 0x27, 0x4d, 0x44, 0xbe, 0xfd, 0x2f, 0x07, 0x80, 0xc5, 0xdb, 0x38, 0x11, 0x69,
-	0xac, 0x85, 0xac, 0x94, 0xf0, 0xb1, 0x1e, 0x6b, 0xeb, 0x8d, 0xec, 0xc3, 0x7c, 0xb4, 0x6d, 0x1c,
-	0x6f, 0x96, 0xef, 0xf7, 0xf1, 0x66, 0xfe, 0x89, 0xf5, 0xc0, 0x43, 0x74, 0x62, 0x6d, 0x7f, 0xce,
-	0x02, 0xa4, 0xa2, 0xa7, 0x74, 0x48, 0xc9, 0x0c, 0xd4, 0x54, 0xb8, 0x96, 0x58, 0x2f, 0x5a, 0x2c,
-	0x4a, 0x00, 0xd6, 0x38, 0x7d, 0x78, 0x2f, 0x9e, 0x92, 0x3a, 0xab, 0x9c, 0xce, 0xb9, 0x60, 0x9a,
-	0x4e, 0xa8, 0x30, 0xfb, 0x57, 0x4a, 0xf0, 0x08, 0x37, 0x97, 0x96, 0x9d, 0xc0, 0xd9, 0x24, 0x4d,
-	0xda, 0xab, 0x7e, 0x83, 0x84, 0x5c, 0xba, 0x6d, 0xf6, 0x64, 0x86, 0xc4, 0x51, 0xe5, 0x15, 0x97,
-	0x33, 0x5c, 0xb2, 0x2c, 0x06, 0x5e, 0x82, 0x19, 0x71, 0x14, 0x43, 0x55, 0x5e, 0x66, 0x24, 0xf4,
-	0x4f, 0x41, 0x8c, 0x94, 0x28, 0x16, 0x96, 0x05, 0xc1, 0x8a, 0x11, 0x35, 0x1f, 0xfc, 0xd0, 0xdd,
-	0xa6, 0x4b, 0x3e, 0x6b, 0x3e, 0x2c, 0x89, 0x76, 0xac, 0x30, 0xec, 0x26, 0x8c, 0xcb, 0x31, 0x6c,
-	0x5d, 0x25, 0x1d, 0x4c, 0x36, 0xa8, 0xce, 0x75, 0x65, 0x93, 0x71, 0xbf, 0x92, 0xd2, 0xb9, 0xf3,
-	0x26, 0x10, 0xa7, 0x71, 0x65, 0x75, 0xe1, 0x52, 0x7e, 0x75, 0x61, 0xfb, 0x57, 0x2c, 0xc8, 0x2a,
-	0x7d, 0xa3, 0x96, 0xaa, 0xb5, 0x6f, 0x2d, 0xd5, 0x43, 0x54, 0x23, 0xfd, 0x4e, 0x18, 0x76, 0x12,
-	0x6a, 0xd5, 0x71, 0x0f, 0x4c, 0xf9, 0xde, 0x4e, 0x0e, 0x97, 0xc3, 0x86, 0xb7, 0xe1, 0x31, 0xcf,
-	0x8b, 0x49, 0xce, 0x7e, 0xdb, 0x82, 0xda, 0x42, 0xd4, 0x39, 0x7c, 0xaa, 0x5a, 0x77, 0x22, 0x5a,
-	0xe9, 0x50, 0x89, 0x68, 0x32, 0xd5, 0xad, 0xdc, 0x2b, 0xd5, 0xcd, 0xfe, 0x8b, 0x01, 0x98, 0xe8,
-	0xca, 0xbd, 0x44, 0x2f, 0xc2, 0x88, 0xfa, 0x4a, 0xd2, 0xed, 0x5a, 0x33, 0x83, 0x97, 0x35, 0x0c,
-	0xa7, 0x30, 0xfb, 0x58, 0xaa, 0x8b, 0x70, 0x32, 0x22, 0xaf, 0xb7, 0x49, 0x9b, 0xcc, 0x6e, 0x24,
-	0x24, 0xaa, 0x13, 0x37, 0x0c, 0x1a, 0xbc, 0x18, 0x71, 0x79, 0xee, 0xd1, 0x3b, 0x7b, 0x53, 0x27,
-	0x71, 0x37, 0x18, 0xe7, 0x3d, 0x83, 0x5a, 0x30, 0xea, 0x9b, 0xfb, 0x05, 0xb1, 0x4d, 0xbd, 0xa7,
-	0xad, 0x86, 0x9a, 0xad, 0xa9, 0x66, 0x9c, 0x66, 0x90, 0xde, 0x74, 0x54, 0x1e, 0xd0, 0xa6, 0xe3,
-	0x93, 0x7a, 0xd3, 0xc1, 0x63, 0x81, 0x3e, 0x5c, 0x70, 0xee, 0x6d, 0x3f, 0xbb, 0x8e, 0xa3, 0xec,
-	0x23, 0x5e, 0x86, 0xaa, 0x8c, 0x93, 0xec, 0x2b, 0xbe, 0xd0, 0xa4, 0xd3, 0x43, 0xb6, 0x3f, 0x0d,
-	0xef, 0xbe, 0x10, 0x45, 0xc6, 0x60, 0x5e, 0x0b, 0x93, 0x59, 0xdf, 0x0f, 0x6f, 0x53, 0x73, 0xe5,
-	0x7a, 0x4c, 0x84, 0x1f, 0xd0, 0xbe, 0x5b, 0x82, 0x9c, 0x2d, 0x35, 0x5d, 0x93, 0xda, 0x2e, 0x4c,
-	0xad, 0xc9, 0xc3, 0xd9, 0x86, 0x68, 0x97, 0xc7, 0x92, 0x72, 0x6b, 0xe0, 0x43, 0x45, 0xbb, 0x04,
-	0x74, 0x78, 0xa9, 0x92, 0x94, 0x2a, 0xc4, 0xf4, 0x3c, 0x80, 0x36, 0xe7, 0x85, 0x4d, 0xa8, 0x82,
-	0x43, 0xb4, 0xd5, 0x8f, 0x0d, 0x2c, 0xf4, 0x02, 0x0c, 0x7b, 0x41, 0x9c, 0x38, 0xbe, 0x7f, 0xd9,
-	0x0b, 0x12, 0x61, 0x27, 0x2a, 0xb3, 0x67, 0x51, 0x83, 0xb0, 0x89, 0x77, 0xf6, 0x03, 0xc6, 0xf7,
-	0x3b, 0xcc, 0x77, 0xdf, 0x82, 0x33, 0x97, 0xbc, 0x44, 0x25, 0x29, 0xaa, 0xf9, 0x46, 0xad, 0x75,
-	0x25, 0xab, 0xac, 0x9e, 0x69, 0xb9, 0x46, 0x92, 0x60, 0x29, 0x9d, 0xd3, 0x98, 0x4d, 0x12, 0xb4,
-	0x5d, 0x38, 0x75, 0xc9, 0x4b, 0x2e, 0x7a, 0x3e, 0x39, 0x46, 0x26, 0xbf, 0x34, 0x08, 0x23, 0x66,
-	0xee, 0xfe, 0x61, 0x24, 0xfb, 0x17, 0xa8, 0x1d, 0x2b, 0x06, 0xc2, 0x53, 0x07, 0xde, 0x37, 0x8f,
-	0x5c, 0x48, 0x20, 0x7f, 0x70, 0x0d, 0x53, 0x56, 0xf3, 0xc4, 0x66, 0x07, 0xd0, 0x6d, 0xa8, 0x6c,
-	0xb0, 0x7c, 0xb7, 0x72, 0x11, 0xa1, 0x4a, 0x79, 0x83, 0xaf, 0x57, 0x2e, 0xcf, 0x98, 0xe3, 0xfc,
-	0xa8, 0xf9, 0x11, 0xa5, 0xd3, 0xac, 0x8d, 0x2c, 0x04, 0xa1, 0xd7, 0x14, 0x46, 0x2f, 0xed, 0x51,
-	0xb9, 0x07, 0xed, 0x91, 0x92, 0xe5, 0x83, 0x0f, 0x48, 0x96, 0xb3, 0xdc, 0xc5, 0x64, 0x8b, 0x19,
-	0xc7, 0x22, 0x6d, 0x6a, 0x88, 0x0d, 0x82, 0x91, 0xbb, 0x98, 0x02, 0xe3, 0x2c, 0x3e, 0xfa, 0x98,
-	0xd2, 0x06, 0xd5, 0x22, 0x0e, 0x14, 0xcc, 0x19, 0x7d, 0xdc, 0x8a, 0xe0, 0x73, 0x25, 0x18, 0xbb,
-	0x14, 0xb4, 0x57, 0x2f, 0xad, 0xb6, 0xd7, 0x7d, 0xcf, 0xbd, 0x4a, 0x3a, 0x54, 0xda, 0x6f, 0x93,
-	0xce, 0xe2, 0x82, 0x58, 0x41, 0x6a, 0xce, 0x5c, 0xa5, 0x8d, 0x98, 0xc3, 0xa8, 0xdc, 0xda, 0xf0,
-	0x82, 0x4d, 0x12, 0xb5, 0x22, 0x4f, 0xf8, 0xfa, 0x0d, 0xb9, 0x75, 0x51, 0x83, 0xb0, 0x89, 0x47,
-	0x69, 0x87, 0xb7, 0x03, 0x55, 0x48, 0x49, 0xd1, 0x5e, 0xa1, 0x8d, 0x98, 0xc3, 0x28, 0x52, 0x12,
-	0xb5, 0x85, 0x2b, 0xcd, 0x40, 0x5a, 0xa3, 0x8d, 0x98, 0xc3, 0xc4, 0x2e, 0x9d, 0x45, 0x82, 0x55,
-	0xba, 0x76, 0xe9, 0x2c, 0x88, 0x42, 0xc2, 0x29, 0xea, 0x36, 0xe9, 0x2c, 0x38, 0x89, 0x93, 0xdd,
-	0x64, 0x5f, 0xe5, 0xcd, 0x58, 0xc2, 0x59, 0x65, 0xe5, 0xf4, 0x70, 0x7c, 0xcd, 0x55, 0x56, 0x4e,
-	0x77, 0xbf, 0x87, 0x43, 0xe6, 0xef, 0x94, 0x60, 0xe4, 0x9d, 0xeb, 0x4f, 0x73, 0x2e, 0xf6, 0xb9,
-	0x09, 0x13, 0x5d, 0x19, 0xd3, 0x7d, 0x58, 0x48, 0x07, 0x56, 0xb4, 0xb0, 0x31, 0x0c, 0x53, 0xc2,
-	0xb2, 0xa2, 0xe0, 0x3c, 0x4c, 0xf0, 0xc5, 0x4b, 0x39, 0xb1, 0x04, 0x58, 0x95, 0x05, 0xcf, 0x0e,
-	0xb3, 0x6e, 0x64, 0x81, 0xb8, 0x1b, 0xdf, 0xfe, 0xbc, 0x05, 0xa3, 0xa9, 0x24, 0xf6, 0x82, 0x6c,
-	0x39, 0xb6, 0xba, 0x43, 0x16, 0xc5, 0xcc, 0xb2, 0x4a, 0xca, 0x4c, 0x0d, 0xeb, 0xd5, 0xad, 0x41,
-	0xd8, 0xc4, 0xb3, 0x7f, 0xb3, 0x0c, 0x55, 0x19, 0x71, 0xd5, 0x47, 0x57, 0x3e, 0x6b, 0xc1, 0xa8,
-	0x3a, 0x40, 0x64, 0x1e, 0xdf, 0x52, 0x11, 0x39, 0x75, 0xb4, 0x07, 0xca, 0x7f, 0x12, 0x6c, 0x84,
-	0x7a, 0x63, 0x81, 0x4d, 0x66, 0x38, 0xcd, 0x1b, 0xdd, 0x00, 0x88, 0x3b, 0x71, 0x42, 0x9a, 0x86,
-	0xef, 0xd9, 0x36, 0x66, 0xd9, 0xb4, 0x1b, 0x46, 0x84, 0xce, 0xa9, 0x6b, 0x61, 0x83, 0xd4, 0x15,
-	0xa6, 0xb6, 0xf0, 0x74, 0x1b, 0x36, 0x28, 0xa1, 0x37, 0xd4, 0x71, 0xf7, 0x40, 0x11, 0x7a, 0x5d,
-	0x8e, 0x6f, 0x3f, 0xe7, 0xdd, 0x47, 0x38, 0x5f, 0xb6, 0x7f, 0xba, 0x04, 0x27, 0xb2, 0x23, 0x89,
-	0x3e, 0x0c, 0x23, 0x72, 0xd0, 0x0c, 0x37, 0x83, 0x0c, 0x73, 0x1b, 0xc1, 0x06, 0xec, 0xee, 0xde,
-	0xd4, 0x54, 0xf7, 0x3d, 0xda, 0xd3, 0x26, 0x0a, 0x4e, 0x11, 0xe3, 0x87, 0xcf, 0x22, 0x4a, 0x62,
-	0xae, 0x33, 0xdb, 0x6a, 0x89, 0x13, 0x64, 0xe3, 0xf0, 0xd9, 0x84, 0xe2, 0x0c, 0x36, 0x5a, 0x85,
-	0x53, 0x46, 0xcb, 0x35, 0xe2, 0x6d, 0x6e, 0xad, 0x87, 0x91, 0xdc, 0xd7, 0x3e, 0xae, 0x83, 0x6a,
-	0xbb, 0x71, 0x70, 0xee, 0x93, 0xd4, 0x30, 0x72, 0x9d, 0x96, 0xe3, 0x7a, 0x49, 0x47, 0x9c, 0x01,
-	0x28, 0x31, 0x3e, 0x2f, 0xda, 0xb1, 0xc2, 0xb0, 0xff, 0xe1, 0x00, 0x9c, 0xe0, 0x51, 0xa4, 0x44,
-	0x05, 0x49, 0xa3, 0x0f, 0x43, 0x2d, 0x4e, 0x9c, 0x88, 0x3b, 0x35, 0xac, 0x43, 0x8b, 0x2e, 0x9d,
-	0x79, 0x2f, 0x89, 0x60, 0x4d, 0x0f, 0xbd, 0xc2, 0xca, 0x96, 0x79, 0xf1, 0x16, 0xa3, 0x5e, 0xba,
-	0x37, 0x97, 0xc9, 0x45, 0x45, 0x01, 0x1b, 0xd4, 0xd0, 0xb7, 0x40, 0xa5, 0xb5, 0xe5, 0xc4, 0xd2,
-	0x9f, 0xf7, 0xb4, 0x94, 0x13, 0xab, 0xb4, 0xf1, 0xee, 0xde, 0xd4, 0xe9, 0xec, 0xab, 0x32, 0x00,
-	0xe6, 0x0f, 0x99, 0x52, 0x7e, 0xe0, 0xe0, 0x7b, 0x79, 0x1a, 0x51, 0xa7, 0x7e, 0x79, 0x36, 0x7b,
-	0x93, 0xcb, 0x02, 0x6b, 0xc5, 0x02, 0x4a, 0x65, 0xd2, 0x16, 0x67, 0xd9, 0xa0, 0xc8, 0x83, 0x69,
-	0x8b, 0xe3, 0xb2, 0x06, 0x61, 0x13, 0x0f, 0x7d, 0xae, 0x3b, 0xc6, 0x78, 0xe8, 0x18, 0x72, 0x50,
-	0xfa, 0x8d, 0x2e, 0xbe, 0x00, 0x35, 0xd1, 0xd5, 0xb5, 0x10, 0xbd, 0x08, 0x23, 0xdc, 0x5d, 0x34,
-	0x17, 0x39, 0x81, 0xbb, 0x95, 0x75, 0xf2, 0xac, 0x19, 0x30, 0x9c, 0xc2, 0xb4, 0x97, 0x61, 0xa0,
-	0x4f, 0x21, 0xdb, 0xd7, 0xde, 0xfd, 0x65, 0xa8, 0x52, 0x72, 0x72, 0x83, 0x56, 0x04, 0xc9, 0x10,
-	0xaa, 0xf2, 0x96, 0x47, 0x64, 0x43, 0xd9, 0x73, 0x64, 0x2c, 0x89, 0x5a, 0x42, 0x8b, 0x71, 0xdc,
-	0x66, 0xd3, 0x8e, 0x02, 0xd1, 0x53, 0x50, 0x26, 0xbb, 0xad, 0x6c, 0xd0, 0xc8, 0x85, 0xdd, 0x96,
-	0x17, 0x91, 0x98, 0x22, 0x91, 0xdd, 0x16, 0x3a, 0x0b, 0x25, 0xaf, 0x21, 0x66, 0x24, 0x08, 0x9c,
-	0xd2, 0xe2, 0x02, 0x2e, 0x79, 0x0d, 0x7b, 0x17, 0x6a, 0xea, 0x5a, 0x49, 0xb4, 0x2d, 0x4d, 0x2a,
-	0xab, 0x88, 0x28, 0x62, 0x49, 0xb7, 0x87, 0x31, 0xd5, 0x06, 0xd0, 0x25, 0x1d, 0x8a, 0x52, 0xc1,
-	0xe7, 0x60, 0xc0, 0x0d, 0x45, 0x31, 0x9e, 0xaa, 0x26, 0xc3, 0x6c, 0x29, 0x06, 0xb1, 0x6f, 0xc2,
-	0xd8, 0xd5, 0x20, 0xbc, 0xcd, 0x6e, 0x7f, 0x62, 0xc5, 0x8e, 0x29, 0xe1, 0x0d, 0xfa, 0x23, 0x6b,
-	0xb9, 0x33, 0x28, 0xe6, 0x30, 0x55, 0x86, 0xb5, 0xd4, 0xab, 0x0c, 0xab, 0xfd, 0x71, 0x0b, 0x46,
-	0x54, 0x6e, 0xf8, 0xa5, 0x9d, 0x6d, 0x4a, 0x77, 0x33, 0x0a, 0xdb, 0xad, 0x2c, 0x5d, 0x76, 0x83,
-	0x2d, 0xe6, 0x30, 0xb3, 0x68, 0x42, 0xe9, 0x80, 0xa2, 0x09, 0xe7, 0x60, 0x60, 0xdb, 0x0b, 0x1a,
-	0x59, 0xa7, 0xe8, 0x55, 0x2f, 0x68, 0x60, 0x06, 0xb1, 0xff, 0xd2, 0x82, 0x13, 0xaa, 0x0b, 0xd2,
-	0x66, 0x7a, 0x11, 0x46, 0xd6, 0xdb, 0x9e, 0xdf, 0x90, 0x55, 0x9c, 0x33, 0xcb, 0x65, 0xce, 0x80,
-	0xe1, 0x14, 0x26, 0x3a, 0x0f, 0xb0, 0xee, 0x05, 0x4e, 0xd4, 0x59, 0xd5, 0x46, 0x9a, 0xd2, 0xdb,
-	0x73, 0x0a, 0x82, 0x0d, 0x2c, 0xf4, 0x26, 0x54, 0x77, 0xe4, 0xe9, 0x6d, 0xb9, 0xd0, 0x5c, 0x7f,
-	0x31, 0x1e, 0x7a, 0x25, 0xa8, 0xe3, 0x60, 0xc5, 0xd1, 0xfe, 0x62, 0x19, 0xc6, 0xd2, 0xf9, 0xf9,
-	0x7d, 0x78, 0x4e, 0x9e, 0x82, 0x0a, 0x4b, 0xd9, 0xcf, 0x4e, 0x2c, 0x5e, 0x76, 0x99, 0xc3, 0x50,
-	0x0c, 0x83, 0x5c, 0x94, 0x14, 0x73, 0x07, 0xa9, 0xea, 0xa4, 0xf2, 0xe3, 0xb2, 0x48, 0x6f, 0xe1,
-	0x16, 0x17, 0xac, 0xd0, 0xa7, 0x2c, 0x18, 0x0a, 0x5b, 0x66, 0xfd, 0xcf, 0x0f, 0x15, 0x59, 0xbb,
-	0x40, 0x24, 0x08, 0x0b, 0x6b, 0x48, 0x4d, 0x3c, 0x39, 0x19, 0x24, 0xeb, 0xb3, 0xdf, 0x0c, 0x23,
-	0x26, 0xe6, 0x41, 0x06, 0x51, 0xd5, 0x34, 0x88, 0x3e, 0x6b, 0x4e, 0x49, 0x51, 0x9d, 0xa1, 0x8f,
-	0xc5, 0x7e, 0x1d, 0x2a, 0xae, 0x0a, 0x87, 0xbb, 0xa7, 0x9b, 0x07, 0x54, 0xf5, 0x32, 0x16, 0x6a,
-	0xc0, 0xa9, 0xd9, 0xbf, 0x6f, 0x19, 0xf3, 0x03, 0x93, 0x78, 0xb1, 0x81, 0x22, 0x28, 0x6f, 0xee,
-	0x6c, 0x0b, 0x23, 0xe3, 0x4a, 0x41, 0xc3, 0x7b, 0x69, 0x67, 0x5b, 0xaf, 0x30, 0xb3, 0x15, 0x53,
-	0x66, 0x7d, 0x1c, 0x36, 0xa4, 0x8a, 0x78, 0x94, 0x0f, 0x2e, 0xe2, 0x61, 0xbf, 0x5d, 0x82, 0x89,
-	0xae, 0x49, 0x85, 0xde, 0x80, 0x4a, 0x44, 0xdf, 0x52, 0xbc, 0xde, 0x52, 0x61, 0x65, 0x37, 0xe2,
-	0xc5, 0x86, 0x56, 0xde, 0xe9, 0x76, 0xcc, 0x59, 0xa2, 0x2b, 0x80, 0x74, 0xd0, 0xa6, 0x3a, 0xe9,
-	0xe0, 0xaf, 0xac, 0x22, 0xbb, 0x66, 0xbb, 0x30, 0x70, 0xce, 0x53, 0xe8, 0xa5, 0xec, 0x81, 0x49,
-	0xa6, 0xa2, 0xf4, 0x7e, 0x67, 0x1f, 0xf6, 0x5b, 0xe6, 0x14, 0xbc, 0xa1, 0x85, 0xe9, 0x51, 0x37,
-	0xa7, 0x5d, 0x92, 0xb5, 0xdc, 0xaf, 0x64, 0xb5, 0x7f, 0xa1, 0x04, 0xa3, 0xa9, 0x0a, 0xb1, 0xc8,
-	0x87, 0x2a, 0xf1, 0xd9, 0xc9, 0xae, 0xd4, 0xbe, 0x47, 0xbd, 0x2c, 0x46, 0xc9, 0xc9, 0x0b, 0x82,
-	0x2e, 0x56, 0x1c, 0x1e, 0x8e, 0x18, 0xb4, 0x17, 0x61, 0x44, 0x76, 0xe8, 0x43, 0x4e, 0xd3, 0xcf,
-	0x0e, 0xdf, 0x05, 0x03, 0x86, 0x53, 0x98, 0xf6, 0xaf, 0x96, 0x61, 0x92, 0x1f, 0x85, 0x37, 0xd4,
-	0x62, 0x50, 0x21, 0x2d, 0x3f, 0xa8, 0xeb, 0x38, 0x5b, 0x45, 0xdc, 0x88, 0xde, 0x8b, 0x51, 0x5f,
-	0xa1, 0xd3, 0x3f, 0x9e, 0x09, 0x9d, 0xe6, 0x5b, 0xf5, 0xcd, 0x63, 0xea, 0xd1, 0xd7, 0x56, 0x2c,
-	0xf5, 0x3f, 0x2d, 0xc1, 0x78, 0xe6, 0xe2, 0x3b, 0xf4, 0xc5, 0xf4, 0x5d, 0x29, 0x56, 0x11, 0xc7,
-	0x84, 0xfb, 0xde, 0x85, 0x76, 0xb8, 0x1b, 0x53, 0x1e, 0xd0, 0x52, 0xb1, 0x7f, 0xb7, 0x04, 0x63,
-	0xe9, 0x1b, 0xfb, 0x1e, 0xc2, 0x91, 0x7a, 0x1f, 0xd4, 0xd8, 0xa5, 0x54, 0x57, 0x49, 0x47, 0x9e,
-	0x32, 0xf2, 0xfb, 0x7f, 0x64, 0x23, 0xd6, 0xf0, 0x87, 0xe2, 0x22, 0x1a, 0xfb, 0x9f, 0x5b, 0x70,
-	0x9a, 0xbf, 0x65, 0x76, 0x1e, 0xfe, 0xad, 0xbc, 0xd1, 0x7d, 0xb5, 0xd8, 0x0e, 0x66, 0xea, 0x8f,
-	0x1f, 0x34, 0xbe, 0xec, 0x5e, 0x78, 0xd1, 0xdb, 0xf4, 0x54, 0x78, 0x08, 0x3b, 0x7b, 0xa8, 0xc9,
-	0x60, 0xff, 0xc7, 0x12, 0x0c, 0xaf, 0xcc, 0x2f, 0x2a, 0x11, 0x3e, 0x03, 0x35, 0x37, 0x22, 0x8e,
-	0x76, 0xff, 0x98, 0x81, 0x56, 0x12, 0x80, 0x35, 0x0e, 0xdd, 0x45, 0xf1, 0x40, 0xc5, 0x38, 0xbb,
-	0x8b, 0xe2, 0x71, 0x8c, 0x31, 0x96, 0x70, 0xf4, 0x0c, 0x54, 0x59, 0x0a, 0xf1, 0xf5, 0x48, 0x6a,
-	0x1c, 0xbd, 0xb5, 0x66, 0xed, 0x78, 0x09, 0x2b, 0x0c, 0x4a, 0xb8, 0x11, 0xba, 0x31, 0x45, 0xce,
-	0x78, 0x64, 0x16, 0x68, 0x33, 0x5e, 0xc2, 0x12, 0xce, 0x2a, 0x40, 0x32, 0xaf, 0x05, 0x45, 0xae,
-	0xa4, 0x3b, 0xcd, 0xdd, 0x1b, 0x14, 0x5d, 0xe3, 0x1c, 0xa6, 0x52, 0x68, 0x26, 0x8d, 0x6f, 0xa8,
-	0xbf, 0x34, 0x3e, 0xfb, 0x77, 0xcb, 0x50, 0xd3, 0x4e, 0x35, 0x4f, 0xd4, 0xcd, 0x28, 0xa4, 0xbe,
-	0x7d, 0xbd, 0x13, 0xb8, 0x8a, 0x34, 0x8f, 0x26, 0x30, 0xca, 0x66, 0x7c, 0xbf, 0x05, 0xc3, 0x5e,
-	0xe0, 0x25, 0x9e, 0xc3, 0x7c, 0x83, 0xc5, 0xdc, 0x13, 0xae, 0xd8, 0x2d, 0x72, 0xca, 0x61, 0x64,
-	0x1e, 0xf9, 0x2b, 0x66, 0xd8, 0xe4, 0x8c, 0x3e, 0x2a, 0xb2, 0xc6, 0xca, 0x85, 0x15, 0x9f, 0xa9,
-	0x66, 0x52, 0xc5, 0x5a, 0xd4, 0xc6, 0x4e, 0xa2, 0x82, 0x6a, 0x36, 0x61, 0x4a, 0x4a, 0xdd, 0xb3,
-	0xa2, 0x76, 0x31, 0xac, 0x19, 0x73, 0x46, 0x76, 0x0c, 0xa8, 0x7b, 0x2c, 0x0e, 0x99, 0x91, 0x33,
-	0x03, 0x35, 0xa7, 0x9d, 0x84, 0x4d, 0x3a, 0x4c, 0x22, 0x60, 0x40, 0xe7, 0x1c, 0x49, 0x00, 0xd6,
-	0x38, 0xf6, 0x17, 0x2b, 0x90, 0xa9, 0x62, 0x81, 0x76, 0xa1, 0xa6, 0xea, 0x58, 0x14, 0x93, 0xe1,
-	0xaa, 0x67, 0x94, 0xea, 0x8c, 0x6a, 0xc2, 0x9a, 0x19, 0xda, 0x94, 0x6e, 0x56, 0xbe, 0xda, 0x5f,
-	0xce, 0xba, 0x59, 0xbf, 0xbd, 0xbf, 0x53, 0x37, 0x3a, 0x57, 0x67, 0x78, 0xdd, 0xc2, 0xe9, 0x03,
-	0x3d, 0xb2, 0x07, 0xdd, 0x94, 0xfe, 0x09, 0x71, 0xab, 0x19, 0x26, 0x71, 0xdb, 0x4f, 0xc4, 0x6c,
-	0x78, 0xb9, 0xc0, 0x55, 0xc6, 0x09, 0xeb, 0x6a, 0x50, 0xfc, 0x3f, 0x36, 0x98, 0xa6, 0xfd, 0xe6,
-	0x83, 0xc7, 0xea, 0x37, 0x1f, 0x2a, 0xd4, 0x6f, 0x7e, 0x1e, 0x80, 0xcd, 0x6d, 0x9e, 0x39, 0x50,
-	0x65, 0xee, 0x4c, 0xa5, 0x62, 0xb0, 0x82, 0x60, 0x03, 0xcb, 0xfe, 0x46, 0x48, 0x97, 0x33, 0x43,
-	0x53, 0xb2, 0x7a, 0x1a, 0x3f, 0x11, 0x64, 0x49, 0x9b, 0xa9, 0x42, 0x67, 0x3f, 0x67, 0x81, 0x59,
-	0x73, 0x0d, 0xbd, 0xce, 0x8b, 0xbb, 0x59, 0x45, 0x9c, 0x30, 0x19, 0x74, 0xa7, 0x97, 0x9d, 0x56,
-	0x26, 0xda, 0x49, 0x56, 0x78, 0x3b, 0xfb, 0x01, 0xa8, 0x4a, 0xe8, 0xa1, 0x8c, 0xe5, 0x8f, 0xc1,
-	0x49, 0x59, 0x00, 0x42, 0x1e, 0x06, 0x89, 0xa8, 0x83, 0x83, 0x7d, 0x8c, 0xd2, 0x71, 0x58, 0xea,
-	0xe5, 0x38, 0x54, 0xbb, 0xe1, 0x72, 0xcf, 0xb2, 0xed, 0x3f, 0x6f, 0xc1, 0xb9, 0x6c, 0x07, 0xe2,
-	0xe5, 0x30, 0xf0, 0x92, 0x30, 0xaa, 0x93, 0x24, 0xf1, 0x82, 0x4d, 0x56, 0x83, 0xf7, 0xb6, 0x13,
-	0xc9, 0x7b, 0x98, 0x98, 0xa0, 0xbc, 0xe9, 0x44, 0x01, 0x66, 0xad, 0xa8, 0x03, 0x83, 0x3c, 0xd4,
-	0x5a, 0xec, 0x82, 0x8e, 0xb8, 0x36, 0x72, 0x86, 0x43, 0x6f, 0xc3, 0x78, 0x98, 0x37, 0x16, 0x0c,
-	0xed, 0xaf, 0x58, 0x80, 0x56, 0x76, 0x48, 0x14, 0x79, 0x0d, 0x23, 0x38, 0x9c, 0xdd, 0x0e, 0x6a,
-	0xdc, 0x02, 0x6a, 0x96, 0x27, 0xc9, 0xdc, 0x0e, 0x6a, 0xfc, 0xcb, 0xbf, 0x1d, 0xb4, 0x74, 0xb8,
-	0xdb, 0x41, 0xd1, 0x0a, 0x9c, 0x6e, 0xf2, 0x6d, 0x1c, 0xbf, 0x71, 0x8f, 0xef, 0xe9, 0x54, 0x26,
-	0xfd, 0x99, 0x3b, 0x7b, 0x53, 0xa7, 0x97, 0xf3, 0x10, 0x70, 0xfe, 0x73, 0xf6, 0x07, 0x00, 0xf1,
-	0x98, 0xf0, 0xf9, 0xbc, 0xb0, 0xd6, 0x9e, 0x6e, 0x0e, 0xfb, 0xc7, 0x2a, 0x30, 0x9e, 0xb9, 0xa5,
-	0x83, 0x6e, 0xa1, 0xbb, 0xe3, 0x68, 0x8f, 0xac, 0xbf, 0xbb, 0xbb, 0xd7, 0x57, 0x64, 0x6e, 0x00,
-	0x15, 0x2f, 0x68, 0xb5, 0x93, 0x62, 0x0a, 0x79, 0xf0, 0x4e, 0x2c, 0x52, 0x82, 0xc6, 0xb9, 0x04,
-	0xfd, 0x8b, 0x39, 0x9b, 0x22, 0xe3, 0x7c, 0x53, 0x9b, 0x9c, 0x81, 0x07, 0xe4, 0x66, 0xf9, 0x84,
-	0x8e, 0xba, 0xad, 0x14, 0xe1, 0x43, 0xce, 0x4c, 0x96, 0xe3, 0x0e, 0xb5, 0xfa, 0x99, 0x12, 0x0c,
-	0x1b, 0x1f, 0x0d, 0xfd, 0x44, 0xba, 0x22, 0xa9, 0x55, 0xdc, 0x2b, 0x31, 0xfa, 0xd3, 0xba, 0xe6,
-	0x28, 0x7f, 0xa5, 0xa7, 0xbb, 0x8b, 0x91, 0xde, 0xdd, 0x9b, 0x3a, 0x91, 0x29, 0x37, 0x9a, 0x2a,
-	0x50, 0x7a, 0xf6, 0xbb, 0x61, 0x3c, 0x43, 0x26, 0xe7, 0x95, 0xd7, 0xcc, 0x57, 0x3e, 0xb2, 0xbb,
-	0xcf, 0x1c, 0xb2, 0x2f, 0xd3, 0x21, 0x13, 0xf5, 0x03, 0x42, 0x9f, 0xf4, 0xe1, 0xeb, 0xcc, 0xec,
-	0x2f, 0x4a, 0x7d, 0x96, 0x09, 0x79, 0x2f, 0x54, 0x5b, 0xa1, 0xef, 0xb9, 0x9e, 0x2a, 0x68, 0xce,
-	0x0a, 0x93, 0xac, 0x8a, 0x36, 0xac, 0xa0, 0xe8, 0x36, 0xd4, 0x6e, 0xdd, 0x4e, 0xf8, 0x31, 0xa3,
-	0x38, 0xca, 0x28, 0xea, 0x74, 0x51, 0x19, 0x2d, 0xea, 0x1c, 0x13, 0x6b, 0x5e, 0xc8, 0x86, 0x41,
-	0xa6, 0x04, 0x65, 0x2e, 0x21, 0x3b, 0x66, 0x61, 0xda, 0x31, 0xc6, 0x02, 0x62, 0xff, 0xfb, 0x61,
-	0x38, 0x95, 0x77, 0x55, 0x12, 0x7a, 0x13, 0x06, 0x79, 0x1f, 0x8b, 0xb9, 0x8d, 0x2f, 0x8f, 0xc7,
-	0x25, 0x46, 0x50, 0x74, 0x8b, 0xfd, 0xc6, 0x82, 0xa7, 0xe0, 0xee, 0x3b, 0xeb, 0x62, 0x86, 0x1c,
-	0x0f, 0xf7, 0x25, 0x47, 0x73, 0x5f, 0x72, 0x38, 0x77, 0xdf, 0x59, 0x47, 0xbb, 0x50, 0xd9, 0xf4,
-	0x12, 0xe2, 0x08, 0xe7, 0xcc, 0xcd, 0x63, 0x61, 0x4e, 0x1c, 0x6e, 0xa5, 0xb1, 0x9f, 0x98, 0x33,
-	0x44, 0x5f, 0xb2, 0x60, 0x7c, 0x3d, 0x5d, 0x9f, 0x48, 0x08, 0x4f, 0xe7, 0x18, 0xae, 0xc3, 0x4a,
-	0x33, 0xe2, 0xd7, 0xe3, 0x66, 0x1a, 0x71, 0xb6, 0x3b, 0xe8, 0x93, 0x16, 0x0c, 0x6d, 0x78, 0xbe,
-	0x71, 0xdf, 0xc8, 0x31, 0x7c, 0x9c, 0x8b, 0x8c, 0x81, 0xde, 0x71, 0xf0, 0xff, 0x31, 0x96, 0x9c,
-	0x7b, 0x69, 0xaa, 0xc1, 0xa3, 0x6a, 0xaa, 0xa1, 0x07, 0xa4, 0xa9, 0x3e, 0x63, 0x41, 0x4d, 0x8d,
-	0xb4, 0xa8, 0xf3, 0xf2, 0xe1, 0x63, 0xfc, 0xe4, 0xdc, 0x23, 0xa5, 0xfe, 0x62, 0xcd, 0x1c, 0xbd,
-	0x65, 0xc1, 0xb0, 0xf3, 0x46, 0x3b, 0x22, 0x0d, 0xb2, 0x13, 0xb6, 0x62, 0x51, 0x80, 0xf5, 0xd5,
-	0xe2, 0x3b, 0x33, 0x4b, 0x99, 0x2c, 0x90, 0x9d, 0x95, 0x56, 0x2c, 0xf2, 0x9c, 0x75, 0x03, 0x36,
-	0xbb, 0x80, 0xbe, 0x4f, 0xeb, 0x71, 0x28, 0xa2, 0x0c, 0x77, 0x5e, 0x6f, 0xfa, 0x4a, 0xdb, 0x27,
-	0xf0, 0x98, 0x1b, 0x06, 0x89, 0x17, 0xb4, 0xc9, 0x4a, 0x80, 0x49, 0x2b, 0xbc, 0x16, 0x26, 0x17,
-	0xc3, 0x76, 0xd0, 0xb8, 0x10, 0x45, 0x61, 0xc4, 0x0a, 0xd9, 0x18, 0x97, 0xb0, 0xce, 0xf7, 0x46,
-	0xc5, 0xfb, 0xd1, 0x39, 0x8a, 0xcd, 0xb0, 0x57, 0x82, 0xa9, 0x03, 0x06, 0x1b, 0xbd, 0x08, 0x23,
-	0x61, 0xb4, 0xe9, 0x04, 0xde, 0x1b, 0x66, 0x6d, 0x36, 0x65, 0x90, 0xae, 0x18, 0x30, 0x9c, 0xc2,
-	0x34, 0x8b, 0xf6, 0x94, 0x0e, 0x28, 0xda, 0x73, 0x0e, 0x06, 0x22, 0xd2, 0x0a, 0xb3, 0xfb, 0x2a,
-	0x96, 0x9a, 0xc8, 0x20, 0xe8, 0x09, 0x28, 0x3b, 0x2d, 0x4f, 0x38, 0x17, 0xd5, 0x76, 0x71, 0x76,
-	0x75, 0x11, 0xd3, 0xf6, 0x54, 0x0d, 0xb1, 0xca, 0x7d, 0xa9, 0x21, 0x46, 0x35, 0xa6, 0x38, 0x3e,
-	0x1b, 0xd4, 0x1a, 0x33, 0x7d, 0xac, 0x65, 0xbf, 0x5d, 0x86, 0x27, 0xf6, 0x5d, 0x5a, 0x3a, 0x64,
-	0xdd, 0xda, 0x27, 0x64, 0x5d, 0x0e, 0x4f, 0xe9, 0xa0, 0xe1, 0x29, 0xf7, 0x18, 0x9e, 0x4f, 0x52,
-	0x89, 0x21, 0x6b, 0xda, 0x15, 0x73, 0x91, 0x7c, 0xaf, 0x12, 0x79, 0x42, 0x58, 0x48, 0x28, 0xd6,
-	0x7c, 0xe9, 0x76, 0x29, 0x55, 0xb0, 0xa6, 0x52, 0x84, 0xc6, 0xec, 0x59, 0x57, 0x8e, 0x8b, 0x89,
-	0x5e, 0x55, 0x70, 0xec, 0x5f, 0x1c, 0x80, 0xa7, 0xfa, 0x50, 0x74, 0xe6, 0x2c, 0xb6, 0xfa, 0x9c,
-	0xc5, 0x5f, 0xe3, 0x9f, 0xe9, 0xd3, 0xb9, 0x9f, 0x09, 0x17, 0xff, 0x99, 0xf6, 0xff, 0x42, 0xec,
-	0x04, 0x22, 0x88, 0x89, 0xdb, 0x8e, 0x78, 0xfa, 0x8e, 0x91, 0xb7, 0xbc, 0x28, 0xda, 0xb1, 0xc2,
-	0xa0, 0xdb, 0x5f, 0xd7, 0xa1, 0xcb, 0x7f, 0xa8, 0xa0, 0x02, 0x25, 0x66, 0x0a, 0x34, 0xb7, 0xbe,
-	0xe6, 0x67, 0xa9, 0x04, 0xe0, 0x6c, 0xec, 0x5f, 0xb3, 0xe0, 0x6c, 0x6f, 0x6b, 0x04, 0x3d, 0x0b,
-	0xc3, 0xeb, 0x2c, 0x98, 0x72, 0x99, 0x85, 0x4c, 0x89, 0xa9, 0xc3, 0xde, 0x57, 0x37, 0x63, 0x13,
-	0x07, 0xcd, 0xc3, 0x84, 0x19, 0x85, 0xb9, 0x6c, 0xc4, 0x5a, 0x31, 0x7f, 0xc9, 0x5a, 0x16, 0x88,
-	0xbb, 0xf1, 0xd1, 0x34, 0x40, 0xe2, 0x25, 0x3e, 0xe1, 0x4f, 0xf3, 0x89, 0xc6, 0x1c, 0x8a, 0x6b,
-	0xaa, 0x15, 0x1b, 0x18, 0xf6, 0x57, 0xcb, 0xf9, 0xaf, 0xc1, 0xad, 0xdc, 0xc3, 0xcc, 0x7e, 0x31,
-	0xb7, 0x4b, 0x7d, 0x48, 0xe8, 0xf2, 0xfd, 0x96, 0xd0, 0x03, 0xbd, 0x24, 0x34, 0x5a, 0x80, 0x13,
-	0xc6, 0xb5, 0xae, 0xbc, 0xc4, 0x0d, 0x3f, 0x94, 0x52, 0xf5, 0xe9, 0x56, 0x33, 0x70, 0xdc, 0xf5,
-	0xc4, 0x43, 0x3e, 0x55, 0x7f, 0xbd, 0x04, 0x67, 0x7a, 0x6e, 0x2c, 0xee, 0x93, 0x06, 0x32, 0x3f,
-	0xff, 0xc0, 0xfd, 0xf9, 0xfc, 0xe6, 0x47, 0xa9, 0x1c, 0xf8, 0x51, 0xfa, 0x51, 0xe7, 0xbf, 0x57,
-	0xea, 0xb9, 0x58, 0xe8, 0x46, 0xf4, 0xaf, 0xec, 0x48, 0xbe, 0x04, 0xa3, 0x4e, 0xab, 0xc5, 0xf1,
-	0x58, 0x66, 0x46, 0xa6, 0x66, 0xe6, 0xac, 0x09, 0xc4, 0x69, 0xdc, 0xbe, 0x06, 0xf6, 0x8f, 0x2c,
-	0xa8, 0x61, 0xb2, 0xc1, 0x25, 0x1c, 0xba, 0x25, 0x86, 0xc8, 0x2a, 0xe2, 0xe2, 0x02, 0x3a, 0xb0,
-	0xb1, 0xc7, 0xaa, 0xf9, 0xe7, 0x0d, 0xf6, 0x51, 0x2b, 0x30, 0xa8, 0xcb, 0x60, 0xcb, 0xbd, 0x2f,
-	0x83, 0xb5, 0x7f, 0xa9, 0x46, 0x5f, 0xaf, 0x15, 0xce, 0x47, 0xa4, 0x11, 0xd3, 0xef, 0xdb, 0x8e,
-	0x7c, 0x31, 0x49, 0xd4, 0xf7, 0xbd, 0x8e, 0x97, 0x30, 0x6d, 0x4f, 0x9d, 0x4f, 0x96, 0x0e, 0x55,
-	0x31, 0xb0, 0x7c, 0x60, 0xc5, 0xc0, 0x97, 0x60, 0x34, 0x8e, 0xb7, 0x56, 0x23, 0x6f, 0xc7, 0x49,
-	0xc8, 0x55, 0x22, 0x4b, 0x0b, 0xe9, 0xea, 0x59, 0xf5, 0xcb, 0x1a, 0x88, 0xd3, 0xb8, 0xe8, 0x12,
-	0x4c, 0xe8, 0xba, 0x7d, 0x24, 0x4a, 0x58, 0xca, 0x23, 0x9f, 0x09, 0xaa, 0x6c, 0x8c, 0xae, 0xf4,
-	0x27, 0x10, 0x70, 0xf7, 0x33, 0x54, 0xe6, 0xa6, 0x1a, 0x69, 0x47, 0x06, 0xd3, 0x32, 0x37, 0x45,
-	0x87, 0xf6, 0xa5, 0xeb, 0x09, 0xb4, 0x0c, 0x27, 0xf9, 0xc4, 0x98, 0x6d, 0xb5, 0x8c, 0x37, 0x1a,
-	0x4a, 0x57, 0x8b, 0xbf, 0xd4, 0x8d, 0x82, 0xf3, 0x9e, 0x43, 0x2f, 0xc0, 0xb0, 0x6a, 0x5e, 0x5c,
-	0x10, 0x47, 0x6b, 0xca, 0xb5, 0xa7, 0xc8, 0x2c, 0x36, 0xb0, 0x89, 0x87, 0x3e, 0x04, 0x8f, 0xea,
-	0xbf, 0x3c, 0x85, 0x9e, 0x9f, 0x37, 0x2f, 0x88, 0x92, 0xa8, 0xea, 0x32, 0xb2, 0x4b, 0xb9, 0x68,
-	0x0d, 0xdc, 0xeb, 0x79, 0xb4, 0x0e, 0x67, 0x15, 0xe8, 0x42, 0x90, 0xb0, 0x24, 0xd7, 0x98, 0xcc,
-	0x39, 0x31, 0x8b, 0x9c, 0x00, 0xf6, 0x9e, 0xb6, 0xa0, 0x7e, 0xf6, 0x92, 0x97, 0x5c, 0xce, 0xc3,
-	0xc4, 0x4b, 0x78, 0x1f, 0x2a, 0x68, 0x06, 0x6a, 0x24, 0x70, 0xd6, 0x7d, 0xb2, 0x32, 0xbf, 0x28,
-	0x76, 0xa4, 0x3a, 0x3b, 0x42, 0x02, 0xb0, 0xc6, 0x51, 0xf1, 0xfd, 0x23, 0xbd, 0xe2, 0xfb, 0xd1,
-	0x2a, 0x9c, 0xda, 0x74, 0x5b, 0xd4, 0xca, 0xf4, 0x5c, 0x32, 0xeb, 0xb2, 0x80, 0x62, 0xfa, 0x61,
-	0x78, 0x19, 0x7f, 0x95, 0x28, 0x75, 0x69, 0x7e, 0xb5, 0x0b, 0x07, 0xe7, 0x3e, 0xc9, 0x02, 0xcf,
-	0xa3, 0x70, 0xb7, 0x33, 0x79, 0x32, 0x13, 0x78, 0x4e, 0x1b, 0x31, 0x87, 0xa1, 0x2b, 0x80, 0x58,
-	0xb2, 0xe0, 0xe5, 0x24, 0x69, 0x29, 0xb3, 0x76, 0xf2, 0x54, 0xba, 0x40, 0xe2, 0xc5, 0x2e, 0x0c,
-	0x9c, 0xf3, 0x14, 0xb5, 0x7a, 0x82, 0x90, 0x51, 0x9f, 0x7c, 0x34, 0x6d, 0xf5, 0x5c, 0xe3, 0xcd,
-	0x58, 0xc2, 0xd1, 0x77, 0xc2, 0x64, 0x3b, 0x26, 0x6c, 0xc3, 0x7c, 0x33, 0x8c, 0xb6, 0xfd, 0xd0,
-	0x69, 0x2c, 0xb2, 0x5b, 0x67, 0x93, 0xce, 0xe4, 0x24, 0x63, 0x7e, 0x4e, 0x3c, 0x3b, 0x79, 0xbd,
-	0x07, 0x1e, 0xee, 0x49, 0x21, 0x5b, 0xe1, 0xf3, 0x4c, 0x9f, 0x15, 0x3e, 0x57, 0xe1, 0x94, 0xd4,
-	0x6b, 0x2b, 0xf3, 0x8b, 0xea, 0xa5, 0x27, 0xcf, 0xa6, 0xaf, 0xb1, 0x5b, 0xcc, 0xc1, 0xc1, 0xb9,
-	0x4f, 0xda, 0x7f, 0x68, 0xc1, 0xa8, 0x92, 0x60, 0xf7, 0x21, 0x69, 0xd9, 0x4f, 0x27, 0x2d, 0x5f,
-	0x3a, 0xba, 0x0e, 0x60, 0x3d, 0xef, 0x91, 0x62, 0xf3, 0x23, 0xa3, 0x00, 0x5a, 0x4f, 0x28, 0x15,
-	0x6d, 0xf5, 0x54, 0xd1, 0x0f, 0xad, 0x8c, 0xce, 0xab, 0xd8, 0x58, 0x79, 0xb0, 0x15, 0x1b, 0xeb,
-	0x70, 0x5a, 0x4e, 0x29, 0x7e, 0xa4, 0x7c, 0x39, 0x8c, 0x95, 0xc8, 0x37, 0xee, 0x25, 0x5c, 0xcc,
-	0x43, 0xc2, 0xf9, 0xcf, 0xa6, 0x6c, 0xbb, 0xa1, 0x03, 0x6d, 0x3b, 0x25, 0xe5, 0x96, 0x36, 0xe4,
-	0xad, 0xa1, 0x19, 0x29, 0xb7, 0x74, 0xb1, 0x8e, 0x35, 0x4e, 0xbe, 0xaa, 0xab, 0x15, 0xa4, 0xea,
-	0xe0, 0xd0, 0xaa, 0x4e, 0x0a, 0xdd, 0xe1, 0x9e, 0x42, 0x57, 0x1e, 0x5d, 0x8d, 0xf4, 0x3c, 0xba,
-	0xfa, 0x20, 0x8c, 0x79, 0xc1, 0x16, 0x89, 0xbc, 0x84, 0x34, 0xd8, 0x5a, 0x60, 0x02, 0xb9, 0xaa,
-	0x0d, 0x9d, 0xc5, 0x14, 0x14, 0x67, 0xb0, 0xd3, 0x9a, 0x62, 0xac, 0x0f, 0x4d, 0xd1, 0x43, 0x3f,
-	0x8f, 0x17, 0xa3, 0x9f, 0x4f, 0x1c, 0x5d, 0x3f, 0x4f, 0x1c, 0xab, 0x7e, 0x46, 0x85, 0xe8, 0xe7,
-	0xbe, 0x54, 0x9f, 0xb1, 0x49, 0x3f, 0x75, 0xc0, 0x26, 0xbd, 0x97, 0x72, 0x3e, 0x7d, 0xcf, 0xca,
-	0x39, 0x5f, 0xef, 0x3e, 0xf2, 0x8e, 0xde, 0x2d, 0x44, 0xef, 0x7e, 0xa6, 0x04, 0xa7, 0xb5, 0x66,
-	0xa2, 0xf2, 0xc0, 0xdb, 0xa0, 0xb2, 0x99, 0x5d, 0xc5, 0xcd, 0x0f, 0xbc, 0x8d, 0x54, 0x79, 0x5d,
-	0x2c, 0x40, 0x41, 0xb0, 0x81, 0xc5, 0x32, 0xce, 0x49, 0xc4, 0x2e, 0x81, 0xc9, 0xaa, 0xad, 0x79,
-	0xd1, 0x8e, 0x15, 0x06, 0x1d, 0x04, 0xfa, 0x5b, 0x14, 0x3c, 0xc9, 0x96, 0x17, 0x9f, 0xd7, 0x20,
-	0x6c, 0xe2, 0xa1, 0xf7, 0x72, 0x26, 0x4c, 0x64, 0x52, 0xd5, 0x35, 0xc2, 0xb7, 0x95, 0x4a, 0x4a,
-	0x2a, 0xa8, 0xec, 0x0e, 0xab, 0x88, 0x50, 0xe9, 0xee, 0x0e, 0x8b, 0x1d, 0x55, 0x18, 0xf6, 0xff,
-	0xb2, 0xe0, 0x4c, 0xee, 0x50, 0xdc, 0x07, 0x73, 0x64, 0x37, 0x6d, 0x8e, 0xd4, 0x8b, 0xda, 0x92,
-	0x1a, 0x6f, 0xd1, 0xc3, 0x34, 0xf9, 0x4f, 0x16, 0x8c, 0x69, 0xfc, 0xfb, 0xf0, 0xaa, 0x5e, 0xfa,
-	0x55, 0x8b, 0xdb, 0x7d, 0xd7, 0xba, 0xde, 0xed, 0x57, 0x4b, 0xa0, 0x4a, 0xfe, 0xcf, 0xba, 0x49,
-	0x7f, 0xe9, 0x66, 0x1d, 0x18, 0x64, 0x11, 0x24, 0x71, 0x31, 0xd1, 0x71, 0x69, 0xfe, 0x2c, 0x1a,
-	0x45, 0x1f, 0xe8, 0xb1, 0xbf, 0x31, 0x16, 0x0c, 0xd9, 0x15, 0x45, 0xbc, 0x9a, 0x7a, 0x43, 0x24,
-	0x4e, 0xeb, 0x2b, 0x8a, 0x44, 0x3b, 0x56, 0x18, 0x54, 0x61, 0x7a, 0x6e, 0x18, 0xcc, 0xfb, 0x4e,
-	0x1c, 0x0b, 0x1b, 0x4e, 0x29, 0xcc, 0x45, 0x09, 0xc0, 0x1a, 0x87, 0x05, 0x97, 0x78, 0x71, 0xcb,
-	0x77, 0x3a, 0x86, 0x8f, 0xc5, 0x28, 0xec, 0xa5, 0x40, 0xd8, 0xc4, 0xb3, 0x9b, 0x30, 0x99, 0x7e,
-	0x89, 0x05, 0xb2, 0xc1, 0x22, 0xbb, 0xfb, 0x1a, 0xce, 0x19, 0xa8, 0x39, 0xec, 0xa9, 0xa5, 0xb6,
-	0x23, 0x64, 0x82, 0x8e, 0x6f, 0x96, 0x00, 0xac, 0x71, 0xec, 0x7f, 0x66, 0xc1, 0xc9, 0x9c, 0x41,
-	0x2b, 0x30, 0x31, 0x3d, 0xd1, 0xd2, 0x26, 0xcf, 0xd4, 0xf9, 0x06, 0x18, 0x6a, 0x90, 0x0d, 0x47,
-	0xc6, 0x0e, 0x9b, 0xa9, 0x06, 0xbc, 0x19, 0x4b, 0xb8, 0xfd, 0x0b, 0x25, 0x18, 0x4f, 0xf7, 0x35,
-	0x66, 0xe9, 0x96, 0x7c, 0x98, 0xbc, 0xd8, 0x0d, 0x77, 0x48, 0xd4, 0xa1, 0x6f, 0x6e, 0x65, 0xd2,
-	0x2d, 0xbb, 0x30, 0x70, 0xce, 0x53, 0xec, 0xc2, 0x8f, 0x86, 0x1a, 0x6d, 0x39, 0x23, 0x6f, 0x14,
-	0x39, 0x23, 0xf5, 0xc7, 0x34, 0xe3, 0x8c, 0x14, 0x4b, 0x6c, 0xf2, 0xa7, 0x26, 0x17, 0x4b, 0x16,
-	0x99, 0x6b, 0x7b, 0x7e, 0xe2, 0x05, 0xe2, 0x95, 0xc5, 0x5c, 0x55, 0x26, 0xd7, 0x72, 0x37, 0x0a,
-	0xce, 0x7b, 0xce, 0xfe, 0xca, 0x00, 0xa8, 0xa2, 0x2b, 0x2c, 0x0e, 0xb4, 0xa0, 0x28, 0xda, 0xc3,
-	0x26, 0xed, 0xaa, 0xb9, 0x35, 0xb0, 0x5f, 0x60, 0x16, 0x77, 0xcc, 0x99, 0x1e, 0x7c, 0x35, 0x60,
-	0x6b, 0x1a, 0x84, 0x4d, 0x3c, 0xda, 0x13, 0xdf, 0xdb, 0x21, 0xfc, 0xa1, 0xc1, 0x74, 0x4f, 0x96,
-	0x24, 0x00, 0x6b, 0x1c, 0x56, 0xdf, 0xdb, 0xdb, 0xd8, 0x10, 0x5e, 0x26, 0x5d, 0xdf, 0xdb, 0xdb,
-	0xd8, 0xc0, 0x0c, 0xc2, 0xaf, 0x84, 0x0a, 0xb7, 0xc5, 0x36, 0xc3, 0xb8, 0x12, 0x2a, 0xdc, 0xc6,
-	0x0c, 0x42, 0xbf, 0x52, 0x10, 0x46, 0x4d, 0xc7, 0xf7, 0xde, 0x20, 0x0d, 0xc5, 0x45, 0x6c, 0x2f,
-	0xd4, 0x57, 0xba, 0xd6, 0x8d, 0x82, 0xf3, 0x9e, 0xa3, 0x13, 0xba, 0x15, 0x91, 0x86, 0xe7, 0x26,
-	0x26, 0x35, 0x48, 0x4f, 0xe8, 0xd5, 0x2e, 0x0c, 0x9c, 0xf3, 0x14, 0x9a, 0x85, 0x71, 0x59, 0x34,
-	0x47, 0x16, 0x9a, 0x1c, 0x4e, 0x57, 0xab, 0xc3, 0x69, 0x30, 0xce, 0xe2, 0x53, 0x21, 0xd9, 0x14,
-	0x65, 0x72, 0xd9, 0x6e, 0xc4, 0x10, 0x92, 0xb2, 0x7c, 0x2e, 0x56, 0x18, 0xf6, 0x27, 0xca, 0x54,
-	0xa9, 0xf7, 0xa8, 0x46, 0x7d, 0xdf, 0xa2, 0xb6, 0xd3, 0x33, 0x72, 0xa0, 0x8f, 0x19, 0xf9, 0x3c,
-	0x8c, 0xdc, 0x8a, 0xc3, 0x40, 0x45, 0x44, 0x57, 0x7a, 0x46, 0x44, 0x1b, 0x58, 0xf9, 0x11, 0xd1,
-	0x83, 0x45, 0x45, 0x44, 0x0f, 0xdd, 0x63, 0x44, 0xf4, 0xbf, 0xa9, 0x80, 0xba, 0xf3, 0xf3, 0x1a,
-	0x49, 0x6e, 0x87, 0xd1, 0xb6, 0x17, 0x6c, 0xb2, 0x02, 0x30, 0x5f, 0xb2, 0x64, 0x0d, 0x99, 0x25,
-	0x33, 0x53, 0x78, 0xa3, 0xa0, 0x7b, 0x1b, 0x53, 0xcc, 0xa6, 0xd7, 0x0c, 0x46, 0x3c, 0xb2, 0x26,
-	0x53, 0xab, 0x46, 0x1c, 0x1a, 0xa4, 0x7a, 0x84, 0xbe, 0x1b, 0x40, 0xba, 0xe4, 0x37, 0xa4, 0x04,
-	0x5e, 0x2c, 0xa6, 0x7f, 0x98, 0x6c, 0x68, 0x93, 0x7a, 0x4d, 0x31, 0xc1, 0x06, 0x43, 0xf4, 0x19,
-	0x9d, 0x45, 0xcd, 0x53, 0xa7, 0x3e, 0x7a, 0x2c, 0x63, 0xd3, 0x4f, 0x0e, 0x35, 0x86, 0x21, 0x2f,
-	0xd8, 0xa4, 0xf3, 0x44, 0x44, 0x8e, 0xbe, 0x27, 0xaf, 0xbe, 0xd8, 0x52, 0xe8, 0x34, 0xe6, 0x1c,
-	0xdf, 0x09, 0x5c, 0x12, 0x2d, 0x72, 0x74, 0xad, 0x41, 0x45, 0x03, 0x96, 0x84, 0xba, 0x2e, 0x26,
-	0xad, 0xf4, 0x73, 0x31, 0xe9, 0xd9, 0x6f, 0x83, 0x89, 0xae, 0x8f, 0x79, 0xa8, 0x94, 0xe9, 0x23,
-	0x54, 0x16, 0xfb, 0xc5, 0x41, 0xad, 0xb4, 0xae, 0x85, 0x0d, 0x7e, 0xcf, 0x65, 0xa4, 0xbf, 0xa8,
-	0x30, 0x99, 0x0b, 0x9c, 0x22, 0x4a, 0xcd, 0x18, 0x8d, 0xd8, 0x64, 0x49, 0xe7, 0x68, 0xcb, 0x89,
-	0x48, 0x70, 0xdc, 0x73, 0x74, 0x55, 0x31, 0xc1, 0x06, 0x43, 0xb4, 0x95, 0xca, 0xed, 0xbb, 0x78,
-	0xf4, 0xdc, 0x3e, 0x56, 0xed, 0x35, 0xef, 0x3a, 0xb8, 0xb7, 0x2c, 0x18, 0x0b, 0x52, 0x33, 0xb7,
-	0x98, 0x70, 0xfe, 0xfc, 0x55, 0xc1, 0xaf, 0x8c, 0x4e, 0xb7, 0xe1, 0x0c, 0xff, 0x3c, 0x95, 0x56,
-	0x39, 0xa4, 0x4a, 0xd3, 0xf7, 0xec, 0x0e, 0xf6, 0xba, 0x67, 0x17, 0x05, 0xea, 0x02, 0xf4, 0xa1,
-	0x22, 0x2a, 0xa4, 0xa4, 0x6e, 0x3f, 0x87, 0x9c, 0x9b, 0xcf, 0x6f, 0x9a, 0xa9, 0xbf, 0x87, 0xbf,
-	0x08, 0x7b, 0xb4, 0x57, 0x8a, 0xb0, 0xfd, 0x7f, 0x07, 0xe0, 0x84, 0x1c, 0x11, 0x99, 0x0a, 0x44,
-	0xf5, 0x23, 0xe7, 0xab, 0x6d, 0x65, 0xa5, 0x1f, 0x2f, 0x4b, 0x00, 0xd6, 0x38, 0xd4, 0x1e, 0x6b,
-	0xc7, 0x64, 0xa5, 0x45, 0x82, 0x25, 0x6f, 0x3d, 0x16, 0xc7, 0xef, 0x6a, 0xa1, 0x5c, 0xd7, 0x20,
-	0x6c, 0xe2, 0xb1, 0xfc, 0x64, 0xd7, 0x2c, 0x12, 0xa2, 0xf3, 0x93, 0x85, 0xa1, 0x2a, 0xe1, 0xe8,
-	0x47, 0x73, 0xaf, 0xc7, 0x28, 0x26, 0x81, 0xb6, 0x2b, 0x03, 0xea, 0x70, 0xf7, 0x62, 0xa0, 0x7f,
-	0x64, 0xc1, 0x69, 0xde, 0x2a, 0x47, 0xf2, 0x7a, 0xab, 0xe1, 0x24, 0x24, 0x2e, 0xe6, 0x2a, 0xb1,
-	0x9c, 0xfe, 0x69, 0x2f, 0x7a, 0x1e, 0x5b, 0x9c, 0xdf, 0x1b, 0xf4, 0x45, 0x0b, 0xc6, 0xb7, 0x53,
-	0x45, 0xbe, 0xa4, 0xea, 0x38, 0x6a, 0x05, 0x9c, 0x14, 0x51, 0xbd, 0xd4, 0xd2, 0xed, 0x31, 0xce,
-	0x72, 0xb7, 0xff, 0xdc, 0x02, 0x53, 0x8c, 0xde, 0xff, 0xda, 0x60, 0x87, 0x37, 0x05, 0xa5, 0x75,
-	0x59, 0xe9, 0x69, 0x5d, 0x3e, 0x01, 0xe5, 0xb6, 0xd7, 0x10, 0xfb, 0x0b, 0x7d, 0xe0, 0xbf, 0xb8,
-	0x80, 0x69, 0xbb, 0xfd, 0xc7, 0x15, 0xed, 0x06, 0x11, 0xf9, 0xa9, 0x7f, 0x25, 0x5e, 0x7b, 0x43,
-	0x15, 0xfd, 0xe5, 0x6f, 0x7e, 0xad, 0xab, 0xe8, 0xef, 0xb7, 0x1c, 0x3e, 0xfd, 0x98, 0x0f, 0x50,
-	0xaf, 0x9a, 0xbf, 0x43, 0x07, 0xe4, 0x1e, 0xdf, 0x82, 0x2a, 0xdd, 0x82, 0x31, 0x7f, 0x66, 0x35,
-	0xd5, 0xa9, 0xea, 0x65, 0xd1, 0x7e, 0x77, 0x6f, 0xea, 0x9b, 0x0f, 0xdf, 0x2d, 0xf9, 0x34, 0x56,
-	0xf4, 0x51, 0x0c, 0x35, 0xfa, 0x9b, 0xa5, 0x49, 0x8b, 0xcd, 0xdd, 0x75, 0x25, 0x33, 0x25, 0xa0,
-	0x90, 0x1c, 0x6c, 0xcd, 0x07, 0x05, 0x50, 0xa3, 0x88, 0x9c, 0x29, 0xdf, 0x03, 0xae, 0xaa, 0x64,
-	0x65, 0x09, 0xb8, 0xbb, 0x37, 0xf5, 0xd2, 0xe1, 0x99, 0xaa, 0xc7, 0xb1, 0x66, 0x61, 0xa8, 0xc6,
-	0xe1, 0x9e, 0x57, 0xd0, 0xff, 0xbf, 0x01, 0x3d, 0xbf, 0x45, 0x3d, 0xe8, 0xbf, 0x12, 0xf3, 0xfb,
-	0xc5, 0xcc, 0xfc, 0x3e, 0xd7, 0x35, 0xbf, 0xc7, 0xe8, 0x98, 0xe5, 0x54, 0xa9, 0xbe, 0xdf, 0xc6,
-	0xc2, 0xc1, 0x3e, 0x09, 0x66, 0x25, 0xbd, 0xde, 0xf6, 0x22, 0x12, 0xaf, 0x46, 0xed, 0xc0, 0x0b,
-	0x36, 0xd9, 0x94, 0xad, 0x9a, 0x56, 0x52, 0x0a, 0x8c, 0xb3, 0xf8, 0x74, 0xe3, 0x4f, 0xe7, 0xc5,
-	0x4d, 0x67, 0x87, 0xcf, 0x3c, 0xa3, 0x16, 0x67, 0x5d, 0xb4, 0x63, 0x85, 0x81, 0xb6, 0xe0, 0x71,
-	0x49, 0x60, 0x81, 0xf8, 0x84, 0xbe, 0x10, 0x0b, 0x64, 0x8c, 0x9a, 0x3c, 0xcd, 0x80, 0xc7, 0xa2,
-	0xbc, 0x5b, 0x50, 0x78, 0x1c, 0xef, 0x83, 0x8b, 0xf7, 0xa5, 0x64, 0x7f, 0x99, 0x85, 0x2e, 0x18,
-	0xd5, 0x22, 0xe8, 0xec, 0xf3, 0xbd, 0xa6, 0x27, 0x4b, 0x86, 0xaa, 0xd9, 0xb7, 0x44, 0x1b, 0x31,
-	0x87, 0xa1, 0xdb, 0x30, 0xb4, 0xce, 0xaf, 0xa6, 0x2f, 0xe6, 0x4a, 0x28, 0x71, 0xcf, 0x3d, 0x2b,
-	0x17, 0x2e, 0x2f, 0xbd, 0xbf, 0xab, 0x7f, 0x62, 0xc9, 0xcd, 0xfe, 0x9d, 0x0a, 0x8c, 0xcb, 0xf0,
-	0xb2, 0xcb, 0x5e, 0xcc, 0x22, 0x12, 0xcc, 0x3b, 0x14, 0x4a, 0x07, 0xde, 0xa1, 0xf0, 0x11, 0x80,
-	0x06, 0x69, 0xf9, 0x61, 0x87, 0x19, 0x87, 0x03, 0x87, 0x36, 0x0e, 0xd5, 0x7e, 0x62, 0x41, 0x51,
-	0xc1, 0x06, 0x45, 0x51, 0x27, 0x95, 0x5f, 0xc9, 0x90, 0xa9, 0x93, 0x6a, 0x5c, 0x1c, 0x37, 0x78,
-	0x7f, 0x2f, 0x8e, 0xf3, 0x60, 0x9c, 0x77, 0x51, 0xd5, 0x64, 0xb8, 0x87, 0xd2, 0x0b, 0x2c, 0xab,
-	0x6d, 0x21, 0x4d, 0x06, 0x67, 0xe9, 0x9a, 0xb7, 0xc2, 0x55, 0xef, 0xf7, 0xad, 0x70, 0xef, 0x83,
-	0x9a, 0xfc, 0xce, 0xf1, 0x64, 0x4d, 0xd7, 0x0b, 0x92, 0xd3, 0x20, 0xc6, 0x1a, 0xde, 0x55, 0x5e,
-	0x06, 0x1e, 0x54, 0x79, 0x19, 0xfb, 0xad, 0x32, 0xdd, 0x55, 0xf0, 0x7e, 0x1d, 0xfa, 0x52, 0xc5,
-	0xcb, 0xc6, 0xa5, 0x8a, 0x87, 0xfb, 0x9e, 0xd5, 0xcc, 0xe5, 0x8b, 0x8f, 0xc3, 0x40, 0xe2, 0x6c,
-	0xca, 0x24, 0x5c, 0x06, 0x5d, 0x73, 0x36, 0x63, 0xcc, 0x5a, 0x0f, 0x53, 0x56, 0xfa, 0x25, 0x18,
-	0x8d, 0xbd, 0xcd, 0xc0, 0x49, 0xda, 0x11, 0x31, 0xce, 0x2f, 0x75, 0x90, 0x8e, 0x09, 0xc4, 0x69,
-	0x5c, 0xf4, 0x49, 0x0b, 0x20, 0x22, 0x6a, 0xcf, 0x32, 0x58, 0xc4, 0x1c, 0x52, 0x62, 0x40, 0xd2,
-	0x35, 0xcb, 0x82, 0xa8, 0xbd, 0x8a, 0xc1, 0xd6, 0xfe, 0xb4, 0x05, 0x13, 0x5d, 0x4f, 0xa1, 0x16,
-	0x0c, 0xba, 0xec, 0xea, 0xcb, 0x62, 0x4a, 0x61, 0xa6, 0xaf, 0xd1, 0xe4, 0xca, 0x89, 0xb7, 0x61,
-	0xc1, 0xc7, 0xfe, 0xa5, 0x11, 0x38, 0x55, 0x9f, 0x5f, 0x96, 0x17, 0x21, 0x1d, 0x5b, 0x56, 0x71,
-	0x1e, 0x8f, 0xfb, 0x97, 0x55, 0xdc, 0x83, 0xbb, 0x6f, 0x64, 0x15, 0xfb, 0x46, 0x56, 0x71, 0x3a,
-	0xc5, 0xb3, 0x5c, 0x44, 0x8a, 0x67, 0x5e, 0x0f, 0xfa, 0x49, 0xf1, 0x3c, 0xb6, 0x34, 0xe3, 0x7d,
-	0x3b, 0x74, 0xa8, 0x34, 0x63, 0x95, 0x83, 0x5d, 0x48, 0x46, 0x59, 0x8f, 0x4f, 0x95, 0x9b, 0x83,
-	0xad, 0xf2, 0x5f, 0x79, 0xb6, 0xa4, 0x50, 0x7a, 0xaf, 0x16, 0xdf, 0x81, 0x3e, 0xf2, 0x5f, 0x45,
-	0xc2, 0xa6, 0x99, 0x73, 0x3d, 0x54, 0x44, 0xce, 0x75, 0x5e, 0x77, 0x0e, 0xcc, 0xb9, 0x7e, 0x09,
-	0x46, 0x5d, 0x3f, 0x0c, 0xc8, 0x6a, 0x14, 0x26, 0xa1, 0x1b, 0xca, 0x8b, 0xc6, 0xf5, 0x9d, 0x91,
-	0x26, 0x10, 0xa7, 0x71, 0x7b, 0x25, 0x6c, 0xd7, 0x8e, 0x9a, 0xb0, 0x0d, 0x0f, 0x28, 0x61, 0xdb,
-	0x48, 0x49, 0x1e, 0x2e, 0x22, 0x25, 0x39, 0xef, 0x8b, 0xf4, 0x95, 0x92, 0xfc, 0x36, 0xbf, 0x67,
-	0x9f, 0x6e, 0x46, 0xb8, 0x14, 0x66, 0x47, 0x74, 0xc3, 0xe7, 0x5f, 0x3b, 0x86, 0x09, 0x7b, 0xb3,
-	0xae, 0xd9, 0xa8, 0xbb, 0xf7, 0x75, 0x13, 0x4e, 0x77, 0xe4, 0x28, 0x69, 0xcc, 0x3f, 0x56, 0x82,
-	0xaf, 0x3b, 0xb0, 0x0b, 0xe8, 0x36, 0x40, 0xe2, 0x6c, 0x8a, 0x89, 0x2a, 0x0e, 0xb2, 0x8e, 0x18,
-	0x57, 0xbc, 0x26, 0xe9, 0x89, 0x14, 0x3b, 0x45, 0x1e, 0x1b, 0xac, 0x58, 0x38, 0x71, 0xe8, 0x77,
-	0x55, 0xb1, 0xc6, 0xa1, 0x4f, 0x30, 0x83, 0x50, 0x43, 0x28, 0x22, 0x9b, 0xd4, 0xb8, 0x2f, 0xa7,
-	0x0d, 0x21, 0xcc, 0x5a, 0xb1, 0x80, 0xa2, 0x17, 0x60, 0xd8, 0xf1, 0x7d, 0x9e, 0xee, 0x47, 0x62,
-	0x71, 0x99, 0xab, 0xae, 0x5d, 0xab, 0x41, 0xd8, 0xc4, 0xb3, 0xff, 0xac, 0x04, 0x53, 0x07, 0xc8,
-	0x94, 0xae, 0x34, 0xef, 0x4a, 0xdf, 0x69, 0xde, 0x22, 0x5d, 0x69, 0xb0, 0x47, 0xba, 0xd2, 0x0b,
-	0x30, 0x9c, 0x10, 0xa7, 0x29, 0x22, 0x11, 0xb3, 0x25, 0x19, 0xd7, 0x34, 0x08, 0x9b, 0x78, 0x54,
-	0x8a, 0x8d, 0x39, 0xae, 0x4b, 0xe2, 0x58, 0xe6, 0x23, 0x09, 0x2f, 0x77, 0x61, 0xc9, 0x4e, 0xec,
-	0xf0, 0x60, 0x36, 0xc5, 0x02, 0x67, 0x58, 0x66, 0x07, 0xbc, 0xd6, 0xe7, 0x80, 0xff, 0x64, 0x09,
-	0x9e, 0xd8, 0x57, 0xbb, 0xf5, 0x9d, 0x2a, 0xd6, 0x8e, 0x49, 0x94, 0x9d, 0x38, 0xd7, 0x63, 0x12,
-	0x61, 0x06, 0xe1, 0xa3, 0xd4, 0x6a, 0xa9, 0x28, 0xf2, 0xe2, 0x73, 0x2b, 0xf9, 0x28, 0xa5, 0x58,
-	0xe0, 0x0c, 0xcb, 0x7b, 0x9d, 0x96, 0xbf, 0x33, 0x00, 0x4f, 0xf5, 0x61, 0x03, 0x14, 0x98, 0x83,
-	0x9a, 0xce, 0xaf, 0x2e, 0x3f, 0xa0, 0xfc, 0xea, 0x7b, 0x1b, 0xae, 0x77, 0xd2, 0xb2, 0xfb, 0xca,
-	0x75, 0xfd, 0x72, 0x09, 0xce, 0xf6, 0x36, 0x58, 0xd0, 0xb7, 0xc2, 0x78, 0xa4, 0x42, 0x12, 0xcd,
-	0xd4, 0xec, 0x93, 0xdc, 0xc7, 0x95, 0x02, 0xe1, 0x2c, 0x2e, 0x9a, 0x06, 0x68, 0x39, 0xc9, 0x56,
-	0x7c, 0x61, 0xd7, 0x8b, 0x13, 0x51, 0xcb, 0x6e, 0x8c, 0x9f, 0xbc, 0xca, 0x56, 0x6c, 0x60, 0x50,
-	0x76, 0xec, 0xdf, 0x42, 0x78, 0x2d, 0x4c, 0xf8, 0x43, 0x7c, 0xeb, 0x79, 0x52, 0xde, 0xfc, 0x68,
-	0x80, 0x70, 0x16, 0x97, 0xb2, 0x63, 0x67, 0xfb, 0xbc, 0xa3, 0x03, 0x3a, 0x99, 0x7b, 0x49, 0xb5,
-	0x62, 0x03, 0x23, 0x9b, 0x74, 0x5e, 0x39, 0x38, 0xe9, 0xdc, 0xfe, 0x57, 0x25, 0x38, 0xd3, 0xd3,
-	0xe0, 0xed, 0x4f, 0x4c, 0x3d, 0x7c, 0x89, 0xdf, 0xf7, 0xb8, 0xc2, 0x0e, 0x95, 0x30, 0x6c, 0xff,
-	0x51, 0x8f, 0x99, 0x26, 0x92, 0x81, 0xef, 0xbd, 0x6e, 0xca, 0xc3, 0x37, 0x9e, 0x5d, 0xf9, 0xbf,
-	0x03, 0x87, 0xc8, 0xff, 0xcd, 0x7c, 0x8c, 0x4a, 0x9f, 0xda, 0xe1, 0xbf, 0x0d, 0xf4, 0x1c, 0x5e,
-	0xba, 0x41, 0xee, 0xeb, 0x04, 0x61, 0x01, 0x4e, 0x78, 0x01, 0xbb, 0xcb, 0xb7, 0xde, 0x5e, 0x17,
-	0xe5, 0xcd, 0x78, 0x0d, 0x5f, 0x95, 0x7d, 0xb3, 0x98, 0x81, 0xe3, 0xae, 0x27, 0x1e, 0xc2, 0x7c,
-	0xec, 0x7b, 0x1b, 0xd2, 0x43, 0x4a, 0xee, 0x15, 0x38, 0x2d, 0x87, 0x62, 0xcb, 0x89, 0x48, 0x43,
-	0x28, 0xdb, 0x58, 0xe4, 0x5b, 0x9d, 0xe1, 0x39, 0x5b, 0x39, 0x08, 0x38, 0xff, 0x39, 0x76, 0xf1,
-	0x6a, 0xd8, 0xf2, 0x5c, 0xb1, 0x15, 0xd4, 0x17, 0xaf, 0xd2, 0x46, 0xcc, 0x61, 0x5a, 0x5f, 0xd4,
-	0xee, 0x8f, 0xbe, 0xf8, 0x08, 0xd4, 0xd4, 0x78, 0xf3, 0x9c, 0x0a, 0x35, 0xc9, 0xbb, 0x72, 0x2a,
-	0xd4, 0x0c, 0x37, 0xb0, 0xe8, 0xec, 0xa0, 0x1b, 0x95, 0xcc, 0x6a, 0xa5, 0xfc, 0x68, 0xbb, 0xfd,
-	0x1c, 0x8c, 0x28, 0x5f, 0x60, 0xbf, 0xd7, 0xdf, 0xda, 0x7f, 0x59, 0x82, 0xcc, 0x4d, 0x6f, 0x68,
-	0x17, 0x6a, 0x8d, 0xa8, 0xc3, 0x1b, 0x8b, 0xa9, 0x21, 0xbd, 0x20, 0xc9, 0xe9, 0x83, 0x30, 0xd5,
-	0x84, 0x35, 0x33, 0xf4, 0x26, 0x2f, 0xd7, 0x2c, 0x58, 0x97, 0x8a, 0xc8, 0xc9, 0xaf, 0x2b, 0x7a,
-	0xe6, 0xfd, 0x96, 0xb2, 0x0d, 0x1b, 0xfc, 0x50, 0x02, 0xb5, 0x2d, 0x79, 0xa3, 0x5d, 0x31, 0xe2,
-	0x4e, 0x5d, 0x90, 0xc7, 0x4d, 0x34, 0xf5, 0x17, 0x6b, 0x46, 0xf6, 0x1f, 0x96, 0xe0, 0x54, 0xfa,
-	0x03, 0x88, 0x83, 0xcb, 0x9f, 0xb6, 0xe0, 0x51, 0xdf, 0x89, 0x93, 0x7a, 0x9b, 0x6d, 0x14, 0x36,
-	0xda, 0xfe, 0x4a, 0xa6, 0xb2, 0xf7, 0x51, 0x9d, 0x2d, 0x8a, 0x70, 0xf6, 0x06, 0xc4, 0xb9, 0xc7,
-	0xee, 0xec, 0x4d, 0x3d, 0xba, 0x94, 0xcf, 0x1c, 0xf7, 0xea, 0x15, 0x7a, 0xcb, 0x82, 0x13, 0x6e,
-	0x3b, 0x8a, 0x48, 0x90, 0xe8, 0xae, 0xf2, 0xaf, 0x78, 0xad, 0x90, 0x81, 0xd4, 0x1d, 0x3c, 0x45,
-	0x05, 0xea, 0x7c, 0x86, 0x17, 0xee, 0xe2, 0x6e, 0xff, 0x20, 0xd5, 0x9c, 0x3d, 0xdf, 0xf3, 0xaf,
-	0xd9, 0x95, 0x8d, 0x7f, 0x32, 0x08, 0xa3, 0xa9, 0xf2, 0xe5, 0xa9, 0xc3, 0x3e, 0xeb, 0xc0, 0xc3,
-	0x3e, 0x96, 0x21, 0xd8, 0x0e, 0xe4, 0x6d, 0xf6, 0x46, 0x86, 0x60, 0x3b, 0x20, 0x98, 0xc3, 0xc4,
-	0x90, 0xe2, 0x76, 0x20, 0x72, 0x01, 0xcc, 0x21, 0xc5, 0xed, 0x00, 0x0b, 0x28, 0xfa, 0xb8, 0x05,
-	0x23, 0x6c, 0xf1, 0x89, 0xa3, 0x52, 0xa1, 0xd0, 0xae, 0x14, 0xb0, 0xdc, 0x65, 0xa9, 0x7e, 0x16,
-	0x3b, 0x6a, 0xb6, 0xe0, 0x14, 0x47, 0xf4, 0x29, 0x0b, 0x6a, 0xea, 0xea, 0x5c, 0x71, 0x36, 0x52,
-	0x2f, 0xb6, 0x3a, 0x7c, 0x46, 0xea, 0xa9, 0x32, 0xdd, 0x58, 0x33, 0x46, 0xb1, 0x3a, 0xc7, 0x1c,
-	0x3a, 0x9e, 0x73, 0x4c, 0xc8, 0x39, 0xc3, 0x7c, 0x1f, 0xd4, 0x9a, 0x4e, 0xe0, 0x6d, 0x90, 0x38,
-	0xe1, 0x47, 0x8b, 0xf2, 0x32, 0x10, 0xd9, 0x88, 0x35, 0x9c, 0x1a, 0xfb, 0x31, 0x7b, 0xb1, 0xc4,
-	0x38, 0x0b, 0x64, 0xc6, 0x7e, 0x5d, 0x37, 0x63, 0x13, 0xc7, 0x3c, 0xb8, 0x84, 0x07, 0x7a, 0x70,
-	0x39, 0x7c, 0xc0, 0xc1, 0x65, 0x1d, 0x4e, 0x3b, 0xed, 0x24, 0xbc, 0x4c, 0x1c, 0x7f, 0x36, 0x49,
-	0x48, 0xb3, 0x95, 0xc4, 0xbc, 0xe2, 0xfd, 0x08, 0x73, 0x01, 0xab, 0x68, 0xb7, 0x3a, 0xf1, 0x37,
-	0xba, 0x90, 0x70, 0xfe, 0xb3, 0xf6, 0xbf, 0xb0, 0xe0, 0x74, 0xee, 0x54, 0x78, 0x78, 0xf3, 0x0c,
-	0xec, 0x1f, 0xae, 0xc0, 0xc9, 0x9c, 0xcb, 0x0d, 0x50, 0xc7, 0x5c, 0x24, 0x56, 0x11, 0x21, 0x7b,
-	0xe9, 0x08, 0x34, 0xf9, 0x6d, 0x72, 0x56, 0xc6, 0xe1, 0x62, 0x11, 0x74, 0x3c, 0x40, 0xf9, 0xfe,
-	0xc6, 0x03, 0x18, 0x73, 0x7d, 0xe0, 0x81, 0xce, 0xf5, 0xca, 0x01, 0x73, 0xfd, 0x67, 0x2c, 0x98,
-	0x6c, 0xf6, 0xb8, 0xa9, 0x4c, 0x9c, 0x27, 0xdd, 0x38, 0x9e, 0x7b, 0xd0, 0xe6, 0x1e, 0xbf, 0xb3,
-	0x37, 0xd5, 0xf3, 0x82, 0x38, 0xdc, 0xb3, 0x57, 0xf6, 0x57, 0xca, 0xc0, 0xec, 0x35, 0x56, 0xc0,
-	0xba, 0x83, 0x3e, 0x66, 0xde, 0x91, 0x62, 0x15, 0x75, 0x9f, 0x07, 0x27, 0xae, 0xee, 0x58, 0xe1,
-	0x23, 0x98, 0x77, 0xe5, 0x4a, 0x56, 0x12, 0x96, 0xfa, 0x90, 0x84, 0xbe, 0xbc, 0x8c, 0xa6, 0x5c,
-	0xfc, 0x65, 0x34, 0xb5, 0xec, 0x45, 0x34, 0xfb, 0x7f, 0xe2, 0x81, 0x87, 0xf2, 0x13, 0xff, 0xb2,
-	0xc5, 0x05, 0x4f, 0xe6, 0x2b, 0x68, 0x73, 0xc3, 0xda, 0xc7, 0xdc, 0x78, 0x06, 0xaa, 0xb1, 0x90,
-	0xcc, 0xc2, 0x2c, 0xd1, 0xa1, 0x60, 0xa2, 0x1d, 0x2b, 0x0c, 0xba, 0xeb, 0x72, 0x7c, 0x3f, 0xbc,
-	0x7d, 0xa1, 0xd9, 0x4a, 0x3a, 0xc2, 0x40, 0x51, 0xdb, 0x82, 0x59, 0x05, 0xc1, 0x06, 0x16, 0x7a,
-	0x0a, 0x06, 0x79, 0xa5, 0x09, 0xe1, 0xdc, 0x19, 0xa6, 0xeb, 0x90, 0x97, 0xa1, 0x68, 0x60, 0x01,
-	0xb2, 0xb7, 0xc0, 0xd8, 0x55, 0xdc, 0xfb, 0x75, 0xd8, 0x07, 0xdf, 0x70, 0x69, 0xff, 0x83, 0x92,
-	0x60, 0xc5, 0x77, 0x09, 0x3a, 0x32, 0xd0, 0x3a, 0x64, 0x64, 0xe0, 0x9b, 0x00, 0x6e, 0xd8, 0x6c,
-	0xd1, 0x7d, 0xf3, 0x5a, 0x58, 0xcc, 0x66, 0x6b, 0x5e, 0xd1, 0xd3, 0xa3, 0xaa, 0xdb, 0xb0, 0xc1,
-	0x2f, 0x25, 0xda, 0xcb, 0x07, 0x8a, 0xf6, 0x94, 0x94, 0x1b, 0xd8, 0x5f, 0xca, 0xd9, 0x7f, 0x66,
-	0x41, 0xca, 0xea, 0x43, 0x2d, 0xa8, 0xd0, 0xee, 0x76, 0x84, 0xc0, 0x58, 0x29, 0xce, 0xc4, 0xa4,
-	0x92, 0x5a, 0xac, 0x42, 0xf6, 0x13, 0x73, 0x46, 0xc8, 0x17, 0x51, 0x90, 0x85, 0x6c, 0x7e, 0x4c,
-	0x86, 0x97, 0xc3, 0x70, 0x9b, 0x07, 0x13, 0xe9, 0x88, 0x4a, 0xfb, 0x45, 0x98, 0xe8, 0xea, 0x14,
-	0xbb, 0x42, 0x3b, 0x94, 0x3b, 0x78, 0x63, 0xf5, 0xb0, 0x82, 0x0f, 0x98, 0xc3, 0xec, 0x2f, 0x5b,
-	0x70, 0x22, 0x4b, 0x1e, 0xbd, 0x6d, 0xc1, 0x44, 0x9c, 0xa5, 0x77, 0x5c, 0x63, 0xa7, 0xb2, 0x1d,
-	0xba, 0x40, 0xb8, 0xbb, 0x13, 0xf6, 0xff, 0x14, 0xda, 0xe0, 0xa6, 0x17, 0x34, 0xc2, 0xdb, 0xca,
-	0x4e, 0xb2, 0x7a, 0xda, 0x49, 0x54, 0x3c, 0xb8, 0x5b, 0xa4, 0xd1, 0xf6, 0xbb, 0xca, 0x50, 0xd4,
-	0x45, 0x3b, 0x56, 0x18, 0x2c, 0xeb, 0xbe, 0x2d, 0xf6, 0xad, 0x99, 0x49, 0xb9, 0x20, 0xda, 0xb1,
-	0xc2, 0x40, 0xcf, 0xc3, 0x88, 0xf1, 0x92, 0x72, 0x5e, 0xb2, 0x4d, 0x87, 0xa1, 0xc1, 0x63, 0x9c,
-	0xc2, 0x42, 0xd3, 0x00, 0xca, 0xe6, 0x92, 0x1a, 0x9b, 0x39, 0xda, 0x95, 0x60, 0x8c, 0xb1, 0x81,
-	0xc1, 0x6a, 0x5c, 0xf8, 0xed, 0x98, 0x9d, 0x24, 0x0f, 0xea, 0x0b, 0x1d, 0xe6, 0x45, 0x1b, 0x56,
-	0x50, 0x2a, 0xdc, 0x9a, 0x4e, 0xd0, 0x76, 0x7c, 0x3a, 0x42, 0xc2, 0x75, 0xa6, 0x96, 0xe1, 0xb2,
-	0x82, 0x60, 0x03, 0x8b, 0xbe, 0x71, 0xe2, 0x35, 0xc9, 0x2b, 0x61, 0x20, 0xa3, 0xd4, 0x75, 0x70,
-	0x81, 0x68, 0xc7, 0x0a, 0x03, 0xbd, 0x08, 0xc3, 0x4e, 0xd0, 0xe0, 0x06, 0x62, 0x18, 0x89, 0x33,
-	0x4a, 0xb5, 0xfb, 0xbc, 0x1e, 0x93, 0x59, 0x0d, 0xc5, 0x26, 0x6a, 0xf6, 0x36, 0x0b, 0xe8, 0xf3,
-	0xb6, 0xbc, 0x3f, 0xb5, 0x60, 0x5c, 0x17, 0x2d, 0x62, 0x1e, 0xb6, 0x94, 0x6b, 0xd1, 0x3a, 0xd0,
-	0xb5, 0x98, 0xae, 0x5d, 0x52, 0xea, 0xab, 0x76, 0x89, 0x59, 0x56, 0xa4, 0xbc, 0x6f, 0x59, 0x91,
-	0xaf, 0x87, 0xa1, 0x6d, 0xd2, 0x31, 0xea, 0x8f, 0x30, 0xe5, 0x70, 0x95, 0x37, 0x61, 0x09, 0x43,
-	0x36, 0x0c, 0xba, 0x8e, 0xaa, 0x61, 0x38, 0x22, 0x62, 0xd3, 0x66, 0x19, 0x92, 0x80, 0xd8, 0x2b,
-	0x50, 0x53, 0x87, 0xfa, 0xd2, 0xd3, 0x67, 0xe5, 0x7b, 0xfa, 0xfa, 0x2a, 0x6f, 0x30, 0xb7, 0xfe,
-	0x1b, 0x5f, 0x7d, 0xf2, 0x5d, 0xbf, 0xfd, 0xd5, 0x27, 0xdf, 0xf5, 0x07, 0x5f, 0x7d, 0xf2, 0x5d,
-	0x1f, 0xbf, 0xf3, 0xa4, 0xf5, 0x1b, 0x77, 0x9e, 0xb4, 0x7e, 0xfb, 0xce, 0x93, 0xd6, 0x1f, 0xdc,
-	0x79, 0xd2, 0xfa, 0xca, 0x9d, 0x27, 0xad, 0xb7, 0xfe, 0xeb, 0x93, 0xef, 0x7a, 0x25, 0x37, 0x2f,
-	0x82, 0xfe, 0x78, 0xbf, 0xdb, 0x98, 0xd9, 0x79, 0x8e, 0x85, 0xe6, 0xd3, 0xf5, 0x3c, 0x63, 0x4c,
-	0xe2, 0x19, 0xb9, 0x9e, 0xff, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x87, 0xd1, 0xb1, 0x14, 0x1c,
-	0x01, 0x01, 0x00,
+	// 13301 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6b, 0x70, 0x25, 0xd9,
+	0x59, 0x98, 0xfb, 0x3e, 0x24, 0xdd, 0xa3, 0xd7, 0x4c, 0xef, 0xcc, 0xee, 0x9d, 0xd9, 0x87, 0x86,
+	0x5e, 0xb3, 0x36, 0xc1, 0xd6, 0xe0, 0xb5, 0xb1, 0x37, 0x3c, 0x0c, 0x7a, 0xcd, 0x8c, 0x76, 0xa4,
+	0x91, 0xf6, 0xbb, 0x9a, 0x19, 0x6c, 0xe3, 0x47, 0xeb, 0xde, 0xa3, 0xab, 0x5e, 0xf5, 0xed, 0xbe,
+	0xdb, 0xdd, 0x57, 0x33, 0x5a, 0x6c, 0x63, 0x43, 0x0c, 0x06, 0xf3, 0x30, 0x8f, 0x4a, 0x4c, 0x12,
+	0x08, 0x04, 0x08, 0x49, 0x25, 0x14, 0x4e, 0x52, 0x29, 0x48, 0x85, 0x14, 0x05, 0xa4, 0x5c, 0x90,
+	0x17, 0x84, 0x22, 0x40, 0x02, 0x99, 0xe0, 0x25, 0xa9, 0x50, 0xf9, 0x41, 0x55, 0xc2, 0x9f, 0x64,
+	0x43, 0x51, 0xa9, 0xef, 0xbc, 0xfb, 0x71, 0xa5, 0xab, 0x51, 0x6b, 0x66, 0x0c, 0xfb, 0x4b, 0xba,
+	0xe7, 0xfb, 0xfa, 0xfb, 0x4e, 0x9f, 0x3e, 0x8f, 0xef, 0x7c, 0x4f, 0xb2, 0xd6, 0xf5, 0x92, 0xdd,
+	0xc1, 0xf6, 0x7c, 0x3b, 0xec, 0x5d, 0x76, 0xa3, 0x6e, 0xd8, 0x8f, 0xc2, 0x97, 0xd9, 0x3f, 0x6f,
+	0x6f, 0x77, 0x2e, 0xef, 0xbf, 0xf3, 0x72, 0x7f, 0xaf, 0x7b, 0xd9, 0xed, 0x7b, 0xf1, 0x65, 0xb7,
+	0xdf, 0xf7, 0xbd, 0xb6, 0x9b, 0x78, 0x61, 0x70, 0x79, 0xff, 0x1d, 0xae, 0xdf, 0xdf, 0x75, 0xdf,
+	0x71, 0xb9, 0x4b, 0x03, 0x1a, 0xb9, 0x09, 0xed, 0xcc, 0xf7, 0xa3, 0x30, 0x09, 0xed, 0xaf, 0xd3,
+	0xd4, 0xe6, 0x25, 0x35, 0xf6, 0xcf, 0x87, 0xdb, 0x9d, 0xf9, 0xfd, 0x77, 0xce, 0xf7, 0xf7, 0xba,
+	0xf3, 0x48, 0x6d, 0xde, 0xa0, 0x36, 0x2f, 0xa9, 0x5d, 0x7c, 0xbb, 0xd1, 0x97, 0x6e, 0xd8, 0x0d,
+	0x2f, 0x33, 0xa2, 0xdb, 0x83, 0x1d, 0xf6, 0x8b, 0xfd, 0x60, 0xff, 0x71, 0x66, 0x17, 0x9d, 0xbd,
+	0x17, 0xe2, 0x79, 0x2f, 0xc4, 0xee, 0x5d, 0x6e, 0x87, 0x11, 0xbd, 0xbc, 0x9f, 0xeb, 0xd0, 0xc5,
+	0x6b, 0x1a, 0x87, 0xde, 0x4d, 0x68, 0x10, 0x7b, 0x61, 0x10, 0xbf, 0x1d, 0xbb, 0x40, 0xa3, 0x7d,
+	0x1a, 0x99, 0xaf, 0x67, 0x20, 0x14, 0x51, 0x7a, 0x97, 0xa6, 0xd4, 0x73, 0xdb, 0xbb, 0x5e, 0x40,
+	0xa3, 0x03, 0xfd, 0x78, 0x8f, 0x26, 0x6e, 0xd1, 0x53, 0x97, 0x87, 0x3d, 0x15, 0x0d, 0x82, 0xc4,
+	0xeb, 0xd1, 0xdc, 0x03, 0xef, 0x3e, 0xea, 0x81, 0xb8, 0xbd, 0x4b, 0x7b, 0x6e, 0xee, 0xb9, 0x77,
+	0x0e, 0x7b, 0x6e, 0x90, 0x78, 0xfe, 0x65, 0x2f, 0x48, 0xe2, 0x24, 0xca, 0x3e, 0xe4, 0xfc, 0x6d,
+	0x8b, 0x4c, 0x2f, 0xdc, 0x6e, 0x2d, 0x0c, 0x92, 0xdd, 0xa5, 0x30, 0xd8, 0xf1, 0xba, 0xf6, 0x57,
+	0x93, 0xc9, 0xb6, 0x3f, 0x88, 0x13, 0x1a, 0xdd, 0x70, 0x7b, 0xb4, 0x69, 0x5d, 0xb2, 0xde, 0xda,
+	0x58, 0x7c, 0xec, 0xd7, 0xee, 0xcd, 0xbd, 0xe9, 0xb5, 0x7b, 0x73, 0x93, 0x4b, 0x1a, 0x04, 0x26,
+	0x9e, 0xfd, 0x15, 0x64, 0x3c, 0x0a, 0x7d, 0xba, 0x00, 0x37, 0x9a, 0x15, 0xf6, 0xc8, 0xac, 0x78,
+	0x64, 0x1c, 0x78, 0x33, 0x48, 0x38, 0xa2, 0xf6, 0xa3, 0x70, 0xc7, 0xf3, 0x69, 0xb3, 0x9a, 0x46,
+	0xdd, 0xe4, 0xcd, 0x20, 0xe1, 0xce, 0x8f, 0x54, 0xc8, 0xec, 0x42, 0xbf, 0x7f, 0x8d, 0xba, 0x7e,
+	0xb2, 0xdb, 0x4a, 0xdc, 0x64, 0x10, 0xdb, 0x5d, 0x32, 0x16, 0xb3, 0xff, 0x44, 0xdf, 0x36, 0xc4,
+	0xd3, 0x63, 0x1c, 0xfe, 0xfa, 0xbd, 0xb9, 0xaf, 0x2f, 0x9a, 0xd1, 0x5d, 0x2f, 0x09, 0xfb, 0xf1,
+	0xdb, 0x69, 0xd0, 0xf5, 0x02, 0xca, 0xc6, 0x65, 0x97, 0x51, 0x9d, 0x37, 0x89, 0x2f, 0x85, 0x1d,
+	0x0a, 0x82, 0x3c, 0xf6, 0xb3, 0x47, 0xe3, 0xd8, 0xed, 0xd2, 0xec, 0x2b, 0xad, 0xf3, 0x66, 0x90,
+	0x70, 0x3b, 0x22, 0xb6, 0xef, 0xc6, 0xc9, 0x56, 0xe4, 0x06, 0xb1, 0x87, 0x53, 0x7a, 0xcb, 0xeb,
+	0xf1, 0xb7, 0x9b, 0x7c, 0xfe, 0xaf, 0xcc, 0xf3, 0x0f, 0x33, 0x6f, 0x7e, 0x18, 0xbd, 0x0e, 0x70,
+	0xde, 0xcc, 0xef, 0xbf, 0x63, 0x1e, 0x9f, 0x58, 0x7c, 0xfc, 0xb5, 0x7b, 0x73, 0xf6, 0x5a, 0x8e,
+	0x12, 0x14, 0x50, 0x77, 0x7e, 0xa7, 0x42, 0xc8, 0x42, 0xbf, 0xbf, 0x19, 0x85, 0x2f, 0xd3, 0x76,
+	0x62, 0x7f, 0x84, 0x4c, 0x20, 0xa9, 0x8e, 0x9b, 0xb8, 0x6c, 0x60, 0x26, 0x9f, 0xff, 0xaa, 0xd1,
+	0x18, 0x6f, 0x6c, 0xe3, 0xf3, 0xeb, 0x34, 0x71, 0x17, 0x6d, 0xf1, 0x82, 0x44, 0xb7, 0x81, 0xa2,
+	0x6a, 0x07, 0xa4, 0x16, 0xf7, 0x69, 0x9b, 0x0d, 0xc6, 0xe4, 0xf3, 0x6b, 0xf3, 0x27, 0x59, 0xe9,
+	0xf3, 0xba, 0xe7, 0xad, 0x3e, 0x6d, 0x2f, 0x4e, 0x09, 0xce, 0x35, 0xfc, 0x05, 0x8c, 0x8f, 0xbd,
+	0xaf, 0x3e, 0x34, 0x1f, 0xc8, 0x1b, 0xa5, 0x71, 0x64, 0x54, 0x17, 0x67, 0xd2, 0x13, 0x47, 0x7e,
+	0x77, 0xe7, 0xbf, 0x58, 0x64, 0x46, 0x23, 0xaf, 0x79, 0x71, 0x62, 0x7f, 0x73, 0x6e, 0x70, 0xe7,
+	0x47, 0x1b, 0x5c, 0x7c, 0x9a, 0x0d, 0xed, 0x19, 0xc1, 0x6c, 0x42, 0xb6, 0x18, 0x03, 0xdb, 0x23,
+	0x75, 0x2f, 0xa1, 0xbd, 0xb8, 0x59, 0xb9, 0x54, 0x7d, 0xeb, 0xe4, 0xf3, 0xd7, 0xca, 0x7a, 0xcf,
+	0xc5, 0x69, 0xc1, 0xb4, 0xbe, 0x8a, 0xe4, 0x81, 0x73, 0x71, 0x7e, 0x7a, 0xd6, 0x7c, 0x3f, 0x1c,
+	0x70, 0xfb, 0x1d, 0x64, 0x32, 0x0e, 0x07, 0x51, 0x9b, 0x02, 0xed, 0x87, 0xb8, 0xb0, 0xaa, 0x38,
+	0xdd, 0x71, 0xc1, 0xb7, 0x74, 0x33, 0x98, 0x38, 0xf6, 0xf7, 0x59, 0x64, 0xaa, 0x43, 0xe3, 0xc4,
+	0x0b, 0x18, 0x7f, 0xd9, 0xf9, 0xad, 0x13, 0x77, 0x5e, 0x36, 0x2e, 0x6b, 0xe2, 0x8b, 0xe7, 0xc4,
+	0x8b, 0x4c, 0x19, 0x8d, 0x31, 0xa4, 0xf8, 0xe3, 0xc6, 0xd5, 0xa1, 0x71, 0x3b, 0xf2, 0xfa, 0xf8,
+	0xbb, 0x59, 0x4d, 0x6f, 0x5c, 0xcb, 0x1a, 0x04, 0x26, 0x9e, 0x1d, 0x90, 0x3a, 0x6e, 0x4c, 0x71,
+	0xb3, 0xc6, 0xfa, 0xbf, 0x7a, 0xb2, 0xfe, 0x8b, 0x41, 0xc5, 0x3d, 0x4f, 0x8f, 0x3e, 0xfe, 0x8a,
+	0x81, 0xb3, 0xb1, 0xbf, 0xd7, 0x22, 0x4d, 0xb1, 0x71, 0x02, 0xe5, 0x03, 0x7a, 0x7b, 0xd7, 0x4b,
+	0xa8, 0xef, 0xc5, 0x49, 0xb3, 0xce, 0xfa, 0x70, 0x79, 0xb4, 0xb9, 0x75, 0x35, 0x0a, 0x07, 0xfd,
+	0xeb, 0x5e, 0xd0, 0x59, 0xbc, 0x24, 0x38, 0x35, 0x97, 0x86, 0x10, 0x86, 0xa1, 0x2c, 0xed, 0x1f,
+	0xb2, 0xc8, 0xc5, 0xc0, 0xed, 0xd1, 0xb8, 0xef, 0xb6, 0xa9, 0x04, 0x2f, 0xfa, 0x6e, 0x7b, 0x8f,
+	0xf5, 0x68, 0xec, 0xfe, 0x7a, 0xe4, 0x88, 0x1e, 0x5d, 0xbc, 0x31, 0x94, 0x34, 0x1c, 0xc2, 0xd6,
+	0xfe, 0x49, 0x8b, 0x9c, 0x0d, 0xa3, 0xfe, 0xae, 0x1b, 0xd0, 0x8e, 0x84, 0xc6, 0xcd, 0x71, 0xb6,
+	0xf4, 0x3e, 0x74, 0xb2, 0x4f, 0xb4, 0x91, 0x25, 0xbb, 0x1e, 0x06, 0x5e, 0x12, 0x46, 0x2d, 0x9a,
+	0x24, 0x5e, 0xd0, 0x8d, 0x17, 0xcf, 0xbf, 0x76, 0x6f, 0xee, 0x6c, 0x0e, 0x0b, 0xf2, 0xfd, 0xb1,
+	0xbf, 0x85, 0x4c, 0xc6, 0x07, 0x41, 0xfb, 0xb6, 0x17, 0x74, 0xc2, 0x3b, 0x71, 0x73, 0xa2, 0x8c,
+	0xe5, 0xdb, 0x52, 0x04, 0xc5, 0x02, 0xd4, 0x0c, 0xc0, 0xe4, 0x56, 0xfc, 0xe1, 0xf4, 0x54, 0x6a,
+	0x94, 0xfd, 0xe1, 0xf4, 0x64, 0x3a, 0x84, 0xad, 0xfd, 0x9d, 0x16, 0x99, 0x8e, 0xbd, 0x6e, 0xe0,
+	0x26, 0x83, 0x88, 0x5e, 0xa7, 0x07, 0x71, 0x93, 0xb0, 0x8e, 0xbc, 0x78, 0xc2, 0x51, 0x31, 0x48,
+	0x2e, 0x9e, 0x17, 0x7d, 0x9c, 0x36, 0x5b, 0x63, 0x48, 0xf3, 0x2d, 0x5a, 0x68, 0x7a, 0x5a, 0x4f,
+	0x96, 0xbb, 0xd0, 0xf4, 0xa4, 0x1e, 0xca, 0xd2, 0xfe, 0x46, 0x72, 0x86, 0x37, 0xa9, 0x91, 0x8d,
+	0x9b, 0x53, 0x6c, 0xa3, 0x3d, 0xf7, 0xda, 0xbd, 0xb9, 0x33, 0xad, 0x0c, 0x0c, 0x72, 0xd8, 0xf6,
+	0x2b, 0x64, 0xae, 0x4f, 0xa3, 0x9e, 0x97, 0x6c, 0x04, 0xfe, 0x81, 0xdc, 0xbe, 0xdb, 0x61, 0x9f,
+	0x76, 0x44, 0x77, 0xe2, 0xe6, 0xf4, 0x25, 0xeb, 0xad, 0x13, 0x8b, 0x6f, 0x11, 0xdd, 0x9c, 0xdb,
+	0x3c, 0x1c, 0x1d, 0x8e, 0xa2, 0x67, 0x7f, 0xc1, 0x22, 0x17, 0x8d, 0x5d, 0xb6, 0x45, 0xa3, 0x7d,
+	0xaf, 0x4d, 0x17, 0xda, 0xed, 0x70, 0x10, 0x24, 0x71, 0x73, 0x86, 0x0d, 0xe3, 0xf6, 0x69, 0xec,
+	0xf9, 0x69, 0x56, 0x7a, 0x5e, 0x0e, 0x45, 0x89, 0xe1, 0x90, 0x9e, 0xda, 0x9f, 0xb7, 0x48, 0x33,
+	0x6c, 0x7b, 0x6a, 0xc6, 0xdc, 0xa2, 0x91, 0xb7, 0x23, 0xb8, 0x36, 0x67, 0xd9, 0xbe, 0x72, 0xeb,
+	0x84, 0xfb, 0xca, 0xd2, 0x6a, 0x21, 0xf5, 0xc5, 0xa7, 0x70, 0xc2, 0x0c, 0x83, 0xc2, 0xd0, 0x5e,
+	0x39, 0xbf, 0x5e, 0x21, 0x67, 0xb2, 0x42, 0x8b, 0xfd, 0xf7, 0x2c, 0x32, 0xfb, 0xf2, 0x9d, 0x64,
+	0x2b, 0xdc, 0xa3, 0x41, 0xbc, 0x78, 0x80, 0x47, 0x0b, 0x3b, 0xae, 0x27, 0x9f, 0x6f, 0x97, 0x2b,
+	0x1e, 0xcd, 0xbf, 0x98, 0xe6, 0xb2, 0x12, 0x24, 0xd1, 0xc1, 0xe2, 0x13, 0xe2, 0x33, 0xcc, 0xbe,
+	0x78, 0x7b, 0xcb, 0x84, 0x42, 0xb6, 0x53, 0x17, 0x3f, 0x63, 0x91, 0x73, 0x45, 0x24, 0xec, 0x33,
+	0xa4, 0xba, 0x47, 0x0f, 0xb8, 0xf0, 0x0e, 0xf8, 0xaf, 0xfd, 0x41, 0x52, 0xdf, 0x77, 0xfd, 0x01,
+	0x15, 0x92, 0xe5, 0xd5, 0x93, 0xbd, 0x88, 0xea, 0x19, 0x70, 0xaa, 0x5f, 0x53, 0x79, 0xc1, 0x72,
+	0x7e, 0xa3, 0x4a, 0x26, 0x8d, 0x79, 0xf6, 0x00, 0xa4, 0xe5, 0x30, 0x25, 0x2d, 0xaf, 0x97, 0xb6,
+	0x44, 0x86, 0x8a, 0xcb, 0x77, 0x32, 0xe2, 0xf2, 0x46, 0x79, 0x2c, 0x0f, 0x95, 0x97, 0xed, 0x84,
+	0x34, 0xc2, 0x3e, 0x8d, 0xf8, 0x52, 0xaa, 0x95, 0xf1, 0x09, 0x37, 0x24, 0xb9, 0xc5, 0xe9, 0xd7,
+	0xee, 0xcd, 0x35, 0xd4, 0x4f, 0xd0, 0x8c, 0x9c, 0xdf, 0xb5, 0xc8, 0x39, 0xa3, 0x8f, 0x4b, 0x61,
+	0xd0, 0x61, 0x77, 0x23, 0xfb, 0x12, 0xa9, 0x25, 0x07, 0x7d, 0x79, 0x73, 0x55, 0x23, 0xb5, 0x75,
+	0xd0, 0xa7, 0xc0, 0x20, 0x8f, 0xfa, 0xc5, 0xee, 0x87, 0x2c, 0xf2, 0x78, 0xf1, 0x9e, 0x68, 0x3f,
+	0x47, 0xc6, 0xb8, 0xda, 0x42, 0xbc, 0x9d, 0xfe, 0x24, 0xac, 0x15, 0x04, 0xd4, 0xbe, 0x4c, 0x1a,
+	0xea, 0x8c, 0x16, 0xef, 0x78, 0x56, 0xa0, 0x36, 0xf4, 0xc1, 0xae, 0x71, 0x70, 0xd0, 0x02, 0x57,
+	0xbc, 0x99, 0x31, 0x68, 0x88, 0x0b, 0x0c, 0xe2, 0xfc, 0xb6, 0x45, 0xde, 0x3c, 0xca, 0x4e, 0x7d,
+	0x7a, 0x7d, 0x6c, 0x91, 0xf3, 0x1d, 0xba, 0xe3, 0x0e, 0xfc, 0x24, 0xcd, 0x51, 0x74, 0xfa, 0x69,
+	0xf1, 0xf0, 0xf9, 0xe5, 0x22, 0x24, 0x28, 0x7e, 0xd6, 0xf9, 0xaf, 0x16, 0x99, 0x35, 0x5e, 0xeb,
+	0x01, 0xdc, 0xf6, 0x82, 0xf4, 0x6d, 0x6f, 0xb5, 0xb4, 0x65, 0x3a, 0xe4, 0xba, 0xf7, 0xbd, 0x16,
+	0xb9, 0x68, 0x60, 0xad, 0xbb, 0x49, 0x7b, 0x77, 0xe5, 0x6e, 0x3f, 0xa2, 0x71, 0x8c, 0x53, 0xea,
+	0x69, 0x63, 0x3b, 0x5e, 0x9c, 0x14, 0x14, 0xaa, 0xd7, 0xe9, 0x01, 0xdf, 0x9b, 0xdf, 0x46, 0x26,
+	0xf8, 0x9a, 0x0b, 0x23, 0xf1, 0x91, 0xd4, 0xbb, 0x6d, 0x88, 0x76, 0x50, 0x18, 0xb6, 0x43, 0xc6,
+	0xd8, 0x9e, 0x8b, 0x7b, 0x10, 0x4a, 0x36, 0x04, 0xbf, 0xfb, 0x2d, 0xd6, 0x02, 0x02, 0xe2, 0xc4,
+	0xa9, 0xee, 0x6c, 0x46, 0x94, 0xcd, 0x87, 0xce, 0x15, 0x8f, 0xfa, 0x9d, 0x18, 0x6f, 0xa2, 0x6e,
+	0x10, 0x84, 0x89, 0xb8, 0x54, 0x1a, 0x37, 0xd1, 0x05, 0xdd, 0x0c, 0x26, 0x0e, 0x32, 0xf5, 0xdd,
+	0x6d, 0xea, 0xf3, 0x11, 0x15, 0x4c, 0xd7, 0x58, 0x0b, 0x08, 0x88, 0xf3, 0x5a, 0x85, 0xcc, 0x18,
+	0x5c, 0x5b, 0xf4, 0x41, 0x28, 0x4c, 0xa2, 0xd4, 0x11, 0xb0, 0x59, 0xde, 0x7e, 0x4c, 0x87, 0x2b,
+	0x4d, 0x5e, 0xcd, 0x9c, 0x02, 0x50, 0x2a, 0xd7, 0xc3, 0x15, 0x27, 0x9f, 0xa8, 0x92, 0xb9, 0xf4,
+	0x03, 0xb9, 0x43, 0x04, 0x6f, 0xe9, 0x06, 0xa3, 0xac, 0x7a, 0xd1, 0xc0, 0x07, 0x13, 0x6f, 0xc8,
+	0x3e, 0x5c, 0x39, 0xcd, 0x7d, 0xd8, 0x3c, 0x26, 0xaa, 0x47, 0x1c, 0x13, 0xcf, 0xa9, 0x51, 0xaf,
+	0x65, 0xf6, 0xbc, 0xf4, 0x51, 0x79, 0x89, 0xd4, 0xe2, 0x84, 0xf6, 0x9b, 0xf5, 0xf4, 0x36, 0xdb,
+	0x4a, 0x68, 0x1f, 0x18, 0xc4, 0xfe, 0x7a, 0x32, 0x9b, 0xb8, 0x51, 0x97, 0x26, 0x11, 0xdd, 0xf7,
+	0x98, 0x2a, 0x9a, 0x5d, 0xc1, 0x1b, 0x8b, 0x8f, 0xa1, 0xd4, 0xb5, 0xc5, 0x40, 0x20, 0x41, 0x90,
+	0xc5, 0x75, 0xfe, 0x67, 0x85, 0x3c, 0x91, 0xfe, 0x04, 0xfa, 0x60, 0xfc, 0x86, 0xd4, 0xc1, 0xf8,
+	0x95, 0xe6, 0xc1, 0xf8, 0xfa, 0xbd, 0xb9, 0x27, 0x87, 0x3c, 0xf6, 0x25, 0x73, 0x6e, 0xda, 0x57,
+	0x33, 0x1f, 0xe1, 0x72, 0x4e, 0x31, 0xfc, 0xf4, 0x90, 0x77, 0xcc, 0x7c, 0xa5, 0xe7, 0xc8, 0x58,
+	0x44, 0xdd, 0x38, 0x0c, 0x9a, 0xf5, 0xf4, 0xd7, 0x04, 0xd6, 0x0a, 0x02, 0xea, 0xfc, 0x56, 0x23,
+	0x3b, 0xd8, 0x57, 0xb9, 0x7a, 0x3d, 0x8c, 0x6c, 0x8f, 0xd4, 0xd8, 0x45, 0x93, 0xef, 0x2c, 0xd7,
+	0x4f, 0xb6, 0x0a, 0xf1, 0x14, 0x51, 0xa4, 0x17, 0x27, 0xf0, 0xab, 0x61, 0x13, 0x30, 0x16, 0xf6,
+	0x5d, 0x32, 0xd1, 0x96, 0xf7, 0xbf, 0x4a, 0x19, 0x9a, 0x52, 0x71, 0xfb, 0xd3, 0x1c, 0xa7, 0x70,
+	0xbb, 0x57, 0x97, 0x46, 0xc5, 0xcd, 0xa6, 0xa4, 0xda, 0xf5, 0x12, 0xf1, 0x59, 0x4f, 0x78, 0xc3,
+	0xbf, 0xea, 0x19, 0xaf, 0x38, 0x8e, 0x67, 0xd0, 0x55, 0x2f, 0x01, 0xa4, 0x6f, 0x7f, 0xca, 0x22,
+	0x93, 0x71, 0xbb, 0xb7, 0x19, 0x85, 0xfb, 0x5e, 0x87, 0x46, 0xcd, 0x5a, 0x19, 0x3b, 0x5b, 0x6b,
+	0x69, 0x5d, 0x12, 0xd4, 0x7c, 0xb9, 0xc6, 0x45, 0x43, 0xc0, 0xe4, 0x8b, 0x77, 0xaf, 0x27, 0xc4,
+	0xbb, 0x2f, 0xd3, 0x36, 0x5b, 0x71, 0xf2, 0x9a, 0xdf, 0xac, 0x97, 0x21, 0x73, 0x2f, 0x0f, 0xda,
+	0x7b, 0xb8, 0xde, 0x74, 0x87, 0x9e, 0x7c, 0xed, 0xde, 0xdc, 0x13, 0x4b, 0xc5, 0x3c, 0x61, 0x58,
+	0x67, 0xd8, 0x80, 0xf5, 0x07, 0xbe, 0x0f, 0xf4, 0x95, 0x01, 0x65, 0x4a, 0xbc, 0x12, 0x06, 0x6c,
+	0x53, 0x13, 0xcc, 0x0c, 0x98, 0x01, 0x01, 0x93, 0xaf, 0xfd, 0x0a, 0x19, 0xeb, 0xb9, 0x49, 0xe4,
+	0xdd, 0x6d, 0x8e, 0x97, 0x71, 0x0b, 0x5a, 0x67, 0xb4, 0x34, 0x73, 0x76, 0xd0, 0xf3, 0x46, 0x10,
+	0x8c, 0x50, 0x97, 0xde, 0xa3, 0x51, 0x97, 0x36, 0x27, 0xca, 0xb0, 0x52, 0xac, 0x23, 0x29, 0xcd,
+	0xb0, 0x81, 0xc2, 0x15, 0x6b, 0x03, 0xce, 0xc5, 0xfe, 0x20, 0x99, 0x88, 0xa9, 0x4f, 0xdb, 0x28,
+	0x1e, 0x35, 0x18, 0xc7, 0x77, 0x8e, 0x28, 0x2a, 0xa2, 0x5c, 0xd2, 0x12, 0x8f, 0xf2, 0x05, 0x26,
+	0x7f, 0x81, 0x22, 0x89, 0x03, 0xd8, 0xf7, 0x07, 0x5d, 0x2f, 0x68, 0x92, 0x32, 0x06, 0x70, 0x93,
+	0xd1, 0xca, 0x0c, 0x20, 0x6f, 0x04, 0xc1, 0xc8, 0xf9, 0xef, 0x16, 0xb1, 0xd3, 0x9b, 0xda, 0x03,
+	0x90, 0x89, 0x5f, 0x49, 0xcb, 0xc4, 0x6b, 0x65, 0x0a, 0x2d, 0x43, 0xc4, 0xe2, 0x7f, 0xd1, 0x20,
+	0x99, 0xe3, 0xe0, 0x06, 0x8d, 0x13, 0xda, 0x79, 0x63, 0x0b, 0x7f, 0x63, 0x0b, 0x7f, 0x63, 0x0b,
+	0x97, 0x3f, 0xec, 0xed, 0xcc, 0x16, 0xfe, 0x5e, 0x63, 0xd5, 0x6b, 0x77, 0x89, 0x0f, 0x2b, 0x7f,
+	0x0a, 0xb3, 0x07, 0x06, 0x02, 0xee, 0x04, 0x2f, 0xb6, 0x36, 0x6e, 0x14, 0xee, 0xd9, 0x1f, 0x4e,
+	0xef, 0xd9, 0x27, 0x65, 0xf1, 0x97, 0x61, 0x97, 0xfe, 0x82, 0x45, 0xde, 0x92, 0xde, 0xbd, 0xe4,
+	0xcc, 0x59, 0xed, 0x06, 0x61, 0x44, 0x97, 0xbd, 0x9d, 0x1d, 0x1a, 0xd1, 0x00, 0xcd, 0x06, 0x52,
+	0xb7, 0x63, 0x0d, 0xd3, 0xed, 0xd8, 0xef, 0x22, 0x53, 0x2f, 0xc7, 0x61, 0xb0, 0x19, 0x7a, 0x81,
+	0xd8, 0x82, 0xf0, 0xc6, 0x71, 0x06, 0x0d, 0xae, 0x38, 0xa2, 0xb2, 0x1d, 0x52, 0x58, 0xf6, 0x12,
+	0x39, 0xfb, 0xf2, 0x2b, 0x9b, 0x6e, 0x62, 0x68, 0x13, 0xe4, 0xbd, 0x9f, 0x99, 0xd0, 0x5e, 0x7c,
+	0x29, 0x03, 0x84, 0x3c, 0xbe, 0xf3, 0xb7, 0x2a, 0xe4, 0x42, 0xe6, 0x45, 0x42, 0xdf, 0x0f, 0x07,
+	0x09, 0xde, 0x89, 0xec, 0x1f, 0xb3, 0xc8, 0x99, 0x5e, 0x5a, 0x61, 0x11, 0x0b, 0x75, 0xf7, 0x37,
+	0x95, 0x76, 0x46, 0x64, 0x34, 0x22, 0x8b, 0x4d, 0x31, 0x42, 0x67, 0x32, 0x80, 0x18, 0x72, 0x7d,
+	0xb1, 0x3f, 0x48, 0x1a, 0x3d, 0xf7, 0xee, 0xcd, 0x7e, 0xc7, 0x4d, 0xe4, 0x75, 0x74, 0xb8, 0x16,
+	0x61, 0x90, 0x78, 0xfe, 0x3c, 0x77, 0xc4, 0x99, 0x5f, 0x0d, 0x92, 0x8d, 0xa8, 0x95, 0x44, 0x5e,
+	0xd0, 0xe5, 0x4a, 0xce, 0x75, 0x49, 0x06, 0x34, 0x45, 0xe7, 0x47, 0x2d, 0xf2, 0xf4, 0x90, 0xd1,
+	0x89, 0xdc, 0x84, 0x76, 0x0f, 0xec, 0x8f, 0x92, 0x3a, 0xde, 0x1b, 0xe5, 0xa8, 0xdc, 0x2e, 0xf3,
+	0xe4, 0x34, 0xbe, 0x84, 0x3e, 0x44, 0xf1, 0x57, 0x0c, 0x9c, 0xa9, 0xf3, 0x63, 0x8d, 0xac, 0xb0,
+	0xc0, 0xdc, 0x09, 0x9e, 0x27, 0xa4, 0x1b, 0x6e, 0xd1, 0x5e, 0xdf, 0x77, 0x13, 0x3e, 0xef, 0x26,
+	0xb4, 0xaa, 0xe4, 0xaa, 0x82, 0x80, 0x81, 0x65, 0x7f, 0x97, 0x45, 0x48, 0x57, 0xce, 0x79, 0x29,
+	0x08, 0xdc, 0x2c, 0xf3, 0x75, 0xf4, 0x8a, 0xd2, 0x7d, 0x51, 0x0c, 0xc1, 0x60, 0x6e, 0x7f, 0x9b,
+	0x45, 0x26, 0x12, 0xd9, 0x7d, 0x7e, 0x34, 0x6e, 0x95, 0xd9, 0x13, 0xf9, 0xd2, 0x5a, 0x26, 0x52,
+	0x43, 0xa2, 0xf8, 0xda, 0xdf, 0x61, 0x11, 0x82, 0xf6, 0xde, 0xcd, 0xd0, 0xf7, 0xda, 0x07, 0xcd,
+	0x5a, 0x19, 0x36, 0xaa, 0xcc, 0xb7, 0x52, 0xd4, 0x17, 0x67, 0x70, 0x34, 0xf4, 0x6f, 0x30, 0x38,
+	0xdb, 0x1f, 0x27, 0x13, 0xb1, 0x98, 0x6e, 0xcd, 0x7a, 0xf9, 0x83, 0x21, 0xa7, 0xb2, 0xd8, 0x5e,
+	0xc5, 0x2f, 0x50, 0x3c, 0xed, 0xbf, 0x61, 0x91, 0xd9, 0x7e, 0x5a, 0x4d, 0x28, 0x8e, 0xc3, 0xf2,
+	0xf6, 0x80, 0x8c, 0x1a, 0x92, 0x6b, 0x5b, 0x32, 0x8d, 0x90, 0xed, 0x05, 0xee, 0x80, 0x7a, 0x06,
+	0x6f, 0xf4, 0xb9, 0xca, 0x72, 0x5c, 0xef, 0x80, 0x57, 0xb3, 0x40, 0xc8, 0xe3, 0xdb, 0x9b, 0xe4,
+	0x1c, 0xf6, 0xee, 0x80, 0x8b, 0x9f, 0xf2, 0x78, 0x89, 0xd9, 0x61, 0x38, 0xb1, 0xf8, 0x94, 0x98,
+	0x21, 0xe7, 0x16, 0x0a, 0x70, 0xa0, 0xf0, 0x49, 0xfb, 0x37, 0x2c, 0xf2, 0x94, 0xc7, 0x8e, 0x01,
+	0x53, 0x61, 0xaf, 0x4f, 0x04, 0xe1, 0x1b, 0x40, 0x4b, 0xdd, 0x2b, 0x86, 0x1d, 0x3f, 0x8b, 0x6f,
+	0x16, 0x6f, 0xf0, 0xd4, 0xea, 0x21, 0x5d, 0x82, 0x43, 0x3b, 0x6c, 0xbf, 0x87, 0x4c, 0xcb, 0x75,
+	0xb1, 0x89, 0x5b, 0x30, 0x3b, 0x68, 0x1b, 0x8b, 0x67, 0xd1, 0x09, 0x60, 0xcb, 0x04, 0x40, 0x1a,
+	0xcf, 0xf9, 0xd7, 0x55, 0x72, 0x2e, 0x3b, 0xdd, 0x98, 0x8e, 0x07, 0xb7, 0x9b, 0xb6, 0xd4, 0xff,
+	0xc8, 0xdd, 0xb3, 0xd4, 0xed, 0x46, 0x69, 0x97, 0xf4, 0x76, 0xa3, 0x9a, 0x62, 0x30, 0x98, 0xa3,
+	0x50, 0x7a, 0xd6, 0xcd, 0x6a, 0x4a, 0xc5, 0x0e, 0xf8, 0xc1, 0x32, 0xbb, 0x94, 0xb7, 0xe9, 0x5d,
+	0x10, 0x5d, 0x3b, 0x9b, 0x03, 0x41, 0xbe, 0x4b, 0xf6, 0xc7, 0x48, 0x23, 0x52, 0xce, 0x38, 0xd5,
+	0x32, 0xae, 0x6a, 0x72, 0xda, 0x88, 0xee, 0x28, 0x03, 0x90, 0x76, 0xbb, 0xd1, 0x1c, 0x9d, 0x4f,
+	0x57, 0xc8, 0xe3, 0xd9, 0x8f, 0x29, 0xf6, 0x88, 0xa3, 0x8d, 0x7e, 0xdf, 0x67, 0x91, 0xc9, 0x28,
+	0xf4, 0x7d, 0x2f, 0xe8, 0xe2, 0x3e, 0x27, 0x0e, 0xeb, 0x0f, 0x9c, 0xca, 0x79, 0x29, 0x36, 0x34,
+	0x26, 0x59, 0x83, 0xe6, 0x09, 0x66, 0x07, 0xec, 0xaf, 0x25, 0xd3, 0x1d, 0xea, 0x53, 0x7c, 0x76,
+	0x23, 0xc2, 0x3b, 0x11, 0x57, 0x32, 0x2b, 0xe7, 0x96, 0x65, 0x13, 0x08, 0x69, 0x5c, 0xf4, 0x51,
+	0x6c, 0x0e, 0xdb, 0xcc, 0x6d, 0x4a, 0x9e, 0x94, 0x3b, 0x95, 0x1a, 0xc7, 0x8d, 0x40, 0xd2, 0x13,
+	0xe7, 0xf1, 0xb3, 0x82, 0xcf, 0x93, 0x9b, 0xc3, 0x51, 0xe1, 0x30, 0x3a, 0xf6, 0xfb, 0xc9, 0x19,
+	0x63, 0x50, 0x62, 0x35, 0xaa, 0x8d, 0xc5, 0x79, 0x94, 0x9e, 0x16, 0x32, 0xb0, 0xd7, 0xef, 0xcd,
+	0x3d, 0x9e, 0x6d, 0x13, 0xa7, 0x4d, 0x8e, 0x8e, 0xf3, 0x53, 0xb9, 0x4f, 0xad, 0x04, 0x85, 0xcf,
+	0x59, 0x39, 0x55, 0xc4, 0x37, 0x9d, 0xc6, 0xe1, 0xcc, 0x94, 0x16, 0xca, 0xed, 0x64, 0x38, 0xce,
+	0x43, 0xb4, 0xf9, 0x3b, 0xff, 0xb6, 0x46, 0x0e, 0xe9, 0xd9, 0x08, 0x92, 0xff, 0xb1, 0x8d, 0xb0,
+	0xdf, 0x63, 0x29, 0x6b, 0x1b, 0xdf, 0x00, 0x3a, 0xa7, 0x35, 0xf6, 0xfc, 0xf2, 0x15, 0x73, 0xbf,
+	0x13, 0xa5, 0x82, 0x4f, 0xdb, 0xf5, 0xec, 0x1f, 0xb7, 0xd2, 0xf6, 0x42, 0xee, 0xc4, 0xe9, 0x9d,
+	0x5a, 0x9f, 0x0c, 0x23, 0x24, 0xef, 0x98, 0x36, 0x5d, 0x0d, 0x33, 0x4f, 0xce, 0x13, 0xb2, 0xe3,
+	0x05, 0xae, 0xef, 0xbd, 0x8a, 0x57, 0xab, 0x3a, 0x93, 0x0e, 0x98, 0xb8, 0x75, 0x45, 0xb5, 0x82,
+	0x81, 0x71, 0xf1, 0xaf, 0x92, 0x49, 0xe3, 0xcd, 0x0b, 0xdc, 0x65, 0xce, 0x99, 0xee, 0x32, 0x0d,
+	0xc3, 0xcb, 0xe5, 0xe2, 0x7b, 0xc9, 0x99, 0x6c, 0x07, 0x8f, 0xf3, 0xbc, 0xf3, 0x7f, 0xc6, 0xb3,
+	0x06, 0xbc, 0x2d, 0x1a, 0xf5, 0xb0, 0x6b, 0x6f, 0x68, 0xc5, 0xde, 0xd0, 0x8a, 0xbd, 0xa1, 0x15,
+	0x33, 0x0d, 0x1b, 0x42, 0xe3, 0x33, 0xfe, 0x80, 0x34, 0x3e, 0x29, 0x1d, 0xd6, 0x44, 0xe9, 0x3a,
+	0x2c, 0xe7, 0x53, 0x39, 0xb5, 0xff, 0x56, 0x44, 0xa9, 0x1d, 0x92, 0x7a, 0x10, 0x76, 0xa8, 0x14,
+	0x90, 0x5f, 0x2c, 0x47, 0xda, 0xbb, 0x11, 0x76, 0x0c, 0xf7, 0x78, 0xfc, 0x15, 0x03, 0xe7, 0xe3,
+	0xfc, 0xe9, 0x18, 0x49, 0xc9, 0xa2, 0xfc, 0xbb, 0x63, 0x74, 0x11, 0xed, 0x87, 0x37, 0x61, 0xad,
+	0x69, 0xa5, 0x2d, 0xcf, 0xc0, 0x9b, 0x41, 0xc2, 0xf1, 0xcc, 0xeb, 0xbb, 0xc9, 0x6e, 0xb3, 0x92,
+	0x3e, 0xf3, 0x50, 0xef, 0x04, 0x0c, 0x62, 0xbf, 0x97, 0xcc, 0x24, 0x29, 0x3b, 0xba, 0xb0, 0x17,
+	0x3f, 0x2e, 0x70, 0x67, 0xd2, 0x56, 0x76, 0xc8, 0x60, 0xdb, 0xaf, 0x90, 0xda, 0x2e, 0xf5, 0x7b,
+	0xe2, 0xd3, 0xb7, 0xca, 0x3b, 0x6b, 0xd8, 0xbb, 0x5e, 0xa3, 0x7e, 0x8f, 0xef, 0x84, 0xf8, 0x1f,
+	0x30, 0x56, 0x38, 0xef, 0x1b, 0x7b, 0x83, 0x38, 0x09, 0x7b, 0xde, 0xab, 0x52, 0x4d, 0xfa, 0x4d,
+	0x25, 0x33, 0xbe, 0x2e, 0xe9, 0x73, 0x7d, 0x94, 0xfa, 0x09, 0x9a, 0x33, 0xeb, 0x47, 0xc7, 0x8b,
+	0xd8, 0x94, 0x39, 0x68, 0x92, 0x53, 0xe9, 0xc7, 0xb2, 0xa4, 0xcf, 0xfb, 0xa1, 0x7e, 0x82, 0xe6,
+	0x6c, 0x1f, 0xa8, 0xf5, 0x37, 0x79, 0xc9, 0x2a, 0xf7, 0xe2, 0xc6, 0xfa, 0xc0, 0xd7, 0x5e, 0xe1,
+	0x3a, 0x7c, 0x96, 0xd4, 0xdb, 0xbb, 0x6e, 0x94, 0x34, 0xa7, 0xd8, 0xa4, 0x51, 0xb3, 0x78, 0x09,
+	0x1b, 0x81, 0xc3, 0xd0, 0xa9, 0x2a, 0xa2, 0x3b, 0xcd, 0xe9, 0xb4, 0x53, 0x15, 0xd0, 0x1d, 0xc0,
+	0x76, 0x25, 0x97, 0xcd, 0x0c, 0x95, 0xcb, 0x7a, 0xa4, 0xda, 0x1e, 0xd0, 0xe6, 0x6c, 0x19, 0xfb,
+	0x5b, 0xee, 0xed, 0x96, 0x6e, 0xae, 0xf0, 0x83, 0x68, 0xe9, 0xe6, 0x0a, 0x20, 0x1f, 0xe7, 0xf3,
+	0x15, 0x72, 0xae, 0x08, 0x8d, 0xc5, 0xea, 0xb9, 0xed, 0x3d, 0x74, 0xf9, 0xc8, 0x2c, 0xbc, 0x4d,
+	0xde, 0x0c, 0x12, 0x8e, 0x4a, 0x3f, 0xaa, 0x34, 0xa3, 0x62, 0xf9, 0xa9, 0x9b, 0xaf, 0xd6, 0x99,
+	0x82, 0x81, 0x65, 0xef, 0x90, 0x5a, 0xe2, 0x76, 0xa5, 0x28, 0xb9, 0x7c, 0xc2, 0xd3, 0xfd, 0xe6,
+	0xca, 0x96, 0xdb, 0x35, 0x2e, 0x7f, 0x6e, 0x37, 0x06, 0x46, 0xdf, 0x7e, 0x49, 0xf9, 0xa5, 0xf1,
+	0x23, 0xf6, 0xed, 0x43, 0xb7, 0x4e, 0x11, 0x64, 0x39, 0x0f, 0xee, 0x9d, 0x15, 0x69, 0x5a, 0x28,
+	0x74, 0x63, 0xfb, 0x89, 0x0a, 0xb9, 0x98, 0x1b, 0x32, 0x35, 0x59, 0xf9, 0x8e, 0xd5, 0x1e, 0x44,
+	0xb1, 0xd4, 0x7f, 0x1a, 0x3b, 0x16, 0x6b, 0x06, 0x09, 0xb7, 0x3f, 0x69, 0x91, 0x71, 0x54, 0xac,
+	0x07, 0x34, 0x69, 0x56, 0xca, 0xd6, 0xf2, 0xb1, 0x6e, 0xbd, 0xc8, 0xa9, 0xeb, 0x3e, 0x88, 0x06,
+	0x90, 0x7c, 0xb1, 0xbb, 0xf4, 0x6e, 0xdb, 0x1f, 0x74, 0x72, 0xbe, 0x4e, 0x2b, 0xbc, 0x19, 0x24,
+	0x1c, 0x51, 0xbd, 0x80, 0xa3, 0xd6, 0xd2, 0xa8, 0xab, 0x81, 0x40, 0x15, 0x70, 0xe7, 0x17, 0x1b,
+	0xe4, 0x7c, 0xe1, 0x06, 0x87, 0x42, 0x31, 0x1b, 0xc7, 0x2b, 0x9e, 0x4f, 0xa5, 0x97, 0x1f, 0x13,
+	0x8a, 0x6f, 0xa9, 0x56, 0x30, 0x30, 0xec, 0x6f, 0x25, 0xa4, 0xef, 0x46, 0x6e, 0x8f, 0x2a, 0xfb,
+	0xc4, 0x89, 0x65, 0x4f, 0xec, 0xc7, 0xa6, 0xa4, 0xa9, 0x67, 0xaa, 0x6a, 0x8a, 0xc1, 0x60, 0x89,
+	0x7e, 0x6b, 0x11, 0xf5, 0xa9, 0x1b, 0xb3, 0x80, 0x8c, 0x6c, 0x74, 0x19, 0x68, 0x10, 0x98, 0x78,
+	0xe8, 0x4a, 0x64, 0x4c, 0x3c, 0xc3, 0x95, 0x28, 0x3d, 0x9b, 0xec, 0xef, 0xb7, 0xc8, 0x0c, 0x46,
+	0xbc, 0x6a, 0xee, 0x22, 0x16, 0x6c, 0xe3, 0xe4, 0x2f, 0x79, 0xc5, 0xa4, 0xab, 0x4f, 0xb9, 0x54,
+	0x73, 0x0c, 0x19, 0xf6, 0xf8, 0x99, 0xf7, 0x69, 0xc4, 0xd6, 0xf2, 0x58, 0xfa, 0x33, 0xdf, 0xe2,
+	0xcd, 0x20, 0xe1, 0xf6, 0x02, 0x99, 0xed, 0xbb, 0x71, 0xbc, 0x14, 0xd1, 0x0e, 0x0d, 0x12, 0xcf,
+	0xf5, 0x79, 0xa4, 0xd6, 0x84, 0x8e, 0x16, 0xd8, 0x4c, 0x83, 0x21, 0x8b, 0x6f, 0xbf, 0x8f, 0x3c,
+	0xc1, 0x15, 0x80, 0xeb, 0x5e, 0x1c, 0x7b, 0x41, 0x57, 0x4f, 0x03, 0xa1, 0x07, 0x9d, 0x13, 0xa4,
+	0x9e, 0x58, 0x2d, 0x46, 0x83, 0x61, 0xcf, 0xa3, 0x07, 0x6b, 0xbc, 0xe7, 0xf5, 0x97, 0xa2, 0x4e,
+	0xcc, 0x8c, 0x7f, 0x13, 0x5a, 0xeb, 0xde, 0x12, 0xed, 0xa0, 0x30, 0xec, 0x36, 0x99, 0xe2, 0x9f,
+	0x84, 0x7b, 0x74, 0x36, 0xc9, 0xfd, 0xec, 0x17, 0xcc, 0x72, 0x76, 0xcb, 0x20, 0x03, 0x29, 0xa2,
+	0xe9, 0x5b, 0xf7, 0xe4, 0x08, 0xb7, 0xee, 0xaf, 0x26, 0x93, 0x7b, 0x83, 0x6d, 0x2a, 0x46, 0xbe,
+	0x39, 0x95, 0x9e, 0x7d, 0xd7, 0x35, 0x08, 0x4c, 0x3c, 0xe6, 0x4c, 0xdb, 0xf7, 0xc4, 0x2f, 0x0c,
+	0x0e, 0xd2, 0xce, 0xb4, 0x9b, 0xab, 0xb2, 0x19, 0x4c, 0x1c, 0xec, 0x1a, 0x8e, 0xc5, 0x16, 0x8d,
+	0x59, 0x78, 0x0f, 0x0e, 0x97, 0xea, 0x5a, 0x4b, 0x02, 0x40, 0xe3, 0xa0, 0xfa, 0x1a, 0x7f, 0xb4,
+	0x58, 0x50, 0xfa, 0x2d, 0xd7, 0xf7, 0x3a, 0x3a, 0xa6, 0xc6, 0x50, 0x5f, 0xb7, 0x0a, 0x70, 0xa0,
+	0xf0, 0x49, 0x66, 0xf8, 0xe0, 0xc3, 0x75, 0x25, 0x0a, 0x7b, 0xcd, 0x33, 0x97, 0xaa, 0x27, 0x3f,
+	0x03, 0x71, 0x1d, 0xdc, 0x52, 0x34, 0xf9, 0x46, 0xa4, 0xd7, 0xbc, 0x86, 0x80, 0xc1, 0x19, 0xa3,
+	0xcf, 0x9b, 0xc3, 0xf6, 0x52, 0x3b, 0xc6, 0x1d, 0x33, 0xb9, 0xe5, 0x46, 0x52, 0x36, 0x3e, 0x61,
+	0xdc, 0x9f, 0xa0, 0x7b, 0xcb, 0x8d, 0xcc, 0xbd, 0x97, 0x31, 0x00, 0xc9, 0xc9, 0x7e, 0x99, 0xd4,
+	0x12, 0xdf, 0x2d, 0x29, 0x50, 0xd8, 0xe0, 0xa8, 0xcf, 0xcc, 0xb5, 0x05, 0x3c, 0x33, 0x7d, 0x37,
+	0xb6, 0x9f, 0xc2, 0x8b, 0xfe, 0xb6, 0xb4, 0xe8, 0x8a, 0xbb, 0xf9, 0x76, 0x0c, 0xac, 0xd5, 0xf9,
+	0xe1, 0xe9, 0x82, 0xe3, 0x4f, 0xc9, 0x8c, 0x28, 0x0c, 0xe0, 0xec, 0xdd, 0x8c, 0xe8, 0x8e, 0x77,
+	0x57, 0x88, 0x0e, 0x6a, 0xb8, 0x6f, 0x28, 0x08, 0x18, 0x58, 0xf2, 0x99, 0xd6, 0x60, 0x07, 0x9f,
+	0xa9, 0xe4, 0x9f, 0xe1, 0x10, 0x30, 0xb0, 0xec, 0x77, 0x91, 0x31, 0xaf, 0xe7, 0x76, 0x95, 0xc3,
+	0x39, 0xc6, 0x5a, 0x8d, 0xad, 0xb2, 0x96, 0xd7, 0xef, 0xcd, 0xcd, 0xa8, 0x0e, 0xb1, 0x26, 0x10,
+	0xb8, 0xf6, 0x4f, 0x59, 0x64, 0xaa, 0x1d, 0xf6, 0x7a, 0x61, 0xc0, 0x35, 0x2d, 0x42, 0x6d, 0xf4,
+	0xf2, 0x69, 0x49, 0xd4, 0xf3, 0x4b, 0x06, 0x33, 0xae, 0x37, 0x52, 0x11, 0xcd, 0x26, 0x08, 0x52,
+	0xbd, 0x32, 0xb7, 0xe0, 0xfa, 0x11, 0x5b, 0xf0, 0x2f, 0x58, 0xe4, 0x2c, 0x7f, 0xd6, 0x50, 0x00,
+	0x89, 0xe0, 0xdd, 0xf0, 0x94, 0x5f, 0x2b, 0xa7, 0x13, 0x53, 0x46, 0x85, 0x1c, 0x1c, 0xf2, 0x9d,
+	0xb4, 0xaf, 0x92, 0xb3, 0x3b, 0x21, 0x8a, 0x9b, 0xe6, 0x07, 0xe1, 0xe7, 0x87, 0x22, 0x74, 0x25,
+	0x8b, 0x00, 0xf9, 0x67, 0xec, 0x5b, 0xe4, 0x71, 0xa3, 0xd1, 0x1c, 0x07, 0x7e, 0x84, 0x3c, 0x23,
+	0xa8, 0x3d, 0x7e, 0xa5, 0x10, 0x0b, 0x86, 0x3c, 0x9d, 0xde, 0xad, 0x1b, 0x23, 0xec, 0xd6, 0x1f,
+	0x26, 0x17, 0xda, 0xf9, 0x91, 0xd9, 0x8f, 0x07, 0xdb, 0x31, 0x3f, 0x50, 0x26, 0x16, 0xbf, 0x4c,
+	0x10, 0xb8, 0xb0, 0x34, 0x0c, 0x11, 0x86, 0xd3, 0xb0, 0x3f, 0x4a, 0x26, 0x22, 0xca, 0xbe, 0x4a,
+	0x2c, 0x22, 0x59, 0x4f, 0xa8, 0x18, 0xd3, 0x97, 0x3d, 0x4e, 0x56, 0x1f, 0x91, 0xa2, 0x21, 0x06,
+	0xc5, 0xd1, 0xbe, 0x83, 0x77, 0x82, 0xa4, 0xbd, 0x2b, 0xe2, 0x57, 0x4f, 0x6c, 0x03, 0x52, 0xcc,
+	0x99, 0xc9, 0xce, 0xbc, 0x61, 0x30, 0x26, 0x20, 0xb9, 0xa1, 0xd0, 0xd8, 0x0e, 0x7b, 0xfd, 0x30,
+	0xa0, 0x41, 0x22, 0x4f, 0xb3, 0x19, 0x6e, 0x57, 0x93, 0xad, 0x60, 0x60, 0xe4, 0x84, 0x0a, 0x8d,
+	0xd6, 0x3c, 0x7b, 0x88, 0x50, 0x61, 0x50, 0x1b, 0xf6, 0x3c, 0x9e, 0x7a, 0x4c, 0x03, 0x7d, 0xdb,
+	0x4b, 0x76, 0xd1, 0xe4, 0x23, 0x35, 0x33, 0x33, 0xe9, 0x53, 0x6f, 0xad, 0x00, 0x07, 0x0a, 0x9f,
+	0xcc, 0x1e, 0xf1, 0xb3, 0xf7, 0x77, 0xc4, 0x9f, 0x19, 0xe1, 0x88, 0x6f, 0x91, 0xf3, 0xac, 0x07,
+	0x42, 0x5c, 0x97, 0xfa, 0xed, 0xb8, 0x69, 0xb3, 0xce, 0xab, 0x38, 0xaa, 0xb5, 0x22, 0x24, 0x28,
+	0x7e, 0xf6, 0xe2, 0x37, 0x90, 0xb3, 0xb9, 0x4d, 0xee, 0x58, 0xba, 0xeb, 0x65, 0xf2, 0x78, 0xf1,
+	0x76, 0x72, 0x2c, 0x0d, 0xf6, 0x3f, 0xcd, 0xc4, 0x3f, 0x18, 0xb7, 0xf9, 0x11, 0xac, 0x21, 0x2e,
+	0xa9, 0xd2, 0x60, 0x5f, 0x9c, 0xae, 0x57, 0x4e, 0x36, 0xab, 0x57, 0x82, 0x7d, 0xbe, 0x1b, 0xb2,
+	0x9b, 0xf6, 0x4a, 0xb0, 0x0f, 0x48, 0xdb, 0xfe, 0x41, 0x2b, 0x75, 0x93, 0xe1, 0x17, 0xdf, 0x0f,
+	0x9d, 0x8a, 0xfa, 0x62, 0xe4, 0xcb, 0x8d, 0xf3, 0xef, 0x2a, 0xe4, 0xd2, 0x51, 0x44, 0x46, 0x18,
+	0xbe, 0x67, 0x31, 0x00, 0x23, 0xf2, 0x82, 0xae, 0x38, 0xae, 0x26, 0x71, 0x15, 0x73, 0x1f, 0xa7,
+	0x0f, 0x83, 0x00, 0xd9, 0x3e, 0xa9, 0xf6, 0xdc, 0xbe, 0x50, 0xad, 0xaf, 0x9e, 0x34, 0x4e, 0x14,
+	0x7f, 0xbb, 0xfe, 0xba, 0xdb, 0xe7, 0x73, 0xde, 0x68, 0x00, 0x64, 0x63, 0x27, 0xa4, 0xee, 0x46,
+	0x91, 0x2b, 0xdd, 0x67, 0xae, 0x97, 0xc3, 0x6f, 0x01, 0x49, 0x72, 0xef, 0x83, 0x54, 0x13, 0x70,
+	0x66, 0xce, 0x0f, 0x37, 0x52, 0x41, 0x85, 0xcc, 0x27, 0x2a, 0x26, 0x63, 0x42, 0xa3, 0x6e, 0x95,
+	0x1d, 0x9e, 0xcb, 0xa5, 0x59, 0xa6, 0xa4, 0xe0, 0xff, 0x83, 0x60, 0x65, 0x7f, 0xc6, 0x62, 0x49,
+	0x51, 0x64, 0xa4, 0x66, 0xb3, 0x52, 0xb2, 0xfb, 0x8e, 0x99, 0xa3, 0xc5, 0x4c, 0xb5, 0x22, 0x1b,
+	0xc1, 0xe4, 0x2e, 0x12, 0x3f, 0xb1, 0x6b, 0x55, 0x3e, 0xf1, 0x13, 0x36, 0x83, 0x84, 0xdb, 0x77,
+	0x0b, 0x7c, 0x9f, 0x4a, 0x48, 0xac, 0x31, 0x82, 0xb7, 0xd3, 0x8f, 0x5b, 0xe4, 0xac, 0x97, 0x75,
+	0x62, 0x69, 0xd6, 0xcb, 0xf0, 0xae, 0x1b, 0xee, 0x23, 0xa3, 0x04, 0x9d, 0x1c, 0x08, 0xf2, 0x9d,
+	0xb1, 0x3b, 0xa4, 0xe6, 0x05, 0x3b, 0xa1, 0x10, 0xef, 0x16, 0x4f, 0xd6, 0xa9, 0xd5, 0x60, 0x27,
+	0xd4, 0xab, 0x19, 0x7f, 0x01, 0xa3, 0x6e, 0xaf, 0x91, 0x73, 0x32, 0xae, 0xec, 0x9a, 0x17, 0xa3,
+	0x52, 0x6b, 0xcd, 0xeb, 0x79, 0x09, 0x13, 0xcd, 0xaa, 0x8b, 0x4d, 0x3c, 0xde, 0xa0, 0x00, 0x0e,
+	0x85, 0x4f, 0xd9, 0xaf, 0x92, 0x71, 0xe9, 0x38, 0x32, 0x51, 0x86, 0x62, 0x23, 0x3f, 0xff, 0xd5,
+	0x64, 0xe2, 0xbf, 0x63, 0x90, 0x0c, 0xed, 0x4f, 0x5b, 0x64, 0x86, 0xff, 0x7f, 0xed, 0xa0, 0xc3,
+	0x43, 0x59, 0x1b, 0x65, 0x44, 0x87, 0xb4, 0x52, 0x34, 0x17, 0x6d, 0xd4, 0xaa, 0xa4, 0xdb, 0x20,
+	0xc3, 0x37, 0x9b, 0x31, 0x86, 0x3c, 0xc8, 0x8c, 0x31, 0xce, 0xcf, 0x4c, 0x91, 0xb3, 0x0b, 0x87,
+	0x3b, 0xf5, 0x58, 0x0f, 0xda, 0xa9, 0x07, 0xaf, 0xb4, 0xb1, 0xf6, 0xc7, 0x29, 0x61, 0x28, 0x04,
+	0x57, 0xed, 0x2e, 0x81, 0x9e, 0x37, 0x8c, 0x87, 0x3d, 0x20, 0x63, 0x3c, 0xe9, 0x5b, 0xb3, 0x5a,
+	0x86, 0xd9, 0x2e, 0x93, 0x99, 0x4e, 0x2b, 0xf7, 0x78, 0x2b, 0x08, 0x66, 0xf6, 0x5d, 0x32, 0xbe,
+	0xcb, 0xd7, 0x82, 0xb8, 0x68, 0xae, 0x9f, 0x74, 0x7c, 0x53, 0x0b, 0x4c, 0xcf, 0x7c, 0xd1, 0x00,
+	0x92, 0x1d, 0x53, 0xa5, 0x18, 0x5e, 0x6e, 0xf5, 0x32, 0x54, 0x29, 0x45, 0x49, 0x17, 0x8e, 0x74,
+	0x71, 0xfb, 0x08, 0x99, 0x8a, 0x68, 0x3b, 0x0c, 0xda, 0x9e, 0x4f, 0x3b, 0x0b, 0xd2, 0x70, 0x7b,
+	0x9c, 0x48, 0x50, 0xa6, 0x53, 0x03, 0x83, 0x06, 0xa4, 0x28, 0xb2, 0x45, 0xae, 0xb2, 0x43, 0xe0,
+	0x07, 0xa1, 0xc2, 0x40, 0xb7, 0x56, 0x52, 0x2e, 0x0a, 0x46, 0x93, 0x2f, 0xf2, 0x74, 0x1b, 0x64,
+	0xf8, 0xda, 0xef, 0x27, 0x24, 0xdc, 0xe6, 0x8e, 0xa2, 0x0b, 0x49, 0x73, 0xe2, 0xd8, 0xaf, 0x3a,
+	0xc3, 0x23, 0xca, 0x25, 0x05, 0x30, 0xa8, 0xd9, 0xd7, 0x09, 0xe1, 0x2b, 0x07, 0xcd, 0xe9, 0xcd,
+	0x46, 0x2a, 0x94, 0x97, 0xb4, 0x14, 0xe4, 0xf5, 0x7b, 0x73, 0x79, 0xcd, 0x3b, 0x02, 0xc0, 0x78,
+	0xdc, 0xfe, 0x16, 0x32, 0x1e, 0x0f, 0x7a, 0x3d, 0x57, 0xd9, 0xf2, 0x4a, 0x8c, 0x51, 0xe7, 0x74,
+	0x8d, 0x5d, 0x99, 0x37, 0x80, 0xe4, 0x68, 0xbf, 0x8c, 0xe7, 0x8b, 0xd8, 0x1e, 0xf9, 0x2a, 0x62,
+	0xff, 0x0b, 0x7d, 0xe8, 0xbb, 0xe5, 0x15, 0x0a, 0x0a, 0x70, 0xd0, 0x95, 0x2c, 0xdd, 0xbe, 0x16,
+	0xb6, 0x85, 0x4a, 0xb1, 0x88, 0xa6, 0xfd, 0x22, 0x99, 0xd4, 0xaf, 0x2d, 0xd3, 0x2e, 0xbd, 0x55,
+	0xe7, 0xb7, 0x63, 0xcd, 0xc3, 0xc7, 0xcc, 0x7c, 0xd8, 0x5e, 0x27, 0x8f, 0xb5, 0xc3, 0x20, 0x89,
+	0x42, 0xdf, 0xe7, 0xb9, 0x2f, 0xb9, 0x62, 0x80, 0xdb, 0xfa, 0x9e, 0x14, 0xdd, 0x7e, 0x6c, 0x29,
+	0x8f, 0x02, 0x45, 0xcf, 0xe1, 0x85, 0x20, 0x7b, 0x38, 0xcd, 0x94, 0xe2, 0x06, 0x92, 0xa2, 0x29,
+	0x76, 0x28, 0xa5, 0xfc, 0x3f, 0xfc, 0x98, 0x72, 0x82, 0xb4, 0x33, 0x80, 0xf8, 0x62, 0xef, 0x22,
+	0x53, 0x18, 0x6e, 0x13, 0x05, 0xae, 0x7f, 0x13, 0xd6, 0xa4, 0xd9, 0x86, 0x2d, 0xcc, 0x15, 0xa3,
+	0x1d, 0x52, 0x58, 0x98, 0x9e, 0x41, 0xa8, 0xe8, 0x8c, 0xf4, 0x0c, 0x5c, 0x45, 0x27, 0x15, 0x72,
+	0xce, 0xe7, 0xab, 0x29, 0x81, 0xf9, 0xa1, 0xb8, 0x1e, 0xb0, 0xd4, 0x65, 0x32, 0xc7, 0x1b, 0x03,
+	0x34, 0x2b, 0xa5, 0x73, 0x56, 0xde, 0x9d, 0x1b, 0x26, 0x23, 0x48, 0xf3, 0xb5, 0xf7, 0x48, 0x7d,
+	0x37, 0x8c, 0x13, 0x79, 0x3d, 0x3c, 0xe1, 0x4d, 0xf4, 0x5a, 0x18, 0x27, 0x4c, 0xca, 0x53, 0xaf,
+	0x8d, 0x2d, 0x31, 0x70, 0x1e, 0xa8, 0x78, 0x88, 0x77, 0xdd, 0xa8, 0x13, 0x2f, 0xb1, 0x64, 0x2a,
+	0x35, 0x26, 0xde, 0x29, 0x61, 0xbe, 0xa5, 0x41, 0x60, 0xe2, 0x39, 0xff, 0xc3, 0x4a, 0xd9, 0xf6,
+	0x6e, 0xb3, 0xc8, 0x98, 0x7d, 0x1a, 0xe0, 0x16, 0x65, 0xfa, 0xe2, 0xbe, 0x27, 0x93, 0x67, 0xe0,
+	0x2d, 0xc3, 0xd2, 0xd4, 0xde, 0x41, 0x0a, 0xf3, 0x8c, 0x84, 0xe1, 0xb6, 0xfb, 0x09, 0x2b, 0x9d,
+	0x30, 0xa2, 0x52, 0xc6, 0xbd, 0xd1, 0xe8, 0xf7, 0xd1, 0xb9, 0x27, 0x9c, 0x1f, 0xb4, 0xc8, 0xf8,
+	0xa2, 0xdb, 0xde, 0x0b, 0x77, 0x76, 0xd0, 0x98, 0xd4, 0x19, 0x44, 0x66, 0xee, 0x0a, 0xa5, 0x29,
+	0x5b, 0x16, 0xed, 0xa0, 0x30, 0x70, 0xea, 0xef, 0xb8, 0x6d, 0x99, 0x3a, 0xa5, 0xca, 0xa7, 0xfe,
+	0x15, 0xd6, 0x02, 0x02, 0x82, 0xc3, 0xdf, 0x73, 0xef, 0xca, 0x87, 0xb3, 0x86, 0xc5, 0x75, 0x0d,
+	0x02, 0x13, 0xcf, 0xf9, 0x57, 0x16, 0x69, 0x2e, 0xba, 0xb1, 0xd7, 0xc6, 0xd4, 0xbd, 0x8b, 0x5e,
+	0xb2, 0x3d, 0x68, 0xef, 0xd1, 0x84, 0xa7, 0xd8, 0xc1, 0x5e, 0x0e, 0x62, 0x1a, 0x19, 0xd7, 0x75,
+	0xd5, 0xcb, 0x9b, 0xa2, 0x1d, 0x14, 0x86, 0xfd, 0x2a, 0x99, 0x44, 0x73, 0xdc, 0x9d, 0x30, 0xea,
+	0x00, 0xdd, 0x29, 0x27, 0x09, 0x57, 0x8b, 0xb6, 0x23, 0x9a, 0x00, 0xdd, 0x11, 0x8e, 0x54, 0x9a,
+	0x3e, 0x98, 0xcc, 0x9c, 0xef, 0xb2, 0xc8, 0xb9, 0x45, 0xea, 0x46, 0x34, 0x62, 0x39, 0xbb, 0xd4,
+	0x8b, 0xd8, 0xaf, 0x90, 0x89, 0x04, 0x5b, 0xb0, 0x47, 0x56, 0xb9, 0x3d, 0x62, 0x2e, 0x50, 0x5b,
+	0x82, 0x38, 0x28, 0x36, 0xce, 0xf7, 0x59, 0xe4, 0x42, 0x51, 0x5f, 0x96, 0xfc, 0x70, 0xd0, 0x79,
+	0x18, 0x1d, 0xda, 0x20, 0x63, 0xdc, 0xa7, 0x61, 0x24, 0xdd, 0x8b, 0xa9, 0x12, 0xd3, 0x4b, 0x9d,
+	0x99, 0xb6, 0x84, 0x86, 0xcc, 0xf9, 0x9b, 0x16, 0x99, 0x62, 0x7e, 0x2a, 0xcb, 0x34, 0x71, 0x3d,
+	0x3f, 0x97, 0x33, 0xd5, 0x1a, 0x31, 0x67, 0xea, 0x25, 0x52, 0xdb, 0x0d, 0x7b, 0x34, 0xeb, 0x63,
+	0x75, 0x2d, 0xc4, 0xee, 0x20, 0x04, 0xd5, 0x92, 0x3d, 0xd7, 0x0b, 0x12, 0x17, 0xd7, 0xb7, 0x34,
+	0xce, 0xcc, 0xf2, 0x19, 0xad, 0x9a, 0xc1, 0xc4, 0x71, 0x7e, 0xb9, 0x41, 0xc6, 0x85, 0x43, 0xe0,
+	0xc8, 0x39, 0xa4, 0xe4, 0xb8, 0x54, 0x86, 0x8e, 0x4b, 0x4c, 0xc6, 0xda, 0x2c, 0xb1, 0x75, 0xb3,
+	0x5a, 0x86, 0x06, 0x48, 0x74, 0x90, 0xe7, 0xca, 0xd6, 0xdd, 0xe2, 0xbf, 0x41, 0xb0, 0xb2, 0x3f,
+	0x6b, 0x91, 0xd9, 0x76, 0x18, 0x04, 0xb4, 0xad, 0x85, 0xd1, 0x5a, 0x19, 0x37, 0x8e, 0xa5, 0x34,
+	0x51, 0x6d, 0x60, 0xcf, 0x00, 0x20, 0xcb, 0x1e, 0xa3, 0x0d, 0xf8, 0x98, 0xdd, 0x4a, 0x59, 0x94,
+	0x74, 0x2a, 0x4d, 0x13, 0x08, 0x69, 0x5c, 0x54, 0xbc, 0x07, 0x3a, 0x69, 0xe5, 0x98, 0x56, 0xbc,
+	0x1b, 0xe9, 0x2a, 0x0d, 0x0c, 0xcc, 0xfe, 0x12, 0xd1, 0x9d, 0x88, 0xc6, 0xbb, 0xc2, 0x61, 0x92,
+	0x09, 0xc2, 0xe3, 0xf7, 0x97, 0xfd, 0x05, 0x72, 0x94, 0xa0, 0x80, 0xba, 0xbd, 0x27, 0x94, 0x22,
+	0x13, 0x65, 0x1c, 0x10, 0xe2, 0x33, 0x0f, 0xd5, 0x8d, 0xcc, 0x91, 0x3a, 0x3b, 0x0b, 0x99, 0x00,
+	0x5e, 0xe5, 0x11, 0xc7, 0xec, 0xa4, 0x04, 0xde, 0x6e, 0x2f, 0x93, 0x33, 0x99, 0x44, 0xa0, 0xb1,
+	0xb0, 0xfc, 0xa8, 0xe8, 0xd2, 0x4c, 0x0a, 0xd1, 0x18, 0x72, 0x4f, 0x98, 0x0a, 0xb3, 0xc9, 0x23,
+	0x14, 0x66, 0x07, 0xca, 0x2d, 0x9f, 0xdb, 0x64, 0x5e, 0x2a, 0x65, 0x00, 0x46, 0xf2, 0xc1, 0xff,
+	0xde, 0x8c, 0x0f, 0xfe, 0xf4, 0xa5, 0xea, 0xc9, 0x7d, 0x98, 0x64, 0x07, 0x8e, 0xef, 0x70, 0xff,
+	0x30, 0x1d, 0xe8, 0x7f, 0xbe, 0x42, 0xe4, 0x77, 0x5d, 0x72, 0xdb, 0xbb, 0x14, 0xa7, 0x0c, 0xfa,
+	0x9b, 0x2a, 0x75, 0x07, 0x97, 0xb1, 0x2c, 0x36, 0x6b, 0x94, 0x30, 0x0e, 0x29, 0x28, 0x64, 0xb0,
+	0xd1, 0xfe, 0x88, 0xe3, 0xc4, 0x1f, 0xe5, 0x82, 0x84, 0x52, 0xa9, 0x2c, 0x6c, 0xae, 0x8a, 0xa7,
+	0x34, 0x8e, 0x1d, 0x92, 0xb3, 0xbe, 0x1b, 0x27, 0xac, 0x07, 0xa8, 0xfd, 0xb8, 0xcf, 0xdc, 0x4b,
+	0x2c, 0x84, 0x71, 0x2d, 0x4b, 0x08, 0xf2, 0xb4, 0xd1, 0x01, 0x88, 0xa2, 0xdc, 0x16, 0x6f, 0xd2,
+	0x68, 0xdd, 0x0b, 0x06, 0x62, 0xbb, 0xab, 0xea, 0xfd, 0x69, 0x25, 0x0d, 0x86, 0x2c, 0xbe, 0xf3,
+	0x1f, 0xea, 0x64, 0x3a, 0xb5, 0xb9, 0x1e, 0x53, 0x88, 0x79, 0x1b, 0x99, 0x90, 0x72, 0x45, 0x36,
+	0x4f, 0x9d, 0x12, 0x3e, 0x14, 0x06, 0x9e, 0x7b, 0xdb, 0xfa, 0xa4, 0xcf, 0x0a, 0x5d, 0x86, 0x10,
+	0x00, 0x26, 0x1e, 0xdb, 0xd7, 0x13, 0x3f, 0x5e, 0xf2, 0x3d, 0x1a, 0x24, 0xbc, 0x9b, 0xe5, 0xec,
+	0xeb, 0x5b, 0x6b, 0x2d, 0x93, 0xa8, 0x1e, 0xb7, 0x0c, 0x00, 0xb2, 0xec, 0xed, 0xbf, 0x66, 0x91,
+	0x69, 0xf7, 0x4e, 0xac, 0x0b, 0x38, 0x34, 0xeb, 0x65, 0x9c, 0x73, 0xa9, 0x9a, 0x10, 0xdc, 0xd2,
+	0x91, 0x6a, 0x82, 0x34, 0x53, 0x0c, 0xca, 0xb2, 0xe9, 0x5d, 0xda, 0x96, 0x21, 0x05, 0xa2, 0x2f,
+	0x63, 0x65, 0x68, 0x15, 0x56, 0x72, 0x74, 0xf9, 0xc1, 0x90, 0x6f, 0x87, 0x82, 0x3e, 0xd8, 0x2f,
+	0x12, 0xbb, 0xe3, 0xc5, 0xee, 0xb6, 0x8f, 0xa6, 0x7d, 0xe5, 0x9f, 0xca, 0x1d, 0x0c, 0x2e, 0x8a,
+	0x71, 0xb6, 0x97, 0x73, 0x18, 0x50, 0xf0, 0x14, 0x9b, 0x65, 0x51, 0x78, 0xf7, 0xe0, 0x66, 0xe4,
+	0x37, 0x27, 0x32, 0xb3, 0x4c, 0xb4, 0x83, 0xc2, 0x70, 0xfe, 0xb8, 0xaa, 0x76, 0x03, 0x1d, 0x3f,
+	0xe3, 0x1a, 0x7e, 0xfc, 0xd6, 0xfd, 0xfb, 0xf1, 0x2b, 0xbe, 0x05, 0xf9, 0x28, 0x52, 0xe1, 0xeb,
+	0x95, 0x87, 0x14, 0xbe, 0xfe, 0x6d, 0x56, 0x2a, 0x17, 0xe4, 0xe4, 0xf3, 0xef, 0x2f, 0x37, 0x76,
+	0x67, 0x9e, 0x3b, 0x6d, 0x65, 0x8e, 0xa6, 0x8c, 0x5b, 0xe5, 0xdb, 0xc8, 0xc4, 0x8e, 0xef, 0xb2,
+	0x0c, 0x46, 0xcd, 0x5a, 0xda, 0xf7, 0xef, 0x8a, 0x68, 0x07, 0x85, 0x81, 0x07, 0x87, 0x41, 0xf4,
+	0x58, 0x1b, 0xff, 0x7f, 0xae, 0x92, 0x49, 0x43, 0x68, 0x28, 0x94, 0x00, 0xad, 0x47, 0x4c, 0x02,
+	0xac, 0x1c, 0x43, 0x02, 0xfc, 0x56, 0xd2, 0x68, 0xcb, 0x03, 0xad, 0x9c, 0x72, 0x1c, 0xd9, 0x63,
+	0x52, 0x9f, 0x69, 0xaa, 0x09, 0x34, 0x4f, 0xf4, 0x12, 0x32, 0xc8, 0xa4, 0x74, 0x15, 0x45, 0x31,
+	0xcc, 0xe2, 0x50, 0xcc, 0x3f, 0x93, 0x75, 0x98, 0xa8, 0x1f, 0xed, 0x30, 0x81, 0xa9, 0x86, 0xe5,
+	0xc7, 0x7d, 0x00, 0xb9, 0xb0, 0x5e, 0x4e, 0xe7, 0xc2, 0x5a, 0x29, 0x65, 0x98, 0x87, 0x24, 0xc1,
+	0xba, 0x41, 0xc6, 0xd1, 0xe9, 0xc2, 0x0d, 0x3a, 0xf6, 0x97, 0x93, 0xf1, 0x36, 0xff, 0x57, 0xe8,
+	0xf5, 0x98, 0xf5, 0x5e, 0x40, 0x41, 0xc2, 0xd0, 0x2b, 0xd0, 0x8d, 0xba, 0x52, 0x97, 0xc7, 0xbc,
+	0x02, 0x17, 0x22, 0xf4, 0xb3, 0xc7, 0x56, 0xe7, 0x7f, 0x59, 0x64, 0x06, 0x1f, 0xf1, 0x92, 0x75,
+	0xf9, 0x3a, 0xcf, 0x91, 0x31, 0x77, 0x90, 0xec, 0x86, 0xb9, 0xab, 0xdc, 0x02, 0x6b, 0x05, 0x01,
+	0xc5, 0xab, 0x9c, 0x4a, 0xa2, 0x62, 0x5c, 0xe5, 0x96, 0x71, 0x2e, 0x33, 0x08, 0x4a, 0xc3, 0xf1,
+	0x60, 0xbb, 0xc8, 0x7c, 0xdc, 0xe2, 0xcd, 0x20, 0xe1, 0x48, 0x6c, 0x3b, 0xec, 0x1c, 0x34, 0x6b,
+	0x69, 0x62, 0x8b, 0x61, 0xe7, 0x00, 0x18, 0x04, 0x23, 0x34, 0xe2, 0x5d, 0x57, 0x3a, 0x2a, 0x08,
+	0x84, 0x6a, 0xeb, 0xda, 0x02, 0x60, 0xbb, 0x0a, 0x38, 0x8a, 0xfc, 0xe6, 0xd8, 0x61, 0x01, 0x47,
+	0x91, 0xef, 0xfc, 0x93, 0x1a, 0x61, 0x0e, 0x48, 0x6e, 0x44, 0x3b, 0x5b, 0x21, 0x4b, 0xc3, 0x7d,
+	0xaa, 0x76, 0x7e, 0x7d, 0x17, 0x7e, 0x94, 0x6d, 0xfd, 0x86, 0xbd, 0xb7, 0xfa, 0xa0, 0xed, 0xbd,
+	0xc5, 0x26, 0xfc, 0xda, 0x23, 0x64, 0xc2, 0x77, 0xbe, 0xc7, 0x22, 0xb6, 0x72, 0x27, 0xd3, 0x3e,
+	0x36, 0x97, 0x49, 0x43, 0xf9, 0xaf, 0x89, 0xf5, 0xa2, 0xb7, 0x45, 0x09, 0x00, 0x8d, 0x33, 0x82,
+	0x02, 0x44, 0x29, 0x86, 0xaa, 0x87, 0x28, 0x86, 0x7e, 0xa5, 0x42, 0x1e, 0xe7, 0xe2, 0xd2, 0xba,
+	0x1b, 0xb8, 0x5d, 0xda, 0xc3, 0x5e, 0x8d, 0xea, 0x35, 0xd5, 0xc6, 0x9b, 0xb7, 0x27, 0x63, 0x57,
+	0x4e, 0xba, 0x5f, 0xf1, 0x7d, 0x86, 0xef, 0x2c, 0xab, 0x81, 0x97, 0x00, 0x23, 0x6e, 0xc7, 0x64,
+	0x42, 0xd6, 0x2e, 0x6b, 0x56, 0xcb, 0x64, 0xa4, 0xb6, 0x62, 0x21, 0x59, 0x50, 0x50, 0x8c, 0x50,
+	0x7c, 0xf0, 0xc3, 0xf6, 0x1e, 0x2e, 0xf9, 0xac, 0xf8, 0xb0, 0x26, 0xda, 0x41, 0x61, 0x38, 0x3d,
+	0x32, 0x2b, 0xc7, 0xb0, 0x8f, 0xf9, 0xb3, 0xe9, 0x0e, 0x9e, 0xb9, 0x6d, 0xd9, 0x64, 0x94, 0x53,
+	0x53, 0x67, 0xee, 0x92, 0x09, 0x84, 0x34, 0xae, 0xcc, 0xcc, 0x5d, 0x29, 0xce, 0xcc, 0xed, 0xfc,
+	0x8a, 0x45, 0xb2, 0x87, 0xbe, 0x91, 0x87, 0xd8, 0x3a, 0x34, 0x0f, 0xf1, 0x31, 0x32, 0xf9, 0x7e,
+	0x33, 0x99, 0x74, 0x13, 0x94, 0xea, 0xb8, 0x12, 0xa7, 0x7a, 0x7f, 0xd6, 0xcc, 0xf5, 0xb0, 0xe3,
+	0xed, 0x78, 0x48, 0x01, 0x4c, 0x72, 0xce, 0xe7, 0x2c, 0xd2, 0x58, 0x8e, 0x0e, 0x8e, 0x1f, 0xe6,
+	0x99, 0x0f, 0xe2, 0xac, 0x1c, 0x2b, 0x88, 0x53, 0x86, 0x89, 0x56, 0x87, 0x85, 0x89, 0x3a, 0xff,
+	0xbb, 0x46, 0xce, 0xe6, 0xe2, 0x96, 0xed, 0x17, 0xc8, 0x94, 0xfa, 0x4a, 0x52, 0x15, 0xdc, 0x30,
+	0xbd, 0xb9, 0x35, 0x0c, 0x52, 0x98, 0x23, 0x2c, 0xd5, 0x55, 0xf2, 0x58, 0x84, 0x1a, 0xad, 0x01,
+	0x5d, 0xd8, 0x49, 0x68, 0xd4, 0xa2, 0x68, 0x40, 0xe7, 0x89, 0xbc, 0xab, 0x8b, 0x4f, 0xa0, 0x55,
+	0x11, 0xf2, 0x60, 0x28, 0x7a, 0xc6, 0xee, 0x93, 0x69, 0xdf, 0xbc, 0x2f, 0x34, 0x6b, 0xf7, 0x7f,
+	0xd5, 0x50, 0xb3, 0x35, 0xd5, 0x0c, 0x69, 0x06, 0xe9, 0x4b, 0x47, 0xfd, 0x21, 0x5d, 0x3a, 0xbe,
+	0x5d, 0x5f, 0x3a, 0xb8, 0x73, 0xd4, 0x07, 0x4a, 0x8e, 0x5b, 0x1f, 0xe5, 0xd6, 0x71, 0x92, 0x7b,
+	0xc4, 0x4b, 0x64, 0x42, 0x3a, 0x8e, 0x96, 0xa5, 0xf4, 0x7f, 0x8e, 0xbc, 0x79, 0x25, 0x8a, 0x8c,
+	0xc1, 0xbc, 0x11, 0x26, 0x0b, 0xbe, 0x1f, 0xde, 0x41, 0x71, 0xe5, 0x66, 0x4c, 0x85, 0x2a, 0xd1,
+	0x79, 0xbd, 0x42, 0x0a, 0xae, 0xd4, 0xb8, 0x26, 0xb5, 0x5c, 0x98, 0x5a, 0x93, 0xc7, 0x93, 0x0d,
+	0xed, 0xbb, 0xdc, 0xb9, 0x96, 0x4b, 0x03, 0xef, 0x2b, 0x5b, 0x25, 0xa0, 0xfd, 0x6d, 0xd5, 0x4e,
+	0xa9, 0x7c, 0x6e, 0x9f, 0x27, 0x44, 0x8b, 0xf3, 0x42, 0x26, 0x54, 0x0e, 0x2b, 0x5a, 0xea, 0x07,
+	0x03, 0x0b, 0x35, 0x44, 0x5e, 0x10, 0x27, 0xae, 0xef, 0x5f, 0xf3, 0x82, 0x44, 0xc8, 0x89, 0x4a,
+	0xec, 0x59, 0xd5, 0x20, 0x30, 0xf1, 0x2e, 0xbe, 0xdb, 0xf8, 0x7e, 0xc7, 0xf9, 0xee, 0x3b, 0xe4,
+	0x1c, 0xcb, 0x29, 0xc6, 0x8f, 0x5f, 0xf6, 0x4e, 0xbe, 0xd7, 0x4e, 0xf0, 0x0b, 0xef, 0x60, 0x7b,
+	0xd3, 0x4a, 0x7f, 0x61, 0x86, 0x0c, 0x1c, 0xc6, 0x76, 0x73, 0xfe, 0x5c, 0x6e, 0x37, 0xe7, 0xcd,
+	0x20, 0xe1, 0xce, 0x2e, 0xb9, 0x70, 0xd5, 0x4b, 0x54, 0x98, 0xaa, 0x9a, 0xd7, 0x78, 0x2b, 0x50,
+	0x7b, 0xa2, 0x35, 0x34, 0x74, 0xde, 0x08, 0x13, 0xad, 0xa4, 0xa3, 0x5a, 0xb3, 0x61, 0xa2, 0x4e,
+	0x9b, 0x9c, 0xbb, 0xea, 0x25, 0x18, 0x82, 0x77, 0x8a, 0x4c, 0x7e, 0x69, 0x8c, 0x4c, 0x99, 0xf9,
+	0x35, 0x8e, 0x73, 0x82, 0x60, 0x42, 0x28, 0x19, 0x51, 0xee, 0x29, 0x63, 0xff, 0xed, 0x13, 0x27,
+	0xfb, 0x28, 0x1e, 0x5c, 0x43, 0x64, 0xd6, 0x3c, 0xc1, 0xec, 0x80, 0x7d, 0x07, 0xbf, 0xb5, 0xaf,
+	0x04, 0x66, 0x38, 0x71, 0x4f, 0x72, 0x83, 0x6f, 0xce, 0x1f, 0x56, 0x92, 0x70, 0x47, 0x46, 0x48,
+	0x46, 0xe9, 0x54, 0x08, 0x46, 0xf8, 0x07, 0x6f, 0x07, 0x85, 0x31, 0xec, 0x94, 0xaa, 0xdf, 0xc7,
+	0x29, 0x95, 0x3a, 0x33, 0xc6, 0x1e, 0xd2, 0x99, 0xc1, 0xa2, 0x57, 0x93, 0x5d, 0x26, 0x84, 0x8b,
+	0x78, 0xb5, 0x71, 0x36, 0x08, 0x46, 0xf4, 0x6a, 0x0a, 0x0c, 0x59, 0x7c, 0xfb, 0xe3, 0xea, 0xd4,
+	0x99, 0x28, 0xc3, 0xf6, 0x61, 0xce, 0xe8, 0xd3, 0x3e, 0x70, 0xbe, 0xa7, 0x42, 0x66, 0xae, 0x06,
+	0x83, 0xcd, 0xab, 0x9b, 0x83, 0x6d, 0xdf, 0x6b, 0x5f, 0xa7, 0x07, 0xb8, 0xe7, 0xec, 0xd1, 0x83,
+	0xd5, 0xe5, 0xec, 0x9e, 0x73, 0x1d, 0x1b, 0x81, 0xc3, 0x70, 0x7f, 0xdc, 0xf1, 0x82, 0x2e, 0x8d,
+	0xfa, 0x91, 0x27, 0xcc, 0x12, 0xc6, 0xfe, 0x78, 0x45, 0x83, 0xc0, 0xc4, 0x43, 0xda, 0xe1, 0x9d,
+	0x40, 0x25, 0x3b, 0x53, 0xb4, 0x37, 0xb0, 0x11, 0x38, 0x0c, 0x91, 0x92, 0x68, 0x20, 0x54, 0x76,
+	0x06, 0xd2, 0x16, 0x36, 0x02, 0x87, 0x09, 0x6d, 0x00, 0xf3, 0x82, 0xab, 0xe7, 0xb4, 0x01, 0xd8,
+	0x0c, 0x12, 0x8e, 0xa8, 0x7b, 0xf4, 0x60, 0x19, 0xd5, 0x35, 0x99, 0xcb, 0xfc, 0x75, 0xde, 0x0c,
+	0x12, 0xce, 0xb2, 0x9f, 0xa7, 0x87, 0xe3, 0x4b, 0x2e, 0xfb, 0x79, 0xba, 0xfb, 0x43, 0x14, 0x3f,
+	0x7f, 0xbd, 0x42, 0xa6, 0xde, 0xa8, 0xaa, 0x9c, 0xa7, 0xee, 0xdc, 0x26, 0x67, 0x73, 0x31, 0xf3,
+	0x23, 0x48, 0x62, 0x47, 0x66, 0x9d, 0x71, 0x80, 0x4c, 0x22, 0x61, 0x99, 0xf5, 0x73, 0x89, 0x9c,
+	0x15, 0x91, 0xc6, 0x9e, 0x4f, 0x59, 0x08, 0xb4, 0xca, 0x83, 0xc0, 0xec, 0x6e, 0xb7, 0xb2, 0x40,
+	0xc8, 0xe3, 0x63, 0x69, 0xa7, 0xe9, 0x54, 0x1a, 0x83, 0x92, 0x64, 0x46, 0xb6, 0xba, 0x43, 0xe6,
+	0xc1, 0xcd, 0xc2, 0x79, 0xaa, 0xec, 0x18, 0xd6, 0xab, 0x5b, 0x83, 0xc0, 0xc4, 0x73, 0x7e, 0xc6,
+	0x22, 0xe7, 0x8a, 0x22, 0xad, 0xb1, 0x5b, 0x7b, 0x5e, 0xd0, 0xc9, 0x76, 0x0b, 0x6b, 0x6a, 0x02,
+	0x83, 0x8c, 0x70, 0x3b, 0x12, 0xd7, 0xe1, 0xea, 0x61, 0x85, 0xaa, 0x78, 0x2c, 0x4e, 0xf6, 0xae,
+	0x2e, 0x63, 0x74, 0x40, 0x61, 0x38, 0xbf, 0x5e, 0x25, 0x13, 0xd2, 0x2f, 0x6e, 0x84, 0x41, 0xfb,
+	0x8c, 0x45, 0xa6, 0x95, 0x55, 0x16, 0x9f, 0x11, 0x4b, 0xf5, 0xc6, 0xc9, 0x3d, 0xf3, 0x94, 0x46,
+	0x09, 0x75, 0xe0, 0xea, 0xaa, 0x05, 0x26, 0x33, 0x48, 0xf3, 0xb6, 0x6f, 0x61, 0x70, 0x4c, 0x9c,
+	0xd0, 0x9e, 0xa1, 0x8d, 0x77, 0x8c, 0xf5, 0x30, 0xdf, 0x0e, 0x23, 0x8a, 0xb3, 0x1f, 0xbd, 0x09,
+	0x5b, 0x0a, 0x53, 0xcb, 0xbc, 0xba, 0x0d, 0x0c, 0x4a, 0x58, 0x3b, 0xca, 0x37, 0xe3, 0xa1, 0xa1,
+	0x1c, 0xbf, 0xc3, 0x51, 0x9c, 0x08, 0x4e, 0x60, 0xb4, 0x77, 0x7e, 0xae, 0x42, 0xce, 0x64, 0x47,
+	0xd2, 0xfe, 0x00, 0x3a, 0x9c, 0xeb, 0x0a, 0xaa, 0x19, 0x67, 0xc4, 0x29, 0x30, 0x60, 0xaf, 0xdf,
+	0x9b, 0x9b, 0xd3, 0x4e, 0x89, 0x97, 0x71, 0xf0, 0x2e, 0xef, 0x1b, 0x7e, 0x9b, 0x38, 0x0d, 0x52,
+	0xc4, 0xb8, 0x45, 0x5f, 0xb8, 0x9e, 0x2c, 0x1e, 0x2c, 0xf4, 0xfb, 0xc2, 0x2c, 0x6f, 0x58, 0xf4,
+	0x4d, 0x28, 0x64, 0xb0, 0x31, 0x7a, 0xd4, 0x68, 0xb9, 0x41, 0xbd, 0xee, 0xee, 0x76, 0x18, 0xc9,
+	0x9b, 0xfe, 0x53, 0xda, 0xf5, 0x39, 0x8f, 0x03, 0x85, 0x4f, 0xe2, 0xec, 0x6f, 0xbb, 0x7d, 0xb7,
+	0xed, 0x25, 0x07, 0xc2, 0x2a, 0xa2, 0x66, 0xff, 0x92, 0x68, 0x07, 0x85, 0xe1, 0xfc, 0xdd, 0x1a,
+	0x39, 0xc3, 0x7d, 0x7d, 0xa9, 0x72, 0x65, 0xb7, 0x3f, 0x40, 0x1a, 0x71, 0xe2, 0x46, 0x5c, 0xcd,
+	0x63, 0x1d, 0x7b, 0x93, 0xd5, 0x59, 0x22, 0x24, 0x11, 0xd0, 0xf4, 0xd0, 0x25, 0x7e, 0xc7, 0x0b,
+	0xbc, 0x78, 0x97, 0x51, 0xaf, 0xdc, 0x9f, 0x12, 0xe9, 0x8a, 0xa2, 0x00, 0x06, 0x35, 0xfb, 0xeb,
+	0x48, 0xbd, 0xbf, 0xeb, 0xc6, 0x52, 0xc3, 0xf9, 0x9c, 0xdc, 0xd1, 0x36, 0xb1, 0x11, 0x9d, 0xba,
+	0xb3, 0xaf, 0xca, 0x00, 0xc0, 0x1f, 0x32, 0xcf, 0xa3, 0xda, 0xd1, 0x55, 0xbe, 0x3a, 0xd1, 0x41,
+	0xeb, 0xda, 0x42, 0xb6, 0x2e, 0xd4, 0x32, 0x6b, 0x05, 0x01, 0xc5, 0xdd, 0x73, 0x97, 0xb3, 0xec,
+	0x20, 0xf2, 0x58, 0x5a, 0x36, 0xba, 0xa6, 0x41, 0x60, 0xe2, 0x61, 0x6a, 0xcd, 0xac, 0x27, 0xf8,
+	0xf8, 0x29, 0x84, 0x29, 0x8d, 0xea, 0x03, 0xbe, 0x42, 0x1a, 0xfc, 0x7f, 0xba, 0x15, 0xa2, 0xda,
+	0x8b, 0x2b, 0xd0, 0x16, 0x23, 0x37, 0x68, 0xef, 0x66, 0xd5, 0x5e, 0x5b, 0x06, 0x0c, 0x52, 0x98,
+	0xce, 0x3a, 0xa9, 0x8d, 0xb8, 0xc9, 0x8e, 0xa4, 0xcd, 0x78, 0x89, 0x4c, 0x20, 0x39, 0x79, 0x95,
+	0x2c, 0x83, 0x64, 0x48, 0x26, 0x64, 0xcd, 0x58, 0xdb, 0x21, 0x55, 0xcf, 0x95, 0x0e, 0x3a, 0x6a,
+	0x09, 0xad, 0xc6, 0xf1, 0x80, 0x4d, 0x3b, 0x04, 0xda, 0xcf, 0x92, 0x2a, 0xbd, 0xdb, 0xcf, 0x7a,
+	0xe2, 0xac, 0xdc, 0xed, 0x7b, 0x11, 0x8d, 0x11, 0x89, 0xde, 0xed, 0xdb, 0x17, 0x49, 0xc5, 0xeb,
+	0x88, 0x19, 0x49, 0x04, 0x4e, 0x65, 0x75, 0x19, 0x2a, 0x5e, 0xc7, 0xb9, 0x4b, 0x1a, 0x92, 0x21,
+	0xf3, 0xf5, 0xe6, 0xc2, 0x9f, 0x55, 0x86, 0xaf, 0xb7, 0xa4, 0x3b, 0x44, 0xec, 0x1b, 0x10, 0xa2,
+	0xb3, 0x7e, 0x94, 0x25, 0x2c, 0x5c, 0x22, 0xb5, 0x76, 0x28, 0x12, 0x47, 0x4d, 0x68, 0x32, 0x4c,
+	0xea, 0x63, 0x10, 0xe7, 0x36, 0x99, 0xb9, 0x1e, 0x84, 0x77, 0x58, 0x2d, 0x39, 0xa6, 0xb9, 0x18,
+	0x4d, 0xaf, 0x21, 0x93, 0x3a, 0x57, 0x86, 0x25, 0x75, 0x76, 0x3e, 0x61, 0x91, 0x29, 0x95, 0x3e,
+	0xe0, 0xea, 0xfe, 0x1e, 0xd2, 0xed, 0x46, 0xe1, 0xa0, 0x9f, 0xa5, 0xcb, 0x4a, 0x78, 0x03, 0x87,
+	0x99, 0x79, 0x35, 0x2a, 0x47, 0xe4, 0xd5, 0x90, 0x82, 0x4b, 0x75, 0x98, 0xe0, 0xe2, 0xfc, 0xb9,
+	0x45, 0xce, 0xa8, 0x2e, 0x48, 0xe9, 0xee, 0x05, 0x32, 0xb5, 0x3d, 0xf0, 0xfc, 0x8e, 0xf8, 0x9d,
+	0x5d, 0x2e, 0x8b, 0x06, 0x0c, 0x52, 0x98, 0xa8, 0xab, 0xda, 0xf6, 0x02, 0x37, 0x3a, 0xd8, 0xd4,
+	0xe2, 0xa4, 0x3a, 0xb7, 0x17, 0x15, 0x04, 0x0c, 0x2c, 0x4c, 0x07, 0xb1, 0x2f, 0xed, 0xd9, 0xd5,
+	0x52, 0xd3, 0x41, 0x88, 0xf1, 0xd0, 0x2b, 0x41, 0x19, 0xc8, 0x15, 0x47, 0xe7, 0xfb, 0xab, 0x64,
+	0x26, 0x9d, 0xc2, 0x61, 0x04, 0x1d, 0xcf, 0xb3, 0xa4, 0xce, 0xb2, 0x3a, 0x64, 0x27, 0x16, 0x7b,
+	0x1e, 0x38, 0x0c, 0x7d, 0x77, 0xf9, 0x56, 0x52, 0x4e, 0x45, 0x63, 0xd5, 0x49, 0xa5, 0xd9, 0x66,
+	0xfe, 0xf8, 0xc2, 0x50, 0x20, 0x58, 0xa1, 0x43, 0xd5, 0x78, 0xd8, 0x37, 0xb3, 0x09, 0xbf, 0xaf,
+	0xcc, 0xf4, 0x16, 0x22, 0x86, 0x5c, 0x48, 0x43, 0x6a, 0xe2, 0xc9, 0xc9, 0x20, 0x59, 0x5f, 0xfc,
+	0x1a, 0x32, 0x65, 0x62, 0x1e, 0x25, 0x10, 0x4d, 0x98, 0x02, 0xd1, 0x67, 0xcc, 0x29, 0x29, 0x12,
+	0x78, 0x8c, 0xb0, 0xd8, 0x6f, 0x92, 0x7a, 0x5b, 0xf9, 0x18, 0xde, 0x57, 0x1d, 0x13, 0x95, 0x0b,
+	0x11, 0xc9, 0x00, 0xa7, 0x86, 0xde, 0x13, 0x33, 0x46, 0x6f, 0xe2, 0xd5, 0x8e, 0x1d, 0x91, 0x6a,
+	0x77, 0x7f, 0x4f, 0x08, 0x19, 0x2f, 0x96, 0x34, 0xbc, 0x57, 0xf7, 0xf7, 0xf4, 0x0a, 0x33, 0x5b,
+	0x01, 0x99, 0x8d, 0x70, 0xc1, 0x48, 0xe5, 0x79, 0xa9, 0x1e, 0x9d, 0xe7, 0xc5, 0xf9, 0x5c, 0x85,
+	0x9c, 0xcd, 0x4d, 0x2a, 0xfb, 0x55, 0x52, 0x8f, 0xf0, 0x2d, 0x9b, 0x56, 0x19, 0x87, 0x77, 0x7a,
+	0xe4, 0xf4, 0xe1, 0x9d, 0x6e, 0x07, 0xce, 0x12, 0x7d, 0xdd, 0xb4, 0x27, 0xac, 0xb2, 0xfd, 0xf0,
+	0x57, 0x56, 0xbe, 0x6e, 0x0b, 0x39, 0x0c, 0x28, 0x78, 0x0a, 0x6d, 0x97, 0x69, 0x13, 0x52, 0x26,
+	0x3f, 0xfd, 0x61, 0xd6, 0x20, 0xe7, 0xb3, 0xe6, 0x14, 0xbc, 0xa5, 0x37, 0xd3, 0x93, 0x5e, 0xa3,
+	0x73, 0x3b, 0x6b, 0x75, 0xd4, 0x9d, 0xd5, 0xf9, 0xc5, 0x0a, 0x99, 0x4e, 0xe5, 0x9b, 0xb6, 0x7d,
+	0x32, 0x41, 0x7d, 0x66, 0xeb, 0x96, 0xa7, 0xef, 0x49, 0x4b, 0x4f, 0xa9, 0x7d, 0x72, 0x45, 0xd0,
+	0x05, 0xc5, 0xe1, 0xd1, 0xf0, 0xca, 0x7b, 0x81, 0x4c, 0xc9, 0x0e, 0xbd, 0xcf, 0xed, 0xf9, 0xd9,
+	0xe1, 0x5b, 0x31, 0x60, 0x90, 0xc2, 0x74, 0x7e, 0xb5, 0x4a, 0x9a, 0xdc, 0x9c, 0xd0, 0x51, 0x8b,
+	0x41, 0x39, 0xf9, 0x7c, 0xb7, 0xce, 0x0a, 0xcf, 0x07, 0x72, 0xfb, 0xa4, 0x95, 0x1e, 0x8b, 0x19,
+	0x8d, 0xe4, 0x8f, 0xfe, 0x63, 0x19, 0x7f, 0x74, 0x7e, 0x55, 0xef, 0x9e, 0x52, 0x8f, 0xbe, 0xb4,
+	0x1c, 0xd4, 0xff, 0x7e, 0x85, 0xcc, 0x66, 0xca, 0x68, 0x62, 0xee, 0x49, 0xb3, 0xf2, 0x92, 0x55,
+	0x86, 0xe1, 0xf4, 0xd0, 0xca, 0x8a, 0xc7, 0xab, 0xbf, 0xf4, 0x90, 0x96, 0x8a, 0xf3, 0xdb, 0x15,
+	0x32, 0x93, 0xae, 0xff, 0xf9, 0x08, 0x8e, 0xd4, 0x57, 0x92, 0x06, 0x2b, 0x71, 0x77, 0x9d, 0x1e,
+	0x48, 0xbb, 0x2b, 0xaf, 0x26, 0x26, 0x1b, 0x41, 0xc3, 0x1f, 0x89, 0xb2, 0x56, 0xce, 0x3f, 0xb4,
+	0xc8, 0x79, 0xfe, 0x96, 0xd9, 0x79, 0xf8, 0x03, 0x45, 0xa3, 0xfb, 0xc1, 0x72, 0x3b, 0x98, 0xa9,
+	0x66, 0x70, 0xd4, 0xf8, 0xa2, 0xf0, 0x72, 0x4e, 0xf4, 0x36, 0x3d, 0x15, 0x1e, 0xc1, 0xce, 0x1e,
+	0x6b, 0x32, 0x38, 0xff, 0xb1, 0x42, 0x26, 0x37, 0x96, 0x56, 0xd5, 0x16, 0x8e, 0xae, 0x67, 0x11,
+	0x75, 0xb5, 0xfa, 0xc7, 0x74, 0x3d, 0x93, 0x00, 0xd0, 0x38, 0x78, 0x8b, 0xe2, 0xae, 0x9b, 0x71,
+	0xf6, 0x16, 0xc5, 0x3d, 0x3b, 0x63, 0x90, 0x70, 0xd4, 0x4e, 0xb1, 0x40, 0x6f, 0x74, 0xa7, 0xac,
+	0xa6, 0x0d, 0x8c, 0x2c, 0x10, 0x1c, 0xed, 0xb2, 0x0a, 0x03, 0x09, 0x77, 0xc2, 0x76, 0x8c, 0xc8,
+	0x19, 0x8d, 0xcc, 0x32, 0x36, 0xa3, 0x0d, 0x57, 0xc0, 0xb1, 0xd3, 0x5c, 0x6b, 0x81, 0xc8, 0xf5,
+	0x74, 0xa7, 0xb9, 0x7a, 0x03, 0xd1, 0x35, 0xce, 0x71, 0xb2, 0xda, 0x66, 0x62, 0x23, 0xc7, 0x47,
+	0x8b, 0x8d, 0x74, 0xbe, 0xb3, 0x42, 0x9a, 0x1b, 0x4b, 0xab, 0x2d, 0xaf, 0x1b, 0xb8, 0xc9, 0x20,
+	0x42, 0xe1, 0xc7, 0xdb, 0x11, 0x5f, 0x15, 0x03, 0xe9, 0xfa, 0xd2, 0x32, 0x93, 0x4a, 0x7b, 0xac,
+	0xec, 0x35, 0x98, 0x98, 0x4b, 0xfd, 0x8f, 0x97, 0x11, 0xe2, 0xb1, 0x24, 0xb9, 0x89, 0x36, 0x51,
+	0x9f, 0x30, 0x9c, 0x42, 0x74, 0xce, 0x0b, 0xba, 0xab, 0x9c, 0xf2, 0x81, 0x9e, 0x58, 0xab, 0x8a,
+	0x17, 0x18, 0x7c, 0x31, 0xf0, 0xdf, 0x4d, 0x12, 0x1a, 0xcb, 0x13, 0xb5, 0xaa, 0x03, 0xff, 0x17,
+	0x8c, 0x76, 0x48, 0x61, 0x39, 0x5d, 0x62, 0xe7, 0x79, 0xa1, 0x12, 0xcd, 0x43, 0x75, 0x4a, 0xce,
+	0x1f, 0x98, 0x29, 0x59, 0x22, 0x10, 0x50, 0xd3, 0xdb, 0xb7, 0x72, 0xb8, 0xb7, 0xaf, 0xf3, 0xdb,
+	0x55, 0xd2, 0xd0, 0x7a, 0x4c, 0x4f, 0x24, 0x94, 0x29, 0xa5, 0x40, 0x09, 0x86, 0x38, 0x29, 0xd2,
+	0xdc, 0xa5, 0xc5, 0xc8, 0x27, 0xf3, 0x9d, 0x16, 0x7a, 0x89, 0x78, 0x89, 0xe7, 0x32, 0x75, 0xac,
+	0x38, 0xaa, 0x36, 0x4b, 0x4a, 0x38, 0xb2, 0xca, 0x29, 0x87, 0x91, 0xe9, 0x77, 0xa2, 0x98, 0x81,
+	0xc9, 0xd9, 0xfe, 0x88, 0x88, 0x7e, 0xac, 0x96, 0x96, 0x12, 0x6a, 0x22, 0x13, 0xf2, 0xd8, 0xc7,
+	0x6b, 0x4d, 0x12, 0x95, 0x94, 0x49, 0x0d, 0x90, 0x94, 0x2a, 0x94, 0xa5, 0x2e, 0x8e, 0xac, 0x19,
+	0x38, 0x23, 0x27, 0x26, 0x76, 0x7e, 0x2c, 0x8e, 0x19, 0x16, 0x86, 0xb1, 0x73, 0x83, 0x24, 0xec,
+	0xe1, 0x30, 0x09, 0x6f, 0x12, 0x1d, 0x3b, 0x27, 0x01, 0xa0, 0x71, 0x9c, 0xef, 0xaf, 0x93, 0x4c,
+	0x7a, 0x17, 0xfb, 0x2e, 0x69, 0xa8, 0x04, 0x2f, 0xe5, 0x84, 0x7e, 0xeb, 0x19, 0xa5, 0x3a, 0xa3,
+	0x9a, 0x40, 0x33, 0xb3, 0xbb, 0x52, 0xb3, 0xcd, 0x57, 0xc0, 0x4b, 0x59, 0xcd, 0xf6, 0x37, 0x8e,
+	0x66, 0x92, 0xc5, 0xb9, 0x7a, 0x99, 0x67, 0x13, 0x9d, 0x3f, 0x52, 0x09, 0x5e, 0x3d, 0x42, 0x09,
+	0xfe, 0x49, 0x51, 0x96, 0x12, 0x68, 0x3c, 0xf0, 0x13, 0x31, 0x1b, 0x5e, 0x2a, 0x71, 0x95, 0x71,
+	0xc2, 0x3a, 0x47, 0x1b, 0xff, 0x0d, 0x06, 0xd3, 0xb4, 0xa9, 0x62, 0xec, 0x54, 0x4d, 0x15, 0xe3,
+	0xa5, 0x9a, 0x2a, 0x9e, 0x27, 0x84, 0xcd, 0x6d, 0x1e, 0xbe, 0x32, 0xc1, 0x34, 0xc8, 0x6a, 0xf3,
+	0x05, 0x05, 0x01, 0x03, 0xcb, 0xf9, 0x2a, 0x92, 0x4e, 0x32, 0x88, 0xc1, 0xc7, 0x3c, 0xa7, 0x21,
+	0x3f, 0x3f, 0x58, 0xf0, 0x71, 0x2a, 0xfd, 0xe0, 0x2f, 0x58, 0xc4, 0xcc, 0x84, 0x68, 0xbf, 0xc2,
+	0x53, 0x2e, 0x5a, 0x65, 0x18, 0xf5, 0x0c, 0xba, 0xf3, 0xeb, 0x6e, 0x3f, 0xe3, 0x72, 0x27, 0xf3,
+	0x2e, 0xa2, 0x1f, 0x9c, 0x84, 0x1e, 0xeb, 0x7e, 0xf2, 0x71, 0xf2, 0x98, 0xcc, 0x8c, 0x22, 0xed,
+	0x6f, 0xc2, 0x25, 0xe5, 0x68, 0xb5, 0xae, 0xd4, 0xd5, 0x56, 0x8e, 0x34, 0x32, 0x57, 0x87, 0x29,
+	0x20, 0x9c, 0x7f, 0x86, 0xa7, 0x77, 0xa6, 0x03, 0xf1, 0x92, 0x4f, 0xdd, 0x60, 0xd0, 0x4f, 0x95,
+	0xe0, 0xb1, 0xca, 0x2f, 0x23, 0xfd, 0x6d, 0x96, 0x99, 0x89, 0xad, 0x52, 0x46, 0x18, 0x77, 0xc1,
+	0x58, 0x1e, 0x91, 0x8e, 0xed, 0xab, 0xc9, 0x64, 0x37, 0x72, 0xdb, 0x74, 0x93, 0x46, 0x5e, 0xd8,
+	0xc9, 0x46, 0xc6, 0x5e, 0xd5, 0x20, 0x30, 0xf1, 0x9c, 0xdf, 0xaf, 0x90, 0x4b, 0xb9, 0x71, 0x5b,
+	0x0f, 0x03, 0x2f, 0x09, 0xa3, 0x16, 0x4d, 0x12, 0x2f, 0xe8, 0xb2, 0x8c, 0xe2, 0x77, 0xdc, 0x48,
+	0x16, 0x20, 0x64, 0x07, 0xcc, 0x6d, 0x37, 0x0a, 0x80, 0xb5, 0x62, 0x04, 0x3b, 0x8f, 0x93, 0x38,
+	0xbd, 0x57, 0xd7, 0xb2, 0x06, 0x63, 0x04, 0x82, 0x21, 0x5b, 0x51, 0x9d, 0xb0, 0x9f, 0x08, 0x13,
+	0x06, 0x5f, 0x51, 0xd8, 0x00, 0xbc, 0xdd, 0xfe, 0x18, 0x19, 0x6f, 0xf3, 0x49, 0x50, 0x4e, 0x1d,
+	0xde, 0x61, 0x53, 0x4c, 0x04, 0x5d, 0xf1, 0x1f, 0x20, 0x79, 0x3a, 0x7f, 0x68, 0x11, 0x7b, 0x63,
+	0x9f, 0x46, 0x91, 0xd7, 0x31, 0x22, 0x4f, 0x58, 0xd9, 0x6e, 0xa3, 0x3c, 0xb7, 0x99, 0x8f, 0x29,
+	0x53, 0xb6, 0xdb, 0xf8, 0x55, 0x5c, 0xb6, 0xbb, 0x72, 0xbc, 0xb2, 0xdd, 0xf6, 0x06, 0x39, 0xcf,
+	0x5d, 0x4a, 0x45, 0x29, 0x5c, 0xe1, 0x68, 0x2a, 0x45, 0xc3, 0x0b, 0x98, 0x3f, 0x78, 0xbd, 0x08,
+	0x01, 0x8a, 0x9f, 0x73, 0xde, 0x4d, 0x6c, 0x1e, 0x70, 0xb2, 0x54, 0xe4, 0x33, 0x3f, 0x54, 0x63,
+	0xe8, 0xfc, 0x68, 0x9d, 0xcc, 0x66, 0xca, 0x67, 0xa1, 0x36, 0x2a, 0xef, 0xa4, 0x7f, 0x62, 0xb9,
+	0x2c, 0xdf, 0xbd, 0x91, 0xdc, 0xfe, 0x03, 0x52, 0xf7, 0x82, 0xfe, 0x20, 0x29, 0x27, 0x73, 0x11,
+	0xef, 0xc4, 0x2a, 0x12, 0x34, 0x4c, 0x7c, 0xf8, 0x13, 0x38, 0x9b, 0x32, 0x83, 0x08, 0x52, 0xfa,
+	0x82, 0xda, 0x43, 0xd2, 0x58, 0x7e, 0x52, 0xbb, 0xf4, 0xd7, 0xcb, 0x30, 0xc7, 0x64, 0x26, 0xcb,
+	0x69, 0xfb, 0x57, 0x7e, 0xbe, 0x42, 0x26, 0x8d, 0x8f, 0x66, 0xff, 0x44, 0x3a, 0xff, 0xb3, 0x55,
+	0xde, 0x2b, 0x31, 0xfa, 0xf3, 0x3a, 0xc3, 0x33, 0x7f, 0xa5, 0xe7, 0xf2, 0xa9, 0x9f, 0x5f, 0xbf,
+	0x37, 0x77, 0x26, 0x93, 0xdc, 0x39, 0x95, 0x0e, 0xfa, 0xe2, 0xc7, 0xc8, 0x6c, 0x86, 0x4c, 0xc1,
+	0x2b, 0x6f, 0x99, 0xaf, 0x7c, 0x62, 0xcd, 0xb9, 0x39, 0x64, 0x3f, 0x8b, 0x43, 0x26, 0xf2, 0x9b,
+	0x84, 0x3e, 0x1d, 0xc1, 0x6c, 0x90, 0xb9, 0xaa, 0x57, 0x46, 0x4c, 0x63, 0xf4, 0x56, 0x32, 0xd1,
+	0x0f, 0x7d, 0xaf, 0xed, 0xa9, 0xf2, 0x11, 0xec, 0x68, 0xde, 0x14, 0x6d, 0xa0, 0xa0, 0xf6, 0x1d,
+	0xd2, 0x78, 0xf9, 0x4e, 0xc2, 0x2d, 0xf6, 0xcd, 0x5a, 0xa9, 0x86, 0x7a, 0x75, 0x1c, 0xcb, 0x96,
+	0x18, 0x34, 0x2f, 0xcc, 0x20, 0xc6, 0x84, 0x1b, 0x19, 0xa8, 0xcc, 0x2c, 0x96, 0x4c, 0xea, 0x89,
+	0x41, 0x40, 0x9c, 0x7f, 0x3f, 0x49, 0xce, 0x15, 0xd5, 0x30, 0xb4, 0x3f, 0x4a, 0xc6, 0x78, 0x1f,
+	0xcb, 0x29, 0x93, 0x5b, 0xc4, 0xe3, 0x2a, 0x23, 0x28, 0xba, 0xc5, 0xfe, 0x07, 0xc1, 0x53, 0x70,
+	0xf7, 0xdd, 0xed, 0x66, 0xe5, 0x14, 0xb9, 0xaf, 0xb9, 0x9a, 0xfb, 0x9a, 0xcb, 0xb9, 0xfb, 0xee,
+	0xb6, 0x7d, 0x97, 0xd4, 0xbb, 0x5e, 0x42, 0x5d, 0xa1, 0xe7, 0xbc, 0x7d, 0x2a, 0xcc, 0xa9, 0xcb,
+	0x65, 0x05, 0xf6, 0x2f, 0x70, 0x86, 0x18, 0x7d, 0x3a, 0xbb, 0x9d, 0x4e, 0xc8, 0x26, 0x36, 0x4f,
+	0xb7, 0xfc, 0x4e, 0x64, 0x32, 0xbf, 0xf1, 0xba, 0xf5, 0x99, 0x46, 0xc8, 0x76, 0x07, 0xc3, 0xa4,
+	0xc6, 0x77, 0x3c, 0xdf, 0x28, 0x33, 0x75, 0x0a, 0x1f, 0xe7, 0x0a, 0x63, 0xa0, 0x6f, 0x92, 0xfc,
+	0x77, 0x0c, 0x92, 0xf3, 0xb0, 0x93, 0x6a, 0xec, 0xa4, 0x27, 0xd5, 0xf8, 0x43, 0x3a, 0xa9, 0x3e,
+	0x6d, 0x91, 0x86, 0x1a, 0x69, 0x91, 0x87, 0xea, 0x03, 0xa7, 0xf8, 0xc9, 0xb9, 0x72, 0x57, 0xfd,
+	0x04, 0xcd, 0x1c, 0xd3, 0x4f, 0x4c, 0xba, 0xaf, 0x0e, 0x22, 0xda, 0xa1, 0xfb, 0x61, 0x3f, 0x16,
+	0xe9, 0xae, 0x3f, 0x58, 0x7e, 0x67, 0x16, 0x90, 0xc9, 0x32, 0xdd, 0xdf, 0xe8, 0xc7, 0x22, 0x89,
+	0x82, 0x6e, 0x00, 0xb3, 0x0b, 0x98, 0x8a, 0x58, 0x9e, 0xe3, 0xa4, 0x8c, 0xa2, 0x07, 0x45, 0xbd,
+	0x19, 0x29, 0x27, 0x08, 0x25, 0x4f, 0xb6, 0xc3, 0x20, 0xf1, 0x82, 0x01, 0xdd, 0x08, 0x80, 0xf6,
+	0xc3, 0x1b, 0x61, 0x72, 0x25, 0x1c, 0x04, 0x9d, 0x95, 0x28, 0x0a, 0xa3, 0xe6, 0x64, 0xba, 0x3a,
+	0xfa, 0xd2, 0x70, 0x54, 0x38, 0x8c, 0xce, 0x49, 0x64, 0x86, 0x7b, 0x15, 0x32, 0x77, 0xc4, 0x60,
+	0xa3, 0x21, 0x37, 0x8c, 0xba, 0x6e, 0xe0, 0xbd, 0x6a, 0x26, 0xa3, 0x54, 0x02, 0xe9, 0x86, 0x01,
+	0x83, 0x14, 0xa6, 0x99, 0x54, 0xac, 0x72, 0x44, 0x52, 0xb1, 0x4b, 0xa4, 0x16, 0x61, 0xec, 0x73,
+	0xe6, 0xbe, 0x8c, 0x2f, 0x0b, 0x0c, 0x82, 0x4e, 0xd9, 0x6e, 0xdf, 0x13, 0x7a, 0x7a, 0xa5, 0x06,
+	0x58, 0xd8, 0x5c, 0x05, 0x6c, 0x4f, 0x25, 0x4d, 0xac, 0x3f, 0x90, 0xa4, 0x89, 0x78, 0x62, 0x0a,
+	0x4b, 0xf4, 0x98, 0x3e, 0x31, 0xd3, 0x16, 0x62, 0xe7, 0x73, 0x55, 0xf2, 0xf4, 0xa1, 0x4b, 0x4b,
+	0xc7, 0xa9, 0x58, 0x87, 0xc4, 0xa9, 0xc8, 0xe1, 0xa9, 0x1c, 0x35, 0x3c, 0xd5, 0x21, 0xc3, 0xf3,
+	0xed, 0xb8, 0x63, 0xc8, 0x24, 0x9e, 0xe5, 0xdc, 0x2c, 0x87, 0xe5, 0x04, 0x15, 0x9b, 0x85, 0x84,
+	0x82, 0xe6, 0x8b, 0xd7, 0xa5, 0x54, 0x36, 0xac, 0x7a, 0x19, 0x27, 0xe6, 0xd0, 0x44, 0x9a, 0x7c,
+	0x9b, 0x18, 0x96, 0x62, 0xcb, 0xf9, 0x97, 0x35, 0xf2, 0xec, 0x08, 0x07, 0x9d, 0x39, 0x8b, 0xad,
+	0x11, 0x67, 0xf1, 0x97, 0xf8, 0x67, 0xfa, 0x54, 0xe1, 0x67, 0x82, 0xf2, 0x3f, 0xd3, 0xe1, 0x5f,
+	0x88, 0x19, 0xf3, 0x82, 0x98, 0xb6, 0x07, 0x11, 0x8f, 0xd9, 0x33, 0x02, 0x2d, 0x56, 0x45, 0x3b,
+	0x28, 0x0c, 0xbc, 0xfe, 0xb6, 0x5d, 0x5c, 0xfe, 0xe3, 0x25, 0x65, 0x3f, 0x32, 0xf3, 0x2b, 0x70,
+	0xe9, 0x6b, 0x69, 0x01, 0x77, 0x00, 0xce, 0x06, 0xf3, 0xe2, 0x5e, 0x1c, 0x2e, 0x8d, 0x60, 0xf6,
+	0x9f, 0x6d, 0xe6, 0x97, 0xbc, 0xce, 0xbc, 0x0f, 0xc5, 0xd4, 0x61, 0xef, 0xab, 0x9b, 0xc1, 0xc4,
+	0x41, 0x7d, 0x89, 0xe9, 0xd0, 0xbc, 0x6e, 0xb8, 0x2d, 0x32, 0x7d, 0xc9, 0x56, 0x16, 0x08, 0x79,
+	0x7c, 0x34, 0xfc, 0x25, 0x5e, 0xe2, 0x53, 0xfe, 0x34, 0x9f, 0x68, 0x4c, 0x51, 0xbc, 0xa5, 0x5a,
+	0xc1, 0xc0, 0x70, 0xbe, 0x58, 0x2d, 0x7e, 0x0d, 0x2e, 0xe5, 0x1e, 0x67, 0xf6, 0x8b, 0xb9, 0x5d,
+	0x19, 0x61, 0x87, 0xae, 0x3e, 0xe8, 0x1d, 0xba, 0x36, 0x6c, 0x87, 0xc6, 0xfc, 0x99, 0x46, 0xbd,
+	0x75, 0x9e, 0x3f, 0x8b, 0xdb, 0x77, 0x55, 0xfe, 0xcc, 0xcd, 0x0c, 0x1c, 0x72, 0x4f, 0x3c, 0xe2,
+	0x53, 0xf5, 0x0b, 0x15, 0x72, 0x61, 0xe8, 0xc5, 0xe2, 0x01, 0x9d, 0x40, 0xe6, 0xe7, 0xaf, 0x3d,
+	0x98, 0xcf, 0x6f, 0x7e, 0x94, 0xfa, 0x91, 0x1f, 0x65, 0x94, 0xe3, 0xfc, 0x77, 0x2a, 0x43, 0x17,
+	0x0b, 0x5e, 0x44, 0xff, 0xc2, 0x8e, 0xe4, 0xd7, 0x92, 0x69, 0xb7, 0xdf, 0xe7, 0x78, 0x2c, 0xc8,
+	0x29, 0x93, 0xd3, 0x77, 0xc1, 0x04, 0x42, 0x1a, 0x77, 0xa4, 0x81, 0xfd, 0x03, 0x8b, 0x34, 0x80,
+	0xee, 0xf0, 0x1d, 0x0e, 0x2b, 0xb5, 0xb0, 0x21, 0xb2, 0xca, 0xa8, 0xd4, 0x82, 0x03, 0x1b, 0x7b,
+	0xac, 0x7c, 0x49, 0xd1, 0x60, 0x9f, 0x34, 0xbd, 0x8b, 0xaa, 0xd2, 0x5e, 0x1d, 0x5e, 0xa5, 0xdd,
+	0xf9, 0xa5, 0x06, 0xbe, 0x5e, 0x3f, 0xc4, 0x42, 0xc4, 0x31, 0x7e, 0xdf, 0x41, 0xe4, 0x37, 0xad,
+	0xf4, 0xf7, 0x45, 0xff, 0x11, 0x6c, 0x4f, 0xd9, 0x9d, 0x2b, 0xc7, 0x4a, 0x47, 0x5a, 0x3d, 0x32,
+	0x1d, 0x29, 0xa6, 0xe6, 0x8b, 0x77, 0x37, 0x23, 0x6f, 0xdf, 0x4d, 0xd0, 0x50, 0xd1, 0xac, 0xa5,
+	0x3f, 0x64, 0xab, 0x75, 0x4d, 0x03, 0x21, 0x8d, 0x8b, 0x99, 0xf1, 0x74, 0x52, 0x50, 0x1a, 0x25,
+	0x2c, 0xce, 0x99, 0xcf, 0x04, 0x95, 0x93, 0x4a, 0xa7, 0x11, 0x15, 0x08, 0x90, 0x7f, 0x06, 0xf7,
+	0xdc, 0x54, 0x23, 0x76, 0x64, 0x2c, 0xbd, 0xe7, 0xa6, 0xe8, 0x60, 0x5f, 0x72, 0x4f, 0x60, 0x79,
+	0x0c, 0x3e, 0x31, 0x16, 0xfa, 0x7d, 0xe3, 0x8d, 0xc6, 0xd3, 0xe5, 0x31, 0xae, 0xe6, 0x51, 0xa0,
+	0xe8, 0x39, 0x66, 0x8f, 0x92, 0xcd, 0xab, 0xcb, 0xc2, 0x64, 0xaa, 0xed, 0x51, 0x0a, 0x84, 0xf6,
+	0x28, 0x8d, 0x87, 0xa5, 0x1f, 0xf5, 0x4f, 0x9e, 0x9f, 0x83, 0xfb, 0x11, 0x2c, 0x8b, 0x94, 0xcd,
+	0xaa, 0xf4, 0xe3, 0xd5, 0x42, 0xb4, 0x0e, 0x0c, 0x7b, 0xde, 0xde, 0x26, 0x17, 0x15, 0x68, 0x25,
+	0x48, 0x58, 0x64, 0x7b, 0x4c, 0x17, 0xdd, 0x98, 0x39, 0x21, 0x11, 0xf6, 0x9e, 0x8e, 0xa0, 0x7e,
+	0xf1, 0xaa, 0x97, 0x5c, 0x2b, 0xc2, 0x84, 0x35, 0x38, 0x84, 0x0a, 0xba, 0x2d, 0xd0, 0xc0, 0xdd,
+	0xf6, 0xe9, 0xc6, 0xd2, 0xaa, 0xb8, 0x91, 0xea, 0x40, 0x23, 0x09, 0x00, 0x8d, 0xa3, 0x42, 0x65,
+	0xa6, 0x86, 0x85, 0xca, 0x60, 0xcc, 0x61, 0xb7, 0xdd, 0x47, 0x29, 0xd3, 0x6b, 0xd3, 0x85, 0x36,
+	0xf3, 0xcd, 0xc7, 0x0f, 0xc3, 0xeb, 0x96, 0xa8, 0x98, 0xc3, 0xab, 0x4b, 0x9b, 0x39, 0x1c, 0x28,
+	0x7c, 0x92, 0xc5, 0x70, 0x60, 0xaa, 0xd3, 0xe6, 0x63, 0x99, 0x18, 0x0e, 0x6c, 0x04, 0x0e, 0x43,
+	0x8f, 0x74, 0x16, 0x21, 0x7c, 0x2d, 0x49, 0xfa, 0x4a, 0xac, 0x6d, 0x9e, 0x4b, 0x67, 0x5f, 0xbd,
+	0x92, 0xc3, 0x80, 0x82, 0xa7, 0x50, 0xea, 0x09, 0x42, 0x46, 0xbd, 0xf9, 0x44, 0x5a, 0xea, 0xb9,
+	0xc1, 0x9b, 0x41, 0xc2, 0xed, 0x6f, 0x26, 0xcd, 0x41, 0x4c, 0xd9, 0x85, 0xf9, 0x76, 0x18, 0xed,
+	0xf9, 0xa1, 0xdb, 0x91, 0x1e, 0x48, 0xcd, 0x26, 0x63, 0x7e, 0x49, 0x3c, 0xdb, 0xbc, 0x39, 0x04,
+	0x0f, 0x86, 0x52, 0xc8, 0xa6, 0x0f, 0xbe, 0x30, 0x62, 0xfa, 0xe0, 0x4d, 0x72, 0x4e, 0x9e, 0x6b,
+	0x1b, 0x4b, 0xab, 0xea, 0xa5, 0x9b, 0x17, 0xd3, 0x45, 0x43, 0x57, 0x0b, 0x70, 0xa0, 0xf0, 0x49,
+	0xe7, 0xf7, 0x2d, 0x32, 0xad, 0x76, 0xb0, 0x07, 0x90, 0xa9, 0xc0, 0x4f, 0x67, 0x2a, 0xb8, 0x7a,
+	0xf2, 0x33, 0x80, 0xf5, 0x7c, 0x48, 0xb4, 0xda, 0x1f, 0x4d, 0x13, 0xa2, 0xcf, 0x09, 0x75, 0x44,
+	0x5b, 0x43, 0x8f, 0xe8, 0x47, 0x76, 0x8f, 0x2e, 0x4a, 0x07, 0x5b, 0x7f, 0xb8, 0xe9, 0x60, 0x5b,
+	0xe4, 0xbc, 0x9c, 0x52, 0xdc, 0xa4, 0x8c, 0x21, 0xd4, 0x72, 0xcb, 0x37, 0xaa, 0xc0, 0xae, 0x16,
+	0x21, 0x41, 0xf1, 0xb3, 0x29, 0xd9, 0x6e, 0xfc, 0x48, 0xd9, 0x4e, 0xed, 0x72, 0x6b, 0x3b, 0xb2,
+	0x46, 0x73, 0x66, 0x97, 0x5b, 0xbb, 0xd2, 0x02, 0x8d, 0x53, 0x7c, 0xd4, 0x35, 0x4a, 0x3a, 0xea,
+	0xc8, 0xb1, 0x8f, 0x3a, 0xb9, 0xe9, 0x4e, 0x0e, 0xdd, 0x74, 0xa5, 0xe9, 0x6a, 0x6a, 0xa8, 0xe9,
+	0xea, 0xbd, 0x64, 0xc6, 0x0b, 0x76, 0x69, 0xe4, 0x25, 0xb4, 0xc3, 0xd6, 0x02, 0xdb, 0x90, 0x27,
+	0xb4, 0xa0, 0xb3, 0x9a, 0x82, 0x42, 0x06, 0x3b, 0x7d, 0x52, 0xcc, 0x8c, 0x70, 0x52, 0x0c, 0x39,
+	0x9f, 0x67, 0xcb, 0x39, 0x9f, 0xcf, 0x9c, 0xfc, 0x7c, 0x3e, 0x7b, 0xaa, 0xe7, 0xb3, 0x5d, 0xca,
+	0xf9, 0x3c, 0xd2, 0xd1, 0x67, 0x5c, 0xd2, 0xcf, 0x1d, 0x71, 0x49, 0x1f, 0x76, 0x38, 0x9f, 0xbf,
+	0xef, 0xc3, 0xb9, 0xf8, 0xdc, 0x7d, 0xfc, 0x8d, 0x73, 0xb7, 0x8c, 0x73, 0x17, 0x47, 0x97, 0xaf,
+	0xa8, 0x4d, 0x37, 0x4a, 0x3c, 0xd7, 0x5f, 0xf2, 0xc3, 0x80, 0x36, 0x9f, 0x4c, 0x8f, 0xee, 0x4a,
+	0x0e, 0x03, 0x0a, 0x9e, 0x72, 0x3e, 0x5d, 0x21, 0xe7, 0xf5, 0x29, 0x87, 0x7b, 0x0b, 0xf7, 0x17,
+	0xa7, 0xe8, 0x2d, 0xc8, 0x8d, 0xe7, 0x46, 0x06, 0x0b, 0x9d, 0xc3, 0x43, 0x41, 0xc0, 0xc0, 0x62,
+	0x89, 0x20, 0x68, 0xc4, 0x2a, 0x68, 0x65, 0x8f, 0xc0, 0x25, 0xd1, 0x0e, 0x0a, 0x03, 0x07, 0x14,
+	0xff, 0x17, 0x19, 0x93, 0xb2, 0xde, 0x5e, 0x4b, 0x1a, 0x04, 0x26, 0x1e, 0x1a, 0xce, 0xdb, 0x72,
+	0xfb, 0xc5, 0x63, 0x70, 0x8a, 0x5f, 0x51, 0xd5, 0x8e, 0xab, 0xa0, 0xb2, 0x3b, 0x2c, 0x51, 0x49,
+	0x3d, 0xdf, 0x1d, 0x6c, 0x07, 0x85, 0xe1, 0xfc, 0xa9, 0x45, 0x2e, 0x14, 0x0e, 0xc5, 0x03, 0x10,
+	0x6d, 0xee, 0xa6, 0x45, 0x9b, 0x56, 0x59, 0xd7, 0x5b, 0xe3, 0x2d, 0x86, 0x88, 0x39, 0xff, 0xc9,
+	0x22, 0x33, 0x1a, 0xff, 0x01, 0xbc, 0xaa, 0x97, 0x7e, 0xd5, 0xf2, 0x6e, 0xf2, 0x8d, 0xdc, 0xbb,
+	0xfd, 0x6a, 0x85, 0xa8, 0xf2, 0x26, 0x0b, 0xed, 0x64, 0xb4, 0x28, 0xd0, 0x03, 0x32, 0xc6, 0xbc,
+	0x51, 0x4a, 0x72, 0x82, 0x4c, 0xf3, 0x67, 0x9e, 0x2d, 0xda, 0x38, 0xc8, 0x7e, 0xc6, 0x20, 0x18,
+	0xb2, 0xfa, 0x6e, 0xbc, 0xec, 0x43, 0x47, 0x38, 0x03, 0xea, 0xfa, 0x6e, 0xa2, 0x1d, 0x14, 0x06,
+	0x1e, 0xbe, 0x5e, 0x3b, 0x0c, 0x96, 0x7c, 0x37, 0x8e, 0x85, 0x3c, 0xa8, 0x0e, 0xdf, 0x55, 0x09,
+	0x00, 0x8d, 0xc3, 0x1c, 0x55, 0xbc, 0xb8, 0xef, 0xbb, 0x07, 0x86, 0xbe, 0xc6, 0xc8, 0x0c, 0xa8,
+	0x40, 0x60, 0xe2, 0x39, 0x3d, 0xd2, 0x4c, 0xbf, 0xc4, 0x32, 0xdd, 0x61, 0xde, 0xff, 0x23, 0x0d,
+	0x27, 0xfa, 0xc0, 0xb3, 0xa7, 0xd6, 0x06, 0x6e, 0xb3, 0x92, 0xee, 0xe5, 0x82, 0x04, 0x80, 0xc6,
+	0x71, 0xfe, 0x81, 0x45, 0x1e, 0x2b, 0x18, 0xb4, 0x12, 0xf3, 0x45, 0x24, 0x7a, 0xb7, 0x29, 0x12,
+	0x9b, 0x30, 0x02, 0x88, 0xee, 0xb8, 0xd2, 0xbf, 0xdc, 0x8c, 0x00, 0xe2, 0xcd, 0x20, 0xe1, 0x18,
+	0xd5, 0x3b, 0x9b, 0xee, 0x6b, 0xcc, 0xa2, 0xa0, 0xf9, 0x30, 0x79, 0x71, 0x3b, 0xdc, 0xa7, 0xd1,
+	0x01, 0xbe, 0xb9, 0x95, 0x89, 0x82, 0xce, 0x61, 0x40, 0xc1, 0x53, 0xac, 0xb8, 0x51, 0x47, 0x8d,
+	0xb6, 0x9c, 0x91, 0xb7, 0xca, 0x9c, 0x91, 0xfa, 0x63, 0x1a, 0x53, 0x41, 0xb3, 0x04, 0x93, 0x3f,
+	0x8a, 0x6f, 0x2c, 0x86, 0x0b, 0x03, 0x9d, 0x13, 0x2f, 0x10, 0xaf, 0x2c, 0xe6, 0xaa, 0x12, 0xdf,
+	0xd6, 0xf3, 0x28, 0x50, 0xf4, 0x9c, 0xf3, 0x87, 0x35, 0xa2, 0x72, 0x21, 0x31, 0x9f, 0xd2, 0x92,
+	0x3c, 0xad, 0x8f, 0x1b, 0x4b, 0xaf, 0xe6, 0x56, 0xed, 0x30, 0x27, 0x2f, 0xae, 0xe4, 0x33, 0xad,
+	0x01, 0x6a, 0xc0, 0xb6, 0x34, 0x08, 0x4c, 0x3c, 0xec, 0x89, 0xef, 0xed, 0x53, 0xfe, 0xd0, 0x58,
+	0xba, 0x27, 0x6b, 0x12, 0x00, 0x1a, 0x07, 0x7b, 0xd2, 0xf1, 0x76, 0x76, 0x9a, 0xe3, 0xe9, 0x9e,
+	0xe0, 0xe8, 0x00, 0x83, 0xf0, 0xf2, 0x77, 0xe1, 0x9e, 0xb8, 0xb2, 0x18, 0xe5, 0xef, 0xc2, 0x3d,
+	0x60, 0x10, 0xfc, 0x4a, 0x41, 0x18, 0xf5, 0x5c, 0xdf, 0x7b, 0x95, 0x76, 0x14, 0x17, 0x71, 0x55,
+	0x51, 0x5f, 0xe9, 0x46, 0x1e, 0x05, 0x8a, 0x9e, 0xc3, 0x09, 0xdd, 0x8f, 0x68, 0xc7, 0x6b, 0x27,
+	0x26, 0x35, 0x92, 0x9e, 0xd0, 0x9b, 0x39, 0x0c, 0x28, 0x78, 0x0a, 0xd3, 0x5d, 0x4a, 0x6f, 0x6f,
+	0x99, 0x11, 0x77, 0x32, 0x9d, 0xee, 0x12, 0xd2, 0x60, 0xc8, 0xe2, 0xe3, 0x26, 0xd9, 0x13, 0xf9,
+	0xbc, 0x9b, 0x53, 0xe9, 0x4d, 0x52, 0xe6, 0xf9, 0x06, 0x85, 0xe1, 0xfc, 0x40, 0x8d, 0xa8, 0x22,
+	0xbe, 0xcb, 0x91, 0xb7, 0x93, 0xac, 0xf4, 0xbd, 0x38, 0xec, 0xd0, 0x47, 0x78, 0xaa, 0x7d, 0x80,
+	0x34, 0x3a, 0xd8, 0x53, 0x16, 0x01, 0x52, 0xbf, 0xff, 0xf8, 0x92, 0x65, 0x49, 0x04, 0x34, 0x3d,
+	0xfb, 0x3d, 0x64, 0x7a, 0xc7, 0x48, 0xf5, 0x2b, 0xf5, 0xef, 0xac, 0xc6, 0x92, 0x99, 0x03, 0x38,
+	0x86, 0x34, 0x9e, 0xbd, 0x45, 0x26, 0x76, 0xa9, 0xeb, 0xdf, 0x67, 0x58, 0x0a, 0x93, 0xd0, 0xae,
+	0x89, 0xe7, 0x41, 0x51, 0xb2, 0x57, 0x38, 0x55, 0x26, 0xff, 0xf1, 0x92, 0x46, 0x5f, 0x21, 0x3f,
+	0xe6, 0x35, 0xd1, 0x8e, 0x39, 0xb4, 0x52, 0x5f, 0x50, 0x02, 0x40, 0x3d, 0x8a, 0x73, 0x82, 0x93,
+	0x5c, 0x3c, 0x10, 0xd3, 0xfc, 0x8c, 0x49, 0x06, 0xdb, 0x41, 0x61, 0x38, 0x9f, 0xac, 0x92, 0x0b,
+	0x92, 0x62, 0xae, 0x5e, 0xc2, 0x03, 0x8b, 0xf6, 0x48, 0x4f, 0x9d, 0xda, 0x08, 0x53, 0x07, 0x3d,
+	0xee, 0xe3, 0x30, 0x50, 0x1e, 0xf7, 0xf5, 0xa1, 0x1e, 0xf7, 0x06, 0x56, 0xb1, 0xc7, 0xfd, 0x58,
+	0x59, 0x1e, 0xf7, 0xe3, 0xf7, 0xe9, 0x71, 0xff, 0x6f, 0xea, 0x44, 0x15, 0xd1, 0xbe, 0x41, 0x93,
+	0x3b, 0x61, 0xb4, 0x87, 0x71, 0x9a, 0x18, 0xeb, 0xf7, 0xe3, 0x96, 0x4c, 0xf7, 0xb5, 0x66, 0x26,
+	0x75, 0xd8, 0x29, 0xa9, 0x10, 0x72, 0x8a, 0xd9, 0xfc, 0x96, 0xc1, 0x88, 0x7b, 0x6e, 0x65, 0xd2,
+	0x8a, 0x71, 0x10, 0xa4, 0x7a, 0x64, 0x7f, 0x8c, 0x10, 0x69, 0xf2, 0xd9, 0x91, 0xa7, 0xf2, 0x6a,
+	0x39, 0xfd, 0x43, 0x93, 0x9b, 0xba, 0x66, 0x6d, 0x29, 0x26, 0x60, 0x30, 0x44, 0x5f, 0x3f, 0x69,
+	0x3e, 0xe3, 0x21, 0x97, 0x1f, 0x39, 0x95, 0xb1, 0x19, 0x25, 0xdd, 0x05, 0x90, 0x71, 0x2f, 0xe8,
+	0xe2, 0x3c, 0x11, 0x9e, 0xc9, 0x6f, 0x29, 0x4a, 0x05, 0xb9, 0x16, 0xba, 0x9d, 0x45, 0xd7, 0x77,
+	0x83, 0x36, 0x56, 0xa8, 0x62, 0xe8, 0x5a, 0xaa, 0x12, 0x0d, 0x20, 0x09, 0xe5, 0x2a, 0x7d, 0xd7,
+	0x47, 0xa9, 0xf4, 0x7d, 0xf1, 0x1b, 0xc8, 0xd9, 0xdc, 0xc7, 0x3c, 0x56, 0x76, 0x8b, 0x13, 0x24,
+	0x81, 0xfc, 0xd5, 0x71, 0x2d, 0xc8, 0x60, 0xda, 0x4b, 0x56, 0x38, 0x3a, 0xd2, 0x5f, 0x54, 0x5c,
+	0xa3, 0x4a, 0x9c, 0x22, 0x4a, 0xf4, 0x30, 0x1a, 0xc1, 0x64, 0x89, 0x73, 0xb4, 0xef, 0x46, 0x34,
+	0x38, 0xed, 0x39, 0xba, 0xa9, 0x98, 0x80, 0xc1, 0xd0, 0xde, 0x4d, 0xc5, 0x04, 0x5f, 0x39, 0x79,
+	0x4c, 0x30, 0x4b, 0x21, 0x5e, 0x54, 0x0e, 0xf5, 0xb3, 0x16, 0x99, 0x09, 0x52, 0x33, 0xb7, 0x9c,
+	0x70, 0x91, 0xe2, 0x55, 0xb1, 0x68, 0xa3, 0x5e, 0x34, 0xdd, 0x06, 0x19, 0xfe, 0x45, 0x62, 0x4e,
+	0xfd, 0x98, 0x62, 0x8e, 0x2e, 0x5c, 0x3f, 0x36, 0xac, 0x70, 0xbd, 0x1d, 0x90, 0x31, 0x9e, 0xf0,
+	0xb8, 0x39, 0x5e, 0x46, 0x32, 0x2b, 0x33, 0x6b, 0x32, 0xe7, 0xc7, 0x5b, 0x40, 0x70, 0xb1, 0x6f,
+	0x9b, 0x59, 0x1a, 0x26, 0x8e, 0x2d, 0x04, 0x4c, 0x0f, 0xcd, 0xe6, 0x80, 0x3e, 0x6e, 0x6d, 0x51,
+	0x75, 0x00, 0xfd, 0x85, 0x4b, 0x08, 0x15, 0x2d, 0x2a, 0x68, 0x60, 0x96, 0x33, 0x12, 0xcc, 0x40,
+	0xf3, 0x75, 0xfe, 0x51, 0x9d, 0x9c, 0x91, 0xdf, 0x45, 0x06, 0xbc, 0xe1, 0x29, 0xcd, 0xdf, 0x5e,
+	0xdf, 0xe2, 0x14, 0x95, 0x6b, 0x12, 0x00, 0x1a, 0x07, 0x6f, 0x0a, 0x83, 0x18, 0xd3, 0x7d, 0x06,
+	0x6b, 0xde, 0x76, 0x2c, 0x9c, 0x4c, 0xd4, 0x72, 0xbd, 0xa9, 0x41, 0x60, 0xe2, 0x69, 0x3e, 0x4b,
+	0x2b, 0x6b, 0xcd, 0xf1, 0x22, 0x3e, 0x4b, 0x2b, 0x6b, 0xa0, 0x71, 0x58, 0x06, 0x8c, 0xb6, 0x99,
+	0x86, 0x4a, 0x67, 0xc0, 0x68, 0x8b, 0x74, 0x6e, 0x02, 0x6e, 0xff, 0x48, 0x61, 0x49, 0xaa, 0x72,
+	0xf2, 0x05, 0xe4, 0x02, 0x03, 0x8f, 0x57, 0x8b, 0xca, 0xfe, 0x69, 0x8b, 0x9c, 0xe7, 0xad, 0x72,
+	0xe8, 0x6f, 0xf6, 0x3b, 0x6e, 0x42, 0xe3, 0xe6, 0xd8, 0x29, 0xf5, 0x4f, 0x1b, 0x97, 0x8a, 0xd8,
+	0x42, 0x71, 0x6f, 0x30, 0xfb, 0xce, 0xec, 0x5e, 0x2a, 0x8d, 0xa4, 0x3c, 0xf1, 0x4e, 0x9a, 0x63,
+	0x2d, 0x45, 0x54, 0xef, 0x10, 0xe9, 0xf6, 0x18, 0xb2, 0xdc, 0xb1, 0xdc, 0x9d, 0xb9, 0xfb, 0x3f,
+	0xf8, 0xec, 0x93, 0xc7, 0x97, 0x60, 0xa5, 0x50, 0x5c, 0x3f, 0x2c, 0xcf, 0xf6, 0xc0, 0xeb, 0x34,
+	0xc7, 0x32, 0x7e, 0x30, 0xab, 0xcb, 0x80, 0xed, 0xce, 0x1f, 0x8d, 0x69, 0x8d, 0x9e, 0x08, 0xc7,
+	0xff, 0x0b, 0xf1, 0xda, 0x3b, 0x2a, 0x01, 0x3e, 0x7f, 0xf3, 0x1b, 0xb9, 0x04, 0xf8, 0x5f, 0x77,
+	0xfc, 0x6c, 0x0b, 0x7c, 0x80, 0x86, 0xe5, 0xbf, 0x1f, 0x3f, 0x22, 0xd5, 0xc2, 0xcb, 0x64, 0x02,
+	0xb5, 0x09, 0xc6, 0xd5, 0xec, 0x86, 0xba, 0x53, 0x89, 0xf6, 0xd7, 0xef, 0xcd, 0x7d, 0xcd, 0xf1,
+	0xbb, 0x25, 0x9f, 0x06, 0x45, 0xdf, 0x8e, 0x49, 0x03, 0xff, 0x67, 0x59, 0x21, 0xc4, 0x05, 0xee,
+	0xa6, 0xda, 0xfc, 0x24, 0xa0, 0x94, 0x94, 0x13, 0x9a, 0x8f, 0x1d, 0x90, 0x06, 0x22, 0x72, 0xa6,
+	0x5c, 0x9d, 0xb1, 0x29, 0x99, 0xb6, 0x24, 0xe0, 0xf5, 0x7b, 0x73, 0x5f, 0x7b, 0x7c, 0xa6, 0xea,
+	0x71, 0xd0, 0x2c, 0x8c, 0x13, 0x7d, 0x72, 0xe8, 0x89, 0x9e, 0x3e, 0x08, 0xa7, 0x1e, 0xd2, 0x41,
+	0xf8, 0x67, 0x35, 0xbd, 0xca, 0x44, 0x85, 0x86, 0xbf, 0x10, 0xab, 0xec, 0x85, 0xcc, 0x2a, 0xbb,
+	0x94, 0x5b, 0x65, 0x33, 0xf8, 0xe5, 0x0a, 0xea, 0x46, 0x3c, 0x68, 0x49, 0xeb, 0x68, 0x25, 0x1f,
+	0x13, 0x31, 0x5f, 0x19, 0x78, 0x11, 0x8d, 0x37, 0xa3, 0x41, 0x80, 0x85, 0x12, 0x1a, 0x0c, 0xd9,
+	0x10, 0x31, 0x53, 0x60, 0xc8, 0xe2, 0xa3, 0xd6, 0x04, 0x67, 0xe7, 0x6d, 0x77, 0x9f, 0xcf, 0x7f,
+	0x23, 0xe7, 0x74, 0x4b, 0xb4, 0x83, 0xc2, 0xb0, 0x77, 0xc9, 0x53, 0x92, 0xc0, 0x32, 0xf5, 0x29,
+	0xbe, 0x10, 0x4e, 0x1e, 0x2f, 0xea, 0xb9, 0x89, 0xd4, 0xe3, 0x4d, 0x2c, 0xbe, 0x59, 0x50, 0x78,
+	0x0a, 0x0e, 0xc1, 0x85, 0x43, 0x29, 0x39, 0x7f, 0xc6, 0xfc, 0x8a, 0x8c, 0x14, 0x3d, 0x38, 0xfb,
+	0x7c, 0xaf, 0xe7, 0xc9, 0xd4, 0xd8, 0x6a, 0xf6, 0xad, 0x61, 0x23, 0x70, 0x98, 0x7d, 0x87, 0x8c,
+	0x6f, 0xbb, 0xed, 0xbd, 0x70, 0x67, 0xa7, 0x9c, 0x62, 0x90, 0x8b, 0x9c, 0x18, 0x2b, 0xe0, 0x31,
+	0x2e, 0x7e, 0xbc, 0xae, 0xff, 0x05, 0xc9, 0x0d, 0xf5, 0xad, 0x3b, 0xae, 0xe7, 0x1b, 0x09, 0x15,
+	0x36, 0x02, 0xff, 0x20, 0xab, 0x15, 0xbf, 0x92, 0x47, 0x81, 0xa2, 0xe7, 0x9c, 0xdf, 0x1d, 0x23,
+	0xb3, 0xd2, 0x95, 0xf4, 0x9a, 0x17, 0x33, 0xef, 0x23, 0xb3, 0x48, 0x52, 0xe5, 0xc8, 0x22, 0x49,
+	0x1f, 0x22, 0xa4, 0x43, 0xfb, 0x7e, 0x78, 0xc0, 0x04, 0xf5, 0xda, 0xb1, 0x05, 0x75, 0x75, 0xb7,
+	0x5b, 0x56, 0x54, 0xc0, 0xa0, 0x28, 0xd2, 0x8b, 0xf3, 0x9a, 0x4b, 0x99, 0xf4, 0xe2, 0x46, 0x05,
+	0xda, 0xb1, 0x07, 0x5b, 0x81, 0xd6, 0x23, 0xb3, 0xbc, 0x8b, 0x2a, 0xaf, 0xce, 0x7d, 0xe8, 0x29,
+	0x59, 0x04, 0xeb, 0x72, 0x9a, 0x0c, 0x64, 0xe9, 0x9a, 0xe5, 0x65, 0x27, 0x1e, 0x74, 0x79, 0xd9,
+	0xaf, 0x24, 0x0d, 0xf9, 0x9d, 0xf9, 0x4d, 0x49, 0xa4, 0xd9, 0x93, 0xd3, 0x80, 0xe5, 0x53, 0x11,
+	0xff, 0xe6, 0x52, 0x84, 0x91, 0x87, 0x96, 0x22, 0x6c, 0x81, 0xcc, 0xf6, 0xdc, 0xc0, 0xdb, 0xa1,
+	0x71, 0x12, 0x2f, 0x7b, 0x5d, 0x1a, 0x27, 0x59, 0xc5, 0xff, 0x7a, 0x1a, 0x0c, 0x59, 0x7c, 0xfb,
+	0x1b, 0xc9, 0x99, 0x4c, 0x13, 0x3f, 0x21, 0x1b, 0x8b, 0xe7, 0xd0, 0x65, 0x2a, 0xf3, 0x7c, 0x0c,
+	0x39, 0x6c, 0xe7, 0xb3, 0x55, 0xbc, 0xe0, 0xf1, 0xc1, 0x39, 0x76, 0x89, 0xe8, 0x6b, 0x46, 0x89,
+	0xe8, 0xe3, 0x4d, 0xaa, 0x89, 0x4c, 0x29, 0xe9, 0xa7, 0x48, 0x2d, 0x71, 0xbb, 0x32, 0xea, 0x9f,
+	0x41, 0xb7, 0x5c, 0xac, 0x54, 0x88, 0xad, 0xc7, 0x29, 0x09, 0x81, 0x5e, 0x81, 0x32, 0xd3, 0x9f,
+	0xe1, 0xe4, 0xa0, 0xbd, 0x02, 0x4d, 0x20, 0xa4, 0x71, 0x51, 0xd4, 0x20, 0x11, 0x55, 0xb7, 0xc1,
+	0xb1, 0x32, 0x26, 0xb2, 0xda, 0x8b, 0x24, 0x5d, 0x33, 0xbf, 0x94, 0xba, 0x05, 0x1a, 0x6c, 0x9d,
+	0x4f, 0x59, 0xe4, 0x6c, 0xee, 0x29, 0xbb, 0x4f, 0xc6, 0xda, 0xac, 0x90, 0x77, 0x39, 0x69, 0xac,
+	0xd3, 0x45, 0xc1, 0xf9, 0x81, 0xcb, 0xdb, 0x40, 0xf0, 0x71, 0x7e, 0x69, 0x8a, 0x9c, 0x6b, 0x2d,
+	0xad, 0xcb, 0xb2, 0x8e, 0xa7, 0x96, 0xc6, 0xa0, 0x88, 0xc7, 0x83, 0x4b, 0x63, 0x30, 0x84, 0xbb,
+	0x6f, 0xa4, 0x31, 0xf0, 0x8d, 0x34, 0x06, 0xe9, 0x98, 0xf2, 0x6a, 0x19, 0x31, 0xe5, 0x45, 0x3d,
+	0x18, 0x25, 0xa6, 0xfc, 0xd4, 0xf2, 0x1a, 0x1c, 0xda, 0xa1, 0x63, 0xe5, 0x35, 0x50, 0x49, 0x1f,
+	0x4a, 0x09, 0x61, 0x1d, 0xf2, 0xa9, 0x0a, 0x93, 0x3e, 0xa8, 0x80, 0x7b, 0x1e, 0x9e, 0xdd, 0x1c,
+	0x2b, 0x23, 0xe0, 0xbe, 0xa8, 0x03, 0x23, 0x04, 0xdc, 0xf3, 0x1f, 0xa9, 0x24, 0x0f, 0xe3, 0x65,
+	0x24, 0x79, 0x28, 0xea, 0xce, 0x91, 0x49, 0x1e, 0xb0, 0x02, 0xb6, 0x1f, 0x06, 0x58, 0x65, 0x36,
+	0x09, 0xdb, 0xa1, 0xdf, 0x9c, 0x48, 0x6f, 0x90, 0x4b, 0x26, 0x10, 0xd2, 0xb8, 0xc3, 0x32, 0x44,
+	0x34, 0x4e, 0x9a, 0x21, 0x82, 0x3c, 0xa4, 0x0c, 0x11, 0x46, 0x0e, 0x84, 0xc9, 0x32, 0x72, 0x20,
+	0x14, 0x7d, 0x91, 0x91, 0x72, 0x20, 0x7c, 0xce, 0x22, 0xd3, 0xee, 0x1d, 0x76, 0xc1, 0xe2, 0xbb,
+	0x30, 0xb3, 0xe3, 0x4f, 0x3e, 0xff, 0xe1, 0x53, 0x98, 0xb0, 0xb7, 0x5b, 0x9a, 0x0d, 0xb7, 0x72,
+	0xa7, 0x9a, 0x20, 0xdd, 0x91, 0x93, 0xe4, 0x4d, 0xf8, 0xd1, 0x0a, 0xf9, 0xb2, 0x23, 0xbb, 0x60,
+	0xdf, 0x41, 0xcb, 0x61, 0x57, 0x4c, 0xd4, 0xa6, 0x55, 0x46, 0x20, 0xc3, 0x96, 0xa4, 0x27, 0x62,
+	0x7a, 0x15, 0x79, 0x30, 0x58, 0xb1, 0xf8, 0x85, 0xd0, 0xcf, 0x55, 0xa0, 0x80, 0xd0, 0xa7, 0xc0,
+	0x20, 0x28, 0x08, 0x45, 0xb4, 0x8b, 0x37, 0x8c, 0x6a, 0x5a, 0x10, 0x02, 0xd6, 0x0a, 0x02, 0x8a,
+	0x0a, 0x6e, 0xd7, 0xf7, 0x79, 0x7c, 0x31, 0x8d, 0x45, 0xb9, 0x3b, 0x9d, 0x77, 0x5e, 0x83, 0xc0,
+	0xc4, 0x73, 0xfe, 0xa4, 0x42, 0xe6, 0x8e, 0xd8, 0x53, 0x72, 0x79, 0x25, 0xea, 0x23, 0xe7, 0x95,
+	0x10, 0xf1, 0x91, 0x63, 0x43, 0xe2, 0x23, 0xd1, 0x7d, 0x87, 0x62, 0xc5, 0x54, 0xee, 0x11, 0x9d,
+	0x49, 0xa7, 0xbc, 0xa5, 0x41, 0x60, 0xe2, 0xe1, 0x2e, 0x36, 0xe3, 0xb6, 0xdb, 0x34, 0x8e, 0x65,
+	0x00, 0xa4, 0x30, 0x7b, 0x94, 0x16, 0x5d, 0xc9, 0xac, 0x49, 0x0b, 0x29, 0x16, 0x90, 0x61, 0x99,
+	0x1d, 0xf0, 0xc6, 0x88, 0x03, 0xfe, 0x93, 0x15, 0xf2, 0xf4, 0xa1, 0xa7, 0xdb, 0xc8, 0xb1, 0xa9,
+	0x18, 0xb4, 0x92, 0x9d, 0x38, 0x18, 0xd2, 0x02, 0x0c, 0xc2, 0x47, 0xa9, 0xdf, 0x57, 0x61, 0x2b,
+	0xe5, 0x07, 0x73, 0xf3, 0x51, 0x4a, 0xb1, 0x80, 0x0c, 0xcb, 0xfb, 0x9d, 0x96, 0xbf, 0x55, 0x23,
+	0xcf, 0x8e, 0x20, 0x03, 0x94, 0x18, 0xf4, 0x9e, 0x4e, 0xe8, 0x50, 0x7d, 0x48, 0x09, 0x1d, 0xee,
+	0x6f, 0xb8, 0xde, 0xc8, 0x03, 0x31, 0x52, 0x70, 0xfd, 0xcf, 0x56, 0xc8, 0xc5, 0xe1, 0x02, 0x8b,
+	0xfd, 0xf5, 0xa8, 0xbb, 0x93, 0x7e, 0xcb, 0x66, 0x2e, 0x88, 0xc7, 0xb8, 0xde, 0x2e, 0x05, 0x82,
+	0x2c, 0x2e, 0xcb, 0xe3, 0xee, 0x26, 0xbb, 0xf1, 0xca, 0x5d, 0x2f, 0x4e, 0x44, 0xf2, 0x4c, 0x9e,
+	0xc7, 0x5d, 0xb5, 0x82, 0x81, 0x81, 0xec, 0xd8, 0xaf, 0x65, 0x4c, 0x12, 0xc4, 0x1f, 0xe2, 0x57,
+	0xcf, 0xc7, 0x64, 0x7d, 0x69, 0x03, 0x04, 0x59, 0x5c, 0x64, 0xc7, 0x9c, 0x3d, 0x78, 0x47, 0x6b,
+	0x3a, 0x7b, 0xc4, 0x9a, 0x6a, 0x05, 0x03, 0x23, 0x9b, 0xe5, 0xa2, 0x7e, 0x74, 0x96, 0x0b, 0xe7,
+	0xe7, 0x2b, 0xe4, 0xc2, 0x50, 0x81, 0x77, 0xb4, 0x6d, 0xea, 0xd1, 0xcb, 0x34, 0x71, 0x9f, 0x2b,
+	0xec, 0x58, 0x19, 0x0a, 0x9c, 0x3f, 0x18, 0x32, 0xd3, 0x44, 0xf6, 0x81, 0xfb, 0x4f, 0xd4, 0xf4,
+	0xe8, 0x8d, 0x67, 0x2e, 0xe1, 0x40, 0xed, 0x18, 0x09, 0x07, 0x32, 0x1f, 0xa3, 0x3e, 0xe2, 0xe9,
+	0xf0, 0xdf, 0x6a, 0x43, 0x87, 0x17, 0x2f, 0xc8, 0x23, 0x59, 0x45, 0x96, 0xc9, 0x19, 0x2f, 0x68,
+	0xfb, 0x83, 0x0e, 0x6d, 0x0d, 0xb6, 0x45, 0x3e, 0x45, 0x9e, 0x0c, 0x5e, 0x85, 0xfb, 0xad, 0x66,
+	0xe0, 0x90, 0x7b, 0xe2, 0x11, 0x4c, 0x00, 0x71, 0x7f, 0x43, 0x7a, 0xcc, 0x9d, 0x7b, 0x83, 0x9c,
+	0x97, 0x43, 0xb1, 0xeb, 0x46, 0xb4, 0x23, 0x0e, 0xdb, 0x58, 0x04, 0x78, 0x5e, 0xe0, 0x41, 0xa2,
+	0x05, 0x08, 0x50, 0xfc, 0x1c, 0x7e, 0xb2, 0x24, 0xec, 0x7b, 0xed, 0xe6, 0x44, 0xfa, 0x93, 0x6d,
+	0x61, 0x23, 0x70, 0x98, 0x3e, 0x2f, 0x1a, 0x0f, 0xe6, 0xbc, 0xf8, 0x10, 0x69, 0xa8, 0xf1, 0xe6,
+	0x81, 0x57, 0x6a, 0x92, 0xe7, 0x02, 0xaf, 0xd4, 0x0c, 0x37, 0xb0, 0x64, 0x79, 0xea, 0x4a, 0x71,
+	0x79, 0x6a, 0xe7, 0xe7, 0x2c, 0xf2, 0x34, 0x97, 0x08, 0x5a, 0x5e, 0x87, 0xe2, 0xdd, 0xf1, 0x40,
+	0x1a, 0xfe, 0x58, 0xbe, 0xd1, 0xd2, 0x72, 0x9c, 0x5f, 0x25, 0x63, 0xdc, 0xe9, 0x44, 0x4c, 0xd4,
+	0xcb, 0x4a, 0x99, 0xca, 0x5a, 0x5f, 0xbf, 0x37, 0x37, 0xac, 0x1f, 0x1c, 0x01, 0xc4, 0xe3, 0xce,
+	0x3b, 0xc9, 0x94, 0xd2, 0x5e, 0x8a, 0x70, 0x7f, 0x56, 0xfa, 0x3f, 0xdb, 0xbf, 0xeb, 0xd8, 0x08,
+	0x1c, 0xe6, 0xfc, 0x79, 0x85, 0x64, 0xea, 0xca, 0x62, 0xf9, 0x04, 0xac, 0x8b, 0xcb, 0x1a, 0xcb,
+	0x29, 0x9f, 0xb0, 0x2c, 0xc9, 0x99, 0x9e, 0xd6, 0xa2, 0x09, 0x34, 0x33, 0xfb, 0xa3, 0xbc, 0x52,
+	0x81, 0x60, 0x5d, 0x29, 0x23, 0x6d, 0x49, 0x4b, 0xd1, 0x33, 0xab, 0x69, 0xcb, 0x36, 0x30, 0xf8,
+	0xd9, 0x09, 0x69, 0xec, 0xca, 0xfa, 0xb9, 0xe5, 0x6c, 0xd0, 0xaa, 0x1c, 0x2f, 0x17, 0x2a, 0xd5,
+	0x4f, 0xd0, 0x8c, 0x30, 0x11, 0xfb, 0xb9, 0xf4, 0x07, 0x10, 0xe6, 0xe3, 0x9f, 0xb3, 0xc8, 0x13,
+	0xbe, 0x1b, 0x27, 0xad, 0x01, 0xbb, 0xda, 0xec, 0x0c, 0xfc, 0x8d, 0x4c, 0x51, 0x8b, 0x93, 0xaa,
+	0x87, 0x14, 0xe1, 0x6c, 0xbd, 0xe5, 0xc5, 0x27, 0x31, 0x90, 0x77, 0xad, 0x98, 0x39, 0x0c, 0xeb,
+	0x15, 0xea, 0xd4, 0xce, 0xb4, 0x07, 0x51, 0x44, 0x83, 0x44, 0x77, 0x95, 0x7f, 0xc5, 0x1b, 0xa5,
+	0x0c, 0xa4, 0xee, 0x20, 0x33, 0x5f, 0x2c, 0x65, 0x78, 0x41, 0x8e, 0xbb, 0xf3, 0xdd, 0x78, 0xd6,
+	0x0f, 0x7d, 0xcf, 0xbf, 0x64, 0x05, 0xa2, 0xff, 0xef, 0x38, 0x99, 0x4e, 0x55, 0xee, 0x48, 0xd9,
+	0x48, 0xad, 0x23, 0x6d, 0xa4, 0x2c, 0x88, 0x7a, 0x10, 0x88, 0x02, 0xa6, 0x66, 0x10, 0xf5, 0x20,
+	0xc0, 0xca, 0x24, 0xf8, 0x47, 0x0c, 0x29, 0x0c, 0x02, 0x61, 0xcc, 0x35, 0x87, 0x14, 0x06, 0x01,
+	0x08, 0x28, 0xba, 0xfb, 0x4e, 0xb1, 0xc5, 0x27, 0x0c, 0xd6, 0xcd, 0x5a, 0x19, 0x5e, 0x02, 0x2d,
+	0x83, 0x22, 0x77, 0x7f, 0x36, 0x5b, 0x20, 0xc5, 0x11, 0x8b, 0x35, 0x19, 0xf5, 0x1b, 0xc6, 0xca,
+	0x08, 0x23, 0xcd, 0x16, 0x46, 0xc9, 0xec, 0x7a, 0x85, 0x15, 0x1c, 0x62, 0x65, 0xfe, 0x1d, 0x3f,
+	0x1d, 0xf3, 0x2f, 0x29, 0x30, 0xfd, 0x62, 0xe9, 0x31, 0x69, 0xeb, 0x6b, 0x4e, 0x68, 0x9b, 0xa8,
+	0x32, 0x09, 0x82, 0x86, 0xe3, 0xf5, 0x24, 0x66, 0x2f, 0x96, 0x18, 0x26, 0x54, 0x76, 0x3d, 0x69,
+	0xe9, 0x66, 0x30, 0x71, 0x4c, 0x7b, 0x2f, 0x79, 0xa8, 0xf6, 0xde, 0xc9, 0x23, 0xec, 0xbd, 0x2d,
+	0x72, 0xde, 0x1d, 0x24, 0x21, 0x3a, 0x93, 0x60, 0x69, 0xac, 0x5e, 0x3f, 0x89, 0x79, 0xb1, 0x97,
+	0x29, 0xa6, 0xb4, 0x56, 0x9e, 0x8f, 0x2d, 0xea, 0xef, 0xe4, 0x90, 0xa0, 0xf8, 0x59, 0xe1, 0xcc,
+	0x9c, 0x84, 0x11, 0x6d, 0x05, 0x6e, 0x3f, 0xde, 0x0d, 0x93, 0xe6, 0x74, 0xda, 0x74, 0x0b, 0x69,
+	0x30, 0x64, 0xf1, 0x87, 0x79, 0x48, 0xcc, 0xdc, 0xa7, 0x87, 0xc4, 0x3f, 0xb6, 0xc8, 0xf9, 0xc2,
+	0xc9, 0xf9, 0xe8, 0x06, 0xef, 0x38, 0x5f, 0x18, 0x23, 0x8f, 0x15, 0x54, 0x1a, 0xb2, 0x0f, 0xcc,
+	0x65, 0x6b, 0x95, 0xe1, 0x50, 0x9a, 0xf6, 0x8f, 0x94, 0xb3, 0xa5, 0x60, 0xad, 0x1e, 0xcf, 0xa9,
+	0x44, 0x3b, 0x76, 0x54, 0x1f, 0xac, 0x63, 0x87, 0xb1, 0xfa, 0x6a, 0x0f, 0x75, 0xf5, 0xd5, 0x8f,
+	0x58, 0x7d, 0x9f, 0xb7, 0x48, 0xb3, 0x37, 0xa4, 0x52, 0x6b, 0x73, 0xac, 0x0c, 0x3d, 0xdf, 0xb0,
+	0x3a, 0xb0, 0x8b, 0x4f, 0x61, 0x4e, 0x8b, 0x61, 0x50, 0x18, 0xda, 0x2b, 0xe6, 0x02, 0x26, 0x17,
+	0xf5, 0x78, 0x7a, 0x0a, 0xa8, 0xd5, 0xac, 0x30, 0x8a, 0x9c, 0x38, 0x26, 0x4a, 0x70, 0xe2, 0x68,
+	0x1c, 0xcb, 0x89, 0xe3, 0xd3, 0x15, 0xc2, 0xdd, 0x33, 0xdd, 0x41, 0x8c, 0xf7, 0xc3, 0xfa, 0x20,
+	0x48, 0x3c, 0xbf, 0x69, 0x1d, 0xdb, 0x2d, 0x43, 0x6d, 0x0e, 0x37, 0x91, 0x00, 0x70, 0x3a, 0xdc,
+	0x0a, 0xe2, 0xc6, 0x6a, 0x49, 0x18, 0x56, 0x10, 0x37, 0xe6, 0x56, 0x10, 0xfc, 0xcb, 0xf3, 0x37,
+	0x0d, 0x62, 0xe6, 0x56, 0x93, 0xcb, 0xdf, 0xc4, 0xdb, 0x41, 0x61, 0x60, 0xf4, 0x24, 0xff, 0xff,
+	0xbe, 0xfc, 0xb1, 0xa6, 0x34, 0x55, 0x8c, 0x9e, 0x94, 0x94, 0x9c, 0xef, 0x18, 0x23, 0x4c, 0xfe,
+	0x17, 0x77, 0xb8, 0x8f, 0x9b, 0xe5, 0xe6, 0xac, 0xb2, 0x4a, 0xa3, 0x71, 0xe2, 0xaa, 0x5c, 0x1d,
+	0x9f, 0xff, 0x45, 0xd5, 0xeb, 0xb2, 0x27, 0x6b, 0x65, 0x84, 0x93, 0xd5, 0x97, 0x75, 0xfd, 0xaa,
+	0xe5, 0xd7, 0xf5, 0x6b, 0x64, 0x6b, 0xfa, 0x1d, 0xbe, 0x40, 0x6b, 0x8f, 0xe4, 0x02, 0xdd, 0x25,
+	0x75, 0xf6, 0xb9, 0x4b, 0x4a, 0x60, 0x2e, 0xd7, 0x0d, 0x1f, 0x1c, 0xf6, 0x2f, 0x70, 0x06, 0xf6,
+	0x3f, 0xb7, 0x48, 0x33, 0x2e, 0xbe, 0x9b, 0x4b, 0x79, 0xf2, 0xa4, 0xbe, 0x1f, 0x87, 0x69, 0x20,
+	0x74, 0x6a, 0x9e, 0x21, 0x68, 0x31, 0x0c, 0xed, 0x9e, 0xf3, 0xcb, 0x16, 0x3f, 0x5c, 0x33, 0x73,
+	0x55, 0x0b, 0xf9, 0xd6, 0x21, 0x42, 0xfe, 0xdb, 0x58, 0x61, 0x35, 0x26, 0x0f, 0x89, 0xcb, 0x80,
+	0xde, 0x03, 0x45, 0x3b, 0x28, 0x0c, 0xd4, 0xce, 0xb8, 0xbe, 0x1f, 0xde, 0x59, 0xe9, 0xf5, 0x13,
+	0xe9, 0xe3, 0xa9, 0x2e, 0xe3, 0x0b, 0x0a, 0x02, 0x06, 0x96, 0xfd, 0x2c, 0x19, 0xe3, 0xa9, 0x77,
+	0x84, 0x12, 0x98, 0xd5, 0xd9, 0xe2, 0x09, 0x7a, 0x3a, 0x20, 0x40, 0xce, 0x2e, 0x31, 0xee, 0xf2,
+	0xa8, 0xb8, 0x35, 0xf3, 0x38, 0x67, 0x15, 0xb7, 0x66, 0xda, 0x67, 0x48, 0x61, 0x1e, 0x5d, 0xc5,
+	0xde, 0xf9, 0x3b, 0x15, 0xc1, 0x8a, 0xdf, 0xcd, 0xb5, 0x57, 0xb4, 0x75, 0x4c, 0xaf, 0xe8, 0x8f,
+	0x12, 0xd2, 0x0e, 0x7b, 0x7d, 0xd4, 0xaf, 0x6d, 0x85, 0xe5, 0xa8, 0x38, 0x96, 0x14, 0x3d, 0x3d,
+	0xaa, 0xba, 0x0d, 0x0c, 0x7e, 0x29, 0xf1, 0xa5, 0x7a, 0xa4, 0xf8, 0x92, 0x3a, 0xc9, 0x6b, 0x87,
+	0x9f, 0xe4, 0xce, 0x9f, 0x58, 0x24, 0x75, 0xd7, 0xc2, 0xfa, 0xa3, 0xd8, 0xdd, 0x03, 0xb1, 0xad,
+	0x6e, 0x94, 0x77, 0xb1, 0x63, 0x33, 0x59, 0x14, 0x7d, 0xc3, 0x7f, 0x81, 0x33, 0xb2, 0x7d, 0xe1,
+	0x01, 0x5e, 0x8a, 0xca, 0xc1, 0x64, 0x88, 0x3e, 0xe4, 0xdc, 0xe9, 0x50, 0x7b, 0x93, 0x3b, 0x2f,
+	0x90, 0xb3, 0xb9, 0x4e, 0xe1, 0xea, 0x61, 0xf9, 0xb8, 0xb2, 0xab, 0x87, 0x65, 0xa2, 0x02, 0x0e,
+	0x73, 0x7e, 0xd6, 0x22, 0x67, 0xb2, 0xe4, 0xd1, 0xc3, 0xe3, 0x6c, 0x9c, 0xa5, 0x77, 0x5a, 0x63,
+	0xa7, 0xe2, 0xcd, 0x72, 0x20, 0xc8, 0x77, 0xc2, 0xf9, 0x7f, 0x75, 0x3e, 0xf9, 0x6f, 0x7b, 0x41,
+	0x27, 0xbc, 0xa3, 0xee, 0x02, 0xd6, 0xd0, 0xbb, 0x00, 0x6e, 0x0f, 0xed, 0x5d, 0xda, 0x19, 0xf8,
+	0xb9, 0x9c, 0x56, 0x2d, 0xd1, 0x0e, 0x0a, 0x03, 0xb1, 0x3b, 0x03, 0xa1, 0x2d, 0xca, 0x4c, 0xca,
+	0x65, 0xd1, 0x0e, 0x0a, 0x83, 0x95, 0x36, 0xd6, 0x2f, 0x29, 0xe7, 0x25, 0x2f, 0x6d, 0x6c, 0xb4,
+	0x43, 0x0a, 0x0b, 0x0d, 0x72, 0xea, 0x5e, 0x21, 0xa5, 0x52, 0x66, 0x90, 0x53, 0xc7, 0x47, 0x0c,
+	0x06, 0x06, 0x4b, 0x98, 0xe5, 0x0f, 0xe2, 0x44, 0xa7, 0x7b, 0xe0, 0x09, 0xb3, 0x44, 0x1b, 0x28,
+	0x28, 0x6e, 0x6e, 0x3d, 0x37, 0x18, 0xb8, 0x3e, 0x8e, 0x90, 0x50, 0xb1, 0xab, 0x65, 0xb8, 0xae,
+	0x20, 0x60, 0x60, 0xe1, 0x1b, 0x27, 0x5e, 0x8f, 0xbe, 0x3f, 0x0c, 0x64, 0x9c, 0x90, 0x76, 0x42,
+	0x12, 0xed, 0xa0, 0x30, 0xec, 0x17, 0xc8, 0xa4, 0x1b, 0x74, 0xf8, 0x25, 0x28, 0x8c, 0x84, 0x2f,
+	0x83, 0xd2, 0xf9, 0x60, 0x56, 0x36, 0x0d, 0x05, 0x13, 0x35, 0x5b, 0x66, 0x8b, 0x8c, 0x58, 0x66,
+	0xeb, 0x3d, 0x64, 0x9a, 0xde, 0x65, 0x86, 0x80, 0xce, 0x32, 0x0b, 0x2b, 0x9c, 0xd4, 0x09, 0x2f,
+	0x56, 0x4c, 0x00, 0xa4, 0xf1, 0xec, 0x57, 0xc9, 0x44, 0xdb, 0xf5, 0x69, 0xd0, 0x71, 0xa3, 0xe6,
+	0x54, 0x19, 0x7e, 0xd3, 0x7a, 0xd6, 0x2d, 0x09, 0xba, 0xe2, 0x3b, 0x88, 0x5f, 0xa0, 0xf8, 0x61,
+	0xa0, 0x2d, 0xbd, 0xdb, 0xf7, 0x22, 0x1a, 0x2f, 0xf0, 0xcb, 0xf6, 0x7d, 0x04, 0xda, 0xae, 0x48,
+	0x02, 0xa0, 0x69, 0x39, 0x1d, 0x62, 0xe7, 0xbb, 0xc1, 0xaa, 0xaf, 0x4b, 0x1b, 0x45, 0xae, 0xfa,
+	0xba, 0x04, 0x80, 0xc6, 0x39, 0xca, 0xdc, 0xf0, 0xc7, 0x16, 0x99, 0xd5, 0x19, 0x2c, 0xd9, 0x53,
+	0x29, 0xb3, 0x8f, 0x75, 0xa4, 0xd9, 0x27, 0x9d, 0x7c, 0xae, 0x32, 0x52, 0xf2, 0x39, 0x33, 0x2f,
+	0x5c, 0xf5, 0xd0, 0xbc, 0x70, 0x5f, 0x4e, 0xc6, 0xf7, 0xe8, 0x81, 0x91, 0x40, 0x8e, 0x1d, 0xc8,
+	0xd7, 0x79, 0x13, 0x48, 0x18, 0x06, 0x6c, 0xb5, 0x5d, 0x95, 0xd0, 0x7a, 0x4a, 0xf8, 0x0d, 0x2f,
+	0x30, 0x24, 0x01, 0x71, 0x36, 0x48, 0x43, 0x39, 0x5c, 0xc9, 0x61, 0xb1, 0x8a, 0x87, 0x65, 0xa4,
+	0xfc, 0x54, 0x8b, 0xdb, 0xbf, 0xf6, 0xc5, 0x67, 0xde, 0xf4, 0x9b, 0x5f, 0x7c, 0xe6, 0x4d, 0xbf,
+	0xf7, 0xc5, 0x67, 0xde, 0xf4, 0x89, 0xd7, 0x9e, 0xb1, 0x7e, 0xed, 0xb5, 0x67, 0xac, 0xdf, 0x7c,
+	0xed, 0x19, 0xeb, 0xf7, 0x5e, 0x7b, 0xc6, 0xfa, 0xc3, 0xd7, 0x9e, 0xb1, 0x3e, 0xfb, 0x47, 0xcf,
+	0xbc, 0xe9, 0xfd, 0x85, 0xd1, 0x80, 0xf8, 0xcf, 0xdb, 0xdb, 0x9d, 0xcb, 0xfb, 0xef, 0x64, 0x01,
+	0x69, 0x38, 0x2f, 0x2e, 0x1b, 0x33, 0xf1, 0xb2, 0x9c, 0x89, 0xff, 0x7f, 0x00, 0x09, 0xa2, 0x0b,
+	0x3b, 0x75, 0x13, 0x01, 0x00,
 }
 
 func (m *AWSAuthConfig) Marshal() (dAtA []byte, err error) {
@@ -5925,6 +6300,18 @@ func (m *AppProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OCISignatureVerification != nil {
+		{
+			size, err := m.OCISignatureVerification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
 	if len(m.DestinationServiceAccounts) > 0 {
 		for iNdEx := len(m.DestinationServiceAccounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -7688,6 +8075,18 @@ func (m *ApplicationSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CUE != nil {
+		{
+			size, err := m.CUE.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
 	i -= len(m.Name)
 	copy(dAtA[i:], m.Name)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
@@ -7769,6 +8168,65 @@ func (m *ApplicationSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationSourceCUE) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSourceCUE) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSourceCUE) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Values != nil {
+		{
+			size, err := m.Values.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.Expression)
+	copy(dAtA[i:], m.Expression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Package)
+	copy(dAtA[i:], m.Package)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Package)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationSourceDirectory) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7840,6 +8298,22 @@ func (m *ApplicationSourceHelm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValuesFrom) > 0 {
+		for iNdEx := len(m.ValuesFrom) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValuesFrom[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
 	i--
 	if m.SkipSchemaValidation {
 		dAtA[i] = 1
@@ -8366,6 +8840,20 @@ func (m *ApplicationSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SyncWindows) > 0 {
+		for iNdEx := len(m.SyncWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SyncWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.SourceHydrator != nil {
 		{
 			size, err := m.SourceHydrator.MarshalToSizedBuffer(dAtA[:i])
@@ -8927,6 +9415,39 @@ func (m *BearerTokenBitbucketCloud) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *CUETag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CUETag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CUETag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ChartDetails) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9144,6 +9665,9 @@ func (m *ClusterCacheInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.EventsPerMinute))
+	i--
+	dAtA[i] = 0x20
 	if m.LastCacheSyncTime != nil {
 		{
 			size, err := m.LastCacheSyncTime.MarshalToSizedBuffer(dAtA[:i])
@@ -10018,6 +10542,39 @@ func (m *ExecProviderConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FieldManagerConflict) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldManagerConflict) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FieldManagerConflict) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Manager)
+	copy(dAtA[i:], m.Manager)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Manager)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Field)
+	copy(dAtA[i:], m.Field)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Field)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *GitDirectoryGeneratorItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10446,6 +11003,52 @@ func (m *HelmParameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HelmValuesFromSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HelmValuesFromSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HelmValuesFromSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Optional {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *HostInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11559,6 +12162,94 @@ func (m *OCIMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OCISignatureVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OCISignatureVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OCISignatureVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Attestations[iNdEx])
+			copy(dAtA[i:], m.Attestations[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Attestations[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Identities) > 0 {
+		for iNdEx := len(m.Identities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Identities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OCISigningIdentity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OCISigningIdentity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OCISigningIdentity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Subject)
+	copy(dAtA[i:], m.Subject)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Subject)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Issuer)
+	copy(dAtA[i:], m.Issuer)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Issuer)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Operation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11861,6 +12552,60 @@ func (m *OrphanedResourceKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OrphanedResourcesCleanup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrphanedResourcesCleanup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrphanedResourcesCleanup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.GracePeriod)
+	copy(dAtA[i:], m.GracePeriod)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GracePeriod)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Resources) > 0 {
+		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Resources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Selector != nil {
+		{
+			size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OrphanedResourcesMonitorSettings) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11881,6 +12626,28 @@ func (m *OrphanedResourcesMonitorSettings) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
+	if m.Cleanup != nil {
+		{
+			size, err := m.Cleanup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Adopt != nil {
+		i--
+		if *m.Adopt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Ignore) > 0 {
 		for iNdEx := len(m.Ignore) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -13052,6 +13819,16 @@ func (m *Repository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	i--
+	if m.EnablePartialClone {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd8
+	i--
 	if m.InsecureOCIForceHttp {
 		dAtA[i] = 1
 	} else {
@@ -13650,6 +14427,90 @@ func (m *ResourceDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ResourceDriftEpisode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceDriftEpisode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceDriftEpisode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.HealedBy)
+	copy(dAtA[i:], m.HealedBy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HealedBy)))
+	i--
+	dAtA[i] = 0x4a
+	i -= len(m.HealType)
+	copy(dAtA[i:], m.HealType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HealType)))
+	i--
+	dAtA[i] = 0x42
+	if m.HealedAt != nil {
+		{
+			size, err := m.HealedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.FieldManagers) > 0 {
+		for iNdEx := len(m.FieldManagers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FieldManagers[iNdEx])
+			copy(dAtA[i:], m.FieldManagers[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.FieldManagers[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.DriftedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Group)
+	copy(dAtA[i:], m.Group)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ResourceIgnoreDifferences) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13848,6 +14709,20 @@ func (m *ResourceNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Conflicts) > 0 {
+		for iNdEx := len(m.Conflicts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conflicts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.CreatedAt != nil {
 		{
 			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
@@ -13959,6 +14834,11 @@ func (m *ResourceOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.HealthCEL)
+	copy(dAtA[i:], m.HealthCEL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HealthCEL)))
+	i--
+	dAtA[i] = 0x3a
 	{
 		size, err := m.IgnoreResourceUpdates.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -14087,6 +14967,20 @@ func (m *ResourceResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Conflicts) > 0 {
+		for iNdEx := len(m.Conflicts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conflicts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.Images) > 0 {
 		for iNdEx := len(m.Images) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Images[iNdEx])
@@ -14261,6 +15155,14 @@ func (m *RetryStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.FailedResourcesOnly {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
 	if m.Backoff != nil {
 		{
 			size, err := m.Backoff.MarshalToSizedBuffer(dAtA[:i])
@@ -14299,6 +15201,20 @@ func (m *RevisionHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ManifestsDigests) > 0 {
+		for iNdEx := len(m.ManifestsDigests) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ManifestsDigests[iNdEx])
+			copy(dAtA[i:], m.ManifestsDigests[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ManifestsDigests[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	i -= len(m.ManifestsDigest)
+	copy(dAtA[i:], m.ManifestsDigest)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ManifestsDigest)))
+	i--
+	dAtA[i] = 0x5a
 	{
 		size, err := m.InitiatedBy.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -15209,6 +16125,44 @@ func (m *SecretRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ServerSideApplyConflictPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServerSideApplyConflictPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServerSideApplyConflictPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Action)
+	copy(dAtA[i:], m.Action)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Action)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Group)
+	copy(dAtA[i:], m.Group)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SignatureKey) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15402,6 +16356,19 @@ func (m *SyncOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.FailedResourcesOnly {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x70
+	i -= len(m.RestoreSnapshot)
+	copy(dAtA[i:], m.RestoreSnapshot)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RestoreSnapshot)))
+	i--
+	dAtA[i] = 0x6a
 	i = encodeVarintGenerated(dAtA, i, uint64(m.SelfHealAttemptsCount))
 	i--
 	dAtA[i] = 0x60
@@ -15571,6 +16538,25 @@ func (m *SyncOperationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ManifestsDigests) > 0 {
+		for iNdEx := len(m.ManifestsDigests) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ManifestsDigests[iNdEx])
+			copy(dAtA[i:], m.ManifestsDigests[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ManifestsDigests[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	i -= len(m.ManifestsDigest)
+	copy(dAtA[i:], m.ManifestsDigest)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ManifestsDigest)))
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.Snapshot)
+	copy(dAtA[i:], m.Snapshot)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Snapshot)))
+	i--
+	dAtA[i] = 0x3a
 	if m.ManagedNamespaceMetadata != nil {
 		{
 			size, err := m.ManagedNamespaceMetadata.MarshalToSizedBuffer(dAtA[:i])
@@ -15638,6 +16624,61 @@ func (m *SyncOperationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PausedAt != nil {
+		{
+			size, err := m.PausedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.PausedBy)
+	copy(dAtA[i:], m.PausedBy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PausedBy)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Until.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SyncPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15658,6 +16699,32 @@ func (m *SyncPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ServerSideApplyConflicts) > 0 {
+		for iNdEx := len(m.ServerSideApplyConflicts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ServerSideApplyConflicts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Pause != nil {
+		{
+			size, err := m.Pause.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ManagedNamespaceMetadata != nil {
 		{
 			size, err := m.ManagedNamespaceMetadata.MarshalToSizedBuffer(dAtA[:i])
@@ -15979,6 +17046,39 @@ func (m *SyncWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAt != nil {
+		{
+			size, err := m.ExpiresAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Calendar != nil {
+		{
+			size, err := m.Calendar.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.ExcludedDates) > 0 {
+		for iNdEx := len(m.ExcludedDates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExcludedDates[iNdEx])
+			copy(dAtA[i:], m.ExcludedDates[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ExcludedDates[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	i -= len(m.Description)
 	copy(dAtA[i:], m.Description)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Description)))
@@ -16050,6 +17150,39 @@ func (m *SyncWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncWindowCalendar) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWindowCalendar) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWindowCalendar) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.ConfigMap)
+	copy(dAtA[i:], m.ConfigMap)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ConfigMap)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *TLSClientConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -16294,6 +17427,10 @@ func (m *AppProjectSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.OCISignatureVerification != nil {
+		l = m.OCISignatureVerification.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16916,6 +18053,33 @@ func (m *ApplicationSource) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.CUE != nil {
+		l = m.CUE.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationSourceCUE) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Package)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Expression)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Values != nil {
+		l = m.Values.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16984,6 +18148,12 @@ func (m *ApplicationSourceHelm) Size() (n int) {
 	}
 	n += 2
 	n += 2
+	if len(m.ValuesFrom) > 0 {
+		for _, e := range m.ValuesFrom {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -17174,6 +18344,12 @@ func (m *ApplicationSpec) Size() (n int) {
 		l = m.SourceHydrator.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.SyncWindows) > 0 {
+		for _, e := range m.SyncWindows {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -17355,6 +18531,19 @@ func (m *BearerTokenBitbucketCloud) Size() (n int) {
 	return n
 }
 
+func (m *CUETag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ChartDetails) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17439,6 +18628,7 @@ func (m *ClusterCacheInfo) Size() (n int) {
 		l = m.LastCacheSyncTime.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	n += 1 + sovGenerated(uint64(m.EventsPerMinute))
 	return n
 }
 
@@ -17755,6 +18945,19 @@ func (m *ExecProviderConfig) Size() (n int) {
 	return n
 }
 
+func (m *FieldManagerConflict) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Manager)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *GitDirectoryGeneratorItem) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17916,6 +19119,22 @@ func (m *HelmParameter) Size() (n int) {
 	return n
 }
 
+func (m *HelmValuesFromSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
 func (m *HostInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18334,6 +19553,46 @@ func (m *OCIMetadata) Size() (n int) {
 	return n
 }
 
+func (m *OCISignatureVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, s := range m.PublicKeys {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Identities) > 0 {
+		for _, e := range m.Identities {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for _, s := range m.Attestations {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OCISigningIdentity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Subject)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Operation) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18442,6 +19701,27 @@ func (m *OrphanedResourceKey) Size() (n int) {
 	return n
 }
 
+func (m *OrphanedResourcesCleanup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Selector != nil {
+		l = m.Selector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Resources) > 0 {
+		for _, e := range m.Resources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.GracePeriod)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *OrphanedResourcesMonitorSettings) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18457,6 +19737,13 @@ func (m *OrphanedResourcesMonitorSettings) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.Adopt != nil {
+		n += 2
+	}
+	if m.Cleanup != nil {
+		l = m.Cleanup.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -18929,6 +20216,7 @@ func (m *Repository) Size() (n int) {
 	l = len(m.BearerToken)
 	n += 2 + l + sovGenerated(uint64(l))
 	n += 3
+	n += 3
 	return n
 }
 
@@ -19088,6 +20376,39 @@ func (m *ResourceDiff) Size() (n int) {
 	return n
 }
 
+func (m *ResourceDriftEpisode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.DriftedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.FieldManagers) > 0 {
+		for _, s := range m.FieldManagers {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.HealedAt != nil {
+		l = m.HealedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.HealType)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.HealedBy)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ResourceIgnoreDifferences) Size() (n int) {
 	if m == nil {
 		return 0
@@ -19206,6 +20527,12 @@ func (m *ResourceNode) Size() (n int) {
 		l = m.CreatedAt.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Conflicts) > 0 {
+		for _, e := range m.Conflicts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -19230,6 +20557,8 @@ func (m *ResourceOverride) Size() (n int) {
 	n += 2
 	l = m.IgnoreResourceUpdates.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.HealthCEL)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -19286,6 +20615,12 @@ func (m *ResourceResult) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.Conflicts) > 0 {
+		for _, e := range m.Conflicts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -19329,6 +20664,7 @@ func (m *RetryStrategy) Size() (n int) {
 		l = m.Backoff.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	n += 2
 	return n
 }
 
@@ -19363,6 +20699,14 @@ func (m *RevisionHistory) Size() (n int) {
 	}
 	l = m.InitiatedBy.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ManifestsDigest)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.ManifestsDigests) > 0 {
+		for _, s := range m.ManifestsDigests {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -19667,6 +21011,21 @@ func (m *SecretRef) Size() (n int) {
 	return n
 }
 
+func (m *ServerSideApplyConflictPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Action)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *SignatureKey) Size() (n int) {
 	if m == nil {
 		return 0
@@ -19776,6 +21135,9 @@ func (m *SyncOperation) Size() (n int) {
 		}
 	}
 	n += 1 + sovGenerated(uint64(m.SelfHealAttemptsCount))
+	l = len(m.RestoreSnapshot)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
@@ -19828,6 +21190,35 @@ func (m *SyncOperationResult) Size() (n int) {
 		l = m.ManagedNamespaceMetadata.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.Snapshot)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ManifestsDigest)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.ManifestsDigests) > 0 {
+		for _, s := range m.ManifestsDigests {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SyncPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Until.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PausedBy)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.PausedAt != nil {
+		l = m.PausedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -19855,6 +21246,16 @@ func (m *SyncPolicy) Size() (n int) {
 		l = m.ManagedNamespaceMetadata.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Pause != nil {
+		l = m.Pause.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.ServerSideApplyConflicts) > 0 {
+		for _, e := range m.ServerSideApplyConflicts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -19981,6 +21382,33 @@ func (m *SyncWindow) Size() (n int) {
 	n += 2
 	l = len(m.Description)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.ExcludedDates) > 0 {
+		for _, s := range m.ExcludedDates {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Calendar != nil {
+		l = m.Calendar.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ExpiresAt != nil {
+		l = m.ExpiresAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *SyncWindowCalendar) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConfigMap)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -20143,6 +21571,7 @@ func (this *AppProjectSpec) String() string {
 		`SourceNamespaces:` + fmt.Sprintf("%v", this.SourceNamespaces) + `,`,
 		`PermitOnlyProjectScopedClusters:` + fmt.Sprintf("%v", this.PermitOnlyProjectScopedClusters) + `,`,
 		`DestinationServiceAccounts:` + repeatedStringForDestinationServiceAccounts + `,`,
+		`OCISignatureVerification:` + strings.Replace(this.OCISignatureVerification.String(), "OCISignatureVerification", "OCISignatureVerification", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20564,6 +21993,25 @@ func (this *ApplicationSource) String() string {
 		`Chart:` + fmt.Sprintf("%v", this.Chart) + `,`,
 		`Ref:` + fmt.Sprintf("%v", this.Ref) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`CUE:` + strings.Replace(this.CUE.String(), "ApplicationSourceCUE", "ApplicationSourceCUE", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSourceCUE) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTags := "[]CUETag{"
+	for _, f := range this.Tags {
+		repeatedStringForTags += strings.Replace(strings.Replace(f.String(), "CUETag", "CUETag", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForTags += "}"
+	s := strings.Join([]string{`&ApplicationSourceCUE{`,
+		`Package:` + fmt.Sprintf("%v", this.Package) + `,`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`Tags:` + repeatedStringForTags + `,`,
+		`Values:` + strings.Replace(fmt.Sprintf("%v", this.Values), "RawExtension", "runtime.RawExtension", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20595,6 +22043,11 @@ func (this *ApplicationSourceHelm) String() string {
 		repeatedStringForFileParameters += strings.Replace(strings.Replace(f.String(), "HelmFileParameter", "HelmFileParameter", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForFileParameters += "}"
+	repeatedStringForValuesFrom := "[]HelmValuesFromSource{"
+	for _, f := range this.ValuesFrom {
+		repeatedStringForValuesFrom += strings.Replace(strings.Replace(f.String(), "HelmValuesFromSource", "HelmValuesFromSource", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForValuesFrom += "}"
 	s := strings.Join([]string{`&ApplicationSourceHelm{`,
 		`ValueFiles:` + fmt.Sprintf("%v", this.ValueFiles) + `,`,
 		`Parameters:` + repeatedStringForParameters + `,`,
@@ -20611,6 +22064,7 @@ func (this *ApplicationSourceHelm) String() string {
 		`APIVersions:` + fmt.Sprintf("%v", this.APIVersions) + `,`,
 		`SkipTests:` + fmt.Sprintf("%v", this.SkipTests) + `,`,
 		`SkipSchemaValidation:` + fmt.Sprintf("%v", this.SkipSchemaValidation) + `,`,
+		`ValuesFrom:` + repeatedStringForValuesFrom + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20748,6 +22202,11 @@ func (this *ApplicationSpec) String() string {
 		repeatedStringForSources += strings.Replace(strings.Replace(f.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForSources += "}"
+	repeatedStringForSyncWindows := "[]*SyncWindow{"
+	for _, f := range this.SyncWindows {
+		repeatedStringForSyncWindows += strings.Replace(f.String(), "SyncWindow", "SyncWindow", 1) + ","
+	}
+	repeatedStringForSyncWindows += "}"
 	s := strings.Join([]string{`&ApplicationSpec{`,
 		`Source:` + strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1) + `,`,
 		`Destination:` + strings.Replace(strings.Replace(this.Destination.String(), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + `,`,
@@ -20758,6 +22217,7 @@ func (this *ApplicationSpec) String() string {
 		`RevisionHistoryLimit:` + valueToStringGenerated(this.RevisionHistoryLimit) + `,`,
 		`Sources:` + repeatedStringForSources + `,`,
 		`SourceHydrator:` + strings.Replace(this.SourceHydrator.String(), "SourceHydrator", "SourceHydrator", 1) + `,`,
+		`SyncWindows:` + repeatedStringForSyncWindows + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20893,6 +22353,17 @@ func (this *BearerTokenBitbucketCloud) String() string {
 	}, "")
 	return s
 }
+func (this *CUETag) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CUETag{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ChartDetails) String() string {
 	if this == nil {
 		return "nil"
@@ -20955,6 +22426,7 @@ func (this *ClusterCacheInfo) String() string {
 		`ResourcesCount:` + fmt.Sprintf("%v", this.ResourcesCount) + `,`,
 		`APIsCount:` + fmt.Sprintf("%v", this.APIsCount) + `,`,
 		`LastCacheSyncTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCacheSyncTime), "Time", "v1.Time", 1) + `,`,
+		`EventsPerMinute:` + fmt.Sprintf("%v", this.EventsPerMinute) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21207,6 +22679,17 @@ func (this *ExecProviderConfig) String() string {
 	}, "")
 	return s
 }
+func (this *FieldManagerConflict) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FieldManagerConflict{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Manager:` + fmt.Sprintf("%v", this.Manager) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GitDirectoryGeneratorItem) String() string {
 	if this == nil {
 		return "nil"
@@ -21342,6 +22825,19 @@ func (this *HelmParameter) String() string {
 	}, "")
 	return s
 }
+func (this *HelmValuesFromSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HelmValuesFromSource{`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Optional:` + fmt.Sprintf("%v", this.Optional) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *HostInfo) String() string {
 	if this == nil {
 		return "nil"
@@ -21708,6 +23204,34 @@ func (this *OCIMetadata) String() string {
 	}, "")
 	return s
 }
+func (this *OCISignatureVerification) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForIdentities := "[]OCISigningIdentity{"
+	for _, f := range this.Identities {
+		repeatedStringForIdentities += strings.Replace(strings.Replace(f.String(), "OCISigningIdentity", "OCISigningIdentity", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForIdentities += "}"
+	s := strings.Join([]string{`&OCISignatureVerification{`,
+		`PublicKeys:` + fmt.Sprintf("%v", this.PublicKeys) + `,`,
+		`Identities:` + repeatedStringForIdentities + `,`,
+		`Attestations:` + fmt.Sprintf("%v", this.Attestations) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OCISigningIdentity) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OCISigningIdentity{`,
+		`Issuer:` + fmt.Sprintf("%v", this.Issuer) + `,`,
+		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Operation) String() string {
 	if this == nil {
 		return "nil"
@@ -21795,6 +23319,23 @@ func (this *OrphanedResourceKey) String() string {
 	}, "")
 	return s
 }
+func (this *OrphanedResourcesCleanup) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForResources := "[]OrphanedResourceKey{"
+	for _, f := range this.Resources {
+		repeatedStringForResources += strings.Replace(strings.Replace(f.String(), "OrphanedResourceKey", "OrphanedResourceKey", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForResources += "}"
+	s := strings.Join([]string{`&OrphanedResourcesCleanup{`,
+		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`Resources:` + repeatedStringForResources + `,`,
+		`GracePeriod:` + fmt.Sprintf("%v", this.GracePeriod) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *OrphanedResourcesMonitorSettings) String() string {
 	if this == nil {
 		return "nil"
@@ -21807,6 +23348,8 @@ func (this *OrphanedResourcesMonitorSettings) String() string {
 	s := strings.Join([]string{`&OrphanedResourcesMonitorSettings{`,
 		`Warn:` + valueToStringGenerated(this.Warn) + `,`,
 		`Ignore:` + repeatedStringForIgnore + `,`,
+		`Adopt:` + valueToStringGenerated(this.Adopt) + `,`,
+		`Cleanup:` + strings.Replace(this.Cleanup.String(), "OrphanedResourcesCleanup", "OrphanedResourcesCleanup", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22121,6 +23664,7 @@ func (this *Repository) String() string {
 		`UseAzureWorkloadIdentity:` + fmt.Sprintf("%v", this.UseAzureWorkloadIdentity) + `,`,
 		`BearerToken:` + fmt.Sprintf("%v", this.BearerToken) + `,`,
 		`InsecureOCIForceHttp:` + fmt.Sprintf("%v", this.InsecureOCIForceHttp) + `,`,
+		`EnablePartialClone:` + fmt.Sprintf("%v", this.EnablePartialClone) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22252,6 +23796,24 @@ func (this *ResourceDiff) String() string {
 	}, "")
 	return s
 }
+func (this *ResourceDriftEpisode) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceDriftEpisode{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`DriftedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.DriftedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`FieldManagers:` + fmt.Sprintf("%v", this.FieldManagers) + `,`,
+		`HealedAt:` + strings.Replace(fmt.Sprintf("%v", this.HealedAt), "Time", "v1.Time", 1) + `,`,
+		`HealType:` + fmt.Sprintf("%v", this.HealType) + `,`,
+		`HealedBy:` + fmt.Sprintf("%v", this.HealedBy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ResourceIgnoreDifferences) String() string {
 	if this == nil {
 		return "nil"
@@ -22326,6 +23888,11 @@ func (this *ResourceNode) String() string {
 		repeatedStringForInfo += strings.Replace(strings.Replace(f.String(), "InfoItem", "InfoItem", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForInfo += "}"
+	repeatedStringForConflicts := "[]FieldManagerConflict{"
+	for _, f := range this.Conflicts {
+		repeatedStringForConflicts += strings.Replace(strings.Replace(f.String(), "FieldManagerConflict", "FieldManagerConflict", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForConflicts += "}"
 	s := strings.Join([]string{`&ResourceNode{`,
 		`ResourceRef:` + strings.Replace(strings.Replace(this.ResourceRef.String(), "ResourceRef", "ResourceRef", 1), `&`, ``, 1) + `,`,
 		`ParentRefs:` + repeatedStringForParentRefs + `,`,
@@ -22335,6 +23902,7 @@ func (this *ResourceNode) String() string {
 		`Images:` + fmt.Sprintf("%v", this.Images) + `,`,
 		`Health:` + strings.Replace(this.Health.String(), "HealthStatus", "HealthStatus", 1) + `,`,
 		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Time", "v1.Time", 1) + `,`,
+		`Conflicts:` + repeatedStringForConflicts + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22355,6 +23923,7 @@ func (this *ResourceOverride) String() string {
 		`KnownTypeFields:` + repeatedStringForKnownTypeFields + `,`,
 		`UseOpenLibs:` + fmt.Sprintf("%v", this.UseOpenLibs) + `,`,
 		`IgnoreResourceUpdates:` + strings.Replace(strings.Replace(this.IgnoreResourceUpdates.String(), "OverrideIgnoreDiff", "OverrideIgnoreDiff", 1), `&`, ``, 1) + `,`,
+		`HealthCEL:` + fmt.Sprintf("%v", this.HealthCEL) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22378,6 +23947,11 @@ func (this *ResourceResult) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForConflicts := "[]FieldManagerConflict{"
+	for _, f := range this.Conflicts {
+		repeatedStringForConflicts += strings.Replace(strings.Replace(f.String(), "FieldManagerConflict", "FieldManagerConflict", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForConflicts += "}"
 	s := strings.Join([]string{`&ResourceResult{`,
 		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
 		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
@@ -22390,6 +23964,7 @@ func (this *ResourceResult) String() string {
 		`HookPhase:` + fmt.Sprintf("%v", this.HookPhase) + `,`,
 		`SyncPhase:` + fmt.Sprintf("%v", this.SyncPhase) + `,`,
 		`Images:` + fmt.Sprintf("%v", this.Images) + `,`,
+		`Conflicts:` + repeatedStringForConflicts + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22421,6 +23996,7 @@ func (this *RetryStrategy) String() string {
 	s := strings.Join([]string{`&RetryStrategy{`,
 		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
 		`Backoff:` + strings.Replace(this.Backoff.String(), "Backoff", "Backoff", 1) + `,`,
+		`FailedResourcesOnly:` + fmt.Sprintf("%v", this.FailedResourcesOnly) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22443,6 +24019,8 @@ func (this *RevisionHistory) String() string {
 		`Sources:` + repeatedStringForSources + `,`,
 		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
 		`InitiatedBy:` + strings.Replace(strings.Replace(this.InitiatedBy.String(), "OperationInitiator", "OperationInitiator", 1), `&`, ``, 1) + `,`,
+		`ManifestsDigest:` + fmt.Sprintf("%v", this.ManifestsDigest) + `,`,
+		`ManifestsDigests:` + fmt.Sprintf("%v", this.ManifestsDigests) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22645,6 +24223,18 @@ func (this *SecretRef) String() string {
 	}, "")
 	return s
 }
+func (this *ServerSideApplyConflictPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ServerSideApplyConflictPolicy{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SignatureKey) String() string {
 	if this == nil {
 		return "nil"
@@ -22716,6 +24306,8 @@ func (this *SyncOperation) String() string {
 		`Sources:` + repeatedStringForSources + `,`,
 		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
 		`SelfHealAttemptsCount:` + fmt.Sprintf("%v", this.SelfHealAttemptsCount) + `,`,
+		`RestoreSnapshot:` + fmt.Sprintf("%v", this.RestoreSnapshot) + `,`,
+		`FailedResourcesOnly:` + fmt.Sprintf("%v", this.FailedResourcesOnly) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22754,6 +24346,22 @@ func (this *SyncOperationResult) String() string {
 		`Sources:` + repeatedStringForSources + `,`,
 		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
 		`ManagedNamespaceMetadata:` + strings.Replace(this.ManagedNamespaceMetadata.String(), "ManagedNamespaceMetadata", "ManagedNamespaceMetadata", 1) + `,`,
+		`Snapshot:` + fmt.Sprintf("%v", this.Snapshot) + `,`,
+		`ManifestsDigest:` + fmt.Sprintf("%v", this.ManifestsDigest) + `,`,
+		`ManifestsDigests:` + fmt.Sprintf("%v", this.ManifestsDigests) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncPause) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncPause{`,
+		`Until:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Until), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`PausedBy:` + fmt.Sprintf("%v", this.PausedBy) + `,`,
+		`PausedAt:` + strings.Replace(fmt.Sprintf("%v", this.PausedAt), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22762,11 +24370,18 @@ func (this *SyncPolicy) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForServerSideApplyConflicts := "[]ServerSideApplyConflictPolicy{"
+	for _, f := range this.ServerSideApplyConflicts {
+		repeatedStringForServerSideApplyConflicts += strings.Replace(strings.Replace(f.String(), "ServerSideApplyConflictPolicy", "ServerSideApplyConflictPolicy", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForServerSideApplyConflicts += "}"
 	s := strings.Join([]string{`&SyncPolicy{`,
 		`Automated:` + strings.Replace(this.Automated.String(), "SyncPolicyAutomated", "SyncPolicyAutomated", 1) + `,`,
 		`SyncOptions:` + fmt.Sprintf("%v", this.SyncOptions) + `,`,
 		`Retry:` + strings.Replace(this.Retry.String(), "RetryStrategy", "RetryStrategy", 1) + `,`,
 		`ManagedNamespaceMetadata:` + strings.Replace(this.ManagedNamespaceMetadata.String(), "ManagedNamespaceMetadata", "ManagedNamespaceMetadata", 1) + `,`,
+		`Pause:` + strings.Replace(this.Pause.String(), "SyncPause", "SyncPause", 1) + `,`,
+		`ServerSideApplyConflicts:` + repeatedStringForServerSideApplyConflicts + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22854,6 +24469,20 @@ func (this *SyncWindow) String() string {
 		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
 		`UseAndOperator:` + fmt.Sprintf("%v", this.UseAndOperator) + `,`,
 		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`ExcludedDates:` + fmt.Sprintf("%v", this.ExcludedDates) + `,`,
+		`Calendar:` + strings.Replace(this.Calendar.String(), "SyncWindowCalendar", "SyncWindowCalendar", 1) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncWindowCalendar) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncWindowCalendar{`,
+		`ConfigMap:` + fmt.Sprintf("%v", this.ConfigMap) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -23940,6 +25569,42 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OCISignatureVerification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OCISignatureVerification == nil {
+				m.OCISignatureVerification = &OCISignatureVerification{}
+			}
+			if err := m.OCISignatureVerification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29112,6 +30777,42 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CUE", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CUE == nil {
+				m.CUE = &ApplicationSourceCUE{}
+			}
+			if err := m.CUE.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29133,7 +30834,7 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSourceCUE) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29156,17 +30857,17 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourceDirectory: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSourceCUE: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourceDirectory: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSourceCUE: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recurse", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Package", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29176,17 +30877,29 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Recurse = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Package = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jsonnet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29196,30 +30909,29 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Jsonnet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29229,29 +30941,31 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Exclude = string(dAtA[iNdEx:postIndex])
+			m.Tags = append(m.Tags, CUETag{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29261,23 +30975,27 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Include = string(dAtA[iNdEx:postIndex])
+			if m.Values == nil {
+				m.Values = &runtime.RawExtension{}
+			}
+			if err := m.Values.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29300,7 +31018,7 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29323,17 +31041,17 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourceHelm: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSourceDirectory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourceHelm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSourceDirectory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFiles", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recurse", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29343,27 +31061,15 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValueFiles = append(m.ValueFiles, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.Recurse = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Jsonnet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29390,14 +31096,13 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, HelmParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Jsonnet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29425,11 +31130,11 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReleaseName = string(dAtA[iNdEx:postIndex])
+			m.Exclude = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29457,45 +31162,225 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Values = string(dAtA[iNdEx:postIndex])
+			m.Include = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileParameters", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FileParameters = append(m.FileParameters, HelmFileParameter{})
-			if err := m.FileParameters[len(m.FileParameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourceHelm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourceHelm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueFiles = append(m.ValueFiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, HelmParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleaseName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileParameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileParameters = append(m.FileParameters, HelmFileParameter{})
+			if err := m.FileParameters[len(m.FileParameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29757,6 +31642,40 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.SkipSchemaValidation = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValuesFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValuesFrom = append(m.ValuesFrom, HelmValuesFromSource{})
+			if err := m.ValuesFrom[len(m.ValuesFrom)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -31337,6 +33256,40 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncWindows = append(m.SyncWindows, &SyncWindow{})
+			if err := m.SyncWindows[len(m.SyncWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32702,7 +34655,7 @@ func (m *BearerTokenBitbucketCloud) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChartDetails) Unmarshal(dAtA []byte) error {
+func (m *CUETag) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32725,15 +34678,15 @@ func (m *ChartDetails) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChartDetails: wiretype end group for non-group")
+			return fmt.Errorf("proto: CUETag: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChartDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CUETag: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32761,43 +34714,11 @@ func (m *ChartDetails) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Home", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Home = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Maintainers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32825,7 +34746,153 @@ func (m *ChartDetails) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Maintainers = append(m.Maintainers, string(dAtA[iNdEx:postIndex]))
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChartDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChartDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChartDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Home", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Home = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maintainers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Maintainers = append(m.Maintainers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -33590,6 +35657,25 @@ func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventsPerMinute", wireType)
+			}
+			m.EventsPerMinute = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventsPerMinute |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -36556,7 +38642,7 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
+func (m *FieldManagerConflict) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36579,15 +38665,15 @@ func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitDirectoryGeneratorItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: FieldManagerConflict: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitDirectoryGeneratorItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FieldManagerConflict: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36615,81 +38701,11 @@ func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Field = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Exclude = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GitFileGeneratorItem) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GitFileGeneratorItem: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitFileGeneratorItem: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36717,28 +38733,212 @@ func (m *GitFileGeneratorItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Manager = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Exclude = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GitDirectoryGeneratorItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GitDirectoryGeneratorItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exclude = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GitFileGeneratorItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GitFileGeneratorItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GitFileGeneratorItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exclude = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -37836,59 +40036,225 @@ func (m *HelmOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValuesFileSchemes = append(m.ValuesFileSchemes, string(dAtA[iNdEx:postIndex]))
+			m.ValuesFileSchemes = append(m.ValuesFileSchemes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HelmParameter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HelmParameter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HelmParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceString", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceString = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HelmValuesFromSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HelmValuesFromSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HelmValuesFromSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HelmParameter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HelmParameter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmParameter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -37920,9 +40286,9 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37950,11 +40316,11 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceString", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Optional", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -37971,7 +40337,7 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.ForceString = bool(v != 0)
+			m.Optional = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -41606,13 +43972,223 @@ func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageURL = string(dAtA[iNdEx:postIndex])
+			m.ImageURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocsURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocsURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OCISignatureVerification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OCISignatureVerification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OCISignatureVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocsURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identities", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41622,27 +44198,29 @@ func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DocsURL = string(dAtA[iNdEx:postIndex])
+			m.Identities = append(m.Identities, OCISigningIdentity{})
+			if err := m.Identities[len(m.Identities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41670,11 +44248,61 @@ func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceURL = string(dAtA[iNdEx:postIndex])
+			m.Attestations = append(m.Attestations, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OCISigningIdentity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OCISigningIdentity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OCISigningIdentity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41702,11 +44330,11 @@ func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Issuer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41734,7 +44362,7 @@ func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Subject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -42721,6 +45349,158 @@ func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *OrphanedResourcesCleanup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrphanedResourcesCleanup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrphanedResourcesCleanup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Selector == nil {
+				m.Selector = &v1.LabelSelector{}
+			}
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, OrphanedResourceKey{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GracePeriod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -42805,6 +45585,63 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Adopt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Adopt = &b
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cleanup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cleanup == nil {
+				m.Cleanup = &OrphanedResourcesCleanup{}
+			}
+			if err := m.Cleanup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -47466,6 +50303,26 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.InsecureOCIForceHttp = bool(v != 0)
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnablePartialClone", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnablePartialClone = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -47933,7 +50790,321 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceAction) Unmarshal(dAtA []byte) error {
+func (m *ResourceAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = append(m.Params, ResourceActionParam{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IconClass", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IconClass = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceActionDefinition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceActionDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionLua", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionLua = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -47956,10 +51127,10 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceAction: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceActionParam: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceAction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceActionParam: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -47996,9 +51167,9 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48008,49 +51179,27 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Params = append(m.Params, ResourceActionParam{})
-			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Disabled = bool(v != 0)
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IconClass", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48078,11 +51227,11 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IconClass = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48110,7 +51259,7 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			m.Default = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -48133,7 +51282,7 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
+func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48156,15 +51305,15 @@ func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActionDefinition: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceActions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActionDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceActions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionDiscoveryLua", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48192,13 +51341,13 @@ func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ActionDiscoveryLua = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionLua", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Definitions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48208,24 +51357,46 @@ func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActionLua = string(dAtA[iNdEx:postIndex])
+			m.Definitions = append(m.Definitions, ResourceActionDefinition{})
+			if err := m.Definitions[len(m.Definitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeBuiltinActions", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MergeBuiltinActions = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -48247,7 +51418,7 @@ func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
+func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48270,15 +51441,15 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActionParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActionParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48306,11 +51477,11 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48338,11 +51509,11 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48370,11 +51541,11 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48402,61 +51573,127 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Default = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetState", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceActions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.TargetState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveState", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.LiveState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Diff = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Hook = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionDiscoveryLua", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedLiveState", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48484,13 +51721,13 @@ func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActionDiscoveryLua = string(dAtA[iNdEx:postIndex])
+			m.NormalizedLiveState = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Definitions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PredictedLiveState", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48500,29 +51737,59 @@ func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Definitions = append(m.Definitions, ResourceActionDefinition{})
-			if err := m.Definitions[len(m.Definitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PredictedLiveState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
 			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeBuiltinActions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -48539,7 +51806,7 @@ func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.MergeBuiltinActions = bool(v != 0)
+			m.Modified = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -48561,7 +51828,7 @@ func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
+func (m *ResourceDriftEpisode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48584,10 +51851,10 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceDiff: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceDriftEpisode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceDriftEpisode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -48720,9 +51987,9 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DriftedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48732,27 +51999,28 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetState = string(dAtA[iNdEx:postIndex])
+			if err := m.DriftedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiveState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldManagers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48780,13 +52048,13 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiveState = string(dAtA[iNdEx:postIndex])
+			m.FieldManagers = append(m.FieldManagers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48796,79 +52064,31 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Diff = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Hook = bool(v != 0)
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedLiveState", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			if m.HealedAt == nil {
+				m.HealedAt = &v1.Time{}
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.HealedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.NormalizedLiveState = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PredictedLiveState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48896,11 +52116,11 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PredictedLiveState = string(dAtA[iNdEx:postIndex])
+			m.HealType = ResourceDriftHealType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48928,28 +52148,8 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
+			m.HealedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Modified = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -49951,6 +53151,40 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflicts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conflicts = append(m.Conflicts, FieldManagerConflict{})
+			if err := m.Conflicts[len(m.Conflicts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -50185,6 +53419,38 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthCEL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HealthCEL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -50667,11 +53933,43 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = github_com_argoproj_gitops_engine_pkg_sync_common.ResultCode(dAtA[iNdEx:postIndex])
+			m.Status = github_com_argoproj_gitops_engine_pkg_sync_common.ResultCode(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HookType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -50699,11 +53997,11 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.HookType = github_com_argoproj_gitops_engine_pkg_sync_common.HookType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HookType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HookPhase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -50731,11 +54029,11 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HookType = github_com_argoproj_gitops_engine_pkg_sync_common.HookType(dAtA[iNdEx:postIndex])
+			m.HookPhase = github_com_argoproj_gitops_engine_pkg_sync_common.OperationPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HookPhase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPhase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -50763,11 +54061,11 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HookPhase = github_com_argoproj_gitops_engine_pkg_sync_common.OperationPhase(dAtA[iNdEx:postIndex])
+			m.SyncPhase = github_com_argoproj_gitops_engine_pkg_sync_common.SyncPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncPhase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -50795,13 +54093,13 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyncPhase = github_com_argoproj_gitops_engine_pkg_sync_common.SyncPhase(dAtA[iNdEx:postIndex])
+			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 11:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflicts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50811,23 +54109,25 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
+			m.Conflicts = append(m.Conflicts, FieldManagerConflict{})
+			if err := m.Conflicts[len(m.Conflicts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -51291,6 +54591,26 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedResourcesOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FailedResourcesOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -51593,6 +54913,70 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManifestsDigest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManifestsDigest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManifestsDigests", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManifestsDigests = append(m.ManifestsDigests, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -54316,6 +57700,152 @@ func (m *SecretRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ServerSideApplyConflictPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServerSideApplyConflictPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServerSideApplyConflictPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = ServerSideApplyConflictAction(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SignatureKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -55175,6 +58705,58 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestoreSnapshot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestoreSnapshot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedResourcesOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FailedResourcesOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -55604,6 +59186,285 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snapshot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManifestsDigest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManifestsDigest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManifestsDigests", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManifestsDigests = append(m.ManifestsDigests, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncPause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncPause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncPause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Until.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PausedAt == nil {
+				m.PausedAt = &v1.Time{}
+			}
+			if err := m.PausedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -55794,6 +59655,76 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pause == nil {
+				m.Pause = &SyncPause{}
+			}
+			if err := m.Pause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSideApplyConflicts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerSideApplyConflicts = append(m.ServerSideApplyConflicts, ServerSideApplyConflictPolicy{})
+			if err := m.ServerSideApplyConflicts[len(m.ServerSideApplyConflicts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -56839,6 +60770,224 @@ func (m *SyncWindow) Unmarshal(dAtA []byte) error {
 			}
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludedDates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExcludedDates = append(m.ExcludedDates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calendar", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Calendar == nil {
+				m.Calendar = &SyncWindowCalendar{}
+			}
+			if err := m.Calendar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = &v1.Time{}
+			}
+			if err := m.ExpiresAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncWindowCalendar) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncWindowCalendar: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncWindowCalendar: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigMap = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -8,6 +8,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/oci"
 
 	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/util/archive"
 	"github.com/argoproj/argo-cd/v3/util/cert"
 	"github.com/argoproj/argo-cd/v3/util/git"
 	"github.com/argoproj/argo-cd/v3/util/helm"
@@ -114,6 +115,9 @@ type Repository struct {
 	BearerToken string `json:"bearerToken,omitempty" protobuf:"bytes,25,opt,name=bearerToken"`
 	// InsecureOCIForceHttp specifies whether the connection to the repository uses TLS at _all_. If true, no TLS. This flag is applicable for OCI repos only.
 	InsecureOCIForceHttp bool `json:"insecureOCIForceHttp,omitempty" protobuf:"bytes,26,opt,name=insecureOCIForceHttp"` //nolint:revive //FIXME(var-naming)
+	// EnablePartialClone specifies whether the repo is cloned without file contents and only the paths used by the
+	// applications are checked out. Only valid for Git repositories.
+	EnablePartialClone bool `json:"enablePartialClone,omitempty" protobuf:"bytes,27,opt,name=enablePartialClone"`
 }
 
 // IsInsecure returns true if the repository has been configured to skip server verification or set to HTTP only
@@ -285,6 +289,19 @@ func (repo *Repository) GetOCICreds() oci.Creds {
 	}
 }
 
+// GetArchiveCreds returns the credentials from a repository configuration used to authenticate an archive repository
+func (repo *Repository) GetArchiveCreds() archive.Creds {
+	return archive.Creds{
+		Username:           repo.Username,
+		Password:           repo.Password,
+		BearerToken:        repo.BearerToken,
+		CAPath:             getCAPath(repo.Repo),
+		CertData:           []byte(repo.TLSClientCertData),
+		KeyData:            []byte(repo.TLSClientCertKey),
+		InsecureSkipVerify: repo.Insecure,
+	}
+}
+
 func getCAPath(repoURL string) string {
 	// For git ssh protocol url without ssh://, url.Parse() will fail to parse.
 	// However, no warn log is output since ssh scheme url is a possible format.
@@ -327,6 +344,7 @@ func getCAPath(repoURL string) string {
 func (repo *Repository) CopySettingsFrom(source *Repository) {
 	if source != nil {
 		repo.EnableLFS = source.EnableLFS
+		repo.EnablePartialClone = source.EnablePartialClone
 		repo.InsecureIgnoreHostKey = source.InsecureIgnoreHostKey
 		repo.Insecure = source.Insecure
 		repo.InheritedCreds = source.InheritedCreds
@@ -350,6 +368,7 @@ func (repo *Repository) Sanitized() *Repository {
 		Username:                   repo.Username,
 		Insecure:                   repo.IsInsecure(),
 		EnableLFS:                  repo.EnableLFS,
+		EnablePartialClone:         repo.EnablePartialClone,
 		EnableOCI:                  repo.EnableOCI,
 		Proxy:                      repo.Proxy,
 		NoProxy:                    repo.NoProxy,
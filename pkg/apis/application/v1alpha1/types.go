@@ -13,6 +13,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -29,6 +30,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -42,7 +44,10 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/rbac"
 
 	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/util/archive"
+	"github.com/argoproj/argo-cd/v3/util/cosign"
 	"github.com/argoproj/argo-cd/v3/util/env"
+	"github.com/argoproj/argo-cd/v3/util/glob"
 	"github.com/argoproj/argo-cd/v3/util/helm"
 	utilhttp "github.com/argoproj/argo-cd/v3/util/http"
 	"github.com/argoproj/argo-cd/v3/util/security"
@@ -92,6 +97,11 @@ type ApplicationSpec struct {
 
 	// SourceHydrator provides a way to push hydrated manifests back to git before syncing them to the cluster.
 	SourceHydrator *SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,9,opt,name=sourceHydrator"`
+
+	// SyncWindows controls when syncs can be run for this application, in addition to the sync windows of its project.
+	// The applications, namespaces and clusters of these windows are ignored, as they always apply to this application.
+	// Only deny windows are supported, so that these windows can only narrow the schedule of the project.
+	SyncWindows SyncWindows `json:"syncWindows,omitempty" protobuf:"bytes,10,opt,name=syncWindows"`
 }
 
 type IgnoreDifferences []ResourceIgnoreDifferences
@@ -204,6 +214,8 @@ type ApplicationSource struct {
 	Ref string `json:"ref,omitempty" protobuf:"bytes,13,opt,name=ref"`
 	// Name is used to refer to a source and is displayed in the UI. It is used in multi-source Applications.
 	Name string `json:"name,omitempty" protobuf:"bytes,14,opt,name=name"`
+	// CUE holds CUE specific options
+	CUE *ApplicationSourceCUE `json:"cue,omitempty" protobuf:"bytes,15,opt,name=cue"`
 }
 
 // ApplicationSources contains list of required information about the sources of an application
@@ -306,6 +318,12 @@ func (source *ApplicationSource) IsOCI() bool {
 	return strings.HasPrefix(source.RepoURL, "oci://")
 }
 
+// IsArchive returns true when the application source is an archive of manifests, which is fetched over HTTP(S) or
+// from an S3 compatible endpoint
+func (source *ApplicationSource) IsArchive() bool {
+	return !source.IsHelm() && archive.IsArchiveURL(source.RepoURL)
+}
+
 // IsRef returns true when the application source is of type Ref
 func (source *ApplicationSource) IsRef() bool {
 	return source.Ref != ""
@@ -333,7 +351,8 @@ func (source *ApplicationSource) IsZero() bool {
 			source.Helm.IsZero() &&
 			source.Kustomize.IsZero() &&
 			source.Directory.IsZero() &&
-			source.Plugin.IsZero()
+			source.Plugin.IsZero() &&
+			source.CUE.IsZero()
 }
 
 // GetNamespaceOrDefault gets the static namespace configured in the source. If none is configured, returns the given
@@ -389,6 +408,7 @@ const (
 	ApplicationSourceTypeKustomize ApplicationSourceType = "Kustomize"
 	ApplicationSourceTypeDirectory ApplicationSourceType = "Directory"
 	ApplicationSourceTypePlugin    ApplicationSourceType = "Plugin"
+	ApplicationSourceTypeCUE       ApplicationSourceType = "CUE"
 )
 
 // SourceHydrator specifies a dry "don't repeat yourself" source for manifests, a sync source from which to sync
@@ -526,6 +546,40 @@ type ApplicationSourceHelm struct {
 	SkipTests bool `json:"skipTests,omitempty" protobuf:"bytes,14,opt,name=skipTests"`
 	// SkipSchemaValidation skips JSON schema validation (Helm's --skip-schema-validation)
 	SkipSchemaValidation bool `json:"skipSchemaValidation,omitempty" protobuf:"bytes,15,opt,name=skipSchemaValidation"`
+	// ValuesFrom is a list of ConfigMaps and Secrets in the namespace of the Application holding Helm values. They are
+	// passed to helm template after the ValueFiles and before Values/ValuesObject, in the order they are listed.
+	ValuesFrom []HelmValuesFromSource `json:"valuesFrom,omitempty" protobuf:"bytes,16,rep,name=valuesFrom"`
+}
+
+const (
+	// HelmValuesFromKindConfigMap references Helm values stored in a ConfigMap
+	HelmValuesFromKindConfigMap = "ConfigMap"
+	// HelmValuesFromKindSecret references Helm values stored in a Secret
+	HelmValuesFromKindSecret = "Secret"
+	// HelmValuesFromDefaultKey is the key of the Helm values in the ConfigMap or Secret if no key is given
+	HelmValuesFromDefaultKey = "values.yaml"
+)
+
+// HelmValuesFromSource references Helm values stored in a ConfigMap or Secret in the namespace of the Application. The
+// ConfigMap or Secret must be labeled with argocd.argoproj.io/helm-values=true to be used as a values source.
+type HelmValuesFromSource struct {
+	// Kind is the kind of the resource holding the values, either ConfigMap or Secret
+	// +kubebuilder:validation:Enum=ConfigMap;Secret
+	Kind string `json:"kind" protobuf:"bytes,1,opt,name=kind"`
+	// Name is the name of the ConfigMap or Secret
+	Name string `json:"name" protobuf:"bytes,2,opt,name=name"`
+	// Key is the key of the values in the ConfigMap or Secret. Defaults to values.yaml
+	Key string `json:"key,omitempty" protobuf:"bytes,3,opt,name=key"`
+	// Optional prevents manifest generation from failing when the ConfigMap or Secret, or the key, does not exist
+	Optional bool `json:"optional,omitempty" protobuf:"varint,4,opt,name=optional"`
+}
+
+// GetKey returns the key of the values in the ConfigMap or Secret
+func (s HelmValuesFromSource) GetKey() string {
+	if s.Key == "" {
+		return HelmValuesFromDefaultKey
+	}
+	return s.Key
 }
 
 // HelmParameter is a parameter that's passed to helm template during manifest generation
@@ -607,7 +661,7 @@ func (ash *ApplicationSourceHelm) AddFileParameter(p HelmFileParameter) {
 
 // IsZero Returns true if the Helm options in an application source are considered zero
 func (ash *ApplicationSourceHelm) IsZero() bool {
-	return ash == nil || (ash.Version == "") && (ash.ReleaseName == "") && len(ash.ValueFiles) == 0 && len(ash.Parameters) == 0 && len(ash.FileParameters) == 0 && ash.ValuesIsEmpty() && !ash.PassCredentials && !ash.IgnoreMissingValueFiles && !ash.SkipCrds && !ash.SkipTests && !ash.SkipSchemaValidation && ash.KubeVersion == "" && len(ash.APIVersions) == 0 && ash.Namespace == ""
+	return ash == nil || (ash.Version == "") && (ash.ReleaseName == "") && len(ash.ValueFiles) == 0 && len(ash.Parameters) == 0 && len(ash.FileParameters) == 0 && ash.ValuesIsEmpty() && !ash.PassCredentials && !ash.IgnoreMissingValueFiles && !ash.SkipCrds && !ash.SkipTests && !ash.SkipSchemaValidation && ash.KubeVersion == "" && len(ash.APIVersions) == 0 && ash.Namespace == "" && len(ash.ValuesFrom) == 0
 }
 
 // KustomizeImage represents a Kustomize image definition in the format [old_image_name=]<image_name>:<image_tag>
@@ -871,6 +925,62 @@ func (d *ApplicationSourceDirectory) IsZero() bool {
 	return d == nil || !d.Recurse && d.Jsonnet.IsZero()
 }
 
+// CUETag is the value of a field annotated with a @tag() attribute in a CUE package
+type CUETag struct {
+	// Name is the name of the tag
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Value is the value of the tag
+	Value string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
+}
+
+// NewCUETag parses a CUE tag from a string in the format name=value
+func NewCUETag(s string) CUETag {
+	parts := strings.SplitN(s, "=", 2)
+	if len(parts) == 2 {
+		return CUETag{Name: parts[0], Value: parts[1]}
+	}
+	return CUETag{Name: s}
+}
+
+// ApplicationSourceCUE holds options specific to applications of type CUE
+type ApplicationSourceCUE struct {
+	// Package is the name of the CUE package to evaluate in the path of the source. Defaults to the only package in the path.
+	Package string `json:"package,omitempty" protobuf:"bytes,1,opt,name=package"`
+	// Expression selects the manifests within the evaluated package, e.g. `objects`. Defaults to the whole package.
+	Expression string `json:"expression,omitempty" protobuf:"bytes,2,opt,name=expression"`
+	// Tags is a list of values injected into the fields annotated with @tag() attributes
+	Tags []CUETag `json:"tags,omitempty" protobuf:"bytes,3,rep,name=tags"`
+	// Values is unified with the evaluated package before the expression is selected, defined as a map.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Values *runtime.RawExtension `json:"values,omitempty" protobuf:"bytes,4,opt,name=values"`
+}
+
+// IsZero returns true if the CUE options of an application are considered to be empty
+func (c *ApplicationSourceCUE) IsZero() bool {
+	return c == nil || c.Package == "" && c.Expression == "" && len(c.Tags) == 0 && (c.Values == nil || len(c.Values.Raw) == 0)
+}
+
+// AddTag adds a CUE tag to the application source. If a tag with the same name already exists, it is overwritten.
+func (c *ApplicationSourceCUE) AddTag(tag CUETag) {
+	for i, t := range c.Tags {
+		if t.Name == tag.Name {
+			c.Tags[i] = tag
+			return
+		}
+	}
+	c.Tags = append(c.Tags, tag)
+}
+
+// RemoveTag removes the CUE tag with the given name from the application source
+func (c *ApplicationSourceCUE) RemoveTag(name string) {
+	for i, t := range c.Tags {
+		if t.Name == name {
+			c.Tags = append(c.Tags[:i], c.Tags[i+1:]...)
+			return
+		}
+	}
+}
+
 type OptionalMap struct {
 	// Map is the value of a map type parameter.
 	// +optional
@@ -1363,6 +1473,12 @@ type SyncOperation struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,11,opt,name=revisions"`
 	// SelfHealAttemptsCount contains the number of auto-heal attempts
 	SelfHealAttemptsCount int64 `json:"autoHealAttemptsCount,omitempty" protobuf:"bytes,12,opt,name=autoHealAttemptsCount"`
+	// RestoreSnapshot is the ID of a snapshot of the live state taken by a previous sync operation. If set, the
+	// resources of the snapshot are synced instead of the resources of the application source
+	RestoreSnapshot string `json:"restoreSnapshot,omitempty" protobuf:"bytes,13,opt,name=restoreSnapshot"`
+	// FailedResourcesOnly is true if the operation retries the resources which failed in a previous sync operation. Unlike
+	// other selective syncs, it runs the hooks selected by its resources
+	FailedResourcesOnly bool `json:"failedResourcesOnly,omitempty" protobuf:"bytes,14,opt,name=failedResourcesOnly"`
 }
 
 // IsApplyStrategy returns true if the sync strategy is "apply"
@@ -1370,6 +1486,27 @@ func (o *SyncOperation) IsApplyStrategy() bool {
 	return o.SyncStrategy != nil && o.SyncStrategy.Apply != nil
 }
 
+// RetryFailedResources returns a copy of the sync operation which only syncs the resources that failed in the given result,
+// pinned to the revisions the result was performed against. Returns nil if no resource failed.
+func (o *SyncOperation) RetryFailedResources(result *SyncOperationResult) *SyncOperation {
+	if result == nil {
+		return nil
+	}
+	resources := result.Resources.FailedResources()
+	if len(resources) == 0 {
+		return nil
+	}
+	retry := o.DeepCopy()
+	retry.Resources = resources
+	retry.FailedResourcesOnly = true
+	if len(result.Revisions) > 0 {
+		retry.Revisions = slices.Clone(result.Revisions)
+	} else if result.Revision != "" {
+		retry.Revision = result.Revision
+	}
+	return retry
+}
+
 // OperationState contains information about state of a running operation
 type OperationState struct {
 	// Operation is the original requested operation
@@ -1442,9 +1579,62 @@ type SyncPolicy struct {
 	Retry *RetryStrategy `json:"retry,omitempty" protobuf:"bytes,3,opt,name=retry"`
 	// ManagedNamespaceMetadata controls metadata in the given namespace (if CreateNamespace=true)
 	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,4,opt,name=managedNamespaceMetadata"`
+	// Pause temporarily suspends automated sync and self-heal. It is removed by the controller once it has expired.
+	Pause *SyncPause `json:"pause,omitempty" protobuf:"bytes,5,opt,name=pause"`
+	// ServerSideApplyConflicts controls, per resource kind, how conflicts with other field managers are handled when
+	// resources are synced using server-side apply. The first matching policy applies.
+	ServerSideApplyConflicts []ServerSideApplyConflictPolicy `json:"serverSideApplyConflicts,omitempty" protobuf:"bytes,6,rep,name=serverSideApplyConflicts"`
 	// If you add a field here, be sure to update IsZero.
 }
 
+// ServerSideApplyConflictAction is the action taken when server-side apply conflicts with other field managers
+// +kubebuilder:validation:Enum=fail;warn;force
+type ServerSideApplyConflictAction string
+
+const (
+	// ServerSideApplyConflictActionFail fails the sync of a resource which conflicts with other field managers
+	ServerSideApplyConflictActionFail ServerSideApplyConflictAction = "fail"
+	// ServerSideApplyConflictActionWarn takes over the conflicting fields and reports the conflicts in the sync result message
+	ServerSideApplyConflictActionWarn ServerSideApplyConflictAction = "warn"
+	// ServerSideApplyConflictActionForce takes over the conflicting fields
+	ServerSideApplyConflictActionForce ServerSideApplyConflictAction = "force"
+)
+
+// ServerSideApplyConflictPolicy defines how server-side apply conflicts are handled for matching resources
+type ServerSideApplyConflictPolicy struct {
+	// Group is the API group of the matching resources. Supports glob patterns.
+	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	// Kind is the kind of the matching resources. Supports glob patterns.
+	Kind string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	// Action is the action taken on conflicts. One of fail, warn or force.
+	Action ServerSideApplyConflictAction `json:"action" protobuf:"bytes,3,opt,name=action,casttype=ServerSideApplyConflictAction"`
+}
+
+// FieldManagerConflict is a field whose value is set by the application while it is owned by another field manager
+type FieldManagerConflict struct {
+	// Field is the path of the conflicting field, e.g. .spec.replicas
+	Field string `json:"field" protobuf:"bytes,1,opt,name=field"`
+	// Manager is the field manager owning the field in the live state
+	Manager string `json:"manager" protobuf:"bytes,2,opt,name=manager"`
+}
+
+// SyncPause temporarily suspends the automated sync and self-heal of an application
+type SyncPause struct {
+	// Until is the time at which automated sync is resumed
+	Until metav1.Time `json:"until" protobuf:"bytes,1,opt,name=until"`
+	// Reason describes why automated sync was paused
+	Reason string `json:"reason,omitempty" protobuf:"bytes,2,opt,name=reason"`
+	// PausedBy is the user who paused automated sync
+	PausedBy string `json:"pausedBy,omitempty" protobuf:"bytes,3,opt,name=pausedBy"`
+	// PausedAt is the time at which automated sync was paused
+	PausedAt *metav1.Time `json:"pausedAt,omitempty" protobuf:"bytes,4,opt,name=pausedAt"`
+}
+
+// IsActive returns true if automated sync is paused at the given time
+func (p *SyncPause) IsActive(now time.Time) bool {
+	return p != nil && now.Before(p.Until.Time)
+}
+
 // IsAutomatedSyncEnabled checks if the automated sync is enabled or disabled
 func (p *SyncPolicy) IsAutomatedSyncEnabled() bool {
 	if p.Automated != nil && (p.Automated.Enabled == nil || *p.Automated.Enabled) {
@@ -1455,7 +1645,41 @@ func (p *SyncPolicy) IsAutomatedSyncEnabled() bool {
 
 // IsZero returns true if the sync policy is empty
 func (p *SyncPolicy) IsZero() bool {
-	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.ManagedNamespaceMetadata == nil)
+	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.ManagedNamespaceMetadata == nil && p.Pause == nil && len(p.ServerSideApplyConflicts) == 0)
+}
+
+// GetServerSideApplyConflictAction returns the action taken on server-side apply conflicts of resources of the given
+// group and kind. Conflicts are forced unless a policy says otherwise.
+func (p *SyncPolicy) GetServerSideApplyConflictAction(group, kind string) ServerSideApplyConflictAction {
+	if p != nil {
+		for _, policy := range p.ServerSideApplyConflicts {
+			if glob.Match(policy.Group, group) && glob.Match(policy.Kind, kind) {
+				return policy.Action
+			}
+		}
+	}
+	return ServerSideApplyConflictActionForce
+}
+
+// GetPause returns the sync pause of the policy, or nil if automated sync is not paused
+func (p *SyncPolicy) GetPause() *SyncPause {
+	if p == nil {
+		return nil
+	}
+	return p.Pause
+}
+
+// SetSyncPause sets the sync pause of the spec. The sync policy is copied, so that the specs sharing it are not modified.
+func (spec *ApplicationSpec) SetSyncPause(pause *SyncPause) {
+	syncPolicy := spec.SyncPolicy.DeepCopy()
+	if syncPolicy == nil {
+		syncPolicy = &SyncPolicy{}
+	}
+	syncPolicy.Pause = pause.DeepCopy()
+	if syncPolicy.IsZero() {
+		syncPolicy = nil
+	}
+	spec.SyncPolicy = syncPolicy
 }
 
 // RetryStrategy contains information about the strategy to apply when a sync failed
@@ -1464,6 +1688,9 @@ type RetryStrategy struct {
 	Limit int64 `json:"limit,omitempty" protobuf:"bytes,1,opt,name=limit"`
 	// Backoff controls how to backoff on subsequent retries of failed syncs
 	Backoff *Backoff `json:"backoff,omitempty" protobuf:"bytes,2,opt,name=backoff,casttype=Backoff"`
+	// FailedResourcesOnly limits each retry to the resources which failed in the previous attempt, along with the hooks of the operation.
+	// Retries are performed against the revision of the previous attempt.
+	FailedResourcesOnly bool `json:"failedResourcesOnly,omitempty" protobuf:"bytes,3,opt,name=failedResourcesOnly"`
 }
 
 func parseStringToDuration(durationString string) (time.Duration, error) {
@@ -1656,6 +1883,12 @@ type SyncOperationResult struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,5,opt,name=revisions"`
 	// ManagedNamespaceMetadata contains the current sync state of managed namespace metadata
 	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,6,opt,name=managedNamespaceMetadata"`
+	// Snapshot is the ID of the snapshot of the live state of the resources taken before the sync operation modified them
+	Snapshot string `json:"snapshot,omitempty" protobuf:"bytes,7,opt,name=snapshot"`
+	// ManifestsDigest holds the digest of the manifests generated for the source, which is the subject of their provenance attestation
+	ManifestsDigest string `json:"manifestsDigest,omitempty" protobuf:"bytes,8,opt,name=manifestsDigest"`
+	// ManifestsDigests holds the digest of the manifests generated for respective indexed source in sources field
+	ManifestsDigests []string `json:"manifestsDigests,omitempty" protobuf:"bytes,9,opt,name=manifestsDigests"`
 }
 
 // ResourceResult holds the operation result details of a specific resource
@@ -1683,6 +1916,9 @@ type ResourceResult struct {
 	SyncPhase synccommon.SyncPhase `json:"syncPhase,omitempty" protobuf:"bytes,10,opt,name=syncPhase"`
 	// Images contains the images related to the ResourceResult
 	Images []string `json:"images,omitempty" protobuf:"bytes,11,opt,name=images"`
+	// Conflicts lists the fields owned by other field managers which were found conflicting when the resource was
+	// synced using server-side apply
+	Conflicts []FieldManagerConflict `json:"conflicts,omitempty" protobuf:"bytes,12,rep,name=conflicts"`
 }
 
 // GroupVersionKind returns the GVK schema information for a given resource within a sync result
@@ -1707,6 +1943,32 @@ func (r ResourceResults) Find(group string, kind string, namespace string, name
 	return 0, nil
 }
 
+// FailedResources returns the resources which failed to sync, along with all hooks of the operation so that they run again
+// when the failed resources are retried. Returns nil if no resource failed.
+func (r ResourceResults) FailedResources() []SyncOperationResource {
+	var failed, hooks []SyncOperationResource
+	seen := map[SyncOperationResource]bool{}
+	for _, res := range r {
+		resource := SyncOperationResource{Group: res.Group, Kind: res.Kind, Name: res.Name, Namespace: res.Namespace}
+		if seen[resource] {
+			continue
+		}
+		switch {
+		case res.HookType != "":
+			hooks = append(hooks, resource)
+		case res.Status == synccommon.ResultCodeSyncFailed:
+			failed = append(failed, resource)
+		default:
+			continue
+		}
+		seen[resource] = true
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return append(failed, hooks...)
+}
+
 // PruningRequired returns a positive integer containing the number of resources that require pruning after an operation has been completed
 func (r ResourceResults) PruningRequired() (num int) {
 	for _, res := range r {
@@ -1736,6 +1998,10 @@ type RevisionHistory struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,9,opt,name=revisions"`
 	// InitiatedBy contains information about who initiated the operations
 	InitiatedBy OperationInitiator `json:"initiatedBy,omitempty" protobuf:"bytes,10,opt,name=initiatedBy"`
+	// ManifestsDigest holds the digest of the manifests deployed for the source, which is the subject of their provenance attestation
+	ManifestsDigest string `json:"manifestsDigest,omitempty" protobuf:"bytes,11,opt,name=manifestsDigest"`
+	// ManifestsDigests holds the digest of the manifests deployed for each source in sources field
+	ManifestsDigests []string `json:"manifestsDigests,omitempty" protobuf:"bytes,12,opt,name=manifestsDigests"`
 }
 
 // ApplicationWatchEvent contains information about application change.
@@ -1803,6 +2069,10 @@ const (
 	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
 	// ApplicationConditionOrphanedResourceWarning indicates that application has orphaned resources
 	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionSchemaValidationError indicates that application has resources which do not match the OpenAPI schema of the destination cluster
+	ApplicationConditionSchemaValidationError = "SchemaValidationError"
+	// ApplicationConditionSchemaValidationWarning indicates that application has resources which use deprecated API versions of the destination cluster
+	ApplicationConditionSchemaValidationWarning = "SchemaValidationWarning"
 )
 
 // ApplicationCondition contains details about an application condition, which is usually an error or warning
@@ -2091,6 +2361,8 @@ type ResourceNode struct {
 	Health *HealthStatus `json:"health,omitempty" protobuf:"bytes,7,opt,name=health"`
 	// CreatedAt records the timestamp when the resource was created.
 	CreatedAt *metav1.Time `json:"createdAt,omitempty" protobuf:"bytes,8,opt,name=createdAt"`
+	// Conflicts lists the server-side apply conflicts with other field managers found by the last sync of the resource.
+	Conflicts []FieldManagerConflict `json:"conflicts,omitempty" protobuf:"bytes,9,rep,name=conflicts"`
 }
 
 // FullName returns a resource node's full name in the format "group/kind/namespace/name"
@@ -2178,6 +2450,51 @@ func (r *ResourceDiff) FullName() string {
 	return fmt.Sprintf("%s/%s/%s/%s", r.Group, r.Kind, r.Namespace, r.Name)
 }
 
+// ResourceDriftHealType describes how the drift of a resource was healed
+type ResourceDriftHealType string
+
+const (
+	// ResourceDriftHealTypeSelfHeal indicates that the drift was healed by a sync initiated automatically by the application controller
+	ResourceDriftHealTypeSelfHeal ResourceDriftHealType = "SelfHeal"
+	// ResourceDriftHealTypeSync indicates that the drift was healed by a sync initiated by a user
+	ResourceDriftHealTypeSync ResourceDriftHealType = "Sync"
+	// ResourceDriftHealTypeExternal indicates that the resource got back in sync without any Argo CD sync operation
+	// (e.g. the change was reverted in the cluster or the desired state was updated to match the live state)
+	ResourceDriftHealTypeExternal ResourceDriftHealType = "External"
+)
+
+// ResourceDriftEpisode records a period of time during which a managed resource has diverged from its desired state
+type ResourceDriftEpisode struct {
+	// Group represents the API group of the resource
+	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	// Kind represents the kind of the resource
+	Kind string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	// Namespace specifies the namespace of the resource
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,3,opt,name=namespace"`
+	// Name is the name of the resource
+	Name string `json:"name" protobuf:"bytes,4,opt,name=name"`
+	// DriftedAt is the time at which the resource was first observed out of sync
+	DriftedAt metav1.Time `json:"driftedAt" protobuf:"bytes,5,opt,name=driftedAt"`
+	// FieldManagers holds the field managers that last updated the drifted fields of the live resource
+	FieldManagers []string `json:"fieldManagers,omitempty" protobuf:"bytes,6,rep,name=fieldManagers"`
+	// HealedAt is the time at which the resource was observed in sync again. It is not set while the resource is still drifted.
+	HealedAt *metav1.Time `json:"healedAt,omitempty" protobuf:"bytes,7,opt,name=healedAt"`
+	// HealType describes how the drift was healed
+	HealType ResourceDriftHealType `json:"healType,omitempty" protobuf:"bytes,8,opt,name=healType,casttype=ResourceDriftHealType"`
+	// HealedBy contains the name of the user who initiated the sync that healed the drift
+	HealedBy string `json:"healedBy,omitempty" protobuf:"bytes,9,opt,name=healedBy"`
+}
+
+// Key returns the key of the drifted resource
+func (e *ResourceDriftEpisode) Key() kube.ResourceKey {
+	return kube.NewResourceKey(e.Group, e.Kind, e.Namespace, e.Name)
+}
+
+// IsHealed returns true if the resource is no longer drifted
+func (e *ResourceDriftEpisode) IsHealed() bool {
+	return e.HealedAt != nil
+}
+
 // ConnectionStatus represents the status indicator for a connection to a remote resource
 type ConnectionStatus = string
 
@@ -2302,6 +2619,8 @@ type ClusterCacheInfo struct {
 	APIsCount int64 `json:"apisCount,omitempty" protobuf:"bytes,2,opt,name=apisCount"`
 	// LastCacheSyncTime holds time of most recent cache synchronization
 	LastCacheSyncTime *metav1.Time `json:"lastCacheSyncTime,omitempty" protobuf:"bytes,3,opt,name=lastCacheSyncTime"`
+	// EventsPerMinute holds the observed rate of Kubernetes watch events received from the cluster
+	EventsPerMinute int64 `json:"eventsPerMinute,omitempty" protobuf:"bytes,4,opt,name=eventsPerMinute"`
 }
 
 // ClusterList is a collection of Clusters.
@@ -2415,6 +2734,7 @@ type OverrideIgnoreDiff struct {
 type rawResourceOverride struct {
 	HealthLua             string           `json:"health.lua,omitempty"`
 	UseOpenLibs           bool             `json:"health.lua.useOpenLibs,omitempty"`
+	HealthCEL             string           `json:"health.cel,omitempty"`
 	Actions               string           `json:"actions,omitempty"`
 	IgnoreDifferences     string           `json:"ignoreDifferences,omitempty"`
 	IgnoreResourceUpdates string           `json:"ignoreResourceUpdates,omitempty"`
@@ -2427,6 +2747,8 @@ type ResourceOverride struct {
 	HealthLua string `protobuf:"bytes,1,opt,name=healthLua"`
 	// UseOpenLibs indicates whether to use open-source libraries for the resource.
 	UseOpenLibs bool `protobuf:"bytes,5,opt,name=useOpenLibs"`
+	// HealthCEL contains a CEL expression that defines custom health checks for the resource. It takes precedence over HealthLua.
+	HealthCEL string `protobuf:"bytes,7,opt,name=healthCEL"`
 	// Actions defines the set of actions that can be performed on the resource, as a Lua script.
 	Actions string `protobuf:"bytes,3,opt,name=actions"`
 	// IgnoreDifferences contains configuration for which differences should be ignored during the resource diffing.
@@ -2448,6 +2770,7 @@ func (ro *ResourceOverride) UnmarshalJSON(data []byte) error {
 	ro.KnownTypeFields = raw.KnownTypeFields
 	ro.HealthLua = raw.HealthLua
 	ro.UseOpenLibs = raw.UseOpenLibs
+	ro.HealthCEL = raw.HealthCEL
 	ro.Actions = raw.Actions
 	err := yaml.Unmarshal([]byte(raw.IgnoreDifferences), &ro.IgnoreDifferences)
 	if err != nil {
@@ -2471,7 +2794,7 @@ func (ro ResourceOverride) MarshalJSON() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	raw := &rawResourceOverride{ro.HealthLua, ro.UseOpenLibs, ro.Actions, string(ignoreDifferencesData), string(ignoreResourceUpdatesData), ro.KnownTypeFields}
+	raw := &rawResourceOverride{ro.HealthLua, ro.UseOpenLibs, ro.HealthCEL, ro.Actions, string(ignoreDifferencesData), string(ignoreResourceUpdatesData), ro.KnownTypeFields}
 	return json.Marshal(raw)
 }
 
@@ -2645,6 +2968,20 @@ type OrphanedResourcesMonitorSettings struct {
 	Warn *bool `json:"warn,omitempty" protobuf:"bytes,1,name=warn"`
 	// Ignore contains a list of resources that are to be excluded from orphaned resources monitoring
 	Ignore []OrphanedResourceKey `json:"ignore,omitempty" protobuf:"bytes,2,opt,name=ignore"`
+	// Adopt indicates if orphaned resources can be exported to be adopted by the applications of the project
+	Adopt *bool `json:"adopt,omitempty" protobuf:"bytes,3,opt,name=adopt"`
+	// Cleanup configures the automatic deletion of orphaned resources
+	Cleanup *OrphanedResourcesCleanup `json:"cleanup,omitempty" protobuf:"bytes,4,opt,name=cleanup"`
+}
+
+// OrphanedResourcesCleanup configures the automatic deletion of the orphaned resources of the applications of a project
+type OrphanedResourcesCleanup struct {
+	// Selector selects the orphaned resources to delete by their labels. No resource is deleted if the selector is not set.
+	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,1,opt,name=selector"`
+	// Resources optionally restricts the deletion to the orphaned resources matching one of the given keys
+	Resources []OrphanedResourceKey `json:"resources,omitempty" protobuf:"bytes,2,opt,name=resources"`
+	// GracePeriod is the duration for which a resource must remain orphaned before being deleted (e.g. 30m, 24h). Defaults to 24h.
+	GracePeriod string `json:"gracePeriod,omitempty" protobuf:"bytes,3,opt,name=gracePeriod"`
 }
 
 // OrphanedResourceKey is a reference to a resource to be ignored from
@@ -2659,12 +2996,86 @@ func (s *OrphanedResourcesMonitorSettings) IsWarn() bool {
 	return s.Warn != nil && *s.Warn
 }
 
+// IsAdopt returns true if orphaned resources can be exported to be adopted by applications
+func (s *OrphanedResourcesMonitorSettings) IsAdopt() bool {
+	return s.Adopt != nil && *s.Adopt
+}
+
+// DefaultOrphanedResourcesCleanupGracePeriod is the default duration for which a resource must remain orphaned before being deleted
+const DefaultOrphanedResourcesCleanupGracePeriod = 24 * time.Hour
+
+// GetGracePeriod returns the duration for which a resource must remain orphaned before being deleted
+func (c *OrphanedResourcesCleanup) GetGracePeriod() (time.Duration, error) {
+	if c.GracePeriod == "" {
+		return DefaultOrphanedResourcesCleanupGracePeriod, nil
+	}
+	gracePeriod, err := time.ParseDuration(c.GracePeriod)
+	if err != nil {
+		return 0, fmt.Errorf("invalid orphaned resources cleanup grace period %q: %w", c.GracePeriod, err)
+	}
+	if gracePeriod < 0 {
+		return 0, fmt.Errorf("invalid orphaned resources cleanup grace period %q: must not be negative", c.GracePeriod)
+	}
+	return gracePeriod, nil
+}
+
+// Matches returns true if the orphaned resource with the given key and labels must be deleted
+func (c *OrphanedResourcesCleanup) Matches(key kube.ResourceKey, resourceLabels map[string]string) (bool, error) {
+	if c.Selector == nil {
+		return false, nil
+	}
+	if len(c.Resources) > 0 {
+		matched := false
+		for _, item := range c.Resources {
+			if (item.Kind == "" || glob.Match(item.Kind, key.Kind)) && glob.Match(item.Group, key.Group) && (item.Name == "" || glob.Match(item.Name, key.Name)) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false, nil
+		}
+	}
+	selector, err := metav1.LabelSelectorAsSelector(c.Selector)
+	if err != nil {
+		return false, fmt.Errorf("invalid orphaned resources cleanup selector: %w", err)
+	}
+	return selector.Matches(labels.Set(resourceLabels)), nil
+}
+
 // SignatureKey is the specification of a key required to verify commit signatures with
 type SignatureKey struct {
 	// The ID of the key in hexadecimal notation
 	KeyID string `json:"keyID" protobuf:"bytes,1,name=keyID"`
 }
 
+// OCISignatureVerification is the specification of the cosign signatures and attestations required for OCI artifacts
+type OCISignatureVerification struct {
+	// PublicKeys is a list of PEM encoded public keys trusted to sign OCI artifacts
+	PublicKeys []string `json:"publicKeys,omitempty" protobuf:"bytes,1,rep,name=publicKeys"`
+	// Identities is a list of keyless signing identities trusted to sign OCI artifacts
+	Identities []OCISigningIdentity `json:"identities,omitempty" protobuf:"bytes,2,rep,name=identities"`
+	// Attestations is a list of in-toto predicate types of attestations that OCI artifacts must carry, signed by a trusted key or identity
+	Attestations []string `json:"attestations,omitempty" protobuf:"bytes,3,rep,name=attestations"`
+}
+
+// OCISigningIdentity is a keyless signing identity, as found in the certificate a signature was made with
+type OCISigningIdentity struct {
+	// Issuer is a glob pattern matching the OIDC issuer of the signing certificate
+	Issuer string `json:"issuer" protobuf:"bytes,1,opt,name=issuer"`
+	// Subject is a glob pattern matching the subject of the signing certificate, e.g. an email address or a workflow URL
+	Subject string `json:"subject" protobuf:"bytes,2,opt,name=subject"`
+}
+
+// CosignPolicy returns the policy OCI artifacts are verified against
+func (v *OCISignatureVerification) CosignPolicy() (*cosign.Policy, error) {
+	identities := make([]cosign.Identity, 0, len(v.Identities))
+	for _, identity := range v.Identities {
+		identities = append(identities, cosign.Identity{Issuer: identity.Issuer, Subject: identity.Subject})
+	}
+	return cosign.NewPolicy(v.PublicKeys, identities, v.Attestations)
+}
+
 // AppProjectSpec is the specification of an AppProject
 type AppProjectSpec struct {
 	// SourceRepos contains list of repository URLs which can be used for deployment
@@ -2696,6 +3107,8 @@ type AppProjectSpec struct {
 	PermitOnlyProjectScopedClusters bool `json:"permitOnlyProjectScopedClusters,omitempty" protobuf:"bytes,13,opt,name=permitOnlyProjectScopedClusters"`
 	// DestinationServiceAccounts holds information about the service accounts to be impersonated for the application sync operation for each destination.
 	DestinationServiceAccounts []ApplicationDestinationServiceAccount `json:"destinationServiceAccounts,omitempty" protobuf:"bytes,14,name=destinationServiceAccounts"`
+	// OCISignatureVerification contains the cosign public keys and keyless identities that OCI artifacts must be signed with in order to be allowed for sync
+	OCISignatureVerification *OCISignatureVerification `json:"ociSignatureVerification,omitempty" protobuf:"bytes,15,opt,name=ociSignatureVerification"`
 }
 
 // SyncWindows is a collection of sync windows in this project
@@ -2723,6 +3136,20 @@ type SyncWindow struct {
 	UseAndOperator bool `json:"andOperator,omitempty" protobuf:"bytes,9,opt,name=andOperator"`
 	// Description of the sync that will be applied to the schedule, can be used to add any information such as a ticket number for example
 	Description string `json:"description,omitempty" protobuf:"bytes,10,opt,name=description"`
+	// ExcludedDates contains a list of dates, in the YYYY-MM-DD format and in the time zone of the window, on which the window is not active
+	ExcludedDates []string `json:"excludedDates,omitempty" protobuf:"bytes,11,rep,name=excludedDates"`
+	// Calendar references an iCalendar file in a ConfigMap, the dates of its events are excluded like the ExcludedDates
+	Calendar *SyncWindowCalendar `json:"calendar,omitempty" protobuf:"bytes,12,opt,name=calendar"`
+	// ExpiresAt is the time after which the window no longer applies. A window with an expiry and without schedule is active until it expires.
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty" protobuf:"bytes,13,opt,name=expiresAt"`
+}
+
+// SyncWindowCalendar references an iCalendar file, such as a holiday calendar, stored in a ConfigMap of the Argo CD namespace
+type SyncWindowCalendar struct {
+	// ConfigMap is the name of the ConfigMap containing the calendar. It must be labeled with app.kubernetes.io/part-of: argocd.
+	ConfigMap string `json:"configMap" protobuf:"bytes,1,opt,name=configMap"`
+	// Key is the key of the calendar in the ConfigMap
+	Key string `json:"key" protobuf:"bytes,2,opt,name=key"`
 }
 
 // HasWindows returns true if SyncWindows has one or more SyncWindow
@@ -2742,21 +3169,15 @@ func (w *SyncWindows) active(currentTime time.Time) (*SyncWindows, error) {
 
 	if w.HasWindows() {
 		var active SyncWindows
-		specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 		for _, w := range *w {
-			schedule, sErr := specParser.Parse(w.Schedule)
-			if sErr != nil {
-				return nil, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, sErr)
+			if w.expired(currentTime) {
+				continue
 			}
-			duration, dErr := time.ParseDuration(w.Duration)
-			if dErr != nil {
-				return nil, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, dErr)
+			isActive, err := w.isActive(currentTime)
+			if err != nil {
+				return nil, err
 			}
-
-			// Offset the nextWindow time to consider the timeZone of the sync window
-			timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
-			nextWindow := schedule.Next(currentTime.Add(timeZoneOffsetDuration - duration))
-			if nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)) {
+			if isActive {
 				active = append(active, w)
 			}
 		}
@@ -2781,24 +3202,15 @@ func (w *SyncWindows) inactiveAllows(currentTime time.Time) (*SyncWindows, error
 
 	if w.HasWindows() {
 		var inactive SyncWindows
-		specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 		for _, w := range *w {
-			if w.Kind != "allow" {
+			if w.Kind != "allow" || w.expired(currentTime) {
 				continue
 			}
-			schedule, sErr := specParser.Parse(w.Schedule)
-			if sErr != nil {
-				return nil, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, sErr)
-			}
-			duration, dErr := time.ParseDuration(w.Duration)
-			if dErr != nil {
-				return nil, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, dErr)
+			isActive, err := w.isActive(currentTime)
+			if err != nil {
+				return nil, err
 			}
-			// Offset the nextWindow time to consider the timeZone of the sync window
-			timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
-			nextWindow := schedule.Next(currentTime.Add(timeZoneOffsetDuration - duration))
-
-			if !nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)) {
+			if !isActive {
 				inactive = append(inactive, w)
 			}
 		}
@@ -2809,6 +3221,53 @@ func (w *SyncWindows) inactiveAllows(currentTime time.Time) (*SyncWindows, error
 	return nil, nil
 }
 
+// isActive returns true if the window is active at the given time, without considering its expiry
+func (w *SyncWindow) isActive(currentTime time.Time) (bool, error) {
+	if w.isExcluded(currentTime) {
+		return false, nil
+	}
+	if w.isFreeze() {
+		return true, nil
+	}
+
+	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	schedule, sErr := specParser.Parse(w.Schedule)
+	if sErr != nil {
+		return false, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, sErr)
+	}
+	duration, dErr := time.ParseDuration(w.Duration)
+	if dErr != nil {
+		return false, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, dErr)
+	}
+
+	// Offset the nextWindow time to consider the timeZone of the sync window
+	timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
+	nextWindow := schedule.Next(currentTime.Add(timeZoneOffsetDuration - duration))
+	return nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)), nil
+}
+
+// isFreeze returns true if the window is an ad-hoc window without schedule, which is active until it expires
+func (w *SyncWindow) isFreeze() bool {
+	return w.Schedule == "" && w.Duration == "" && w.ExpiresAt != nil
+}
+
+// expired returns true if the window has an expiry which is past the given time
+func (w *SyncWindow) expired(currentTime time.Time) bool {
+	return w.ExpiresAt != nil && !currentTime.Before(w.ExpiresAt.Time)
+}
+
+// isExcluded returns true if the given time is on one of the excluded dates of the window, in the time zone of the window
+func (w *SyncWindow) isExcluded(currentTime time.Time) bool {
+	if len(w.ExcludedDates) == 0 {
+		return false
+	}
+	loc, err := time.LoadLocation(w.TimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+	return slices.Contains(w.ExcludedDates, currentTime.In(loc).Format(time.DateOnly))
+}
+
 func (w *SyncWindow) scheduleOffsetByTimeZone() time.Duration {
 	loc, err := time.LoadLocation(w.TimeZone)
 	if err != nil {
@@ -2855,6 +3314,65 @@ func (spec *AppProjectSpec) AddWindow(knd string, sch string, dur string, app []
 	return nil
 }
 
+// AddFreezeWindow adds a deny window to the AppProject which blocks syncs until the given time. The window applies to
+// all the applications of the project if no applications, namespaces or clusters are given.
+func (spec *AppProjectSpec) AddFreezeWindow(until time.Time, reason string, app []string, ns []string, cl []string) error {
+	if !until.After(time.Now()) {
+		return fmt.Errorf("cannot create freeze window: expiry %s is in the past", until.Format(time.RFC3339))
+	}
+	if len(app) == 0 && len(ns) == 0 && len(cl) == 0 {
+		app = []string{"*"}
+	}
+
+	expiresAt := metav1.NewTime(until.UTC().Truncate(time.Second))
+	window := &SyncWindow{
+		Kind:         "deny",
+		Applications: app,
+		Namespaces:   ns,
+		Clusters:     cl,
+		Description:  reason,
+		ExpiresAt:    &expiresAt,
+	}
+	err := window.Validate()
+	if err != nil {
+		return err
+	}
+
+	spec.SyncWindows = append(spec.SyncWindows, window)
+	return nil
+}
+
+// RemoveExpiredWindows removes the sync windows which have expired from the AppProject, and returns their number
+func (spec *AppProjectSpec) RemoveExpiredWindows() int {
+	now := time.Now()
+	var windows SyncWindows
+	for _, window := range spec.SyncWindows {
+		if window != nil && window.expired(now) {
+			continue
+		}
+		windows = append(windows, window)
+	}
+	removed := len(spec.SyncWindows) - len(windows)
+	spec.SyncWindows = windows
+	return removed
+}
+
+// NextWindowExpiry returns the earliest expiry of the sync windows of the AppProject which have not expired yet, or nil
+// if none of them expires
+func (spec *AppProjectSpec) NextWindowExpiry() *time.Time {
+	now := time.Now()
+	var next *time.Time
+	for _, window := range spec.SyncWindows {
+		if window == nil || window.ExpiresAt == nil || window.expired(now) {
+			continue
+		}
+		if next == nil || window.ExpiresAt.Time.Before(*next) {
+			next = &window.ExpiresAt.Time
+		}
+	}
+	return next
+}
+
 // DeleteWindow deletes a sync window with the given id from the AppProject
 func (spec *AppProjectSpec) DeleteWindow(id int) error {
 	var exists bool
@@ -2947,6 +3465,47 @@ func (w *SyncWindows) Matches(app *Application) *SyncWindows {
 	return nil
 }
 
+// GetSyncWindows returns the sync windows of the project which apply to the application, followed by the deny sync
+// windows defined by the application itself. Other windows of the application are ignored, as they would allow syncs
+// outside of the schedule of the project.
+func (app *Application) GetSyncWindows(proj *AppProject) *SyncWindows {
+	windows := proj.Spec.SyncWindows.Matches(app)
+	var denies SyncWindows
+	for _, window := range app.Spec.SyncWindows {
+		if window != nil && window.Kind == "deny" {
+			denies = append(denies, window)
+		}
+	}
+	if len(denies) == 0 {
+		return windows
+	}
+	var all SyncWindows
+	if windows != nil {
+		all = append(all, *windows...)
+	}
+	all = append(all, denies...)
+	return &all
+}
+
+// BlockReason returns the descriptions of the currently active deny windows, which can be used to explain why a sync
+// is blocked
+func (w *SyncWindows) BlockReason() (string, error) {
+	active, err := w.Active()
+	if err != nil {
+		return "", fmt.Errorf("invalid sync windows: %w", err)
+	}
+	if !active.HasWindows() {
+		return "", nil
+	}
+	var reasons []string
+	for _, window := range *active {
+		if window.Kind == "deny" && window.Description != "" && !slices.Contains(reasons, window.Description) {
+			reasons = append(reasons, window.Description)
+		}
+	}
+	return strings.Join(reasons, "; "), nil
+}
+
 // CanSync returns true if a sync window currently allows a sync. isManual indicates whether the sync has been triggered manually.
 func (w *SyncWindows) CanSync(isManual bool) (bool, error) {
 	if !w.HasWindows() {
@@ -3045,21 +3604,10 @@ func (w SyncWindow) active(currentTime time.Time) (bool, error) {
 	// first converted to UTC before search
 	currentTime = currentTime.UTC()
 
-	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
-	schedule, sErr := specParser.Parse(w.Schedule)
-	if sErr != nil {
-		return false, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, sErr)
-	}
-	duration, dErr := time.ParseDuration(w.Duration)
-	if dErr != nil {
-		return false, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, dErr)
+	if w.expired(currentTime) {
+		return false, nil
 	}
-
-	// Offset the nextWindow time to consider the timeZone of the sync window
-	timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
-	nextWindow := schedule.Next(currentTime.Add(timeZoneOffsetDuration - duration))
-
-	return nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)), nil
+	return w.isActive(currentTime)
 }
 
 // Update updates a sync window's settings with the given parameter
@@ -3113,14 +3661,25 @@ func (w *SyncWindow) Validate() error {
 	if w.Kind != "allow" && w.Kind != "deny" {
 		return fmt.Errorf("kind '%s' mismatch: can only be allow or deny", w.Kind)
 	}
-	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
-	_, err := specParser.Parse(w.Schedule)
-	if err != nil {
-		return fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, err)
+	if !w.isFreeze() {
+		specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+		_, err := specParser.Parse(w.Schedule)
+		if err != nil {
+			return fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, err)
+		}
+		_, err = time.ParseDuration(w.Duration)
+		if err != nil {
+			return fmt.Errorf("cannot parse duration '%s': %w", w.Duration, err)
+		}
 	}
-	_, err = time.ParseDuration(w.Duration)
-	if err != nil {
-		return fmt.Errorf("cannot parse duration '%s': %w", w.Duration, err)
+
+	for _, date := range w.ExcludedDates {
+		if _, err := time.Parse(time.DateOnly, date); err != nil {
+			return fmt.Errorf("cannot parse excluded date '%s': %w", date, err)
+		}
+	}
+	if w.Calendar != nil && (w.Calendar.ConfigMap == "" || w.Calendar.Key == "") {
+		return errors.New("calendar requires a ConfigMap and a key")
 	}
 
 	if len(w.Description) > 255 {
@@ -3261,6 +3820,18 @@ func (app *Application) IsHydrateRequested() bool {
 	return false
 }
 
+func (app *Application) HasPreDeleteFinalizer() bool {
+	return getFinalizerIndex(app.ObjectMeta, PreDeleteFinalizerName) > -1
+}
+
+func (app *Application) SetPreDeleteFinalizer() {
+	setFinalizer(&app.ObjectMeta, PreDeleteFinalizerName, true)
+}
+
+func (app *Application) UnSetPreDeleteFinalizer() {
+	setFinalizer(&app.ObjectMeta, PreDeleteFinalizerName, false)
+}
+
 func (app *Application) HasPostDeleteFinalizer(stage ...string) bool {
 	return getFinalizerIndex(app.ObjectMeta, strings.Join(append([]string{PostDeleteFinalizerName}, stage...), "/")) > -1
 }
@@ -3427,6 +3998,9 @@ func (source *ApplicationSource) ExplicitType() (*ApplicationSourceType, error)
 	if source.Plugin != nil {
 		appTypes = append(appTypes, ApplicationSourceTypePlugin)
 	}
+	if source.CUE != nil {
+		appTypes = append(appTypes, ApplicationSourceTypeCUE)
+	}
 	if len(appTypes) == 0 {
 		return nil, nil
 	}
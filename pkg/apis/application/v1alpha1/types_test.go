@@ -15,6 +15,7 @@ import (
 	argocdcommon "github.com/argoproj/argo-cd/v3/common"
 
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -649,6 +650,40 @@ func TestAppProject_ValidateSources(t *testing.T) {
 	require.Error(t, err)
 }
 
+// TestAppProject_ValidateOrphanedResourcesCleanup tests for an invalid orphaned resources cleanup policy
+func TestAppProject_ValidateOrphanedResourcesCleanup(t *testing.T) {
+	p := newTestProject()
+	p.Spec.OrphanedResources = &OrphanedResourcesMonitorSettings{Cleanup: &OrphanedResourcesCleanup{
+		Selector:    &metav1.LabelSelector{MatchLabels: map[string]string{"temporary": "true"}},
+		GracePeriod: "1h",
+	}}
+	require.NoError(t, p.ValidateProject())
+
+	p.Spec.OrphanedResources.Cleanup.GracePeriod = "-1h"
+	require.Error(t, p.ValidateProject())
+
+	p.Spec.OrphanedResources.Cleanup.GracePeriod = ""
+	p.Spec.OrphanedResources.Cleanup.Selector.MatchExpressions = []metav1.LabelSelectorRequirement{{Key: "temporary", Operator: "bad"}}
+	require.Error(t, p.ValidateProject())
+}
+
+// TestAppProject_ValidateOCISignatureVerification tests for an invalid OCI signature verification
+func TestAppProject_ValidateOCISignatureVerification(t *testing.T) {
+	p := newTestProject()
+	p.Spec.OCISignatureVerification = &OCISignatureVerification{
+		Identities:   []OCISigningIdentity{{Issuer: "https://token.actions.githubusercontent.com", Subject: "https://github.com/argoproj/*"}},
+		Attestations: []string{"https://slsa.dev/provenance/v1"},
+	}
+	require.NoError(t, p.ValidateProject())
+
+	p.Spec.OCISignatureVerification.Identities[0].Subject = ""
+	require.ErrorContains(t, p.ValidateProject(), "invalid OCI signature verification")
+
+	p.Spec.OCISignatureVerification.Identities = nil
+	p.Spec.OCISignatureVerification.PublicKeys = []string{"not a key"}
+	require.ErrorContains(t, p.ValidateProject(), "invalid public key at index 0")
+}
+
 // TestAppProject_ValidateDestinations tests for an invalid destination
 func TestAppProject_ValidateDestinations(t *testing.T) {
 	p := newTestProject()
@@ -1394,6 +1429,51 @@ func TestSyncOperation_IsApplyStrategy(t *testing.T) {
 	}
 }
 
+func TestSyncOperation_RetryFailedResources(t *testing.T) {
+	failed := &ResourceResult{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook", Status: common.ResultCodeSyncFailed}
+	synced := &ResourceResult{Kind: "Service", Namespace: "default", Name: "guestbook", Status: common.ResultCodeSynced}
+	op := &SyncOperation{Revision: "HEAD", Prune: true, Resources: []SyncOperationResource{{Kind: "Service", Name: "guestbook"}}}
+
+	t.Run("NilResult", func(t *testing.T) {
+		assert.Nil(t, op.RetryFailedResources(nil))
+	})
+	t.Run("NothingFailed", func(t *testing.T) {
+		assert.Nil(t, op.RetryFailedResources(&SyncOperationResult{Revision: "abc", Resources: ResourceResults{synced}}))
+	})
+	t.Run("Source", func(t *testing.T) {
+		retry := op.RetryFailedResources(&SyncOperationResult{Revision: "abc", Resources: ResourceResults{synced, failed}})
+		require.NotNil(t, retry)
+		assert.Equal(t, "abc", retry.Revision)
+		assert.True(t, retry.Prune)
+		assert.Equal(t, []SyncOperationResource{{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook"}}, retry.Resources)
+		assert.True(t, retry.FailedResourcesOnly)
+		// the original operation is left untouched
+		assert.Equal(t, "HEAD", op.Revision)
+		assert.Len(t, op.Resources, 1)
+		assert.False(t, op.FailedResourcesOnly)
+	})
+	t.Run("Sources", func(t *testing.T) {
+		retry := op.RetryFailedResources(&SyncOperationResult{Revisions: []string{"abc", "def"}, Resources: ResourceResults{failed}})
+		require.NotNil(t, retry)
+		assert.Equal(t, []string{"abc", "def"}, retry.Revisions)
+	})
+}
+
+func TestResourceResults_FailedResources(t *testing.T) {
+	failed := &ResourceResult{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook", Status: common.ResultCodeSyncFailed}
+	synced := &ResourceResult{Kind: "Service", Namespace: "default", Name: "guestbook", Status: common.ResultCodeSynced}
+	preSync := &ResourceResult{Group: "batch", Kind: "Job", Namespace: "default", Name: "migrate", HookType: common.HookTypePreSync, HookPhase: common.OperationSucceeded}
+	postSync := &ResourceResult{Group: "batch", Kind: "Job", Namespace: "default", Name: "notify", HookType: common.HookTypePostSync, HookPhase: common.OperationFailed}
+
+	assert.Nil(t, ResourceResults{}.FailedResources())
+	assert.Nil(t, ResourceResults{synced, preSync, postSync}.FailedResources())
+	assert.Equal(t, []SyncOperationResource{
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook"},
+		{Group: "batch", Kind: "Job", Namespace: "default", Name: "migrate"},
+		{Group: "batch", Kind: "Job", Namespace: "default", Name: "notify"},
+	}, ResourceResults{preSync, synced, failed, postSync, preSync}.FailedResources())
+}
+
 func TestResourceResults_Find(t *testing.T) {
 	type args struct {
 		group     string
@@ -1471,6 +1551,26 @@ func TestApplicationSource_IsZero(t *testing.T) {
 	}
 }
 
+func TestApplicationSource_IsArchive(t *testing.T) {
+	tests := []struct {
+		name   string
+		source *ApplicationSource
+		want   bool
+	}{
+		{"Git", &ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps.git"}, false},
+		{"OCI", &ApplicationSource{RepoURL: "oci://ghcr.io/argoproj/manifests"}, false},
+		{"Helm", &ApplicationSource{RepoURL: "https://example.com/charts/guestbook-1.0.0.tgz", Chart: "guestbook"}, false},
+		{"TarGz", &ApplicationSource{RepoURL: "https://example.com/guestbook/{{revision}}/manifests.tar.gz"}, true},
+		{"Zip", &ApplicationSource{RepoURL: "https://example.com/guestbook-{{revision}}.zip"}, true},
+		{"S3", &ApplicationSource{RepoURL: "s3://manifests/guestbook/{{revision}}.tar.gz?region=eu-west-1"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.source.IsArchive())
+		})
+	}
+}
+
 func TestApplicationSourceHelm_AddParameter(t *testing.T) {
 	src := ApplicationSourceHelm{}
 	t.Run("Add", func(t *testing.T) {
@@ -3059,6 +3159,134 @@ func TestSyncWindow_Validate(t *testing.T) {
 		window.Duration = "1000days"
 		require.Error(t, window.Validate())
 	})
+	t.Run("FreezeWindow", func(t *testing.T) {
+		expiresAt := metav1.NewTime(time.Now().Add(time.Hour))
+		freeze := &SyncWindow{Kind: "deny", ExpiresAt: &expiresAt}
+		require.NoError(t, freeze.Validate())
+	})
+	t.Run("IncorrectExcludedDate", func(t *testing.T) {
+		excluded := &SyncWindow{Kind: "allow", Schedule: "* * * * *", Duration: "1h", ExcludedDates: []string{"2026-12-25", "25/12/2026"}}
+		require.ErrorContains(t, excluded.Validate(), "cannot parse excluded date '25/12/2026'")
+	})
+	t.Run("IncorrectCalendar", func(t *testing.T) {
+		calendar := &SyncWindow{Kind: "allow", Schedule: "* * * * *", Duration: "1h", Calendar: &SyncWindowCalendar{ConfigMap: "holidays"}}
+		require.EqualError(t, calendar.Validate(), "calendar requires a ConfigMap and a key")
+	})
+}
+
+func TestSyncWindow_ExcludedDates(t *testing.T) {
+	currentTime := time.Date(2026, 12, 25, 10, 0, 0, 0, time.UTC)
+	window := SyncWindow{Kind: "allow", Schedule: "0 * * * *", Duration: "2h", ExcludedDates: []string{"2026-12-25"}}
+
+	isActive, err := window.active(currentTime)
+	require.NoError(t, err)
+	assert.False(t, isActive)
+
+	isActive, err = window.active(currentTime.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	assert.True(t, isActive)
+
+	// the dates are evaluated in the time zone of the window
+	window.TimeZone = "America/New_York"
+	isActive, err = window.active(time.Date(2026, 12, 26, 2, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.False(t, isActive)
+
+	// an excluded allow window blocks the syncs
+	windows := SyncWindows{&window}
+	inactive, err := windows.inactiveAllows(time.Date(2026, 12, 26, 2, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Len(t, *inactive, 1)
+}
+
+func TestSyncWindow_Expiry(t *testing.T) {
+	now := time.Now()
+	expiresAt := metav1.NewTime(now.Add(time.Hour))
+	freeze := &SyncWindow{Kind: "deny", ExpiresAt: &expiresAt, Description: "Incident 123"}
+
+	isActive, err := freeze.active(now)
+	require.NoError(t, err)
+	assert.True(t, isActive)
+
+	isActive, err = freeze.active(now.Add(2 * time.Hour))
+	require.NoError(t, err)
+	assert.False(t, isActive)
+
+	// an expired allow window no longer blocks the syncs
+	expiredAt := metav1.NewTime(now.Add(-time.Hour))
+	windows := SyncWindows{{Kind: "allow", Schedule: inactiveCronSchedule(), Duration: "1h", ExpiresAt: &expiredAt}}
+	inactive, err := windows.inactiveAllows(now)
+	require.NoError(t, err)
+	assert.Nil(t, inactive)
+	canSync, err := windows.CanSync(false)
+	require.NoError(t, err)
+	assert.True(t, canSync)
+}
+
+func TestAppProjectSpec_AddFreezeWindow(t *testing.T) {
+	t.Run("Blocks all applications by default", func(t *testing.T) {
+		proj := newTestProject()
+		require.NoError(t, proj.Spec.AddFreezeWindow(time.Now().Add(time.Hour), "Year-end freeze", nil, nil, nil))
+		require.Len(t, proj.Spec.SyncWindows, 1)
+		assert.Equal(t, []string{"*"}, proj.Spec.SyncWindows[0].Applications)
+		require.NoError(t, proj.ValidateProject())
+
+		windows := newTestApp().GetSyncWindows(proj)
+		canSync, err := windows.CanSync(true)
+		require.NoError(t, err)
+		assert.False(t, canSync)
+		reason, err := windows.BlockReason()
+		require.NoError(t, err)
+		assert.Equal(t, "Year-end freeze", reason)
+	})
+
+	t.Run("Expiry in the past", func(t *testing.T) {
+		proj := newTestProject()
+		require.ErrorContains(t, proj.Spec.AddFreezeWindow(time.Now().Add(-time.Hour), "", nil, nil, nil), "is in the past")
+	})
+
+	t.Run("Removes expired windows", func(t *testing.T) {
+		proj := newTestProject()
+		expiredAt := metav1.NewTime(time.Now().Add(-time.Hour))
+		proj.Spec.SyncWindows = SyncWindows{
+			{Kind: "deny", ExpiresAt: &expiredAt, Applications: []string{"*"}},
+			newSyncWindow("allow", "* * * * *", false, false),
+		}
+		assert.Equal(t, 1, proj.Spec.RemoveExpiredWindows())
+		require.Len(t, proj.Spec.SyncWindows, 1)
+		assert.Equal(t, "allow", proj.Spec.SyncWindows[0].Kind)
+	})
+}
+
+func TestApplication_GetSyncWindows(t *testing.T) {
+	proj := newProjectBuilder().withInactiveAllowWindow(false).build()
+	app := newTestApp()
+	app.Name = "app1"
+	assert.Len(t, *app.GetSyncWindows(proj), 1)
+
+	appWindow := &SyncWindow{Kind: "deny", Schedule: "* * * * *", Duration: "1h", Description: "Application maintenance"}
+	app.Spec.SyncWindows = SyncWindows{appWindow}
+	windows := app.GetSyncWindows(proj)
+	require.Len(t, *windows, 2)
+	assert.Same(t, appWindow, (*windows)[1])
+	reason, err := windows.BlockReason()
+	require.NoError(t, err)
+	assert.Equal(t, "Application maintenance", reason)
+
+	// the windows of the application apply even if the project has no matching window
+	app.Name = "other-app"
+	windows = app.GetSyncWindows(proj)
+	require.Len(t, *windows, 1)
+	assert.Same(t, appWindow, (*windows)[0])
+
+	// the allow windows of the application are ignored, as they would allow syncs the project windows block
+	app.Name = "app1"
+	app.Spec.SyncWindows = SyncWindows{{Kind: "allow", Schedule: "* * * * *", Duration: "1h", ManualSync: true}}
+	windows = app.GetSyncWindows(proj)
+	require.Len(t, *windows, 1)
+	canSync, err := windows.CanSync(true)
+	require.NoError(t, err)
+	assert.False(t, canSync)
 }
 
 func TestApplicationStatus_GetConditions(t *testing.T) {
@@ -3369,6 +3597,36 @@ func TestSyncPolicy_IsZero(t *testing.T) {
 	assert.False(t, (&SyncPolicy{Automated: &SyncPolicyAutomated{}}).IsZero())
 	assert.False(t, (&SyncPolicy{SyncOptions: SyncOptions{""}}).IsZero())
 	assert.False(t, (&SyncPolicy{Retry: &RetryStrategy{}}).IsZero())
+	assert.False(t, (&SyncPolicy{Pause: &SyncPause{}}).IsZero())
+	assert.False(t, (&SyncPolicy{ServerSideApplyConflicts: []ServerSideApplyConflictPolicy{{}}}).IsZero())
+}
+
+func TestSyncPolicy_GetServerSideApplyConflictAction(t *testing.T) {
+	var nilPolicy *SyncPolicy
+	assert.Equal(t, ServerSideApplyConflictActionForce, nilPolicy.GetServerSideApplyConflictAction("apps", "Deployment"))
+
+	policy := &SyncPolicy{ServerSideApplyConflicts: []ServerSideApplyConflictPolicy{
+		{Group: "autoscaling", Kind: "*", Action: ServerSideApplyConflictActionWarn},
+		{Group: "apps", Kind: "Deployment", Action: ServerSideApplyConflictActionFail},
+		{Group: "*", Kind: "*", Action: ServerSideApplyConflictActionWarn},
+	}}
+	assert.Equal(t, ServerSideApplyConflictActionFail, policy.GetServerSideApplyConflictAction("apps", "Deployment"))
+	assert.Equal(t, ServerSideApplyConflictActionWarn, policy.GetServerSideApplyConflictAction("autoscaling", "HorizontalPodAutoscaler"))
+	assert.Equal(t, ServerSideApplyConflictActionWarn, policy.GetServerSideApplyConflictAction("", "ConfigMap"))
+	assert.Equal(t, ServerSideApplyConflictActionForce, (&SyncPolicy{}).GetServerSideApplyConflictAction("", "ConfigMap"))
+}
+
+func TestSyncPause_IsActive(t *testing.T) {
+	now := time.Now()
+	var nilPolicy *SyncPolicy
+	assert.Nil(t, nilPolicy.GetPause())
+	assert.False(t, nilPolicy.GetPause().IsActive(now))
+
+	pause := &SyncPause{Until: metav1.NewTime(now.Add(time.Minute))}
+	policy := &SyncPolicy{Pause: pause}
+	assert.Same(t, pause, policy.GetPause())
+	assert.True(t, policy.GetPause().IsActive(now))
+	assert.False(t, policy.GetPause().IsActive(now.Add(time.Minute)))
 }
 
 func TestSyncOptions_HasOption(t *testing.T) {
@@ -3624,6 +3882,86 @@ func TestOrphanedResourcesMonitorSettings_IsWarn(t *testing.T) {
 	assert.True(t, settings.IsWarn())
 }
 
+func TestOrphanedResourcesMonitorSettings_IsAdopt(t *testing.T) {
+	settings := OrphanedResourcesMonitorSettings{}
+	assert.False(t, settings.IsAdopt())
+
+	settings.Adopt = ptr.To(false)
+	assert.False(t, settings.IsAdopt())
+
+	settings.Adopt = ptr.To(true)
+	assert.True(t, settings.IsAdopt())
+}
+
+func TestOrphanedResourcesCleanup_GetGracePeriod(t *testing.T) {
+	gracePeriod, err := (&OrphanedResourcesCleanup{}).GetGracePeriod()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultOrphanedResourcesCleanupGracePeriod, gracePeriod)
+
+	gracePeriod, err = (&OrphanedResourcesCleanup{GracePeriod: "1h30m"}).GetGracePeriod()
+	require.NoError(t, err)
+	assert.Equal(t, 90*time.Minute, gracePeriod)
+
+	_, err = (&OrphanedResourcesCleanup{GracePeriod: "tomorrow"}).GetGracePeriod()
+	require.Error(t, err)
+
+	_, err = (&OrphanedResourcesCleanup{GracePeriod: "-1h"}).GetGracePeriod()
+	require.Error(t, err)
+}
+
+func TestOrphanedResourcesCleanup_Matches(t *testing.T) {
+	key := kube.NewResourceKey("", "ConfigMap", "default", "temp-config")
+	testCases := []struct {
+		name    string
+		cleanup OrphanedResourcesCleanup
+		labels  map[string]string
+		matches bool
+	}{{
+		name:    "no selector",
+		cleanup: OrphanedResourcesCleanup{},
+		labels:  map[string]string{"temporary": "true"},
+		matches: false,
+	}, {
+		name:    "matching selector",
+		cleanup: OrphanedResourcesCleanup{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"temporary": "true"}}},
+		labels:  map[string]string{"temporary": "true"},
+		matches: true,
+	}, {
+		name:    "not matching selector",
+		cleanup: OrphanedResourcesCleanup{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"temporary": "true"}}},
+		labels:  map[string]string{"temporary": "false"},
+		matches: false,
+	}, {
+		name:    "empty selector",
+		cleanup: OrphanedResourcesCleanup{Selector: &metav1.LabelSelector{}},
+		matches: true,
+	}, {
+		name: "matching resources",
+		cleanup: OrphanedResourcesCleanup{
+			Selector:  &metav1.LabelSelector{},
+			Resources: []OrphanedResourceKey{{Kind: "ConfigMap", Name: "temp-*"}},
+		},
+		matches: true,
+	}, {
+		name: "not matching resources",
+		cleanup: OrphanedResourcesCleanup{
+			Selector:  &metav1.LabelSelector{},
+			Resources: []OrphanedResourceKey{{Group: "apps", Kind: "Deployment"}},
+		},
+		matches: false,
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			matches, err := tc.cleanup.Matches(key, tc.labels)
+			require.NoError(t, err)
+			assert.Equal(t, tc.matches, matches)
+		})
+	}
+
+	_, err := (&OrphanedResourcesCleanup{Selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "a", Operator: "bad"}}}}).Matches(key, nil)
+	require.Error(t, err)
+}
+
 func Test_isValidPolicyObject(t *testing.T) {
 	policyTests := []struct {
 		name    string
@@ -194,6 +194,11 @@ func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 		*out = make([]ApplicationDestinationServiceAccount, len(*in))
 		copy(*out, *in)
 	}
+	if in.OCISignatureVerification != nil {
+		in, out := &in.OCISignatureVerification, &out.OCISignatureVerification
+		*out = new(OCISignatureVerification)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1066,6 +1071,11 @@ func (in *ApplicationSource) DeepCopyInto(out *ApplicationSource) {
 		*out = new(ApplicationSourcePlugin)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CUE != nil {
+		in, out := &in.CUE, &out.CUE
+		*out = new(ApplicationSourceCUE)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1079,6 +1089,32 @@ func (in *ApplicationSource) DeepCopy() *ApplicationSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSourceCUE) DeepCopyInto(out *ApplicationSourceCUE) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]CUETag, len(*in))
+		copy(*out, *in)
+	}
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSourceCUE.
+func (in *ApplicationSourceCUE) DeepCopy() *ApplicationSourceCUE {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSourceCUE)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSourceDirectory) DeepCopyInto(out *ApplicationSourceDirectory) {
 	*out = *in
@@ -1124,6 +1160,11 @@ func (in *ApplicationSourceHelm) DeepCopyInto(out *ApplicationSourceHelm) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ValuesFrom != nil {
+		in, out := &in.ValuesFrom, &out.ValuesFrom
+		*out = make([]HelmValuesFromSource, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1377,6 +1418,17 @@ func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
 		*out = new(SourceHydrator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SyncWindows != nil {
+		in, out := &in.SyncWindows, &out.SyncWindows
+		*out = make(SyncWindows, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SyncWindow)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	return
 }
 
@@ -1613,6 +1665,22 @@ func (in *BearerTokenBitbucketCloud) DeepCopy() *BearerTokenBitbucketCloud {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CUETag) DeepCopyInto(out *CUETag) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CUETag.
+func (in *CUETag) DeepCopy() *CUETag {
+	if in == nil {
+		return nil
+	}
+	out := new(CUETag)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChartDetails) DeepCopyInto(out *ChartDetails) {
 	*out = *in
@@ -2080,6 +2148,22 @@ func (in *ExecProviderConfig) DeepCopy() *ExecProviderConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FieldManagerConflict) DeepCopyInto(out *FieldManagerConflict) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldManagerConflict.
+func (in *FieldManagerConflict) DeepCopy() *FieldManagerConflict {
+	if in == nil {
+		return nil
+	}
+	out := new(FieldManagerConflict)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GitDirectoryGeneratorItem) DeepCopyInto(out *GitDirectoryGeneratorItem) {
 	*out = *in
@@ -2262,6 +2346,22 @@ func (in *HelmParameter) DeepCopy() *HelmParameter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmValuesFromSource) DeepCopyInto(out *HelmValuesFromSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmValuesFromSource.
+func (in *HelmValuesFromSource) DeepCopy() *HelmValuesFromSource {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmValuesFromSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostInfo) DeepCopyInto(out *HostInfo) {
 	*out = *in
@@ -2836,6 +2936,53 @@ func (in *OCIMetadata) DeepCopy() *OCIMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCISignatureVerification) DeepCopyInto(out *OCISignatureVerification) {
+	*out = *in
+	if in.PublicKeys != nil {
+		in, out := &in.PublicKeys, &out.PublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Identities != nil {
+		in, out := &in.Identities, &out.Identities
+		*out = make([]OCISigningIdentity, len(*in))
+		copy(*out, *in)
+	}
+	if in.Attestations != nil {
+		in, out := &in.Attestations, &out.Attestations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCISignatureVerification.
+func (in *OCISignatureVerification) DeepCopy() *OCISignatureVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(OCISignatureVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCISigningIdentity) DeepCopyInto(out *OCISigningIdentity) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCISigningIdentity.
+func (in *OCISigningIdentity) DeepCopy() *OCISigningIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(OCISigningIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Operation) DeepCopyInto(out *Operation) {
 	*out = *in
@@ -2973,6 +3120,32 @@ func (in *OrphanedResourceKey) DeepCopy() *OrphanedResourceKey {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanedResourcesCleanup) DeepCopyInto(out *OrphanedResourcesCleanup) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]OrphanedResourceKey, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanedResourcesCleanup.
+func (in *OrphanedResourcesCleanup) DeepCopy() *OrphanedResourcesCleanup {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanedResourcesCleanup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OrphanedResourcesMonitorSettings) DeepCopyInto(out *OrphanedResourcesMonitorSettings) {
 	*out = *in
@@ -2986,6 +3159,16 @@ func (in *OrphanedResourcesMonitorSettings) DeepCopyInto(out *OrphanedResourcesM
 		*out = make([]OrphanedResourceKey, len(*in))
 		copy(*out, *in)
 	}
+	if in.Adopt != nil {
+		in, out := &in.Adopt, &out.Adopt
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Cleanup != nil {
+		in, out := &in.Cleanup, &out.Cleanup
+		*out = new(OrphanedResourcesCleanup)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3707,6 +3890,32 @@ func (in *ResourceDiff) DeepCopy() *ResourceDiff {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceDriftEpisode) DeepCopyInto(out *ResourceDriftEpisode) {
+	*out = *in
+	in.DriftedAt.DeepCopyInto(&out.DriftedAt)
+	if in.FieldManagers != nil {
+		in, out := &in.FieldManagers, &out.FieldManagers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.HealedAt != nil {
+		in, out := &in.HealedAt, &out.HealedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceDriftEpisode.
+func (in *ResourceDriftEpisode) DeepCopy() *ResourceDriftEpisode {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceDriftEpisode)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceIgnoreDifferences) DeepCopyInto(out *ResourceIgnoreDifferences) {
 	*out = *in
@@ -3818,6 +4027,11 @@ func (in *ResourceNode) DeepCopyInto(out *ResourceNode) {
 		in, out := &in.CreatedAt, &out.CreatedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.Conflicts != nil {
+		in, out := &in.Conflicts, &out.Conflicts
+		*out = make([]FieldManagerConflict, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3878,6 +4092,11 @@ func (in *ResourceResult) DeepCopyInto(out *ResourceResult) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Conflicts != nil {
+		in, out := &in.Conflicts, &out.Conflicts
+		*out = make([]FieldManagerConflict, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -4003,6 +4222,11 @@ func (in *RevisionHistory) DeepCopyInto(out *RevisionHistory) {
 		copy(*out, *in)
 	}
 	out.InitiatedBy = in.InitiatedBy
+	if in.ManifestsDigests != nil {
+		in, out := &in.ManifestsDigests, &out.ManifestsDigests
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -4370,6 +4594,22 @@ func (in *SecretRef) DeepCopy() *SecretRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerSideApplyConflictPolicy) DeepCopyInto(out *ServerSideApplyConflictPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerSideApplyConflictPolicy.
+func (in *ServerSideApplyConflictPolicy) DeepCopy() *ServerSideApplyConflictPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerSideApplyConflictPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SignatureKey) DeepCopyInto(out *SignatureKey) {
 	*out = *in
@@ -4553,6 +4793,11 @@ func (in *SyncOperationResult) DeepCopyInto(out *SyncOperationResult) {
 		*out = new(ManagedNamespaceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ManifestsDigests != nil {
+		in, out := &in.ManifestsDigests, &out.ManifestsDigests
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -4586,6 +4831,27 @@ func (in SyncOptions) DeepCopy() SyncOptions {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncPause) DeepCopyInto(out *SyncPause) {
+	*out = *in
+	in.Until.DeepCopyInto(&out.Until)
+	if in.PausedAt != nil {
+		in, out := &in.PausedAt, &out.PausedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncPause.
+func (in *SyncPause) DeepCopy() *SyncPause {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncPause)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncPolicy) DeepCopyInto(out *SyncPolicy) {
 	*out = *in
@@ -4609,6 +4875,16 @@ func (in *SyncPolicy) DeepCopyInto(out *SyncPolicy) {
 		*out = new(ManagedNamespaceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Pause != nil {
+		in, out := &in.Pause, &out.Pause
+		*out = new(SyncPause)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServerSideApplyConflicts != nil {
+		in, out := &in.ServerSideApplyConflicts, &out.ServerSideApplyConflicts
+		*out = make([]ServerSideApplyConflictPolicy, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -4758,6 +5034,20 @@ func (in *SyncWindow) DeepCopyInto(out *SyncWindow) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ExcludedDates != nil {
+		in, out := &in.ExcludedDates, &out.ExcludedDates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Calendar != nil {
+		in, out := &in.Calendar, &out.Calendar
+		*out = new(SyncWindowCalendar)
+		**out = **in
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -4771,6 +5061,22 @@ func (in *SyncWindow) DeepCopy() *SyncWindow {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncWindowCalendar) DeepCopyInto(out *SyncWindowCalendar) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncWindowCalendar.
+func (in *SyncWindowCalendar) DeepCopy() *SyncWindowCalendar {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncWindowCalendar)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in SyncWindows) DeepCopyInto(out *SyncWindows) {
 	{
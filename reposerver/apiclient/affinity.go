@@ -0,0 +1,205 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/balancer/base"
+	"google.golang.org/grpc/resolver"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/env"
+	"github.com/argoproj/argo-cd/v3/util/git"
+)
+
+const (
+	// RepoAffinityBalancerName is the name of the gRPC load balancing policy which routes the requests for the same
+	// repository to the same repo server replica
+	RepoAffinityBalancerName = "argocd_repo_affinity"
+	// repoAffinityScheme is the scheme of the resolver which discovers all the repo server replicas behind a headless
+	// service
+	repoAffinityScheme = "argocd-repo-affinity"
+)
+
+// repoAffinityResolveInterval is the interval at which the addresses of the repo server replicas are resolved again
+var repoAffinityResolveInterval = env.ParseDurationFromEnv("ARGOCD_REPO_SERVER_AFFINITY_RESOLVE_INTERVAL", 30*time.Second, time.Second, time.Hour)
+
+func init() {
+	balancer.Register(base.NewBalancerBuilder(RepoAffinityBalancerName, &repoAffinityPickerBuilder{}, base.Config{}))
+}
+
+type repoAffinityKey struct{}
+
+// repoAffinityFromContext returns the normalized URL of the repository the request is for, if any
+func repoAffinityFromContext(ctx context.Context) string {
+	key, _ := ctx.Value(repoAffinityKey{}).(string)
+	return key
+}
+
+// repoAffinityUnaryInterceptor stores the repository of the request in the call context, so that the picker can
+// route it to the replica serving the repository
+func repoAffinityUnaryInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if r, ok := req.(interface{ GetRepo() *v1alpha1.Repository }); ok && r.GetRepo() != nil && r.GetRepo().Repo != "" {
+		ctx = context.WithValue(ctx, repoAffinityKey{}, git.NormalizeGitURLAllowInvalid(r.GetRepo().Repo))
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// rendezvousIndex returns the index of the node with the highest random weight for the given key. Removing a node
+// only moves the keys of this node, which are spread over the remaining nodes.
+func rendezvousIndex(key string, nodes []string) int {
+	index := 0
+	var highest uint64
+	for i, node := range nodes {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(key))
+		_, _ = h.Write([]byte{0})
+		_, _ = h.Write([]byte(node))
+		if score := h.Sum64(); i == 0 || score > highest {
+			index = i
+			highest = score
+		}
+	}
+	return index
+}
+
+type repoAffinityPickerBuilder struct{}
+
+func (*repoAffinityPickerBuilder) Build(info base.PickerBuildInfo) balancer.Picker {
+	if len(info.ReadySCs) == 0 {
+		return base.NewErrPicker(balancer.ErrNoSubConnAvailable)
+	}
+	picker := &repoAffinityPicker{}
+	for subConn, subConnInfo := range info.ReadySCs {
+		picker.subConns = append(picker.subConns, subConn)
+		picker.addresses = append(picker.addresses, subConnInfo.Address.Addr)
+	}
+	return picker
+}
+
+// repoAffinityPicker picks the ready replica with the highest rendezvous hash weight for the repository of the request.
+// If the replica of a repository is not ready, its requests fail over to the next replica. Requests which are not
+// for a repository are distributed in round-robin fashion.
+type repoAffinityPicker struct {
+	subConns  []balancer.SubConn
+	addresses []string
+	next      atomic.Uint32
+}
+
+func (p *repoAffinityPicker) Pick(info balancer.PickInfo) (balancer.PickResult, error) {
+	key := repoAffinityFromContext(info.Ctx)
+	if key == "" {
+		next := p.next.Add(1)
+		return balancer.PickResult{SubConn: p.subConns[int(next)%len(p.subConns)]}, nil
+	}
+	return balancer.PickResult{SubConn: p.subConns[rendezvousIndex(key, p.addresses)]}, nil
+}
+
+// repoAffinityResolverBuilder builds resolvers which periodically resolve all the addresses of a host, e.g. the pod
+// IPs of a headless service, as opposed to the passthrough resolver used by default.
+type repoAffinityResolverBuilder struct {
+	lookupHost func(ctx context.Context, host string) ([]string, error)
+}
+
+func (b *repoAffinityResolverBuilder) Build(target resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions) (resolver.Resolver, error) {
+	host, port, err := net.SplitHostPort(target.Endpoint())
+	if err != nil {
+		return nil, fmt.Errorf("invalid repo server address %q: %w", target.Endpoint(), err)
+	}
+	lookupHost := b.lookupHost
+	if lookupHost == nil {
+		lookupHost = net.DefaultResolver.LookupHost
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &repoAffinityResolver{
+		host:       host,
+		port:       port,
+		cc:         cc,
+		lookupHost: lookupHost,
+		resolveNow: make(chan struct{}, 1),
+		cancel:     cancel,
+	}
+	r.wg.Add(1)
+	go r.watch(ctx)
+	return r, nil
+}
+
+func (*repoAffinityResolverBuilder) Scheme() string {
+	return repoAffinityScheme
+}
+
+type repoAffinityResolver struct {
+	host       string
+	port       string
+	cc         resolver.ClientConn
+	lookupHost func(ctx context.Context, host string) ([]string, error)
+	resolveNow chan struct{}
+	cancel     context.CancelFunc
+	wg         sync.WaitGroup
+	addresses  []string
+}
+
+func (r *repoAffinityResolver) watch(ctx context.Context) {
+	defer r.wg.Done()
+	ticker := time.NewTicker(repoAffinityResolveInterval)
+	defer ticker.Stop()
+	for {
+		r.resolve(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-r.resolveNow:
+		}
+	}
+}
+
+func (r *repoAffinityResolver) resolve(ctx context.Context) {
+	ips, err := r.lookupHost(ctx, r.host)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Warnf("Failed to resolve repo server address %s: %v", r.host, err)
+			r.cc.ReportError(err)
+		}
+		return
+	}
+	addresses := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		addresses = append(addresses, net.JoinHostPort(ip, r.port))
+	}
+	slices.Sort(addresses)
+	if slices.Equal(addresses, r.addresses) {
+		return
+	}
+	state := resolver.State{}
+	for _, address := range addresses {
+		state.Addresses = append(state.Addresses, resolver.Address{Addr: address})
+	}
+	if err := r.cc.UpdateState(state); err != nil {
+		log.Warnf("Failed to update repo server addresses: %v", err)
+		return
+	}
+	log.Infof("Resolved %d repo server replicas for %s", len(addresses), r.host)
+	r.addresses = addresses
+}
+
+func (r *repoAffinityResolver) ResolveNow(resolver.ResolveNowOptions) {
+	select {
+	case r.resolveNow <- struct{}{}:
+	default:
+	}
+}
+
+func (r *repoAffinityResolver) Close() {
+	r.cancel()
+	r.wg.Wait()
+}
@@ -0,0 +1,181 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/balancer/base"
+	"google.golang.org/grpc/resolver"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+type fakeSubConn struct {
+	balancer.SubConn
+	address string
+}
+
+type fakeResolverClientConn struct {
+	resolver.ClientConn
+	lock   sync.Mutex
+	states []resolver.State
+}
+
+func (cc *fakeResolverClientConn) UpdateState(state resolver.State) error {
+	cc.lock.Lock()
+	defer cc.lock.Unlock()
+	cc.states = append(cc.states, state)
+	return nil
+}
+
+func (cc *fakeResolverClientConn) ReportError(error) {}
+
+func (cc *fakeResolverClientConn) getStates() []resolver.State {
+	cc.lock.Lock()
+	defer cc.lock.Unlock()
+	return append([]resolver.State{}, cc.states...)
+}
+
+func parseTarget(t *testing.T, target string) resolver.Target {
+	t.Helper()
+	u, err := url.Parse(target)
+	require.NoError(t, err)
+	return resolver.Target{URL: *u}
+}
+
+func buildRepoAffinityPicker(addresses ...string) balancer.Picker {
+	info := base.PickerBuildInfo{ReadySCs: map[balancer.SubConn]base.SubConnInfo{}}
+	for _, address := range addresses {
+		info.ReadySCs[&fakeSubConn{address: address}] = base.SubConnInfo{Address: resolver.Address{Addr: address}}
+	}
+	return (&repoAffinityPickerBuilder{}).Build(info)
+}
+
+func pickAddress(t *testing.T, picker balancer.Picker, repo string) string {
+	t.Helper()
+	ctx := context.Background()
+	if repo != "" {
+		ctx = context.WithValue(ctx, repoAffinityKey{}, repo)
+	}
+	res, err := picker.Pick(balancer.PickInfo{Ctx: ctx})
+	require.NoError(t, err)
+	return res.SubConn.(*fakeSubConn).address
+}
+
+func TestRendezvousIndex(t *testing.T) {
+	nodes := []string{"10.0.0.1:8081", "10.0.0.2:8081", "10.0.0.3:8081", "10.0.0.4:8081"}
+	assignments := map[string]string{}
+	counts := map[string]int{}
+	for i := 0; i < 400; i++ {
+		repo := fmt.Sprintf("https://github.com/org/repo-%d", i)
+		node := nodes[rendezvousIndex(repo, nodes)]
+		assert.Equal(t, node, nodes[rendezvousIndex(repo, nodes)])
+		assignments[repo] = node
+		counts[node]++
+	}
+	for _, node := range nodes {
+		assert.Greater(t, counts[node], 50, node)
+	}
+
+	// only the repositories of the removed node are moved
+	remaining := []string{nodes[0], nodes[1], nodes[3]}
+	for repo, node := range assignments {
+		moved := remaining[rendezvousIndex(repo, remaining)]
+		if node != nodes[2] {
+			assert.Equal(t, node, moved, repo)
+		}
+	}
+}
+
+func TestRepoAffinityPicker(t *testing.T) {
+	t.Run("NoReadyReplicas", func(t *testing.T) {
+		_, err := buildRepoAffinityPicker().Pick(balancer.PickInfo{Ctx: context.Background()})
+		assert.ErrorIs(t, err, balancer.ErrNoSubConnAvailable)
+	})
+	t.Run("Affinity", func(t *testing.T) {
+		addresses := []string{"10.0.0.1:8081", "10.0.0.2:8081", "10.0.0.3:8081"}
+		picker := buildRepoAffinityPicker(addresses...)
+		for i := 0; i < 20; i++ {
+			repo := fmt.Sprintf("https://github.com/org/repo-%d", i)
+			address := pickAddress(t, picker, repo)
+			assert.Equal(t, addresses[rendezvousIndex(repo, addresses)], address)
+			assert.Equal(t, address, pickAddress(t, picker, repo))
+			// the picker is rebuilt with the same replicas in a different order
+			assert.Equal(t, address, pickAddress(t, buildRepoAffinityPicker(addresses[2], addresses[0], addresses[1]), repo))
+		}
+	})
+	t.Run("Failover", func(t *testing.T) {
+		addresses := []string{"10.0.0.1:8081", "10.0.0.2:8081"}
+		repo := "https://github.com/org/repo"
+		address := pickAddress(t, buildRepoAffinityPicker(addresses...), repo)
+		remaining := addresses[0]
+		if address == remaining {
+			remaining = addresses[1]
+		}
+		assert.Equal(t, remaining, pickAddress(t, buildRepoAffinityPicker(remaining), repo))
+	})
+	t.Run("RoundRobinWithoutRepo", func(t *testing.T) {
+		picker := buildRepoAffinityPicker("10.0.0.1:8081", "10.0.0.2:8081")
+		picked := map[string]bool{}
+		for i := 0; i < 4; i++ {
+			picked[pickAddress(t, picker, "")] = true
+		}
+		assert.Len(t, picked, 2)
+	})
+}
+
+func TestRepoAffinityUnaryInterceptor(t *testing.T) {
+	invoke := func(req any) string {
+		var key string
+		err := repoAffinityUnaryInterceptor(context.Background(), "/method", req, nil, nil, func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+			key = repoAffinityFromContext(ctx)
+			return nil
+		})
+		require.NoError(t, err)
+		return key
+	}
+
+	assert.Equal(t, "https://github.com/argoproj/argo-cd", invoke(&ManifestRequest{Repo: &v1alpha1.Repository{Repo: "https://github.com/argoproj/argo-cd.git"}}))
+	assert.Equal(t, "https://github.com/argoproj/argo-cd", invoke(&ListAppsRequest{Repo: &v1alpha1.Repository{Repo: "https://GitHub.com/argoproj/argo-cd"}}))
+	assert.Empty(t, invoke(&ManifestRequest{}))
+	assert.Empty(t, invoke(&TestRepositoryRequest{Repo: &v1alpha1.Repository{}}))
+	assert.Empty(t, invoke(&HelmChartsRequest{}))
+}
+
+func TestRepoAffinityResolver(t *testing.T) {
+	var lock sync.Mutex
+	ips := []string{"10.0.0.2", "10.0.0.1"}
+	builder := &repoAffinityResolverBuilder{lookupHost: func(_ context.Context, host string) ([]string, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		assert.Equal(t, "argocd-repo-server-headless", host)
+		return append([]string{}, ips...), nil
+	}}
+	cc := &fakeResolverClientConn{}
+	r, err := builder.Build(parseTarget(t, "argocd-repo-affinity:///argocd-repo-server-headless:8081"), cc, resolver.BuildOptions{})
+	require.NoError(t, err)
+	defer r.Close()
+
+	require.Eventually(t, func() bool { return len(cc.getStates()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []resolver.Address{{Addr: "10.0.0.1:8081"}, {Addr: "10.0.0.2:8081"}}, cc.getStates()[0].Addresses)
+
+	// unchanged addresses are not updated again
+	r.ResolveNow(resolver.ResolveNowOptions{})
+	lock.Lock()
+	ips = []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"}
+	lock.Unlock()
+	r.ResolveNow(resolver.ResolveNowOptions{})
+	require.Eventually(t, func() bool { return len(cc.getStates()) == 2 }, 5*time.Second, 10*time.Millisecond)
+	assert.Len(t, cc.getStates()[1].Addresses, 3)
+
+	_, err = builder.Build(parseTarget(t, "argocd-repo-affinity:///argocd-repo-server-headless"), cc, resolver.BuildOptions{})
+	assert.ErrorContains(t, err, "invalid repo server address")
+}
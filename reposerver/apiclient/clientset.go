@@ -5,6 +5,7 @@ import (
 	"crypto/x509"
 	"fmt"
 	"math"
+	"sync"
 	"time"
 
 	"github.com/argoproj/argo-cd/v3/common"
@@ -40,13 +41,36 @@ type Clientset interface {
 	NewRepoServerClient() (utilio.Closer, RepoServerServiceClient, error)
 }
 
+// ClientsetOpts are options for the repo server Clientset
+type ClientsetOpts func(c *clientSet)
+
+// WithRepoAffinity routes the requests for the same repository to the same repo server replica. The address must
+// resolve to the addresses of all the replicas, e.g. using a headless service.
+func WithRepoAffinity(enabled bool) ClientsetOpts {
+	return func(c *clientSet) {
+		c.repoAffinity = enabled
+	}
+}
+
 type clientSet struct {
 	address        string
 	timeoutSeconds int
 	tlsConfig      TLSConfiguration
+	repoAffinity   bool
+
+	// connLock protects conn, which is shared by the clients when repo affinity is enabled
+	connLock sync.Mutex
+	conn     *grpc.ClientConn
 }
 
 func (c *clientSet) NewRepoServerClient() (utilio.Closer, RepoServerServiceClient, error) {
+	if c.repoAffinity {
+		conn, err := c.sharedConnection()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to open a new connection to repo server: %w", err)
+		}
+		return utilio.NopCloser, NewRepoServerServiceClient(conn), nil
+	}
 	conn, err := NewConnection(c.address, c.timeoutSeconds, &c.tlsConfig)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to open a new connection to repo server: %w", err)
@@ -54,12 +78,36 @@ func (c *clientSet) NewRepoServerClient() (utilio.Closer, RepoServerServiceClien
 	return conn, NewRepoServerServiceClient(conn), nil
 }
 
+// sharedConnection returns the long-lived connection to all the repo server replicas. Sharing the connection keeps
+// the balancer aware of the ready replicas, so that requests are not routed while connecting to all of them.
+func (c *clientSet) sharedConnection() (*grpc.ClientConn, error) {
+	c.connLock.Lock()
+	defer c.connLock.Unlock()
+	if c.conn == nil {
+		conn, err := newConnection(c.address, c.timeoutSeconds, &c.tlsConfig, true)
+		if err != nil {
+			return nil, err
+		}
+		c.conn = conn
+	}
+	return c.conn, nil
+}
+
 func NewConnection(address string, timeoutSeconds int, tlsConfig *TLSConfiguration) (*grpc.ClientConn, error) {
+	return newConnection(address, timeoutSeconds, tlsConfig, false)
+}
+
+func newConnection(address string, timeoutSeconds int, tlsConfig *TLSConfiguration, repoAffinity bool) (*grpc.ClientConn, error) {
 	retryOpts := []grpc_retry.CallOption{
 		grpc_retry.WithMax(3),
 		grpc_retry.WithBackoff(grpc_retry.BackoffLinear(1000 * time.Millisecond)),
 	}
-	unaryInterceptors := []grpc.UnaryClientInterceptor{grpc_retry.UnaryClientInterceptor(retryOpts...)}
+	var unaryInterceptors []grpc.UnaryClientInterceptor
+	if repoAffinity {
+		// the repository must be known by the picker of every retry
+		unaryInterceptors = append(unaryInterceptors, repoAffinityUnaryInterceptor)
+	}
+	unaryInterceptors = append(unaryInterceptors, grpc_retry.UnaryClientInterceptor(retryOpts...))
 	if timeoutSeconds > 0 {
 		unaryInterceptors = append(unaryInterceptors, timeout.UnaryClientInterceptor(time.Duration(timeoutSeconds)*time.Second))
 	}
@@ -81,6 +129,12 @@ func NewConnection(address string, timeoutSeconds int, tlsConfig *TLSConfigurati
 	} else {
 		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	}
+	if repoAffinity {
+		opts = append(opts,
+			grpc.WithResolvers(&repoAffinityResolverBuilder{}),
+			grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"loadBalancingConfig": [{%q: {}}]}`, RepoAffinityBalancerName)))
+		address = repoAffinityScheme + ":///" + address
+	}
 
 	//nolint:staticcheck
 	conn, err := grpc.Dial(address, opts...)
@@ -92,6 +146,10 @@ func NewConnection(address string, timeoutSeconds int, tlsConfig *TLSConfigurati
 }
 
 // NewRepoServerClientset creates new instance of repo server Clientset
-func NewRepoServerClientset(address string, timeoutSeconds int, tlsConfig TLSConfiguration) Clientset {
-	return &clientSet{address: address, timeoutSeconds: timeoutSeconds, tlsConfig: tlsConfig}
+func NewRepoServerClientset(address string, timeoutSeconds int, tlsConfig TLSConfiguration, opts ...ClientsetOpts) Clientset {
+	c := &clientSet{address: address, timeoutSeconds: timeoutSeconds, tlsConfig: tlsConfig}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
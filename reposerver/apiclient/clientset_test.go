@@ -90,3 +90,16 @@ func TestNewConnection_InsecureConnection(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotNil(t, conn)
 }
+
+func TestNewRepoServerClientset_RepoAffinity(t *testing.T) {
+	clientset := apiclient.NewRepoServerClientset("argocd-repo-server-headless:8081", 1, apiclient.TLSConfiguration{DisableTLS: true}, apiclient.WithRepoAffinity(true))
+
+	closer, client, err := clientset.NewRepoServerClient()
+	require.NoError(t, err)
+	assert.NotNil(t, client)
+	// the connection is shared by the clients and is not closed
+	require.NoError(t, closer.Close())
+	_, client, err = clientset.NewRepoServerClient()
+	require.NoError(t, err)
+	assert.NotNil(t, client)
+}
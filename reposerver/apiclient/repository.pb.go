@@ -63,10 +63,16 @@ type ManifestRequest struct {
 	// argocd.argoproj.io/manifest-generate-paths annotation value of the Application to allow optimize which resources propagated to cmpserver
 	AnnotationManifestGeneratePaths string `protobuf:"bytes,26,opt,name=annotationManifestGeneratePaths,proto3" json:"annotationManifestGeneratePaths,omitempty"`
 	// Holds instance installation id
-	InstallationID       string   `protobuf:"bytes,27,opt,name=installationID,proto3" json:"installationID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	InstallationID string `protobuf:"bytes,27,opt,name=installationID,proto3" json:"installationID,omitempty"`
+	// Request to verify the cosign signatures of OCI artifacts against the given specification (only for OCI repositories)
+	OciSignatureVerification *v1alpha1.OCISignatureVerification `protobuf:"bytes,28,opt,name=ociSignatureVerification,proto3" json:"ociSignatureVerification,omitempty"`
+	// Helm values read from the ConfigMaps and Secrets referenced by the valuesFrom of the Helm source, in the same order
+	HelmValuesFrom []string `protobuf:"bytes,29,rep,name=helmValuesFrom,proto3" json:"helmValuesFrom,omitempty"`
+	// Git repositories permitted by the project, whose credentials are used to fetch jsonnet-bundler dependencies
+	GitRepos             []*v1alpha1.Repository `protobuf:"bytes,30,rep,name=gitRepos,proto3" json:"gitRepos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
 func (m *ManifestRequest) Reset()         { *m = ManifestRequest{} }
@@ -270,6 +276,27 @@ func (m *ManifestRequest) GetInstallationID() string {
 	return ""
 }
 
+func (m *ManifestRequest) GetOciSignatureVerification() *v1alpha1.OCISignatureVerification {
+	if m != nil {
+		return m.OciSignatureVerification
+	}
+	return nil
+}
+
+func (m *ManifestRequest) GetHelmValuesFrom() []string {
+	if m != nil {
+		return m.HelmValuesFrom
+	}
+	return nil
+}
+
+func (m *ManifestRequest) GetGitRepos() []*v1alpha1.Repository {
+	if m != nil {
+		return m.GitRepos
+	}
+	return nil
+}
+
 type ManifestRequestWithFiles struct {
 	// Types that are valid to be assigned to Part:
 	//	*ManifestRequestWithFiles_Request
@@ -711,7 +738,9 @@ type ManifestResponse struct {
 	// Raw response of git verify-commit operation (always the empty string for Helm)
 	VerifyResult string `protobuf:"bytes,7,opt,name=verifyResult,proto3" json:"verifyResult,omitempty"`
 	// Commands is the list of commands used to hydrate the manifests
-	Commands             []string `protobuf:"bytes,8,rep,name=commands,proto3" json:"commands,omitempty"`
+	Commands []string `protobuf:"bytes,8,rep,name=commands,proto3" json:"commands,omitempty"`
+	// Provenance holds the DSSE envelopes of the signed provenance attestations of the manifests, if the repo server has a signing key
+	Provenance           []string `protobuf:"bytes,9,rep,name=provenance,proto3" json:"provenance,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -799,6 +828,13 @@ func (m *ManifestResponse) GetCommands() []string {
 	return nil
 }
 
+func (m *ManifestResponse) GetProvenance() []string {
+	if m != nil {
+		return m.Provenance
+	}
+	return nil
+}
+
 type ListRefsRequest struct {
 	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -1244,6 +1280,7 @@ type RepoAppDetailsResponse struct {
 	Kustomize            *KustomizeAppSpec `protobuf:"bytes,4,opt,name=kustomize,proto3" json:"kustomize,omitempty"`
 	Directory            *DirectoryAppSpec `protobuf:"bytes,5,opt,name=directory,proto3" json:"directory,omitempty"`
 	Plugin               *PluginAppSpec    `protobuf:"bytes,6,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	Cue                  *CUEAppSpec       `protobuf:"bytes,7,opt,name=cue,proto3" json:"cue,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1317,6 +1354,13 @@ func (m *RepoAppDetailsResponse) GetPlugin() *PluginAppSpec {
 	return nil
 }
 
+func (m *RepoAppDetailsResponse) GetCue() *CUEAppSpec {
+	if m != nil {
+		return m.Cue
+	}
+	return nil
+}
+
 type RepoServerRevisionMetadataRequest struct {
 	// the repo
 	Repo *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
@@ -1792,6 +1836,55 @@ func (m *PluginAppSpec) GetParametersAnnouncement() []*ParameterAnnouncement {
 	return nil
 }
 
+// CUEAppSpec contains the tags declared by the CUE package
+type CUEAppSpec struct {
+	// tags declared by @tag() attributes, with the default values of the fields they are injected into
+	Tags                 []*v1alpha1.CUETag `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CUEAppSpec) Reset()         { *m = CUEAppSpec{} }
+func (m *CUEAppSpec) String() string { return proto.CompactTextString(m) }
+func (*CUEAppSpec) ProtoMessage()    {}
+func (*CUEAppSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{24}
+}
+func (m *CUEAppSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CUEAppSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CUEAppSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CUEAppSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CUEAppSpec.Merge(m, src)
+}
+func (m *CUEAppSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *CUEAppSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_CUEAppSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CUEAppSpec proto.InternalMessageInfo
+
+func (m *CUEAppSpec) GetTags() []*v1alpha1.CUETag {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
 type HelmChartsRequest struct {
 	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -1803,7 +1896,7 @@ func (m *HelmChartsRequest) Reset()         { *m = HelmChartsRequest{} }
 func (m *HelmChartsRequest) String() string { return proto.CompactTextString(m) }
 func (*HelmChartsRequest) ProtoMessage()    {}
 func (*HelmChartsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{24}
+	return fileDescriptor_dd8723cfcc820480, []int{25}
 }
 func (m *HelmChartsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1851,7 +1944,7 @@ func (m *HelmChart) Reset()         { *m = HelmChart{} }
 func (m *HelmChart) String() string { return proto.CompactTextString(m) }
 func (*HelmChart) ProtoMessage()    {}
 func (*HelmChart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{25}
+	return fileDescriptor_dd8723cfcc820480, []int{26}
 }
 func (m *HelmChart) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1905,7 +1998,7 @@ func (m *HelmChartsResponse) Reset()         { *m = HelmChartsResponse{} }
 func (m *HelmChartsResponse) String() string { return proto.CompactTextString(m) }
 func (*HelmChartsResponse) ProtoMessage()    {}
 func (*HelmChartsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{26}
+	return fileDescriptor_dd8723cfcc820480, []int{27}
 }
 func (m *HelmChartsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1958,7 +2051,7 @@ func (m *GitFilesRequest) Reset()         { *m = GitFilesRequest{} }
 func (m *GitFilesRequest) String() string { return proto.CompactTextString(m) }
 func (*GitFilesRequest) ProtoMessage()    {}
 func (*GitFilesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{27}
+	return fileDescriptor_dd8723cfcc820480, []int{28}
 }
 func (m *GitFilesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2048,7 +2141,7 @@ func (m *GitFilesResponse) Reset()         { *m = GitFilesResponse{} }
 func (m *GitFilesResponse) String() string { return proto.CompactTextString(m) }
 func (*GitFilesResponse) ProtoMessage()    {}
 func (*GitFilesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{28}
+	return fileDescriptor_dd8723cfcc820480, []int{29}
 }
 func (m *GitFilesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2099,7 +2192,7 @@ func (m *GitDirectoriesRequest) Reset()         { *m = GitDirectoriesRequest{} }
 func (m *GitDirectoriesRequest) String() string { return proto.CompactTextString(m) }
 func (*GitDirectoriesRequest) ProtoMessage()    {}
 func (*GitDirectoriesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{29}
+	return fileDescriptor_dd8723cfcc820480, []int{30}
 }
 func (m *GitDirectoriesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2175,7 +2268,7 @@ func (m *GitDirectoriesResponse) Reset()         { *m = GitDirectoriesResponse{}
 func (m *GitDirectoriesResponse) String() string { return proto.CompactTextString(m) }
 func (*GitDirectoriesResponse) ProtoMessage()    {}
 func (*GitDirectoriesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{30}
+	return fileDescriptor_dd8723cfcc820480, []int{31}
 }
 func (m *GitDirectoriesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2212,31 +2305,33 @@ func (m *GitDirectoriesResponse) GetPaths() []string {
 }
 
 type UpdateRevisionForPathsRequest struct {
-	Repo                 *v1alpha1.Repository           `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	AppLabelKey          string                         `protobuf:"bytes,2,opt,name=appLabelKey,proto3" json:"appLabelKey,omitempty"`
-	AppName              string                         `protobuf:"bytes,3,opt,name=appName,proto3" json:"appName,omitempty"`
-	Namespace            string                         `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	ApplicationSource    *v1alpha1.ApplicationSource    `protobuf:"bytes,5,opt,name=applicationSource,proto3" json:"applicationSource,omitempty"`
-	TrackingMethod       string                         `protobuf:"bytes,6,opt,name=trackingMethod,proto3" json:"trackingMethod,omitempty"`
-	RefSources           map[string]*v1alpha1.RefTarget `protobuf:"bytes,7,rep,name=refSources,proto3" json:"refSources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	KubeVersion          string                         `protobuf:"bytes,8,opt,name=kubeVersion,proto3" json:"kubeVersion,omitempty"`
-	ApiVersions          []string                       `protobuf:"bytes,9,rep,name=apiVersions,proto3" json:"apiVersions,omitempty"`
-	HasMultipleSources   bool                           `protobuf:"varint,10,opt,name=hasMultipleSources,proto3" json:"hasMultipleSources,omitempty"`
-	SyncedRevision       string                         `protobuf:"bytes,11,opt,name=syncedRevision,proto3" json:"syncedRevision,omitempty"`
-	Revision             string                         `protobuf:"bytes,12,opt,name=revision,proto3" json:"revision,omitempty"`
-	Paths                []string                       `protobuf:"bytes,13,rep,name=paths,proto3" json:"paths,omitempty"`
-	NoRevisionCache      bool                           `protobuf:"varint,14,opt,name=noRevisionCache,proto3" json:"noRevisionCache,omitempty"`
-	InstallationID       string                         `protobuf:"bytes,15,opt,name=installationID,proto3" json:"installationID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
-	XXX_unrecognized     []byte                         `json:"-"`
-	XXX_sizecache        int32                          `json:"-"`
+	Repo               *v1alpha1.Repository           `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	AppLabelKey        string                         `protobuf:"bytes,2,opt,name=appLabelKey,proto3" json:"appLabelKey,omitempty"`
+	AppName            string                         `protobuf:"bytes,3,opt,name=appName,proto3" json:"appName,omitempty"`
+	Namespace          string                         `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ApplicationSource  *v1alpha1.ApplicationSource    `protobuf:"bytes,5,opt,name=applicationSource,proto3" json:"applicationSource,omitempty"`
+	TrackingMethod     string                         `protobuf:"bytes,6,opt,name=trackingMethod,proto3" json:"trackingMethod,omitempty"`
+	RefSources         map[string]*v1alpha1.RefTarget `protobuf:"bytes,7,rep,name=refSources,proto3" json:"refSources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	KubeVersion        string                         `protobuf:"bytes,8,opt,name=kubeVersion,proto3" json:"kubeVersion,omitempty"`
+	ApiVersions        []string                       `protobuf:"bytes,9,rep,name=apiVersions,proto3" json:"apiVersions,omitempty"`
+	HasMultipleSources bool                           `protobuf:"varint,10,opt,name=hasMultipleSources,proto3" json:"hasMultipleSources,omitempty"`
+	SyncedRevision     string                         `protobuf:"bytes,11,opt,name=syncedRevision,proto3" json:"syncedRevision,omitempty"`
+	Revision           string                         `protobuf:"bytes,12,opt,name=revision,proto3" json:"revision,omitempty"`
+	Paths              []string                       `protobuf:"bytes,13,rep,name=paths,proto3" json:"paths,omitempty"`
+	NoRevisionCache    bool                           `protobuf:"varint,14,opt,name=noRevisionCache,proto3" json:"noRevisionCache,omitempty"`
+	InstallationID     string                         `protobuf:"bytes,15,opt,name=installationID,proto3" json:"installationID,omitempty"`
+	// Hash of the Helm values read from the ConfigMaps and Secrets referenced by the valuesFrom of the Helm source
+	HelmValuesFromHash   string   `protobuf:"bytes,16,opt,name=helmValuesFromHash,proto3" json:"helmValuesFromHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *UpdateRevisionForPathsRequest) Reset()         { *m = UpdateRevisionForPathsRequest{} }
 func (m *UpdateRevisionForPathsRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateRevisionForPathsRequest) ProtoMessage()    {}
 func (*UpdateRevisionForPathsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{31}
+	return fileDescriptor_dd8723cfcc820480, []int{32}
 }
 func (m *UpdateRevisionForPathsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2370,6 +2465,13 @@ func (m *UpdateRevisionForPathsRequest) GetInstallationID() string {
 	return ""
 }
 
+func (m *UpdateRevisionForPathsRequest) GetHelmValuesFromHash() string {
+	if m != nil {
+		return m.HelmValuesFromHash
+	}
+	return ""
+}
+
 type UpdateRevisionForPathsResponse struct {
 	// Changes indicates whether any changes were detected in the provided paths. If false, it means that the manifest
 	// cache was updated to the new revision. If true, it means that there are relevant changes in the repo files and
@@ -2385,7 +2487,7 @@ func (m *UpdateRevisionForPathsResponse) Reset()         { *m = UpdateRevisionFo
 func (m *UpdateRevisionForPathsResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateRevisionForPathsResponse) ProtoMessage()    {}
 func (*UpdateRevisionForPathsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{32}
+	return fileDescriptor_dd8723cfcc820480, []int{33}
 }
 func (m *UpdateRevisionForPathsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2460,6 +2562,7 @@ func init() {
 	proto.RegisterType((*ParameterAnnouncement)(nil), "repository.ParameterAnnouncement")
 	proto.RegisterMapType((map[string]string)(nil), "repository.ParameterAnnouncement.MapEntry")
 	proto.RegisterType((*PluginAppSpec)(nil), "repository.PluginAppSpec")
+	proto.RegisterType((*CUEAppSpec)(nil), "repository.CUEAppSpec")
 	proto.RegisterType((*HelmChartsRequest)(nil), "repository.HelmChartsRequest")
 	proto.RegisterType((*HelmChart)(nil), "repository.HelmChart")
 	proto.RegisterType((*HelmChartsResponse)(nil), "repository.HelmChartsResponse")
@@ -2478,158 +2581,166 @@ func init() {
 }
 
 var fileDescriptor_dd8723cfcc820480 = []byte{
-	// 2407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0xcb, 0x72, 0x1c, 0x49,
-	0x71, 0x9e, 0xd2, 0x4c, 0xea, 0x5d, 0xb6, 0xe5, 0xf6, 0xd8, 0x16, 0xda, 0x06, 0x3b, 0xbc, 0xf6,
-	0xee, 0x28, 0x6c, 0xc7, 0xae, 0xc1, 0xbb, 0x2c, 0xa1, 0x95, 0x6d, 0x49, 0x6b, 0xcb, 0x16, 0x6d,
-	0xef, 0x12, 0x06, 0x03, 0x51, 0xd3, 0x53, 0xea, 0xe9, 0x55, 0x3f, 0xca, 0xdd, 0xd5, 0x5a, 0xe4,
-	0x08, 0x2e, 0x40, 0x70, 0xe1, 0xc2, 0x69, 0x0f, 0x5c, 0xf9, 0x06, 0x82, 0x23, 0x27, 0x02, 0x8e,
-	0x04, 0x17, 0x2e, 0x44, 0x40, 0xf8, 0x4b, 0x88, 0x7a, 0xf4, 0x73, 0x7a, 0x46, 0x5a, 0x8f, 0xad,
-	0x05, 0x2e, 0x52, 0x57, 0x56, 0x56, 0x66, 0x56, 0x56, 0x66, 0x56, 0x66, 0xd6, 0xc0, 0xe5, 0x80,
-	0x50, 0x3f, 0x24, 0xc1, 0x01, 0x09, 0xd6, 0xc4, 0xa7, 0xcd, 0xfc, 0xe0, 0x30, 0xf3, 0xd9, 0xa5,
-	0x81, 0xcf, 0x7c, 0x04, 0x29, 0xa4, 0xf3, 0xc0, 0xb2, 0xd9, 0x20, 0xea, 0x75, 0x4d, 0xdf, 0x5d,
-	0xc3, 0x81, 0xe5, 0xd3, 0xc0, 0xff, 0x5c, 0x7c, 0xbc, 0x6b, 0xf6, 0xd7, 0x0e, 0x6e, 0xae, 0xd1,
-	0x7d, 0x6b, 0x0d, 0x53, 0x3b, 0x5c, 0xc3, 0x94, 0x3a, 0xb6, 0x89, 0x99, 0xed, 0x7b, 0x6b, 0x07,
-	0xd7, 0xb1, 0x43, 0x07, 0xf8, 0xfa, 0x9a, 0x45, 0x3c, 0x12, 0x60, 0x46, 0xfa, 0x92, 0x72, 0xe7,
-	0xbc, 0xe5, 0xfb, 0x96, 0x43, 0xd6, 0xc4, 0xa8, 0x17, 0xed, 0xad, 0x11, 0x97, 0x32, 0xc5, 0x56,
-	0xff, 0xe7, 0x1c, 0x2c, 0xec, 0x60, 0xcf, 0xde, 0x23, 0x21, 0x33, 0xc8, 0xf3, 0x88, 0x84, 0x0c,
-	0x3d, 0x83, 0x06, 0x17, 0x46, 0xab, 0xae, 0x56, 0xaf, 0xcc, 0xdc, 0xd8, 0xea, 0xa6, 0xd2, 0x74,
-	0x63, 0x69, 0xc4, 0xc7, 0x4f, 0xcd, 0x7e, 0xf7, 0xe0, 0x66, 0x97, 0xee, 0x5b, 0x5d, 0x2e, 0x4d,
-	0x37, 0x23, 0x4d, 0x37, 0x96, 0xa6, 0x6b, 0x24, 0xdb, 0x32, 0x04, 0x55, 0xd4, 0x81, 0x56, 0x40,
-	0x0e, 0xec, 0xd0, 0xf6, 0x3d, 0xad, 0xb6, 0x5a, 0xbd, 0xd2, 0x36, 0x92, 0x31, 0xd2, 0x60, 0xda,
-	0xf3, 0x37, 0xb0, 0x39, 0x20, 0x5a, 0x7d, 0xb5, 0x7a, 0xa5, 0x65, 0xc4, 0x43, 0xb4, 0x0a, 0x33,
-	0x98, 0xd2, 0x07, 0xb8, 0x47, 0x9c, 0xfb, 0xe4, 0x50, 0x6b, 0x88, 0x85, 0x59, 0x10, 0x5f, 0x8b,
-	0x29, 0x7d, 0x88, 0x5d, 0xa2, 0x35, 0xc5, 0x6c, 0x3c, 0x44, 0x17, 0xa0, 0xed, 0x61, 0x97, 0x84,
-	0x14, 0x9b, 0x44, 0x6b, 0x89, 0xb9, 0x14, 0x80, 0x7e, 0x0e, 0x4b, 0x19, 0xc1, 0x1f, 0xfb, 0x51,
-	0x60, 0x12, 0x0d, 0xc4, 0xd6, 0x1f, 0x4d, 0xb6, 0xf5, 0xf5, 0x22, 0x59, 0x63, 0x98, 0x13, 0xfa,
-	0x09, 0x34, 0xc5, 0xc9, 0x6b, 0x33, 0xab, 0xf5, 0xd7, 0xaa, 0x6d, 0x49, 0x16, 0x79, 0x30, 0x4d,
-	0x9d, 0xc8, 0xb2, 0xbd, 0x50, 0x9b, 0x15, 0x1c, 0x9e, 0x4c, 0xc6, 0x61, 0xc3, 0xf7, 0xf6, 0x6c,
-	0x6b, 0x07, 0x7b, 0xd8, 0x22, 0x2e, 0xf1, 0xd8, 0xae, 0x20, 0x6e, 0xc4, 0x4c, 0xd0, 0x0b, 0x58,
-	0xdc, 0x8f, 0x42, 0xe6, 0xbb, 0xf6, 0x0b, 0xf2, 0x88, 0xf2, 0xb5, 0xa1, 0x36, 0x27, 0xb4, 0xf9,
-	0x70, 0x32, 0xc6, 0xf7, 0x0b, 0x54, 0x8d, 0x21, 0x3e, 0xdc, 0x48, 0xf6, 0xa3, 0x1e, 0xf9, 0x8c,
-	0x04, 0xc2, 0xba, 0xe6, 0xa5, 0x91, 0x64, 0x40, 0xd2, 0x8c, 0x6c, 0x35, 0x0a, 0xb5, 0x85, 0xd5,
-	0xba, 0x34, 0xa3, 0x04, 0x84, 0xae, 0xc0, 0xc2, 0x01, 0x09, 0xec, 0xbd, 0xc3, 0xc7, 0xb6, 0xe5,
-	0x61, 0x16, 0x05, 0x44, 0x5b, 0x14, 0xa6, 0x58, 0x04, 0x23, 0x17, 0xe6, 0x06, 0xc4, 0x71, 0xb9,
-	0xca, 0x37, 0x02, 0xd2, 0x0f, 0xb5, 0x25, 0xa1, 0xdf, 0xcd, 0xc9, 0x4f, 0x50, 0x90, 0x33, 0xf2,
-	0xd4, 0xb9, 0x60, 0x9e, 0x6f, 0x28, 0x4f, 0x91, 0x3e, 0x82, 0xa4, 0x60, 0x05, 0x30, 0xba, 0x0c,
-	0xf3, 0x2c, 0xc0, 0xe6, 0xbe, 0xed, 0x59, 0x3b, 0x84, 0x0d, 0xfc, 0xbe, 0x76, 0x4a, 0x68, 0xa2,
-	0x00, 0x45, 0x26, 0x20, 0xe2, 0xe1, 0x9e, 0x43, 0xfa, 0xd2, 0x16, 0x9f, 0x1c, 0x52, 0x12, 0x6a,
-	0xa7, 0xc5, 0x2e, 0x6e, 0x76, 0x33, 0x11, 0xaa, 0x10, 0x20, 0xba, 0x77, 0x87, 0x56, 0xdd, 0xf5,
-	0x58, 0x70, 0x68, 0x94, 0x90, 0x43, 0xfb, 0x30, 0xc3, 0xf7, 0x11, 0x9b, 0xc2, 0x19, 0x61, 0x0a,
-	0xdb, 0x93, 0xe9, 0x68, 0x2b, 0x25, 0x68, 0x64, 0xa9, 0xa3, 0x2e, 0xa0, 0x01, 0x0e, 0x77, 0x22,
-	0x87, 0xd9, 0xd4, 0x21, 0x52, 0x8c, 0x50, 0x5b, 0x16, 0x6a, 0x2a, 0x99, 0x41, 0xf7, 0x01, 0x02,
-	0xb2, 0x17, 0xe3, 0x9d, 0x15, 0x3b, 0xbf, 0x36, 0x6e, 0xe7, 0x46, 0x82, 0x2d, 0x77, 0x9c, 0x59,
-	0xce, 0x99, 0xf3, 0x6d, 0x10, 0x93, 0x29, 0x6f, 0x17, 0x6e, 0xad, 0x09, 0x13, 0x2b, 0x99, 0xe1,
-	0xb6, 0xa8, 0xa0, 0x22, 0x68, 0x9d, 0x93, 0xd6, 0x9a, 0x01, 0xa1, 0x2d, 0xf8, 0x06, 0xf6, 0x3c,
-	0x9f, 0x89, 0xed, 0xc7, 0xa2, 0x6c, 0xaa, 0xf0, 0xbe, 0x8b, 0xd9, 0x20, 0xd4, 0x3a, 0x62, 0xd5,
-	0x51, 0x68, 0xdc, 0x24, 0x6c, 0x2f, 0x64, 0xd8, 0x71, 0x04, 0xd2, 0xf6, 0x1d, 0xed, 0xbc, 0x34,
-	0x89, 0x3c, 0xb4, 0x73, 0x17, 0xce, 0x8e, 0x38, 0x5c, 0xb4, 0x08, 0xf5, 0x7d, 0x72, 0x28, 0x2e,
-	0x85, 0xb6, 0xc1, 0x3f, 0xd1, 0x69, 0x68, 0x1e, 0x60, 0x27, 0x22, 0x22, 0x8c, 0xb7, 0x0c, 0x39,
-	0xb8, 0x5d, 0xfb, 0x76, 0xb5, 0xf3, 0xeb, 0x2a, 0x2c, 0x14, 0x54, 0x55, 0xb2, 0xfe, 0xc7, 0xd9,
-	0xf5, 0xaf, 0xc1, 0x71, 0xf6, 0x9e, 0xe0, 0xc0, 0x22, 0x2c, 0x23, 0x88, 0xfe, 0xf7, 0x2a, 0x68,
-	0x85, 0x33, 0xfc, 0x81, 0xcd, 0x06, 0xf7, 0x6c, 0x87, 0x84, 0xe8, 0x16, 0x4c, 0x07, 0x12, 0xa6,
-	0xae, 0xba, 0xf3, 0x63, 0x8e, 0x7e, 0xab, 0x62, 0xc4, 0xd8, 0xe8, 0x23, 0x68, 0xb9, 0x84, 0xe1,
-	0x3e, 0x66, 0x58, 0xc9, 0xbe, 0x5a, 0xb6, 0x92, 0x73, 0xd9, 0x51, 0x78, 0x5b, 0x15, 0x23, 0x59,
-	0x83, 0xde, 0x83, 0xa6, 0x39, 0x88, 0xbc, 0x7d, 0x71, 0xc9, 0xcd, 0xdc, 0xb8, 0x38, 0x6a, 0xf1,
-	0x06, 0x47, 0xda, 0xaa, 0x18, 0x12, 0xfb, 0xe3, 0x29, 0x68, 0x50, 0x1c, 0x30, 0xfd, 0x1e, 0x9c,
-	0x2e, 0x63, 0xc1, 0x6f, 0x56, 0x73, 0x40, 0xcc, 0xfd, 0x30, 0x72, 0x95, 0x9a, 0x93, 0x31, 0x42,
-	0xd0, 0x08, 0xed, 0x17, 0x52, 0xd5, 0x75, 0x43, 0x7c, 0xeb, 0x6f, 0xc3, 0xd2, 0x10, 0x37, 0x7e,
-	0xa8, 0x52, 0x36, 0x4e, 0x61, 0x56, 0xb1, 0xd6, 0x23, 0x38, 0xf3, 0x44, 0xe8, 0x22, 0xb9, 0x5e,
-	0x4e, 0x22, 0x57, 0xd0, 0xb7, 0x60, 0xb9, 0xc8, 0x36, 0xa4, 0xbe, 0x17, 0x12, 0xee, 0x6c, 0x22,
-	0x1e, 0xdb, 0xa4, 0x9f, 0xce, 0x0a, 0x29, 0x5a, 0x46, 0xc9, 0x8c, 0xfe, 0xfb, 0x1a, 0x2c, 0x1b,
-	0x24, 0xf4, 0x9d, 0x03, 0x12, 0x07, 0xcb, 0x93, 0x49, 0x77, 0x7e, 0x04, 0x75, 0x4c, 0xa9, 0x32,
-	0x93, 0xed, 0xd7, 0x96, 0x50, 0x18, 0x9c, 0x2a, 0x7a, 0x07, 0x96, 0xb0, 0xdb, 0xb3, 0xad, 0xc8,
-	0x8f, 0xc2, 0x78, 0x5b, 0xc2, 0xa8, 0xda, 0xc6, 0xf0, 0x04, 0x0f, 0x38, 0xa1, 0xf0, 0xc8, 0x6d,
-	0xaf, 0x4f, 0x7e, 0x26, 0x72, 0xa8, 0xba, 0x91, 0x05, 0xe9, 0x26, 0x9c, 0x1d, 0x52, 0x92, 0x52,
-	0x78, 0x36, 0x6d, 0xab, 0x16, 0xd2, 0xb6, 0x52, 0x31, 0x6a, 0x23, 0xc4, 0xd0, 0x5f, 0x56, 0x61,
-	0x31, 0x75, 0x2e, 0x45, 0xfe, 0x02, 0xb4, 0x5d, 0x05, 0x0b, 0xb5, 0xaa, 0x88, 0x99, 0x29, 0x20,
-	0x9f, 0xc1, 0xd5, 0x8a, 0x19, 0xdc, 0x32, 0x4c, 0xc9, 0x04, 0x5b, 0x6d, 0x5d, 0x8d, 0x72, 0x22,
-	0x37, 0x0a, 0x22, 0xaf, 0x00, 0x84, 0x49, 0x84, 0xd3, 0xa6, 0xc4, 0x6c, 0x06, 0x82, 0x74, 0x98,
-	0x95, 0xf7, 0xbd, 0x41, 0xc2, 0xc8, 0x61, 0xda, 0xb4, 0xc0, 0xc8, 0xc1, 0x84, 0xbf, 0xf9, 0xae,
-	0x8b, 0xbd, 0x7e, 0xa8, 0xb5, 0x84, 0xc8, 0xc9, 0x58, 0xf7, 0x61, 0xe1, 0x81, 0xcd, 0xf7, 0xb7,
-	0x17, 0x9e, 0x8c, 0xab, 0xbc, 0x0f, 0x0d, 0xce, 0x8c, 0x0b, 0xd5, 0x0b, 0xb0, 0x67, 0x0e, 0x48,
-	0xac, 0xc7, 0x64, 0xcc, 0x83, 0x00, 0xc3, 0x56, 0xa8, 0xd5, 0x04, 0x5c, 0x7c, 0xeb, 0x7f, 0xac,
-	0x49, 0x49, 0xd7, 0x29, 0x0d, 0xbf, 0xfe, 0x02, 0xa0, 0x3c, 0x25, 0xa9, 0x0f, 0xa7, 0x24, 0x05,
-	0x91, 0xbf, 0x4a, 0x4a, 0xf2, 0x9a, 0x2e, 0x39, 0x3d, 0x82, 0xe9, 0x75, 0x4a, 0xb9, 0x20, 0xe8,
-	0x3a, 0x34, 0x30, 0xa5, 0x52, 0xe1, 0x85, 0x78, 0xae, 0x50, 0xf8, 0x7f, 0x25, 0x92, 0x40, 0xed,
-	0xdc, 0x82, 0x76, 0x02, 0x3a, 0x8a, 0x6d, 0x3b, 0xcb, 0x76, 0x15, 0x40, 0xe6, 0xdc, 0xdb, 0xde,
-	0x9e, 0xcf, 0x8f, 0x94, 0x3b, 0x82, 0x5a, 0x2a, 0xbe, 0xf5, 0xdb, 0x31, 0x86, 0x90, 0xed, 0x1d,
-	0x68, 0xda, 0x8c, 0xb8, 0xb1, 0x70, 0xcb, 0x59, 0xe1, 0x52, 0x42, 0x86, 0x44, 0xd2, 0xff, 0xd2,
-	0x82, 0x73, 0xfc, 0xc4, 0x1e, 0x0b, 0x17, 0x5a, 0xa7, 0xf4, 0x0e, 0x61, 0xd8, 0x76, 0xc2, 0xef,
-	0x47, 0x24, 0x38, 0x7c, 0xc3, 0x86, 0x61, 0xc1, 0x94, 0xf4, 0x40, 0x15, 0x2d, 0x5f, 0x7b, 0xf9,
-	0xa5, 0xc8, 0xa7, 0x35, 0x57, 0xfd, 0xcd, 0xd4, 0x5c, 0x65, 0x35, 0x50, 0xe3, 0x84, 0x6a, 0xa0,
-	0xd1, 0x65, 0x70, 0xa6, 0xb8, 0x9e, 0xca, 0x17, 0xd7, 0x25, 0xa5, 0xc5, 0xf4, 0x71, 0x4b, 0x8b,
-	0x56, 0x69, 0x69, 0xe1, 0x96, 0xfa, 0x71, 0x5b, 0xa8, 0xfb, 0xbb, 0x59, 0x0b, 0x1c, 0x69, 0x6b,
-	0x93, 0x14, 0x19, 0xf0, 0x46, 0x8b, 0x8c, 0x4f, 0x73, 0x45, 0x83, 0x2c, 0xdb, 0xdf, 0x3b, 0xde,
-	0x9e, 0xc6, 0x94, 0x0f, 0xff, 0x77, 0xa9, 0xf7, 0xaf, 0x44, 0xc6, 0x45, 0xfd, 0x54, 0x07, 0xc9,
-	0x65, 0xcf, 0xef, 0x21, 0x7e, 0xed, 0xaa, 0xa0, 0xc5, 0xbf, 0xd1, 0x35, 0x68, 0x70, 0x25, 0xab,
-	0x94, 0xf8, 0x6c, 0x56, 0x9f, 0xfc, 0x24, 0xd6, 0x29, 0x7d, 0x4c, 0x89, 0x69, 0x08, 0x24, 0x74,
-	0x1b, 0xda, 0x89, 0xe1, 0x2b, 0xcf, 0xba, 0x90, 0x5d, 0x91, 0xf8, 0x49, 0xbc, 0x2c, 0x45, 0xe7,
-	0x6b, 0xfb, 0x76, 0x40, 0x4c, 0x91, 0x30, 0x36, 0x87, 0xd7, 0xde, 0x89, 0x27, 0x93, 0xb5, 0x09,
-	0x3a, 0xba, 0x0e, 0x53, 0xb2, 0xcf, 0x21, 0x3c, 0x68, 0xe6, 0xc6, 0xb9, 0xe1, 0x60, 0x1a, 0xaf,
-	0x52, 0x88, 0xfa, 0x9f, 0xab, 0xf0, 0x56, 0x6a, 0x10, 0xb1, 0x37, 0xc5, 0x39, 0xfb, 0xd7, 0x7f,
-	0xe3, 0x5e, 0x86, 0x79, 0x51, 0x24, 0xa4, 0xed, 0x0e, 0xd9, 0x79, 0x2b, 0x40, 0xf5, 0x3f, 0x54,
-	0xe1, 0xd2, 0xf0, 0x3e, 0x36, 0x06, 0x38, 0x60, 0xc9, 0xf1, 0x9e, 0xc4, 0x5e, 0xe2, 0x0b, 0xaf,
-	0x96, 0x5e, 0x78, 0xb9, 0xfd, 0xd5, 0xf3, 0xfb, 0xd3, 0xff, 0x54, 0x83, 0x99, 0x8c, 0x01, 0x95,
-	0x5d, 0x98, 0x3c, 0x19, 0x14, 0x76, 0x2b, 0xca, 0x42, 0x71, 0x29, 0xb4, 0x8d, 0x0c, 0x04, 0xed,
-	0x03, 0x50, 0x1c, 0x60, 0x97, 0x30, 0x12, 0xf0, 0x48, 0xce, 0x3d, 0xfe, 0xfe, 0xe4, 0xd1, 0x65,
-	0x37, 0xa6, 0x69, 0x64, 0xc8, 0xf3, 0x6c, 0x56, 0xb0, 0x0e, 0x55, 0xfc, 0x56, 0x23, 0xf4, 0x05,
-	0xcc, 0xef, 0xd9, 0x0e, 0xd9, 0x4d, 0x05, 0x99, 0x12, 0x82, 0x3c, 0x9a, 0x5c, 0x90, 0x7b, 0x59,
-	0xba, 0x46, 0x81, 0x8d, 0x7e, 0x15, 0x16, 0x8b, 0xfe, 0xc4, 0x85, 0xb4, 0x5d, 0x6c, 0x25, 0xda,
-	0x52, 0x23, 0x1d, 0xc1, 0x62, 0xd1, 0x7f, 0xf4, 0x7f, 0xd5, 0xe0, 0x4c, 0x42, 0x6e, 0xdd, 0xf3,
-	0xfc, 0xc8, 0x33, 0x45, 0xeb, 0xb0, 0xf4, 0x2c, 0x4e, 0x43, 0x93, 0xd9, 0xcc, 0x49, 0x12, 0x1f,
-	0x31, 0xe0, 0x77, 0x17, 0xf3, 0x7d, 0x87, 0xd9, 0x54, 0x1d, 0x70, 0x3c, 0x94, 0x67, 0xff, 0x3c,
-	0xb2, 0x03, 0xd2, 0x17, 0x91, 0xa0, 0x65, 0x24, 0x63, 0x3e, 0xc7, 0xb3, 0x1a, 0x91, 0xe2, 0x4b,
-	0x65, 0x26, 0x63, 0x61, 0xf7, 0xbe, 0xe3, 0x10, 0x93, 0xab, 0x23, 0x53, 0x04, 0x14, 0xa0, 0xa2,
-	0xb8, 0x60, 0x81, 0xed, 0x59, 0xaa, 0x04, 0x50, 0x23, 0x2e, 0x27, 0x0e, 0x02, 0x7c, 0xa8, 0x32,
-	0x7f, 0x39, 0x40, 0x1f, 0x42, 0xdd, 0xc5, 0x54, 0x5d, 0x74, 0x57, 0x73, 0xd1, 0xa1, 0x4c, 0x03,
-	0xdd, 0x1d, 0x4c, 0xe5, 0x4d, 0xc0, 0x97, 0x75, 0xde, 0x87, 0x56, 0x0c, 0xf8, 0x4a, 0x29, 0xe1,
-	0xe7, 0x30, 0x97, 0x0b, 0x3e, 0xe8, 0x29, 0x2c, 0xa7, 0x16, 0x95, 0x65, 0xa8, 0x92, 0xc0, 0xb7,
-	0x8e, 0x94, 0xcc, 0x18, 0x41, 0x40, 0x7f, 0x0e, 0x4b, 0xdc, 0x64, 0x84, 0xe3, 0x9f, 0x50, 0x69,
-	0xf3, 0x01, 0xb4, 0x13, 0x96, 0xa5, 0x36, 0xd3, 0x81, 0xd6, 0x41, 0xdc, 0xd2, 0x95, 0xb5, 0x4d,
-	0x32, 0xd6, 0xd7, 0x01, 0x65, 0xe5, 0x55, 0x37, 0xd0, 0xb5, 0x7c, 0x52, 0x7c, 0xa6, 0x78, 0xdd,
-	0x08, 0xf4, 0x38, 0x27, 0xfe, 0x47, 0x0d, 0x16, 0x36, 0x6d, 0xd1, 0x23, 0x39, 0xa1, 0x20, 0x77,
-	0x15, 0x16, 0xc3, 0xa8, 0xe7, 0xfa, 0xfd, 0xc8, 0x21, 0x2a, 0x29, 0x50, 0x37, 0xfd, 0x10, 0x7c,
-	0x5c, 0xf0, 0xe3, 0xca, 0xa2, 0x98, 0x0d, 0x54, 0xf5, 0x2b, 0xbe, 0xd1, 0x87, 0x70, 0xee, 0x21,
-	0xf9, 0x42, 0xed, 0x67, 0xd3, 0xf1, 0x7b, 0x3d, 0xdb, 0xb3, 0x62, 0x26, 0x4d, 0xc1, 0x64, 0x34,
-	0x42, 0x59, 0xaa, 0x38, 0x55, 0x9e, 0x2a, 0x26, 0x15, 0xf4, 0x86, 0xef, 0xba, 0x36, 0x53, 0x19,
-	0x65, 0x0e, 0xa6, 0xff, 0xb2, 0x0a, 0x8b, 0xa9, 0x66, 0xd5, 0xd9, 0xdc, 0x92, 0x3e, 0x24, 0x4f,
-	0xe6, 0x52, 0xf6, 0x64, 0x8a, 0xa8, 0xaf, 0xee, 0x3e, 0xb3, 0x59, 0xf7, 0xf9, 0x4d, 0x0d, 0xce,
-	0x6c, 0xda, 0x2c, 0x0e, 0x5c, 0xf6, 0xff, 0xda, 0x29, 0x97, 0x9c, 0x49, 0xe3, 0x78, 0x67, 0xd2,
-	0x2c, 0x39, 0x93, 0x2e, 0x2c, 0x17, 0x95, 0xa1, 0x0e, 0xe6, 0x34, 0x34, 0xa9, 0x68, 0x3a, 0xcb,
-	0xbe, 0x82, 0x1c, 0xe8, 0xbf, 0x98, 0x86, 0x8b, 0x9f, 0xd2, 0x3e, 0x66, 0x49, 0xcf, 0xe8, 0x9e,
-	0x1f, 0x88, 0xae, 0xf3, 0xc9, 0x68, 0xb1, 0xf0, 0x32, 0x58, 0x1b, 0xfb, 0x32, 0x58, 0x1f, 0xf3,
-	0x32, 0xd8, 0x38, 0xd6, 0xcb, 0x60, 0xf3, 0xc4, 0x5e, 0x06, 0x87, 0x6b, 0xad, 0xa9, 0xd2, 0x5a,
-	0xeb, 0x69, 0xae, 0x1e, 0x99, 0x16, 0x6e, 0xf3, 0x9d, 0xac, 0xdb, 0x8c, 0x3d, 0x9d, 0xb1, 0x4f,
-	0x1a, 0x85, 0x07, 0xb5, 0xd6, 0x91, 0x0f, 0x6a, 0xed, 0xe1, 0x07, 0xb5, 0xf2, 0x37, 0x19, 0x18,
-	0xf9, 0x26, 0x73, 0x19, 0xe6, 0xc3, 0x43, 0xcf, 0x24, 0xfd, 0xa4, 0x93, 0x38, 0x23, 0xb7, 0x9d,
-	0x87, 0xe6, 0x3c, 0x62, 0xb6, 0xe0, 0x11, 0x89, 0xa5, 0xce, 0x65, 0x2c, 0xb5, 0xcc, 0x4f, 0xe6,
-	0x47, 0x96, 0xb9, 0x85, 0xe7, 0x92, 0x85, 0xd2, 0xe7, 0x92, 0xff, 0x9a, 0x62, 0xeb, 0x33, 0x58,
-	0x19, 0x75, 0xca, 0xca, 0x79, 0x35, 0x98, 0x36, 0x07, 0xd8, 0xb3, 0x44, 0x5b, 0x50, 0x54, 0xff,
-	0x6a, 0x38, 0xae, 0x3a, 0xb8, 0xf1, 0xe5, 0x2c, 0x2c, 0xa5, 0x59, 0x3f, 0xff, 0x6b, 0x9b, 0x04,
-	0x3d, 0x82, 0xc5, 0xf8, 0x79, 0x29, 0x6e, 0xe4, 0xa2, 0x71, 0x6f, 0x27, 0x9d, 0x0b, 0xe5, 0x93,
-	0x52, 0x34, 0xbd, 0x82, 0x4c, 0x38, 0x57, 0x24, 0x98, 0x3e, 0xd3, 0x7c, 0x6b, 0x0c, 0xe5, 0x04,
-	0xeb, 0x28, 0x16, 0x57, 0xaa, 0xe8, 0x29, 0xcc, 0xe7, 0x1f, 0x13, 0x50, 0x2e, 0x0d, 0x2a, 0x7d,
-	0xdf, 0xe8, 0xe8, 0xe3, 0x50, 0x12, 0xf9, 0x9f, 0x71, 0x33, 0xc8, 0xf5, 0xcd, 0x91, 0x9e, 0xef,
-	0x08, 0x94, 0xbd, 0x3c, 0x74, 0xbe, 0x39, 0x16, 0x27, 0xa1, 0xfe, 0x01, 0xb4, 0xe2, 0x5e, 0x72,
-	0x5e, 0xcd, 0x85, 0x0e, 0x73, 0x67, 0x31, 0x4f, 0x6f, 0x2f, 0xd4, 0x2b, 0xe8, 0x23, 0x98, 0xe1,
-	0x68, 0x8f, 0x36, 0xb6, 0x9f, 0x60, 0xeb, 0x95, 0xd6, 0xb7, 0xe2, 0x5e, 0xeb, 0xf0, 0xe2, 0x4c,
-	0x07, 0xb6, 0x73, 0xaa, 0xa4, 0xeb, 0xa9, 0x57, 0xd0, 0xf7, 0x24, 0xff, 0x5d, 0xf5, 0xf3, 0x80,
-	0xe5, 0xae, 0xfc, 0x35, 0x4a, 0x37, 0xfe, 0x35, 0x4a, 0xf7, 0xae, 0x4b, 0xd9, 0x61, 0xa7, 0xa4,
-	0x2d, 0xa9, 0x08, 0x3c, 0x83, 0xb9, 0x4d, 0xc2, 0xd2, 0x2e, 0x02, 0xba, 0x74, 0xac, 0x5e, 0x4b,
-	0x47, 0x2f, 0xa2, 0x0d, 0x37, 0x22, 0xf4, 0x0a, 0xfa, 0xb2, 0x0a, 0xa7, 0x36, 0x09, 0x2b, 0xd6,
-	0xe5, 0xe8, 0xdd, 0x72, 0x26, 0x23, 0xea, 0xf7, 0xce, 0xc3, 0x49, 0x7d, 0x3a, 0x4f, 0x56, 0xaf,
-	0xa0, 0xdf, 0x56, 0x61, 0x7e, 0x93, 0xf0, 0x73, 0x4b, 0x64, 0xba, 0x3e, 0x5e, 0xa6, 0x92, 0x5a,
-	0xbc, 0x33, 0x61, 0x0f, 0x2c, 0xc3, 0x5d, 0xaf, 0xa0, 0xdf, 0x55, 0xe1, 0x6c, 0x46, 0x57, 0x59,
-	0x7e, 0xaf, 0x22, 0xdb, 0x27, 0x13, 0xfe, 0x10, 0x25, 0x43, 0x52, 0xaf, 0xa0, 0x5d, 0x61, 0x26,
-	0x69, 0xaa, 0x8f, 0x2e, 0x96, 0xe6, 0xf4, 0x09, 0xf7, 0x95, 0x51, 0xd3, 0x89, 0x69, 0x7c, 0x02,
-	0x33, 0x9b, 0x84, 0xc5, 0x39, 0x67, 0xde, 0xf8, 0x0b, 0xe5, 0x40, 0x3e, 0xfa, 0x14, 0xd3, 0x54,
-	0x61, 0xc4, 0x4b, 0x92, 0x56, 0x26, 0xaf, 0xca, 0x87, 0x9f, 0xd2, 0x04, 0x34, 0x6f, 0xc4, 0xe5,
-	0x69, 0x99, 0x5e, 0x41, 0xcf, 0x61, 0xb9, 0x3c, 0xfa, 0xa3, 0xb7, 0x8f, 0x9d, 0x07, 0x74, 0xae,
-	0x1e, 0x07, 0x35, 0x66, 0xf9, 0xf1, 0xfa, 0x5f, 0x5f, 0xae, 0x54, 0xff, 0xf6, 0x72, 0xa5, 0xfa,
-	0xef, 0x97, 0x2b, 0xd5, 0x1f, 0xde, 0x3c, 0xe2, 0x07, 0x6b, 0x99, 0xdf, 0xc0, 0x61, 0x6a, 0x9b,
-	0x8e, 0x4d, 0x3c, 0xd6, 0x9b, 0x12, 0x21, 0xe0, 0xe6, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xc9,
-	0x9b, 0xb9, 0x62, 0x22, 0x27, 0x00, 0x00,
+	// 2537 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3a, 0xcd, 0x73, 0x1b, 0x49,
+	0xf5, 0x96, 0x64, 0xc9, 0xd2, 0xb3, 0xe3, 0x8f, 0xde, 0xc4, 0x99, 0x28, 0x8e, 0x7f, 0xde, 0xf9,
+	0x91, 0x94, 0x37, 0xd9, 0x95, 0x2b, 0x49, 0xed, 0x06, 0xb2, 0xcb, 0x52, 0x5e, 0xc7, 0xb1, 0xbd,
+	0x89, 0x13, 0x33, 0x71, 0x02, 0x81, 0x00, 0xd5, 0x1a, 0xb5, 0x46, 0xb3, 0x9e, 0x8f, 0xce, 0x4c,
+	0x8f, 0x17, 0xa7, 0x8a, 0x0b, 0x54, 0x71, 0xe1, 0x42, 0x71, 0xd8, 0x03, 0x57, 0xee, 0x1c, 0xa8,
+	0xa2, 0x38, 0x72, 0xa2, 0xe0, 0x48, 0x71, 0xe1, 0x08, 0x95, 0x7f, 0x04, 0xaa, 0x3f, 0x66, 0x34,
+	0x33, 0x1a, 0xc9, 0xde, 0x28, 0xf1, 0x02, 0x17, 0x7b, 0xfa, 0xf5, 0xeb, 0xf7, 0x5e, 0xbf, 0x7e,
+	0xef, 0xf5, 0x7b, 0xaf, 0x05, 0x57, 0x02, 0x42, 0xfd, 0x90, 0x04, 0x87, 0x24, 0x58, 0x13, 0x9f,
+	0x36, 0xf3, 0x83, 0xa3, 0xd4, 0x67, 0x8b, 0x06, 0x3e, 0xf3, 0x11, 0xf4, 0x21, 0xcd, 0xfb, 0x96,
+	0xcd, 0x7a, 0x51, 0xbb, 0x65, 0xfa, 0xee, 0x1a, 0x0e, 0x2c, 0x9f, 0x06, 0xfe, 0x67, 0xe2, 0xe3,
+	0x3d, 0xb3, 0xb3, 0x76, 0x78, 0x73, 0x8d, 0x1e, 0x58, 0x6b, 0x98, 0xda, 0xe1, 0x1a, 0xa6, 0xd4,
+	0xb1, 0x4d, 0xcc, 0x6c, 0xdf, 0x5b, 0x3b, 0xbc, 0x8e, 0x1d, 0xda, 0xc3, 0xd7, 0xd7, 0x2c, 0xe2,
+	0x91, 0x00, 0x33, 0xd2, 0x91, 0x94, 0x9b, 0x17, 0x2d, 0xdf, 0xb7, 0x1c, 0xb2, 0x26, 0x46, 0xed,
+	0xa8, 0xbb, 0x46, 0x5c, 0xca, 0x14, 0x5b, 0xfd, 0x5f, 0x73, 0x30, 0xb7, 0x8b, 0x3d, 0xbb, 0x4b,
+	0x42, 0x66, 0x90, 0xe7, 0x11, 0x09, 0x19, 0x7a, 0x06, 0x93, 0x5c, 0x18, 0xad, 0xb4, 0x52, 0x5a,
+	0x9d, 0xbe, 0xb1, 0xdd, 0xea, 0x4b, 0xd3, 0x8a, 0xa5, 0x11, 0x1f, 0x3f, 0x32, 0x3b, 0xad, 0xc3,
+	0x9b, 0x2d, 0x7a, 0x60, 0xb5, 0xb8, 0x34, 0xad, 0x94, 0x34, 0xad, 0x58, 0x9a, 0x96, 0x91, 0x6c,
+	0xcb, 0x10, 0x54, 0x51, 0x13, 0xea, 0x01, 0x39, 0xb4, 0x43, 0xdb, 0xf7, 0xb4, 0xf2, 0x4a, 0x69,
+	0xb5, 0x61, 0x24, 0x63, 0xa4, 0xc1, 0x94, 0xe7, 0x6f, 0x60, 0xb3, 0x47, 0xb4, 0xca, 0x4a, 0x69,
+	0xb5, 0x6e, 0xc4, 0x43, 0xb4, 0x02, 0xd3, 0x98, 0xd2, 0xfb, 0xb8, 0x4d, 0x9c, 0x7b, 0xe4, 0x48,
+	0x9b, 0x14, 0x0b, 0xd3, 0x20, 0xbe, 0x16, 0x53, 0xfa, 0x00, 0xbb, 0x44, 0xab, 0x8a, 0xd9, 0x78,
+	0x88, 0x96, 0xa0, 0xe1, 0x61, 0x97, 0x84, 0x14, 0x9b, 0x44, 0xab, 0x8b, 0xb9, 0x3e, 0x00, 0xfd,
+	0x04, 0x16, 0x52, 0x82, 0x3f, 0xf2, 0xa3, 0xc0, 0x24, 0x1a, 0x88, 0xad, 0x3f, 0x1c, 0x6f, 0xeb,
+	0xeb, 0x79, 0xb2, 0xc6, 0x20, 0x27, 0xf4, 0x43, 0xa8, 0x8a, 0x93, 0xd7, 0xa6, 0x57, 0x2a, 0xaf,
+	0x55, 0xdb, 0x92, 0x2c, 0xf2, 0x60, 0x8a, 0x3a, 0x91, 0x65, 0x7b, 0xa1, 0x36, 0x23, 0x38, 0xec,
+	0x8f, 0xc7, 0x61, 0xc3, 0xf7, 0xba, 0xb6, 0xb5, 0x8b, 0x3d, 0x6c, 0x11, 0x97, 0x78, 0x6c, 0x4f,
+	0x10, 0x37, 0x62, 0x26, 0xe8, 0x05, 0xcc, 0x1f, 0x44, 0x21, 0xf3, 0x5d, 0xfb, 0x05, 0x79, 0x48,
+	0xf9, 0xda, 0x50, 0x3b, 0x23, 0xb4, 0xf9, 0x60, 0x3c, 0xc6, 0xf7, 0x72, 0x54, 0x8d, 0x01, 0x3e,
+	0xdc, 0x48, 0x0e, 0xa2, 0x36, 0x79, 0x42, 0x02, 0x61, 0x5d, 0xb3, 0xd2, 0x48, 0x52, 0x20, 0x69,
+	0x46, 0xb6, 0x1a, 0x85, 0xda, 0xdc, 0x4a, 0x45, 0x9a, 0x51, 0x02, 0x42, 0xab, 0x30, 0x77, 0x48,
+	0x02, 0xbb, 0x7b, 0xf4, 0xc8, 0xb6, 0x3c, 0xcc, 0xa2, 0x80, 0x68, 0xf3, 0xc2, 0x14, 0xf3, 0x60,
+	0xe4, 0xc2, 0x99, 0x1e, 0x71, 0x5c, 0xae, 0xf2, 0x8d, 0x80, 0x74, 0x42, 0x6d, 0x41, 0xe8, 0x77,
+	0x6b, 0xfc, 0x13, 0x14, 0xe4, 0x8c, 0x2c, 0x75, 0x2e, 0x98, 0xe7, 0x1b, 0xca, 0x53, 0xa4, 0x8f,
+	0x20, 0x29, 0x58, 0x0e, 0x8c, 0xae, 0xc0, 0x2c, 0x0b, 0xb0, 0x79, 0x60, 0x7b, 0xd6, 0x2e, 0x61,
+	0x3d, 0xbf, 0xa3, 0xbd, 0x25, 0x34, 0x91, 0x83, 0x22, 0x13, 0x10, 0xf1, 0x70, 0xdb, 0x21, 0x1d,
+	0x69, 0x8b, 0xfb, 0x47, 0x94, 0x84, 0xda, 0x59, 0xb1, 0x8b, 0x9b, 0xad, 0x54, 0x84, 0xca, 0x05,
+	0x88, 0xd6, 0xe6, 0xc0, 0xaa, 0x4d, 0x8f, 0x05, 0x47, 0x46, 0x01, 0x39, 0x74, 0x00, 0xd3, 0x7c,
+	0x1f, 0xb1, 0x29, 0x9c, 0x13, 0xa6, 0xb0, 0x33, 0x9e, 0x8e, 0xb6, 0xfb, 0x04, 0x8d, 0x34, 0x75,
+	0xd4, 0x02, 0xd4, 0xc3, 0xe1, 0x6e, 0xe4, 0x30, 0x9b, 0x3a, 0x44, 0x8a, 0x11, 0x6a, 0x8b, 0x42,
+	0x4d, 0x05, 0x33, 0xe8, 0x1e, 0x40, 0x40, 0xba, 0x31, 0xde, 0x79, 0xb1, 0xf3, 0x6b, 0xa3, 0x76,
+	0x6e, 0x24, 0xd8, 0x72, 0xc7, 0xa9, 0xe5, 0x9c, 0x39, 0xdf, 0x06, 0x31, 0x99, 0x84, 0x08, 0x5f,
+	0xd4, 0x34, 0x61, 0x62, 0x05, 0x33, 0xdc, 0x16, 0x15, 0x54, 0x04, 0xad, 0x0b, 0xd2, 0x5a, 0x53,
+	0x20, 0xb4, 0x0d, 0xff, 0x87, 0x3d, 0xcf, 0x67, 0x62, 0xfb, 0xb1, 0x28, 0x5b, 0x2a, 0xbc, 0xef,
+	0x61, 0xd6, 0x0b, 0xb5, 0xa6, 0x58, 0x75, 0x1c, 0x1a, 0x37, 0x09, 0xdb, 0x0b, 0x19, 0x76, 0x1c,
+	0x81, 0xb4, 0x73, 0x47, 0xbb, 0x28, 0x4d, 0x22, 0x0b, 0x45, 0xbf, 0x2a, 0x81, 0xe6, 0x9b, 0x76,
+	0x62, 0xe4, 0x4f, 0xb8, 0xcd, 0x2b, 0xfd, 0x6b, 0x4b, 0xe2, 0xec, 0x9e, 0x8c, 0x77, 0x76, 0x0f,
+	0x37, 0x76, 0x0a, 0xa9, 0x1b, 0x43, 0xf9, 0x72, 0xe1, 0xf9, 0x21, 0x3f, 0xc1, 0x4e, 0x44, 0xc2,
+	0xbb, 0x81, 0xef, 0x6a, 0x97, 0x84, 0x52, 0x73, 0x50, 0xd4, 0x81, 0xba, 0x65, 0x33, 0xa9, 0xf6,
+	0xe5, 0xd7, 0x1c, 0x4d, 0x13, 0xca, 0xcd, 0x4d, 0x38, 0x3f, 0xc4, 0xfe, 0xd1, 0x3c, 0x54, 0x0e,
+	0xc8, 0x91, 0xb8, 0x37, 0x1b, 0x06, 0xff, 0x44, 0x67, 0xa1, 0x7a, 0xc8, 0x05, 0x14, 0x37, 0x5d,
+	0xdd, 0x90, 0x83, 0xdb, 0xe5, 0xaf, 0x97, 0x9a, 0x3f, 0x2f, 0xc1, 0x5c, 0xce, 0x9a, 0x0a, 0xd6,
+	0xff, 0x20, 0xbd, 0xfe, 0x35, 0xc4, 0x96, 0xee, 0x3e, 0x0e, 0x2c, 0xc2, 0x52, 0x82, 0xe8, 0x7f,
+	0x2b, 0x81, 0x96, 0x33, 0xf3, 0xef, 0xd8, 0xac, 0x77, 0xd7, 0x76, 0x48, 0x88, 0x6e, 0xc1, 0x54,
+	0x20, 0x61, 0x2a, 0x1b, 0xb8, 0x38, 0xc2, 0x3b, 0xb6, 0x27, 0x8c, 0x18, 0x1b, 0x7d, 0x0c, 0x75,
+	0x97, 0x30, 0xdc, 0xc1, 0x0c, 0x2b, 0xd9, 0x57, 0x8a, 0x56, 0x72, 0x2e, 0xbb, 0x0a, 0x6f, 0x7b,
+	0xc2, 0x48, 0xd6, 0xa0, 0xf7, 0xa1, 0x6a, 0xf6, 0x22, 0xef, 0x40, 0xe4, 0x01, 0xd3, 0x37, 0x2e,
+	0x0d, 0x5b, 0xbc, 0xc1, 0x91, 0xb6, 0x27, 0x0c, 0x89, 0xfd, 0x49, 0x0d, 0x26, 0x29, 0x0e, 0x98,
+	0x7e, 0x17, 0xce, 0x16, 0xb1, 0xe0, 0xc9, 0x87, 0xd9, 0x23, 0xe6, 0x41, 0x18, 0xb9, 0x4a, 0xcd,
+	0xc9, 0x18, 0x21, 0x98, 0x0c, 0xed, 0x17, 0x52, 0xd5, 0x15, 0x43, 0x7c, 0xeb, 0xef, 0xc0, 0xc2,
+	0x00, 0x37, 0x7e, 0xa8, 0x52, 0x36, 0x4e, 0x61, 0x46, 0xb1, 0xd6, 0x23, 0x38, 0xb7, 0x2f, 0x74,
+	0x91, 0xd8, 0xcc, 0x69, 0xa4, 0x53, 0xfa, 0x36, 0x2c, 0xe6, 0xd9, 0x86, 0xd4, 0xf7, 0x42, 0xc2,
+	0xe3, 0x91, 0xb8, 0xb2, 0x6c, 0xd2, 0xe9, 0xcf, 0x0a, 0x29, 0xea, 0x46, 0xc1, 0x8c, 0xfe, 0x9b,
+	0x32, 0x2c, 0x1a, 0x24, 0xf4, 0x9d, 0x43, 0x12, 0xdf, 0x27, 0xa7, 0x93, 0x11, 0x7e, 0x1f, 0x2a,
+	0x98, 0x52, 0xad, 0xfc, 0x3a, 0xae, 0x86, 0x54, 0xce, 0x65, 0x70, 0xaa, 0xe8, 0x5d, 0x58, 0xc0,
+	0x6e, 0xdb, 0xb6, 0x22, 0x3f, 0x0a, 0xe3, 0x6d, 0x09, 0xa3, 0x6a, 0x18, 0x83, 0x13, 0x3c, 0x26,
+	0x87, 0xc2, 0x23, 0x77, 0xbc, 0x0e, 0xf9, 0xb1, 0x48, 0x33, 0x2b, 0x46, 0x1a, 0xa4, 0x9b, 0x70,
+	0x7e, 0x40, 0x49, 0x4a, 0xe1, 0xe9, 0xcc, 0xb6, 0x94, 0xcb, 0x6c, 0x0b, 0xc5, 0x28, 0x0f, 0x11,
+	0x43, 0xff, 0x69, 0x19, 0xe6, 0xfb, 0xce, 0xa5, 0xc8, 0x2f, 0x41, 0xc3, 0x55, 0xb0, 0x50, 0x2b,
+	0x89, 0x08, 0xd8, 0x07, 0x64, 0x93, 0xdc, 0x72, 0x3e, 0xc9, 0x5d, 0x84, 0x9a, 0xac, 0x41, 0xd4,
+	0xd6, 0xd5, 0x28, 0x23, 0xf2, 0x64, 0x4e, 0xe4, 0x65, 0x80, 0x30, 0x89, 0x70, 0x5a, 0x4d, 0xcc,
+	0xa6, 0x20, 0x48, 0x87, 0x19, 0x99, 0x12, 0x19, 0x24, 0x8c, 0x1c, 0xa6, 0x4d, 0x09, 0x8c, 0x0c,
+	0x4c, 0xf8, 0x9b, 0xef, 0xba, 0xd8, 0xeb, 0x84, 0x5a, 0x5d, 0x88, 0x9c, 0x8c, 0x39, 0x7d, 0x1a,
+	0xf8, 0x87, 0xc4, 0xc3, 0x9e, 0x49, 0xb4, 0x86, 0x98, 0x4d, 0x41, 0x74, 0x1f, 0xe6, 0xee, 0xdb,
+	0x7c, 0xff, 0xdd, 0xf0, 0x74, 0x5c, 0xe9, 0x03, 0x98, 0xe4, 0xcc, 0xb8, 0xd0, 0xed, 0x00, 0x7b,
+	0x66, 0x8f, 0xc4, 0x7a, 0x4e, 0xc6, 0x3c, 0x48, 0x30, 0x6c, 0x85, 0x5a, 0x59, 0xc0, 0xc5, 0xb7,
+	0xfe, 0x87, 0xb2, 0x94, 0x74, 0x9d, 0xd2, 0xf0, 0xab, 0xaf, 0xa1, 0x8a, 0xb3, 0xba, 0xca, 0x60,
+	0x56, 0x97, 0x13, 0xf9, 0xcb, 0x64, 0x75, 0xaf, 0xe9, 0x12, 0xd4, 0x23, 0x98, 0x5a, 0xa7, 0x94,
+	0x0b, 0x82, 0xae, 0xc3, 0x24, 0xa6, 0x54, 0x2a, 0x3c, 0x17, 0xef, 0x15, 0x0a, 0xff, 0xaf, 0x44,
+	0x12, 0xa8, 0xcd, 0x5b, 0xd0, 0x48, 0x40, 0xc7, 0xb1, 0x6d, 0xa4, 0xd9, 0xae, 0x00, 0xc8, 0xb2,
+	0x65, 0xc7, 0xeb, 0xfa, 0xfc, 0x48, 0xb9, 0xa3, 0xa8, 0xa5, 0xe2, 0x5b, 0xbf, 0x1d, 0x63, 0x08,
+	0xd9, 0xde, 0x85, 0xaa, 0xcd, 0x88, 0x1b, 0x0b, 0xb7, 0x98, 0x16, 0xae, 0x4f, 0xc8, 0x90, 0x48,
+	0xfa, 0x9f, 0xeb, 0x70, 0x81, 0x9f, 0xd8, 0x23, 0xe1, 0x62, 0xeb, 0x94, 0xde, 0x21, 0x0c, 0xdb,
+	0x4e, 0xf8, 0xed, 0x88, 0x04, 0x47, 0x6f, 0xd8, 0x30, 0x2c, 0xa8, 0x49, 0x0f, 0xd5, 0xca, 0x6f,
+	0xa6, 0x82, 0xad, 0x85, 0xb9, 0xb2, 0xb5, 0xf2, 0x66, 0xca, 0xd6, 0xa2, 0x32, 0x72, 0xf2, 0x94,
+	0xca, 0xc8, 0xe1, 0x9d, 0x84, 0x54, 0x7f, 0xa2, 0x96, 0xed, 0x4f, 0x14, 0x54, 0x67, 0x53, 0x27,
+	0xad, 0xce, 0xea, 0x85, 0xd5, 0x99, 0x5b, 0xe8, 0xc7, 0x0d, 0xa1, 0xee, 0x6f, 0xa6, 0x2d, 0x70,
+	0xa8, 0xad, 0x8d, 0x53, 0xa7, 0xc1, 0x1b, 0xad, 0xd3, 0x1e, 0x67, 0xea, 0x2e, 0xd9, 0xf9, 0x78,
+	0xff, 0x64, 0x7b, 0x1a, 0x51, 0x81, 0xfd, 0xcf, 0xa5, 0xe6, 0xbf, 0x15, 0x19, 0x19, 0xf5, 0xfb,
+	0x3a, 0x48, 0x92, 0x01, 0x7e, 0x0f, 0xf1, 0x6b, 0x59, 0x05, 0x2d, 0xfe, 0x8d, 0xae, 0xc1, 0x24,
+	0x57, 0xb2, 0x4a, 0x99, 0xcf, 0xa7, 0xf5, 0xc9, 0x4f, 0x62, 0x9d, 0xd2, 0x47, 0x94, 0x98, 0x86,
+	0x40, 0x42, 0xb7, 0xa1, 0x91, 0x18, 0xbe, 0xf2, 0xac, 0xa5, 0xf4, 0x8a, 0xc4, 0x4f, 0xe2, 0x65,
+	0x7d, 0x74, 0xbe, 0xb6, 0x63, 0x07, 0xc4, 0xe4, 0x88, 0x5a, 0x75, 0x70, 0xed, 0x9d, 0x78, 0x32,
+	0x59, 0x9b, 0xa0, 0xa3, 0xeb, 0x50, 0x93, 0xad, 0x22, 0xe1, 0x41, 0xd3, 0x37, 0x2e, 0x0c, 0x06,
+	0xd3, 0x78, 0x95, 0x42, 0x44, 0xab, 0x50, 0x31, 0x23, 0xe9, 0x4f, 0xb9, 0xe0, 0xbb, 0xf1, 0x78,
+	0x33, 0x46, 0xe6, 0x28, 0xfa, 0x9f, 0x4a, 0xf0, 0x76, 0xdf, 0x74, 0x62, 0xbf, 0x8b, 0xb3, 0xff,
+	0xaf, 0xfe, 0x6e, 0xbe, 0x02, 0xb3, 0xa2, 0xdc, 0xe8, 0xf7, 0x96, 0x64, 0x9b, 0x33, 0x07, 0xd5,
+	0x7f, 0x5f, 0x82, 0xcb, 0x83, 0xfb, 0xd8, 0xe8, 0xe1, 0x80, 0x25, 0x86, 0x70, 0x1a, 0x7b, 0x89,
+	0xaf, 0xc6, 0x72, 0xff, 0x6a, 0xcc, 0xec, 0xaf, 0x92, 0xdd, 0x9f, 0xfe, 0xc7, 0x32, 0x4c, 0xa7,
+	0x4c, 0xad, 0xe8, 0x6a, 0xe5, 0x69, 0x9f, 0xb0, 0x70, 0x51, 0x60, 0x8a, 0xeb, 0xa3, 0x61, 0xa4,
+	0x20, 0xe8, 0x00, 0x80, 0xe2, 0x00, 0xbb, 0x84, 0x91, 0x80, 0xc7, 0x7c, 0x1e, 0x1b, 0xee, 0x8d,
+	0x1f, 0x87, 0xf6, 0x62, 0x9a, 0x46, 0x8a, 0x3c, 0xcf, 0x8b, 0x05, 0xeb, 0x50, 0x45, 0x7a, 0x35,
+	0x42, 0x9f, 0xc3, 0x6c, 0xd7, 0x76, 0xc8, 0x5e, 0x5f, 0x90, 0xda, 0x4a, 0x65, 0xfc, 0xfb, 0x94,
+	0x0b, 0x72, 0x37, 0x4d, 0xd7, 0xc8, 0xb1, 0xd1, 0xaf, 0xc2, 0x7c, 0xde, 0xf3, 0xb8, 0x90, 0xb6,
+	0x8b, 0xad, 0x44, 0x5b, 0x6a, 0xa4, 0x23, 0x98, 0xcf, 0x7b, 0x9a, 0xfe, 0x8f, 0x32, 0x9c, 0x4b,
+	0xc8, 0xad, 0x7b, 0x9e, 0x1f, 0x79, 0xa6, 0xe8, 0xd3, 0x16, 0x9e, 0xc5, 0x59, 0xa8, 0x32, 0x9b,
+	0x39, 0x49, 0x8a, 0x24, 0x06, 0xfc, 0x96, 0x63, 0xbe, 0xcf, 0x3b, 0x65, 0xea, 0x80, 0xe3, 0xa1,
+	0x3c, 0xfb, 0xe7, 0x91, 0x1d, 0x90, 0x8e, 0x88, 0x19, 0x75, 0x23, 0x19, 0xf3, 0x39, 0x9e, 0xff,
+	0x88, 0x62, 0x41, 0x2a, 0x33, 0x19, 0x0b, 0xbb, 0xf7, 0x1d, 0x87, 0x98, 0x5c, 0x1d, 0xa9, 0x72,
+	0x22, 0x07, 0xe5, 0x3b, 0x0d, 0x59, 0x60, 0x7b, 0x96, 0x2a, 0x26, 0xd4, 0x88, 0xcb, 0x89, 0x83,
+	0x00, 0x1f, 0xa9, 0x1a, 0x42, 0x0e, 0xd0, 0x47, 0x50, 0x71, 0x31, 0x55, 0x57, 0xe2, 0xd5, 0x4c,
+	0x1c, 0x29, 0xd2, 0x40, 0x6b, 0x17, 0x53, 0x79, 0x67, 0xf0, 0x65, 0xcd, 0x0f, 0xa0, 0x1e, 0x03,
+	0xbe, 0x54, 0xf2, 0xf8, 0x19, 0x9c, 0xc9, 0x84, 0x29, 0xf4, 0x14, 0x16, 0xfb, 0x16, 0x95, 0x66,
+	0xa8, 0xd2, 0xc5, 0xb7, 0x8f, 0x95, 0xcc, 0x18, 0x42, 0x40, 0xef, 0x02, 0xf4, 0x43, 0x1c, 0xfa,
+	0xae, 0xaa, 0x3d, 0x24, 0xd9, 0x3b, 0x63, 0xf6, 0xf1, 0x1f, 0x6f, 0xee, 0x63, 0x4b, 0x55, 0x30,
+	0xcf, 0x61, 0x81, 0x9b, 0xa6, 0x08, 0x30, 0xa7, 0x54, 0x6c, 0x7d, 0x08, 0x8d, 0x84, 0x65, 0xa1,
+	0x6d, 0x36, 0xa1, 0x7e, 0x18, 0xf7, 0xe9, 0x65, 0xb5, 0x95, 0x8c, 0xf5, 0x75, 0x40, 0x69, 0x79,
+	0xd5, 0x9d, 0x78, 0x2d, 0x9b, 0xa6, 0x9f, 0xcb, 0x5f, 0x80, 0x02, 0x3d, 0xce, 0xd2, 0xff, 0x5e,
+	0x86, 0xb9, 0x2d, 0x5b, 0x74, 0x75, 0x4e, 0x29, 0x98, 0x5e, 0x85, 0xf9, 0x30, 0x6a, 0xbb, 0x7e,
+	0x27, 0x72, 0x88, 0x4a, 0x53, 0x54, 0xee, 0x31, 0x00, 0x1f, 0x15, 0x64, 0xb9, 0xb2, 0x28, 0x66,
+	0x3d, 0x55, 0xaf, 0x8b, 0x6f, 0xf4, 0x11, 0x5c, 0x78, 0x40, 0x3e, 0x57, 0xfb, 0xd9, 0x72, 0xfc,
+	0x76, 0xdb, 0xf6, 0xac, 0x98, 0x49, 0x55, 0x30, 0x19, 0x8e, 0x50, 0x94, 0xbc, 0xd6, 0x8a, 0x93,
+	0xd7, 0xa4, 0xe6, 0xdf, 0xf0, 0x5d, 0xd7, 0x66, 0x2a, 0xc7, 0xcd, 0xc0, 0xf4, 0x9f, 0x95, 0x60,
+	0xbe, 0xaf, 0x59, 0x75, 0x36, 0xb7, 0xa4, 0xaf, 0xca, 0x93, 0xb9, 0x9c, 0x3e, 0x99, 0x3c, 0xea,
+	0xab, 0xbb, 0xe9, 0x4c, 0xda, 0x4d, 0x7f, 0x51, 0x86, 0x73, 0x5b, 0x36, 0x8b, 0x03, 0xa4, 0xfd,
+	0xdf, 0x76, 0xca, 0x05, 0x67, 0x32, 0x79, 0xb2, 0x33, 0xa9, 0x16, 0x9c, 0x49, 0x0b, 0x16, 0xf3,
+	0xca, 0x50, 0x07, 0x73, 0x16, 0xaa, 0x54, 0xbc, 0x24, 0xc8, 0x4e, 0x87, 0x1c, 0xe8, 0xbf, 0x9b,
+	0x82, 0x4b, 0x8f, 0x69, 0x07, 0xb3, 0xa4, 0xcb, 0x75, 0xd7, 0x0f, 0xc4, 0x53, 0xc2, 0xe9, 0x68,
+	0x31, 0xf7, 0xdc, 0x5b, 0x1e, 0xf9, 0xdc, 0x5b, 0x19, 0xf1, 0xdc, 0x3b, 0x79, 0xa2, 0xe7, 0xde,
+	0xea, 0xa9, 0x3d, 0xf7, 0x0e, 0x56, 0x7f, 0xb5, 0xc2, 0xea, 0xef, 0x69, 0xa6, 0x42, 0x9a, 0x12,
+	0x6e, 0xf3, 0x8d, 0xb4, 0xdb, 0x8c, 0x3c, 0x9d, 0x91, 0xef, 0x54, 0xb9, 0x57, 0xd2, 0xfa, 0xb1,
+	0xaf, 0xa4, 0x8d, 0xc1, 0x57, 0xd2, 0xe2, 0x87, 0x36, 0x18, 0xfa, 0xd0, 0x76, 0x05, 0x66, 0xc3,
+	0x23, 0xcf, 0x24, 0x9d, 0x58, 0x60, 0x6d, 0x5a, 0x6e, 0x3b, 0x0b, 0xcd, 0x78, 0xc4, 0x4c, 0xce,
+	0x23, 0x12, 0x4b, 0x3d, 0x93, 0xb2, 0xd4, 0x22, 0x3f, 0x99, 0x1d, 0x5a, 0x78, 0xe7, 0xde, 0xc0,
+	0xe6, 0x0a, 0xdf, 0xc0, 0xf8, 0xde, 0x32, 0x0f, 0x4b, 0xdb, 0x38, 0xec, 0x89, 0x47, 0xe0, 0x86,
+	0x51, 0x30, 0xf3, 0x9f, 0x53, 0x2e, 0x3e, 0x81, 0xe5, 0x61, 0x56, 0xa1, 0x9c, 0x5d, 0x83, 0x29,
+	0xb3, 0x87, 0x3d, 0x4b, 0x34, 0x36, 0x45, 0xff, 0x42, 0x0d, 0x47, 0x55, 0x2d, 0x37, 0xbe, 0x98,
+	0x81, 0x85, 0x7e, 0x35, 0xc2, 0xff, 0xda, 0x26, 0x41, 0x0f, 0x61, 0x3e, 0x7e, 0x63, 0x8c, 0x5b,
+	0xd5, 0x68, 0xd4, 0xeb, 0x50, 0x73, 0xa9, 0x78, 0x52, 0x8a, 0xa6, 0x4f, 0x20, 0x13, 0x2e, 0xe4,
+	0x09, 0xf6, 0x1f, 0xa2, 0xbe, 0x36, 0x82, 0x72, 0x82, 0x75, 0x1c, 0x8b, 0xd5, 0x12, 0x7a, 0x0a,
+	0xb3, 0xd9, 0xe7, 0x12, 0x94, 0x49, 0xcf, 0x0a, 0x5f, 0x70, 0x9a, 0xfa, 0x28, 0x94, 0x44, 0xfe,
+	0x67, 0x30, 0x97, 0x7b, 0x19, 0x40, 0x7a, 0xb6, 0xa7, 0x51, 0xf4, 0xb6, 0xd2, 0xfc, 0xff, 0x91,
+	0x38, 0x09, 0xf5, 0x0f, 0xa1, 0x1e, 0x77, 0xc3, 0xb3, 0x6a, 0xce, 0xf5, 0xc8, 0x9b, 0xf3, 0x59,
+	0x7a, 0xdd, 0x50, 0x9f, 0x40, 0x1f, 0xc3, 0x34, 0x47, 0x7b, 0xb8, 0xb1, 0xb3, 0x8f, 0xad, 0x57,
+	0x5a, 0x5f, 0x8f, 0xbb, 0xc5, 0x83, 0x8b, 0x53, 0x3d, 0xe4, 0xe6, 0x5b, 0x05, 0x7d, 0x5b, 0x7d,
+	0x02, 0x7d, 0x4b, 0xf2, 0xdf, 0x53, 0xbf, 0x11, 0x59, 0x6c, 0xc9, 0x9f, 0x24, 0xb5, 0xe2, 0x9f,
+	0x24, 0xb5, 0x36, 0xf9, 0x4f, 0x92, 0x9a, 0x05, 0x8d, 0x55, 0x45, 0xe0, 0x19, 0x9c, 0xd9, 0x22,
+	0xac, 0xdf, 0x07, 0x41, 0x97, 0x4f, 0xd4, 0x2d, 0x6a, 0xea, 0x79, 0xb4, 0xc1, 0x56, 0x8a, 0x3e,
+	0x81, 0xbe, 0x28, 0xc1, 0x5b, 0x5b, 0x84, 0xe5, 0xfb, 0x05, 0xe8, 0xbd, 0x62, 0x26, 0x43, 0xfa,
+	0x0a, 0xcd, 0x07, 0xe3, 0xfa, 0x74, 0x96, 0xac, 0x3e, 0x81, 0x7e, 0x59, 0x82, 0xd9, 0x2d, 0xc2,
+	0xcf, 0x2d, 0x91, 0xe9, 0xfa, 0x68, 0x99, 0x0a, 0x7a, 0x04, 0xcd, 0x9d, 0xb1, 0x5f, 0xec, 0x53,
+	0x22, 0xfd, 0xba, 0x04, 0xe7, 0x53, 0xba, 0x4a, 0xf3, 0x7b, 0x15, 0xd9, 0x3e, 0x1d, 0xb3, 0x8a,
+	0x49, 0x91, 0xd4, 0x27, 0xd0, 0x9e, 0x30, 0x93, 0x7e, 0x69, 0x80, 0x2e, 0x15, 0xd6, 0x00, 0x09,
+	0xf7, 0xe5, 0x61, 0xd3, 0x89, 0x69, 0x7c, 0x0a, 0xd3, 0x5b, 0x84, 0xc5, 0x39, 0x6a, 0xd6, 0xf8,
+	0x73, 0xe5, 0x43, 0x73, 0xa9, 0x78, 0x32, 0x15, 0x20, 0x16, 0x24, 0xad, 0x54, 0x1e, 0x96, 0x0d,
+	0x3f, 0x85, 0x09, 0x6b, 0x53, 0x1f, 0x85, 0x92, 0x50, 0x7f, 0x0e, 0x8b, 0xc5, 0xd1, 0x1f, 0xbd,
+	0x73, 0xe2, 0xbc, 0xa1, 0x79, 0xf5, 0x24, 0xa8, 0x31, 0xcb, 0x4f, 0xd6, 0xff, 0xf2, 0x72, 0xb9,
+	0xf4, 0xd7, 0x97, 0xcb, 0xa5, 0x7f, 0xbe, 0x5c, 0x2e, 0x7d, 0xef, 0xe6, 0x31, 0xbf, 0x5a, 0x4c,
+	0xfd, 0x10, 0x12, 0x53, 0xdb, 0x74, 0x6c, 0xe2, 0xb1, 0x76, 0x4d, 0x84, 0x80, 0x9b, 0xff, 0x1e,
+	0x00, 0x29, 0x18, 0xc6, 0x0d, 0x27, 0x29, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3343,6 +3454,47 @@ func (m *ManifestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.GitRepos) > 0 {
+		for iNdEx := len(m.GitRepos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GitRepos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.HelmValuesFrom) > 0 {
+		for iNdEx := len(m.HelmValuesFrom) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.HelmValuesFrom[iNdEx])
+			copy(dAtA[i:], m.HelmValuesFrom[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.HelmValuesFrom[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if m.OciSignatureVerification != nil {
+		{
+			size, err := m.OciSignatureVerification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
 	if len(m.InstallationID) > 0 {
 		i -= len(m.InstallationID)
 		copy(dAtA[i:], m.InstallationID)
@@ -4005,6 +4157,15 @@ func (m *ManifestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Provenance) > 0 {
+		for iNdEx := len(m.Provenance) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Provenance[iNdEx])
+			copy(dAtA[i:], m.Provenance[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.Provenance[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Commands) > 0 {
 		for iNdEx := len(m.Commands) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Commands[iNdEx])
@@ -4529,6 +4690,18 @@ func (m *RepoAppDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Cue != nil {
+		{
+			size, err := m.Cue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.Plugin != nil {
 		{
 			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
@@ -4985,6 +5158,47 @@ func (m *PluginAppSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CUEAppSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CUEAppSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CUEAppSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *HelmChartsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5385,6 +5599,15 @@ func (m *UpdateRevisionForPathsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.HelmValuesFromHash) > 0 {
+		i -= len(m.HelmValuesFromHash)
+		copy(dAtA[i:], m.HelmValuesFromHash)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.HelmValuesFromHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if len(m.InstallationID) > 0 {
 		i -= len(m.InstallationID)
 		copy(dAtA[i:], m.InstallationID)
@@ -5708,6 +5931,22 @@ func (m *ManifestRequest) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovRepository(uint64(l))
 	}
+	if m.OciSignatureVerification != nil {
+		l = m.OciSignatureVerification.Size()
+		n += 2 + l + sovRepository(uint64(l))
+	}
+	if len(m.HelmValuesFrom) > 0 {
+		for _, s := range m.HelmValuesFrom {
+			l = len(s)
+			n += 2 + l + sovRepository(uint64(l))
+		}
+	}
+	if len(m.GitRepos) > 0 {
+		for _, e := range m.GitRepos {
+			l = e.Size()
+			n += 2 + l + sovRepository(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5916,6 +6155,12 @@ func (m *ManifestResponse) Size() (n int) {
 			n += 1 + l + sovRepository(uint64(l))
 		}
 	}
+	if len(m.Provenance) > 0 {
+		for _, s := range m.Provenance {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6139,6 +6384,10 @@ func (m *RepoAppDetailsResponse) Size() (n int) {
 		l = m.Plugin.Size()
 		n += 1 + l + sovRepository(uint64(l))
 	}
+	if m.Cue != nil {
+		l = m.Cue.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6331,6 +6580,24 @@ func (m *PluginAppSpec) Size() (n int) {
 	return n
 }
 
+func (m *CUEAppSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *HelmChartsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6571,6 +6838,10 @@ func (m *UpdateRevisionForPathsRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRepository(uint64(l))
 	}
+	l = len(m.HelmValuesFromHash)
+	if l > 0 {
+		n += 2 + l + sovRepository(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7553,6 +7824,108 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.InstallationID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OciSignatureVerification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OciSignatureVerification == nil {
+				m.OciSignatureVerification = &v1alpha1.OCISignatureVerification{}
+			}
+			if err := m.OciSignatureVerification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HelmValuesFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HelmValuesFrom = append(m.HelmValuesFrom, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GitRepos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GitRepos = append(m.GitRepos, &v1alpha1.Repository{})
+			if err := m.GitRepos[len(m.GitRepos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -8618,6 +8991,38 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Commands = append(m.Commands, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provenance = append(m.Provenance, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -10204,6 +10609,42 @@ func (m *RepoAppDetailsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cue == nil {
+				m.Cue = &CUEAppSpec{}
+			}
+			if err := m.Cue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -11372,6 +11813,91 @@ func (m *PluginAppSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CUEAppSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CUEAppSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CUEAppSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, &v1alpha1.CUETag{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *HelmChartsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12921,6 +13447,38 @@ func (m *UpdateRevisionForPathsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.InstallationID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HelmValuesFromHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HelmValuesFromHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
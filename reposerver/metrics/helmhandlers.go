@@ -0,0 +1,19 @@
+package metrics
+
+import (
+	"github.com/argoproj/argo-cd/v3/util/helm"
+)
+
+// NewHelmDependencyCacheEventHandlers creates event handlers that update helm dependency cache related metrics
+func NewHelmDependencyCacheEventHandlers(metricsServer *MetricsServer) helm.DependencyCacheEventHandlers {
+	return helm.DependencyCacheEventHandlers{
+		OnHit: func(bytes int64) {
+			metricsServer.IncHelmDependencyCacheRequest(true)
+			metricsServer.AddHelmDependencyCacheSavedBytes(bytes)
+		},
+		OnMiss: func() {
+			metricsServer.IncHelmDependencyCacheRequest(false)
+		},
+		OnSizeChange: metricsServer.SetHelmDependencyCacheSize,
+	}
+}
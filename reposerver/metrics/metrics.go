@@ -19,6 +19,9 @@ type MetricsServer struct {
 	repoPendingRequestsGauge *prometheus.GaugeVec
 	redisRequestCounter      *prometheus.CounterVec
 	redisRequestHistogram    *prometheus.HistogramVec
+	helmDepCacheCounter      *prometheus.CounterVec
+	helmDepCacheSavedCounter prometheus.Counter
+	helmDepCacheSizeGauge    prometheus.Gauge
 	PrometheusRegistry       *prometheus.Registry
 }
 
@@ -100,6 +103,31 @@ func NewMetricsServer() *MetricsServer {
 	)
 	registry.MustRegister(redisRequestHistogram)
 
+	helmDepCacheCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_helm_dependency_cache_requests_total",
+			Help: "Number of lookups of chart dependencies in the helm dependency cache of the repo server",
+		},
+		[]string{"result"},
+	)
+	registry.MustRegister(helmDepCacheCounter)
+
+	helmDepCacheSavedCounter := prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "argocd_helm_dependency_cache_saved_bytes_total",
+			Help: "Number of bytes of chart dependencies restored from the helm dependency cache instead of being downloaded",
+		},
+	)
+	registry.MustRegister(helmDepCacheSavedCounter)
+
+	helmDepCacheSizeGauge := prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "argocd_helm_dependency_cache_size_bytes",
+			Help: "Size of the chart dependencies in the helm dependency cache of the repo server",
+		},
+	)
+	registry.MustRegister(helmDepCacheSizeGauge)
+
 	return &MetricsServer{
 		handler:                  promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
 		gitFetchFailCounter:      gitFetchFailCounter,
@@ -109,6 +137,9 @@ func NewMetricsServer() *MetricsServer {
 		repoPendingRequestsGauge: repoPendingRequestsGauge,
 		redisRequestCounter:      redisRequestCounter,
 		redisRequestHistogram:    redisRequestHistogram,
+		helmDepCacheCounter:      helmDepCacheCounter,
+		helmDepCacheSavedCounter: helmDepCacheSavedCounter,
+		helmDepCacheSizeGauge:    helmDepCacheSizeGauge,
 		PrometheusRegistry:       registry,
 	}
 }
@@ -149,3 +180,22 @@ func (m *MetricsServer) IncRedisRequest(failed bool) {
 func (m *MetricsServer) ObserveRedisRequestDuration(duration time.Duration) {
 	m.redisRequestHistogram.WithLabelValues("argocd-repo-server").Observe(duration.Seconds())
 }
+
+// IncHelmDependencyCacheRequest increments the helm dependency cache lookups counter
+func (m *MetricsServer) IncHelmDependencyCacheRequest(hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	m.helmDepCacheCounter.WithLabelValues(result).Inc()
+}
+
+// AddHelmDependencyCacheSavedBytes adds the size of chart dependencies restored from the helm dependency cache
+func (m *MetricsServer) AddHelmDependencyCacheSavedBytes(bytes int64) {
+	m.helmDepCacheSavedCounter.Add(float64(bytes))
+}
+
+// SetHelmDependencyCacheSize sets the size of the helm dependency cache
+func (m *MetricsServer) SetHelmDependencyCacheSize(bytes int64) {
+	m.helmDepCacheSizeGauge.Set(float64(bytes))
+}
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/go-jsonnet"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/cue"
+	"github.com/argoproj/argo-cd/v3/util/helm"
+	"github.com/argoproj/argo-cd/v3/util/kustomize"
+	"github.com/argoproj/argo-cd/v3/util/provenance"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+// toolVersions caches the versions of the helm and kustomize binaries, keyed by binary, since getting them requires
+// running the binaries
+var toolVersions sync.Map
+
+// attestManifests signs the provenance of the generated manifests with the signing key of the repo server, if any,
+// and adds the resulting attestation to the response. The provenance records the application source the manifests
+// were generated from, the revisions the source and the sources it references were resolved to, and the versions of
+// the tools which generated them.
+func (s *Service) attestManifests(q *apiclient.ManifestRequest, revision string, repoRefs map[string]repoRef, res *apiclient.ManifestResponse, startedOn time.Time) error {
+	if s.provenanceSigner == nil {
+		return nil
+	}
+
+	source := q.ApplicationSource
+	uri := source.RepoURL
+	if source.IsHelm() {
+		uri = source.RepoURL + "/" + source.Chart
+	}
+	isGit := !source.IsHelm() && !source.IsOCI() && !source.IsArchive()
+	dependencies := []provenance.ResourceDescriptor{provenance.NewDependency("", uri, revision, isGit)}
+	refKeys := make([]string, 0, len(repoRefs))
+	for normalizedURL := range repoRefs {
+		refKeys = append(refKeys, normalizedURL)
+	}
+	sort.Strings(refKeys)
+	for _, normalizedURL := range refKeys {
+		ref := repoRefs[normalizedURL]
+		refURI := normalizedURL
+		if refSource, ok := q.RefSources[ref.key]; ok {
+			refURI = refSource.Repo.Repo
+		}
+		dependencies = append(dependencies, provenance.NewDependency(ref.key, refURI, ref.commitSHA, true))
+	}
+
+	finishedOn := s.now().UTC()
+	startedOn = startedOn.UTC()
+	statement := &provenance.Statement{
+		Type:          provenance.StatementType,
+		Subject:       []provenance.ResourceDescriptor{provenance.NewSubject(q.AppName, provenance.Digest(res.Manifests))},
+		PredicateType: provenance.PredicateType,
+		Predicate: provenance.Predicate{
+			BuildDefinition: provenance.BuildDefinition{
+				BuildType:          provenance.BuildType,
+				ExternalParameters: provenance.ExternalParameters{Source: *source},
+				InternalParameters: provenance.InternalParameters{
+					AppName:     q.AppName,
+					Namespace:   q.Namespace,
+					Project:     q.ProjectName,
+					SourceType:  res.SourceType,
+					KubeVersion: source.GetKubeVersionOrDefault(q.KubeVersion),
+					// the values are not recorded, since they might be sensitive
+					HelmValuesFromHash: argo.HelmValuesFromHash(q.HelmValuesFrom),
+				},
+				ResolvedDependencies: dependencies,
+			},
+			RunDetails: provenance.RunDetails{
+				Builder: provenance.Builder{
+					ID:      provenance.BuilderID,
+					Version: getToolVersions(q, v1alpha1.ApplicationSourceType(res.SourceType)),
+				},
+				Metadata: provenance.Metadata{StartedOn: &startedOn, FinishedOn: &finishedOn},
+			},
+		},
+	}
+	envelope, err := s.provenanceSigner.Sign(statement)
+	if err != nil {
+		return err
+	}
+	res.Provenance = []string{envelope}
+	return nil
+}
+
+// getToolVersions returns the versions of Argo CD and of the config management tool of the given source type
+func getToolVersions(q *apiclient.ManifestRequest, sourceType v1alpha1.ApplicationSourceType) map[string]string {
+	versions := map[string]string{"argocd": common.GetVersion().Version}
+	switch sourceType {
+	case v1alpha1.ApplicationSourceTypeHelm:
+		if version, ok := getBinaryVersion("helm", helm.Version); ok {
+			versions["helm"] = version
+		}
+	case v1alpha1.ApplicationSourceTypeKustomize:
+		binaryPath, err := settings.GetKustomizeBinaryPath(q.KustomizeOptions, *q.ApplicationSource)
+		if err == nil {
+			if version, ok := getBinaryVersion("kustomize:"+binaryPath, func() (string, error) { return kustomize.BinaryVersion(binaryPath) }); ok {
+				versions["kustomize"] = version
+			}
+		}
+	case v1alpha1.ApplicationSourceTypePlugin:
+		if q.ApplicationSource.Plugin != nil && q.ApplicationSource.Plugin.Name != "" {
+			versions["plugin"] = q.ApplicationSource.Plugin.Name
+		}
+	case v1alpha1.ApplicationSourceTypeDirectory:
+		versions["jsonnet"] = jsonnet.Version()
+	case v1alpha1.ApplicationSourceTypeCUE:
+		versions["cue"] = cue.Version()
+	}
+	return versions
+}
+
+func getBinaryVersion(key string, version func() (string, error)) (string, bool) {
+	if v, ok := toolVersions.Load(key); ok {
+		return v.(string), true
+	}
+	v, err := version()
+	if err != nil {
+		log.Warnf("Failed to get the version of %s for the manifest provenance: %v", key, err)
+		return "", false
+	}
+	toolVersions.Store(key, v)
+	return v, true
+}
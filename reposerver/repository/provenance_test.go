@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/provenance"
+)
+
+func writeSigningKey(t *testing.T) (string, crypto.PublicKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "signing.key")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+	return path, key.Public()
+}
+
+func TestGenerateManifest_Provenance(t *testing.T) {
+	newRequest := func() *apiclient.ManifestRequest {
+		return &apiclient.ManifestRequest{
+			AppName:   "guestbook",
+			Namespace: "default",
+			Repo:      &v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps"},
+			ApplicationSource: &v1alpha1.ApplicationSource{
+				RepoURL: "https://github.com/argoproj/argocd-example-apps",
+				Path:    ".",
+				CUE:     &v1alpha1.ApplicationSourceCUE{Expression: "objects", Tags: []v1alpha1.CUETag{{Name: "region", Value: "eu"}}},
+			},
+			ProjectName:        "default",
+			ProjectSourceRepos: []string{"*"},
+		}
+	}
+
+	t.Run("Disabled", func(t *testing.T) {
+		service := newService(t, "../../util/cue/testdata/guestbook")
+		res, err := service.GenerateManifest(t.Context(), newRequest())
+		require.NoError(t, err)
+		assert.Empty(t, res.Provenance)
+	})
+
+	t.Run("Signed", func(t *testing.T) {
+		keyPath, publicKey := writeSigningKey(t)
+		service := newService(t, "../../util/cue/testdata/guestbook")
+		service.initConstants.ProvenanceSigningKeyPath = keyPath
+		require.NoError(t, service.Init())
+		q := newRequest()
+		q.HelmValuesFrom = []string{"replicaCount: 2"}
+		res, err := service.GenerateManifest(t.Context(), q)
+		require.NoError(t, err)
+		require.Len(t, res.Provenance, 1)
+
+		statement, _, err := provenance.Verify(res.Provenance[0], []crypto.PublicKey{publicKey})
+		require.NoError(t, err)
+		assert.Equal(t, provenance.Digest(res.Manifests), statement.ManifestsDigest())
+		assert.Equal(t, "guestbook", statement.Subject[0].Name)
+		build := statement.Predicate.BuildDefinition
+		assert.Equal(t, provenance.BuildType, build.BuildType)
+		assert.Equal(t, "objects", build.ExternalParameters.Source.CUE.Expression)
+		assert.Equal(t, "CUE", build.InternalParameters.SourceType)
+		assert.Equal(t, argo.HelmValuesFromHash(q.HelmValuesFrom), build.InternalParameters.HelmValuesFromHash)
+		require.Len(t, build.ResolvedDependencies, 1)
+		assert.Equal(t, "https://github.com/argoproj/argocd-example-apps", build.ResolvedDependencies[0].URI)
+		assert.Equal(t, res.Revision, build.ResolvedDependencies[0].Annotations["revision"])
+		versions := statement.Predicate.RunDetails.Builder.Version
+		assert.NotEmpty(t, versions["argocd"])
+		assert.NotEmpty(t, versions["cue"])
+	})
+
+	t.Run("CachedWithoutProvenance", func(t *testing.T) {
+		keyPath, _ := writeSigningKey(t)
+		service := newService(t, "../../util/cue/testdata/guestbook")
+		res, err := service.GenerateManifest(t.Context(), newRequest())
+		require.NoError(t, err)
+		assert.Empty(t, res.Provenance)
+
+		// the cached manifests are generated again to be attested
+		service.initConstants.ProvenanceSigningKeyPath = keyPath
+		require.NoError(t, service.Init())
+		res, err = service.GenerateManifest(t.Context(), newRequest())
+		require.NoError(t, err)
+		assert.Len(t, res.Provenance, 1)
+	})
+
+	t.Run("InvalidKey", func(t *testing.T) {
+		service := newService(t, "../../util/cue/testdata/guestbook")
+		service.initConstants.ProvenanceSigningKeyPath = filepath.Join(t.TempDir(), "missing.key")
+		require.ErrorContains(t, service.Init(), "error loading provenance signing key")
+	})
+}
+
+func TestGetToolVersions(t *testing.T) {
+	q := &apiclient.ManifestRequest{ApplicationSource: &v1alpha1.ApplicationSource{Plugin: &v1alpha1.ApplicationSourcePlugin{Name: "my-plugin-v1.0"}}}
+	versions := getToolVersions(q, v1alpha1.ApplicationSourceTypePlugin)
+	assert.Equal(t, "my-plugin-v1.0", versions["plugin"])
+	assert.NotEmpty(t, versions["argocd"])
+
+	versions = getToolVersions(q, v1alpha1.ApplicationSourceTypeDirectory)
+	assert.NotEmpty(t, versions["jsonnet"])
+}
@@ -14,6 +14,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	gosync "sync"
 	"time"
 
 	"github.com/TomOnTime/utfutil"
@@ -50,8 +51,11 @@ import (
 	"github.com/argoproj/argo-cd/v3/reposerver/metrics"
 	"github.com/argoproj/argo-cd/v3/util/app/discovery"
 	apppathutil "github.com/argoproj/argo-cd/v3/util/app/path"
+	"github.com/argoproj/argo-cd/v3/util/archive"
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/cmp"
+	"github.com/argoproj/argo-cd/v3/util/cosign"
+	"github.com/argoproj/argo-cd/v3/util/cue"
 	"github.com/argoproj/argo-cd/v3/util/git"
 	"github.com/argoproj/argo-cd/v3/util/glob"
 	"github.com/argoproj/argo-cd/v3/util/gpg"
@@ -60,8 +64,10 @@ import (
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/io/files"
 	pathutil "github.com/argoproj/argo-cd/v3/util/io/path"
+	"github.com/argoproj/argo-cd/v3/util/jsonnetbundler"
 	"github.com/argoproj/argo-cd/v3/util/kustomize"
 	"github.com/argoproj/argo-cd/v3/util/manifeststream"
+	"github.com/argoproj/argo-cd/v3/util/provenance"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 	"github.com/argoproj/argo-cd/v3/util/versions"
 )
@@ -84,12 +90,18 @@ type Service struct {
 	gitRepoPaths              utilio.TempPaths
 	chartPaths                utilio.TempPaths
 	ociPaths                  utilio.TempPaths
+	archivePaths              utilio.TempPaths
+	jsonnetDependencyPaths    utilio.TempPaths
+	jsonnetDependencyUsage    *jsonnetDependencyUsage
+	helmDependencyCache       *helm.DependencyCache
+	provenanceSigner          *provenance.Signer
 	gitRepoInitializer        func(rootPath string) goio.Closer
 	repoLock                  *repositoryLock
 	cache                     *cache.Cache
 	parallelismLimitSemaphore *semaphore.Weighted
 	metricsServer             *metrics.MetricsServer
 	newOCIClient              func(repoURL string, creds oci.Creds, proxy string, noProxy string, mediaTypes []string, opts ...oci.ClientOpts) (oci.Client, error)
+	newArchiveClient          func(repoURL string, creds archive.Creds, proxy string, noProxy string, opts ...archive.ClientOpts) (archive.Client, error)
 	newGitClient              func(rawRepoURL string, root string, creds git.Creds, insecure bool, enableLfs bool, proxy string, noProxy string, opts ...git.ClientOpts) (git.Client, error)
 	newHelmClient             func(repoURL string, creds helm.Creds, enableOci bool, proxy string, noProxy string, opts ...helm.ClientOpts) helm.Client
 	initConstants             RepoServerInitConstants
@@ -113,6 +125,11 @@ type RepoServerInitConstants struct {
 	HelmRegistryMaxIndexSize                     int64
 	OCIManifestMaxExtractedSize                  int64
 	DisableOCIManifestMaxExtractedSize           bool
+	ArchiveManifestMaxExtractedSize              int64
+	DisableArchiveManifestMaxExtractedSize       bool
+	CosignTrustRootPath                          string
+	ProvenanceSigningKeyPath                     string
+	HelmDependencyCacheMaxSize                   int64
 	DisableHelmManifestMaxExtractedSize          bool
 	IncludeHiddenDirectories                     bool
 	CMPUseManifestGeneratePaths                  bool
@@ -120,6 +137,13 @@ type RepoServerInitConstants struct {
 
 var manifestGenerateLock = sync.NewKeyLock()
 
+// jsonnetDependencyLock prevents fetching the same jsonnet-bundler dependency concurrently
+var jsonnetDependencyLock = sync.NewKeyLock()
+
+// jsonnetDependencyMaxIdle is the duration after which the working tree of a jsonnet-bundler dependency which is no
+// longer used is removed
+var jsonnetDependencyMaxIdle = 24 * time.Hour
+
 // NewService returns a new instance of the Manifest service
 func NewService(metricsServer *metrics.MetricsServer, cache *cache.Cache, initConstants RepoServerInitConstants, gitCredsStore git.CredsStore, rootDir string) *Service {
 	var parallelismLimitSemaphore *semaphore.Weighted
@@ -130,6 +154,12 @@ func NewService(metricsServer *metrics.MetricsServer, cache *cache.Cache, initCo
 	gitRandomizedPaths := utilio.NewRandomizedTempPaths(rootDir)
 	helmRandomizedPaths := utilio.NewRandomizedTempPaths(rootDir)
 	ociRandomizedPaths := utilio.NewRandomizedTempPaths(rootDir)
+	archiveRandomizedPaths := utilio.NewRandomizedTempPaths(rootDir)
+	jsonnetDependencyRandomizedPaths := utilio.NewRandomizedTempPaths(rootDir)
+	var helmDependencyCache *helm.DependencyCache
+	if initConstants.HelmDependencyCacheMaxSize > 0 {
+		helmDependencyCache = helm.NewDependencyCache(utilio.NewRandomizedTempPaths(rootDir), initConstants.HelmDependencyCacheMaxSize, metrics.NewHelmDependencyCacheEventHandlers(metricsServer))
+	}
 	return &Service{
 		parallelismLimitSemaphore: parallelismLimitSemaphore,
 		repoLock:                  repoLock,
@@ -137,21 +167,34 @@ func NewService(metricsServer *metrics.MetricsServer, cache *cache.Cache, initCo
 		metricsServer:             metricsServer,
 		newGitClient:              git.NewClientExt,
 		newOCIClient:              oci.NewClient,
+		newArchiveClient:          archive.NewClient,
 		newHelmClient: func(repoURL string, creds helm.Creds, enableOci bool, proxy string, noProxy string, opts ...helm.ClientOpts) helm.Client {
 			return helm.NewClientWithLock(repoURL, creds, sync.NewKeyLock(), enableOci, proxy, noProxy, opts...)
 		},
-		initConstants:      initConstants,
-		now:                time.Now,
-		gitCredsStore:      gitCredsStore,
-		gitRepoPaths:       gitRandomizedPaths,
-		chartPaths:         helmRandomizedPaths,
-		ociPaths:           ociRandomizedPaths,
-		gitRepoInitializer: directoryPermissionInitializer,
-		rootDir:            rootDir,
+		initConstants:          initConstants,
+		now:                    time.Now,
+		gitCredsStore:          gitCredsStore,
+		gitRepoPaths:           gitRandomizedPaths,
+		chartPaths:             helmRandomizedPaths,
+		ociPaths:               ociRandomizedPaths,
+		archivePaths:           archiveRandomizedPaths,
+		jsonnetDependencyPaths: jsonnetDependencyRandomizedPaths,
+		jsonnetDependencyUsage: &jsonnetDependencyUsage{lastUsed: make(map[string]time.Time)},
+		helmDependencyCache:    helmDependencyCache,
+		gitRepoInitializer:     directoryPermissionInitializer,
+		rootDir:                rootDir,
 	}
 }
 
 func (s *Service) Init() error {
+	if s.initConstants.ProvenanceSigningKeyPath != "" {
+		signer, err := provenance.LoadSigner(s.initConstants.ProvenanceSigningKeyPath)
+		if err != nil {
+			return fmt.Errorf("error loading provenance signing key: %w", err)
+		}
+		s.provenanceSigner = signer
+	}
+
 	_, err := os.Stat(s.rootDir)
 	if os.IsNotExist(err) {
 		return os.MkdirAll(s.rootDir, 0o300)
@@ -253,6 +296,12 @@ func (s *Service) ListApps(ctx context.Context, q *apiclient.ListAppsRequest) (*
 	}
 
 	defer utilio.Close(closer)
+	if q.Repo.EnablePartialClone {
+		// applications are discovered in the whole repository
+		if err := gitClient.SparseCheckout([]string{"."}); err != nil {
+			return nil, fmt.Errorf("error widening sparse checkout: %w", err)
+		}
+	}
 	apps, err := discovery.Discover(ctx, gitClient.Root(), gitClient.Root(), q.EnabledSourceTypes, s.initConstants.CMPTarExcludedGlobs, []string{})
 	if err != nil {
 		return nil, fmt.Errorf("error discovering applications: %w", err)
@@ -290,6 +339,8 @@ type operationSettings struct {
 	noCache         bool
 	noRevisionCache bool
 	allowConcurrent bool
+	// ociSignatureVerification is the specification OCI images are verified against before they are extracted
+	ociSignatureVerification *v1alpha1.OCISignatureVerification
 }
 
 // operationContext contains request values which are generated by runRepoOperation (on demand) by a call to the
@@ -298,8 +349,12 @@ type operationContext struct {
 	// application path or helm chart path
 	appPath string
 
-	// output of 'git verify-(tag/commit)', if signature verification is enabled (otherwise "")
+	// output of 'git verify-(tag/commit)' or the cosign verification result of an OCI image, if signature
+	// verification is enabled (otherwise "")
 	verificationResult string
+
+	// widens the sparse checkout of a partial clone with the given paths, nil unless the repository is a partial clone
+	sparseCheckout func(paths []string) error
 }
 
 // The 'operation' function parameter of 'runRepoOperation' may call this function to retrieve
@@ -330,6 +385,7 @@ func (s *Service) runRepoOperation(
 	}
 
 	var ociClient oci.Client
+	var archiveClient archive.Client
 	var gitClient git.Client
 	var helmClient helm.Client
 	var err error
@@ -342,6 +398,8 @@ func (s *Service) runRepoOperation(
 		ociClient, revision, err = s.newOCIClientResolveRevision(ctx, repo, revision, settings.noCache || settings.noRevisionCache)
 	case source.IsHelm():
 		helmClient, revision, err = s.newHelmClientResolveRevision(repo, revision, source.Chart, settings.noCache || settings.noRevisionCache)
+	case source.IsArchive():
+		archiveClient, revision, err = s.newArchiveClientResolveRevision(ctx, repo, revision)
 	default:
 		gitClient, revision, err = s.newClientResolveRevision(repo, revision, gitClientOpts)
 	}
@@ -380,6 +438,15 @@ func (s *Service) runRepoOperation(
 			}
 		}
 
+		var verificationResult string
+		if settings.ociSignatureVerification != nil {
+			result, err := s.verifyOCISignature(ctx, ociClient, repo, revision, settings.ociSignatureVerification)
+			if err != nil {
+				return err
+			}
+			verificationResult = result.String()
+		}
+
 		ociPath, closer, err := ociClient.Extract(ctx, revision)
 		if err != nil {
 			return err
@@ -409,7 +476,7 @@ func (s *Service) runRepoOperation(
 		}
 
 		return operation(ociPath, revision, revision, func() (*operationContext, error) {
-			return &operationContext{appPath, ""}, nil
+			return &operationContext{appPath, verificationResult, nil}, nil
 		})
 	} else if source.IsHelm() {
 		if settings.noCache {
@@ -444,7 +511,53 @@ func (s *Service) runRepoOperation(
 			}
 		}
 		return operation(chartPath, revision, revision, func() (*operationContext, error) {
-			return &operationContext{chartPath, ""}, nil
+			return &operationContext{chartPath, "", nil}, nil
+		})
+	} else if source.IsArchive() {
+		if settings.noCache {
+			err = archiveClient.CleanCache(revision)
+			if err != nil {
+				return err
+			}
+		}
+
+		archivePath, closer, err := archiveClient.Extract(ctx, revision)
+		if err != nil {
+			return err
+		}
+		defer utilio.Close(closer)
+
+		if !s.initConstants.AllowOutOfBoundsSymlinks {
+			err := apppathutil.CheckOutOfBoundsSymlinks(archivePath)
+			if err != nil {
+				oobError := &apppathutil.OutOfBoundsSymlinkError{}
+				if errors.As(err, &oobError) {
+					log.WithFields(log.Fields{
+						common.SecurityField: common.SecurityHigh,
+						"repo":               repo.Repo,
+						"revision":           revision,
+						"file":               oobError.File,
+					}).Warn("archive contains out-of-bounds symlink")
+					return fmt.Errorf("archive contains out-of-bounds symlinks. file: %s", oobError.File)
+				}
+				return err
+			}
+		}
+
+		appPath, err := apppathutil.Path(archivePath, source.Path)
+		if err != nil {
+			return err
+		}
+
+		return operation(archivePath, revision, revision, func() (*operationContext, error) {
+			var signature string
+			if verifyCommit {
+				signature, err = archiveClient.VerifySignature(ctx, revision)
+				if err != nil {
+					return nil, err
+				}
+			}
+			return &operationContext{appPath, signature, nil}, nil
 		})
 	}
 	closer, err := s.repoLock.Lock(gitClient.Root(), revision, settings.allowConcurrent, func() (goio.Closer, error) {
@@ -456,6 +569,12 @@ func (s *Service) runRepoOperation(
 
 	defer utilio.Close(closer)
 
+	if repo.EnablePartialClone && source != nil {
+		if err := sparseCheckout(gitClient, sparseCheckoutPaths(source)); err != nil {
+			return err
+		}
+	}
+
 	if !s.initConstants.AllowOutOfBoundsSymlinks {
 		err := apppathutil.CheckOutOfBoundsSymlinks(gitClient.Root())
 		if err != nil {
@@ -491,9 +610,13 @@ func (s *Service) runRepoOperation(
 		}
 	}
 
-	// Here commitSHA refers to the SHA of the actual commit, whereas revision refers to the branch/tag name etc
-	// We use the commitSHA to generate manifests and store them in cache, and revision to retrieve them from cache
-	return operation(gitClient.Root(), commitSHA, revision, func() (*operationContext, error) {
+	var widenSparseCheckout func(paths []string) error
+	if repo.EnablePartialClone {
+		widenSparseCheckout = func(paths []string) error {
+			return sparseCheckout(gitClient, paths)
+		}
+	}
+	opContextSrc := func() (*operationContext, error) {
 		var signature string
 		if verifyCommit {
 			// When the revision is an annotated tag, we need to pass the unresolved revision (i.e. the tag name)
@@ -505,6 +628,7 @@ func (s *Service) runRepoOperation(
 			} else {
 				rev = revision
 			}
+			var err error
 			signature, err = gitClient.VerifyCommitSignature(rev)
 			if err != nil {
 				return nil, err
@@ -514,8 +638,24 @@ func (s *Service) runRepoOperation(
 		if err != nil {
 			return nil, err
 		}
-		return &operationContext{appPath, signature}, nil
-	})
+		return &operationContext{appPath, signature, widenSparseCheckout}, nil
+	}
+
+	// Here commitSHA refers to the SHA of the actual commit, whereas revision refers to the branch/tag name etc
+	// We use the commitSHA to generate manifests and store them in cache, and revision to retrieve them from cache
+	err = operation(gitClient.Root(), commitSHA, revision, opContextSrc)
+	if err != nil && repo.EnablePartialClone && isMissingPathError(err) {
+		// the operation might need files which are not referenced by the source, e.g. relative Jsonnet imports
+		log.WithFields(log.Fields{
+			"repo":     repo.Repo,
+			"revision": revision,
+		}).Infof("Widening sparse checkout to the whole repository after error: %v", err)
+		if err := gitClient.SparseCheckout([]string{"."}); err != nil {
+			return fmt.Errorf("failed to widen sparse checkout: %w", err)
+		}
+		return operation(gitClient.Root(), commitSHA, revision, opContextSrc)
+	}
+	return err
 }
 
 func getRepoSanitizerRegex(rootDir string) *regexp.Regexp {
@@ -586,7 +726,7 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 	var err error
 
 	// Skip this path for ref only sources
-	if q.HasMultipleSources && q.ApplicationSource.Path == "" && !q.ApplicationSource.IsOCI() && !q.ApplicationSource.IsHelm() && q.ApplicationSource.IsRef() {
+	if q.HasMultipleSources && q.ApplicationSource.Path == "" && !q.ApplicationSource.IsOCI() && !q.ApplicationSource.IsHelm() && !q.ApplicationSource.IsArchive() && q.ApplicationSource.IsRef() {
 		log.Debugf("Skipping manifest generation for ref only source for application: %s and ref %s", q.AppName, q.ApplicationSource.Ref)
 		_, revision, err := s.newClientResolveRevision(q.Repo, q.Revision, git.WithCache(s.cache, !q.NoRevisionCache && !q.NoCache))
 		res = &apiclient.ManifestResponse{
@@ -633,7 +773,7 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 		return nil
 	}
 
-	settings := operationSettings{sem: s.parallelismLimitSemaphore, noCache: q.NoCache, noRevisionCache: q.NoRevisionCache, allowConcurrent: q.ApplicationSource.AllowsConcurrentProcessing()}
+	settings := operationSettings{sem: s.parallelismLimitSemaphore, noCache: q.NoCache, noRevisionCache: q.NoRevisionCache, allowConcurrent: q.ApplicationSource.AllowsConcurrentProcessing(), ociSignatureVerification: q.OciSignatureVerification}
 	err = s.runRepoOperation(ctx, q.Revision, q.Repo, q.ApplicationSource, q.VerifySignature, cacheFn, operation, settings, q.HasMultipleSources, q.RefSources)
 
 	// if the tarDoneCh message is sent it means that the manifest
@@ -689,7 +829,7 @@ func (s *Service) GenerateManifestWithFiles(stream apiclient.RepoServerService_G
 		if err != nil {
 			return nil, fmt.Errorf("failed to get app path: %w", err)
 		}
-		return &operationContext{appPath, ""}, nil
+		return &operationContext{appPath, "", nil}, nil
 	}, req)
 
 	var res *apiclient.ManifestResponse
@@ -847,6 +987,13 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 							}
 						}(closer)
 
+						if refSourceMapping.Repo.EnablePartialClone {
+							if err := sparseCheckout(gitClient, refSparseCheckoutPaths(refCandidates, q.RefSources, normalizedRepoURL)); err != nil {
+								ch.errCh <- err
+								return
+							}
+						}
+
 						// Symlink check must happen after acquiring lock.
 						if !s.initConstants.AllowOutOfBoundsSymlinks {
 							err := apppathutil.CheckOutOfBoundsSymlinks(gitClient.Root())
@@ -873,7 +1020,11 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 			}
 		}
 
-		manifestGenResult, err = GenerateManifests(ctx, opContext.appPath, repoRoot, commitSHA, q, false, s.gitCredsStore, s.initConstants.MaxCombinedDirectoryManifestsSize, s.gitRepoPaths, WithCMPTarDoneChannel(ch.tarDoneCh), WithCMPTarExcludedGlobs(s.initConstants.CMPTarExcludedGlobs), WithCMPUseManifestGeneratePaths(s.initConstants.CMPUseManifestGeneratePaths))
+		startedOn := s.now()
+		manifestGenResult, err = GenerateManifests(ctx, opContext.appPath, repoRoot, commitSHA, q, false, s.gitCredsStore, s.initConstants.MaxCombinedDirectoryManifestsSize, s.gitRepoPaths, WithCMPTarDoneChannel(ch.tarDoneCh), WithCMPTarExcludedGlobs(s.initConstants.CMPTarExcludedGlobs), WithCMPUseManifestGeneratePaths(s.initConstants.CMPUseManifestGeneratePaths), WithJsonnetDependencyFetcher(s.newJsonnetDependencyFetcher(q)), WithHelmDependencyCache(s.helmDependencyCache), WithSparseCheckout(opContext.sparseCheckout))
+		if err == nil {
+			err = s.attestManifests(q, commitSHA, repoRefs, manifestGenResult, startedOn)
+		}
 	}
 	refSourceCommitSHAs := make(map[string]string)
 	if len(repoRefs) > 0 {
@@ -894,7 +1045,7 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 			// Retrieve a new copy (if available) of the cached response: this ensures we are updating the latest copy of the cache,
 			// rather than a copy of the cache that occurred before (a potentially lengthy) manifest generation.
 			innerRes := &cache.CachedManifestResponse{}
-			cacheErr := s.cache.GetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, innerRes, refSourceCommitSHAs, q.InstallationID)
+			cacheErr := s.cache.GetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, innerRes, refSourceCommitSHAs, q.InstallationID, argo.HelmValuesFromHash(q.HelmValuesFrom))
 			if cacheErr != nil && !errors.Is(cacheErr, cache.ErrCacheMiss) {
 				logCtx.Warnf("manifest cache get error %s: %v", appSourceCopy.String(), cacheErr)
 				ch.errCh <- cacheErr
@@ -912,7 +1063,7 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 			// Update the cache to include failure information
 			innerRes.NumberOfConsecutiveFailures++
 			innerRes.MostRecentError = err.Error()
-			cacheErr = s.cache.SetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, innerRes, refSourceCommitSHAs, q.InstallationID)
+			cacheErr = s.cache.SetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, innerRes, refSourceCommitSHAs, q.InstallationID, argo.HelmValuesFromHash(q.HelmValuesFrom))
 
 			if cacheErr != nil {
 				logCtx.Warnf("manifest cache set error %s: %v", appSourceCopy.String(), cacheErr)
@@ -936,7 +1087,7 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 	}
 	manifestGenResult.Revision = commitSHA
 	manifestGenResult.VerifyResult = opContext.verificationResult
-	err = s.cache.SetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &manifestGenCacheEntry, refSourceCommitSHAs, q.InstallationID)
+	err = s.cache.SetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &manifestGenCacheEntry, refSourceCommitSHAs, q.InstallationID, argo.HelmValuesFromHash(q.HelmValuesFrom))
 	if err != nil {
 		log.Warnf("manifest cache set error %s/%s: %v", appSourceCopy.String(), cacheKey, err)
 	}
@@ -953,7 +1104,7 @@ func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRe
 	cache.LogDebugManifestCacheKeyFields("getting manifests cache", "GenerateManifest API call", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs)
 
 	res := cache.CachedManifestResponse{}
-	err := s.cache.GetManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &res, refSourceCommitSHAs, q.InstallationID)
+	err := s.cache.GetManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &res, refSourceCommitSHAs, q.InstallationID, argo.HelmValuesFromHash(q.HelmValuesFrom))
 	if err == nil {
 		// The cache contains an existing value
 
@@ -970,7 +1121,7 @@ func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRe
 						cache.LogDebugManifestCacheKeyFields("deleting manifests cache", "manifest hash did not match or cached response is empty", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs)
 
 						// We can now try again, so reset the cache state and run the operation below
-						err = s.cache.DeleteManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs, q.InstallationID)
+						err = s.cache.DeleteManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs, q.InstallationID, argo.HelmValuesFromHash(q.HelmValuesFrom))
 						if err != nil {
 							log.Warnf("manifest cache set error %s/%s: %v", q.ApplicationSource.String(), cacheKey, err)
 						}
@@ -985,7 +1136,7 @@ func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRe
 						cache.LogDebugManifestCacheKeyFields("deleting manifests cache", "reset after paused generation count", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs)
 
 						// We can now try again, so reset the error cache state and run the operation below
-						err = s.cache.DeleteManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs, q.InstallationID)
+						err = s.cache.DeleteManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs, q.InstallationID, argo.HelmValuesFromHash(q.HelmValuesFrom))
 						if err != nil {
 							log.Warnf("manifest cache set error %s/%s: %v", q.ApplicationSource.String(), cacheKey, err)
 						}
@@ -1006,7 +1157,7 @@ func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRe
 					// Increment the number of returned cached responses and push that new value to the cache
 					// (if we have not already done so previously in this function)
 					res.NumberOfCachedResponsesReturned++
-					err = s.cache.SetManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &res, refSourceCommitSHAs, q.InstallationID)
+					err = s.cache.SetManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &res, refSourceCommitSHAs, q.InstallationID, argo.HelmValuesFromHash(q.HelmValuesFrom))
 					if err != nil {
 						log.Warnf("manifest cache set error %s/%s: %v", q.ApplicationSource.String(), cacheKey, err)
 					}
@@ -1021,6 +1172,20 @@ func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRe
 			return false, res.ManifestResponse, nil
 		}
 
+		// Manifests generated without verifying the signature of an OCI image must not be served when the
+		// verification is requested
+		if q.OciSignatureVerification != nil && res.ManifestResponse != nil && res.ManifestResponse.VerifyResult == "" {
+			log.Infof("manifest cache hit without signature verification result: %s/%s", q.ApplicationSource.String(), cacheKey)
+			return false, nil, nil
+		}
+
+		// Manifests whose cache entry was moved to another revision, which did not change the paths of the
+		// application, lost the attestation of the revision they were generated from and are attested again
+		if s.provenanceSigner != nil && res.ManifestResponse != nil && len(res.ManifestResponse.Provenance) == 0 {
+			log.Infof("manifest cache hit without provenance: %s/%s", q.ApplicationSource.String(), cacheKey)
+			return false, nil, nil
+		}
+
 		log.Infof("manifest cache hit: %s/%s", q.ApplicationSource.String(), cacheKey)
 		return true, res.ManifestResponse, nil
 	}
@@ -1141,7 +1306,9 @@ func sanitizeRepoName(repoName string) string {
 // if multiple threads are trying to run it.
 // Multiple goroutines might process same helm app in one repo concurrently when repo server process multiple
 // manifest generation requests of the same commit.
-func runHelmBuild(appPath string, h helm.Helm) error {
+// If a dependency cache is given, the dependencies locked by the chart are restored from the cache instead, and
+// cached once downloaded for the applications of the same project.
+func runHelmBuild(appPath string, h helm.Helm, dependencyCache *helm.DependencyCache, repo *v1alpha1.Repository, project string) error {
 	manifestGenerateLock.Lock(appPath)
 	defer manifestGenerateLock.Unlock(appPath)
 
@@ -1156,19 +1323,54 @@ func runHelmBuild(appPath string, h helm.Helm) error {
 		return err
 	}
 
-	err = h.DependencyBuild()
-	if err != nil {
-		return fmt.Errorf("error building helm chart dependencies: %w", err)
+	var cacheKey string
+	var archives []string
+	if dependencyCache != nil {
+		cacheKey, archives, err = helm.DependencyCacheKey(appPath, getHelmDependencyCacheRepoURL(repo), project)
+		if err != nil {
+			log.Warnf("Failed to get the helm dependency cache key of %s: %v", appPath, err)
+		}
+	}
+	restored := false
+	if cacheKey != "" {
+		restored, err = dependencyCache.Restore(cacheKey, appPath)
+		if err != nil {
+			log.Warnf("Failed to restore helm chart dependencies of %s from cache: %v", appPath, err)
+		}
+	}
+
+	if !restored {
+		err = h.DependencyBuild()
+		if err != nil {
+			return fmt.Errorf("error building helm chart dependencies: %w", err)
+		}
+		if cacheKey != "" {
+			if err := dependencyCache.Store(cacheKey, appPath, archives); err != nil {
+				log.Warnf("Failed to cache helm chart dependencies of %s: %v", appPath, err)
+			}
+		}
 	}
 	return os.WriteFile(markerFile, []byte("marker"), 0o644)
 }
 
+// getHelmDependencyCacheRepoURL returns the URL the dependencies of the charts of the given repository are cached
+// for. Git repositories are normalized, so that all URLs of the same repository share the cache.
+func getHelmDependencyCacheRepoURL(repo *v1alpha1.Repository) string {
+	if repo == nil {
+		return ""
+	}
+	if repo.Type == "" || repo.Type == "git" {
+		return git.NormalizeGitURL(repo.Repo)
+	}
+	return repo.Repo
+}
+
 func isSourcePermitted(url string, repos []string) bool {
 	p := v1alpha1.AppProject{Spec: v1alpha1.AppProjectSpec{SourceRepos: repos}}
 	return p.IsSourcePermitted(v1alpha1.ApplicationSource{RepoURL: url})
 }
 
-func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, isLocal bool, gitRepoPaths utilio.TempPaths) ([]*unstructured.Unstructured, string, error) {
+func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, isLocal bool, gitRepoPaths utilio.TempPaths, dependencyCache *helm.DependencyCache) ([]*unstructured.Unstructured, string, error) {
 	// We use the app name as Helm's release name property, which must not
 	// contain any underscore characters and must not exceed 53 characters.
 	// We are not interested in the fully qualified application name while
@@ -1206,6 +1408,23 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 
 		templateOpts.Values = resolvedValueFiles
 
+		// The values of ConfigMaps and Secrets are passed after the value files, so that they take precedence
+		for _, values := range q.HelmValuesFrom {
+			rand, err := uuid.NewRandom()
+			if err != nil {
+				return nil, "", fmt.Errorf("error generating random filename for Helm values file: %w", err)
+			}
+			p := path.Join(os.TempDir(), rand.String())
+			defer func() {
+				_ = os.RemoveAll(p)
+			}()
+			err = os.WriteFile(p, []byte(values), 0o600)
+			if err != nil {
+				return nil, "", fmt.Errorf("error writing helm values file: %w", err)
+			}
+			templateOpts.Values = append(templateOpts.Values, pathutil.ResolvedFilePath(p))
+		}
+
 		if !appHelm.ValuesIsEmpty() {
 			rand, err := uuid.NewRandom()
 			if err != nil {
@@ -1287,7 +1506,7 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 			return nil, "", err
 		}
 
-		err = runHelmBuild(appPath, h)
+		err = runHelmBuild(appPath, h, dependencyCache, q.Repo, q.ProjectName)
 		if err != nil {
 			var reposNotPermitted []string
 			// We do a sanity check here to give a nicer error message in case any of the Helm repositories are not permitted by
@@ -1437,6 +1656,9 @@ type (
 		cmpTarDoneCh                chan<- bool
 		cmpTarExcludedGlobs         []string
 		cmpUseManifestGeneratePaths bool
+		fetchJsonnetDependency      jsonnetbundler.Fetcher
+		helmDependencyCache         *helm.DependencyCache
+		sparseCheckout              func(paths []string) error
 	}
 )
 
@@ -1473,6 +1695,31 @@ func WithCMPUseManifestGeneratePaths(enabled bool) GenerateManifestOpt {
 	}
 }
 
+// WithJsonnetDependencyFetcher defines the fetcher of the jsonnet-bundler dependencies locked in the
+// jsonnetfile.lock.json of directory apps. Without it, only the dependencies vendored in the repository can be
+// imported, using the jsonnet libs of the app.
+func WithJsonnetDependencyFetcher(fetch jsonnetbundler.Fetcher) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.fetchJsonnetDependency = fetch
+	}
+}
+
+// WithHelmDependencyCache defines the cache of the chart dependencies downloaded by `helm dependency build`, shared
+// by the manifest generations of all apps. Without it, the dependencies are downloaded for each app.
+func WithHelmDependencyCache(cache *helm.DependencyCache) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.helmDependencyCache = cache
+	}
+}
+
+// WithSparseCheckout defines the function widening the sparse checkout of a partial clone, which is used to check out
+// the whole repository before it is sent to a config management plugin.
+func WithSparseCheckout(sparseCheckout func(paths []string) error) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.sparseCheckout = sparseCheckout
+	}
+}
+
 // GenerateManifests generates manifests from a path. Overrides are applied as a side effect on the given ApplicationSource.
 func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string, q *apiclient.ManifestRequest, isLocal bool, gitCredsStore git.CredsStore, maxCombinedManifestQuantity resource.Quantity, gitRepoPaths utilio.TempPaths, opts ...GenerateManifestOpt) (*apiclient.ManifestResponse, error) {
 	opt := newGenerateManifestOpt(opts...)
@@ -1496,7 +1743,7 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 	switch appSourceType {
 	case v1alpha1.ApplicationSourceTypeHelm:
 		var command string
-		targetObjs, command, err = helmTemplate(appPath, repoRoot, env, q, isLocal, gitRepoPaths)
+		targetObjs, command, err = helmTemplate(appPath, repoRoot, env, q, isLocal, gitRepoPaths, opt.helmDependencyCache)
 		commands = append(commands, command)
 	case v1alpha1.ApplicationSourceTypeKustomize:
 		var kustomizeBinary string
@@ -1514,6 +1761,12 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 		if q.ApplicationSource.Plugin != nil {
 			pluginName = q.ApplicationSource.Plugin.Name
 		}
+		// the plugin is sent the whole repository, which might have been detected from a sparse checkout
+		if opt.sparseCheckout != nil {
+			if err := opt.sparseCheckout([]string{"."}); err != nil {
+				return nil, err
+			}
+		}
 		// if pluginName is provided it has to be `<metadata.name>-<spec.version>` or just `<metadata.name>` if plugin version is empty
 		targetObjs, err = runConfigManagementPluginSidecars(ctx, appPath, repoRoot, pluginName, env, q, q.Repo.GetGitCreds(gitCredsStore), opt.cmpTarDoneCh, opt.cmpTarExcludedGlobs, opt.cmpUseManifestGeneratePaths)
 		if err != nil {
@@ -1525,7 +1778,9 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 			directory = &v1alpha1.ApplicationSourceDirectory{}
 		}
 		logCtx := log.WithField("application", q.AppName)
-		targetObjs, err = findManifests(logCtx, appPath, repoRoot, env, *directory, q.EnabledSourceTypes, maxCombinedManifestQuantity)
+		targetObjs, err = findManifests(logCtx, appPath, repoRoot, env, *directory, q.EnabledSourceTypes, maxCombinedManifestQuantity, opt.fetchJsonnetDependency)
+	case v1alpha1.ApplicationSourceTypeCUE:
+		targetObjs, err = cue.Evaluate(ctx, appPath, repoRoot, q.ApplicationSource.CUE, env)
 	}
 	if err != nil {
 		return nil, err
@@ -1720,7 +1975,7 @@ func isNullList(obj *unstructured.Unstructured) bool {
 var manifestFile = regexp.MustCompile(`^.*\.(yaml|yml|json|jsonnet)$`)
 
 // findManifests looks at all yaml files in a directory and unmarshals them into a list of unstructured objects
-func findManifests(logCtx *log.Entry, appPath string, repoRoot string, env *v1alpha1.Env, directory v1alpha1.ApplicationSourceDirectory, enabledManifestGeneration map[string]bool, maxCombinedManifestQuantity resource.Quantity) ([]*unstructured.Unstructured, error) {
+func findManifests(logCtx *log.Entry, appPath string, repoRoot string, env *v1alpha1.Env, directory v1alpha1.ApplicationSourceDirectory, enabledManifestGeneration map[string]bool, maxCombinedManifestQuantity resource.Quantity, fetchJsonnetDependency jsonnetbundler.Fetcher) ([]*unstructured.Unstructured, error) {
 	// Validate the directory before loading any manifests to save memory.
 	potentiallyValidManifests, err := getPotentiallyValidManifests(logCtx, appPath, repoRoot, directory.Recurse, directory.Include, directory.Exclude, maxCombinedManifestQuantity)
 	if err != nil {
@@ -1729,6 +1984,8 @@ func findManifests(logCtx *log.Entry, appPath string, repoRoot string, env *v1al
 	}
 
 	var objs []*unstructured.Unstructured
+	jsonnetVendorPath := ""
+	jsonnetVendored := false
 	for _, potentiallyValidManifest := range potentiallyValidManifests {
 		manifestPath := potentiallyValidManifest.path
 		manifestFileInfo := potentiallyValidManifest.fileInfo
@@ -1737,7 +1994,16 @@ func findManifests(logCtx *log.Entry, appPath string, repoRoot string, env *v1al
 			if !discovery.IsManifestGenerationEnabled(v1alpha1.ApplicationSourceTypeDirectory, enabledManifestGeneration) {
 				continue
 			}
-			vm, err := makeJsonnetVM(appPath, repoRoot, directory.Jsonnet, env)
+			if !jsonnetVendored && fetchJsonnetDependency != nil {
+				jsonnetVendored = true
+				vendorPath, closer, err := vendorJsonnetDependencies(appPath, repoRoot, fetchJsonnetDependency)
+				if err != nil {
+					return nil, err
+				}
+				defer utilio.Close(closer)
+				jsonnetVendorPath = vendorPath
+			}
+			vm, err := makeJsonnetVM(appPath, repoRoot, directory.Jsonnet, env, jsonnetVendorPath)
 			if err != nil {
 				return nil, err
 			}
@@ -1873,6 +2139,10 @@ func getPotentiallyValidManifestFile(path string, f os.FileInfo, appPath, repoRo
 	if !manifestFile.MatchString(f.Name()) {
 		return nil, "", nil
 	}
+	// the files of jsonnet-bundler are not manifests
+	if f.Name() == jsonnetbundler.JsonnetFile || f.Name() == jsonnetbundler.LockFile {
+		return nil, "", nil
+	}
 
 	// If the file is a symlink, these will be overridden with the destination file's info.
 	relRealPath := relPath
@@ -1984,7 +2254,27 @@ func getPotentiallyValidManifests(logCtx *log.Entry, appPath string, repoRoot st
 	return potentiallyValidManifests, nil
 }
 
-func makeJsonnetVM(appPath string, repoRoot string, sourceJsonnet v1alpha1.ApplicationSourceJsonnet, env *v1alpha1.Env) (*jsonnet.VM, error) {
+// vendorJsonnetDependencies makes the dependencies locked in the jsonnetfile.lock.json of the app path, or of the
+// nearest of its parents, importable from a vendor directory. It returns an empty path if there is no lock file.
+func vendorJsonnetDependencies(appPath string, repoRoot string, fetch jsonnetbundler.Fetcher) (string, utilio.Closer, error) {
+	lockFile, err := jsonnetbundler.FindLockFile(appPath, repoRoot)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to find %s: %w", jsonnetbundler.LockFile, err)
+	}
+	if lockFile == "" {
+		return "", utilio.NopCloser, nil
+	}
+	vendorPath, closer, err := jsonnetbundler.Vendor(lockFile, repoRoot, fetch)
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return "", nil, status.Error(s.Code(), s.Message())
+		}
+		return "", nil, status.Errorf(codes.FailedPrecondition, "Failed to install jsonnet dependencies: %v", err)
+	}
+	return vendorPath, closer, nil
+}
+
+func makeJsonnetVM(appPath string, repoRoot string, sourceJsonnet v1alpha1.ApplicationSourceJsonnet, env *v1alpha1.Env, vendorPath string) (*jsonnet.VM, error) {
 	vm := jsonnet.MakeVM()
 	for i, j := range sourceJsonnet.TLAs {
 		sourceJsonnet.TLAs[i].Value = env.Envsubst(j.Value)
@@ -2017,6 +2307,11 @@ func makeJsonnetVM(appPath string, repoRoot string, sourceJsonnet v1alpha1.Appli
 		}
 		jpaths = append(jpaths, string(jpath))
 	}
+	// the jsonnet-bundler dependencies are imported after the libs, which may contain a vendor directory of the
+	// repository
+	if vendorPath != "" {
+		jpaths = append(jpaths, vendorPath)
+	}
 
 	vm.Importer(&jsonnet.FileImporter{
 		JPaths: jpaths,
@@ -2169,9 +2464,18 @@ func (s *Service) GetAppDetails(ctx context.Context, q *apiclient.RepoServerAppD
 				return err
 			}
 		case v1alpha1.ApplicationSourceTypePlugin:
+			if opContext.sparseCheckout != nil {
+				if err := opContext.sparseCheckout([]string{"."}); err != nil {
+					return err
+				}
+			}
 			if err := populatePluginAppDetails(ctx, res, opContext.appPath, repoRoot, q, s.initConstants.CMPTarExcludedGlobs); err != nil {
 				return fmt.Errorf("failed to populate plugin app details: %w", err)
 			}
+		case v1alpha1.ApplicationSourceTypeCUE:
+			if err := populateCUEAppDetails(ctx, res, opContext.appPath, repoRoot, q, env); err != nil {
+				return fmt.Errorf("failed to populate CUE app details: %w", err)
+			}
 		}
 		_ = s.cache.SetAppDetails(revision, q.Source, q.RefSources, res, v1alpha1.TrackingMethod(q.TrackingMethod), nil)
 		return nil
@@ -2323,6 +2627,15 @@ func populateKustomizeAppDetails(res *apiclient.RepoAppDetailsResponse, q *apicl
 	return nil
 }
 
+func populateCUEAppDetails(ctx context.Context, res *apiclient.RepoAppDetailsResponse, appPath string, repoRoot string, q *apiclient.RepoServerAppDetailsQuery, env *v1alpha1.Env) error {
+	tags, err := cue.Tags(ctx, appPath, repoRoot, q.Source.CUE, env)
+	if err != nil {
+		return err
+	}
+	res.Cue = &apiclient.CUEAppSpec{Tags: tags}
+	return nil
+}
+
 func populatePluginAppDetails(ctx context.Context, res *apiclient.RepoAppDetailsResponse, appPath string, repoPath string, q *apiclient.RepoServerAppDetailsQuery, tarExcludedGlobs []string) error {
 	res.Plugin = &apiclient.PluginAppSpec{}
 
@@ -2537,10 +2850,145 @@ func (s *Service) newClient(repo *v1alpha1.Repository, opts ...git.ClientOpts) (
 	if err != nil {
 		return nil, err
 	}
-	opts = append(opts, git.WithEventHandlers(metrics.NewGitClientEventHandlers(s.metricsServer)))
+	opts = append(opts, git.WithEventHandlers(metrics.NewGitClientEventHandlers(s.metricsServer)), git.WithPartialClone(repo.EnablePartialClone))
 	return s.newGitClient(repo.Repo, repoPath, repo.GetGitCreds(s.gitCredsStore), repo.IsInsecure(), repo.EnableLFS, repo.Proxy, repo.NoProxy, opts...)
 }
 
+// newJsonnetDependencyFetcher returns a fetcher of the jsonnet-bundler dependencies of the app of the given request.
+// Each dependency is fetched once per remote and commit SHA, using the credentials of the matching repository of the
+// request, and its working tree is shared by all apps until it is unused for jsonnetDependencyMaxIdle.
+func (s *Service) newJsonnetDependencyFetcher(q *apiclient.ManifestRequest) jsonnetbundler.Fetcher {
+	return func(remote string, version string) (string, error) {
+		if !isSourcePermitted(remote, q.ProjectSourceRepos) {
+			return "", status.Errorf(codes.PermissionDenied, "jsonnet dependency %s is not permitted in project '%s'", remote, q.ProjectName)
+		}
+		dependencyPath, err := s.fetchJsonnetDependency(q, remote, version)
+		// the idle dependencies are removed once the lock of the fetched dependency is released, since removing them
+		// requires their locks
+		s.removeIdleJsonnetDependencies()
+		return dependencyPath, err
+	}
+}
+
+func (s *Service) fetchJsonnetDependency(q *apiclient.ManifestRequest, remote string, version string) (string, error) {
+	key := git.NormalizeGitURL(remote) + "@" + version
+	dependencyPath, err := s.jsonnetDependencyPaths.GetPath(key)
+	if err != nil {
+		return "", err
+	}
+	jsonnetDependencyLock.Lock(key)
+	defer jsonnetDependencyLock.Unlock(key)
+	s.jsonnetDependencyUsage.touch(key, s.now())
+
+	if _, err := os.Stat(dependencyPath); err == nil {
+		// the repository is removed once the working tree is checked out completely
+		if _, err := os.Stat(filepath.Join(dependencyPath, ".git")); os.IsNotExist(err) {
+			return dependencyPath, nil
+		}
+	}
+	if err := os.RemoveAll(dependencyPath); err != nil {
+		return "", err
+	}
+
+	repo := getJsonnetDependencyRepo(remote, q)
+	gitClient, err := s.newGitClient(remote, dependencyPath, repo.GetGitCreds(s.gitCredsStore), repo.IsInsecure(), false, repo.Proxy, repo.NoProxy, git.WithEventHandlers(metrics.NewGitClientEventHandlers(s.metricsServer)))
+	if err != nil {
+		return "", err
+	}
+	if err := checkoutRevision(gitClient, version, false); err != nil {
+		s.metricsServer.IncGitFetchFail(dependencyPath, version)
+		return "", err
+	}
+
+	if !s.initConstants.AllowOutOfBoundsSymlinks {
+		err := apppathutil.CheckOutOfBoundsSymlinks(dependencyPath)
+		if err != nil {
+			// the working tree is left incomplete, so that it is checked out again and checked by the next fetch
+			oobError := &apppathutil.OutOfBoundsSymlinkError{}
+			if errors.As(err, &oobError) {
+				log.WithFields(log.Fields{
+					common.SecurityField: common.SecurityHigh,
+					"repo":               remote,
+					"revision":           version,
+					"file":               oobError.File,
+				}).Warn("jsonnet dependency contains out-of-bounds symlink")
+				return "", fmt.Errorf("jsonnet dependency %s contains out-of-bounds symlinks. file: %s", remote, oobError.File)
+			}
+			return "", err
+		}
+	}
+
+	if err := os.RemoveAll(filepath.Join(dependencyPath, ".git")); err != nil {
+		return "", err
+	}
+	return dependencyPath, nil
+}
+
+// removeIdleJsonnetDependencies removes the working trees of the jsonnet-bundler dependencies which have not been
+// used for jsonnetDependencyMaxIdle, typically the dependencies locked by previous revisions of the apps.
+func (s *Service) removeIdleJsonnetDependencies() {
+	for _, key := range s.jsonnetDependencyUsage.idle(s.now().Add(-jsonnetDependencyMaxIdle)) {
+		jsonnetDependencyLock.Lock(key)
+		// the dependency might have been fetched again in the meantime
+		if !s.jsonnetDependencyUsage.used(key) {
+			if dependencyPath := s.jsonnetDependencyPaths.GetPathIfExists(key); dependencyPath != "" {
+				if err := os.RemoveAll(dependencyPath); err != nil {
+					log.Warnf("Failed to remove jsonnet dependency %s: %v", key, err)
+				}
+			}
+		}
+		jsonnetDependencyLock.Unlock(key)
+	}
+}
+
+// jsonnetDependencyUsage records when the jsonnet-bundler dependencies were last used
+type jsonnetDependencyUsage struct {
+	lock     gosync.Mutex
+	lastUsed map[string]time.Time
+}
+
+func (u *jsonnetDependencyUsage) touch(key string, now time.Time) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	u.lastUsed[key] = now
+}
+
+func (u *jsonnetDependencyUsage) used(key string) bool {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	_, ok := u.lastUsed[key]
+	return ok
+}
+
+// idle forgets the dependencies last used before the given time and returns their keys
+func (u *jsonnetDependencyUsage) idle(before time.Time) []string {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	var keys []string
+	for key, lastUsed := range u.lastUsed {
+		if lastUsed.Before(before) {
+			keys = append(keys, key)
+			delete(u.lastUsed, key)
+		}
+	}
+	return keys
+}
+
+// getJsonnetDependencyRepo returns the repository of the request with the given remote, or a repository without
+// credentials if there is none
+func getJsonnetDependencyRepo(remote string, q *apiclient.ManifestRequest) *v1alpha1.Repository {
+	normalized := git.NormalizeGitURL(remote)
+	if q.Repo != nil && git.NormalizeGitURL(q.Repo.Repo) == normalized {
+		return q.Repo
+	}
+	for _, repo := range q.GitRepos {
+		if git.NormalizeGitURL(repo.Repo) == normalized {
+			return repo
+		}
+	}
+	return &v1alpha1.Repository{Repo: remote}
+}
+
 // newClientResolveRevision is a helper to perform the common task of instantiating a git client
 // and resolving a revision to a commit SHA
 func (s *Service) newClientResolveRevision(repo *v1alpha1.Repository, revision string, opts ...git.ClientOpts) (git.Client, string, error) {
@@ -2556,6 +3004,32 @@ func (s *Service) newClientResolveRevision(repo *v1alpha1.Repository, revision s
 	return gitClient, commitSHA, nil
 }
 
+// verifyOCISignature verifies the cosign signatures of the OCI image with the given digest against the signature
+// verification of the project.
+func (s *Service) verifyOCISignature(ctx context.Context, ociClient oci.Client, repo *v1alpha1.Repository, digest string, verification *v1alpha1.OCISignatureVerification) (*cosign.Result, error) {
+	policy, err := verification.CosignPolicy()
+	if err != nil {
+		return nil, fmt.Errorf("invalid oci signature verification: %w", err)
+	}
+	var trustRoot *cosign.TrustRoot
+	if s.initConstants.CosignTrustRootPath != "" {
+		trustRoot, err = cosign.LoadTrustRoot(s.initConstants.CosignTrustRootPath)
+		if err != nil {
+			return nil, fmt.Errorf("error loading cosign trust root: %w", err)
+		}
+	}
+	result, err := ociClient.VerifySignature(ctx, digest, cosign.NewVerifier(policy, trustRoot))
+	if err != nil {
+		log.WithFields(log.Fields{
+			common.SecurityField: common.SecurityHigh,
+			"repo":               repo.Repo,
+			"digest":             digest,
+		}).Warnf("oci image signature verification failed: %v", err)
+		return nil, fmt.Errorf("signature verification of oci image with digest %s failed: %w", digest, err)
+	}
+	return result, nil
+}
+
 func (s *Service) newOCIClientResolveRevision(ctx context.Context, repo *v1alpha1.Repository, revision string, noRevisionCache bool) (oci.Client, string, error) {
 	ociClient, err := s.newOCIClient(repo.Repo, repo.GetOCICreds(), repo.Proxy, repo.NoProxy, s.initConstants.OCIMediaTypes, oci.WithIndexCache(s.cache), oci.WithImagePaths(s.ociPaths), oci.WithManifestMaxExtractedSize(s.initConstants.OCIManifestMaxExtractedSize), oci.WithDisableManifestMaxExtractedSize(s.initConstants.DisableOCIManifestMaxExtractedSize))
 	if err != nil {
@@ -2570,6 +3044,20 @@ func (s *Service) newOCIClientResolveRevision(ctx context.Context, repo *v1alpha
 	return ociClient, digest, nil
 }
 
+func (s *Service) newArchiveClientResolveRevision(ctx context.Context, repo *v1alpha1.Repository, revision string) (archive.Client, string, error) {
+	archiveClient, err := s.newArchiveClient(repo.Repo, repo.GetArchiveCreds(), repo.Proxy, repo.NoProxy, archive.WithArchivePaths(s.archivePaths), archive.WithManifestMaxExtractedSize(s.initConstants.ArchiveManifestMaxExtractedSize), archive.WithDisableManifestMaxExtractedSize(s.initConstants.DisableArchiveManifestMaxExtractedSize), archive.WithSignatureVerifier(gpg.VerifyDetachedSignature))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to initialize archive client: %w", err)
+	}
+
+	resolved, err := archiveClient.ResolveRevision(ctx, revision)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to resolve revision %q: %w", revision, err)
+	}
+
+	return archiveClient, resolved, nil
+}
+
 func (s *Service) newHelmClientResolveRevision(repo *v1alpha1.Repository, revision string, chart string, noRevisionCache bool) (helm.Client, string, error) {
 	enableOCI := repo.EnableOCI || helm.IsHelmOciRepo(repo.Repo)
 	helmClient := s.newHelmClient(repo.Repo, repo.GetHelmCreds(), enableOCI, repo.Proxy, repo.NoProxy, helm.WithIndexCache(s.cache), helm.WithChartPaths(s.chartPaths))
@@ -2757,6 +3245,14 @@ func (s *Service) TestRepository(ctx context.Context, q *apiclient.TestRepositor
 			_, err = client.TestRepo(ctx)
 			return err
 		},
+		"archive": func() error {
+			client, err := archive.NewClient(repo.Repo, repo.GetArchiveCreds(), repo.Proxy, repo.NoProxy)
+			if err != nil {
+				return err
+			}
+			_, err = client.TestRepo(ctx)
+			return err
+		},
 		"helm": func() error {
 			if repo.EnableOCI {
 				if !helm.IsHelmOciRepo(repo.Repo) {
@@ -2806,6 +3302,17 @@ func (s *Service) ResolveRevision(ctx context.Context, q *apiclient.ResolveRevis
 			AmbiguousRevision: fmt.Sprintf("%v (%v)", ambiguousRevision, revision),
 		}, nil
 	}
+
+	if source.IsArchive() {
+		_, revision, err := s.newArchiveClientResolveRevision(ctx, repo, ambiguousRevision)
+		if err != nil {
+			return &apiclient.ResolveRevisionResponse{Revision: "", AmbiguousRevision: ""}, err
+		}
+		return &apiclient.ResolveRevisionResponse{
+			Revision:          revision,
+			AmbiguousRevision: revision,
+		}, nil
+	}
 	gitClient, err := git.NewClient(repo.Repo, repo.GetGitCreds(s.gitCredsStore), repo.IsInsecure(), repo.IsLFSEnabled(), repo.Proxy, repo.NoProxy)
 	if err != nil {
 		return &apiclient.ResolveRevisionResponse{Revision: "", AmbiguousRevision: ""}, err
@@ -2864,6 +3371,12 @@ func (s *Service) GetGitFiles(_ context.Context, request *apiclient.GitFilesRequ
 	}
 	defer utilio.Close(closer)
 
+	if repo.EnablePartialClone {
+		if err := gitClient.SparseCheckout([]string{globBase(gitPath)}); err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to checkout git repo %s with revision %s pattern %s: %v", repo.Repo, revision, gitPath, err)
+		}
+	}
+
 	gitFiles, err := gitClient.LsFiles(gitPath, enableNewGitFileGlobbing)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "unable to list files. repo %s with revision %s pattern %s: %v", repo.Repo, revision, gitPath, err)
@@ -2946,6 +3459,12 @@ func (s *Service) GetGitDirectories(_ context.Context, request *apiclient.GitDir
 	}
 	defer utilio.Close(closer)
 
+	if repo.EnablePartialClone {
+		if err := gitClient.SparseCheckout([]string{"."}); err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to checkout git repo %s with revision %s: %v", repo.Repo, revision, err)
+		}
+	}
+
 	repoRoot := gitClient.Root()
 	var paths []string
 	if err := filepath.WalkDir(repoRoot, func(path string, entry fs.DirEntry, fnErr error) error {
@@ -3091,7 +3610,7 @@ func (s *Service) updateCachedRevision(logCtx *log.Entry, oldRev string, newRev
 		}
 	}
 
-	err := s.cache.SetNewRevisionManifests(newRev, oldRev, request.ApplicationSource, request.RefSources, request, request.Namespace, request.TrackingMethod, request.AppLabelKey, request.AppName, repoRefs, request.InstallationID)
+	err := s.cache.SetNewRevisionManifests(newRev, oldRev, request.ApplicationSource, request.RefSources, request, request.Namespace, request.TrackingMethod, request.AppLabelKey, request.AppName, repoRefs, request.InstallationID, request.HelmValuesFromHash)
 	if err != nil {
 		if errors.Is(err, cache.ErrCacheMiss) {
 			logCtx.Debugf("manifest cache miss during comparison for application %s in repo %s from revision %s", request.AppName, request.GetRepo().Repo, oldRev)
@@ -32,6 +32,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -44,7 +46,10 @@ import (
 	repositorymocks "github.com/argoproj/argo-cd/v3/reposerver/cache/mocks"
 	"github.com/argoproj/argo-cd/v3/reposerver/metrics"
 	fileutil "github.com/argoproj/argo-cd/v3/test/fixture/path"
+	"github.com/argoproj/argo-cd/v3/util/archive"
+	archivemocks "github.com/argoproj/argo-cd/v3/util/archive/mocks"
 	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/cosign"
 	"github.com/argoproj/argo-cd/v3/util/git"
 	gitmocks "github.com/argoproj/argo-cd/v3/util/git/mocks"
 	"github.com/argoproj/argo-cd/v3/util/helm"
@@ -363,7 +368,7 @@ func TestGenerateManifests_K8SAPIResetCache(t *testing.T) {
 
 	cachedFakeResponse := &apiclient.ManifestResponse{Manifests: []string{"Fake"}, Revision: mock.Anything}
 
-	err := service.cache.SetManifests(mock.Anything, &src, q.RefSources, &q, "", "", "", "", &cache.CachedManifestResponse{ManifestResponse: cachedFakeResponse}, nil, "")
+	err := service.cache.SetManifests(mock.Anything, &src, q.RefSources, &q, "", "", "", "", &cache.CachedManifestResponse{ManifestResponse: cachedFakeResponse}, nil, "", "")
 	require.NoError(t, err)
 
 	res, err := service.GenerateManifest(t.Context(), &q)
@@ -388,7 +393,7 @@ func TestGenerateManifests_EmptyCache(t *testing.T) {
 		ProjectSourceRepos: []string{"*"},
 	}
 
-	err := service.cache.SetManifests(mock.Anything, &src, q.RefSources, &q, "", "", "", "", &cache.CachedManifestResponse{ManifestResponse: nil}, nil, "")
+	err := service.cache.SetManifests(mock.Anything, &src, q.RefSources, &q, "", "", "", "", &cache.CachedManifestResponse{ManifestResponse: nil}, nil, "", "")
 	require.NoError(t, err)
 
 	res, err := service.GenerateManifest(t.Context(), &q)
@@ -780,6 +785,34 @@ func TestGenerateJsonnetManifestInDir(t *testing.T) {
 	assert.Len(t, res1.Manifests, 2)
 }
 
+func TestGenerateCUEManifest(t *testing.T) {
+	service := newService(t, "../../util/cue/testdata/guestbook")
+
+	q := apiclient.ManifestRequest{
+		AppName: "guestbook",
+		Repo:    &v1alpha1.Repository{},
+		ApplicationSource: &v1alpha1.ApplicationSource{
+			Path: ".",
+			CUE: &v1alpha1.ApplicationSourceCUE{
+				Expression: "objects",
+				Tags:       []v1alpha1.CUETag{{Name: "env", Value: "$ARGOCD_APP_NAME"}, {Name: "region", Value: "eu"}},
+			},
+		},
+		ProjectName:        "something",
+		ProjectSourceRepos: []string{"*"},
+	}
+	res, err := service.GenerateManifest(t.Context(), &q)
+	require.NoError(t, err)
+	assert.Equal(t, "CUE", res.SourceType)
+	require.Len(t, res.Manifests, 2)
+	assert.Contains(t, res.Manifests[0], `"name":"guestbook-guestbook"`)
+
+	// the source type is detected from cue.mod, but the package root is not a list of objects
+	q.ApplicationSource.CUE = nil
+	_, err = service.GenerateManifest(t.Context(), &q)
+	require.ErrorContains(t, err, "env is a string, not a Kubernetes object")
+}
+
 func TestGenerateJsonnetManifestInRootDir(t *testing.T) {
 	service := newService(t, "testdata/jsonnet-1")
 
@@ -830,7 +863,7 @@ func TestManifestGenErrorCacheByNumRequests(t *testing.T) {
 		assert.NotNil(t, manifestRequest)
 
 		cachedManifestResponse := &cache.CachedManifestResponse{}
-		err := service.cache.GetManifests(mock.Anything, manifestRequest.ApplicationSource, manifestRequest.RefSources, manifestRequest, manifestRequest.Namespace, "", manifestRequest.AppLabelKey, manifestRequest.AppName, cachedManifestResponse, nil, "")
+		err := service.cache.GetManifests(mock.Anything, manifestRequest.ApplicationSource, manifestRequest.RefSources, manifestRequest, manifestRequest.Namespace, "", manifestRequest.AppLabelKey, manifestRequest.AppName, cachedManifestResponse, nil, "", "")
 		require.NoError(t, err)
 		return cachedManifestResponse
 	}
@@ -1234,6 +1267,49 @@ func TestGenerateHelmWithEnvVars(t *testing.T) {
 
 // The requested value file (`../minio/values.yaml`) is outside the app path (`./util/helm/testdata/redis`), however
 // since the requested value is still under the repo directory (`~/go/src/github.com/argoproj/argo-cd`), it is allowed
+func TestGenerateHelmWithValuesFrom(t *testing.T) {
+	service := newService(t, "../../util/helm/testdata/redis")
+
+	generateSlaveReplicas := func(t *testing.T, helm *v1alpha1.ApplicationSourceHelm, valuesFrom []string) int32 {
+		t.Helper()
+		res, err := service.GenerateManifest(t.Context(), &apiclient.ManifestRequest{
+			Repo:    &v1alpha1.Repository{},
+			AppName: "test",
+			ApplicationSource: &v1alpha1.ApplicationSource{
+				Path: ".",
+				Helm: helm,
+			},
+			HelmValuesFrom:     valuesFrom,
+			ProjectName:        "something",
+			ProjectSourceRepos: []string{"*"},
+		})
+		require.NoError(t, err)
+		for _, src := range res.Manifests {
+			obj := unstructured.Unstructured{}
+			require.NoError(t, json.Unmarshal([]byte(src), &obj))
+			if obj.GetKind() == "Deployment" && obj.GetName() == "test-redis-slave" {
+				var dep appsv1.Deployment
+				require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &dep))
+				return *dep.Spec.Replicas
+			}
+		}
+		require.FailNow(t, "slave deployment not found")
+		return 0
+	}
+
+	valuesFrom := []string{"cluster: {slaveCount: 3}", "cluster: {slaveCount: 4}"}
+	// values from later references override values from earlier references and value files
+	assert.Equal(t, int32(4), generateSlaveReplicas(t, &v1alpha1.ApplicationSourceHelm{
+		ValueFiles: []string{"values-production.yaml"},
+		ValuesFrom: []v1alpha1.HelmValuesFromSource{{Kind: v1alpha1.HelmValuesFromKindConfigMap, Name: "a"}, {Kind: v1alpha1.HelmValuesFromKindSecret, Name: "b"}},
+	}, valuesFrom))
+	// inline values override values from references
+	assert.Equal(t, int32(2), generateSlaveReplicas(t, &v1alpha1.ApplicationSourceHelm{
+		ValuesObject: &runtime.RawExtension{Raw: []byte(`cluster: {slaveCount: 2}`)},
+		ValuesFrom:   []v1alpha1.HelmValuesFromSource{{Kind: v1alpha1.HelmValuesFromKindConfigMap, Name: "a"}, {Kind: v1alpha1.HelmValuesFromKindSecret, Name: "b"}},
+	}, valuesFrom))
+}
+
 func TestGenerateHelmWithValuesDirectoryTraversal(t *testing.T) {
 	service := newService(t, "../../util/helm/testdata")
 	_, err := service.GenerateManifest(t.Context(), &apiclient.ManifestRequest{
@@ -1706,6 +1782,25 @@ func TestGetAppDetailsKustomize(t *testing.T) {
 	assert.Equal(t, []string{"nginx:1.15.4", "registry.k8s.io/nginx-slim:0.8"}, res.Kustomize.Images)
 }
 
+func TestGetAppDetailsCUE(t *testing.T) {
+	service := newService(t, "../../util/cue/testdata/guestbook")
+
+	res, err := service.GetAppDetails(t.Context(), &apiclient.RepoServerAppDetailsQuery{
+		Repo: &v1alpha1.Repository{},
+		Source: &v1alpha1.ApplicationSource{
+			Path: ".",
+			CUE: &v1alpha1.ApplicationSourceCUE{
+				Tags: []v1alpha1.CUETag{{Name: "replicas", Value: "2"}},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "CUE", res.Type)
+	require.NotNil(t, res.Cue)
+	assert.Equal(t, []*v1alpha1.CUETag{{Name: "env", Value: "dev"}, {Name: "replicas", Value: "2"}, {Name: "region"}}, res.Cue.Tags)
+}
+
 func TestGetAppDetailsKustomize_CustomVersion(t *testing.T) {
 	service := newService(t, "../../util/kustomize/testdata/kustomize-with-version-override")
 
@@ -2216,7 +2311,7 @@ func TestGenerateManifestsWithAppParameterFile(t *testing.T) {
 			// Try to pull from the cache with a `source` that does not include any overrides. Overrides should not be
 			// part of the cache key, because you can't get the overrides without a repo operation. And avoiding repo
 			// operations is the point of the cache.
-			err = service.cache.GetManifests(mock.Anything, source, v1alpha1.RefTargetRevisionMapping{}, &v1alpha1.ClusterInfo{}, "", "", "", "test", res, nil, "")
+			err = service.cache.GetManifests(mock.Anything, source, v1alpha1.RefTargetRevisionMapping{}, &v1alpha1.ClusterInfo{}, "", "", "", "test", res, nil, "", "")
 			require.NoError(t, err)
 		})
 	})
@@ -2433,7 +2528,7 @@ func TestFindResources(t *testing.T) {
 				Recurse: true,
 				Include: tc.include,
 				Exclude: tc.exclude,
-			}, map[string]bool{}, resource.MustParse("0"))
+			}, map[string]bool{}, resource.MustParse("0"), nil)
 			require.NoError(t, err)
 			var names []string
 			for i := range objs {
@@ -2444,11 +2539,143 @@ func TestFindResources(t *testing.T) {
 	}
 }
 
+func TestGenerateManifests_JsonnetBundler(t *testing.T) {
+	dependencyRepoPath := t.TempDir()
+	runGit(t, dependencyRepoPath, "init", "-b", "main")
+	require.NoError(t, os.MkdirAll(filepath.Join(dependencyRepoPath, "lib"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dependencyRepoPath, "lib", "config.libsonnet"), []byte(`{ name: 'jsonnet-bundler' }`), 0o644))
+	runGit(t, dependencyRepoPath, "add", "-A")
+	runGit(t, dependencyRepoPath, "-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-m", "Initial commit")
+	revision := strings.TrimSpace(runGit(t, dependencyRepoPath, "rev-parse", "HEAD"))
+
+	appPath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(appPath, "jsonnetfile.lock.json"), []byte(`{
+  "version": 1,
+  "dependencies": [
+    {
+      "source": {"git": {"remote": "https://example.com/org/libs.git", "subdir": "lib"}},
+      "version": "`+revision+`",
+      "sum": "ignored"
+    }
+  ],
+  "legacyImports": true
+}`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(appPath, "main.jsonnet"), []byte(`
+local config = import 'example.com/org/libs/lib/config.libsonnet';
+local legacy = import 'lib/config.libsonnet';
+{ apiVersion: 'v1', kind: 'ConfigMap', metadata: { name: config.name }, data: { legacy: legacy.name } }
+`), 0o644))
+
+	service := newService(t, appPath)
+	service.jsonnetDependencyPaths = utilio.NewRandomizedTempPaths(t.TempDir())
+	clients := 0
+	service.newGitClient = func(rawRepoURL string, root string, creds git.Creds, insecure bool, enableLfs bool, proxy string, noProxy string, opts ...git.ClientOpts) (git.Client, error) {
+		clients++
+		assert.Equal(t, "https://example.com/org/libs.git", rawRepoURL)
+		return git.NewClientExt("file://"+dependencyRepoPath, root, creds, insecure, enableLfs, proxy, noProxy, opts...)
+	}
+
+	q := &apiclient.ManifestRequest{
+		Repo:               &v1alpha1.Repository{},
+		ApplicationSource:  &v1alpha1.ApplicationSource{},
+		ProjectName:        "something",
+		ProjectSourceRepos: []string{"*"},
+	}
+	for range 2 {
+		res, err := GenerateManifests(t.Context(), appPath, appPath, "", q, false, &git.NoopCredsStore{}, resource.MustParse("0"), nil, WithJsonnetDependencyFetcher(service.newJsonnetDependencyFetcher(q)))
+		require.NoError(t, err)
+		require.Len(t, res.Manifests, 1)
+		assert.JSONEq(t, `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"jsonnet-bundler"},"data":{"legacy":"jsonnet-bundler"}}`, res.Manifests[0])
+	}
+	// the dependency is fetched once and shared afterwards
+	assert.Equal(t, 1, clients)
+
+	t.Run("NotPermitted", func(t *testing.T) {
+		q := &apiclient.ManifestRequest{
+			Repo:               &v1alpha1.Repository{},
+			ApplicationSource:  &v1alpha1.ApplicationSource{},
+			ProjectName:        "something",
+			ProjectSourceRepos: []string{"https://github.com/argoproj/*"},
+		}
+		_, err := GenerateManifests(t.Context(), appPath, appPath, "", q, false, &git.NoopCredsStore{}, resource.MustParse("0"), nil, WithJsonnetDependencyFetcher(service.newJsonnetDependencyFetcher(q)))
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Contains(t, err.Error(), "jsonnet dependency https://example.com/org/libs.git is not permitted in project 'something'")
+	})
+
+	t.Run("WithoutFetcher", func(t *testing.T) {
+		_, err := GenerateManifests(t.Context(), appPath, appPath, "", q, false, &git.NoopCredsStore{}, resource.MustParse("0"), nil)
+		require.ErrorContains(t, err, "couldn't open import")
+	})
+
+	t.Run("IdleDependencyRemoved", func(t *testing.T) {
+		key := git.NormalizeGitURL("https://example.com/org/libs.git") + "@" + revision
+		dependencyPath := service.jsonnetDependencyPaths.GetPathIfExists(key)
+		require.DirExists(t, dependencyPath)
+
+		now := time.Now()
+		service.now = func() time.Time { return now.Add(jsonnetDependencyMaxIdle / 2) }
+		service.removeIdleJsonnetDependencies()
+		require.DirExists(t, dependencyPath)
+
+		service.now = func() time.Time { return now.Add(2 * jsonnetDependencyMaxIdle) }
+		service.removeIdleJsonnetDependencies()
+		assert.NoDirExists(t, dependencyPath)
+
+		// the dependency is fetched again
+		_, err := GenerateManifests(t.Context(), appPath, appPath, "", q, false, &git.NoopCredsStore{}, resource.MustParse("0"), nil, WithJsonnetDependencyFetcher(service.newJsonnetDependencyFetcher(q)))
+		require.NoError(t, err)
+		assert.Equal(t, 2, clients)
+	})
+}
+
+func TestGenerateManifests_JsonnetBundlerOutOfBoundsSymlink(t *testing.T) {
+	dependencyRepoPath := t.TempDir()
+	runGit(t, dependencyRepoPath, "init", "-b", "main")
+	require.NoError(t, os.Symlink("/etc/passwd", filepath.Join(dependencyRepoPath, "config.libsonnet")))
+	runGit(t, dependencyRepoPath, "add", "-A")
+	runGit(t, dependencyRepoPath, "-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-m", "Initial commit")
+	revision := strings.TrimSpace(runGit(t, dependencyRepoPath, "rev-parse", "HEAD"))
+
+	appPath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(appPath, "jsonnetfile.lock.json"), []byte(`{
+  "version": 1,
+  "dependencies": [
+    {"source": {"git": {"remote": "https://example.com/org/libs.git"}}, "version": "`+revision+`"}
+  ]
+}`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(appPath, "main.jsonnet"), []byte(`import 'example.com/org/libs/config.libsonnet'`), 0o644))
+
+	service := newService(t, appPath)
+	service.jsonnetDependencyPaths = utilio.NewRandomizedTempPaths(t.TempDir())
+	service.newGitClient = func(_ string, root string, creds git.Creds, insecure bool, enableLfs bool, proxy string, noProxy string, opts ...git.ClientOpts) (git.Client, error) {
+		return git.NewClientExt("file://"+dependencyRepoPath, root, creds, insecure, enableLfs, proxy, noProxy, opts...)
+	}
+
+	q := &apiclient.ManifestRequest{
+		Repo:               &v1alpha1.Repository{},
+		ApplicationSource:  &v1alpha1.ApplicationSource{},
+		ProjectSourceRepos: []string{"*"},
+	}
+	_, err := GenerateManifests(t.Context(), appPath, appPath, "", q, false, &git.NoopCredsStore{}, resource.MustParse("0"), nil, WithJsonnetDependencyFetcher(service.newJsonnetDependencyFetcher(q)))
+	require.ErrorContains(t, err, "jsonnet dependency https://example.com/org/libs.git contains out-of-bounds symlinks. file: config.libsonnet")
+}
+
+func TestGetJsonnetDependencyRepo(t *testing.T) {
+	q := &apiclient.ManifestRequest{
+		Repo:     &v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps", Username: "source"},
+		GitRepos: []*v1alpha1.Repository{{Repo: "https://github.com/grafana/jsonnet-libs", Username: "libs"}},
+	}
+	assert.Equal(t, "source", getJsonnetDependencyRepo("https://github.com/argoproj/argocd-example-apps.git", q).Username)
+	assert.Equal(t, "libs", getJsonnetDependencyRepo("https://github.com/grafana/jsonnet-libs.git", q).Username)
+	assert.Equal(t, &v1alpha1.Repository{Repo: "https://github.com/other/libs.git"}, getJsonnetDependencyRepo("https://github.com/other/libs.git", q))
+}
+
 func TestFindManifests_Exclude(t *testing.T) {
 	objs, err := findManifests(&log.Entry{}, "testdata/app-include-exclude", ".", nil, v1alpha1.ApplicationSourceDirectory{
 		Recurse: true,
 		Exclude: "subdir/deploymentSub.yaml",
-	}, map[string]bool{}, resource.MustParse("0"))
+	}, map[string]bool{}, resource.MustParse("0"), nil)
 
 	require.NoError(t, err)
 	require.Len(t, objs, 1)
@@ -2460,7 +2687,7 @@ func TestFindManifests_Exclude_NothingMatches(t *testing.T) {
 	objs, err := findManifests(&log.Entry{}, "testdata/app-include-exclude", ".", nil, v1alpha1.ApplicationSourceDirectory{
 		Recurse: true,
 		Exclude: "nothing.yaml",
-	}, map[string]bool{}, resource.MustParse("0"))
+	}, map[string]bool{}, resource.MustParse("0"), nil)
 
 	require.NoError(t, err)
 	require.Len(t, objs, 2)
@@ -2800,7 +3027,7 @@ func Test_findManifests(t *testing.T) {
 		err = os.Chmod(appDir, 0o000)
 		require.NoError(t, err)
 
-		manifests, err := findManifests(logCtx, appDir, appDir, nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, appDir, appDir, nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Empty(t, manifests)
 		require.Error(t, err)
 
@@ -2812,20 +3039,20 @@ func Test_findManifests(t *testing.T) {
 	})
 
 	t.Run("no recursion when recursion is disabled", func(t *testing.T) {
-		manifests, err := findManifests(logCtx, "./testdata/recurse", "./testdata/recurse", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/recurse", "./testdata/recurse", nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Len(t, manifests, 2)
 		require.NoError(t, err)
 	})
 
 	t.Run("recursion when recursion is enabled", func(t *testing.T) {
 		recurse := v1alpha1.ApplicationSourceDirectory{Recurse: true}
-		manifests, err := findManifests(logCtx, "./testdata/recurse", "./testdata/recurse", nil, recurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/recurse", "./testdata/recurse", nil, recurse, nil, resource.MustParse("0"), nil)
 		assert.Len(t, manifests, 4)
 		require.NoError(t, err)
 	})
 
 	t.Run("non-JSON/YAML is skipped", func(t *testing.T) {
-		manifests, err := findManifests(logCtx, "./testdata/non-manifest-file", "./testdata/non-manifest-file", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/non-manifest-file", "./testdata/non-manifest-file", nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Empty(t, manifests)
 		require.NoError(t, err)
 	})
@@ -2840,14 +3067,14 @@ func Test_findManifests(t *testing.T) {
 		t.Chdir(testDir)
 		require.NoError(t, fileutil.CreateSymlink(t, "a.json", "b.json"))
 		require.NoError(t, fileutil.CreateSymlink(t, "b.json", "a.json"))
-		manifests, err := findManifests(logCtx, "./testdata/circular-link", "./testdata/circular-link", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/circular-link", "./testdata/circular-link", nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Empty(t, manifests)
 		require.Error(t, err)
 	})
 
 	t.Run("out-of-bounds symlink should throw an error", func(t *testing.T) {
 		require.DirExists(t, "./testdata/out-of-bounds-link")
-		manifests, err := findManifests(logCtx, "./testdata/out-of-bounds-link", "./testdata/out-of-bounds-link", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/out-of-bounds-link", "./testdata/out-of-bounds-link", nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Empty(t, manifests)
 		require.Error(t, err)
 	})
@@ -2857,13 +3084,13 @@ func Test_findManifests(t *testing.T) {
 		require.NoError(t, err)
 		appPath, err := filepath.Abs("./testdata/in-bounds-link/app")
 		require.NoError(t, err)
-		manifests, err := findManifests(logCtx, appPath, repoRoot, nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, appPath, repoRoot, nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Len(t, manifests, 1)
 		require.NoError(t, err)
 	})
 
 	t.Run("symlink to nowhere should be ignored", func(t *testing.T) {
-		manifests, err := findManifests(logCtx, "./testdata/link-to-nowhere", "./testdata/link-to-nowhere", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/link-to-nowhere", "./testdata/link-to-nowhere", nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Empty(t, manifests)
 		require.NoError(t, err)
 	})
@@ -2874,82 +3101,82 @@ func Test_findManifests(t *testing.T) {
 		appPath, err := filepath.Abs("./testdata/in-bounds-link/app")
 		require.NoError(t, err)
 		// The file is 35 bytes.
-		manifests, err := findManifests(logCtx, appPath, repoRoot, nil, noRecurse, nil, resource.MustParse("34"))
+		manifests, err := findManifests(logCtx, appPath, repoRoot, nil, noRecurse, nil, resource.MustParse("34"), nil)
 		assert.Empty(t, manifests)
 		assert.ErrorIs(t, err, ErrExceededMaxCombinedManifestFileSize)
 	})
 
 	t.Run("group of files should be limited at precisely the sum of their size", func(t *testing.T) {
 		// There is a total of 10 files, each file being 10 bytes.
-		manifests, err := findManifests(logCtx, "./testdata/several-files", "./testdata/several-files", nil, noRecurse, nil, resource.MustParse("365"))
+		manifests, err := findManifests(logCtx, "./testdata/several-files", "./testdata/several-files", nil, noRecurse, nil, resource.MustParse("365"), nil)
 		assert.Len(t, manifests, 10)
 		require.NoError(t, err)
 
-		manifests, err = findManifests(logCtx, "./testdata/several-files", "./testdata/several-files", nil, noRecurse, nil, resource.MustParse("364"))
+		manifests, err = findManifests(logCtx, "./testdata/several-files", "./testdata/several-files", nil, noRecurse, nil, resource.MustParse("364"), nil)
 		assert.Empty(t, manifests)
 		assert.ErrorIs(t, err, ErrExceededMaxCombinedManifestFileSize)
 	})
 
 	t.Run("jsonnet isn't counted against size limit", func(t *testing.T) {
 		// Each file is 36 bytes. Only the 36-byte json file should be counted against the limit.
-		manifests, err := findManifests(logCtx, "./testdata/jsonnet-and-json", "./testdata/jsonnet-and-json", nil, noRecurse, nil, resource.MustParse("36"))
+		manifests, err := findManifests(logCtx, "./testdata/jsonnet-and-json", "./testdata/jsonnet-and-json", nil, noRecurse, nil, resource.MustParse("36"), nil)
 		assert.Len(t, manifests, 2)
 		require.NoError(t, err)
 
-		manifests, err = findManifests(logCtx, "./testdata/jsonnet-and-json", "./testdata/jsonnet-and-json", nil, noRecurse, nil, resource.MustParse("35"))
+		manifests, err = findManifests(logCtx, "./testdata/jsonnet-and-json", "./testdata/jsonnet-and-json", nil, noRecurse, nil, resource.MustParse("35"), nil)
 		assert.Empty(t, manifests)
 		assert.ErrorIs(t, err, ErrExceededMaxCombinedManifestFileSize)
 	})
 
 	t.Run("partially valid YAML file throws an error", func(t *testing.T) {
 		require.DirExists(t, "./testdata/partially-valid-yaml")
-		manifests, err := findManifests(logCtx, "./testdata/partially-valid-yaml", "./testdata/partially-valid-yaml", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/partially-valid-yaml", "./testdata/partially-valid-yaml", nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Empty(t, manifests)
 		require.Error(t, err)
 	})
 
 	t.Run("invalid manifest throws an error", func(t *testing.T) {
 		require.DirExists(t, "./testdata/invalid-manifests")
-		manifests, err := findManifests(logCtx, "./testdata/invalid-manifests", "./testdata/invalid-manifests", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/invalid-manifests", "./testdata/invalid-manifests", nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Empty(t, manifests)
 		require.Error(t, err)
 	})
 
 	t.Run("invalid manifest containing '+argocd:skip-file-rendering' doesn't throw an error", func(t *testing.T) {
 		require.DirExists(t, "./testdata/invalid-manifests-skipped")
-		manifests, err := findManifests(logCtx, "./testdata/invalid-manifests-skipped", "./testdata/invalid-manifests-skipped", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/invalid-manifests-skipped", "./testdata/invalid-manifests-skipped", nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Empty(t, manifests)
 		require.NoError(t, err)
 	})
 
 	t.Run("irrelevant YAML gets skipped, relevant YAML gets parsed", func(t *testing.T) {
-		manifests, err := findManifests(logCtx, "./testdata/irrelevant-yaml", "./testdata/irrelevant-yaml", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/irrelevant-yaml", "./testdata/irrelevant-yaml", nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Len(t, manifests, 1)
 		require.NoError(t, err)
 	})
 
 	t.Run("multiple JSON objects in one file throws an error", func(t *testing.T) {
 		require.DirExists(t, "./testdata/json-list")
-		manifests, err := findManifests(logCtx, "./testdata/json-list", "./testdata/json-list", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/json-list", "./testdata/json-list", nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Empty(t, manifests)
 		require.Error(t, err)
 	})
 
 	t.Run("invalid JSON throws an error", func(t *testing.T) {
 		require.DirExists(t, "./testdata/invalid-json")
-		manifests, err := findManifests(logCtx, "./testdata/invalid-json", "./testdata/invalid-json", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/invalid-json", "./testdata/invalid-json", nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Empty(t, manifests)
 		require.Error(t, err)
 	})
 
 	t.Run("valid JSON returns manifest and no error", func(t *testing.T) {
-		manifests, err := findManifests(logCtx, "./testdata/valid-json", "./testdata/valid-json", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/valid-json", "./testdata/valid-json", nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Len(t, manifests, 1)
 		require.NoError(t, err)
 	})
 
 	t.Run("YAML with an empty document doesn't throw an error", func(t *testing.T) {
-		manifests, err := findManifests(logCtx, "./testdata/yaml-with-empty-document", "./testdata/yaml-with-empty-document", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/yaml-with-empty-document", "./testdata/yaml-with-empty-document", nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Len(t, manifests, 1)
 		require.NoError(t, err)
 	})
@@ -2978,6 +3205,88 @@ func Test_getHelmDependencyRepos(t *testing.T) {
 	assert.Equal(t, repos[1].Repo, repo2)
 }
 
+// dependencyBuildHelm fakes `helm dependency build` by writing the archives of the locked dependencies
+type dependencyBuildHelm struct {
+	helm.Helm
+	appPath string
+	builds  int
+}
+
+func (h *dependencyBuildHelm) DependencyBuild() error {
+	h.builds++
+	chartsPath := filepath.Join(h.appPath, "charts")
+	if err := os.MkdirAll(chartsPath, 0o755); err != nil {
+		return err
+	}
+	for _, archive := range []string{"mongodb-7.8.10.tgz", "eventstore-0.2.5.tgz"} {
+		if err := os.WriteFile(filepath.Join(chartsPath, archive), []byte(archive), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func Test_runHelmBuild_DependencyCache(t *testing.T) {
+	newAppPath := func(t *testing.T) string {
+		t.Helper()
+		appPath := t.TempDir()
+		for _, name := range []string{"Chart.yaml", "Chart.lock"} {
+			content, err := os.ReadFile(filepath.Join("../../util/helm/testdata/dependency", name))
+			require.NoError(t, err)
+			require.NoError(t, os.WriteFile(filepath.Join(appPath, name), content, 0o644))
+		}
+		return appPath
+	}
+	metricsServer := metrics.NewMetricsServer()
+	dependencyCache := helm.NewDependencyCache(utilio.NewRandomizedTempPaths(t.TempDir()), 1024, metrics.NewHelmDependencyCacheEventHandlers(metricsServer))
+	repo := &v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps.git"}
+
+	first := &dependencyBuildHelm{appPath: newAppPath(t)}
+	require.NoError(t, runHelmBuild(first.appPath, first, dependencyCache, repo, "default"))
+	assert.Equal(t, 1, first.builds)
+
+	// the dependencies of the same chart lock of the same repository are restored from the cache
+	second := &dependencyBuildHelm{appPath: newAppPath(t)}
+	require.NoError(t, runHelmBuild(second.appPath, second, dependencyCache, &v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps"}, "default"))
+	assert.Equal(t, 0, second.builds)
+	content, err := os.ReadFile(filepath.Join(second.appPath, "charts", "mongodb-7.8.10.tgz"))
+	require.NoError(t, err)
+	assert.Equal(t, "mongodb-7.8.10.tgz", string(content))
+	assert.FileExists(t, filepath.Join(second.appPath, helmDepUpMarkerFile))
+
+	// charts of other repositories do not share the dependencies
+	other := &dependencyBuildHelm{appPath: newAppPath(t)}
+	require.NoError(t, runHelmBuild(other.appPath, other, dependencyCache, &v1alpha1.Repository{Repo: "https://github.com/argoproj/other"}, "default"))
+	assert.Equal(t, 1, other.builds)
+
+	// applications of other projects do not share the dependencies, since they might not be permitted to use them
+	otherProject := &dependencyBuildHelm{appPath: newAppPath(t)}
+	require.NoError(t, runHelmBuild(otherProject.appPath, otherProject, dependencyCache, repo, "other"))
+	assert.Equal(t, 1, otherProject.builds)
+
+	// without a cache, the dependencies are always built
+	uncached := &dependencyBuildHelm{appPath: newAppPath(t)}
+	require.NoError(t, runHelmBuild(uncached.appPath, uncached, nil, repo, "default"))
+	assert.Equal(t, 1, uncached.builds)
+
+	families, err := metricsServer.PrometheusRegistry.Gather()
+	require.NoError(t, err)
+	values := map[string]float64{}
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			name := family.GetName()
+			for _, label := range metric.GetLabel() {
+				name += "/" + label.GetValue()
+			}
+			values[name] = metric.GetCounter().GetValue() + metric.GetGauge().GetValue()
+		}
+	}
+	assert.InDelta(t, 1, values["argocd_helm_dependency_cache_requests_total/hit"], 0)
+	assert.InDelta(t, 3, values["argocd_helm_dependency_cache_requests_total/miss"], 0)
+	assert.InDelta(t, len("mongodb-7.8.10.tgz")+len("eventstore-0.2.5.tgz"), values["argocd_helm_dependency_cache_saved_bytes_total"], 0)
+	assert.InDelta(t, 3*(len("mongodb-7.8.10.tgz")+len("eventstore-0.2.5.tgz")), values["argocd_helm_dependency_cache_size_bytes"], 0)
+}
+
 func TestResolveRevision(t *testing.T) {
 	service := newService(t, ".")
 	repo := &v1alpha1.Repository{Repo: "https://github.com/argoproj/argo-cd"}
@@ -4580,3 +4889,132 @@ func TestGenerateManifest_OCISourceSkipsGitClient(t *testing.T) {
 	// verify that newGitClient was never invoked
 	assert.False(t, gitCalled, "GenerateManifest should not invoke Git for OCI sources")
 }
+
+func TestGenerateManifest_PartialCloneMissingPath(t *testing.T) {
+	root := t.TempDir()
+	writeSparseTestFile(t, root, "app/main.jsonnet", `(import '../lib/config.libsonnet') + {kind: 'ConfigMap', apiVersion: 'v1'}`)
+	service, gitClient, _ := newServiceWithMocks(t, root, false)
+	gitClient.On("SparseCheckout", []string{"app"}).Return(nil).Once()
+	// the imported library is only checked out once the whole repository is
+	gitClient.On("SparseCheckout", []string{"."}).Run(func(_ mock.Arguments) {
+		writeSparseTestFile(t, root, "lib/config.libsonnet", `{metadata: {name: 'config'}}`)
+	}).Return(nil).Once()
+
+	res, err := service.GenerateManifest(t.Context(), &apiclient.ManifestRequest{
+		Repo:               &v1alpha1.Repository{EnablePartialClone: true},
+		ApplicationSource:  &v1alpha1.ApplicationSource{Path: "app"},
+		NoCache:            true,
+		ProjectName:        "something",
+		ProjectSourceRepos: []string{"*"},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Manifests, 1)
+	assert.Contains(t, res.Manifests[0], `"name":"config"`)
+	gitClient.AssertCalled(t, "SparseCheckout", []string{"app"})
+	gitClient.AssertCalled(t, "SparseCheckout", []string{"."})
+}
+
+func TestGenerateManifest_ArchiveSource(t *testing.T) {
+	root, err := filepath.Abs("./testdata")
+	require.NoError(t, err)
+	svc := newService(t, t.TempDir())
+	svc.newGitClient = func(_, _ string, _ git.Creds, _, _ bool, _, _ string, _ ...git.ClientOpts) (git.Client, error) {
+		return nil, errors.New("git should not be called for archives")
+	}
+	pinned := "1.0.0@sha256:" + strings.Repeat("a", 64)
+	archiveClient := &archivemocks.Client{}
+	archiveClient.EXPECT().ResolveRevision(mock.Anything, "1.0.0").Return(pinned, nil)
+	archiveClient.EXPECT().CleanCache(pinned).Return(nil)
+	archiveClient.EXPECT().Extract(mock.Anything, pinned).Return(root, utilio.NopCloser, nil)
+	archiveClient.EXPECT().VerifySignature(mock.Anything, pinned).Return(testSignature, nil)
+	svc.newArchiveClient = func(repoURL string, _ archive.Creds, _ string, _ string, _ ...archive.ClientOpts) (archive.Client, error) {
+		assert.Equal(t, "https://example.com/guestbook/{{revision}}/manifests.tar.gz", repoURL)
+		return archiveClient, nil
+	}
+
+	res, err := svc.GenerateManifest(t.Context(), &apiclient.ManifestRequest{
+		Repo: &v1alpha1.Repository{Repo: "https://example.com/guestbook/{{revision}}/manifests.tar.gz", Type: "archive"},
+		ApplicationSource: &v1alpha1.ApplicationSource{
+			RepoURL:        "https://example.com/guestbook/{{revision}}/manifests.tar.gz",
+			TargetRevision: "1.0.0",
+			Path:           "concatenated",
+		},
+		VerifySignature:    true,
+		NoCache:            true,
+		ProjectName:        "something",
+		ProjectSourceRepos: []string{"*"},
+	})
+	require.NoError(t, err)
+	assert.Len(t, res.Manifests, 3)
+	assert.Equal(t, pinned, res.Revision)
+	assert.Equal(t, testSignature, res.VerifyResult)
+
+	pinned = "2.0.0@sha256:" + strings.Repeat("b", 64)
+	archiveClient.EXPECT().ResolveRevision(mock.Anything, "2.0.0").Return(pinned, nil)
+	app := &v1alpha1.Application{Spec: v1alpha1.ApplicationSpec{Source: &v1alpha1.ApplicationSource{
+		RepoURL: "https://example.com/guestbook/{{revision}}/manifests.tar.gz",
+	}}}
+	resolved, err := svc.ResolveRevision(t.Context(), &apiclient.ResolveRevisionRequest{
+		Repo:              &v1alpha1.Repository{Repo: "https://example.com/guestbook/{{revision}}/manifests.tar.gz"},
+		App:               app,
+		AmbiguousRevision: "2.0.0",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, pinned, resolved.Revision)
+}
+
+func TestGenerateManifest_OCISignatureVerification(t *testing.T) {
+	root, err := filepath.Abs("./testdata")
+	require.NoError(t, err)
+	digest := "sha256:" + strings.Repeat("a", 64)
+	verification := &v1alpha1.OCISignatureVerification{
+		Identities: []v1alpha1.OCISigningIdentity{{Issuer: "https://token.actions.githubusercontent.com", Subject: "https://github.com/argoproj/*"}},
+	}
+	newRequest := func() *apiclient.ManifestRequest {
+		return &apiclient.ManifestRequest{
+			Repo: &v1alpha1.Repository{Repo: "oci://example.com/guestbook", Type: "oci"},
+			ApplicationSource: &v1alpha1.ApplicationSource{
+				RepoURL:        "oci://example.com/guestbook",
+				TargetRevision: "1.0.0",
+				Path:           "concatenated",
+			},
+			OciSignatureVerification: verification,
+			NoCache:                  true,
+			ProjectName:              "something",
+			ProjectSourceRepos:       []string{"*"},
+		}
+	}
+
+	t.Run("Verified", func(t *testing.T) {
+		result := &cosign.Result{
+			Digest:  digest,
+			Signers: []cosign.Signer{{Issuer: "https://token.actions.githubusercontent.com", Subject: "https://github.com/argoproj/argo-cd"}},
+		}
+		service, _, _ := newServiceWithOpt(t, func(_ *gitmocks.Client, _ *helmmocks.Client, ociClient *ocimocks.Client, paths *iomocks.TempPaths) {
+			paths.EXPECT().GetPath(mock.Anything).Return(root, nil)
+			paths.EXPECT().GetPathIfExists(mock.Anything).Return(root)
+			ociClient.EXPECT().ResolveRevision(mock.Anything, "1.0.0", true).Return(digest, nil)
+			ociClient.EXPECT().CleanCache(digest).Return(nil)
+			ociClient.EXPECT().VerifySignature(mock.Anything, digest, mock.Anything).Return(result, nil)
+			ociClient.EXPECT().Extract(mock.Anything, digest).Return(root, utilio.NopCloser, nil)
+		}, root)
+
+		res, err := service.GenerateManifest(t.Context(), newRequest())
+		require.NoError(t, err)
+		assert.Len(t, res.Manifests, 3)
+		assert.Equal(t, result.String(), res.VerifyResult)
+	})
+
+	t.Run("Not verified", func(t *testing.T) {
+		service, _, _ := newServiceWithOpt(t, func(_ *gitmocks.Client, _ *helmmocks.Client, ociClient *ocimocks.Client, paths *iomocks.TempPaths) {
+			paths.EXPECT().GetPath(mock.Anything).Return(root, nil)
+			paths.EXPECT().GetPathIfExists(mock.Anything).Return(root)
+			ociClient.EXPECT().ResolveRevision(mock.Anything, "1.0.0", true).Return(digest, nil)
+			ociClient.EXPECT().CleanCache(digest).Return(nil)
+			ociClient.EXPECT().VerifySignature(mock.Anything, digest, mock.Anything).Return(nil, errors.New("no cosign signature found"))
+		}, root)
+
+		_, err := service.GenerateManifest(t.Context(), newRequest())
+		require.ErrorContains(t, err, "signature verification of oci image with digest "+digest+" failed: no cosign signature found")
+	})
+}
@@ -0,0 +1,265 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"syscall"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/git"
+	"github.com/argoproj/argo-cd/v3/util/kustomize"
+)
+
+// sparseCheckoutPath returns the path relative to the repository root of a file or directory referenced by an
+// application, which is relative to the application path unless it is absolute. The second return value is false if
+// the path is outside of the repository.
+func sparseCheckoutPath(appPath string, p string) (string, bool) {
+	if path.IsAbs(p) {
+		p = path.Clean(strings.TrimPrefix(p, "/"))
+	} else {
+		p = path.Join(appPath, p)
+	}
+	if p == ".." || strings.HasPrefix(p, "../") {
+		return "", false
+	}
+	return p, true
+}
+
+// missingPathErrorRegex matches the errors of the config management tools caused by files which are not checked out
+var missingPathErrorRegex = regexp.MustCompile(`no such file or directory|couldn't open import|directory \S+ not found`)
+
+// isMissingPathError returns true if the given error of a repository operation might be caused by a path of the
+// repository which is missing from the sparse checkout
+func isMissingPathError(err error) bool {
+	return errors.Is(err, os.ErrNotExist) || missingPathErrorRegex.MatchString(err.Error())
+}
+
+// sparseCheckoutPaths returns the paths of the repository which are used to generate the manifests of the given
+// source: the application path, its local Helm value files and its Jsonnet libraries. Config management plugins are
+// sent the whole repository, so it is checked out entirely for sources using a plugin.
+func sparseCheckoutPaths(source *v1alpha1.ApplicationSource) []string {
+	appPath := path.Clean(source.Path)
+	if source.Plugin != nil {
+		return []string{"."}
+	}
+	paths := []string{appPath}
+	if source.Directory != nil {
+		// the jsonnet library paths are relative to the repository root
+		for _, lib := range source.Directory.Jsonnet.Libs {
+			if p, ok := sparseCheckoutPath(".", lib); ok {
+				paths = append(paths, p)
+			}
+		}
+	}
+	if source.Helm == nil {
+		return paths
+	}
+	files := slices.Clone(source.Helm.ValueFiles)
+	for _, fileParam := range source.Helm.FileParameters {
+		files = append(files, fileParam.Path)
+	}
+	for _, file := range files {
+		// referenced sources and remote value files are not part of the repository
+		if strings.HasPrefix(file, "$") || strings.Contains(file, "://") {
+			continue
+		}
+		if p, ok := sparseCheckoutPath(appPath, file); ok {
+			paths = append(paths, p)
+		}
+	}
+	return paths
+}
+
+// refSparseCheckoutPaths returns the paths of the value files taken from the referenced sources of the given
+// repository
+func refSparseCheckoutPaths(refCandidates []string, refSources map[string]*v1alpha1.RefTarget, normalizedRepoURL string) []string {
+	var paths []string
+	for _, file := range refCandidates {
+		if !strings.HasPrefix(file, "$") {
+			continue
+		}
+		refVar, refPath, _ := strings.Cut(file, "/")
+		refSource, ok := refSources[refVar]
+		if !ok || git.NormalizeGitURL(refSource.Repo.Repo) != normalizedRepoURL {
+			continue
+		}
+		if p, ok := sparseCheckoutPath(".", "/"+refPath); ok {
+			paths = append(paths, p)
+		}
+	}
+	return paths
+}
+
+// isRemoteKustomizeReference returns true if the given entry of a kustomization refers to a remote resource
+func isRemoteKustomizeReference(entry string) bool {
+	return strings.Contains(entry, "://") || strings.Contains(entry, "?ref=") || strings.HasPrefix(entry, "git@") ||
+		strings.HasPrefix(entry, "github.com/") || strings.HasPrefix(entry, "gitlab.com/")
+}
+
+// kustomizeReferencedPaths returns the local paths, relative to the repository root, which are referenced by the
+// kustomization of the given directory of the repository, e.g. bases and components located in sibling directories
+func kustomizeReferencedPaths(repoRoot string, dir string) ([]string, error) {
+	var data []byte
+	for _, name := range kustomize.KustomizationNames {
+		var err error
+		data, err = os.ReadFile(filepath.Join(repoRoot, dir, name))
+		if err == nil {
+			break
+		}
+		// the path might be a file, e.g. a Helm value file
+		if !errors.Is(err, os.ErrNotExist) && !errors.Is(err, syscall.ENOTDIR) {
+			return nil, fmt.Errorf("failed to read kustomization of %s: %w", dir, err)
+		}
+	}
+	if data == nil {
+		return nil, nil
+	}
+	var kustomization struct {
+		Resources             []string `json:"resources"`
+		Bases                 []string `json:"bases"`
+		Components            []string `json:"components"`
+		Crds                  []string `json:"crds"`
+		PatchesStrategicMerge []any    `json:"patchesStrategicMerge"`
+		Patches               []struct {
+			Path string `json:"path"`
+		} `json:"patches"`
+		PatchesJSON6902 []struct {
+			Path string `json:"path"`
+		} `json:"patchesJson6902"`
+		ConfigMapGenerator []struct {
+			Files []string `json:"files"`
+			Envs  []string `json:"envs"`
+			Env   string   `json:"env"`
+		} `json:"configMapGenerator"`
+		SecretGenerator []struct {
+			Files []string `json:"files"`
+			Envs  []string `json:"envs"`
+			Env   string   `json:"env"`
+		} `json:"secretGenerator"`
+	}
+	if err := yaml.Unmarshal(data, &kustomization); err != nil {
+		return nil, fmt.Errorf("failed to parse kustomization of %s: %w", dir, err)
+	}
+
+	entries := slices.Concat(kustomization.Resources, kustomization.Bases, kustomization.Components, kustomization.Crds)
+	for _, patch := range kustomization.PatchesStrategicMerge {
+		// inline patches are maps or multi-line strings
+		if file, ok := patch.(string); ok && !strings.Contains(file, "\n") {
+			entries = append(entries, file)
+		}
+	}
+	for _, patch := range kustomization.Patches {
+		entries = append(entries, patch.Path)
+	}
+	for _, patch := range kustomization.PatchesJSON6902 {
+		entries = append(entries, patch.Path)
+	}
+	generators := slices.Concat(kustomization.ConfigMapGenerator, kustomization.SecretGenerator)
+	for _, generator := range generators {
+		for _, file := range generator.Files {
+			// files can be specified as key=path
+			if _, p, ok := strings.Cut(file, "="); ok {
+				file = p
+			}
+			entries = append(entries, file)
+		}
+		entries = append(entries, generator.Envs...)
+		entries = append(entries, generator.Env)
+	}
+
+	var paths []string
+	for _, entry := range entries {
+		if entry == "" || isRemoteKustomizeReference(entry) {
+			continue
+		}
+		if p, ok := sparseCheckoutPath(dir, entry); ok && !slices.Contains(paths, p) {
+			paths = append(paths, p)
+		}
+	}
+	return paths, nil
+}
+
+// helmReferencedPaths returns the paths, relative to the repository root, of the local dependencies with a file://
+// repository declared by the Helm chart of the given directory of the repository
+func helmReferencedPaths(repoRoot string, dir string) ([]string, error) {
+	var paths []string
+	// the dependencies of charts with apiVersion v1 are declared in requirements.yaml
+	for _, name := range []string{"Chart.yaml", "requirements.yaml"} {
+		data, err := os.ReadFile(filepath.Join(repoRoot, dir, name))
+		if errors.Is(err, os.ErrNotExist) || errors.Is(err, syscall.ENOTDIR) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s of %s: %w", name, dir, err)
+		}
+		var chart struct {
+			Dependencies []struct {
+				Repository string `json:"repository"`
+			} `json:"dependencies"`
+		}
+		if err := yaml.Unmarshal(data, &chart); err != nil {
+			return nil, fmt.Errorf("failed to parse %s of %s: %w", name, dir, err)
+		}
+		for _, dependency := range chart.Dependencies {
+			dependencyPath, ok := strings.CutPrefix(dependency.Repository, "file://")
+			if !ok {
+				continue
+			}
+			if p, ok := sparseCheckoutPath(dir, dependencyPath); ok && !slices.Contains(paths, p) {
+				paths = append(paths, p)
+			}
+		}
+	}
+	return paths, nil
+}
+
+// sparseCheckout widens the sparse checkout of a partial clone with the given paths and the local paths referenced by
+// their kustomizations and Helm charts, until all the bases of the kustomizations and the local dependencies of the
+// charts are checked out
+func sparseCheckout(gitClient git.Client, paths []string) error {
+	checkedOut := map[string]bool{}
+	for len(paths) > 0 {
+		if err := gitClient.SparseCheckout(paths); err != nil {
+			return fmt.Errorf("failed to widen sparse checkout: %w", err)
+		}
+		for _, p := range paths {
+			checkedOut[p] = true
+		}
+		var referenced []string
+		for _, p := range paths {
+			kustomizeRefs, err := kustomizeReferencedPaths(gitClient.Root(), p)
+			if err != nil {
+				return err
+			}
+			helmRefs, err := helmReferencedPaths(gitClient.Root(), p)
+			if err != nil {
+				return err
+			}
+			for _, ref := range slices.Concat(kustomizeRefs, helmRefs) {
+				if !checkedOut[ref] && !slices.Contains(referenced, ref) {
+					referenced = append(referenced, ref)
+				}
+			}
+		}
+		paths = referenced
+	}
+	return nil
+}
+
+// globBase returns the directory of the given glob pattern which precedes its first meta character
+func globBase(pattern string) string {
+	pattern = strings.TrimPrefix(pattern, "/")
+	idx := strings.IndexAny(pattern, "*?[{\\")
+	if idx < 0 {
+		return path.Clean(pattern)
+	}
+	return path.Dir(pattern[:idx])
+}
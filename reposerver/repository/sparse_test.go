@@ -0,0 +1,216 @@
+package repository
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	gitmocks "github.com/argoproj/argo-cd/v3/util/git/mocks"
+)
+
+func writeSparseTestFile(t *testing.T, root string, name string, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(root, name)), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, name), []byte(content), 0o644))
+}
+
+func TestSparseCheckoutPaths(t *testing.T) {
+	assert.Equal(t, []string{"apps/guestbook"}, sparseCheckoutPaths(&v1alpha1.ApplicationSource{Path: "apps/guestbook/"}))
+	assert.Equal(t, []string{"."}, sparseCheckoutPaths(&v1alpha1.ApplicationSource{Path: ""}))
+
+	source := &v1alpha1.ApplicationSource{
+		Path: "charts/guestbook",
+		Helm: &v1alpha1.ApplicationSourceHelm{
+			ValueFiles: []string{
+				"values.yaml",
+				"../../envs/prod/values.yaml",
+				"/envs/common.yaml",
+				"$values/envs/prod.yaml",
+				"https://example.com/values.yaml",
+				"../../../outside.yaml",
+			},
+			FileParameters: []v1alpha1.HelmFileParameter{{Name: "config", Path: "files/config.json"}},
+		},
+	}
+	assert.Equal(t, []string{
+		"charts/guestbook",
+		"charts/guestbook/values.yaml",
+		"envs/prod/values.yaml",
+		"envs/common.yaml",
+		"charts/guestbook/files/config.json",
+	}, sparseCheckoutPaths(source))
+
+	source = &v1alpha1.ApplicationSource{
+		Path: "apps/guestbook",
+		Directory: &v1alpha1.ApplicationSourceDirectory{
+			Jsonnet: v1alpha1.ApplicationSourceJsonnet{Libs: []string{"vendor", "/lib/", "../outside"}},
+		},
+	}
+	assert.Equal(t, []string{"apps/guestbook", "vendor", "lib"}, sparseCheckoutPaths(source))
+
+	// plugins are sent the whole repository
+	source = &v1alpha1.ApplicationSource{Path: "apps/guestbook", Plugin: &v1alpha1.ApplicationSourcePlugin{Name: "plugin"}}
+	assert.Equal(t, []string{"."}, sparseCheckoutPaths(source))
+}
+
+func TestIsMissingPathError(t *testing.T) {
+	_, err := os.Stat(filepath.Join(t.TempDir(), "missing"))
+	assert.True(t, isMissingPathError(err))
+	assert.True(t, isMissingPathError(errors.New("`kustomize build` failed: accumulating resources: lstat /tmp/base: no such file or directory")))
+	assert.True(t, isMissingPathError(errors.New(`couldn't open import "lib.libsonnet": no match locally or in the Jsonnet library paths`)))
+	assert.True(t, isMissingPathError(errors.New("`helm dependency build` failed: Error: directory /tmp/charts/common not found")))
+	assert.False(t, isMissingPathError(errors.New("chart common not found in repository")))
+}
+
+func TestHelmReferencedPaths(t *testing.T) {
+	root := t.TempDir()
+	writeSparseTestFile(t, root, "charts/guestbook/Chart.yaml", `
+apiVersion: v2
+name: guestbook
+dependencies:
+- name: common
+  repository: file://../common
+- name: local
+  repository: file://charts/local
+- name: redis
+  repository: https://charts.example.com
+- name: outside
+  repository: file://../../../outside
+`)
+	writeSparseTestFile(t, root, "charts/legacy/requirements.yaml", "dependencies:\n- name: common\n  repository: file://../common\n")
+
+	paths, err := helmReferencedPaths(root, "charts/guestbook")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"charts/common", "charts/guestbook/charts/local"}, paths)
+
+	paths, err = helmReferencedPaths(root, "charts/legacy")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"charts/common"}, paths)
+
+	// files and directories without chart are ignored
+	for _, dir := range []string{"charts/guestbook/Chart.yaml", "missing", "."} {
+		paths, err = helmReferencedPaths(root, dir)
+		require.NoError(t, err)
+		assert.Empty(t, paths)
+	}
+
+	writeSparseTestFile(t, root, "invalid/Chart.yaml", "dependencies: {")
+	_, err = helmReferencedPaths(root, "invalid")
+	assert.ErrorContains(t, err, "failed to parse Chart.yaml of invalid")
+}
+
+func TestRefSparseCheckoutPaths(t *testing.T) {
+	refSources := map[string]*v1alpha1.RefTarget{
+		"$values": {Repo: v1alpha1.Repository{Repo: "https://github.com/org/values.git"}},
+		"$all":    {Repo: v1alpha1.Repository{Repo: "https://github.com/org/values"}},
+		"$other":  {Repo: v1alpha1.Repository{Repo: "https://github.com/org/other.git"}},
+	}
+	paths := refSparseCheckoutPaths([]string{
+		"values.yaml",
+		"$values/envs/prod.yaml",
+		"$other/envs/prod.yaml",
+		"$unknown/envs/prod.yaml",
+		"$values/../outside.yaml",
+	}, refSources, "https://github.com/org/values")
+	assert.Equal(t, []string{"envs/prod.yaml"}, paths)
+
+	assert.Equal(t, []string{"."}, refSparseCheckoutPaths([]string{"$all"}, refSources, "https://github.com/org/values"))
+}
+
+func TestKustomizeReferencedPaths(t *testing.T) {
+	root := t.TempDir()
+	writeSparseTestFile(t, root, "overlays/prod/kustomization.yaml", `
+resources:
+- ../../base
+- deployment.yaml
+- https://github.com/org/repo//config?ref=v1.0.0
+- github.com/org/repo/config
+- ../../../outside
+components:
+- ../../components/monitoring
+patches:
+- path: patch.yaml
+- patch: |-
+    - op: replace
+      path: /spec/replicas
+      value: 3
+patchesStrategicMerge:
+- strategic.yaml
+- |-
+  apiVersion: apps/v1
+  kind: Deployment
+configMapGenerator:
+- name: config
+  files:
+  - config.properties
+  - app.conf=../../config/app.conf
+  envs:
+  - config.env
+`)
+	writeSparseTestFile(t, root, "base/Kustomization", "resources:\n- service.yaml\n")
+	writeSparseTestFile(t, root, "values.yaml", "replicaCount: 1\n")
+
+	paths, err := kustomizeReferencedPaths(root, "overlays/prod")
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"base",
+		"overlays/prod/deployment.yaml",
+		"components/monitoring",
+		"overlays/prod/strategic.yaml",
+		"overlays/prod/patch.yaml",
+		"overlays/prod/config.properties",
+		"config/app.conf",
+		"overlays/prod/config.env",
+	}, paths)
+
+	paths, err = kustomizeReferencedPaths(root, "base")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"base/service.yaml"}, paths)
+
+	// files and directories without kustomization are ignored
+	for _, dir := range []string{"values.yaml", "missing", "."} {
+		paths, err = kustomizeReferencedPaths(root, dir)
+		require.NoError(t, err)
+		assert.Empty(t, paths)
+	}
+
+	writeSparseTestFile(t, root, "invalid/kustomization.yaml", "resources: {")
+	_, err = kustomizeReferencedPaths(root, "invalid")
+	assert.ErrorContains(t, err, "failed to parse kustomization of invalid")
+}
+
+func TestSparseCheckout(t *testing.T) {
+	root := t.TempDir()
+	writeSparseTestFile(t, root, "overlays/prod/kustomization.yaml", "resources:\n- ../../base\n")
+	writeSparseTestFile(t, root, "base/kustomization.yaml", "components:\n- ../components/monitoring\n- ../overlays/prod\n")
+	writeSparseTestFile(t, root, "components/monitoring/Chart.yaml", "dependencies:\n- repository: file://../../charts/common\n")
+
+	gitClient := &gitmocks.Client{}
+	gitClient.On("Root").Return(root)
+	gitClient.On("SparseCheckout", []string{"overlays/prod"}).Return(nil).Once()
+	gitClient.On("SparseCheckout", []string{"base"}).Return(nil).Once()
+	gitClient.On("SparseCheckout", []string{"components/monitoring"}).Return(nil).Once()
+	gitClient.On("SparseCheckout", []string{"charts/common"}).Return(nil).Once()
+
+	require.NoError(t, sparseCheckout(gitClient, []string{"overlays/prod"}))
+	gitClient.AssertExpectations(t)
+}
+
+func TestGlobBase(t *testing.T) {
+	for pattern, expected := range map[string]string{
+		"":                        ".",
+		"*":                       ".",
+		"**/config.json":          ".",
+		"/clusters/*/config.json": "clusters",
+		"clusters/**/config.json": "clusters",
+		"clusters/prod-{a,b}/x":   "clusters",
+		"clusters/config.json":    "clusters/config.json",
+	} {
+		assert.Equal(t, expected, globBase(pattern), pattern)
+	}
+}
@@ -363,6 +363,7 @@ func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateReq
 			}).Warn("User attempted to set operation on application creation. This could have allowed them to bypass branch protection rules by setting manifests directly. Ignoring the set operation.")
 		a.Operation = nil
 	}
+	s.keepSyncPause(ctx, a, nil)
 
 	created, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Create(ctx, a, metav1.CreateOptions{})
 	if err == nil {
@@ -481,7 +482,21 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 		return nil, security.NamespaceNotPermittedError(a.Namespace)
 	}
 
+	var history *v1alpha1.RevisionHistory
+	if q.HistoryId != nil {
+		for i, info := range a.Status.History {
+			if info.ID == q.GetHistoryId() {
+				history = &a.Status.History[i]
+				break
+			}
+		}
+		if history == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "application %s does not have deployment with id %v", a.QualifiedName(), q.GetHistoryId())
+		}
+	}
+
 	manifestInfos := make([]*apiclient.ManifestResponse, 0)
+	var helmValuesFrom *argo.HelmValuesFrom
 	err = s.queryRepoServer(ctx, proj, func(
 		client apiclient.RepoServerServiceClient, helmRepos []*v1alpha1.Repository, helmCreds []*v1alpha1.RepoCreds, ociRepos []*v1alpha1.Repository, ociCreds []*v1alpha1.RepoCreds, helmOptions *v1alpha1.HelmOptions, enableGenerateManifests map[string]bool,
 	) error {
@@ -505,9 +520,28 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 			return fmt.Errorf("error getting API resources: %w", err)
 		}
 
+		// the Secrets of valuesFrom are only resolved for the target revisions of the application
+		targetSources := slices.Clone(a.Spec.GetSources())
 		sources := make([]v1alpha1.ApplicationSource, 0)
 		appSpec := a.Spec
-		if a.Spec.HasMultipleSources() {
+		appSpec.Sources = slices.Clone(a.Spec.Sources)
+		hasMultipleSources := a.Spec.HasMultipleSources()
+		switch {
+		case history != nil && len(history.Sources) > 0:
+			// generate the manifests of the sources deployed with the given revision history entry
+			hasMultipleSources = true
+			for i, source := range history.Sources {
+				if i < len(history.Revisions) {
+					source.TargetRevision = history.Revisions[i]
+				}
+				sources = append(sources, source)
+			}
+		case history != nil:
+			hasMultipleSources = false
+			source := history.Source
+			source.TargetRevision = history.Revision
+			sources = append(sources, source)
+		case hasMultipleSources:
 			numOfSources := int64(len(a.Spec.GetSources()))
 			for i, pos := range q.SourcePositions {
 				if pos <= 0 || pos > numOfSources {
@@ -516,7 +550,7 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 				appSpec.Sources[pos-1].TargetRevision = q.Revisions[i]
 			}
 			sources = appSpec.GetSources()
-		} else {
+		default:
 			source := a.Spec.GetSource()
 			if q.GetRevision() != "" {
 				source.TargetRevision = q.GetRevision()
@@ -530,7 +564,16 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 			return fmt.Errorf("failed to get ref sources: %w", err)
 		}
 
-		for _, source := range sources {
+		gitRepos, err := argo.GetPermittedGitRepos(ctx, s.db, proj)
+		if err != nil {
+			return fmt.Errorf("error getting permitted git repositories: %w", err)
+		}
+
+		for i, source := range sources {
+			if history != nil || i >= len(targetSources) || source.TargetRevision != targetSources[i].TargetRevision {
+				source = argo.WithoutHelmValuesFromSecrets(source)
+			}
+
 			repo, err := s.db.GetRepository(ctx, source.RepoURL, proj.Name)
 			if err != nil {
 				return fmt.Errorf("error getting repository: %w", err)
@@ -562,6 +605,12 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 				helmRepoCreds = append(helmRepoCreds, ociCreds...)
 			}
 
+			sourceHelmValuesFrom, err := argo.GetHelmValuesFrom(ctx, argo.NewHelmValuesClientGetter(s.kubeclientset), a.Namespace, &source)
+			if err != nil {
+				return fmt.Errorf("error getting helm values: %w", err)
+			}
+			helmValuesFrom = helmValuesFrom.Merge(sourceHelmValuesFrom)
+
 			manifestInfo, err := client.GenerateManifest(ctx, &apiclient.ManifestRequest{
 				Repo:                            repo,
 				Revision:                        source.TargetRevision,
@@ -579,10 +628,12 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 				EnabledSourceTypes:              enableGenerateManifests,
 				ProjectName:                     proj.Name,
 				ProjectSourceRepos:              proj.Spec.SourceRepos,
-				HasMultipleSources:              a.Spec.HasMultipleSources(),
+				HasMultipleSources:              hasMultipleSources,
 				RefSources:                      refSources,
 				AnnotationManifestGeneratePaths: a.GetAnnotation(v1alpha1.AnnotationKeyManifestGeneratePaths),
 				InstallationID:                  installationID,
+				HelmValuesFrom:                  sourceHelmValuesFrom.GetValues(),
+				GitRepos:                        gitRepos,
 			})
 			if err != nil {
 				return fmt.Errorf("error generating manifests: %w", err)
@@ -603,11 +654,18 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 			if err != nil {
 				return nil, fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
 			}
+			modified := false
 			if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
 				obj, _, err = diff.HideSecretData(obj, nil, s.settingsMgr.GetSensitiveAnnotations())
 				if err != nil {
 					return nil, fmt.Errorf("error hiding secret data: %w", err)
 				}
+				modified = true
+			}
+			if helmValuesFrom.Redact(obj) {
+				modified = true
+			}
+			if modified {
 				data, err := json.Marshal(obj)
 				if err != nil {
 					return nil, fmt.Errorf("error marshaling manifest: %w", err)
@@ -616,6 +674,7 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 			}
 		}
 		manifests.Manifests = append(manifests.Manifests, manifestInfo.Manifests...)
+		manifests.Provenance = append(manifests.Provenance, manifestInfo.Provenance...)
 	}
 
 	return manifests, nil
@@ -638,6 +697,7 @@ func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_Get
 	}
 
 	var manifestInfo *apiclient.ManifestResponse
+	var helmValuesFrom *argo.HelmValuesFrom
 	err = s.queryRepoServer(ctx, proj, func(
 		client apiclient.RepoServerServiceClient, helmRepos []*v1alpha1.Repository, helmCreds []*v1alpha1.RepoCreds, _ []*v1alpha1.Repository, _ []*v1alpha1.RepoCreds, helmOptions *v1alpha1.HelmOptions, enableGenerateManifests map[string]bool,
 	) error {
@@ -666,7 +726,8 @@ func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_Get
 			return fmt.Errorf("error getting API resources: %w", err)
 		}
 
-		source := a.Spec.GetSource()
+		// the manifests are generated from uploaded files, so the Secrets of valuesFrom are not resolved
+		source := argo.WithoutHelmValuesFromSecrets(a.Spec.GetSource())
 
 		proj, err := argo.GetAppProject(ctx, a, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db)
 		if err != nil {
@@ -683,6 +744,16 @@ func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_Get
 			return fmt.Errorf("error getting kustomize settings: %w", err)
 		}
 
+		helmValuesFrom, err = argo.GetHelmValuesFrom(ctx, argo.NewHelmValuesClientGetter(s.kubeclientset), a.Namespace, &source)
+		if err != nil {
+			return fmt.Errorf("error getting helm values: %w", err)
+		}
+
+		gitRepos, err := argo.GetPermittedGitRepos(ctx, s.db, proj)
+		if err != nil {
+			return fmt.Errorf("error getting permitted git repositories: %w", err)
+		}
+
 		req := &apiclient.ManifestRequest{
 			Repo:                            repo,
 			Revision:                        source.TargetRevision,
@@ -701,6 +772,8 @@ func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_Get
 			ProjectName:                     proj.Name,
 			ProjectSourceRepos:              proj.Spec.SourceRepos,
 			AnnotationManifestGeneratePaths: a.GetAnnotation(v1alpha1.AnnotationKeyManifestGeneratePaths),
+			HelmValuesFrom:                  helmValuesFrom.GetValues(),
+			GitRepos:                        gitRepos,
 		}
 
 		repoStreamClient, err := client.GenerateManifestWithFiles(stream.Context())
@@ -731,11 +804,18 @@ func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_Get
 		if err != nil {
 			return fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
 		}
+		modified := false
 		if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
 			obj, _, err = diff.HideSecretData(obj, nil, s.settingsMgr.GetSensitiveAnnotations())
 			if err != nil {
 				return fmt.Errorf("error hiding secret data: %w", err)
 			}
+			modified = true
+		}
+		if helmValuesFrom.Redact(obj) {
+			modified = true
+		}
+		if modified {
 			data, err := json.Marshal(obj)
 			if err != nil {
 				return fmt.Errorf("error marshaling manifest: %w", err)
@@ -979,7 +1059,9 @@ func (s *Server) waitSync(app *v1alpha1.Application) {
 
 func (s *Server) updateApp(ctx context.Context, app *v1alpha1.Application, newApp *v1alpha1.Application, merge bool) (*v1alpha1.Application, error) {
 	for i := 0; i < 10; i++ {
+		pause := app.Spec.SyncPolicy.GetPause()
 		app.Spec = newApp.Spec
+		s.keepSyncPause(ctx, app, pause)
 		if merge {
 			app.Labels = collections.Merge(app.Labels, newApp.Labels)
 			app.Annotations = collections.Merge(app.Annotations, newApp.Annotations)
@@ -1009,6 +1091,20 @@ func (s *Server) updateApp(ctx context.Context, app *v1alpha1.Application, newAp
 	return nil, status.Errorf(codes.Internal, "Failed to update application. Too many conflicts")
 }
 
+// keepSyncPause restores the sync pause the application had before its spec was replaced, unless the user is allowed
+// to pause the application. The pause can otherwise only be changed through the Pause and Resume APIs, which record who
+// paused the application.
+func (s *Server) keepSyncPause(ctx context.Context, a *v1alpha1.Application, pause *v1alpha1.SyncPause) {
+	if reflect.DeepEqual(a.Spec.SyncPolicy.GetPause(), pause) || s.enf.Enforce(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionPause, a.RBACName(s.ns)) {
+		return
+	}
+	log.WithFields(applog.GetAppLogFields(a)).
+		WithFields(log.Fields{
+			argocommon.SecurityField: argocommon.SecurityLow,
+		}).Warn("User attempted to change the sync pause of the application without the permission to pause it. Ignoring the change of the sync pause.")
+	a.Spec.SetSyncPause(pause)
+}
+
 // Update updates an application
 func (s *Server) Update(ctx context.Context, q *application.ApplicationUpdateRequest) (*v1alpha1.Application, error) {
 	if q.GetApplication() == nil {
@@ -1155,6 +1251,13 @@ func (s *Server) Delete(ctx context.Context, q *application.ApplicationDeleteReq
 		patchFinalizer = true
 	}
 
+	if q.GetSkipPreDeleteHooks() && a.HasPreDeleteFinalizer() {
+		// the pre-delete finalizer is also removed from an application which is already being deleted, so that an
+		// application blocked by failed hooks can be deleted
+		a.UnSetPreDeleteFinalizer()
+		patchFinalizer = true
+	}
+
 	if patchFinalizer {
 		// Although the cascaded deletion/propagation policy finalizer is not set when apps are created via
 		// API, they will often be set by the user as part of declarative config. As part of a delete
@@ -1759,6 +1862,27 @@ func (s *Server) ManagedResources(ctx context.Context, q *application.ResourcesQ
 	return res, nil
 }
 
+// ResourceDriftHistory returns the drift episodes recorded by the application controller for the application resources
+func (s *Server) ResourceDriftHistory(ctx context.Context, q *application.ResourcesQuery) (*application.ResourceDriftHistoryResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetApplicationName())
+	if err != nil {
+		return nil, err
+	}
+
+	var history []*v1alpha1.ResourceDriftEpisode
+	err = s.cache.GetAppResourceDriftHistory(a.InstanceName(s.ns), &history)
+	if err != nil && !errors.Is(err, servercache.ErrCacheMiss) {
+		return nil, fmt.Errorf("error getting cached app drift history: %w", err)
+	}
+	res := &application.ResourceDriftHistoryResponse{}
+	for _, episode := range history {
+		if isMatchingResource(q, episode.Key()) {
+			res.Items = append(res.Items, episode)
+		}
+	}
+	return res, nil
+}
+
 func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.ApplicationService_PodLogsServer) error {
 	if q.PodName != nil {
 		podKind := "Pod"
@@ -1990,11 +2114,18 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 
 	s.inferResourcesStatusHealth(a)
 
-	canSync, err := proj.Spec.SyncWindows.Matches(a).CanSync(true)
+	syncWindows, err := argo.GetSyncWindows(a, proj, s.settingsMgr)
+	if err != nil {
+		return a, status.Errorf(codes.PermissionDenied, "cannot sync: invalid sync window: %v", err)
+	}
+	canSync, err := syncWindows.CanSync(true)
 	if err != nil {
 		return a, status.Errorf(codes.PermissionDenied, "cannot sync: invalid sync window: %v", err)
 	}
 	if !canSync {
+		if reason, err := syncWindows.BlockReason(); err == nil && reason != "" {
+			return a, status.Errorf(codes.PermissionDenied, "cannot sync: blocked by sync window: %s", reason)
+		}
 		return a, status.Errorf(codes.PermissionDenied, "cannot sync: blocked by sync window")
 	}
 
@@ -2014,6 +2145,10 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
 	}
 
+	if syncReq.GetRetryFailed() {
+		return s.retryFailedSync(ctx, a, syncReq)
+	}
+
 	revision, displayRevision, sourceRevisions, displayRevisions, err := s.resolveSourceRevisions(ctx, a, syncReq)
 	if err != nil {
 		return nil, err
@@ -2037,7 +2172,7 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	}
 
 	// We cannot use local manifests if we're only allowed to sync to signed commits
-	if syncReq.Manifests != nil && len(proj.Spec.SignatureKeys) > 0 {
+	if syncReq.Manifests != nil && (len(proj.Spec.SignatureKeys) > 0 || proj.Spec.OCISignatureVerification != nil) {
 		return nil, status.Errorf(codes.FailedPrecondition, "Cannot use local sync when signature keys are required.")
 	}
 
@@ -2092,6 +2227,54 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	return a, nil
 }
 
+// retryFailedSync starts a sync operation of the resources which failed in the last sync operation of the application,
+// pinned to the revisions that operation was performed against.
+func (s *Server) retryFailedSync(ctx context.Context, a *v1alpha1.Application, syncReq *application.ApplicationSyncRequest) (*v1alpha1.Application, error) {
+	if syncReq.GetRevision() != "" || len(syncReq.GetRevisions()) > 0 || len(syncReq.GetResources()) > 0 || syncReq.Manifests != nil {
+		return nil, status.Error(codes.InvalidArgument, "revisions, resources and local manifests cannot be specified when retrying failed resources")
+	}
+	opState := a.Status.OperationState
+	if opState == nil || opState.Operation.Sync == nil || opState.SyncResult == nil || !opState.Phase.Failed() {
+		return nil, status.Error(codes.FailedPrecondition, "cannot retry failed resources: the last sync operation did not fail")
+	}
+	syncOp := opState.Operation.Sync.RetryFailedResources(opState.SyncResult)
+	if syncOp == nil {
+		return nil, status.Error(codes.FailedPrecondition, "cannot retry failed resources: no resource failed in the last sync operation")
+	}
+	if syncOp.Manifests != nil {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionOverride, a.RBACName(s.ns)); err != nil {
+			return nil, err
+		}
+	}
+	if syncOp.SyncOptions.HasOption(common.SyncOptionReplace) && !s.syncWithReplaceAllowed {
+		return nil, status.Error(codes.FailedPrecondition, "sync with replace was disabled on the API Server level via the server configuration")
+	}
+	syncOp.DryRun = syncReq.GetDryRun()
+	syncOp.SelfHealAttemptsCount = 0
+
+	op := v1alpha1.Operation{
+		Sync:        syncOp,
+		InitiatedBy: v1alpha1.OperationInitiator{Username: session.Username(ctx)},
+		Info:        syncReq.Infos,
+		Retry:       opState.Operation.Retry,
+	}
+	if syncReq.RetryStrategy != nil {
+		op.Retry = *syncReq.RetryStrategy
+	}
+
+	appIf := s.appclientset.ArgoprojV1alpha1().Applications(s.appNamespaceOrDefault(syncReq.GetAppNamespace()))
+	a, err := argo.SetAppOperation(appIf, syncReq.GetName(), &op)
+	if err != nil {
+		return nil, fmt.Errorf("error setting app operation: %w", err)
+	}
+	revision := syncOp.Revision
+	if len(syncOp.Revisions) > 0 {
+		revision = strings.Join(syncOp.Revisions, ",")
+	}
+	s.logAppEvent(ctx, a, argo.EventReasonOperationStarted, fmt.Sprintf("initiated retry of failed resources at %s", revision))
+	return a, nil
+}
+
 func (s *Server) resolveSourceRevisions(ctx context.Context, a *v1alpha1.Application, syncReq *application.ApplicationSyncRequest) (string, string, []string, []string, error) {
 	if a.Spec.HasMultipleSources() {
 		numOfSources := int64(len(a.Spec.GetSources()))
@@ -2195,6 +2378,61 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 	return a, nil
 }
 
+// RestoreSnapshot starts a sync operation which reapplies the live state of the resources captured by a snapshot taken
+// before a previous sync operation. Resources created after the snapshot was taken are not affected.
+func (s *Server) RestoreSnapshot(ctx context.Context, req *application.ApplicationRestoreSnapshotRequest) (*v1alpha1.Application, error) {
+	a, proj, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionSync, req.GetProject(), req.GetAppNamespace(), req.GetName(), "")
+	if err != nil {
+		return nil, err
+	}
+	// the restored state does not come from the application source, like a local sync
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionOverride, a.RBACName(s.ns)); err != nil {
+		return nil, err
+	}
+
+	if a.DeletionTimestamp != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
+	}
+	if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.IsAutomatedSyncEnabled() && !a.Spec.SyncPolicy.Pause.IsActive(time.Now()) && !req.GetDryRun() {
+		return nil, status.Errorf(codes.FailedPrecondition, "snapshot cannot be restored when auto-sync is enabled, pause or disable it first")
+	}
+	if len(proj.Spec.SignatureKeys) > 0 || proj.Spec.OCISignatureVerification != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot restore snapshot when signature keys are required")
+	}
+
+	id := req.GetId()
+	if id == "" {
+		if a.Status.OperationState == nil || a.Status.OperationState.SyncResult == nil || a.Status.OperationState.SyncResult.Snapshot == "" {
+			return nil, status.Errorf(codes.FailedPrecondition, "the last sync operation of application %s did not take a snapshot", a.Name)
+		}
+		id = a.Status.OperationState.SyncResult.Snapshot
+	}
+
+	var syncOptions v1alpha1.SyncOptions
+	if a.Spec.SyncPolicy != nil {
+		syncOptions = a.Spec.SyncPolicy.SyncOptions
+	}
+	op := v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{
+			Revision:        a.Status.Sync.Revision,
+			Revisions:       a.Status.Sync.Revisions,
+			DryRun:          req.GetDryRun(),
+			SyncOptions:     syncOptions,
+			SyncStrategy:    &v1alpha1.SyncStrategy{Apply: &v1alpha1.SyncStrategyApply{}},
+			Sources:         a.Spec.Sources,
+			RestoreSnapshot: id,
+		},
+		InitiatedBy: v1alpha1.OperationInitiator{Username: session.Username(ctx)},
+	}
+	appIf := s.appclientset.ArgoprojV1alpha1().Applications(a.Namespace)
+	a, err = argo.SetAppOperation(appIf, a.Name, &op)
+	if err != nil {
+		return nil, fmt.Errorf("error setting app operation: %w", err)
+	}
+	s.logAppEvent(ctx, a, argo.EventReasonOperationStarted, "initiated restore of snapshot "+id)
+	return a, nil
+}
+
 func (s *Server) ListLinks(ctx context.Context, req *application.ListAppLinksRequest) (*application.LinksResponse, error) {
 	a, proj, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionGet, req.GetProject(), req.GetNamespace(), req.GetName(), "")
 	if err != nil {
@@ -2395,6 +2633,67 @@ func (s *Server) TerminateOperation(ctx context.Context, termOpReq *application.
 	return nil, status.Errorf(codes.Internal, "Failed to terminate app. Too many conflicts")
 }
 
+// Pause suspends the automated sync and self-heal of an application until the given time
+func (s *Server) Pause(ctx context.Context, q *application.ApplicationPauseRequest) (*v1alpha1.Application, error) {
+	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionPause, q.GetProject(), q.GetAppNamespace(), q.GetName(), "")
+	if err != nil {
+		return nil, err
+	}
+	until, err := time.Parse(time.RFC3339, q.GetUntil())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pause expiry %q: %v", q.GetUntil(), err)
+	}
+	now := metav1.Now()
+	if !until.After(now.Time) {
+		return nil, status.Errorf(codes.InvalidArgument, "pause expiry %s is not in the future", until.Format(time.RFC3339))
+	}
+	pause := &v1alpha1.SyncPause{
+		Until:    metav1.NewTime(until),
+		Reason:   q.GetReason(),
+		PausedBy: session.Username(ctx),
+		PausedAt: &now,
+	}
+	patch, err := json.Marshal(map[string]any{
+		"spec": map[string]any{
+			"syncPolicy": map[string]any{
+				"pause": pause,
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling sync pause: %w", err)
+	}
+	updated, err := s.appclientset.ArgoprojV1alpha1().Applications(a.Namespace).Patch(ctx, a.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error patching application with sync pause: %w", err)
+	}
+	s.waitSync(updated)
+	message := "paused automated sync until " + until.Format(time.RFC3339)
+	if pause.Reason != "" {
+		message += ": " + pause.Reason
+	}
+	s.logAppEvent(ctx, updated, argo.EventReasonAutoSyncPaused, message)
+	return updated, nil
+}
+
+// Resume resumes the automated sync of a paused application
+func (s *Server) Resume(ctx context.Context, q *application.ApplicationResumeRequest) (*v1alpha1.Application, error) {
+	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionPause, q.GetProject(), q.GetAppNamespace(), q.GetName(), "")
+	if err != nil {
+		return nil, err
+	}
+	if a.Spec.SyncPolicy.GetPause() == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "automated sync of application %s is not paused", a.Name)
+	}
+	updated, err := s.appclientset.ArgoprojV1alpha1().Applications(a.Namespace).Patch(ctx, a.Name, types.MergePatchType, []byte(`{"spec":{"syncPolicy":{"pause":null}}}`), metav1.PatchOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error removing sync pause from application: %w", err)
+	}
+	s.waitSync(updated)
+	s.logAppEvent(ctx, updated, argo.EventReasonAutoSyncResumed, "resumed automated sync")
+	return updated, nil
+}
+
 func (s *Server) logAppEvent(ctx context.Context, a *v1alpha1.Application, reason string, action string) {
 	eventInfo := argo.EventInfo{Type: corev1.EventTypeNormal, Reason: reason}
 	user := session.Username(ctx)
@@ -2734,7 +3033,10 @@ func (s *Server) GetApplicationSyncWindows(ctx context.Context, q *application.A
 		return nil, err
 	}
 
-	windows := proj.Spec.SyncWindows.Matches(a)
+	windows, err := argo.GetSyncWindows(a, proj, s.settingsMgr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sync windows: %w", err)
+	}
 	sync, err := windows.CanSync(true)
 	if err != nil {
 		return nil, fmt.Errorf("invalid sync windows: %w", err)
@@ -2786,6 +3088,9 @@ func convertSyncWindows(w *v1alpha1.SyncWindows) []*application.ApplicationSyncW
 				Duration:   &w.Duration,
 				ManualSync: &w.ManualSync,
 			}
+			if w.Description != "" {
+				nw.Description = &w.Description
+			}
 			windows = append(windows, nw)
 		}
 		if len(windows) > 0 {
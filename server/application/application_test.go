@@ -2,6 +2,7 @@ package application
 
 import (
 	"context"
+	"encoding/json"
 	stderrors "errors"
 	"fmt"
 	"io"
@@ -936,6 +937,28 @@ func TestNoAppEnumeration(t *testing.T) {
 		assert.EqualError(t, err, "rpc error: code = NotFound desc = applications.argoproj.io \"doest-not-exist\" not found", "when the request specifies a project, we can return the standard k8s error message")
 	})
 
+	t.Run("ResourceDriftHistory", func(t *testing.T) {
+		_, err := appServer.ResourceDriftHistory(adminCtx, &application.ResourcesQuery{ApplicationName: ptr.To("test")})
+		require.NoError(t, err)
+		_, err = appServer.ResourceDriftHistory(noRoleCtx, &application.ResourcesQuery{ApplicationName: ptr.To("test")})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.ResourceDriftHistory(adminCtx, &application.ResourcesQuery{ApplicationName: ptr.To("doest-not-exist")})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.ResourceDriftHistory(adminCtx, &application.ResourcesQuery{ApplicationName: ptr.To("doest-not-exist"), Project: ptr.To("test")})
+		assert.EqualError(t, err, "rpc error: code = NotFound desc = applications.argoproj.io \"doest-not-exist\" not found", "when the request specifies a project, we can return the standard k8s error message")
+	})
+
+	t.Run("ExportOrphanedResource", func(t *testing.T) {
+		_, err := appServer.ExportOrphanedResource(adminCtx, &application.ApplicationResourceRequest{Name: ptr.To("test"), ResourceName: ptr.To("test"), Kind: ptr.To("ConfigMap"), Namespace: ptr.To("test")})
+		require.EqualError(t, err, "rpc error: code = FailedPrecondition desc = adoption of orphaned resources is not enabled in project default")
+		_, err = appServer.ExportOrphanedResource(noRoleCtx, &application.ApplicationResourceRequest{Name: ptr.To("test"), ResourceName: ptr.To("test"), Kind: ptr.To("ConfigMap"), Namespace: ptr.To("test")})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.ExportOrphanedResource(adminCtx, &application.ApplicationResourceRequest{Name: ptr.To("doest-not-exist"), ResourceName: ptr.To("test"), Kind: ptr.To("ConfigMap"), Namespace: ptr.To("test")})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.ExportOrphanedResource(adminCtx, &application.ApplicationResourceRequest{Name: ptr.To("doest-not-exist"), Project: ptr.To("test"), ResourceName: ptr.To("test"), Kind: ptr.To("ConfigMap"), Namespace: ptr.To("test")})
+		assert.EqualError(t, err, "rpc error: code = NotFound desc = applications.argoproj.io \"doest-not-exist\" not found", "when the request specifies a project, we can return the standard k8s error message")
+	})
+
 	t.Run("Sync", func(t *testing.T) {
 		_, err := appServer.Sync(adminCtx, &application.ApplicationSyncRequest{Name: ptr.To("test")})
 		require.NoError(t, err)
@@ -1624,6 +1647,30 @@ func TestDeleteApp(t *testing.T) {
 		assert.True(t, deleted)
 		t.Cleanup(revertValues)
 	})
+
+	t.Run("Delete skipping pre-delete hooks", func(t *testing.T) {
+		var patchedFinalizers []string
+		fakeAppCs.PrependReactor("get", "applications", func(_ kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			return true, &v1alpha1.Application{
+				ObjectMeta: metav1.ObjectMeta{Finalizers: []string{v1alpha1.PreDeleteFinalizerName}},
+				Spec:       v1alpha1.ApplicationSpec{Source: &v1alpha1.ApplicationSource{}},
+			}, nil
+		})
+		fakeAppCs.PrependReactor("patch", "applications", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			patched = true
+			var patch map[string]map[string][]string
+			require.NoError(t, json.Unmarshal(action.(kubetesting.PatchAction).GetPatch(), &patch))
+			patchedFinalizers = patch["metadata"]["finalizers"]
+			return true, nil, nil
+		})
+		skipPreDeleteHooks := true
+		_, err = appServer.Delete(ctx, &application.ApplicationDeleteRequest{Name: &app.Name, Cascade: &falseVar, SkipPreDeleteHooks: &skipPreDeleteHooks})
+		require.NoError(t, err)
+		assert.True(t, patched)
+		assert.Empty(t, patchedFinalizers)
+		assert.True(t, deleted)
+		t.Cleanup(revertValues)
+	})
 }
 
 func TestDeleteResourcesRBAC(t *testing.T) {
@@ -1904,6 +1951,141 @@ func TestSyncGit(t *testing.T) {
 	assert.Equal(t, "Unknown user initiated sync locally", events.Items[1].Message)
 }
 
+func TestSyncRetryFailed(t *testing.T) {
+	ctx := t.Context()
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{Automated: &v1alpha1.SyncPolicyAutomated{}}
+		app.Status.OperationState = &v1alpha1.OperationState{
+			Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Revision: "HEAD", Prune: true}},
+			Phase:     synccommon.OperationFailed,
+			SyncResult: &v1alpha1.SyncOperationResult{
+				Revision: "abc",
+				Resources: v1alpha1.ResourceResults{
+					{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook", Status: synccommon.ResultCodeSyncFailed},
+					{Kind: "Service", Namespace: "default", Name: "guestbook", Status: synccommon.ResultCodeSynced},
+				},
+			},
+		}
+	})
+	appServer := newTestAppServer(t, testApp)
+
+	app, err := appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &testApp.Name, RetryFailed: ptr.To(true)})
+	require.NoError(t, err)
+	require.NotNil(t, app.Operation)
+	assert.Equal(t, "abc", app.Operation.Sync.Revision)
+	assert.True(t, app.Operation.Sync.Prune)
+	assert.Equal(t, []v1alpha1.SyncOperationResource{{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook"}}, app.Operation.Sync.Resources)
+
+	events, err := appServer.kubeclientset.CoreV1().Events(appServer.ns).List(t.Context(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "Unknown user initiated retry of failed resources at abc", events.Items[0].Message)
+
+	t.Run("Resources cannot be specified", func(t *testing.T) {
+		_, err := appServer.Sync(ctx, &application.ApplicationSyncRequest{
+			Name:        &testApp.Name,
+			RetryFailed: ptr.To(true),
+			Resources:   []*v1alpha1.SyncOperationResource{{Kind: "Service", Name: "guestbook"}},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("Last sync succeeded", func(t *testing.T) {
+		succeededApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Status.OperationState = &v1alpha1.OperationState{
+				Operation:  v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}},
+				Phase:      synccommon.OperationSucceeded,
+				SyncResult: &v1alpha1.SyncOperationResult{Revision: "abc"},
+			}
+		})
+		appServer := newTestAppServer(t, succeededApp)
+		_, err := appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &succeededApp.Name, RetryFailed: ptr.To(true)})
+		require.EqualError(t, err, "rpc error: code = FailedPrecondition desc = cannot retry failed resources: the last sync operation did not fail")
+	})
+}
+
+func TestPauseResumeApp(t *testing.T) {
+	ctx := t.Context()
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{Automated: &v1alpha1.SyncPolicyAutomated{SelfHeal: true}}
+	})
+	appServer := newTestAppServer(t, testApp)
+
+	_, err := appServer.Resume(ctx, &application.ApplicationResumeRequest{Name: &testApp.Name})
+	require.EqualError(t, err, "rpc error: code = FailedPrecondition desc = automated sync of application test-app is not paused")
+
+	_, err = appServer.Pause(ctx, &application.ApplicationPauseRequest{Name: &testApp.Name, Until: ptr.To(time.Now().Add(-time.Hour).Format(time.RFC3339))})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = appServer.Pause(ctx, &application.ApplicationPauseRequest{Name: &testApp.Name, Until: ptr.To("tomorrow")})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	until := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	app, err := appServer.Pause(ctx, &application.ApplicationPauseRequest{Name: &testApp.Name, Until: ptr.To(until.Format(time.RFC3339)), Reason: ptr.To("maintenance")})
+	require.NoError(t, err)
+	pause := app.Spec.SyncPolicy.GetPause()
+	require.NotNil(t, pause)
+	assert.True(t, until.Equal(pause.Until.Time))
+	assert.Equal(t, "maintenance", pause.Reason)
+	assert.NotNil(t, pause.PausedAt)
+	assert.True(t, app.Spec.SyncPolicy.Automated.SelfHeal)
+
+	events, err := appServer.kubeclientset.CoreV1().Events(appServer.ns).List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "Unknown user paused automated sync until "+until.Format(time.RFC3339)+": maintenance", events.Items[0].Message)
+
+	app, err = appServer.Resume(ctx, &application.ApplicationResumeRequest{Name: &testApp.Name})
+	require.NoError(t, err)
+	assert.Nil(t, app.Spec.SyncPolicy.GetPause())
+	assert.True(t, app.Spec.SyncPolicy.Automated.SelfHeal)
+}
+
+func TestUpdateAppSyncPause(t *testing.T) {
+	pause := &v1alpha1.SyncPause{Until: metav1.NewTime(time.Now().Add(time.Hour).UTC().Truncate(time.Second)), Reason: "maintenance", PausedBy: "admin"}
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{Automated: &v1alpha1.SyncPolicyAutomated{}, Pause: pause}
+	})
+	//nolint:staticcheck
+	ctx := context.WithValue(t.Context(), "claims", &jwt.RegisteredClaims{Subject: "test-user"})
+	appServer := newTestAppServer(t, testApp)
+	appServer.enf.SetDefaultRole("")
+	_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, get, default/test-app, allow
+p, test-user, applications, update, default/test-app, allow
+`)
+
+	t.Run("Patch", func(t *testing.T) {
+		app, err := appServer.Patch(ctx, &application.ApplicationPatchRequest{
+			Name: &testApp.Name, Patch: ptr.To(`{"spec": {"syncPolicy": {"pause": {"until": "2099-01-01T00:00:00Z", "pausedBy": "someone-else"}}}}`), PatchType: ptr.To("merge"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, pause, app.Spec.SyncPolicy.GetPause())
+
+		app, err = appServer.Patch(ctx, &application.ApplicationPatchRequest{
+			Name: &testApp.Name, Patch: ptr.To(`[{"op": "remove", "path": "/spec/syncPolicy/pause"}]`),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, pause, app.Spec.SyncPolicy.GetPause())
+	})
+	t.Run("Update", func(t *testing.T) {
+		updated := testApp.DeepCopy()
+		updated.Spec.SyncPolicy = nil
+		app, err := appServer.Update(ctx, &application.ApplicationUpdateRequest{Application: updated})
+		require.NoError(t, err)
+		assert.Equal(t, pause, app.Spec.SyncPolicy.GetPause())
+		assert.Nil(t, app.Spec.SyncPolicy.Automated)
+	})
+	t.Run("UpdateWithPausePermission", func(t *testing.T) {
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, get, default/test-app, allow
+p, test-user, applications, update, default/test-app, allow
+p, test-user, applications, pause, default/test-app, allow
+`)
+		spec := testApp.Spec.DeepCopy()
+		spec.SyncPolicy = nil
+		updated, err := appServer.UpdateSpec(ctx, &application.ApplicationUpdateSpecRequest{Name: &testApp.Name, Spec: spec})
+		require.NoError(t, err)
+		assert.Nil(t, updated.SyncPolicy.GetPause())
+	})
+}
+
 func TestRollbackApp(t *testing.T) {
 	testApp := newTestApp()
 	testApp.Status.History = []v1alpha1.RevisionHistory{{
@@ -1926,6 +2108,50 @@ func TestRollbackApp(t *testing.T) {
 	assert.Equal(t, "abc", updatedApp.Operation.Sync.Revision)
 }
 
+func TestRestoreSnapshot(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("AutoSyncEnabled", func(t *testing.T) {
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{Automated: &v1alpha1.SyncPolicyAutomated{}}
+		})
+		appServer := newTestAppServer(t, testApp)
+		_, err := appServer.RestoreSnapshot(ctx, &application.ApplicationRestoreSnapshotRequest{Name: &testApp.Name, Id: ptr.To("20250304050607")})
+		require.EqualError(t, err, "rpc error: code = FailedPrecondition desc = snapshot cannot be restored when auto-sync is enabled, pause or disable it first")
+	})
+	t.Run("NoSnapshot", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+		_, err := appServer.RestoreSnapshot(ctx, &application.ApplicationRestoreSnapshotRequest{Name: &testApp.Name})
+		require.EqualError(t, err, "rpc error: code = FailedPrecondition desc = the last sync operation of application test-app did not take a snapshot")
+	})
+	t.Run("LastSnapshot", func(t *testing.T) {
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{
+				Automated: &v1alpha1.SyncPolicyAutomated{},
+				Pause:     &v1alpha1.SyncPause{Until: metav1.NewTime(time.Now().Add(time.Hour))},
+			}
+			app.Status.Sync.Revision = "abc"
+			app.Status.OperationState = &v1alpha1.OperationState{
+				Phase:      synccommon.OperationFailed,
+				SyncResult: &v1alpha1.SyncOperationResult{Revision: "abc", Snapshot: "20250304050607"},
+			}
+		})
+		appServer := newTestAppServer(t, testApp)
+		updatedApp, err := appServer.RestoreSnapshot(ctx, &application.ApplicationRestoreSnapshotRequest{Name: &testApp.Name})
+		require.NoError(t, err)
+		require.NotNil(t, updatedApp.Operation)
+		require.NotNil(t, updatedApp.Operation.Sync)
+		assert.Equal(t, "20250304050607", updatedApp.Operation.Sync.RestoreSnapshot)
+		assert.Equal(t, "abc", updatedApp.Operation.Sync.Revision)
+		assert.False(t, updatedApp.Operation.Sync.Prune)
+
+		events, err := appServer.kubeclientset.CoreV1().Events(appServer.ns).List(ctx, metav1.ListOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "Unknown user initiated restore of snapshot 20250304050607", events.Items[0].Message)
+	})
+}
+
 func TestUpdateAppProject(t *testing.T) {
 	testApp := newTestApp()
 	ctx := t.Context()
@@ -2409,6 +2635,77 @@ func TestGetAppRefresh_HardRefresh(t *testing.T) {
 	}
 }
 
+func TestGetManifests_HelmValuesFrom(t *testing.T) {
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Spec.Source.Helm = &v1alpha1.ApplicationSourceHelm{
+			ValuesFrom: []v1alpha1.HelmValuesFromSource{{Kind: v1alpha1.HelmValuesFromKindSecret, Name: "db-values"}},
+		}
+	})
+	appServer := newTestAppServer(t, testApp)
+	_, err := appServer.kubeclientset.CoreV1().Secrets(testApp.Namespace).Create(t.Context(), &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "db-values", Namespace: testApp.Namespace, Labels: map[string]string{common.LabelKeyHelmValues: "true"}},
+		Data:       map[string][]byte{"values.yaml": []byte("password: s3cr3t-password\n")},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	var manifestRequest *apiclient.ManifestRequest
+	mockRepoServiceClient := mocks.RepoServerServiceClient{}
+	mockRepoServiceClient.On("GenerateManifest", mock.Anything, mock.MatchedBy(func(q *apiclient.ManifestRequest) bool {
+		manifestRequest = q
+		return true
+	})).Return(&apiclient.ManifestResponse{Manifests: []string{
+		`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"db"},"data":{"password":"s3cr3t-password"}}`,
+	}}, nil)
+	appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: &mockRepoServiceClient}
+
+	res, err := appServer.GetManifests(t.Context(), &application.ApplicationManifestQuery{Name: &testApp.Name})
+	require.NoError(t, err)
+	require.NotNil(t, manifestRequest)
+	assert.Equal(t, []string{"password: s3cr3t-password\n"}, manifestRequest.HelmValuesFrom)
+	require.Len(t, res.Manifests, 1)
+	assert.JSONEq(t, `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"db"},"data":{"password":"++++++++"}}`, res.Manifests[0])
+
+	// the Secrets are not resolved for another revision than the target revision
+	manifestRequest = nil
+	_, err = appServer.GetManifests(t.Context(), &application.ApplicationManifestQuery{Name: &testApp.Name, Revision: ptr.To("feature")})
+	require.NoError(t, err)
+	require.NotNil(t, manifestRequest)
+	assert.Empty(t, manifestRequest.HelmValuesFrom)
+	assert.Empty(t, manifestRequest.ApplicationSource.Helm.ValuesFrom)
+}
+
+func TestGetManifests_HistoryId(t *testing.T) {
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Status.History = v1alpha1.RevisionHistories{{
+			ID:       1,
+			Revision: "abc123",
+			Source:   v1alpha1.ApplicationSource{RepoURL: fakeRepoURL, Path: "old-path", TargetRevision: "HEAD"},
+		}}
+	})
+	appServer := newTestAppServer(t, testApp)
+
+	var manifestRequest *apiclient.ManifestRequest
+	mockRepoServiceClient := mocks.RepoServerServiceClient{}
+	mockRepoServiceClient.On("GenerateManifest", mock.Anything, mock.MatchedBy(func(q *apiclient.ManifestRequest) bool {
+		manifestRequest = q
+		return true
+	})).Return(&apiclient.ManifestResponse{
+		Manifests:  []string{`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"cm"}}`},
+		Provenance: []string{"envelope"},
+	}, nil)
+	appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: &mockRepoServiceClient}
+
+	res, err := appServer.GetManifests(t.Context(), &application.ApplicationManifestQuery{Name: &testApp.Name, HistoryId: ptr.To(int64(1))})
+	require.NoError(t, err)
+	require.NotNil(t, manifestRequest)
+	assert.Equal(t, "old-path", manifestRequest.ApplicationSource.Path)
+	assert.Equal(t, "abc123", manifestRequest.Revision)
+	assert.Equal(t, []string{"envelope"}, res.Provenance)
+
+	_, err = appServer.GetManifests(t.Context(), &application.ApplicationManifestQuery{Name: &testApp.Name, HistoryId: ptr.To(int64(2))})
+	require.ErrorContains(t, err, "does not have deployment with id 2")
+}
+
 func TestInferResourcesStatusHealth(t *testing.T) {
 	cacheClient := cache.NewCache(cache.NewInMemoryCache(1 * time.Hour))
 
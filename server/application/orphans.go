@@ -0,0 +1,111 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+)
+
+// ExportOrphanedResource returns the manifest of an orphaned resource, along with a git patch adding it to the
+// application source repository, so that it can be adopted by the application
+func (s *Server) ExportOrphanedResource(ctx context.Context, q *application.ApplicationResourceRequest) (*application.OrphanedResourceExportResponse, error) {
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if proj.Spec.OrphanedResources == nil || !proj.Spec.OrphanedResources.IsAdopt() {
+		return nil, status.Errorf(codes.FailedPrecondition, "adoption of orphaned resources is not enabled in project %s", proj.Name)
+	}
+
+	tree, err := s.getAppResources(ctx, a)
+	if err != nil {
+		return nil, fmt.Errorf("error getting app resources: %w", err)
+	}
+	var found *v1alpha1.ResourceNode
+	for i, node := range tree.OrphanedNodes {
+		if node.Group == q.GetGroup() && node.Kind == q.GetKind() && node.Namespace == q.GetNamespace() && node.Name == q.GetResourceName() {
+			found = &tree.OrphanedNodes[i]
+			break
+		}
+	}
+	if found == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s %s %s not found as orphaned resource of application %s", q.GetKind(), q.GetGroup(), q.GetResourceName(), q.GetName())
+	}
+	if q.GetVersion() != "" {
+		found.Version = q.GetVersion()
+	}
+	config, err := s.getApplicationClusterConfig(ctx, a)
+	if err != nil {
+		return nil, fmt.Errorf("error getting application cluster config: %w", err)
+	}
+	obj, err := s.kubectl.GetResource(ctx, config, found.GroupKindVersion(), found.Name, found.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource: %w", err)
+	}
+	obj, err = s.replaceSecretValues(obj)
+	if err != nil {
+		return nil, fmt.Errorf("error replacing secret values: %w", err)
+	}
+	data, err := yaml.Marshal(cleanOrphanedResource(obj).Object)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling object: %w", err)
+	}
+	manifest := string(data)
+	res := &application.OrphanedResourceExportResponse{Manifest: &manifest}
+	if source := getAdoptionSource(a); source != nil {
+		manifestPath := path.Join(source.Path, fmt.Sprintf("%s-%s.yaml", strings.ToLower(obj.GetKind()), obj.GetName()))
+		patch := newFilePatch(manifestPath, manifest)
+		res.Path = &manifestPath
+		res.Patch = &patch
+	}
+	return res, nil
+}
+
+// cleanOrphanedResource removes the fields populated by the API server from the given live object
+func cleanOrphanedResource(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	obj = obj.DeepCopy()
+	for _, field := range []string{"uid", "resourceVersion", "generation", "creationTimestamp", "deletionTimestamp", "deletionGracePeriodSeconds", "managedFields", "selfLink", "ownerReferences"} {
+		unstructured.RemoveNestedField(obj.Object, "metadata", field)
+	}
+	unstructured.RemoveNestedField(obj.Object, "metadata", "annotations", "kubectl.kubernetes.io/last-applied-configuration")
+	if len(obj.GetAnnotations()) == 0 {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "annotations")
+	}
+	unstructured.RemoveNestedField(obj.Object, "status")
+	return obj
+}
+
+// getAdoptionSource returns the first git source of the application with a path, to which adopted resources are added
+func getAdoptionSource(a *v1alpha1.Application) *v1alpha1.ApplicationSource {
+	for _, source := range a.Spec.GetSources() {
+		if source.Path != "" && !source.IsHelm() && !source.IsOCI() && !source.IsArchive() {
+			return &source
+		}
+	}
+	return nil
+}
+
+// newFilePatch returns a git patch creating a new file with the given content
+func newFilePatch(filePath string, content string) string {
+	lines := strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "diff --git a/%s b/%s\n", filePath, filePath)
+	sb.WriteString("new file mode 100644\n")
+	sb.WriteString("--- /dev/null\n")
+	fmt.Fprintf(&sb, "+++ b/%s\n", filePath)
+	fmt.Fprintf(&sb, "@@ -0,0 +1,%d @@\n", len(lines))
+	for _, line := range lines {
+		sb.WriteString("+" + line + "\n")
+	}
+	return sb.String()
+}
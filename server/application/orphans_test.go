@@ -0,0 +1,61 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func TestCleanOrphanedResource(t *testing.T) {
+	obj := test.NewConfigMap()
+	obj.SetUID("4e1f4a5c-6b4f-4b2b-9b0a-0d9b6c4e3f21")
+	obj.SetResourceVersion("123")
+	obj.SetCreationTimestamp(metav1.Now())
+	obj.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "kubectl"}})
+	obj.SetAnnotations(map[string]string{"kubectl.kubernetes.io/last-applied-configuration": "{}"})
+	require.NoError(t, unstructured.SetNestedField(obj.Object, "Ready", "status", "phase"))
+
+	cleaned := cleanOrphanedResource(obj)
+	assert.Empty(t, cleaned.GetUID())
+	assert.Empty(t, cleaned.GetResourceVersion())
+	assert.Empty(t, cleaned.GetManagedFields())
+	assert.NotContains(t, cleaned.Object["metadata"], "creationTimestamp")
+	assert.NotContains(t, cleaned.Object["metadata"], "annotations")
+	assert.NotContains(t, cleaned.Object, "status")
+	assert.Equal(t, obj.GetName(), cleaned.GetName())
+	// the original object is left untouched
+	assert.Equal(t, "123", obj.GetResourceVersion())
+}
+
+func TestGetAdoptionSource(t *testing.T) {
+	app := newTestApp()
+	app.Spec.Source = nil
+	app.Spec.Sources = v1alpha1.ApplicationSources{
+		{RepoURL: "https://charts.example.com", Chart: "nginx", TargetRevision: "1.0.0"},
+		{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Path: "guestbook"},
+	}
+	source := getAdoptionSource(app)
+	require.NotNil(t, source)
+	assert.Equal(t, "guestbook", source.Path)
+
+	app.Spec.Sources = v1alpha1.ApplicationSources{{RepoURL: "https://charts.example.com", Chart: "nginx", TargetRevision: "1.0.0"}}
+	assert.Nil(t, getAdoptionSource(app))
+}
+
+func TestNewFilePatch(t *testing.T) {
+	patch := newFilePatch("guestbook/configmap-my-map.yaml", "apiVersion: v1\nkind: ConfigMap\n")
+	assert.Equal(t, `diff --git a/guestbook/configmap-my-map.yaml b/guestbook/configmap-my-map.yaml
+new file mode 100644
+--- /dev/null
++++ b/guestbook/configmap-my-map.yaml
+@@ -0,0 +1,2 @@
++apiVersion: v1
++kind: ConfigMap
+`, patch)
+}
@@ -63,6 +63,10 @@ func (c *Cache) GetAppManagedResources(appName string, res *[]*appv1.ResourceDif
 	return c.cache.GetAppManagedResources(appName, res)
 }
 
+func (c *Cache) GetAppResourceDriftHistory(appName string, res *[]*appv1.ResourceDriftEpisode) error {
+	return c.cache.GetAppResourceDriftHistory(appName, res)
+}
+
 func (c *Cache) SetRepoConnectionState(repo string, project string, state *appv1.ConnectionState) error {
 	return c.cache.SetItem(repoConnectionStateKey(repo, project), &state, c.connectionStatusCacheExpiration, state == nil)
 }
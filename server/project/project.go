@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/argoproj/pkg/v2/sync"
@@ -508,6 +509,39 @@ func (s *Server) logEvent(ctx context.Context, a *v1alpha1.AppProject, reason st
 	s.auditLogger.LogAppProjEvent(a, eventInfo, message, user)
 }
 
+// Freeze adds a deny sync window to a project, which blocks the syncs until the given time
+func (s *Server) Freeze(ctx context.Context, q *project.ProjectFreezeRequest) (*v1alpha1.AppProject, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceProjects, rbac.ActionFreeze, q.Name); err != nil {
+		return nil, err
+	}
+	until, err := time.Parse(time.RFC3339, q.Until)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid freeze expiry %q: %v", q.Until, err)
+	}
+
+	s.projectLock.Lock(q.Name)
+	defer s.projectLock.Unlock(q.Name)
+
+	var res *v1alpha1.AppProject
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		proj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, q.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		proj.Spec.RemoveExpiredWindows()
+		if err := proj.Spec.AddFreezeWindow(until, q.Reason, q.Applications, q.Namespaces, q.Clusters); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		res, err = s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Update(ctx, proj, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.logEvent(ctx, res, argo.EventReasonResourceUpdated, "froze syncs until "+until.UTC().Format(time.RFC3339))
+	return res, nil
+}
+
 func (s *Server) GetSyncWindowsState(ctx context.Context, q *project.SyncWindowsQuery) (*project.SyncWindowsResponse, error) {
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceProjects, rbac.ActionGet, q.Name); err != nil {
 		return nil, err
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/db"
@@ -727,6 +728,48 @@ p, role:admin, projects, update, *, allow`)
 		assert.Nil(t, res)
 	})
 
+	t.Run("TestFreeze", func(t *testing.T) {
+		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, session.NewUserStateStorage(nil))
+		projectWithSyncWindows := existingProj.DeepCopy()
+		expired := metav1.NewTime(time.Now().Add(-time.Hour))
+		projectWithSyncWindows.Spec.SyncWindows = v1alpha1.SyncWindows{
+			{Kind: "allow", Schedule: "* * * * *", Duration: "1h"},
+			{Kind: "deny", Applications: []string{"*"}, ExpiresAt: &expired},
+		}
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projectWithSyncWindows), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		until := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+		res, err := projectServer.Freeze(ctx, &project.ProjectFreezeRequest{Name: projectWithSyncWindows.Name, Until: until.Format(time.RFC3339), Reason: "Incident 123"})
+		require.NoError(t, err)
+		require.Len(t, res.Spec.SyncWindows, 2)
+		assert.Equal(t, "allow", res.Spec.SyncWindows[0].Kind)
+		freeze := res.Spec.SyncWindows[1]
+		assert.Equal(t, "deny", freeze.Kind)
+		assert.Equal(t, []string{"*"}, freeze.Applications)
+		assert.Equal(t, "Incident 123", freeze.Description)
+		assert.True(t, until.Equal(freeze.ExpiresAt.Time))
+
+		_, err = projectServer.Freeze(ctx, &project.ProjectFreezeRequest{Name: projectWithSyncWindows.Name, Until: "tomorrow"})
+		require.ErrorContains(t, err, "invalid freeze expiry")
+
+		_, err = projectServer.Freeze(ctx, &project.ProjectFreezeRequest{Name: projectWithSyncWindows.Name, Until: time.Now().Add(-time.Hour).Format(time.RFC3339)})
+		require.ErrorContains(t, err, "is in the past")
+	})
+
+	t.Run("TestFreezeDenied", func(t *testing.T) {
+		enforcer := newEnforcer(kubeclientset)
+		_ = enforcer.SetBuiltinPolicy(`p, role:admin, projects, update, *, allow`)
+		enforcer.SetClaimsEnforcerFunc(nil)
+		//nolint:staticcheck
+		ctx := context.WithValue(t.Context(), "claims", &jwt.MapClaims{"groups": []string{"my-group"}})
+
+		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, session.NewUserStateStorage(nil))
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(existingProj.DeepCopy()), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		_, err := projectServer.Freeze(ctx, &project.ProjectFreezeRequest{Name: existingProj.Name, Until: time.Now().Add(time.Hour).Format(time.RFC3339)})
+		assert.EqualError(t, err, "rpc error: code = PermissionDenied desc = permission denied: projects, freeze, test")
+	})
+
 	t.Run("TestGetSyncWindowsStateDenied", func(t *testing.T) {
 		enforcer = newEnforcer(kubeclientset)
 		_ = enforcer.SetBuiltinPolicy(`p, *, *, *, *, deny`)
@@ -200,6 +200,6 @@ func TestFailOnCreatePrivateNonGitRepoWithBearerToken(t *testing.T) {
 			"--tls-client-cert-key-path", repos.CertKeyPath(t),
 			"--name", "testrepo",
 			"--type", "helm")
-		require.ErrorContains(t, err, "--bearer-token is only supported for Git repositories")
+		require.ErrorContains(t, err, "--bearer-token is only supported for Git and archive repositories")
 	})
 }
@@ -21,7 +21,8 @@ const appTypes = new Array<{field: string; type: models.AppSourceType}>(
     {type: 'Helm', field: 'helm'},
     {type: 'Kustomize', field: 'kustomize'},
     {type: 'Directory', field: 'directory'},
-    {type: 'Plugin', field: 'plugin'}
+    {type: 'Plugin', field: 'plugin'},
+    {type: 'CUE', field: 'cue'}
 );
 
 const DEFAULT_APP: Partial<models.Application> = {
@@ -585,6 +586,9 @@ export const ApplicationCreatePanel = (props: {
                                                         case 'Plugin':
                                                             details = {type, path: details.path, plugin: {name: '', env: []}};
                                                             break;
+                                                        case 'CUE':
+                                                            details = {type, path: details.path, cue: {tags: []}};
+                                                            break;
                                                         // Directory
                                                         default:
                                                             details = {type, path: details.path, directory: {}};
@@ -359,6 +359,9 @@ export const ApplicationParameters = (props: {
                             if (updatedSrc && updatedSrc.kustomize?.images) {
                                 updatedSrc.kustomize.images = updatedSrc.kustomize.images.filter(isDefinedWithVersion);
                             }
+                            if (updatedSrc && updatedSrc.cue?.tags) {
+                                updatedSrc.cue.tags = updatedSrc.cue.tags.filter(isDefined);
+                            }
 
                             let params = input.spec?.source?.plugin?.parameters;
                             if (params) {
@@ -500,6 +503,9 @@ export const ApplicationParameters = (props: {
                         if (appSrc.kustomize && appSrc.kustomize.images) {
                             appSrc.kustomize.images = appSrc.kustomize.images.filter(isDefinedWithVersion);
                         }
+                        if (appSrc.cue && appSrc.cue.tags) {
+                            appSrc.cue.tags = appSrc.cue.tags.filter(isDefined);
+                        }
 
                         let params = input.spec?.sources[ind]?.plugin?.parameters;
                         if (params) {
@@ -847,6 +853,42 @@ function gatherDetails(
                 })
             )
         );
+    } else if (repoDetails.type === 'CUE' && repoDetails.cue) {
+        attributes.push({
+            title: 'PACKAGE',
+            view: source.cue && source.cue.package,
+            edit: (formApi: FormApi) => <FormField formApi={formApi} field={isMultiSource ? 'spec.sources[' + ind + '].cue.package' : 'spec.source.cue.package'} component={Text} />
+        });
+        attributes.push({
+            title: 'EXPRESSION',
+            view: source.cue && source.cue.expression,
+            edit: (formApi: FormApi) => (
+                <FormField formApi={formApi} field={isMultiSource ? 'spec.sources[' + ind + '].cue.expression' : 'spec.source.cue.expression'} component={Text} />
+            )
+        });
+        const tagsByName = new Map<string, models.CUETag>();
+        (repoDetails.cue.tags || []).forEach(tag => tagsByName.set(tag.name, tag));
+        const overridesByName = new Map<string, number>();
+        ((source.cue && source.cue.tags) || []).forEach((override, i) => overridesByName.set(override.name, i));
+        attributes = attributes.concat(
+            getParamsEditableItems(
+                app,
+                'TAGS',
+                isMultiSource ? 'spec.sources[' + ind + '].cue.tags' : 'spec.source.cue.tags',
+                removedOverrides,
+                setRemovedOverrides,
+                distinct(tagsByName.keys(), overridesByName.keys()).map(name => {
+                    const tag = tagsByName.get(name);
+                    const original = (tag && tag.value) || '';
+                    let overrideIndex = overridesByName.get(name);
+                    if (overrideIndex === undefined) {
+                        overrideIndex = -1;
+                    }
+                    const value = (overrideIndex > -1 && source.cue.tags[overrideIndex].value) || original;
+                    return {overrideIndex, original, metadata: {name, value}};
+                })
+            )
+        );
     } else if (repoDetails.type === 'Plugin') {
         attributes.push({
             title: 'NAME',
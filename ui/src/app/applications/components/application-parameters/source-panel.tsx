@@ -15,7 +15,8 @@ const appTypes = new Array<{field: string; type: models.AppSourceType}>(
     {type: 'Helm', field: 'helm'},
     {type: 'Kustomize', field: 'kustomize'},
     {type: 'Directory', field: 'directory'},
-    {type: 'Plugin', field: 'plugin'}
+    {type: 'Plugin', field: 'plugin'},
+    {type: 'CUE', field: 'cue'}
 );
 
 // This is similar to the same function in application-create-panel.tsx. If the create panel
@@ -370,6 +371,9 @@ export const SourcePanel = (props: {
                                                     case 'Plugin':
                                                         details = {type, path: details.path, plugin: {name: '', env: []}};
                                                         break;
+                                                    case 'CUE':
+                                                        details = {type, path: details.path, cue: {tags: []}};
+                                                        break;
                                                     // Directory
                                                     default:
                                                         details = {type, path: details.path, directory: {}};
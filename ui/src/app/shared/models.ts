@@ -223,6 +223,8 @@ export interface ApplicationSource {
 
     directory?: ApplicationSourceDirectory;
 
+    cue?: ApplicationSourceCUE;
+
     ref?: string;
 
     name?: string;
@@ -253,10 +255,18 @@ export interface ApplicationSourceHelm {
     valueFiles: string[];
     values?: string;
     valuesObject?: any;
+    valuesFrom?: HelmValuesFromSource[];
     parameters: HelmParameter[];
     fileParameters: HelmFileParameter[];
 }
 
+export interface HelmValuesFromSource {
+    kind: 'ConfigMap' | 'Secret';
+    name: string;
+    key?: string;
+    optional?: boolean;
+}
+
 export interface ApplicationSourceKustomize {
     namePrefix: string;
     nameSuffix: string;
@@ -264,6 +274,18 @@ export interface ApplicationSourceKustomize {
     version: string;
     namespace: string;
 }
+export interface CUETag {
+    name: string;
+    value: string;
+}
+
+export interface ApplicationSourceCUE {
+    package?: string;
+    expression?: string;
+    tags?: CUETag[];
+    values?: any;
+}
+
 export interface EnvEntry {
     name: string;
     value: string;
@@ -669,7 +691,7 @@ export interface HelmChart {
     versions: string[];
 }
 
-export type AppSourceType = 'Helm' | 'Kustomize' | 'Directory' | 'Plugin';
+export type AppSourceType = 'Helm' | 'Kustomize' | 'Directory' | 'Plugin' | 'CUE';
 
 export interface RepoAppDetails {
     type: AppSourceType;
@@ -677,6 +699,7 @@ export interface RepoAppDetails {
     helm?: HelmAppSpec;
     kustomize?: KustomizeAppSpec;
     plugin?: PluginAppSpec;
+    cue?: CUEAppSpec;
     directory?: {};
 }
 
@@ -715,6 +738,10 @@ export interface KustomizeAppSpec {
     namespace?: string;
 }
 
+export interface CUEAppSpec {
+    tags?: CUETag[];
+}
+
 export interface PluginAppSpec {
     name: string;
     env: EnvEntry[];
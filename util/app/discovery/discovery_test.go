@@ -15,6 +15,7 @@ func TestDiscover(t *testing.T) {
 	assert.Equal(t, map[string]string{
 		"foo": "Kustomize",
 		"baz": "Helm",
+		"qux": "CUE",
 	}, apps)
 }
 
@@ -27,6 +28,10 @@ func TestAppType(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "Helm", appType)
 
+	appType, err = AppType(t.Context(), "./testdata/qux", "./testdata", map[string]bool{}, []string{}, []string{})
+	require.NoError(t, err)
+	assert.Equal(t, "CUE", appType)
+
 	appType, err = AppType(t.Context(), "./testdata", "./testdata", map[string]bool{}, []string{}, []string{})
 	require.NoError(t, err)
 	assert.Equal(t, "Directory", appType)
@@ -36,6 +41,7 @@ func TestAppType_Disabled(t *testing.T) {
 	enableManifestGeneration := map[string]bool{
 		string(v1alpha1.ApplicationSourceTypeKustomize): false,
 		string(v1alpha1.ApplicationSourceTypeHelm):      false,
+		string(v1alpha1.ApplicationSourceTypeCUE):       false,
 	}
 	appType, err := AppType(t.Context(), "./testdata/foo", "./testdata", enableManifestGeneration, []string{}, []string{})
 	require.NoError(t, err)
@@ -45,6 +51,10 @@ func TestAppType_Disabled(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "Directory", appType)
 
+	appType, err = AppType(t.Context(), "./testdata/qux", "./testdata", enableManifestGeneration, []string{}, []string{})
+	require.NoError(t, err)
+	assert.Equal(t, "Directory", appType)
+
 	appType, err = AppType(t.Context(), "./testdata", "./testdata", enableManifestGeneration, []string{}, []string{})
 	require.NoError(t, err)
 	assert.Equal(t, "Directory", appType)
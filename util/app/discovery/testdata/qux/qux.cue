@@ -0,0 +1,5 @@
+package qux
+
+apiVersion: "v1"
+kind:       "ConfigMap"
+metadata: name: "qux"
@@ -0,0 +1,622 @@
+package archive
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/argoproj/pkg/sync"
+
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/io/files"
+	"github.com/argoproj/argo-cd/v3/util/proxy"
+)
+
+const (
+	// RevisionPlaceholder is replaced with the target revision in the URL of an archive repository
+	RevisionPlaceholder = "{{revision}}"
+	// ChecksumSuffix is the suffix of the file next to an archive, which contains its SHA-256 checksum
+	ChecksumSuffix = ".sha256"
+	// SignatureSuffix is the suffix of the optional file next to an archive, which contains its detached ASCII-armored
+	// GnuPG signature
+	SignatureSuffix = ".asc"
+
+	// digestPrefix is the prefix of the SHA-256 digest of the content of an archive in a resolved revision
+	digestPrefix = "sha256:"
+
+	// maxSidecarSize is the maximum size of the checksum and signature files
+	maxSidecarSize = 64 * 1024
+)
+
+var (
+	globalLock = sync.NewKeyLock()
+
+	// revisionRegex matches the revisions which can be substituted in the URL without changing its path
+	revisionRegex = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9._+-]*$`)
+
+	errNotFound = errors.New("not found")
+)
+
+var _ Client = &nativeArchiveClient{}
+
+// Client is a client for repositories of versioned archives of manifests, which are fetched over HTTP(S) or from an
+// S3 compatible endpoint.
+type Client interface {
+	// ResolveRevision returns the revision pinned to the SHA-256 digest of the content of its archive, in the form
+	// <revision>@sha256:<digest>, or sha256:<digest> if the revision is empty. The digest is read from the checksum
+	// published next to the archive. A revision which is pinned already is returned unchanged.
+	ResolveRevision(ctx context.Context, revision string) (string, error)
+
+	// CleanCache is invoked on a hard-refresh or when the manifest cache has expired. This removes the archive from
+	// the cached path, which is looked up by the specified resolved revision.
+	CleanCache(revision string) error
+
+	// Extract downloads the archive of the specified revision, unless it is cached, and verifies its checksum against
+	// the digest the revision is pinned to, or against the published checksum if the revision is not pinned. If
+	// successful, the contents are extracted to a randomized tempdir.
+	Extract(ctx context.Context, revision string) (string, utilio.Closer, error)
+
+	// VerifySignature verifies the detached signature published next to the archive of the specified revision and
+	// returns the output of the verification, or an empty string if the archive is not signed.
+	VerifySignature(ctx context.Context, revision string) (string, error)
+
+	// TestRepo verifies the connectivity and accessibility of the repository.
+	TestRepo(ctx context.Context) (bool, error)
+}
+
+type Creds struct {
+	Username           string
+	Password           string
+	BearerToken        string
+	CAPath             string
+	CertData           []byte
+	KeyData            []byte
+	InsecureSkipVerify bool
+}
+
+type ClientOpts func(c *nativeArchiveClient)
+
+func WithArchivePaths(repoCachePaths utilio.TempPaths) ClientOpts {
+	return func(c *nativeArchiveClient) {
+		c.repoCachePaths = repoCachePaths
+	}
+}
+
+func WithManifestMaxExtractedSize(manifestMaxExtractedSize int64) ClientOpts {
+	return func(c *nativeArchiveClient) {
+		c.manifestMaxExtractedSize = manifestMaxExtractedSize
+	}
+}
+
+func WithDisableManifestMaxExtractedSize(disableManifestMaxExtractedSize bool) ClientOpts {
+	return func(c *nativeArchiveClient) {
+		c.disableManifestMaxExtractedSize = disableManifestMaxExtractedSize
+	}
+}
+
+// WithSignatureVerifier sets the function verifying the detached signature of an archive, which returns output
+// in the format of "git verify-commit"
+func WithSignatureVerifier(verifier func(signatureFile string, dataFile string) (string, error)) ClientOpts {
+	return func(c *nativeArchiveClient) {
+		c.signatureVerifier = verifier
+	}
+}
+
+// IsArchiveURL returns true if the URL refers to an archive of manifests, i.e. an S3 object or a .tar.gz, .tgz or
+// .zip file served over HTTP(S)
+func IsArchiveURL(repoURL string) bool {
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return false
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "s3":
+		return true
+	case "http", "https":
+		return isZip(u) || isTgz(u)
+	}
+	return false
+}
+
+func isZip(u *url.URL) bool {
+	return strings.HasSuffix(strings.ToLower(u.Path), ".zip")
+}
+
+func isTgz(u *url.URL) bool {
+	p := strings.ToLower(u.Path)
+	return strings.HasSuffix(p, ".tar.gz") || strings.HasSuffix(p, ".tgz")
+}
+
+func NewClient(repoURL string, creds Creds, proxyURL, noProxy string, opts ...ClientOpts) (Client, error) {
+	return NewClientWithLock(repoURL, creds, globalLock, proxyURL, noProxy, opts...)
+}
+
+func NewClientWithLock(repoURL string, creds Creds, repoLock sync.KeyLock, proxyURL, noProxy string, opts ...ClientOpts) (Client, error) {
+	u, err := url.Parse(strings.ReplaceAll(repoURL, RevisionPlaceholder, "revision"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse archive repo url: %w", err)
+	}
+
+	tlsConf, err := newTLSConfig(creds)
+	if err != nil {
+		return nil, fmt.Errorf("failed setup tlsConfig: %w", err)
+	}
+	client := &http.Client{
+		Transport: &http.Transport{
+			Proxy:             proxy.GetCallback(proxyURL, noProxy),
+			TLSClientConfig:   tlsConf,
+			DisableKeepAlives: true,
+		},
+	}
+
+	var f fetcher
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https":
+		f = &httpFetcher{client: client, creds: creds}
+	case "s3":
+		f, err = newS3Fetcher(u, creds, client)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported scheme %q of archive repo url, expected http, https or s3", u.Scheme)
+	}
+	return newClientWithLock(repoURL, repoLock, f, opts...), nil
+}
+
+func newClientWithLock(repoURL string, repoLock sync.KeyLock, f fetcher, opts ...ClientOpts) *nativeArchiveClient {
+	c := &nativeArchiveClient{
+		repoURL:  repoURL,
+		repoLock: repoLock,
+		fetcher:  f,
+	}
+	for i := range opts {
+		opts[i](c)
+	}
+	return c
+}
+
+// nativeArchiveClient implements Client interface using net/http and the AWS SDK
+type nativeArchiveClient struct {
+	repoURL                         string
+	fetcher                         fetcher
+	repoLock                        sync.KeyLock
+	repoCachePaths                  utilio.TempPaths
+	manifestMaxExtractedSize        int64
+	disableManifestMaxExtractedSize bool
+	signatureVerifier               func(signatureFile string, dataFile string) (string, error)
+}
+
+func (c *nativeArchiveClient) isTemplate() bool {
+	return strings.Contains(c.repoURL, RevisionPlaceholder)
+}
+
+// archiveURL returns the URL of the archive of the specified revision
+func (c *nativeArchiveClient) archiveURL(revision string) (*url.URL, error) {
+	if !c.isTemplate() {
+		return url.Parse(c.repoURL)
+	}
+	if revision == "" {
+		return nil, fmt.Errorf("a revision is required by archive repo url %s", c.repoURL)
+	}
+	if !revisionRegex.MatchString(revision) {
+		return nil, fmt.Errorf("invalid archive revision %q", revision)
+	}
+	return url.Parse(strings.ReplaceAll(c.repoURL, RevisionPlaceholder, revision))
+}
+
+// TestRepo verifies that the archive can be downloaded or, if the URL is a template, that the server can be connected
+// to.
+func (c *nativeArchiveClient) TestRepo(ctx context.Context) (bool, error) {
+	if !c.isTemplate() {
+		u, err := c.archiveURL("")
+		if err != nil {
+			return false, err
+		}
+		err = c.fetcher.head(ctx, u)
+		if errors.Is(err, errNotFound) {
+			return false, fmt.Errorf("archive %s does not exist", u.Redacted())
+		}
+		return err == nil, err
+	}
+	u, err := url.Parse(strings.ReplaceAll(c.repoURL, RevisionPlaceholder, "revision"))
+	if err != nil {
+		return false, err
+	}
+	err = c.fetcher.ping(ctx, u)
+	return err == nil, err
+}
+
+func (c *nativeArchiveClient) ResolveRevision(ctx context.Context, revision string) (string, error) {
+	name, digest, err := splitRevision(revision)
+	if err != nil {
+		return "", err
+	}
+	u, err := c.archiveURL(name)
+	if err != nil {
+		return "", err
+	}
+	if digest != "" {
+		return revision, nil
+	}
+	digest, err = c.getChecksum(ctx, u)
+	if err != nil {
+		return "", fmt.Errorf("cannot get checksum of archive for revision %s: %w", revision, err)
+	}
+	if name == "" {
+		return digestPrefix + digest, nil
+	}
+	return name + "@" + digestPrefix + digest, nil
+}
+
+// splitRevision splits a revision returned by ResolveRevision into the revision of the archive and the digest of its
+// content. The digest is empty if the revision is not pinned.
+func splitRevision(revision string) (string, string, error) {
+	name, digest := revision, ""
+	if strings.HasPrefix(revision, digestPrefix) {
+		name, digest = "", strings.TrimPrefix(revision, digestPrefix)
+	} else if i := strings.LastIndex(revision, "@"+digestPrefix); i >= 0 {
+		name, digest = revision[:i], revision[i+len(digestPrefix)+1:]
+	} else {
+		return name, "", nil
+	}
+	checksum, err := parseChecksum([]byte(digest))
+	if err != nil || checksum != digest {
+		return "", "", fmt.Errorf("invalid digest of archive revision %q", revision)
+	}
+	return name, digest, nil
+}
+
+func (c *nativeArchiveClient) getCachedPath(version string) (string, error) {
+	keyData, err := json.Marshal(map[string]string{"url": c.repoURL, "version": version})
+	if err != nil {
+		return "", err
+	}
+	return c.repoCachePaths.GetPath(string(keyData))
+}
+
+func (c *nativeArchiveClient) CleanCache(revision string) error {
+	cachePath, err := c.getCachedPath(revision)
+	if err != nil {
+		return fmt.Errorf("error cleaning archive path for revision %s: %w", revision, err)
+	}
+	return errors.Join(os.RemoveAll(cachePath), os.RemoveAll(cachePath+SignatureSuffix))
+}
+
+func (c *nativeArchiveClient) maxSize() int64 {
+	if c.disableManifestMaxExtractedSize {
+		return math.MaxInt64
+	}
+	return c.manifestMaxExtractedSize
+}
+
+func (c *nativeArchiveClient) Extract(ctx context.Context, revision string) (string, utilio.Closer, error) {
+	name, digest, err := splitRevision(revision)
+	if err != nil {
+		return "", nil, err
+	}
+	u, err := c.archiveURL(name)
+	if err != nil {
+		return "", nil, err
+	}
+	cachedPath, err := c.getCachedPath(revision)
+	if err != nil {
+		return "", nil, fmt.Errorf("error getting archive path for revision %s: %w", revision, err)
+	}
+
+	c.repoLock.Lock(cachedPath)
+	defer c.repoLock.Unlock(cachedPath)
+
+	err = c.download(ctx, u, digest, cachedPath)
+	if err != nil {
+		return "", nil, fmt.Errorf("could not download archive of revision %s: %w", revision, err)
+	}
+
+	manifestsDir, err := files.CreateTempDir(os.TempDir())
+	if err != nil {
+		return "", nil, err
+	}
+	err = extract(u, cachedPath, manifestsDir, c.maxSize())
+	if err != nil {
+		_ = os.RemoveAll(manifestsDir)
+		return "", nil, fmt.Errorf("cannot extract contents of archive with revision %s: %w", revision, err)
+	}
+
+	return manifestsDir, utilio.NewCloser(func() error {
+		return os.RemoveAll(manifestsDir)
+	}), nil
+}
+
+func (c *nativeArchiveClient) VerifySignature(ctx context.Context, revision string) (string, error) {
+	if c.signatureVerifier == nil {
+		return "", errors.New("signature verification of archives is not configured")
+	}
+	name, digest, err := splitRevision(revision)
+	if err != nil {
+		return "", err
+	}
+	u, err := c.archiveURL(name)
+	if err != nil {
+		return "", err
+	}
+	cachedPath, err := c.getCachedPath(revision)
+	if err != nil {
+		return "", fmt.Errorf("error getting archive path for revision %s: %w", revision, err)
+	}
+
+	c.repoLock.Lock(cachedPath)
+	defer c.repoLock.Unlock(cachedPath)
+
+	err = c.download(ctx, u, digest, cachedPath)
+	if err != nil {
+		return "", fmt.Errorf("could not download archive of revision %s: %w", revision, err)
+	}
+
+	signaturePath := cachedPath + SignatureSuffix
+	exists, err := fileExists(signaturePath)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		signature, err := c.getSidecar(ctx, u, SignatureSuffix)
+		if errors.Is(err, errNotFound) {
+			return "", nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("could not download signature of archive of revision %s: %w", revision, err)
+		}
+		err = os.WriteFile(signaturePath, signature, 0o600)
+		if err != nil {
+			return "", err
+		}
+	}
+	return c.signatureVerifier(signaturePath, cachedPath)
+}
+
+// download stores the archive at the given URL in cachedPath, unless it is cached already. The download is rejected if
+// its checksum does not match the given digest or, if the digest is empty, the checksum published next to the archive.
+func (c *nativeArchiveClient) download(ctx context.Context, u *url.URL, digest string, cachedPath string) error {
+	exists, err := fileExists(cachedPath)
+	if err != nil || exists {
+		return err
+	}
+	if digest == "" {
+		digest, err = c.getChecksum(ctx, u)
+		if err != nil {
+			return err
+		}
+	}
+
+	body, err := c.fetcher.get(ctx, u)
+	if errors.Is(err, errNotFound) {
+		return fmt.Errorf("archive %s does not exist", u.Redacted())
+	}
+	if err != nil {
+		return err
+	}
+	defer utilio.Close(body)
+
+	f, err := os.CreateTemp(filepath.Dir(cachedPath), filepath.Base(cachedPath)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tempPath := f.Name()
+	defer func() { _ = os.Remove(tempPath) }()
+
+	maxSize := c.maxSize()
+	h := sha256.New()
+	written, err := io.Copy(io.MultiWriter(f, h), io.LimitReader(body, limitWithOverflow(maxSize)))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	if written > maxSize {
+		return fmt.Errorf("archive %s exceeds the maximum size of %d bytes", u.Redacted(), maxSize)
+	}
+
+	if actual := hex.EncodeToString(h.Sum(nil)); actual != digest {
+		return fmt.Errorf("checksum of archive %s does not match: expected %s, got %s", u.Redacted(), digest, actual)
+	}
+	return os.Rename(tempPath, cachedPath)
+}
+
+// getChecksum returns the SHA-256 checksum published next to the archive at the given URL
+func (c *nativeArchiveClient) getChecksum(ctx context.Context, u *url.URL) (string, error) {
+	data, err := c.getSidecar(ctx, u, ChecksumSuffix)
+	if errors.Is(err, errNotFound) {
+		if err := c.fetcher.head(ctx, u); errors.Is(err, errNotFound) {
+			return "", fmt.Errorf("archive %s does not exist", u.Redacted())
+		}
+		return "", fmt.Errorf("no checksum is published for archive %s: the %s file is required", u.Redacted(), ChecksumSuffix)
+	}
+	if err != nil {
+		return "", fmt.Errorf("could not download checksum of archive: %w", err)
+	}
+	return parseChecksum(data)
+}
+
+// getSidecar returns the content of the file with the given suffix next to the archive at the given URL
+func (c *nativeArchiveClient) getSidecar(ctx context.Context, u *url.URL, suffix string) ([]byte, error) {
+	sidecarURL := *u
+	sidecarURL.Path += suffix
+	sidecarURL.RawPath = ""
+	body, err := c.fetcher.get(ctx, &sidecarURL)
+	if err != nil {
+		return nil, err
+	}
+	defer utilio.Close(body)
+	data, err := io.ReadAll(io.LimitReader(body, maxSidecarSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxSidecarSize {
+		return nil, fmt.Errorf("%s exceeds the maximum size of %d bytes", sidecarURL.Redacted(), maxSidecarSize)
+	}
+	return data, nil
+}
+
+// parseChecksum parses the output of sha256sum, i.e. the hex encoded checksum optionally followed by the file name
+func parseChecksum(data []byte) (string, error) {
+	fields := strings.Fields(string(data))
+	if len(fields) == 0 {
+		return "", errors.New("checksum of archive is empty")
+	}
+	checksum := strings.ToLower(fields[0])
+	if _, err := hex.DecodeString(checksum); err != nil || len(checksum) != sha256.Size*2 {
+		return "", fmt.Errorf("invalid SHA-256 checksum of archive: %q", fields[0])
+	}
+	return checksum, nil
+}
+
+// extract extracts the archive stored in cachedPath to manifestsDir, using the format given by the extension of the URL
+func extract(u *url.URL, cachedPath string, manifestsDir string, maxSize int64) error {
+	f, err := os.Open(cachedPath)
+	if err != nil {
+		return err
+	}
+	defer utilio.Close(f)
+	if isZip(u) {
+		fi, err := f.Stat()
+		if err != nil {
+			return err
+		}
+		return files.Unzip(manifestsDir, f, fi.Size(), maxSize, false)
+	}
+	return files.Untgz(manifestsDir, f, maxSize, false)
+}
+
+func limitWithOverflow(maxSize int64) int64 {
+	if maxSize == math.MaxInt64 {
+		return maxSize
+	}
+	return maxSize + 1
+}
+
+func newTLSConfig(creds Creds) (*tls.Config, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: creds.InsecureSkipVerify}
+
+	if creds.CAPath != "" {
+		caData, err := os.ReadFile(creds.CAPath)
+		if err != nil {
+			return nil, err
+		}
+		caCertPool := x509.NewCertPool()
+		caCertPool.AppendCertsFromPEM(caData)
+		tlsConfig.RootCAs = caCertPool
+	}
+
+	// If a client cert & key is provided then configure TLS config accordingly.
+	if len(creds.CertData) > 0 && len(creds.KeyData) > 0 {
+		cert, err := tls.X509KeyPair(creds.CertData, creds.KeyData)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+func fileExists(filePath string) (bool, error) {
+	if _, err := os.Stat(filePath); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// fetcher retrieves the objects of an archive repository
+type fetcher interface {
+	// get returns the content of the object at the given URL, or errNotFound if it does not exist
+	get(ctx context.Context, u *url.URL) (io.ReadCloser, error)
+	// head returns errNotFound if the object at the given URL does not exist
+	head(ctx context.Context, u *url.URL) error
+	// ping verifies that the server of the given URL can be connected to with the configured credentials
+	ping(ctx context.Context, u *url.URL) error
+}
+
+type httpFetcher struct {
+	client *http.Client
+	creds  Creds
+}
+
+func (f *httpFetcher) do(ctx context.Context, method string, u *url.URL) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case f.creds.BearerToken != "":
+		req.Header.Set("Authorization", "Bearer "+f.creds.BearerToken)
+	case f.creds.Username != "" || f.creds.Password != "":
+		req.SetBasicAuth(f.creds.Username, f.creds.Password)
+	}
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		utilio.Close(resp.Body)
+		return nil, errNotFound
+	}
+	return resp, nil
+}
+
+func (f *httpFetcher) get(ctx context.Context, u *url.URL) (io.ReadCloser, error) {
+	resp, err := f.do(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer utilio.Close(resp.Body)
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("failed to get %s: %s: %s", u.Redacted(), resp.Status, bytes.TrimSpace(data))
+	}
+	return resp.Body, nil
+}
+
+func (f *httpFetcher) head(ctx context.Context, u *url.URL) error {
+	resp, err := f.do(ctx, http.MethodHead, u)
+	if err != nil {
+		return err
+	}
+	utilio.Close(resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("failed to get %s: %s", u.Redacted(), resp.Status)
+	}
+	return nil
+}
+
+func (f *httpFetcher) ping(ctx context.Context, u *url.URL) error {
+	root := url.URL{Scheme: u.Scheme, Host: u.Host, Path: "/"}
+	resp, err := f.do(ctx, http.MethodHead, &root)
+	if errors.Is(err, errNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	utilio.Close(resp.Body)
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode >= 500 {
+		return fmt.Errorf("failed to connect to %s: %s", root.String(), resp.Status)
+	}
+	return nil
+}
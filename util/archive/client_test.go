@@ -0,0 +1,352 @@
+package archive
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/io/files"
+)
+
+func createTgz(t *testing.T, content map[string]string) []byte {
+	t.Helper()
+	dir := t.TempDir()
+	for name, data := range content {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(data), 0o644))
+	}
+	buf := &bytes.Buffer{}
+	_, err := files.Tgz(dir, nil, nil, buf)
+	require.NoError(t, err)
+	return buf.Bytes()
+}
+
+func createZip(t *testing.T, content map[string]string) []byte {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	for name, data := range content {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(data))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+type archiveServer struct {
+	*httptest.Server
+	objects  map[string][]byte
+	requests atomic.Int32
+	auth     string
+}
+
+func newArchiveServer(t *testing.T, objects map[string][]byte) *archiveServer {
+	t.Helper()
+	s := &archiveServer{objects: objects}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.requests.Add(1)
+		s.auth = r.Header.Get("Authorization")
+		data, ok := s.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(data)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func newTestClient(t *testing.T, repoURL string, creds Creds, opts ...ClientOpts) *nativeArchiveClient {
+	t.Helper()
+	opts = append([]ClientOpts{
+		WithArchivePaths(utilio.NewRandomizedTempPaths(t.TempDir())),
+		WithManifestMaxExtractedSize(math.MaxInt64),
+	}, opts...)
+	c, err := NewClient(repoURL, creds, "", "", opts...)
+	require.NoError(t, err)
+	return c.(*nativeArchiveClient)
+}
+
+func TestIsArchiveURL(t *testing.T) {
+	for repoURL, expected := range map[string]bool{
+		"https://example.com/app/{{revision}}/manifests.tar.gz": true,
+		"https://example.com/app-{{revision}}.tgz":              true,
+		"http://example.com/app-{{revision}}.ZIP?token=abc":     true,
+		"s3://bucket/app/{{revision}}.tar.gz?region=eu-west-1":  true,
+		"s3://bucket/app/{{revision}}":                          true,
+		"https://github.com/argoproj/argocd-example-apps.git":   false,
+		"https://charts.example.com":                            false,
+		"oci://ghcr.io/org/manifests.tar.gz":                    false,
+		"git@github.com:org/repo.tar.gz":                        false,
+	} {
+		assert.Equal(t, expected, IsArchiveURL(repoURL), repoURL)
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	_, err := NewClient("ftp://example.com/manifests.tar.gz", Creds{}, "", "")
+	require.ErrorContains(t, err, "unsupported scheme")
+	_, err = NewClient("s3:///manifests.tar.gz", Creds{}, "", "")
+	require.ErrorContains(t, err, "does not specify a bucket")
+	_, err = NewClient("s3://bucket/manifests.tar.gz?forcePathStyle=maybe", Creds{}, "", "")
+	require.ErrorContains(t, err, "invalid forcePathStyle")
+}
+
+func Test_nativeArchiveClient_Extract(t *testing.T) {
+	tgz := createTgz(t, map[string]string{"guestbook/deployment.yaml": "kind: Deployment"})
+	zipData := createZip(t, map[string]string{"guestbook/service.yaml": "kind: Service"})
+
+	t.Run("tar.gz with checksum", func(t *testing.T) {
+		server := newArchiveServer(t, map[string][]byte{
+			"/app/1.0.0/manifests.tar.gz":        tgz,
+			"/app/1.0.0/manifests.tar.gz.sha256": []byte(checksum(tgz) + "  manifests.tar.gz\n"),
+		})
+		c := newTestClient(t, server.URL+"/app/{{revision}}/manifests.tar.gz", Creds{Username: "user", Password: "pass"})
+
+		revision, err := c.ResolveRevision(t.Context(), "1.0.0")
+		require.NoError(t, err)
+		path, closer, err := c.Extract(t.Context(), revision)
+		require.NoError(t, err)
+		data, err := os.ReadFile(filepath.Join(path, "guestbook/deployment.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, "kind: Deployment", string(data))
+		assert.True(t, strings.HasPrefix(server.auth, "Basic "))
+		require.NoError(t, closer.Close())
+		assert.NoDirExists(t, path)
+
+		// the archive is cached
+		requests := server.requests.Load()
+		path, closer, err = c.Extract(t.Context(), revision)
+		require.NoError(t, err)
+		defer utilio.Close(closer)
+		assert.FileExists(t, filepath.Join(path, "guestbook/deployment.yaml"))
+		assert.Equal(t, requests, server.requests.Load())
+
+		require.NoError(t, c.CleanCache(revision))
+		_, closer, err = c.Extract(t.Context(), revision)
+		require.NoError(t, err)
+		defer utilio.Close(closer)
+		assert.Greater(t, server.requests.Load(), requests)
+	})
+	t.Run("republished archive", func(t *testing.T) {
+		updated := createTgz(t, map[string]string{"guestbook/deployment.yaml": "kind: StatefulSet"})
+		server := newArchiveServer(t, map[string][]byte{
+			"/app-1.0.0.tgz":        tgz,
+			"/app-1.0.0.tgz.sha256": []byte(checksum(tgz)),
+		})
+		c := newTestClient(t, server.URL+"/app-{{revision}}.tgz", Creds{})
+
+		revision, err := c.ResolveRevision(t.Context(), "1.0.0")
+		require.NoError(t, err)
+		_, closer, err := c.Extract(t.Context(), revision)
+		require.NoError(t, err)
+		utilio.Close(closer)
+
+		server.objects["/app-1.0.0.tgz"] = updated
+		server.objects["/app-1.0.0.tgz.sha256"] = []byte(checksum(updated))
+		updatedRevision, err := c.ResolveRevision(t.Context(), "1.0.0")
+		require.NoError(t, err)
+		assert.NotEqual(t, revision, updatedRevision)
+		path, closer, err := c.Extract(t.Context(), updatedRevision)
+		require.NoError(t, err)
+		defer utilio.Close(closer)
+		data, err := os.ReadFile(filepath.Join(path, "guestbook/deployment.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, "kind: StatefulSet", string(data))
+	})
+	t.Run("archive changed after resolution", func(t *testing.T) {
+		server := newArchiveServer(t, map[string][]byte{
+			"/app-1.0.0.tgz":        zipData,
+			"/app-1.0.0.tgz.sha256": []byte(checksum(zipData)),
+		})
+		c := newTestClient(t, server.URL+"/app-{{revision}}.tgz", Creds{})
+
+		_, _, err := c.Extract(t.Context(), "1.0.0@sha256:"+checksum(tgz))
+		require.ErrorContains(t, err, "checksum of archive")
+	})
+	t.Run("zip", func(t *testing.T) {
+		server := newArchiveServer(t, map[string][]byte{
+			"/app-2.0.0.zip":        zipData,
+			"/app-2.0.0.zip.sha256": []byte(checksum(zipData)),
+		})
+		c := newTestClient(t, server.URL+"/app-{{revision}}.zip", Creds{BearerToken: "token"})
+
+		path, closer, err := c.Extract(t.Context(), "2.0.0")
+		require.NoError(t, err)
+		defer utilio.Close(closer)
+		assert.FileExists(t, filepath.Join(path, "guestbook/service.yaml"))
+		assert.Equal(t, "Bearer token", server.auth)
+	})
+	t.Run("missing checksum", func(t *testing.T) {
+		server := newArchiveServer(t, map[string][]byte{"/app-2.0.0.zip": zipData})
+		c := newTestClient(t, server.URL+"/app-{{revision}}.zip", Creds{})
+
+		_, _, err := c.Extract(t.Context(), "2.0.0")
+		require.ErrorContains(t, err, "no checksum is published")
+	})
+	t.Run("checksum mismatch", func(t *testing.T) {
+		server := newArchiveServer(t, map[string][]byte{
+			"/app-1.0.0.tgz":        tgz,
+			"/app-1.0.0.tgz.sha256": []byte(checksum(zipData)),
+		})
+		c := newTestClient(t, server.URL+"/app-{{revision}}.tgz", Creds{})
+
+		_, _, err := c.Extract(t.Context(), "1.0.0")
+		require.ErrorContains(t, err, "checksum of archive")
+		// the rejected archive is not cached
+		server.objects["/app-1.0.0.tgz.sha256"] = []byte(checksum(tgz))
+		_, closer, err := c.Extract(t.Context(), "1.0.0")
+		require.NoError(t, err)
+		utilio.Close(closer)
+	})
+	t.Run("invalid checksum", func(t *testing.T) {
+		server := newArchiveServer(t, map[string][]byte{
+			"/app-1.0.0.tgz":        tgz,
+			"/app-1.0.0.tgz.sha256": []byte("not-a-checksum"),
+		})
+		c := newTestClient(t, server.URL+"/app-{{revision}}.tgz", Creds{})
+		_, _, err := c.Extract(t.Context(), "1.0.0")
+		require.ErrorContains(t, err, "invalid SHA-256 checksum")
+	})
+	t.Run("missing archive", func(t *testing.T) {
+		server := newArchiveServer(t, map[string][]byte{})
+		c := newTestClient(t, server.URL+"/app-{{revision}}.tgz", Creds{})
+		_, _, err := c.Extract(t.Context(), "1.0.0")
+		require.ErrorContains(t, err, "does not exist")
+	})
+	t.Run("maximum size", func(t *testing.T) {
+		server := newArchiveServer(t, map[string][]byte{
+			"/app-1.0.0.tgz":        tgz,
+			"/app-1.0.0.tgz.sha256": []byte(checksum(tgz)),
+		})
+		c := newTestClient(t, server.URL+"/app-{{revision}}.tgz", Creds{}, WithManifestMaxExtractedSize(10))
+		_, _, err := c.Extract(t.Context(), "1.0.0")
+		require.ErrorContains(t, err, "exceeds the maximum size")
+	})
+}
+
+func Test_nativeArchiveClient_ResolveRevision(t *testing.T) {
+	digest := checksum([]byte("data"))
+	server := newArchiveServer(t, map[string][]byte{
+		"/app-1.0.0.tgz":        []byte("data"),
+		"/app-1.0.0.tgz.sha256": []byte(digest + "  app-1.0.0.tgz\n"),
+		"/app-3.0.0.tgz":        []byte("data"),
+		"/latest.tgz":           []byte("data"),
+		"/latest.tgz.sha256":    []byte(digest),
+	})
+
+	c := newTestClient(t, server.URL+"/app-{{revision}}.tgz", Creds{})
+	revision, err := c.ResolveRevision(t.Context(), "1.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, "1.0.0@sha256:"+digest, revision)
+
+	// a pinned revision is not resolved again
+	requests := server.requests.Load()
+	revision, err = c.ResolveRevision(t.Context(), revision)
+	require.NoError(t, err)
+	assert.Equal(t, "1.0.0@sha256:"+digest, revision)
+	assert.Equal(t, requests, server.requests.Load())
+
+	_, err = c.ResolveRevision(t.Context(), "2.0.0")
+	require.ErrorContains(t, err, "does not exist")
+
+	_, err = c.ResolveRevision(t.Context(), "3.0.0")
+	require.ErrorContains(t, err, "no checksum is published")
+
+	_, err = c.ResolveRevision(t.Context(), "")
+	require.ErrorContains(t, err, "a revision is required")
+
+	for _, invalid := range []string{"..", "../other", "1.0.0/../x", "1.0.0?x=y", ".hidden", "1.0.0@sha1:abc"} {
+		_, err = c.ResolveRevision(t.Context(), invalid)
+		require.ErrorContains(t, err, "invalid archive revision", invalid)
+	}
+	_, err = c.ResolveRevision(t.Context(), "1.0.0@sha256:abc")
+	require.ErrorContains(t, err, "invalid digest of archive revision")
+
+	// the revision is not part of the URL
+	c = newTestClient(t, server.URL+"/latest.tgz", Creds{})
+	revision, err = c.ResolveRevision(t.Context(), "HEAD")
+	require.NoError(t, err)
+	assert.Equal(t, "HEAD@sha256:"+digest, revision)
+	revision, err = c.ResolveRevision(t.Context(), "")
+	require.NoError(t, err)
+	assert.Equal(t, "sha256:"+digest, revision)
+}
+
+func Test_nativeArchiveClient_VerifySignature(t *testing.T) {
+	tgz := createTgz(t, map[string]string{"deployment.yaml": "kind: Deployment"})
+	server := newArchiveServer(t, map[string][]byte{
+		"/signed-1.0.0.tgz":          tgz,
+		"/signed-1.0.0.tgz.sha256":   []byte(checksum(tgz)),
+		"/signed-1.0.0.tgz.asc":      []byte("signature"),
+		"/unsigned-1.0.0.tgz":        tgz,
+		"/unsigned-1.0.0.tgz.sha256": []byte(checksum(tgz)),
+	})
+	verifier := func(signatureFile string, dataFile string) (string, error) {
+		signature, err := os.ReadFile(signatureFile)
+		require.NoError(t, err)
+		data, err := os.ReadFile(dataFile)
+		require.NoError(t, err)
+		assert.Equal(t, tgz, data)
+		return "verified " + string(signature), nil
+	}
+
+	c := newTestClient(t, server.URL+"/signed-{{revision}}.tgz", Creds{}, WithSignatureVerifier(verifier))
+	result, err := c.VerifySignature(t.Context(), "1.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, "verified signature", result)
+
+	c = newTestClient(t, server.URL+"/unsigned-{{revision}}.tgz", Creds{}, WithSignatureVerifier(verifier))
+	result, err = c.VerifySignature(t.Context(), "1.0.0")
+	require.NoError(t, err)
+	assert.Empty(t, result)
+
+	c = newTestClient(t, server.URL+"/signed-{{revision}}.tgz", Creds{})
+	_, err = c.VerifySignature(t.Context(), "1.0.0")
+	require.ErrorContains(t, err, "not configured")
+}
+
+func Test_nativeArchiveClient_TestRepo(t *testing.T) {
+	server := newArchiveServer(t, map[string][]byte{"/latest.tgz": []byte("data")})
+
+	ok, err := newTestClient(t, server.URL+"/latest.tgz", Creds{}).TestRepo(t.Context())
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = newTestClient(t, server.URL+"/missing.tgz", Creds{}).TestRepo(t.Context())
+	require.ErrorContains(t, err, "does not exist")
+	assert.False(t, ok)
+
+	// only the connectivity to the server is verified for templates
+	ok, err = newTestClient(t, server.URL+"/app-{{revision}}.tgz", Creds{}).TestRepo(t.Context())
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	unauthorized := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer unauthorized.Close()
+	_, err = newTestClient(t, unauthorized.URL+"/app-{{revision}}.tgz", Creds{}).TestRepo(t.Context())
+	require.ErrorContains(t, err, "401")
+}
@@ -0,0 +1,104 @@
+package archive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+)
+
+// s3Fetcher retrieves the objects of an S3 compatible bucket, described by s3://bucket/key URLs
+type s3Fetcher struct {
+	client s3iface.S3API
+}
+
+// newS3Fetcher returns a fetcher for the bucket of the given s3://bucket/key URL. The region, endpoint and
+// forcePathStyle query parameters configure the client. The username and password of the repository are used as
+// access key ID and secret access key, otherwise the credentials are taken from the default AWS credential chain.
+func newS3Fetcher(u *url.URL, creds Creds, client *http.Client) (*s3Fetcher, error) {
+	if u.Host == "" {
+		return nil, fmt.Errorf("archive repo url %q does not specify a bucket", u.Redacted())
+	}
+	config := aws.NewConfig().WithHTTPClient(client)
+	query := u.Query()
+	if region := query.Get("region"); region != "" {
+		config = config.WithRegion(region)
+	}
+	if endpoint := query.Get("endpoint"); endpoint != "" {
+		config = config.WithEndpoint(endpoint)
+	}
+	if forcePathStyle := query.Get("forcePathStyle"); forcePathStyle != "" {
+		enabled, err := strconv.ParseBool(forcePathStyle)
+		if err != nil {
+			return nil, fmt.Errorf("invalid forcePathStyle parameter of archive repo url: %w", err)
+		}
+		config = config.WithS3ForcePathStyle(enabled)
+	}
+	if creds.Username != "" && creds.Password != "" {
+		config = config.WithCredentials(credentials.NewStaticCredentials(creds.Username, creds.Password, ""))
+	}
+	sess, err := session.NewSessionWithOptions(session.Options{Config: *config, SharedConfigState: session.SharedConfigEnable})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AWS session: %w", err)
+	}
+	return &s3Fetcher{client: s3.New(sess)}, nil
+}
+
+func bucketAndKey(u *url.URL) (string, string) {
+	return u.Host, strings.TrimPrefix(u.Path, "/")
+}
+
+func isS3NotFound(err error) bool {
+	var awsErr awserr.Error
+	if !errors.As(err, &awsErr) {
+		return false
+	}
+	// HeadObject reports a missing object as NotFound, since the response has no body
+	return awsErr.Code() == s3.ErrCodeNoSuchKey || awsErr.Code() == "NotFound"
+}
+
+func (f *s3Fetcher) get(ctx context.Context, u *url.URL) (io.ReadCloser, error) {
+	bucket, key := bucketAndKey(u)
+	out, err := f.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if isS3NotFound(err) {
+		return nil, errNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return out.Body, nil
+}
+
+func (f *s3Fetcher) head(ctx context.Context, u *url.URL) error {
+	bucket, key := bucketAndKey(u)
+	_, err := f.client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if isS3NotFound(err) {
+		return errNotFound
+	}
+	return err
+}
+
+func (f *s3Fetcher) ping(ctx context.Context, u *url.URL) error {
+	bucket, _ := bucketAndKey(u)
+	_, err := f.client.HeadBucketWithContext(ctx, &s3.HeadBucketInput{
+		Bucket: aws.String(bucket),
+	})
+	return err
+}
@@ -0,0 +1,85 @@
+package archive
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/argoproj/pkg/sync"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+// fakeS3 keeps the objects of a single bucket in memory
+type fakeS3 struct {
+	s3iface.S3API
+	bucket  string
+	objects map[string][]byte
+}
+
+func (f *fakeS3) GetObjectWithContext(_ context.Context, in *s3.GetObjectInput, _ ...request.Option) (*s3.GetObjectOutput, error) {
+	data, ok := f.objects[aws.StringValue(in.Key)]
+	if !ok {
+		return nil, awserr.New(s3.ErrCodeNoSuchKey, "not found", nil)
+	}
+	return &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader(data))}, nil
+}
+
+func (f *fakeS3) HeadObjectWithContext(_ context.Context, in *s3.HeadObjectInput, _ ...request.Option) (*s3.HeadObjectOutput, error) {
+	if _, ok := f.objects[aws.StringValue(in.Key)]; !ok {
+		return nil, awserr.New("NotFound", "not found", nil)
+	}
+	return &s3.HeadObjectOutput{}, nil
+}
+
+func (f *fakeS3) HeadBucketWithContext(_ context.Context, in *s3.HeadBucketInput, _ ...request.Option) (*s3.HeadBucketOutput, error) {
+	if aws.StringValue(in.Bucket) != f.bucket {
+		return nil, awserr.New(s3.ErrCodeNoSuchBucket, "no such bucket", nil)
+	}
+	return &s3.HeadBucketOutput{}, nil
+}
+
+func TestS3Fetcher(t *testing.T) {
+	tgz := createTgz(t, map[string]string{"deployment.yaml": "kind: Deployment"})
+	fake := &fakeS3{bucket: "manifests", objects: map[string][]byte{
+		"apps/guestbook/1.0.0.tar.gz":        tgz,
+		"apps/guestbook/1.0.0.tar.gz.sha256": []byte(checksum(tgz)),
+	}}
+	repoURL := "s3://manifests/apps/guestbook/{{revision}}.tar.gz?region=eu-west-1"
+	c := newClientWithLock(repoURL, sync.NewKeyLock(), &s3Fetcher{client: fake},
+		WithArchivePaths(utilio.NewRandomizedTempPaths(t.TempDir())),
+		WithManifestMaxExtractedSize(math.MaxInt64))
+
+	revision, err := c.ResolveRevision(t.Context(), "1.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, "1.0.0@sha256:"+checksum(tgz), revision)
+	_, err = c.ResolveRevision(t.Context(), "2.0.0")
+	require.ErrorContains(t, err, "does not exist")
+
+	path, closer, err := c.Extract(t.Context(), revision)
+	require.NoError(t, err)
+	defer utilio.Close(closer)
+	data, err := os.ReadFile(filepath.Join(path, "deployment.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "kind: Deployment", string(data))
+
+	ok, err := c.TestRepo(t.Context())
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	u, err := url.Parse("s3://other/apps/guestbook.tar.gz")
+	require.NoError(t, err)
+	require.Error(t, (&s3Fetcher{client: fake}).ping(t.Context(), u))
+}
@@ -26,6 +26,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v3/util/gpg"
 
+	argocommon "github.com/argoproj/argo-cd/v3/common"
 	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/typed/application/v1alpha1"
 	applicationsv1 "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
@@ -257,13 +258,15 @@ func RefreshApp(appIf v1alpha1.ApplicationInterface, name string, refreshType ar
 	return nil, err
 }
 
-func TestRepoWithKnownType(ctx context.Context, repoClient apiclient.RepoServerServiceClient, repo *argoappv1.Repository, isHelm bool, isHelmOci bool, isOCI bool) error {
+func TestRepoWithKnownType(ctx context.Context, repoClient apiclient.RepoServerServiceClient, repo *argoappv1.Repository, isHelm bool, isHelmOci bool, isOCI bool, isArchive bool) error {
 	repo = repo.DeepCopy()
 	switch {
 	case isHelm:
 		repo.Type = "helm"
 	case isOCI:
 		repo.Type = "oci"
+	case isArchive:
+		repo.Type = "archive"
 	case repo.Type != "oci":
 		repo.Type = "git"
 	}
@@ -342,6 +345,10 @@ func ValidateRepo(
 	if err != nil {
 		return nil, fmt.Errorf("failed to get permitted OCI credentials for project %q: %w", proj.Name, err)
 	}
+	permittedGitRepos, err := GetPermittedGitRepos(ctx, db, proj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permitted git repositories for project %q: %w", proj.Name, err)
+	}
 
 	destCluster, err := GetDestinationCluster(ctx, spec.Destination, db)
 	if err != nil {
@@ -377,6 +384,7 @@ func ValidateRepo(
 		repoClient,
 		permittedHelmRepos,
 		permittedOCIRepos,
+		permittedGitRepos,
 		helmOptions,
 		destCluster,
 		apiGroups,
@@ -400,6 +408,7 @@ func validateRepo(ctx context.Context,
 	repoClient apiclient.RepoServerServiceClient,
 	permittedHelmRepos []*argoappv1.Repository,
 	permittedOCIRepos []*argoappv1.Repository,
+	permittedGitRepos []*argoappv1.Repository,
 	helmOptions *argoappv1.HelmOptions,
 	cluster *argoappv1.Cluster,
 	apiGroups []kube.APIResourceInfo,
@@ -417,7 +426,7 @@ func validateRepo(ctx context.Context,
 		if err != nil {
 			return nil, err
 		}
-		if err := TestRepoWithKnownType(ctx, repoClient, repo, source.IsHelm(), source.IsHelmOci(), source.IsOCI()); err != nil {
+		if err := TestRepoWithKnownType(ctx, repoClient, repo, source.IsHelm(), source.IsHelmOci(), source.IsOCI(), source.IsArchive()); err != nil {
 			errMessage = fmt.Sprintf("repositories not accessible: %v: %v", repo.StringForLogging(), err)
 		}
 		repoAccessible := false
@@ -458,6 +467,7 @@ func validateRepo(ctx context.Context,
 		db,
 		permittedHelmRepos,
 		permittedOCIRepos,
+		permittedGitRepos,
 		helmOptions,
 		app,
 		proj,
@@ -621,6 +631,26 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 		}
 	}
 
+	for _, window := range spec.SyncWindows {
+		if window == nil {
+			continue
+		}
+		if window.Kind != "deny" {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("invalid sync window: kind '%s' is not supported, the sync windows of an application must be deny windows", window.Kind),
+			})
+			continue
+		}
+		// validation defaults the time zone of the window, which must not change the spec
+		if err := window.DeepCopy().Validate(); err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("invalid sync window: %v", err),
+			})
+		}
+	}
+
 	destCluster, err := GetDestinationCluster(ctx, spec.Destination, db)
 	if err != nil {
 		conditions = append(conditions, argoappv1.ApplicationCondition{
@@ -741,6 +771,7 @@ func verifyGenerateManifests(
 	db db.ArgoDB,
 	helmRepos argoappv1.Repositories,
 	ociRepos argoappv1.Repositories,
+	gitRepos argoappv1.Repositories,
 	helmOptions *argoappv1.HelmOptions,
 	app *argoappv1.Application,
 	proj *argoappv1.AppProject,
@@ -766,6 +797,11 @@ func verifyGenerateManifests(
 	}
 
 	for _, source := range sources {
+		if source.Helm != nil && len(source.Helm.ValuesFrom) > 0 {
+			// the Helm values referenced by valuesFrom are read by the controller, generating the manifests without
+			// them would report errors for charts requiring them
+			continue
+		}
 		repoRes, err := db.GetRepository(ctx, source.RepoURL, proj.Name)
 		if err != nil {
 			conditions = append(conditions, argoappv1.ApplicationCondition{
@@ -818,6 +854,11 @@ func verifyGenerateManifests(
 			helmRepoCreds = append(helmRepoCreds, ociRepositoryCredentials...)
 		}
 
+		var ociSignatureVerification *argoappv1.OCISignatureVerification
+		if source.IsOCI() {
+			ociSignatureVerification = proj.Spec.OCISignatureVerification
+		}
+
 		req := apiclient.ManifestRequest{
 			Repo: &argoappv1.Repository{
 				Repo:    source.RepoURL,
@@ -827,6 +868,7 @@ func verifyGenerateManifests(
 				NoProxy: repoRes.NoProxy,
 			},
 			VerifySignature:                 verifySignature,
+			OciSignatureVerification:        ociSignatureVerification,
 			Repos:                           repos,
 			Revision:                        source.TargetRevision,
 			AppName:                         app.Name,
@@ -847,6 +889,7 @@ func verifyGenerateManifests(
 			ProjectSourceRepos:              proj.Spec.SourceRepos,
 			AnnotationManifestGeneratePaths: app.GetAnnotation(argoappv1.AnnotationKeyManifestGeneratePaths),
 			InstallationID:                  installationID,
+			GitRepos:                        gitRepos,
 		}
 		req.Repo.CopyCredentialsFromRepo(repoRes)
 		req.Repo.CopySettingsFrom(repoRes)
@@ -1008,6 +1051,19 @@ func GetPermittedRepos(proj *argoappv1.AppProject, repos []*argoappv1.Repository
 	return permittedRepos, nil
 }
 
+// GetPermittedGitRepos returns the git repositories, including their credentials, which are permitted by the given
+// project
+func GetPermittedGitRepos(ctx context.Context, db db.ArgoDB, proj *argoappv1.AppProject) ([]*argoappv1.Repository, error) {
+	repos, err := db.ListRepositories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing repositories: %w", err)
+	}
+	gitRepos := argoappv1.Repositories(repos).Filter(func(r *argoappv1.Repository) bool {
+		return r.Type == "" || r.Type == argocommon.DefaultRepoType
+	})
+	return GetPermittedRepos(proj, gitRepos)
+}
+
 type ClusterGetter interface {
 	GetCluster(ctx context.Context, name string) (*argoappv1.Cluster, error)
 	GetClusterServersByName(ctx context.Context, server string) ([]string, error)
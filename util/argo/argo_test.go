@@ -304,6 +304,28 @@ func TestValidatePermissionsEmptyDestination(t *testing.T) {
 	assert.ElementsMatch(t, conditions, []argoappv1.ApplicationCondition{{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: "Destination server missing from app spec"}})
 }
 
+func TestValidatePermissionsApplicationSyncWindows(t *testing.T) {
+	proj := &argoappv1.AppProject{
+		Spec: argoappv1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	}
+	spec := &argoappv1.ApplicationSpec{
+		Source: &argoappv1.ApplicationSource{RepoURL: "https://github.com/argoproj/argo-cd", Path: "."},
+		SyncWindows: argoappv1.SyncWindows{
+			{Kind: "deny", Schedule: "0 18 * * 5", Duration: "60h"},
+			{Kind: "allow", Schedule: "* * * * *", Duration: "1h", ManualSync: true},
+		},
+	}
+	conditions, err := ValidatePermissions(t.Context(), spec, proj, nil)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []argoappv1.ApplicationCondition{
+		{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: "invalid sync window: kind 'allow' is not supported, the sync windows of an application must be deny windows"},
+		{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: "Destination server missing from app spec"},
+	}, conditions)
+}
+
 func TestValidateChartWithoutRevision(t *testing.T) {
 	appSpec := &argoappv1.ApplicationSpec{
 		Source: &argoappv1.ApplicationSource{RepoURL: "https://charts.helm.sh/incubator/", Chart: "myChart", TargetRevision: ""},
@@ -407,6 +429,7 @@ func TestValidateRepo(t *testing.T) {
 	db.On("GetRepository", t.Context(), app.Spec.Source.RepoURL, "").Return(repo, nil)
 	db.On("ListHelmRepositories", t.Context()).Return(helmRepos, nil)
 	db.On("ListOCIRepositories", t.Context()).Return([]*argoappv1.Repository{}, nil)
+	db.On("ListRepositories", t.Context()).Return([]*argoappv1.Repository{}, nil)
 	db.On("GetCluster", t.Context(), app.Spec.Destination.Server).Return(cluster, nil)
 	db.On("GetAllHelmRepositoryCredentials", t.Context()).Return(nil, nil)
 	db.On("GetAllOCIRepositoryCredentials", t.Context()).Return([]*argoappv1.RepoCreds{}, nil)
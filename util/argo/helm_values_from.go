@@ -0,0 +1,293 @@
+package argo
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	listersv1 "k8s.io/client-go/listers/core/v1"
+	"sigs.k8s.io/yaml"
+
+	argocommon "github.com/argoproj/argo-cd/v3/common"
+	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// redactedHelmValue replaces the values read from Secrets in manifests returned to users
+const redactedHelmValue = "++++++++"
+
+// minSensitiveHelmValueLength is the minimum length of the values read from Secrets which are redacted. Shorter values
+// are too likely to occur in manifests by chance.
+const minSensitiveHelmValueLength = 4
+
+// minEmbeddedSensitiveHelmValueLength is the minimum length of the values read from Secrets which are redacted where
+// they are embedded in longer strings, e.g. in a URL. Shorter values are only redacted where they are the whole string.
+const minEmbeddedSensitiveHelmValueLength = 16
+
+// HelmValuesGetter gets the ConfigMaps and Secrets holding Helm values. The errors returned for objects which do not
+// exist satisfy apierrors.IsNotFound.
+type HelmValuesGetter interface {
+	GetConfigMap(ctx context.Context, namespace string, name string) (*corev1.ConfigMap, error)
+	GetSecret(ctx context.Context, namespace string, name string) (*corev1.Secret, error)
+}
+
+type helmValuesClientGetter struct {
+	kubeClientset kubernetes.Interface
+}
+
+// NewHelmValuesClientGetter returns a HelmValuesGetter which gets the ConfigMaps and Secrets from the Kubernetes API
+func NewHelmValuesClientGetter(kubeClientset kubernetes.Interface) HelmValuesGetter {
+	return &helmValuesClientGetter{kubeClientset: kubeClientset}
+}
+
+func (g *helmValuesClientGetter) GetConfigMap(ctx context.Context, namespace string, name string) (*corev1.ConfigMap, error) {
+	return g.kubeClientset.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+func (g *helmValuesClientGetter) GetSecret(ctx context.Context, namespace string, name string) (*corev1.Secret, error) {
+	return g.kubeClientset.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+type helmValuesListerGetter struct {
+	configMaps listersv1.ConfigMapLister
+	secrets    listersv1.SecretLister
+}
+
+// NewHelmValuesInformerFactory returns an informer factory for the ConfigMaps and Secrets of the given namespace, or of
+// all namespaces if it is empty, which are labeled with LabelKeyHelmValues=true
+func NewHelmValuesInformerFactory(kubeClientset kubernetes.Interface, namespace string, resync time.Duration) informers.SharedInformerFactory {
+	return informers.NewSharedInformerFactoryWithOptions(kubeClientset, resync,
+		informers.WithNamespace(namespace),
+		informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.LabelSelector = argocommon.LabelKeyHelmValues + "=true"
+		}))
+}
+
+// NewHelmValuesListerGetter returns a HelmValuesGetter which gets the ConfigMaps and Secrets from the informers of the
+// given factory, which must be created by NewHelmValuesInformerFactory. It must be called before the factory is
+// started. ConfigMaps and Secrets which are not labeled with LabelKeyHelmValues=true are not found.
+func NewHelmValuesListerGetter(factory informers.SharedInformerFactory) HelmValuesGetter {
+	return &helmValuesListerGetter{
+		configMaps: factory.Core().V1().ConfigMaps().Lister(),
+		secrets:    factory.Core().V1().Secrets().Lister(),
+	}
+}
+
+func (g *helmValuesListerGetter) GetConfigMap(_ context.Context, namespace string, name string) (*corev1.ConfigMap, error) {
+	return g.configMaps.ConfigMaps(namespace).Get(name)
+}
+
+func (g *helmValuesListerGetter) GetSecret(_ context.Context, namespace string, name string) (*corev1.Secret, error) {
+	return g.secrets.Secrets(namespace).Get(name)
+}
+
+// HelmValuesFrom holds the Helm values read from the ConfigMaps and Secrets referenced by the valuesFrom of a Helm
+// source
+type HelmValuesFrom struct {
+	// Values are the Helm values, in the order they are referenced
+	Values []string
+	// sensitive are the string values read from Secrets
+	sensitive []string
+}
+
+// GetHelmValuesFrom reads the Helm values referenced by the valuesFrom of the given source from the ConfigMaps and
+// Secrets in the given namespace, which is the namespace of the Application. It returns nil if the source does not
+// reference any values.
+func GetHelmValuesFrom(ctx context.Context, getter HelmValuesGetter, namespace string, source *argoappv1.ApplicationSource) (*HelmValuesFrom, error) {
+	if source == nil || source.Helm == nil || len(source.Helm.ValuesFrom) == 0 {
+		return nil, nil
+	}
+	result := &HelmValuesFrom{}
+	for _, ref := range source.Helm.ValuesFrom {
+		var meta metav1.ObjectMeta
+		var data map[string][]byte
+		switch ref.Kind {
+		case argoappv1.HelmValuesFromKindConfigMap:
+			cm, err := getter.GetConfigMap(ctx, namespace, ref.Name)
+			if err != nil && !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("error getting ConfigMap %s/%s: %w", namespace, ref.Name, err)
+			}
+			if err == nil {
+				meta = cm.ObjectMeta
+				data = map[string][]byte{}
+				for k, v := range cm.Data {
+					data[k] = []byte(v)
+				}
+			}
+		case argoappv1.HelmValuesFromKindSecret:
+			secret, err := getter.GetSecret(ctx, namespace, ref.Name)
+			if err != nil && !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("error getting Secret %s/%s: %w", namespace, ref.Name, err)
+			}
+			if err == nil {
+				meta = secret.ObjectMeta
+				data = secret.Data
+			}
+		default:
+			return nil, fmt.Errorf("invalid kind %q of Helm valuesFrom %s, must be %s or %s", ref.Kind, ref.Name, argoappv1.HelmValuesFromKindConfigMap, argoappv1.HelmValuesFromKindSecret)
+		}
+
+		if data == nil {
+			if ref.Optional {
+				continue
+			}
+			return nil, fmt.Errorf("%s %s/%s referenced by Helm valuesFrom not found or not labeled with %s=true", ref.Kind, namespace, ref.Name, argocommon.LabelKeyHelmValues)
+		}
+		if meta.Labels[argocommon.LabelKeyHelmValues] != "true" {
+			return nil, fmt.Errorf("%s %s/%s referenced by Helm valuesFrom is not labeled with %s=true", ref.Kind, namespace, ref.Name, argocommon.LabelKeyHelmValues)
+		}
+		values, ok := data[ref.GetKey()]
+		if !ok {
+			if ref.Optional {
+				continue
+			}
+			return nil, fmt.Errorf("key %s not found in %s %s/%s referenced by Helm valuesFrom", ref.GetKey(), ref.Kind, namespace, ref.Name)
+		}
+		var parsed map[string]any
+		if err := yaml.Unmarshal(values, &parsed); err != nil {
+			return nil, fmt.Errorf("invalid Helm values in key %s of %s %s/%s: %w", ref.GetKey(), ref.Kind, namespace, ref.Name, err)
+		}
+		if ref.Kind == argoappv1.HelmValuesFromKindSecret {
+			result.sensitive = collectSensitiveHelmValues(parsed, result.sensitive)
+		}
+		result.Values = append(result.Values, string(values))
+	}
+	return result, nil
+}
+
+// WithoutHelmValuesFromSecrets returns a copy of the given source without the valuesFrom which reference Secrets. It is
+// used to generate manifests from content the user controls, such as uploaded files or another revision than the target
+// revision, whose templates could otherwise expose the values of the Secrets in a form that redaction does not catch.
+func WithoutHelmValuesFromSecrets(source argoappv1.ApplicationSource) argoappv1.ApplicationSource {
+	if source.Helm == nil || len(source.Helm.ValuesFrom) == 0 {
+		return source
+	}
+	result := *source.DeepCopy()
+	result.Helm.ValuesFrom = nil
+	for _, ref := range source.Helm.ValuesFrom {
+		if ref.Kind != argoappv1.HelmValuesFromKindSecret {
+			result.Helm.ValuesFrom = append(result.Helm.ValuesFrom, ref)
+		}
+	}
+	return result
+}
+
+// GetValues returns the Helm values, or nil if there are none
+func (v *HelmValuesFrom) GetValues() []string {
+	if v == nil {
+		return nil
+	}
+	return v.Values
+}
+
+// Hash returns the hash of the Helm values, or an empty string if there are none
+func (v *HelmValuesFrom) Hash() string {
+	return HelmValuesFromHash(v.GetValues())
+}
+
+// Merge returns Helm values holding the sensitive values of both v and other, which is used to redact the manifests
+// of multiple sources at once
+func (v *HelmValuesFrom) Merge(other *HelmValuesFrom) *HelmValuesFrom {
+	if other == nil {
+		return v
+	}
+	if v == nil {
+		return other
+	}
+	return &HelmValuesFrom{
+		Values:    append(append([]string{}, v.Values...), other.Values...),
+		sensitive: append(append([]string{}, v.sensitive...), other.sensitive...),
+	}
+}
+
+// Redact replaces the values read from Secrets in all string fields of the given object: string fields equal to one of
+// the values are redacted entirely, and values of at least minEmbeddedSensitiveHelmValueLength characters are also
+// redacted where they are part of a string field. It returns true if the object was modified.
+func (v *HelmValuesFrom) Redact(obj *unstructured.Unstructured) bool {
+	if v == nil || len(v.sensitive) == 0 || obj == nil {
+		return false
+	}
+	sensitive := append([]string{}, v.sensitive...)
+	// replace longer values first, so that values containing other values are redacted completely
+	sort.Slice(sensitive, func(i, j int) bool {
+		return len(sensitive[i]) > len(sensitive[j])
+	})
+	redacted, modified := redactHelmValues(obj.Object, sensitive)
+	if modified {
+		obj.Object = redacted.(map[string]any)
+	}
+	return modified
+}
+
+// HelmValuesFromHash returns the hash of the given Helm values, which is part of the manifest cache key, or an empty
+// string if there are no values
+func HelmValuesFromHash(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	h := sha256.New()
+	for _, value := range values {
+		_, _ = fmt.Fprintf(h, "%d:%s", len(value), value)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func collectSensitiveHelmValues(value any, sensitive []string) []string {
+	switch v := value.(type) {
+	case map[string]any:
+		for _, item := range v {
+			sensitive = collectSensitiveHelmValues(item, sensitive)
+		}
+	case []any:
+		for _, item := range v {
+			sensitive = collectSensitiveHelmValues(item, sensitive)
+		}
+	case string:
+		if len(v) >= minSensitiveHelmValueLength {
+			sensitive = append(sensitive, v)
+		}
+	}
+	return sensitive
+}
+
+func redactHelmValues(value any, sensitive []string) (any, bool) {
+	modified := false
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if redacted, ok := redactHelmValues(item, sensitive); ok {
+				v[key] = redacted
+				modified = true
+			}
+		}
+	case []any:
+		for i, item := range v {
+			if redacted, ok := redactHelmValues(item, sensitive); ok {
+				v[i] = redacted
+				modified = true
+			}
+		}
+	case string:
+		for _, s := range sensitive {
+			if v == s {
+				return redactedHelmValue, true
+			}
+			if len(s) >= minEmbeddedSensitiveHelmValueLength && strings.Contains(v, s) {
+				v = strings.ReplaceAll(v, s, redactedHelmValue)
+				modified = true
+			}
+		}
+		return v, modified
+	}
+	return value, modified
+}
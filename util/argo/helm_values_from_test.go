@@ -0,0 +1,234 @@
+package argo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func helmValuesSource(valuesFrom ...argoappv1.HelmValuesFromSource) *argoappv1.ApplicationSource {
+	return &argoappv1.ApplicationSource{
+		RepoURL: "https://github.com/argoproj/argocd-example-apps",
+		Path:    "helm-guestbook",
+		Helm:    &argoappv1.ApplicationSourceHelm{ValuesFrom: valuesFrom},
+	}
+}
+
+func TestGetHelmValuesFrom(t *testing.T) {
+	labels := map[string]string{common.LabelKeyHelmValues: "true"}
+	kubeClient := fake.NewClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "env", Namespace: "argocd", Labels: labels},
+			Data: map[string]string{
+				"values.yaml": "accountId: \"123456789012\"\n",
+				"other.yaml":  "endpoint: https://api.example.com\n",
+				"invalid":     "- not\n- a map\n",
+			},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "unlabeled", Namespace: "argocd"},
+			Data:       map[string]string{"values.yaml": "foo: bar\n"},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "argocd", Labels: labels},
+			Data:       map[string][]byte{"values.yaml": []byte("db:\n  password: s3cr3t-password\n  user: ab\n")},
+		},
+	)
+	getter := NewHelmValuesClientGetter(kubeClient)
+
+	t.Run("NoValuesFrom", func(t *testing.T) {
+		values, err := GetHelmValuesFrom(t.Context(), getter, "argocd", &argoappv1.ApplicationSource{})
+		require.NoError(t, err)
+		assert.Nil(t, values)
+		assert.Nil(t, values.GetValues())
+		assert.Empty(t, values.Hash())
+	})
+
+	t.Run("ConfigMapsAndSecrets", func(t *testing.T) {
+		values, err := GetHelmValuesFrom(t.Context(), getter, "argocd", helmValuesSource(
+			argoappv1.HelmValuesFromSource{Kind: argoappv1.HelmValuesFromKindConfigMap, Name: "env"},
+			argoappv1.HelmValuesFromSource{Kind: argoappv1.HelmValuesFromKindSecret, Name: "creds"},
+			argoappv1.HelmValuesFromSource{Kind: argoappv1.HelmValuesFromKindConfigMap, Name: "env", Key: "other.yaml"},
+		))
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"accountId: \"123456789012\"\n",
+			"db:\n  password: s3cr3t-password\n  user: ab\n",
+			"endpoint: https://api.example.com\n",
+		}, values.GetValues())
+		assert.Equal(t, HelmValuesFromHash(values.GetValues()), values.Hash())
+		assert.NotEmpty(t, values.Hash())
+	})
+
+	t.Run("Optional", func(t *testing.T) {
+		values, err := GetHelmValuesFrom(t.Context(), getter, "argocd", helmValuesSource(
+			argoappv1.HelmValuesFromSource{Kind: argoappv1.HelmValuesFromKindConfigMap, Name: "missing", Optional: true},
+			argoappv1.HelmValuesFromSource{Kind: argoappv1.HelmValuesFromKindSecret, Name: "creds", Key: "missing.yaml", Optional: true},
+		))
+		require.NoError(t, err)
+		assert.Empty(t, values.GetValues())
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		_, err := GetHelmValuesFrom(t.Context(), getter, "argocd", helmValuesSource(
+			argoappv1.HelmValuesFromSource{Kind: argoappv1.HelmValuesFromKindConfigMap, Name: "missing"},
+		))
+		require.EqualError(t, err, "ConfigMap argocd/missing referenced by Helm valuesFrom not found or not labeled with argocd.argoproj.io/helm-values=true")
+
+		_, err = GetHelmValuesFrom(t.Context(), getter, "argocd", helmValuesSource(
+			argoappv1.HelmValuesFromSource{Kind: argoappv1.HelmValuesFromKindSecret, Name: "creds", Key: "missing.yaml"},
+		))
+		require.EqualError(t, err, "key missing.yaml not found in Secret argocd/creds referenced by Helm valuesFrom")
+	})
+
+	t.Run("NotLabeled", func(t *testing.T) {
+		_, err := GetHelmValuesFrom(t.Context(), getter, "argocd", helmValuesSource(
+			argoappv1.HelmValuesFromSource{Kind: argoappv1.HelmValuesFromKindConfigMap, Name: "unlabeled"},
+		))
+		require.EqualError(t, err, "ConfigMap argocd/unlabeled referenced by Helm valuesFrom is not labeled with argocd.argoproj.io/helm-values=true")
+	})
+
+	t.Run("OtherNamespace", func(t *testing.T) {
+		_, err := GetHelmValuesFrom(t.Context(), getter, "default", helmValuesSource(
+			argoappv1.HelmValuesFromSource{Kind: argoappv1.HelmValuesFromKindConfigMap, Name: "env"},
+		))
+		require.EqualError(t, err, "ConfigMap default/env referenced by Helm valuesFrom not found or not labeled with argocd.argoproj.io/helm-values=true")
+	})
+
+	t.Run("InvalidValues", func(t *testing.T) {
+		_, err := GetHelmValuesFrom(t.Context(), getter, "argocd", helmValuesSource(
+			argoappv1.HelmValuesFromSource{Kind: argoappv1.HelmValuesFromKindConfigMap, Name: "env", Key: "invalid"},
+		))
+		require.ErrorContains(t, err, "invalid Helm values in key invalid of ConfigMap argocd/env")
+	})
+
+	t.Run("InvalidKind", func(t *testing.T) {
+		_, err := GetHelmValuesFrom(t.Context(), getter, "argocd", helmValuesSource(
+			argoappv1.HelmValuesFromSource{Kind: "Pod", Name: "env"},
+		))
+		require.EqualError(t, err, `invalid kind "Pod" of Helm valuesFrom env, must be ConfigMap or Secret`)
+	})
+}
+
+func TestHelmValuesListerGetter(t *testing.T) {
+	labels := map[string]string{common.LabelKeyHelmValues: "true"}
+	kubeClient := fake.NewClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "env", Namespace: "argocd", Labels: labels},
+			Data:       map[string]string{"values.yaml": "region: eu-west-1\n"},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "unlabeled", Namespace: "argocd"},
+			Data:       map[string]string{"values.yaml": "foo: bar\n"},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "apps", Labels: labels},
+			Data:       map[string][]byte{"values.yaml": []byte("password: s3cr3t\n")},
+		},
+	)
+	factory := NewHelmValuesInformerFactory(kubeClient, "", 0)
+	getter := NewHelmValuesListerGetter(factory)
+	factory.Start(t.Context().Done())
+	factory.WaitForCacheSync(t.Context().Done())
+
+	values, err := GetHelmValuesFrom(t.Context(), getter, "argocd", helmValuesSource(
+		argoappv1.HelmValuesFromSource{Kind: argoappv1.HelmValuesFromKindConfigMap, Name: "env"},
+	))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"region: eu-west-1\n"}, values.GetValues())
+
+	values, err = GetHelmValuesFrom(t.Context(), getter, "apps", helmValuesSource(
+		argoappv1.HelmValuesFromSource{Kind: argoappv1.HelmValuesFromKindSecret, Name: "creds"},
+	))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"password: s3cr3t\n"}, values.GetValues())
+
+	// only the labeled ConfigMaps and Secrets are informed about
+	_, err = GetHelmValuesFrom(t.Context(), getter, "argocd", helmValuesSource(
+		argoappv1.HelmValuesFromSource{Kind: argoappv1.HelmValuesFromKindConfigMap, Name: "unlabeled"},
+	))
+	require.EqualError(t, err, "ConfigMap argocd/unlabeled referenced by Helm valuesFrom not found or not labeled with argocd.argoproj.io/helm-values=true")
+}
+
+func TestWithoutHelmValuesFromSecrets(t *testing.T) {
+	source := argoappv1.ApplicationSource{Helm: &argoappv1.ApplicationSourceHelm{ValuesFrom: []argoappv1.HelmValuesFromSource{
+		{Kind: argoappv1.HelmValuesFromKindConfigMap, Name: "defaults"},
+		{Kind: argoappv1.HelmValuesFromKindSecret, Name: "credentials"},
+	}}}
+
+	result := WithoutHelmValuesFromSecrets(source)
+	assert.Equal(t, []argoappv1.HelmValuesFromSource{{Kind: argoappv1.HelmValuesFromKindConfigMap, Name: "defaults"}}, result.Helm.ValuesFrom)
+	// the given source is not modified
+	assert.Len(t, source.Helm.ValuesFrom, 2)
+
+	assert.Equal(t, argoappv1.ApplicationSource{Path: "."}, WithoutHelmValuesFromSecrets(argoappv1.ApplicationSource{Path: "."}))
+}
+
+func TestHelmValuesFrom_Redact(t *testing.T) {
+	kubeClient := fake.NewClientset(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "argocd", Labels: map[string]string{common.LabelKeyHelmValues: "true"}},
+			Data:       map[string][]byte{"values.yaml": []byte("db:\n  password: s3cr3t\n  token: 0123456789abcdef-token\n  user: ab\n")},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "env", Namespace: "argocd", Labels: map[string]string{common.LabelKeyHelmValues: "true"}},
+			Data:       map[string]string{"values.yaml": "endpoint: https://api.example.com\n"},
+		},
+	)
+	getter := NewHelmValuesClientGetter(kubeClient)
+	secretValues, err := GetHelmValuesFrom(t.Context(), getter, "argocd", helmValuesSource(
+		argoappv1.HelmValuesFromSource{Kind: argoappv1.HelmValuesFromKindSecret, Name: "creds"},
+	))
+	require.NoError(t, err)
+	configMapValues, err := GetHelmValuesFrom(t.Context(), getter, "argocd", helmValuesSource(
+		argoappv1.HelmValuesFromSource{Kind: argoappv1.HelmValuesFromKindConfigMap, Name: "env"},
+	))
+	require.NoError(t, err)
+	values := configMapValues.Merge(secretValues)
+	assert.Len(t, values.GetValues(), 2)
+
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]any{"name": "app"},
+		"data": map[string]any{
+			"PASSWORD":     "s3cr3t",
+			"DATABASE_URL": "postgres://s3cr3t@db",
+			"USER":         "ab",
+			"ENDPOINT":     "https://api.example.com",
+			"TOKEN":        "0123456789abcdef-token",
+		},
+		"args": []any{"--token=0123456789abcdef-token", "--password=s3cr3t"},
+	}}
+	assert.True(t, values.Redact(obj))
+	// short values are only redacted where they are the whole string
+	assert.Equal(t, map[string]any{
+		"PASSWORD":     "++++++++",
+		"DATABASE_URL": "postgres://s3cr3t@db",
+		"USER":         "ab",
+		"ENDPOINT":     "https://api.example.com",
+		"TOKEN":        "++++++++",
+	}, obj.Object["data"])
+	assert.Equal(t, []any{"--token=++++++++", "--password=s3cr3t"}, obj.Object["args"])
+
+	assert.False(t, values.Redact(obj))
+	assert.False(t, configMapValues.Redact(obj))
+	var nilValues *HelmValuesFrom
+	assert.False(t, nilValues.Redact(obj))
+}
+
+func TestHelmValuesFromHash(t *testing.T) {
+	assert.Empty(t, HelmValuesFromHash(nil))
+	assert.Equal(t, HelmValuesFromHash([]string{"a: b\n"}), HelmValuesFromHash([]string{"a: b\n"}))
+	assert.NotEqual(t, HelmValuesFromHash([]string{"a: b\n"}), HelmValuesFromHash([]string{"a: c\n"}))
+	assert.NotEqual(t, HelmValuesFromHash([]string{"a", "b"}), HelmValuesFromHash([]string{"ab"}))
+	assert.NotEqual(t, HelmValuesFromHash([]string{"a", "b"}), HelmValuesFromHash([]string{"b", "a"}))
+}
@@ -3,6 +3,7 @@ package managedfields
 import (
 	"bytes"
 	"fmt"
+	"slices"
 
 	log "github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -85,6 +86,114 @@ func normalize(mf metav1.ManagedFieldsEntry, tr *typedResults) error {
 	return nil
 }
 
+// DriftManagers compares the live and config states and returns the
+// managers owning the fields of the live object that differ from the
+// config, in the order they appear in the live managed fields. If pt is
+// nil, the comparison will use a deduced parseable type which means that
+// lists and maps are compared atomically. It returns nil if live or config
+// are nil.
+func DriftManagers(live, config *unstructured.Unstructured, pt *typed.ParseableType) ([]string, error) {
+	if live == nil || config == nil {
+		return nil, nil
+	}
+	if pt == nil {
+		pt = &typed.DeducedParseableType
+	}
+	results, err := newTypedResults(live, config, pt)
+	if err != nil {
+		return nil, err
+	}
+	drifted := results.comparison.Modified.Union(results.comparison.Removed)
+	if drifted.Empty() {
+		return nil, nil
+	}
+
+	var managers []string
+	for _, mf := range live.GetManagedFields() {
+		if mf.FieldsV1 == nil || slices.Contains(managers, mf.Manager) {
+			continue
+		}
+		mfs := &fieldpath.Set{}
+		if err := mfs.FromJSON(bytes.NewReader(mf.FieldsV1.Raw)); err != nil {
+			return nil, fmt.Errorf("error parsing fields of manager %s: %w", mf.Manager, err)
+		}
+		if ownsAny(mfs, drifted) {
+			managers = append(managers, mf.Manager)
+		}
+	}
+	return managers, nil
+}
+
+// Conflict is a field of the config whose value differs from the live
+// state while it is owned by another manager.
+type Conflict struct {
+	Manager string
+	Field   string
+}
+
+// Conflicts compares the live and config states and returns the fields
+// set by config to a different value while they are owned by a manager
+// other than the given ones, as server-side apply would report them. If
+// pt is nil, the comparison will use a deduced parseable type which means
+// that lists and maps are compared atomically. It returns nil if live or
+// config are nil.
+func Conflicts(live, config *unstructured.Unstructured, managers []string, pt *typed.ParseableType) ([]Conflict, error) {
+	if live == nil || config == nil {
+		return nil, nil
+	}
+	if pt == nil {
+		pt = &typed.DeducedParseableType
+	}
+	results, err := newTypedResults(live, config, pt)
+	if err != nil {
+		return nil, err
+	}
+	modified := results.comparison.Modified
+	if modified.Empty() {
+		return nil, nil
+	}
+
+	var conflicts []Conflict
+	for _, mf := range live.GetManagedFields() {
+		if mf.FieldsV1 == nil || mf.Subresource != "" || slices.Contains(managers, mf.Manager) {
+			continue
+		}
+		mfs := &fieldpath.Set{}
+		if err := mfs.FromJSON(bytes.NewReader(mf.FieldsV1.Raw)); err != nil {
+			return nil, fmt.Errorf("error parsing fields of manager %s: %w", mf.Manager, err)
+		}
+		mfs.Iterate(func(path fieldpath.Path) {
+			for i := len(path); i > 0; i-- {
+				if !modified.Has(path[:i]) {
+					continue
+				}
+				conflict := Conflict{Manager: mf.Manager, Field: path.String()}
+				if !slices.Contains(conflicts, conflict) {
+					conflicts = append(conflicts, conflict)
+				}
+				return
+			}
+		})
+	}
+	return conflicts, nil
+}
+
+// ownsAny returns true if the managed set contains one of the given fields
+// or one of their children. The latter happens when fields are compared
+// atomically while the managed set is more granular.
+func ownsAny(managed *fieldpath.Set, fields *fieldpath.Set) bool {
+	if !managed.Intersection(fields).Empty() {
+		return true
+	}
+	owns := false
+	managed.Iterate(func(path fieldpath.Path) {
+		for i := 1; !owns && i < len(path); i++ {
+			owns = fields.Has(path[:i])
+		}
+	})
+	return owns
+}
+
 type typedResults struct {
 	live       *typed.TypedValue
 	config     *typed.TypedValue
@@ -155,6 +155,97 @@ func TestNormalize(t *testing.T) {
 	})
 }
 
+func TestDriftManagers(t *testing.T) {
+	parser := scheme.StaticParser()
+	pt := parser.Type("io.k8s.api.apps.v1.Deployment")
+	t.Run("will return the managers of the drifted fields", func(t *testing.T) {
+		liveState := StrToUnstructured(testdata.LiveDeploymentWithManagedReplicaYaml)
+		desiredState := liveState.DeepCopy()
+		require.NoError(t, unstructured.SetNestedField(desiredState.Object, int64(5), "spec", "replicas"))
+
+		managers, err := managedfields.DriftManagers(liveState, desiredState, &pt)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"argocd", "kube-controller-manager"}, managers)
+	})
+	t.Run("will match granular managed fields with a deduced type", func(t *testing.T) {
+		liveState := StrToUnstructured(testdata.LiveDeploymentWithManagedReplicaYaml)
+		desiredState := liveState.DeepCopy()
+		containers, _, err := unstructured.NestedSlice(desiredState.Object, "spec", "template", "spec", "containers")
+		require.NoError(t, err)
+		containers[0].(map[string]any)["image"] = "quay.io/argoprojlabs/argocd-e2e-container:0.2"
+		require.NoError(t, unstructured.SetNestedSlice(desiredState.Object, containers, "spec", "template", "spec", "containers"))
+
+		managers, err := managedfields.DriftManagers(liveState, desiredState, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"argocd"}, managers)
+	})
+	t.Run("will return nil if there is no drift", func(t *testing.T) {
+		liveState := StrToUnstructured(testdata.LiveDeploymentWithManagedReplicaYaml)
+
+		managers, err := managedfields.DriftManagers(liveState, liveState.DeepCopy(), &pt)
+
+		require.NoError(t, err)
+		assert.Nil(t, managers)
+	})
+	t.Run("will return nil if live is nil", func(t *testing.T) {
+		managers, err := managedfields.DriftManagers(nil, StrToUnstructured(testdata.DesiredDeploymentYaml), &pt)
+
+		require.NoError(t, err)
+		assert.Nil(t, managers)
+	})
+}
+
+func TestConflicts(t *testing.T) {
+	parser := scheme.StaticParser()
+	pt := parser.Type("io.k8s.api.apps.v1.Deployment")
+	t.Run("will return the fields owned by other managers", func(t *testing.T) {
+		liveState := StrToUnstructured(testdata.LiveDeploymentWithManagedReplicaYaml)
+		desiredState := liveState.DeepCopy()
+		require.NoError(t, unstructured.SetNestedField(desiredState.Object, int64(5), "spec", "replicas"))
+		require.NoError(t, unstructured.SetNestedField(desiredState.Object, int64(10), "spec", "revisionHistoryLimit"))
+
+		conflicts, err := managedfields.Conflicts(liveState, desiredState, []string{"argocd"}, &pt)
+
+		require.NoError(t, err)
+		assert.Equal(t, []managedfields.Conflict{
+			{Manager: "revision-history-manager", Field: ".spec.revisionHistoryLimit"},
+			{Manager: "kube-controller-manager", Field: ".spec.replicas"},
+		}, conflicts)
+	})
+	t.Run("will match granular managed fields with a deduced type", func(t *testing.T) {
+		liveState := StrToUnstructured(testdata.LiveDeploymentWithManagedReplicaYaml)
+		desiredState := liveState.DeepCopy()
+		containers, _, err := unstructured.NestedSlice(desiredState.Object, "spec", "template", "spec", "containers")
+		require.NoError(t, err)
+		containers[0].(map[string]any)["image"] = "quay.io/argoprojlabs/argocd-e2e-container:0.2"
+		require.NoError(t, unstructured.SetNestedSlice(desiredState.Object, containers, "spec", "template", "spec", "containers"))
+
+		conflicts, err := managedfields.Conflicts(liveState, desiredState, nil, nil)
+
+		require.NoError(t, err)
+		assert.Contains(t, conflicts, managedfields.Conflict{Manager: "argocd", Field: `.spec.template.spec.containers[name="guestbook-ui"].image`})
+		for _, conflict := range conflicts {
+			assert.Equal(t, "argocd", conflict.Manager)
+		}
+	})
+	t.Run("will return nil if the values are equal", func(t *testing.T) {
+		liveState := StrToUnstructured(testdata.LiveDeploymentWithManagedReplicaYaml)
+
+		conflicts, err := managedfields.Conflicts(liveState, liveState.DeepCopy(), nil, &pt)
+
+		require.NoError(t, err)
+		assert.Nil(t, conflicts)
+	})
+	t.Run("will return nil if live is nil", func(t *testing.T) {
+		conflicts, err := managedfields.Conflicts(nil, StrToUnstructured(testdata.DesiredDeploymentYaml), nil, &pt)
+
+		require.NoError(t, err)
+		assert.Nil(t, conflicts)
+	})
+}
+
 func validateNestedFloat64(t *testing.T, expected float64, obj *unstructured.Unstructured, fields ...string) {
 	t.Helper()
 	current := getNestedFloat64(t, obj, fields...)
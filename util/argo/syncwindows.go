@@ -0,0 +1,58 @@
+package argo
+
+import (
+	"fmt"
+	"slices"
+
+	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/ical"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+// GetSyncWindows returns the sync windows which apply to the application, from its project and from its own spec. The
+// dates of the calendars referenced by the windows are added to the excluded dates of the returned windows.
+func GetSyncWindows(app *argoappv1.Application, proj *argoappv1.AppProject, settingsMgr *settings.SettingsManager) (*argoappv1.SyncWindows, error) {
+	windows := app.GetSyncWindows(proj)
+	if !windows.HasWindows() {
+		return windows, nil
+	}
+
+	resolved := make(argoappv1.SyncWindows, 0, len(*windows))
+	calendars := map[argoappv1.SyncWindowCalendar][]string{}
+	for _, window := range *windows {
+		if window.Calendar == nil {
+			resolved = append(resolved, window)
+			continue
+		}
+		dates, ok := calendars[*window.Calendar]
+		if !ok {
+			var err error
+			dates, err = getCalendarDates(*window.Calendar, settingsMgr)
+			if err != nil {
+				return nil, err
+			}
+			calendars[*window.Calendar] = dates
+		}
+		window = window.DeepCopy()
+		window.ExcludedDates = append(slices.Clone(window.ExcludedDates), dates...)
+		resolved = append(resolved, window)
+	}
+	return &resolved, nil
+}
+
+// getCalendarDates returns the dates of the events of the given calendar
+func getCalendarDates(calendar argoappv1.SyncWindowCalendar, settingsMgr *settings.SettingsManager) ([]string, error) {
+	cm, err := settingsMgr.GetConfigMapByName(calendar.ConfigMap)
+	if err != nil {
+		return nil, fmt.Errorf("error getting calendar ConfigMap %s: %w", calendar.ConfigMap, err)
+	}
+	data, ok := cm.Data[calendar.Key]
+	if !ok {
+		return nil, fmt.Errorf("calendar ConfigMap %s has no key %s", calendar.ConfigMap, calendar.Key)
+	}
+	dates, err := ical.ParseDates(data)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing calendar %s/%s: %w", calendar.ConfigMap, calendar.Key, err)
+	}
+	return dates, nil
+}
@@ -0,0 +1,112 @@
+package argo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/test"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+func TestGetSyncWindows(t *testing.T) {
+	today := time.Now().UTC().Format("20060102")
+	calendar := "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;VALUE=DATE:" + today + "\nSUMMARY:Holiday\nEND:VEVENT\nEND:VCALENDAR\n"
+	cm := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "holidays",
+			Namespace: test.FakeArgoCDNamespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: map[string]string{
+			"holidays.ics": calendar,
+			"invalid.ics":  "BEGIN:VEVENT\nEND:VEVENT\n",
+		},
+	}
+	settingsMgr := settings.NewSettingsManager(t.Context(), fake.NewClientset(&cm), test.FakeArgoCDNamespace)
+
+	app := &argoappv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-app"},
+		Spec: argoappv1.ApplicationSpec{
+			Destination: argoappv1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "default"},
+		},
+	}
+	projWindow := &argoappv1.SyncWindow{Kind: "allow", Schedule: "* * * * *", Duration: "1h", Applications: []string{"*"}, ExcludedDates: []string{"2000-01-01"}}
+	proj := &argoappv1.AppProject{
+		Spec: argoappv1.AppProjectSpec{
+			SyncWindows: argoappv1.SyncWindows{projWindow},
+		},
+	}
+
+	t.Run("No calendar", func(t *testing.T) {
+		windows, err := GetSyncWindows(app, proj, settingsMgr)
+		require.NoError(t, err)
+		require.Len(t, *windows, 1)
+		assert.Same(t, projWindow, (*windows)[0])
+	})
+
+	t.Run("Calendar dates are excluded", func(t *testing.T) {
+		proj := &argoappv1.AppProject{Spec: argoappv1.AppProjectSpec{SyncWindows: argoappv1.SyncWindows{{
+			Kind:         "allow",
+			Schedule:     "* * * * *",
+			Duration:     "1h",
+			Applications: []string{"*"},
+			Calendar:     &argoappv1.SyncWindowCalendar{ConfigMap: "holidays", Key: "holidays.ics"},
+		}}}}
+		windows, err := GetSyncWindows(app, proj, settingsMgr)
+		require.NoError(t, err)
+		require.Len(t, *windows, 1)
+		assert.Equal(t, []string{time.Now().UTC().Format(time.DateOnly)}, (*windows)[0].ExcludedDates)
+		// the spec of the project is not modified
+		assert.Empty(t, proj.Spec.SyncWindows[0].ExcludedDates)
+
+		// the project window is inactive today, which blocks the syncs
+		canSync, err := windows.CanSync(false)
+		require.NoError(t, err)
+		assert.False(t, canSync)
+	})
+
+	t.Run("Missing key", func(t *testing.T) {
+		app := app.DeepCopy()
+		app.Spec.SyncWindows = argoappv1.SyncWindows{{
+			Kind:     "deny",
+			Schedule: "* * * * *",
+			Duration: "1h",
+			Calendar: &argoappv1.SyncWindowCalendar{ConfigMap: "holidays", Key: "missing.ics"},
+		}}
+		_, err := GetSyncWindows(app, proj, settingsMgr)
+		require.EqualError(t, err, "calendar ConfigMap holidays has no key missing.ics")
+	})
+
+	t.Run("Invalid calendar", func(t *testing.T) {
+		app := app.DeepCopy()
+		app.Spec.SyncWindows = argoappv1.SyncWindows{{
+			Kind:     "deny",
+			Schedule: "* * * * *",
+			Duration: "1h",
+			Calendar: &argoappv1.SyncWindowCalendar{ConfigMap: "holidays", Key: "invalid.ics"},
+		}}
+		_, err := GetSyncWindows(app, proj, settingsMgr)
+		require.EqualError(t, err, "error parsing calendar holidays/invalid.ics: event without DTSTART")
+	})
+
+	t.Run("Missing ConfigMap", func(t *testing.T) {
+		app := app.DeepCopy()
+		app.Spec.SyncWindows = argoappv1.SyncWindows{{
+			Kind:     "deny",
+			Schedule: "* * * * *",
+			Duration: "1h",
+			Calendar: &argoappv1.SyncWindowCalendar{ConfigMap: "missing", Key: "holidays.ics"},
+		}}
+		_, err := GetSyncWindows(app, proj, settingsMgr)
+		require.ErrorContains(t, err, "error getting calendar ConfigMap missing")
+	})
+}
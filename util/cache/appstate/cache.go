@@ -20,6 +20,8 @@ var (
 
 const (
 	clusterInfoCacheExpiration = 10 * time.Minute
+	// resourceDriftHistoryExpiration is refreshed every time the drift history of the application changes
+	resourceDriftHistoryExpiration = 7 * 24 * time.Hour
 )
 
 type Cache struct {
@@ -71,6 +73,18 @@ func (c *Cache) SetAppManagedResources(appName string, managedResources []*appv1
 	return c.SetItem(appManagedResourcesKey(appName), managedResources, c.appStateCacheExpiration, managedResources == nil)
 }
 
+func appResourceDriftHistoryKey(appName string) string {
+	return "app|drift-history|" + appName
+}
+
+func (c *Cache) GetAppResourceDriftHistory(appName string, res *[]*appv1.ResourceDriftEpisode) error {
+	return c.GetItem(appResourceDriftHistoryKey(appName), &res)
+}
+
+func (c *Cache) SetAppResourceDriftHistory(appName string, history []*appv1.ResourceDriftEpisode) error {
+	return c.SetItem(appResourceDriftHistoryKey(appName), history, resourceDriftHistoryExpiration, history == nil)
+}
+
 func appResourcesTreeKey(appName string, shard int64) string {
 	key := "app|resources-tree|" + appName
 	if shard > 0 {
@@ -0,0 +1,425 @@
+package cosign
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testDigest  = "sha256:6e9ba6f4c5d9a5e4f5b4d7f0ad6b8cd43a2b5ce3c5d0a5a4c1f8f6b3d3a2c1e0"
+	testIssuer  = "https://issuer.example.com"
+	testSubject = "release@example.com"
+)
+
+func newKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return key
+}
+
+func publicKeyPEM(t *testing.T, key *ecdsa.PrivateKey) string {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func sign(t *testing.T, key *ecdsa.PrivateKey, data []byte) []byte {
+	t.Helper()
+	digest := sha256.Sum256(data)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	require.NoError(t, err)
+	return sig
+}
+
+func simpleSigningPayloadFor(digest string) []byte {
+	return fmt.Appendf(nil, `{"critical":{"identity":{"docker-reference":"registry.example.com/manifests"},"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"},"optional":null}`, digest)
+}
+
+func signatureLayer(t *testing.T, key *ecdsa.PrivateKey, digest string) Layer {
+	t.Helper()
+	payload := simpleSigningPayloadFor(digest)
+	return Layer{
+		MediaType:   SimpleSigningMediaType,
+		Annotations: map[string]string{signatureAnnotation: base64.StdEncoding.EncodeToString(sign(t, key, payload))},
+		Content:     payload,
+	}
+}
+
+func attestationLayer(t *testing.T, key *ecdsa.PrivateKey, digest, predicateType string) Layer {
+	t.Helper()
+	_, hexDigest, _ := strings.Cut(digest, ":")
+	payload := fmt.Appendf(nil, `{"_type":"https://in-toto.io/Statement/v0.1","predicateType":%q,"subject":[{"name":"registry.example.com/manifests","digest":{"sha256":%q}}],"predicate":{}}`, predicateType, hexDigest)
+	sig := sign(t, key, PreAuthEncoding(inTotoPayloadType, payload))
+	env, err := json.Marshal(map[string]any{
+		"payloadType": inTotoPayloadType,
+		"payload":     base64.StdEncoding.EncodeToString(payload),
+		"signatures":  []map[string]string{{"keyid": "", "sig": base64.StdEncoding.EncodeToString(sig)}},
+	})
+	require.NoError(t, err)
+	return Layer{MediaType: DSSEMediaType, Annotations: map[string]string{"predicateType": predicateType}, Content: env}
+}
+
+// keylessFixture is a certificate authority and a transparency log issuing keyless signatures
+type keylessFixture struct {
+	caKey   *ecdsa.PrivateKey
+	caCert  *x509.Certificate
+	caPEM   []byte
+	logKey  *ecdsa.PrivateKey
+	logPEM  []byte
+	logID   string
+	subject string
+	issuer  string
+}
+
+func newKeylessFixture(t *testing.T) *keylessFixture {
+	t.Helper()
+	caKey := newKey(t)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-24 * time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	logKey := newKey(t)
+	logID, err := KeyID(&logKey.PublicKey)
+	require.NoError(t, err)
+	return &keylessFixture{
+		caKey:   caKey,
+		caCert:  caCert,
+		caPEM:   pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		logKey:  logKey,
+		logPEM:  []byte(publicKeyPEM(t, logKey)),
+		logID:   logID,
+		subject: testSubject,
+		issuer:  testIssuer,
+	}
+}
+
+func (f *keylessFixture) trustRoot(t *testing.T) *TrustRoot {
+	t.Helper()
+	trustRoot, err := NewTrustRoot(f.caPEM, f.logPEM)
+	require.NoError(t, err)
+	return trustRoot
+}
+
+// certificate issues a short-lived signing certificate, which expired before now, like the ones issued by Fulcio
+func (f *keylessFixture) certificate(t *testing.T, key *ecdsa.PrivateKey, signedAt time.Time) []byte {
+	t.Helper()
+	issuer, err := asn1.Marshal(f.issuer)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:    big.NewInt(2),
+		NotBefore:       signedAt.Add(-time.Minute),
+		NotAfter:        signedAt.Add(9 * time.Minute),
+		KeyUsage:        x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		EmailAddresses:  []string{f.subject},
+		ExtraExtensions: []pkix.Extension{{Id: oidIssuerV2, Value: issuer}},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, f.caCert, &key.PublicKey, f.caKey)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+// bundle returns a transparency log entry of the signed content with the given hash
+func (f *keylessFixture) bundle(t *testing.T, cert []byte, sig []byte, contentHash []byte, signedAt time.Time) string {
+	t.Helper()
+	body, err := json.Marshal(map[string]any{
+		"apiVersion": "0.0.1",
+		"kind":       "hashedrekord",
+		"spec": map[string]any{
+			"data": map[string]any{"hash": map[string]string{"algorithm": "sha256", "value": hex.EncodeToString(contentHash)}},
+			"signature": map[string]any{
+				"content":   base64.StdEncoding.EncodeToString(sig),
+				"publicKey": map[string]string{"content": base64.StdEncoding.EncodeToString(cert)},
+			},
+		},
+	})
+	require.NoError(t, err)
+	payload := bundlePayload{
+		Body:           base64.StdEncoding.EncodeToString(body),
+		IntegratedTime: signedAt.Unix(),
+		LogID:          f.logID,
+		LogIndex:       42,
+	}
+	canonical, err := json.Marshal(payload)
+	require.NoError(t, err)
+	data, err := json.Marshal(bundle{
+		SignedEntryTimestamp: base64.StdEncoding.EncodeToString(sign(t, f.logKey, canonical)),
+		Payload:              payload,
+	})
+	require.NoError(t, err)
+	return string(data)
+}
+
+func (f *keylessFixture) signatureLayer(t *testing.T, digest string) Layer {
+	t.Helper()
+	key := newKey(t)
+	signedAt := time.Now().Add(-time.Hour)
+	payload := simpleSigningPayloadFor(digest)
+	sig := sign(t, key, payload)
+	cert := f.certificate(t, key, signedAt)
+	hash := sha256.Sum256(payload)
+	return Layer{
+		MediaType: SimpleSigningMediaType,
+		Annotations: map[string]string{
+			signatureAnnotation:   base64.StdEncoding.EncodeToString(sig),
+			certificateAnnotation: string(cert),
+			chainAnnotation:       string(f.caPEM),
+			bundleAnnotation:      f.bundle(t, cert, sig, hash[:], signedAt),
+		},
+		Content: payload,
+	}
+}
+
+func TestVerifier_Verify_PublicKey(t *testing.T) {
+	key := newKey(t)
+	keyID, err := KeyID(&key.PublicKey)
+	require.NoError(t, err)
+	policy, err := NewPolicy([]string{publicKeyPEM(t, key)}, nil, nil)
+	require.NoError(t, err)
+	verifier := NewVerifier(policy, nil)
+
+	t.Run("valid signature", func(t *testing.T) {
+		result, err := verifier.Verify(testDigest, []Layer{signatureLayer(t, newKey(t), testDigest), signatureLayer(t, key, testDigest)}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, testDigest, result.Digest)
+		assert.Equal(t, []Signer{{KeyID: keyID}}, result.Signers)
+	})
+
+	t.Run("untrusted key", func(t *testing.T) {
+		_, err := verifier.Verify(testDigest, []Layer{signatureLayer(t, newKey(t), testDigest)}, nil)
+		require.ErrorContains(t, err, "no valid cosign signature found")
+		require.ErrorContains(t, err, "not made by any of the trusted public keys")
+	})
+
+	t.Run("no signature", func(t *testing.T) {
+		_, err := verifier.Verify(testDigest, nil, nil)
+		require.ErrorContains(t, err, "no cosign signature found")
+	})
+
+	t.Run("signature of another digest", func(t *testing.T) {
+		other := "sha256:" + hex.EncodeToString(make([]byte, 32))
+		_, err := verifier.Verify(testDigest, []Layer{signatureLayer(t, key, other)}, nil)
+		require.ErrorContains(t, err, "signature is for digest "+other)
+	})
+
+	t.Run("manipulated payload", func(t *testing.T) {
+		layer := signatureLayer(t, key, testDigest)
+		layer.Content = simpleSigningPayloadFor("sha256:" + hex.EncodeToString(make([]byte, 32)))
+		_, err := verifier.Verify(testDigest, []Layer{layer}, nil)
+		require.ErrorContains(t, err, "no valid cosign signature found")
+	})
+}
+
+func TestVerifier_Verify_Keyless(t *testing.T) {
+	f := newKeylessFixture(t)
+	policy, err := NewPolicy(nil, []Identity{{Issuer: testIssuer, Subject: "*@example.com"}}, nil)
+	require.NoError(t, err)
+
+	t.Run("valid signature", func(t *testing.T) {
+		result, err := NewVerifier(policy, f.trustRoot(t)).Verify(testDigest, []Layer{f.signatureLayer(t, testDigest)}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []Signer{{Issuer: testIssuer, Subject: testSubject}}, result.Signers)
+		require.NoError(t, policy.Check(testDigest, result))
+	})
+
+	t.Run("without trust root", func(t *testing.T) {
+		_, err := NewVerifier(policy, nil).Verify(testDigest, []Layer{f.signatureLayer(t, testDigest)}, nil)
+		require.ErrorContains(t, err, "without a cosign trust root")
+	})
+
+	t.Run("untrusted identity", func(t *testing.T) {
+		other, err := NewPolicy(nil, []Identity{{Issuer: testIssuer, Subject: "someone@example.org"}}, nil)
+		require.NoError(t, err)
+		_, err = NewVerifier(other, f.trustRoot(t)).Verify(testDigest, []Layer{f.signatureLayer(t, testDigest)}, nil)
+		require.ErrorContains(t, err, "identity release@example.com issued by https://issuer.example.com, which is not trusted")
+	})
+
+	t.Run("untrusted certificate authority", func(t *testing.T) {
+		_, err := NewVerifier(policy, newKeylessFixture(t).trustRoot(t)).Verify(testDigest, []Layer{f.signatureLayer(t, testDigest)}, nil)
+		require.ErrorContains(t, err, "transparency log "+f.logID+" is not trusted")
+
+		other := newKeylessFixture(t)
+		trustRoot, err := NewTrustRoot(other.caPEM, f.logPEM)
+		require.NoError(t, err)
+		_, err = NewVerifier(policy, trustRoot).Verify(testDigest, []Layer{f.signatureLayer(t, testDigest)}, nil)
+		require.ErrorContains(t, err, "untrusted signing certificate")
+	})
+
+	t.Run("missing transparency log entry", func(t *testing.T) {
+		layer := f.signatureLayer(t, testDigest)
+		delete(layer.Annotations, bundleAnnotation)
+		_, err := NewVerifier(policy, f.trustRoot(t)).Verify(testDigest, []Layer{layer}, nil)
+		require.ErrorContains(t, err, "keyless signature has no transparency log entry")
+	})
+
+	t.Run("manipulated transparency log entry", func(t *testing.T) {
+		layer := f.signatureLayer(t, testDigest)
+		var b bundle
+		require.NoError(t, json.Unmarshal([]byte(layer.Annotations[bundleAnnotation]), &b))
+		b.Payload.IntegratedTime = time.Now().Unix()
+		data, err := json.Marshal(b)
+		require.NoError(t, err)
+		layer.Annotations[bundleAnnotation] = string(data)
+		_, err = NewVerifier(policy, f.trustRoot(t)).Verify(testDigest, []Layer{layer}, nil)
+		require.ErrorContains(t, err, "invalid signed entry timestamp")
+	})
+
+	t.Run("transparency log entry of other content", func(t *testing.T) {
+		layer := f.signatureLayer(t, testDigest)
+		other := f.signatureLayer(t, testDigest)
+		layer.Annotations[bundleAnnotation] = other.Annotations[bundleAnnotation]
+		_, err := NewVerifier(policy, f.trustRoot(t)).Verify(testDigest, []Layer{layer}, nil)
+		require.ErrorContains(t, err, "transparency log entry does not match the signature")
+	})
+}
+
+func TestVerifier_Verify_Attestations(t *testing.T) {
+	key := newKey(t)
+	keyID, err := KeyID(&key.PublicKey)
+	require.NoError(t, err)
+	policy, err := NewPolicy([]string{publicKeyPEM(t, key)}, nil, []string{"https://slsa.dev/provenance/v1"})
+	require.NoError(t, err)
+	verifier := NewVerifier(policy, nil)
+	assert.True(t, verifier.RequiresAttestations())
+	signatures := []Layer{signatureLayer(t, key, testDigest)}
+
+	t.Run("required attestation", func(t *testing.T) {
+		provenance := attestationLayer(t, key, testDigest, "https://slsa.dev/provenance/v1")
+		sbom := attestationLayer(t, key, testDigest, "https://spdx.dev/Document")
+		result, err := verifier.Verify(testDigest, signatures, []Layer{sbom, provenance})
+		require.NoError(t, err)
+		assert.Equal(t, []Attestation{
+			{PredicateType: "https://spdx.dev/Document", Signer: Signer{KeyID: keyID}},
+			{PredicateType: "https://slsa.dev/provenance/v1", Signer: Signer{KeyID: keyID}},
+		}, result.Attestations)
+	})
+
+	t.Run("missing attestation", func(t *testing.T) {
+		sbom := attestationLayer(t, key, testDigest, "https://spdx.dev/Document")
+		_, err := verifier.Verify(testDigest, signatures, []Layer{sbom})
+		require.ErrorContains(t, err, "no attestation of type https://slsa.dev/provenance/v1 made by a signer allowed in AppProject found")
+	})
+
+	t.Run("attestation by untrusted key", func(t *testing.T) {
+		provenance := attestationLayer(t, newKey(t), testDigest, "https://slsa.dev/provenance/v1")
+		_, err := verifier.Verify(testDigest, signatures, []Layer{provenance})
+		require.ErrorContains(t, err, "not made by any of the trusted public keys")
+	})
+
+	t.Run("attestation of another digest", func(t *testing.T) {
+		other := "sha256:" + hex.EncodeToString(make([]byte, 32))
+		provenance := attestationLayer(t, key, other, "https://slsa.dev/provenance/v1")
+		_, err := verifier.Verify(testDigest, signatures, []Layer{provenance})
+		require.ErrorContains(t, err, "attestation of type https://slsa.dev/provenance/v1 is not about "+testDigest)
+	})
+}
+
+func TestPolicy_Check(t *testing.T) {
+	key := newKey(t)
+	keyID, err := KeyID(&key.PublicKey)
+	require.NoError(t, err)
+	policy, err := NewPolicy([]string{publicKeyPEM(t, key)}, []Identity{{Issuer: testIssuer, Subject: testSubject}}, []string{"https://slsa.dev/provenance/v1"})
+	require.NoError(t, err)
+
+	result := &Result{
+		Digest:       testDigest,
+		Signers:      []Signer{{KeyID: keyID}},
+		Attestations: []Attestation{{PredicateType: "https://slsa.dev/provenance/v1", Signer: Signer{Issuer: testIssuer, Subject: testSubject}}},
+	}
+	parsed, err := ParseResult(result.String())
+	require.NoError(t, err)
+	assert.Equal(t, result, parsed)
+	require.NoError(t, policy.Check(testDigest, parsed))
+
+	require.ErrorContains(t, policy.Check("sha256:other", parsed), "signature verification result is for digest "+testDigest)
+
+	removed, err := NewPolicy([]string{publicKeyPEM(t, newKey(t))}, []Identity{{Issuer: testIssuer, Subject: testSubject}}, nil)
+	require.NoError(t, err)
+	require.ErrorContains(t, removed.Check(testDigest, parsed), "made by key "+keyID+", but none of them is allowed in AppProject")
+
+	parsed.Attestations[0].Signer.Subject = "someone@example.org"
+	require.ErrorContains(t, policy.Check(testDigest, parsed), "no attestation of type https://slsa.dev/provenance/v1")
+
+	_, err = ParseResult("not json")
+	require.Error(t, err)
+}
+
+func TestNewPolicy(t *testing.T) {
+	_, err := NewPolicy(nil, nil, []string{"https://slsa.dev/provenance/v1"})
+	require.ErrorContains(t, err, "at least one public key or identity is required")
+	_, err = NewPolicy([]string{"not a key"}, nil, nil)
+	require.ErrorContains(t, err, "invalid public key at index 0")
+	_, err = NewPolicy(nil, []Identity{{Issuer: testIssuer}}, nil)
+	require.ErrorContains(t, err, "identity at index 0 requires an issuer and a subject")
+	_, err = NewPolicy(nil, []Identity{{Issuer: testIssuer, Subject: "[a"}}, nil)
+	require.ErrorContains(t, err, "invalid subject of identity at index 0")
+	_, err = NewPolicy([]string{publicKeyPEM(t, newKey(t))}, nil, []string{""})
+	require.ErrorContains(t, err, "attestation at index 0 requires a predicate type")
+
+	policy, err := NewPolicy(nil, []Identity{{Issuer: "https://token.actions.githubusercontent.com", Subject: "https://github.com/example/*"}}, nil)
+	require.NoError(t, err)
+	assert.False(t, policy.RequiresAttestations())
+	assert.True(t, policy.Trusts(Signer{Issuer: "https://token.actions.githubusercontent.com", Subject: "https://github.com/example/manifests/.github/workflows/release.yaml@refs/tags/v1.0.0"}))
+	assert.False(t, policy.Trusts(Signer{Issuer: "https://accounts.google.com", Subject: "https://github.com/example/manifests"}))
+	assert.False(t, policy.Trusts(Signer{}))
+}
+
+func TestLoadTrustRoot(t *testing.T) {
+	trustRoot, err := LoadTrustRoot(filepath.Join(t.TempDir(), "does-not-exist"))
+	require.NoError(t, err)
+	assert.Nil(t, trustRoot)
+
+	f := newKeylessFixture(t)
+	dir := t.TempDir()
+	_, err = LoadTrustRoot(dir)
+	require.ErrorContains(t, err, "failed to read certificate authorities")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, CertificateAuthoritiesFile), f.caPEM, 0o644))
+	_, err = LoadTrustRoot(dir)
+	require.ErrorContains(t, err, "failed to read transparency log keys")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, TransparencyLogKeysFile), f.logPEM, 0o644))
+	trustRoot, err = LoadTrustRoot(dir)
+	require.NoError(t, err)
+	assert.Contains(t, trustRoot.logKeys, f.logID)
+
+	_, err = NewTrustRoot(nil, f.logPEM)
+	require.ErrorContains(t, err, "does not contain any root certificate authority")
+	_, err = NewTrustRoot(f.caPEM, nil)
+	require.ErrorContains(t, err, "does not contain any transparency log key")
+}
+
+func TestTags(t *testing.T) {
+	assert.Equal(t, "sha256-abc.sig", SignatureTag("sha256:abc"))
+	assert.Equal(t, "sha256-abc.att", AttestationTag("sha256:abc"))
+}
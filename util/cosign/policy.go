@@ -0,0 +1,199 @@
+package cosign
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gobwas/glob"
+)
+
+// Identity is a keyless signing identity. Issuer and Subject are glob patterns which are matched against the OIDC
+// issuer and the subject alternative name of the signing certificate.
+type Identity struct {
+	Issuer  string
+	Subject string
+}
+
+type trustedKey struct {
+	id  string
+	key crypto.PublicKey
+}
+
+type trustedIdentity struct {
+	issuer  glob.Glob
+	subject glob.Glob
+}
+
+// Policy defines the signers which are trusted to sign OCI artifacts and the attestations which are required for them
+type Policy struct {
+	keys         []trustedKey
+	identities   []trustedIdentity
+	attestations []string
+}
+
+// NewPolicy returns a policy trusting the given PEM encoded public keys and keyless identities, and requiring
+// attestations of the given in-toto predicate types
+func NewPolicy(publicKeys []string, identities []Identity, attestations []string) (*Policy, error) {
+	if len(publicKeys) == 0 && len(identities) == 0 {
+		return nil, errors.New("at least one public key or identity is required")
+	}
+	p := &Policy{attestations: attestations}
+	for i, data := range publicKeys {
+		key, err := ParsePublicKey([]byte(data))
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key at index %d: %w", i, err)
+		}
+		id, err := KeyID(key)
+		if err != nil {
+			return nil, err
+		}
+		p.keys = append(p.keys, trustedKey{id: id, key: key})
+	}
+	for i, identity := range identities {
+		if identity.Issuer == "" || identity.Subject == "" {
+			return nil, fmt.Errorf("identity at index %d requires an issuer and a subject", i)
+		}
+		issuer, err := glob.Compile(identity.Issuer)
+		if err != nil {
+			return nil, fmt.Errorf("invalid issuer of identity at index %d: %w", i, err)
+		}
+		subject, err := glob.Compile(identity.Subject)
+		if err != nil {
+			return nil, fmt.Errorf("invalid subject of identity at index %d: %w", i, err)
+		}
+		p.identities = append(p.identities, trustedIdentity{issuer: issuer, subject: subject})
+	}
+	for i, predicateType := range attestations {
+		if predicateType == "" {
+			return nil, fmt.Errorf("attestation at index %d requires a predicate type", i)
+		}
+	}
+	return p, nil
+}
+
+// RequiresAttestations returns true if the policy requires OCI artifacts to carry attestations
+func (p *Policy) RequiresAttestations() bool {
+	return len(p.attestations) > 0
+}
+
+// Trusts returns true if the given signer is trusted by the policy
+func (p *Policy) Trusts(signer Signer) bool {
+	if signer.KeyID != "" {
+		return slices.ContainsFunc(p.keys, func(k trustedKey) bool {
+			return k.id == signer.KeyID
+		})
+	}
+	if signer.Issuer == "" || signer.Subject == "" {
+		return false
+	}
+	return slices.ContainsFunc(p.identities, func(i trustedIdentity) bool {
+		return i.issuer.Match(signer.Issuer) && i.subject.Match(signer.Subject)
+	})
+}
+
+// Check returns an error unless the result shows that the artifact with the given digest is signed by a trusted
+// signer and carries all required attestations, signed by trusted signers as well
+func (p *Policy) Check(digest string, result *Result) error {
+	if result.Digest != digest {
+		return fmt.Errorf("signature verification result is for digest %s, expected %s", result.Digest, digest)
+	}
+	if !slices.ContainsFunc(result.Signers, p.Trusts) {
+		signers := make([]string, 0, len(result.Signers))
+		for _, signer := range result.Signers {
+			signers = append(signers, signer.String())
+		}
+		return fmt.Errorf("found signatures of %s made by %s, but none of them is allowed in AppProject", digest, strings.Join(signers, ", "))
+	}
+	for _, predicateType := range p.attestations {
+		if !slices.ContainsFunc(result.Attestations, func(a Attestation) bool {
+			return a.PredicateType == predicateType && p.Trusts(a.Signer)
+		}) {
+			return fmt.Errorf("no attestation of type %s made by a signer allowed in AppProject found for %s", predicateType, digest)
+		}
+	}
+	return nil
+}
+
+// Signer identifies the public key or the keyless identity which signed an artifact
+type Signer struct {
+	// KeyID is the hex encoded SHA-256 fingerprint of the public key
+	KeyID string `json:"keyID,omitempty"`
+	// Issuer is the OIDC issuer of a keyless signing certificate
+	Issuer string `json:"issuer,omitempty"`
+	// Subject is the subject alternative name of a keyless signing certificate
+	Subject string `json:"subject,omitempty"`
+}
+
+func (s Signer) String() string {
+	if s.KeyID != "" {
+		return "key " + s.KeyID
+	}
+	return fmt.Sprintf("identity %s issued by %s", s.Subject, s.Issuer)
+}
+
+// Attestation is a verified in-toto attestation of an artifact
+type Attestation struct {
+	PredicateType string `json:"predicateType"`
+	Signer        Signer `json:"signer"`
+}
+
+// Result is the outcome of verifying the signatures and attestations of an artifact
+type Result struct {
+	Digest       string        `json:"digest"`
+	Signers      []Signer      `json:"signers"`
+	Attestations []Attestation `json:"attestations,omitempty"`
+}
+
+func (r *Result) String() string {
+	// marshaling cannot fail, the result consists of strings only
+	data, _ := json.Marshal(r)
+	return string(data)
+}
+
+// ParseResult parses a verification result as returned by Result.String
+func ParseResult(data string) (*Result, error) {
+	var result Result
+	if err := json.Unmarshal([]byte(data), &result); err != nil {
+		return nil, fmt.Errorf("failed to parse signature verification result: %w", err)
+	}
+	return &result, nil
+}
+
+// ParsePublicKey parses a PEM encoded ECDSA, RSA or Ed25519 public key
+func ParsePublicKey(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	switch key.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", key)
+	}
+}
+
+// KeyID returns the hex encoded SHA-256 fingerprint of the DER encoding of the given public key
+func KeyID(key crypto.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal public key: %w", err)
+	}
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,88 @@
+package cosign
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// CertificateAuthoritiesFile is the name of the file holding the PEM encoded certificate authorities, e.g. of
+	// Fulcio, which issue keyless signing certificates
+	CertificateAuthoritiesFile = "fulcio.pem"
+	// TransparencyLogKeysFile is the name of the file holding the PEM encoded public keys of the transparency logs,
+	// e.g. of Rekor, which prove the time keyless signatures were made at
+	TransparencyLogKeysFile = "rekor.pub"
+)
+
+// TrustRoot holds the certificate authorities and transparency log keys used to verify keyless signatures
+type TrustRoot struct {
+	roots         *x509.CertPool
+	intermediates *x509.CertPool
+	logKeys       map[string]crypto.PublicKey
+}
+
+// LoadTrustRoot loads the trust root from the CertificateAuthoritiesFile and TransparencyLogKeysFile in the given
+// directory. It returns nil if the directory does not exist, in which case keyless signatures cannot be verified.
+func LoadTrustRoot(dir string) (*TrustRoot, error) {
+	certificates, err := os.ReadFile(filepath.Join(dir, CertificateAuthoritiesFile))
+	if errors.Is(err, os.ErrNotExist) {
+		if _, statErr := os.Stat(dir); errors.Is(statErr, os.ErrNotExist) {
+			return nil, nil
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read certificate authorities of cosign trust root: %w", err)
+	}
+	logKeys, err := os.ReadFile(filepath.Join(dir, TransparencyLogKeysFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read transparency log keys of cosign trust root: %w", err)
+	}
+	return NewTrustRoot(certificates, logKeys)
+}
+
+// NewTrustRoot returns a trust root of the given PEM encoded certificate authorities and transparency log keys. Self
+// signed certificates are trusted as roots, all other certificates are used as intermediates.
+func NewTrustRoot(certificates, logKeys []byte) (*TrustRoot, error) {
+	t := &TrustRoot{
+		roots:         x509.NewCertPool(),
+		intermediates: x509.NewCertPool(),
+		logKeys:       map[string]crypto.PublicKey{},
+	}
+	hasRoot := false
+	for block, rest := pem.Decode(certificates); block != nil; block, rest = pem.Decode(rest) {
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid certificate authority: %w", err)
+		}
+		if bytes.Equal(cert.RawIssuer, cert.RawSubject) && cert.CheckSignatureFrom(cert) == nil {
+			t.roots.AddCert(cert)
+			hasRoot = true
+		} else {
+			t.intermediates.AddCert(cert)
+		}
+	}
+	if !hasRoot {
+		return nil, errors.New("cosign trust root does not contain any root certificate authority")
+	}
+	for block, rest := pem.Decode(logKeys); block != nil; block, rest = pem.Decode(rest) {
+		key, err := ParsePublicKey(pem.EncodeToMemory(block))
+		if err != nil {
+			return nil, fmt.Errorf("invalid transparency log key: %w", err)
+		}
+		id, err := KeyID(key)
+		if err != nil {
+			return nil, err
+		}
+		t.logKeys[id] = key
+	}
+	if len(t.logKeys) == 0 {
+		return nil, errors.New("cosign trust root does not contain any transparency log key")
+	}
+	return t, nil
+}
@@ -0,0 +1,409 @@
+package cosign
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// SimpleSigningMediaType is the media type of the layers of a cosign signature image
+	SimpleSigningMediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+	// DSSEMediaType is the media type of the layers of a cosign attestation image
+	DSSEMediaType = "application/vnd.dsse.envelope.v1+json"
+
+	signatureAnnotation   = "dev.cosignproject.cosign/signature"
+	certificateAnnotation = "dev.sigstore.cosign/certificate"
+	chainAnnotation       = "dev.sigstore.cosign/chain"
+	bundleAnnotation      = "dev.sigstore.cosign/bundle"
+
+	inTotoPayloadType = "application/vnd.in-toto+json"
+)
+
+var (
+	// OIDs of the certificate extensions holding the OIDC issuer of a keyless signing certificate, the first one
+	// holding the raw value and the second one a DER encoded string
+	oidIssuerV1 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+	oidIssuerV2 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}
+)
+
+// SignatureTag returns the tag cosign stores the signatures of the image with the given digest under
+func SignatureTag(digest string) string {
+	return strings.Replace(digest, ":", "-", 1) + ".sig"
+}
+
+// AttestationTag returns the tag cosign stores the attestations of the image with the given digest under
+func AttestationTag(digest string) string {
+	return strings.Replace(digest, ":", "-", 1) + ".att"
+}
+
+// Layer is a layer of a cosign signature or attestation image
+type Layer struct {
+	MediaType   string
+	Annotations map[string]string
+	Content     []byte
+}
+
+// Verifier verifies cosign signatures and attestations against a policy
+type Verifier struct {
+	policy    *Policy
+	trustRoot *TrustRoot
+}
+
+// NewVerifier returns a verifier for the given policy. Keyless signatures can only be verified if a trust root is
+// given.
+func NewVerifier(policy *Policy, trustRoot *TrustRoot) *Verifier {
+	return &Verifier{policy: policy, trustRoot: trustRoot}
+}
+
+// RequiresAttestations returns true if the attestations of artifacts need to be verified
+func (v *Verifier) RequiresAttestations() bool {
+	return v.policy.RequiresAttestations()
+}
+
+// Verify verifies the signature and attestation layers of the artifact with the given digest. It returns an error
+// unless the artifact is signed by a trusted signer and carries all attestations required by the policy.
+func (v *Verifier) Verify(digest string, signatures, attestations []Layer) (*Result, error) {
+	result := &Result{Digest: digest}
+	var errs []error
+	for _, layer := range signatures {
+		signer, err := v.verifySignature(digest, layer)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		result.Signers = append(result.Signers, *signer)
+	}
+	if len(result.Signers) == 0 {
+		if len(errs) == 0 {
+			return nil, fmt.Errorf("no cosign signature found for %s", digest)
+		}
+		return nil, fmt.Errorf("no valid cosign signature found for %s: %w", digest, errors.Join(errs...))
+	}
+
+	errs = nil
+	if v.policy.RequiresAttestations() {
+		for _, layer := range attestations {
+			attestation, err := v.verifyAttestation(digest, layer)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			result.Attestations = append(result.Attestations, *attestation)
+		}
+	}
+	if err := v.policy.Check(digest, result); err != nil {
+		return nil, errors.Join(append([]error{err}, errs...)...)
+	}
+	return result, nil
+}
+
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+func (v *Verifier) verifySignature(digest string, layer Layer) (*Signer, error) {
+	if layer.MediaType != SimpleSigningMediaType {
+		return nil, fmt.Errorf("unexpected media type %s of signature", layer.MediaType)
+	}
+	sig, err := base64.StdEncoding.DecodeString(layer.Annotations[signatureAnnotation])
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature annotation: %w", err)
+	}
+	logHash := sha256.Sum256(layer.Content)
+	signer, err := v.verifyBlob(layer.Content, sig, layer.Annotations, logHash[:])
+	if err != nil {
+		return nil, err
+	}
+	var payload simpleSigningPayload
+	if err := json.Unmarshal(layer.Content, &payload); err != nil {
+		return nil, fmt.Errorf("invalid signature payload: %w", err)
+	}
+	if payload.Critical.Image.DockerManifestDigest != digest {
+		return nil, fmt.Errorf("signature is for digest %s", payload.Critical.Image.DockerManifestDigest)
+	}
+	return signer, nil
+}
+
+type envelope struct {
+	PayloadType string `json:"payloadType"`
+	Payload     string `json:"payload"`
+	Signatures  []struct {
+		Sig string `json:"sig"`
+	} `json:"signatures"`
+}
+
+type statement struct {
+	PredicateType string `json:"predicateType"`
+	Subject       []struct {
+		Digest map[string]string `json:"digest"`
+	} `json:"subject"`
+}
+
+func (v *Verifier) verifyAttestation(digest string, layer Layer) (*Attestation, error) {
+	if layer.MediaType != DSSEMediaType {
+		return nil, fmt.Errorf("unexpected media type %s of attestation", layer.MediaType)
+	}
+	var env envelope
+	if err := json.Unmarshal(layer.Content, &env); err != nil {
+		return nil, fmt.Errorf("invalid attestation envelope: %w", err)
+	}
+	if env.PayloadType != inTotoPayloadType {
+		return nil, fmt.Errorf("unexpected payload type %s of attestation", env.PayloadType)
+	}
+	payload, err := base64.StdEncoding.DecodeString(env.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("invalid attestation payload: %w", err)
+	}
+
+	pae := PreAuthEncoding(env.PayloadType, payload)
+	logHash := sha256.Sum256(payload)
+	var signer *Signer
+	var errs []error
+	for _, s := range env.Signatures {
+		sig, err := base64.StdEncoding.DecodeString(s.Sig)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid attestation signature: %w", err))
+			continue
+		}
+		signer, err = v.verifyBlob(pae, sig, layer.Annotations, logHash[:])
+		if err == nil {
+			break
+		}
+		errs = append(errs, err)
+	}
+	if signer == nil {
+		if len(errs) == 0 {
+			return nil, errors.New("attestation is not signed")
+		}
+		return nil, errors.Join(errs...)
+	}
+
+	var st statement
+	if err := json.Unmarshal(payload, &st); err != nil {
+		return nil, fmt.Errorf("invalid attestation statement: %w", err)
+	}
+	algorithm, hexDigest, _ := strings.Cut(digest, ":")
+	for _, subject := range st.Subject {
+		if subject.Digest[algorithm] == hexDigest {
+			return &Attestation{PredicateType: st.PredicateType, Signer: *signer}, nil
+		}
+	}
+	return nil, fmt.Errorf("attestation of type %s is not about %s", st.PredicateType, digest)
+}
+
+// PreAuthEncoding returns the DSSE pre-authentication encoding of the payload, which is what the signatures of an
+// envelope are made over
+func PreAuthEncoding(payloadType string, payload []byte) []byte {
+	return fmt.Appendf(nil, "DSSEv1 %d %s %d %s", len(payloadType), payloadType, len(payload), payload)
+}
+
+// verifyBlob verifies the signature of the data and returns its signer. The signature is either made by one of the
+// trusted keys or, if the annotations hold a certificate, by a keyless identity. The hash is used to match the
+// transparency log entry of keyless signatures.
+func (v *Verifier) verifyBlob(data, sig []byte, annotations map[string]string, logHash []byte) (*Signer, error) {
+	if annotations[certificateAnnotation] != "" {
+		return v.verifyKeyless(data, sig, annotations, logHash)
+	}
+	for _, k := range v.policy.keys {
+		if VerifySignature(k.key, data, sig) == nil {
+			return &Signer{KeyID: k.id}, nil
+		}
+	}
+	return nil, errors.New("signature was not made by any of the trusted public keys")
+}
+
+func (v *Verifier) verifyKeyless(data, sig []byte, annotations map[string]string, logHash []byte) (*Signer, error) {
+	if v.trustRoot == nil {
+		return nil, errors.New("keyless signature cannot be verified without a cosign trust root")
+	}
+	cert, err := parseCertificate([]byte(annotations[certificateAnnotation]))
+	if err != nil {
+		return nil, fmt.Errorf("invalid signing certificate: %w", err)
+	}
+	signer := Signer{Issuer: certificateIssuer(cert), Subject: certificateSubject(cert)}
+	if !v.policy.Trusts(signer) {
+		return nil, fmt.Errorf("signature was made by %s, which is not trusted", signer)
+	}
+	signedAt, err := v.verifyBundle(annotations[bundleAnnotation], cert, logHash)
+	if err != nil {
+		return nil, err
+	}
+
+	intermediates := v.trustRoot.intermediates.Clone()
+	chain := []byte(annotations[chainAnnotation])
+	for block, rest := pem.Decode(chain); block != nil; block, rest = pem.Decode(rest) {
+		if c, err := x509.ParseCertificate(block.Bytes); err == nil {
+			intermediates.AddCert(c)
+		}
+	}
+	_, err = cert.Verify(x509.VerifyOptions{
+		Roots:         v.trustRoot.roots,
+		Intermediates: intermediates,
+		CurrentTime:   signedAt,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("untrusted signing certificate: %w", err)
+	}
+	if err := VerifySignature(cert.PublicKey, data, sig); err != nil {
+		return nil, err
+	}
+	return &signer, nil
+}
+
+type bundle struct {
+	SignedEntryTimestamp string        `json:"SignedEntryTimestamp"`
+	Payload              bundlePayload `json:"Payload"`
+}
+
+// bundlePayload is the transparency log entry the signed entry timestamp is made over. The fields are ordered as in
+// the canonical JSON encoding which is signed.
+type bundlePayload struct {
+	Body           string `json:"body"`
+	IntegratedTime int64  `json:"integratedTime"`
+	LogID          string `json:"logID"`
+	LogIndex       int64  `json:"logIndex"`
+}
+
+// verifyBundle verifies the transparency log entry of a keyless signature and returns the time it was logged at,
+// which is the time the signing certificate needs to be valid at
+func (v *Verifier) verifyBundle(data string, cert *x509.Certificate, logHash []byte) (time.Time, error) {
+	if data == "" {
+		return time.Time{}, errors.New("keyless signature has no transparency log entry")
+	}
+	var b bundle
+	if err := json.Unmarshal([]byte(data), &b); err != nil {
+		return time.Time{}, fmt.Errorf("invalid transparency log entry: %w", err)
+	}
+	key, ok := v.trustRoot.logKeys[b.Payload.LogID]
+	if !ok {
+		return time.Time{}, fmt.Errorf("transparency log %s is not trusted", b.Payload.LogID)
+	}
+	set, err := base64.StdEncoding.DecodeString(b.SignedEntryTimestamp)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid signed entry timestamp: %w", err)
+	}
+	canonical, err := json.Marshal(b.Payload)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if err := VerifySignature(key, canonical, set); err != nil {
+		return time.Time{}, fmt.Errorf("invalid signed entry timestamp: %w", err)
+	}
+	body, err := base64.StdEncoding.DecodeString(b.Payload.Body)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid transparency log entry body: %w", err)
+	}
+	if !entryReferences(body, cert, logHash) {
+		return time.Time{}, errors.New("transparency log entry does not match the signature")
+	}
+	return time.Unix(b.Payload.IntegratedTime, 0), nil
+}
+
+// entryReferences returns true if the body of a transparency log entry refers to both the signed content, by its
+// hex encoded SHA-256 hash, and to the signing certificate, by its base64 encoded PEM representation. This holds for
+// all kinds of entries cosign creates.
+func entryReferences(body []byte, cert *x509.Certificate, logHash []byte) bool {
+	var entry any
+	if err := json.Unmarshal(body, &entry); err != nil {
+		return false
+	}
+	hash := hex.EncodeToString(logHash)
+	hasHash, hasCert := false, false
+	var walk func(any)
+	walk = func(value any) {
+		switch value := value.(type) {
+		case map[string]any:
+			for _, v := range value {
+				walk(v)
+			}
+		case []any:
+			for _, v := range value {
+				walk(v)
+			}
+		case string:
+			if value == hash {
+				hasHash = true
+			} else if data, err := base64.StdEncoding.DecodeString(value); err == nil {
+				if c, err := parseCertificate(data); err == nil && c.Equal(cert) {
+					hasCert = true
+				}
+			}
+		}
+	}
+	walk(entry)
+	return hasHash && hasCert
+}
+
+func parseCertificate(data []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func certificateIssuer(cert *x509.Certificate) string {
+	var issuer string
+	for _, ext := range cert.Extensions {
+		switch {
+		case ext.Id.Equal(oidIssuerV2):
+			var value string
+			if _, err := asn1.Unmarshal(ext.Value, &value); err == nil {
+				return value
+			}
+		case ext.Id.Equal(oidIssuerV1):
+			issuer = string(ext.Value)
+		}
+	}
+	return issuer
+}
+
+func certificateSubject(cert *x509.Certificate) string {
+	if len(cert.EmailAddresses) > 0 {
+		return cert.EmailAddresses[0]
+	}
+	if len(cert.URIs) > 0 {
+		return cert.URIs[0].String()
+	}
+	return ""
+}
+
+// VerifySignature verifies that the signature of the data is made by the given ECDSA, RSA or Ed25519 public key. ECDSA
+// and RSA signatures are made over the SHA-256 digest of the data.
+func VerifySignature(key crypto.PublicKey, data, sig []byte) error {
+	digest := sha256.Sum256(data)
+	switch key := key.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(key, digest[:], sig) {
+			return errors.New("invalid ECDSA signature")
+		}
+		return nil
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig)
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, data, sig) {
+			return errors.New("invalid Ed25519 signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported public key type %T", key)
+	}
+}
@@ -0,0 +1,345 @@
+package cue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/ast"
+	"cuelang.org/go/cue/build"
+	"cuelang.org/go/cue/cuecontext"
+	cueerrors "cuelang.org/go/cue/errors"
+	"cuelang.org/go/cue/load"
+	"cuelang.org/go/mod/module"
+	"golang.org/x/sync/semaphore"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/env"
+)
+
+// ModuleDir is the name of the directory which marks the root of a CUE module
+const ModuleDir = "cue.mod"
+
+// IsModule returns true if the given directory is the root of a CUE module
+func IsModule(dir string) bool {
+	info, err := os.Stat(filepath.Join(dir, ModuleDir))
+	return err == nil && info.IsDir()
+}
+
+// Version returns the version of the CUE Go API manifests are evaluated with
+func Version() string {
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, dep := range info.Deps {
+			if dep.Path == "cuelang.org/go" {
+				return dep.Version
+			}
+		}
+	}
+	return "unknown"
+}
+
+// evaluationTimeout is the time after which a CUE evaluation is abandoned, like the commands of the other config
+// management tools
+var evaluationTimeout = env.ParseDurationFromEnv("ARGOCD_EXEC_TIMEOUT", 90*time.Second, 0, math.MaxInt64)
+
+// evaluations bounds the number of concurrent CUE evaluations. The CUE evaluator cannot be interrupted, so an abandoned
+// evaluation holds its slot until it completes, which bounds the resources used by runaway evaluations.
+var evaluations = semaphore.NewWeighted(int64(runtime.GOMAXPROCS(0)))
+
+// evaluate runs the given CUE evaluation, which is abandoned once the context is done or after the evaluation timeout.
+func evaluate[T any](ctx context.Context, f func() (T, error)) (T, error) {
+	var zero T
+	if evaluationTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, evaluationTimeout)
+		defer cancel()
+	}
+	if err := evaluations.Acquire(ctx, 1); err != nil {
+		return zero, fmt.Errorf("failed to wait for CUE evaluation: %w", err)
+	}
+	type result struct {
+		value T
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		defer evaluations.Release(1)
+		value, err := f()
+		done <- result{value: value, err: err}
+	}()
+	select {
+	case res := <-done:
+		return res.value, res.err
+	case <-ctx.Done():
+		return zero, fmt.Errorf("CUE evaluation abandoned: %w", ctx.Err())
+	}
+}
+
+// Evaluate evaluates the CUE package in the given app path with the given options and returns the Kubernetes objects
+// it yields. The objects are either a single object, or lists and structs of objects, which are flattened in order.
+func Evaluate(ctx context.Context, appPath string, repoRoot string, source *v1alpha1.ApplicationSourceCUE, env *v1alpha1.Env) ([]*unstructured.Unstructured, error) {
+	return evaluate(ctx, func() ([]*unstructured.Unstructured, error) {
+		return evaluateObjects(appPath, repoRoot, source, env)
+	})
+}
+
+func evaluateObjects(appPath string, repoRoot string, source *v1alpha1.ApplicationSourceCUE, env *v1alpha1.Env) ([]*unstructured.Unstructured, error) {
+	if source == nil {
+		source = &v1alpha1.ApplicationSourceCUE{}
+	}
+	ctx := cuecontext.New()
+	_, value, err := buildInstance(ctx, appPath, repoRoot, source, env)
+	if err != nil {
+		return nil, err
+	}
+	if source.Expression != "" {
+		value = ctx.CompileString(source.Expression, cue.Scope(value), cue.InferBuiltins(true), cue.Filename("expression"))
+		if err := value.Err(); err != nil {
+			return nil, fmt.Errorf("failed to evaluate CUE expression %q: %s", source.Expression, formatError(err, repoRoot))
+		}
+	}
+	var objs []*unstructured.Unstructured
+	if err := collectObjects(value, repoRoot, &objs); err != nil {
+		return nil, err
+	}
+	return objs, nil
+}
+
+// Tags returns the tags declared by @tag() attributes in the CUE package in the given app path, in order of
+// declaration. The value of each tag is the default value of the field it is injected into, given the tags of the
+// source, or empty if the field has no concrete value.
+func Tags(ctx context.Context, appPath string, repoRoot string, source *v1alpha1.ApplicationSourceCUE, env *v1alpha1.Env) ([]*v1alpha1.CUETag, error) {
+	return evaluate(ctx, func() ([]*v1alpha1.CUETag, error) {
+		return evaluateTags(appPath, repoRoot, source, env)
+	})
+}
+
+func evaluateTags(appPath string, repoRoot string, source *v1alpha1.ApplicationSourceCUE, env *v1alpha1.Env) ([]*v1alpha1.CUETag, error) {
+	if source == nil {
+		source = &v1alpha1.ApplicationSourceCUE{}
+	}
+	ctx := cuecontext.New()
+	inst, value, err := buildInstance(ctx, appPath, repoRoot, source, env)
+	if err != nil {
+		return nil, err
+	}
+	var tags []*v1alpha1.CUETag
+	seen := map[string]bool{}
+	for _, file := range inst.Files {
+		for _, field := range taggedFields(file.Decls, nil) {
+			if seen[field.tag] {
+				continue
+			}
+			seen[field.tag] = true
+			tags = append(tags, &v1alpha1.CUETag{Name: field.tag, Value: formatTagValue(value.LookupPath(cue.MakePath(field.path...)))})
+		}
+	}
+	return tags, nil
+}
+
+func buildInstance(ctx *cue.Context, appPath string, repoRoot string, source *v1alpha1.ApplicationSourceCUE, env *v1alpha1.Env) (*build.Instance, cue.Value, error) {
+	moduleRoot, err := findModuleRoot(appPath, repoRoot)
+	if err != nil {
+		return nil, cue.Value{}, err
+	}
+	tags := make([]string, len(source.Tags))
+	for i, tag := range source.Tags {
+		if tag.Value == "" {
+			tags[i] = tag.Name
+		} else {
+			tags[i] = tag.Name + "=" + env.Envsubst(tag.Value)
+		}
+	}
+	insts := load.Instances([]string{"."}, &load.Config{
+		Dir:                 appPath,
+		ModuleRoot:          moduleRoot,
+		Package:             source.Package,
+		Tags:                tags,
+		AcceptLegacyModules: true,
+		// module dependencies must be vendored in the repository: the repo server never fetches them from a registry
+		Registry: noRegistry{},
+		Env:      []string{},
+	})
+	if len(insts) != 1 {
+		return nil, cue.Value{}, fmt.Errorf("expected a single CUE instance, found %d", len(insts))
+	}
+	inst := insts[0]
+	if inst.Err != nil {
+		return nil, cue.Value{}, fmt.Errorf("failed to load CUE package: %s", formatError(inst.Err, repoRoot))
+	}
+	value := ctx.BuildInstance(inst)
+	if err := value.Err(); err != nil {
+		return nil, cue.Value{}, fmt.Errorf("failed to build CUE package: %s", formatError(err, repoRoot))
+	}
+	if source.Values != nil && len(source.Values.Raw) > 0 {
+		data, err := yaml.YAMLToJSON(source.Values.Raw)
+		if err != nil {
+			return nil, cue.Value{}, fmt.Errorf("failed to parse CUE values: %w", err)
+		}
+		value = value.Unify(ctx.CompileBytes(data, cue.Filename("values")))
+		if err := value.Err(); err != nil {
+			return nil, cue.Value{}, fmt.Errorf("failed to unify CUE values: %s", formatError(err, repoRoot))
+		}
+	}
+	return inst, value, nil
+}
+
+// findModuleRoot returns the nearest directory containing a cue.mod directory, starting from the app path and
+// stopping at the repository root. The app path is returned if there is none, so that CUE never looks for a module
+// outside the repository.
+func findModuleRoot(appPath string, repoRoot string) (string, error) {
+	appPath, err := filepath.Abs(appPath)
+	if err != nil {
+		return "", err
+	}
+	repoRoot, err = filepath.Abs(repoRoot)
+	if err != nil {
+		return "", err
+	}
+	for dir := appPath; ; dir = filepath.Dir(dir) {
+		if IsModule(dir) {
+			return dir, nil
+		}
+		if dir == repoRoot || !strings.HasPrefix(dir, repoRoot+string(filepath.Separator)) {
+			return appPath, nil
+		}
+	}
+}
+
+// errRegistryDisabled is returned when a CUE module dependency would be fetched from a registry
+var errRegistryDisabled = errors.New("fetching CUE module dependencies from a registry is not supported: vendor them in the cue.mod directory")
+
+// noRegistry is a CUE module registry which never fetches any module
+type noRegistry struct{}
+
+func (noRegistry) Requirements(context.Context, module.Version) ([]module.Version, error) {
+	return nil, errRegistryDisabled
+}
+
+func (noRegistry) Fetch(context.Context, module.Version) (module.SourceLoc, error) {
+	return module.SourceLoc{}, errRegistryDisabled
+}
+
+func (noRegistry) ModuleVersions(context.Context, string) ([]string, error) {
+	return nil, errRegistryDisabled
+}
+
+func collectObjects(value cue.Value, repoRoot string, objs *[]*unstructured.Unstructured) error {
+	switch value.IncompleteKind() {
+	case cue.StructKind:
+		if value.LookupPath(cue.ParsePath("kind")).Exists() {
+			data, err := value.MarshalJSON()
+			if err != nil {
+				return fmt.Errorf("failed to export %s: %s", describePath(value), formatError(err, repoRoot))
+			}
+			obj := &unstructured.Unstructured{}
+			if err := obj.UnmarshalJSON(data); err != nil {
+				return fmt.Errorf("failed to unmarshal %s: %w", describePath(value), err)
+			}
+			*objs = append(*objs, obj)
+			return nil
+		}
+		fields, err := value.Fields()
+		if err != nil {
+			return fmt.Errorf("failed to iterate %s: %s", describePath(value), formatError(err, repoRoot))
+		}
+		for fields.Next() {
+			if err := collectObjects(fields.Value(), repoRoot, objs); err != nil {
+				return err
+			}
+		}
+		return nil
+	case cue.ListKind:
+		items, err := value.List()
+		if err != nil {
+			return fmt.Errorf("failed to iterate %s: %s", describePath(value), formatError(err, repoRoot))
+		}
+		for items.Next() {
+			if err := collectObjects(items.Value(), repoRoot, objs); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		if err := value.Err(); err != nil {
+			return fmt.Errorf("failed to evaluate %s: %s", describePath(value), formatError(err, repoRoot))
+		}
+		return fmt.Errorf("%s is a %s, not a Kubernetes object", describePath(value), value.IncompleteKind())
+	}
+}
+
+func describePath(value cue.Value) string {
+	if path := value.Path().String(); path != "" {
+		return path
+	}
+	return "the CUE value"
+}
+
+type taggedField struct {
+	tag  string
+	path []cue.Selector
+}
+
+// taggedFields returns the fields annotated with @tag() attributes in the given declarations and the structs nested
+// in them
+func taggedFields(decls []ast.Decl, parent []cue.Selector) []taggedField {
+	var fields []taggedField
+	for _, decl := range decls {
+		field, ok := decl.(*ast.Field)
+		if !ok {
+			continue
+		}
+		if _, _, err := ast.LabelName(field.Label); err != nil {
+			continue
+		}
+		path := append(append([]cue.Selector{}, parent...), cue.Label(field.Label))
+		for _, attr := range field.Attrs {
+			if key, body := attr.Split(); key == "tag" {
+				name, _, _ := strings.Cut(body, ",")
+				fields = append(fields, taggedField{tag: strings.TrimSpace(name), path: path})
+			}
+		}
+		if s, ok := field.Value.(*ast.StructLit); ok {
+			fields = append(fields, taggedFields(s.Elts, path)...)
+		}
+	}
+	return fields
+}
+
+func formatTagValue(value cue.Value) string {
+	value, _ = value.Default()
+	if !value.IsConcrete() {
+		return ""
+	}
+	if s, err := value.String(); err == nil {
+		return s
+	}
+	data, err := value.MarshalJSON()
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// formatError formats all the errors wrapped by the given CUE error, which by default only reports the first one, with
+// positions relative to the repository root
+func formatError(err error, repoRoot string) string {
+	var cueErr cueerrors.Error
+	if !errors.As(err, &cueErr) {
+		return err.Error()
+	}
+	if absRepoRoot, err := filepath.Abs(repoRoot); err == nil {
+		repoRoot = absRepoRoot
+	}
+	return strings.TrimSpace(cueerrors.Details(err, &cueerrors.Config{Cwd: repoRoot, ToSlash: true}))
+}
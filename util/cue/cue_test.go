@@ -0,0 +1,148 @@
+package cue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func names(objs []*unstructured.Unstructured) []string {
+	var res []string
+	for _, obj := range objs {
+		res = append(res, obj.GetKind()+"/"+obj.GetName())
+	}
+	return res
+}
+
+func TestIsModule(t *testing.T) {
+	assert.True(t, IsModule("./testdata/guestbook"))
+	assert.False(t, IsModule("./testdata/guestbook/lib"))
+	assert.False(t, IsModule("./testdata/standalone"))
+}
+
+func TestEvaluate(t *testing.T) {
+	objs, err := Evaluate(t.Context(), "./testdata/guestbook", "./testdata", &v1alpha1.ApplicationSourceCUE{
+		Expression: "objects",
+		Tags:       []v1alpha1.CUETag{{Name: "region", Value: "eu"}},
+	}, &v1alpha1.Env{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Deployment/guestbook-dev", "Service/guestbook-dev"}, names(objs))
+	replicas, _, _ := unstructured.NestedInt64(objs[0].Object, "spec", "replicas")
+	assert.Equal(t, int64(1), replicas)
+}
+
+func TestEvaluate_TagsAndValues(t *testing.T) {
+	objs, err := Evaluate(t.Context(), "./testdata/guestbook", "./testdata", &v1alpha1.ApplicationSourceCUE{
+		Expression: "objects",
+		Tags: []v1alpha1.CUETag{
+			{Name: "env", Value: "$ARGOCD_APP_NAME"},
+			{Name: "replicas", Value: "3"},
+			{Name: "region", Value: "eu"},
+		},
+		Values: &runtime.RawExtension{Raw: []byte(`values: {image: "nginx:1.27"}`)},
+	}, &v1alpha1.Env{{Name: "ARGOCD_APP_NAME", Value: "prod"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Deployment/guestbook-prod", "Service/guestbook-prod"}, names(objs))
+	replicas, _, _ := unstructured.NestedInt64(objs[0].Object, "spec", "replicas")
+	assert.Equal(t, int64(3), replicas)
+	containers, _, _ := unstructured.NestedSlice(objs[0].Object, "spec", "template", "spec", "containers")
+	require.Len(t, containers, 1)
+	assert.Equal(t, "nginx:1.27", containers[0].(map[string]any)["image"])
+}
+
+func TestEvaluate_Expression(t *testing.T) {
+	objs, err := Evaluate(t.Context(), "./testdata/guestbook", "./testdata", &v1alpha1.ApplicationSourceCUE{
+		Expression: "[objects.service]",
+		Tags:       []v1alpha1.CUETag{{Name: "region", Value: "eu"}},
+	}, &v1alpha1.Env{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Service/guestbook-dev"}, names(objs))
+}
+
+func TestEvaluate_Package(t *testing.T) {
+	objs, err := Evaluate(t.Context(), "./testdata/packages", "./testdata", &v1alpha1.ApplicationSourceCUE{Package: "prod", Expression: "objects"}, &v1alpha1.Env{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ConfigMap/prod"}, names(objs))
+
+	_, err = Evaluate(t.Context(), "./testdata/packages", "./testdata", &v1alpha1.ApplicationSourceCUE{Expression: "objects"}, &v1alpha1.Env{})
+	require.ErrorContains(t, err, "failed to load CUE package")
+}
+
+func TestEvaluate_SingleObject(t *testing.T) {
+	objs, err := Evaluate(t.Context(), "./testdata/standalone", "./testdata", nil, &v1alpha1.Env{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ConfigMap/standalone"}, names(objs))
+}
+
+func TestEvaluate_Errors(t *testing.T) {
+	t.Run("Incomplete", func(t *testing.T) {
+		_, err := Evaluate(t.Context(), "./testdata/guestbook", "./testdata", &v1alpha1.ApplicationSourceCUE{Expression: "objects"}, &v1alpha1.Env{})
+		require.ErrorContains(t, err, "service.metadata.labels.region: cannot convert incomplete value")
+		require.ErrorContains(t, err, "guestbook/main.cue:8:10")
+		require.NotContains(t, err.Error(), "/testdata/")
+	})
+	t.Run("NotAnObject", func(t *testing.T) {
+		_, err := Evaluate(t.Context(), "./testdata/guestbook", "./testdata", &v1alpha1.ApplicationSourceCUE{
+			Tags: []v1alpha1.CUETag{{Name: "region", Value: "eu"}},
+		}, &v1alpha1.Env{})
+		require.EqualError(t, err, "env is a string, not a Kubernetes object")
+	})
+	t.Run("UnknownTag", func(t *testing.T) {
+		_, err := Evaluate(t.Context(), "./testdata/guestbook", "./testdata", &v1alpha1.ApplicationSourceCUE{
+			Tags: []v1alpha1.CUETag{{Name: "unknown", Value: "value"}},
+		}, &v1alpha1.Env{})
+		require.ErrorContains(t, err, `no tag for "unknown"`)
+	})
+	t.Run("ConflictingValues", func(t *testing.T) {
+		_, err := Evaluate(t.Context(), "./testdata/guestbook", "./testdata", &v1alpha1.ApplicationSourceCUE{
+			Expression: "objects",
+			Tags:       []v1alpha1.CUETag{{Name: "region", Value: "eu"}},
+			Values:     &runtime.RawExtension{Raw: []byte(`{"replicas": "three"}`)},
+		}, &v1alpha1.Env{})
+		require.ErrorContains(t, err, "failed to unify CUE values")
+	})
+}
+
+func TestTags(t *testing.T) {
+	tags, err := Tags(t.Context(), "./testdata/guestbook", "./testdata", &v1alpha1.ApplicationSourceCUE{
+		Tags: []v1alpha1.CUETag{{Name: "replicas", Value: "2"}},
+	}, &v1alpha1.Env{})
+	require.NoError(t, err)
+	assert.Equal(t, []*v1alpha1.CUETag{
+		{Name: "env", Value: "dev"},
+		{Name: "replicas", Value: "2"},
+		{Name: "region"},
+	}, tags)
+}
+
+func TestEvaluate_RegistryDisabled(t *testing.T) {
+	t.Setenv("CUE_REGISTRY", "registry.example.com")
+	_, err := Evaluate(t.Context(), "./testdata/registry", "./testdata", &v1alpha1.ApplicationSourceCUE{Expression: "objects"}, &v1alpha1.Env{})
+	require.ErrorContains(t, err, "vendor them in the cue.mod directory")
+}
+
+func TestEvaluate_Abandoned(t *testing.T) {
+	release := make(chan struct{})
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+	_, err := evaluate(ctx, func() (string, error) {
+		<-release
+		return "done", nil
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// the abandoned evaluation holds its slot until it completes
+	close(release)
+	value, err := evaluate(t.Context(), func() (string, error) {
+		return "done", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "done", value)
+}
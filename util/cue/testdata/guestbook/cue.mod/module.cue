@@ -0,0 +1,2 @@
+module: "example.com/guestbook"
+language: version: "v0.12.0"
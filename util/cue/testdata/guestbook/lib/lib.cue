@@ -0,0 +1,11 @@
+package lib
+
+#Deployment: {
+	apiVersion: "apps/v1"
+	kind:       "Deployment"
+	metadata: name: string
+	spec: {
+		replicas: int
+		template: spec: containers: [...{name: string, image: string}]
+	}
+}
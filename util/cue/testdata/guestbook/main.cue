@@ -0,0 +1,31 @@
+package guestbook
+
+import "example.com/guestbook/lib"
+
+env:      *"dev" | string @tag(env)
+replicas: *1 | int        @tag(replicas,type=int)
+cluster: {
+	region: string @tag(region)
+}
+
+values: {
+	image: *"gcr.io/heptio-images/ks-guestbook-demo:0.2" | string
+}
+
+objects: {
+	deployment: lib.#Deployment & {
+		metadata: name: "guestbook-\(env)"
+		spec: {
+			"replicas": replicas
+			template: spec: containers: [{name: "guestbook", image: values.image}]
+		}
+	}
+	service: {
+		apiVersion: "v1"
+		kind:       "Service"
+		metadata: {
+			name: "guestbook-\(env)"
+			labels: region: cluster.region
+		}
+	}
+}
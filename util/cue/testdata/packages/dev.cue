@@ -0,0 +1,7 @@
+package dev
+
+objects: [{
+	apiVersion: "v1"
+	kind:       "ConfigMap"
+	metadata: name: "dev"
+}]
@@ -0,0 +1,7 @@
+package prod
+
+objects: [{
+	apiVersion: "v1"
+	kind:       "ConfigMap"
+	metadata: name: "prod"
+}]
@@ -0,0 +1,3 @@
+module: "example.com/registry@v0"
+language: version: "v0.12.0"
+deps: "example.com/lib@v0": v: "v0.1.0"
@@ -0,0 +1,5 @@
+package registry
+
+import "example.com/lib"
+
+objects: lib.objects
@@ -0,0 +1,5 @@
+package standalone
+
+apiVersion: "v1"
+kind:       "ConfigMap"
+metadata: name: "standalone"
@@ -359,6 +359,12 @@ func secretToRepository(secret *corev1.Secret) (*appsv1.Repository, error) {
 	}
 	repository.EnableLFS = enableLfs
 
+	enablePartialClone, err := boolOrFalse(secret, "enablePartialClone")
+	if err != nil {
+		return repository, err
+	}
+	repository.EnablePartialClone = enablePartialClone
+
 	enableOCI, err := boolOrFalse(secret, "enableOCI")
 	if err != nil {
 		return repository, err
@@ -422,6 +428,7 @@ func (s *secretsRepositoryBackend) repositoryToSecret(repository *appsv1.Reposit
 	updateSecretBool(secret, "insecureIgnoreHostKey", repository.InsecureIgnoreHostKey)
 	updateSecretBool(secret, "insecure", repository.Insecure)
 	updateSecretBool(secret, "enableLfs", repository.EnableLFS)
+	updateSecretBool(secret, "enablePartialClone", repository.EnablePartialClone)
 	updateSecretString(secret, "proxy", repository.Proxy)
 	updateSecretString(secret, "noProxy", repository.NoProxy)
 	updateSecretString(secret, "gcpServiceAccountKey", repository.GCPServiceAccountKey)
@@ -12,11 +12,14 @@ import (
 	"net/url"
 	"os"
 	"os/exec"
+	"path"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 	"unicode/utf8"
@@ -121,6 +124,9 @@ type Client interface {
 	IsAnnotatedTag(string) bool
 	ChangedFiles(revision string, targetRevision string) ([]string, error)
 	IsRevisionPresent(revision string) bool
+	// SparseCheckout widens the sparse checkout of a partial clone to include the given paths, relative to the
+	// repository root. The "." path widens it to the whole repository. It is a no-op for full clones.
+	SparseCheckout(paths []string) error
 	// SetAuthor sets the author name and email in the git configuration.
 	SetAuthor(name, email string) (string, error)
 	// CheckoutOrOrphan checks out the branch. If the branch does not exist, it creates an orphan branch.
@@ -162,6 +168,8 @@ type nativeGitClient struct {
 	proxy string
 	// list of targets that shouldn't use the proxy, applies only if the proxy is set
 	noProxy string
+	// Whether the repository is cloned without blobs and with a sparse working tree
+	partialClone bool
 }
 
 type runOpts struct {
@@ -200,6 +208,14 @@ func WithCache(cache gitRefCache, loadRefFromCache bool) ClientOpts {
 	}
 }
 
+// WithPartialClone specifies if the repository should be a partial clone, which fetches the blobs on demand and only
+// checks out the paths passed to SparseCheckout
+func WithPartialClone(enabled bool) ClientOpts {
+	return func(c *nativeGitClient) {
+		c.partialClone = enabled
+	}
+}
+
 // WithEventHandlers sets the git client event handlers
 func WithEventHandlers(handlers EventHandlers) ClientOpts {
 	return func(c *nativeGitClient) {
@@ -236,6 +252,9 @@ func NewClientExt(rawRepoURL string, root string, creds Creds, insecure bool, en
 	return client, nil
 }
 
+// partialCloneFilter is the object filter of partial clones, which fetch the blobs of the checked out files on demand
+const partialCloneFilter = "blob:none"
+
 var gitClientTimeout = env.ParseDurationFromEnv("ARGOCD_GIT_REQUEST_TIMEOUT", 15*time.Second, 0, math.MaxInt64)
 
 // Returns a HTTP client object suitable for go-git to use using the following
@@ -382,11 +401,18 @@ func (m *nativeGitClient) Root() string {
 
 // Init initializes a local git repository and sets the remote origin
 func (m *nativeGitClient) Init() error {
-	_, err := git.PlainOpen(m.root)
+	repo, err := git.PlainOpen(m.root)
 	if err == nil {
-		return nil
-	}
-	if !errors.Is(err, git.ErrRepositoryNotExists) {
+		partialClone, err := isPartialClone(repo)
+		if err != nil {
+			return err
+		}
+		if partialClone == m.partialClone {
+			return nil
+		}
+		// the repository is cloned again when partial clone is toggled
+		log.Infof("Partial clone of %s changed to %t, re-initializing %s", m.repoURL, m.partialClone, m.root)
+	} else if !errors.Is(err, git.ErrRepositoryNotExists) {
 		return err
 	}
 	log.Infof("Initializing %s to %s", m.repoURL, m.root)
@@ -398,7 +424,7 @@ func (m *nativeGitClient) Init() error {
 	if err != nil {
 		return err
 	}
-	repo, err := git.PlainInit(m.root, false)
+	repo, err = git.PlainInit(m.root, false)
 	if err != nil {
 		return err
 	}
@@ -406,7 +432,34 @@ func (m *nativeGitClient) Init() error {
 		Name: git.DefaultRemoteName,
 		URLs: []string{m.repoURL},
 	})
-	return err
+	if err != nil || !m.partialClone {
+		return err
+	}
+	// same configuration as `git clone --filter=blob:none --sparse`, only the files of the root directory are checked
+	// out until the sparse checkout is widened. The repository format is upgraded, since git ignores the worktree
+	// configuration of the sparse checkout in repositories created by go-git otherwise.
+	if _, err := m.config("core.repositoryformatversion", "1"); err != nil {
+		return err
+	}
+	if _, err := m.config("remote.origin.promisor", "true"); err != nil {
+		return err
+	}
+	if _, err := m.config("remote.origin.partialclonefilter", partialCloneFilter); err != nil {
+		return err
+	}
+	if out, err := m.runCmd("sparse-checkout", "set", "--cone"); err != nil {
+		return fmt.Errorf("failed to initialize sparse checkout: %s: %w", out, err)
+	}
+	return nil
+}
+
+// isPartialClone returns true if the origin of the given repository is a promisor remote
+func isPartialClone(repo *git.Repository) (bool, error) {
+	cfg, err := repo.Config()
+	if err != nil {
+		return false, fmt.Errorf("failed to read repository configuration: %w", err)
+	}
+	return cfg.Raw.Section("remote").Subsection(git.DefaultRemoteName).Option("promisor") == "true", nil
 }
 
 // IsLFSEnabled returns true if the repository is LFS enabled
@@ -533,7 +586,12 @@ func (m *nativeGitClient) Checkout(revision string, submoduleEnabled bool) (stri
 	if revision == "" || revision == "HEAD" {
 		revision = "origin/HEAD"
 	}
-	if out, err := m.runCmd("checkout", "--force", revision); err != nil {
+	checkout := m.runCmd
+	if m.partialClone {
+		// the missing blobs of the sparse checkout are fetched from the remote
+		checkout = m.runCredentialedCmdOutput
+	}
+	if out, err := checkout("checkout", "--force", revision); err != nil {
 		return out, fmt.Errorf("failed to checkout %s: %w", revision, err)
 	}
 	// We must populate LFS content by using lfs checkout, if we have at least
@@ -567,6 +625,73 @@ func (m *nativeGitClient) Checkout(revision string, submoduleEnabled bool) (stri
 	return "", nil
 }
 
+// SparseCheckout widens the sparse checkout of a partial clone to include the given paths. The paths are never
+// removed from the sparse checkout, since other requests might still use the checked out files.
+func (m *nativeGitClient) SparseCheckout(paths []string) error {
+	if !m.partialClone {
+		return nil
+	}
+	unlock := lockSparseCheckout(m.root)
+	defer unlock()
+
+	out, err := m.runCmd("config", "--bool", "core.sparseCheckout")
+	if err != nil || strings.TrimSpace(out) != "true" {
+		// the sparse checkout has been widened to the whole repository
+		return nil
+	}
+	out, err = m.runCmd("sparse-checkout", "list")
+	if err != nil {
+		return fmt.Errorf("failed to list sparse checkout: %w", err)
+	}
+	checkedOut := strings.Split(strings.TrimSpace(out), "\n")
+	var missing []string
+	for _, p := range paths {
+		p = path.Clean(filepath.ToSlash(p))
+		if path.IsAbs(p) || p == ".." || strings.HasPrefix(p, "../") {
+			return fmt.Errorf("path %s is outside of the repository", p)
+		}
+		if p == "." {
+			if out, err := m.runCredentialedCmdOutput("sparse-checkout", "disable"); err != nil {
+				return fmt.Errorf("failed to disable sparse checkout: %s: %w", out, err)
+			}
+			return nil
+		}
+		if !isSparseCheckedOut(checkedOut, p) && !slices.Contains(missing, p) {
+			missing = append(missing, p)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	log.Infof("Widening sparse checkout of %s with %s", m.repoURL, strings.Join(missing, ", "))
+	// the checks are skipped so that files can be added, cone mode checks out the files of their parent directory
+	args := append([]string{"sparse-checkout", "add", "--skip-checks", "--"}, missing...)
+	if out, err := m.runCredentialedCmdOutput(args...); err != nil {
+		return fmt.Errorf("failed to widen sparse checkout: %s: %w", out, err)
+	}
+	return nil
+}
+
+// isSparseCheckedOut returns true if the given path is below one of the directories of the sparse checkout
+func isSparseCheckedOut(checkedOut []string, p string) bool {
+	for _, dir := range checkedOut {
+		if dir != "" && (p == dir || strings.HasPrefix(p, dir+"/")) {
+			return true
+		}
+	}
+	return false
+}
+
+var sparseCheckoutLocks sync.Map
+
+// lockSparseCheckout serializes the changes of the sparse checkout of the repository at the given root, since
+// concurrent requests for the same revision share the working tree
+func lockSparseCheckout(root string) func() {
+	lock, _ := sparseCheckoutLocks.LoadOrStore(root, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	return lock.(*sync.Mutex).Unlock
+}
+
 func (m *nativeGitClient) getRefs() ([]*plumbing.Reference, error) {
 	myLockUUID, err := uuid.NewRandom()
 	myLockId := ""
@@ -1074,9 +1199,15 @@ func (m *nativeGitClient) runCmd(args ...string) (string, error) {
 
 // runCredentialedCmd is a convenience function to run a git command with username/password credentials
 func (m *nativeGitClient) runCredentialedCmd(args ...string) error {
+	_, err := m.runCredentialedCmdOutput(args...)
+	return err
+}
+
+// runCredentialedCmdOutput runs a git command with username/password credentials and returns its output
+func (m *nativeGitClient) runCredentialedCmdOutput(args ...string) (string, error) {
 	closer, environ, err := m.creds.Environ()
 	if err != nil {
-		return err
+		return "", err
 	}
 	defer func() { _ = closer.Close() }()
 
@@ -1092,8 +1223,7 @@ func (m *nativeGitClient) runCredentialedCmd(args ...string) error {
 
 	cmd := exec.Command("git", args...)
 	cmd.Env = append(cmd.Env, environ...)
-	_, err = m.runCmdOutput(cmd, runOpts{})
-	return err
+	return m.runCmdOutput(cmd, runOpts{})
 }
 
 func (m *nativeGitClient) runCmdOutput(cmd *exec.Cmd, ropts runOpts) (string, error) {
@@ -474,6 +474,63 @@ func Test_IsRevisionPresent(t *testing.T) {
 	assert.False(t, revisionPresent)
 }
 
+func Test_nativeGitClient_PartialClone(t *testing.T) {
+	origin := t.TempDir()
+	for _, file := range []string{"README", "apps/a/app.yaml", "apps/b/app.yaml", "base/x/base.yaml"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(origin, filepath.Dir(file)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(origin, file), []byte(file), 0o644))
+	}
+	require.NoError(t, runCmd(origin, "git", "init"))
+	require.NoError(t, runCmd(origin, "git", "config", "uploadpack.allowFilter", "true"))
+	require.NoError(t, runCmd(origin, "git", "add", "."))
+	require.NoError(t, runCmd(origin, "git", "commit", "-m", "Initial commit"))
+
+	root := t.TempDir()
+	client, err := NewClientExt("file://"+origin, root, NopCreds{}, true, false, "", "", WithPartialClone(true))
+	require.NoError(t, err)
+	require.NoError(t, client.Init())
+	require.NoError(t, client.Fetch(""))
+	commitSHA, err := client.LsRemote("HEAD")
+	require.NoError(t, err)
+	_, err = client.Checkout(commitSHA, false)
+	require.NoError(t, err)
+
+	out, err := outputCmd(root, "git", "config", "remote.origin.partialclonefilter")
+	require.NoError(t, err)
+	assert.Equal(t, "blob:none", strings.TrimSpace(string(out)))
+	assert.FileExists(t, filepath.Join(root, "README"))
+	assert.NoFileExists(t, filepath.Join(root, "apps/a/app.yaml"))
+	// the blobs of the files which are not checked out are not fetched
+	out, err = outputCmd(root, "git", "rev-list", "--objects", "--missing=print", "HEAD")
+	require.NoError(t, err)
+	assert.Equal(t, 3, strings.Count(string(out), "?"))
+
+	require.NoError(t, client.SparseCheckout([]string{"apps/a", "base/x/base.yaml"}))
+	assert.FileExists(t, filepath.Join(root, "apps/a/app.yaml"))
+	assert.FileExists(t, filepath.Join(root, "base/x/base.yaml"))
+	assert.NoFileExists(t, filepath.Join(root, "apps/b/app.yaml"))
+	// the checked out paths are kept when another revision is checked out
+	_, err = client.Checkout(commitSHA, false)
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(root, "apps/a/app.yaml"))
+
+	require.EqualError(t, client.SparseCheckout([]string{"apps/../../other"}), "path ../other is outside of the repository")
+
+	require.NoError(t, client.SparseCheckout([]string{"."}))
+	assert.FileExists(t, filepath.Join(root, "apps/b/app.yaml"))
+
+	t.Run("Disabled", func(t *testing.T) {
+		client, err := NewClientExt("file://"+origin, root, NopCreds{}, true, false, "", "")
+		require.NoError(t, err)
+		// the partial clone is replaced by a full clone
+		require.NoError(t, client.Init())
+		_, err = outputCmd(root, "git", "config", "remote.origin.promisor")
+		require.Error(t, err)
+		assert.NoFileExists(t, filepath.Join(root, "README"))
+		require.NoError(t, client.SparseCheckout([]string{"apps/a"}))
+	})
+}
+
 func Test_nativeGitClient_RevisionMetadata(t *testing.T) {
 	tempDir := t.TempDir()
 	client, err := NewClient("file://"+tempDir, NopCreds{}, true, false, "", "")
@@ -1087,6 +1087,57 @@ func (_c *Client_SetAuthor_Call) RunAndReturn(run func(name string, email string
 	return _c
 }
 
+// SparseCheckout provides a mock function for the type Client
+func (_mock *Client) SparseCheckout(paths []string) error {
+	ret := _mock.Called(paths)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SparseCheckout")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func([]string) error); ok {
+		r0 = returnFunc(paths)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Client_SparseCheckout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SparseCheckout'
+type Client_SparseCheckout_Call struct {
+	*mock.Call
+}
+
+// SparseCheckout is a helper method to define mock.On call
+//   - paths []string
+func (_e *Client_Expecter) SparseCheckout(paths interface{}) *Client_SparseCheckout_Call {
+	return &Client_SparseCheckout_Call{Call: _e.mock.On("SparseCheckout", paths)}
+}
+
+func (_c *Client_SparseCheckout_Call) Run(run func(paths []string)) *Client_SparseCheckout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []string
+		if args[0] != nil {
+			arg0 = args[0].([]string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *Client_SparseCheckout_Call) Return(err error) *Client_SparseCheckout_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Client_SparseCheckout_Call) RunAndReturn(run func(paths []string) error) *Client_SparseCheckout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Submodule provides a mock function for the type Client
 func (_mock *Client) Submodule() error {
 	ret := _mock.Called()
@@ -575,6 +575,25 @@ func GetInstalledPGPKeys(kids []string) ([]*appsv1.GnuPGPublicKey, error) {
 	return keys, nil
 }
 
+// VerifyDetachedSignature verifies the detached signature of dataFile stored in signatureFile against our key ring.
+// The output of gpg is returned even if the signature is bad or made with an unknown key, and has the same format
+// as the output of "git verify-commit", so it can be parsed by ParseGitCommitVerification.
+func VerifyDetachedSignature(signatureFile string, dataFile string) (string, error) {
+	cmd := exec.Command("gpg", "--no-permission-warning", "--verify", signatureFile, dataFile)
+	cmd.Env = getGPGEnviron()
+	out, err := cmd.CombinedOutput()
+	output := strings.TrimSpace(string(out))
+	for _, line := range strings.Split(output, "\n") {
+		if verificationStartMatch.MatchString(line) {
+			return output, nil
+		}
+	}
+	if err != nil {
+		return "", fmt.Errorf("error verifying signature: %w: %s", err, output)
+	}
+	return output, nil
+}
+
 // ParseGitCommitVerification parses the output of "git verify-commit" and returns the result
 func ParseGitCommitVerification(signature string) PGPVerifyResult {
 	result := PGPVerifyResult{Result: VerifyResultUnknown}
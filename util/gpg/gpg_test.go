@@ -604,3 +604,44 @@ func Test_SyncKeyRingFromDirectory(t *testing.T) {
 		}
 	}
 }
+
+func Test_VerifyDetachedSignature(t *testing.T) {
+	initTempDir(t)
+
+	err := InitializeGnuPG()
+	require.NoError(t, err)
+
+	keys, err := GetInstalledPGPKeys(nil)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+
+	dir := t.TempDir()
+	dataFile := path.Join(dir, "manifests.tar.gz")
+	signatureFile := dataFile + ".asc"
+	require.NoError(t, os.WriteFile(dataFile, []byte("manifests"), 0o644))
+	cmd := exec.Command("gpg", "--no-permission-warning", "--batch", "--armor", "--output", signatureFile, "--detach-sign", dataFile)
+	cmd.Env = getGPGEnviron()
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	t.Run("Good signature", func(t *testing.T) {
+		output, err := VerifyDetachedSignature(signatureFile, dataFile)
+		require.NoError(t, err)
+		res := ParseGitCommitVerification(output)
+		assert.Equal(t, VerifyResultGood, res.Result)
+		assert.Equal(t, KeyID(keys[0].KeyID), res.KeyID)
+	})
+
+	t.Run("Bad signature", func(t *testing.T) {
+		manipulatedFile := path.Join(dir, "manipulated.tar.gz")
+		require.NoError(t, os.WriteFile(manipulatedFile, []byte("manipulated"), 0o644))
+		output, err := VerifyDetachedSignature(signatureFile, manipulatedFile)
+		require.NoError(t, err)
+		assert.Equal(t, VerifyResultBad, ParseGitCommitVerification(output).Result)
+	})
+
+	t.Run("Invalid signature", func(t *testing.T) {
+		_, err := VerifyDetachedSignature(dataFile, dataFile)
+		assert.ErrorContains(t, err, "error verifying signature")
+	})
+}
@@ -0,0 +1,312 @@
+package helm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	gosync "sync"
+
+	"github.com/argoproj/pkg/v2/sync"
+	"sigs.k8s.io/yaml"
+
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+// lockFiles are the files locking the dependencies of charts, for charts of apiVersion v2 and v1 respectively, along
+// with the files declaring the dependencies they lock
+var lockFiles = []struct {
+	lock         string
+	dependencies string
+}{
+	{lock: "Chart.lock", dependencies: "Chart.yaml"},
+	{lock: "requirements.lock", dependencies: "requirements.yaml"},
+}
+
+// DependencyCacheEventHandlers are notified of the lookups of a DependencyCache and of the changes of its size
+type DependencyCacheEventHandlers struct {
+	// OnHit is called with the size of the restored archives when the dependencies of a chart are restored
+	OnHit func(bytes int64)
+	// OnMiss is called when the dependencies of a chart are not cached and have to be downloaded
+	OnMiss func()
+	// OnSizeChange is called with the total size of the cached archives whenever it changes
+	OnSizeChange func(bytes int64)
+}
+
+// DependencyCache is a content-addressed cache of the chart archives downloaded by `helm dependency build`. Archives
+// are cached by the digest of the locked dependencies and of the repository of the chart, so that the dependencies
+// of a chart used by many applications are downloaded once for all of them. The least recently used entries are
+// evicted once the total size of the cached archives exceeds the maximum size of the cache.
+type DependencyCache struct {
+	paths    utilio.TempPaths
+	maxSize  int64
+	handlers DependencyCacheEventHandlers
+	// keyLock prevents an entry from being evicted while it is restored, and from being stored concurrently
+	keyLock sync.KeyLock
+
+	lock    gosync.Mutex
+	entries map[string]*dependencyCacheEntry
+	size    int64
+	// clock orders the entries by their last use
+	clock uint64
+}
+
+type dependencyCacheEntry struct {
+	archives []string
+	size     int64
+	lastUsed uint64
+}
+
+type lockedDependency struct {
+	Name       string `json:"name"`
+	Version    string `json:"version"`
+	Repository string `json:"repository"`
+}
+
+type dependencyLock struct {
+	Digest       string             `json:"digest"`
+	Dependencies []lockedDependency `json:"dependencies"`
+}
+
+// NewDependencyCache returns a cache storing chart archives in the given paths, up to the given total size
+func NewDependencyCache(paths utilio.TempPaths, maxSize int64, handlers DependencyCacheEventHandlers) *DependencyCache {
+	return &DependencyCache{
+		paths:    paths,
+		maxSize:  maxSize,
+		handlers: handlers,
+		keyLock:  sync.NewKeyLock(),
+		entries:  map[string]*dependencyCacheEntry{},
+	}
+}
+
+// DependencyCacheKey returns the key of the dependencies locked in the Chart.lock or requirements.lock file of the
+// chart at the given path, for a chart of the given repository generated for an application of the given project, and
+// the archives `helm dependency build` downloads for them. Cached dependencies are only shared by the applications of
+// the same project, which are permitted to use the same repositories and credentials. The declared dependencies are
+// part of the key, so that a chart whose lock file is out of sync with its dependencies is never restored from the
+// cache but fails to build. It returns an empty key if the dependencies of the chart cannot be cached, because it has
+// no lock file or depends on local charts whose content is not locked.
+func DependencyCacheKey(chartPath string, repoURL string, project string) (string, []string, error) {
+	var data, declared []byte
+	for _, lockFile := range lockFiles {
+		var err error
+		data, err = os.ReadFile(filepath.Join(chartPath, lockFile.lock))
+		if err == nil {
+			declared, err = os.ReadFile(filepath.Join(chartPath, lockFile.dependencies))
+			if err != nil && !os.IsNotExist(err) {
+				return "", nil, fmt.Errorf("error reading %s: %w", lockFile.dependencies, err)
+			}
+			break
+		} else if !os.IsNotExist(err) {
+			return "", nil, fmt.Errorf("error reading %s: %w", lockFile.lock, err)
+		}
+	}
+	if data == nil || declared == nil {
+		return "", nil, nil
+	}
+	var lock dependencyLock
+	if err := yaml.Unmarshal(data, &lock); err != nil {
+		return "", nil, fmt.Errorf("error parsing chart dependency lock: %w", err)
+	}
+	var dependencies struct {
+		Dependencies []map[string]any `json:"dependencies"`
+	}
+	if err := yaml.Unmarshal(declared, &dependencies); err != nil {
+		return "", nil, fmt.Errorf("error parsing chart dependencies: %w", err)
+	}
+	// the declared dependencies are hashed in their canonical JSON form, so that formatting changes keep the key
+	declaredJSON, err := json.Marshal(dependencies.Dependencies)
+	if err != nil {
+		return "", nil, fmt.Errorf("error marshalling chart dependencies: %w", err)
+	}
+
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "%s\n%s\n%s\n%s\n", project, repoURL, lock.Digest, declaredJSON)
+	var archives []string
+	for _, dep := range lock.Dependencies {
+		if strings.HasPrefix(dep.Repository, "file://") {
+			return "", nil, nil
+		}
+		_, _ = fmt.Fprintf(h, "%s %s %s\n", dep.Name, dep.Version, dep.Repository)
+		// dependencies without a repository are expected to be in the charts directory already
+		if dep.Repository != "" {
+			archives = append(archives, fmt.Sprintf("%s-%s.tgz", dep.Name, dep.Version))
+		}
+	}
+	if len(archives) == 0 {
+		return "", nil, nil
+	}
+	return "sha256:" + hex.EncodeToString(h.Sum(nil)), archives, nil
+}
+
+// Restore copies the archives cached with the given key into the charts directory of the chart at the given path.
+// It returns false if the archives are not cached.
+func (c *DependencyCache) Restore(key string, chartPath string) (bool, error) {
+	c.keyLock.RLock(key)
+	defer c.keyLock.RUnlock(key)
+
+	c.lock.Lock()
+	entry, ok := c.entries[key]
+	if ok {
+		c.clock++
+		entry.lastUsed = c.clock
+	}
+	c.lock.Unlock()
+	if !ok {
+		if c.handlers.OnMiss != nil {
+			c.handlers.OnMiss()
+		}
+		return false, nil
+	}
+
+	cachePath, err := c.paths.GetPath(key)
+	if err != nil {
+		return false, err
+	}
+	chartsPath := filepath.Join(chartPath, "charts")
+	if err := os.MkdirAll(chartsPath, 0o755); err != nil {
+		return false, fmt.Errorf("error creating charts directory: %w", err)
+	}
+	for _, archive := range entry.archives {
+		if err := copyFile(filepath.Join(cachePath, archive), filepath.Join(chartsPath, archive)); err != nil {
+			return false, fmt.Errorf("error restoring cached chart archive %s: %w", archive, err)
+		}
+	}
+	if c.handlers.OnHit != nil {
+		c.handlers.OnHit(entry.size)
+	}
+	return true, nil
+}
+
+// Store copies the given archives from the charts directory of the chart at the given path into the cache, and
+// evicts the least recently used entries if the cache exceeds its maximum size. Archives larger than the cache
+// itself are not stored.
+func (c *DependencyCache) Store(key string, chartPath string, archives []string) error {
+	evicted, err := c.store(key, chartPath, archives)
+	// the archives of evicted entries are removed once the lock of the stored entry is released, since removing them
+	// requires their locks
+	for _, evictedKey := range evicted {
+		c.remove(evictedKey)
+	}
+	return err
+}
+
+func (c *DependencyCache) store(key string, chartPath string, archives []string) ([]string, error) {
+	c.keyLock.Lock(key)
+	defer c.keyLock.Unlock(key)
+
+	c.lock.Lock()
+	_, ok := c.entries[key]
+	c.lock.Unlock()
+	if ok {
+		return nil, nil
+	}
+
+	chartsPath := filepath.Join(chartPath, "charts")
+	var size int64
+	for _, archive := range archives {
+		info, err := os.Stat(filepath.Join(chartsPath, archive))
+		if err != nil {
+			return nil, fmt.Errorf("error reading chart archive %s: %w", archive, err)
+		}
+		size += info.Size()
+	}
+	if size > c.maxSize {
+		return nil, nil
+	}
+
+	cachePath, err := c.paths.GetPath(key)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.RemoveAll(cachePath); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(cachePath, 0o700); err != nil {
+		return nil, fmt.Errorf("error creating dependency cache directory: %w", err)
+	}
+	for _, archive := range archives {
+		if err := copyFile(filepath.Join(chartsPath, archive), filepath.Join(cachePath, archive)); err != nil {
+			_ = os.RemoveAll(cachePath)
+			return nil, fmt.Errorf("error caching chart archive %s: %w", archive, err)
+		}
+	}
+
+	c.lock.Lock()
+	c.clock++
+	c.entries[key] = &dependencyCacheEntry{archives: archives, size: size, lastUsed: c.clock}
+	c.size += size
+	evicted := c.evict(key)
+	totalSize := c.size
+	c.lock.Unlock()
+
+	if c.handlers.OnSizeChange != nil {
+		c.handlers.OnSizeChange(totalSize)
+	}
+	return evicted, nil
+}
+
+// evict removes the least recently used entries other than the given one from the index until the cache no longer
+// exceeds its maximum size, and returns their keys. It must be called with the lock held.
+func (c *DependencyCache) evict(keep string) []string {
+	var evicted []string
+	for c.size > c.maxSize {
+		oldestKey := ""
+		var oldest *dependencyCacheEntry
+		for key, entry := range c.entries {
+			if key != keep && (oldest == nil || entry.lastUsed < oldest.lastUsed) {
+				oldestKey, oldest = key, entry
+			}
+		}
+		if oldest == nil {
+			break
+		}
+		delete(c.entries, oldestKey)
+		c.size -= oldest.size
+		evicted = append(evicted, oldestKey)
+	}
+	return evicted
+}
+
+// remove deletes the archives of an evicted entry once they are no longer restored, unless the entry was stored
+// again in the meantime
+func (c *DependencyCache) remove(key string) {
+	c.keyLock.Lock(key)
+	defer c.keyLock.Unlock(key)
+
+	c.lock.Lock()
+	_, ok := c.entries[key]
+	c.lock.Unlock()
+	if ok {
+		return
+	}
+	if cachePath := c.paths.GetPathIfExists(key); cachePath != "" {
+		_ = os.RemoveAll(cachePath)
+	}
+}
+
+// Size returns the total size of the cached archives
+func (c *DependencyCache) Size() int64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.size
+}
+
+func copyFile(src string, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer utilio.Close(in)
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(out, in)
+	return errors.Join(err, out.Close())
+}
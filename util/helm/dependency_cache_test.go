@@ -0,0 +1,193 @@
+package helm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+func writeChartLock(t *testing.T, chartPath string, lock string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(chartPath, "Chart.lock"), []byte(lock), 0o644))
+}
+
+func writeChartYAML(t *testing.T, chartPath string, dependencies string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(chartPath, "Chart.yaml"), []byte("apiVersion: v2\nname: test\nversion: 0.1.0\n"+dependencies), 0o644))
+}
+
+func writeChartArchives(t *testing.T, chartPath string, archives map[string]string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Join(chartPath, "charts"), 0o755))
+	for name, content := range archives {
+		require.NoError(t, os.WriteFile(filepath.Join(chartPath, "charts", name), []byte(content), 0o644))
+	}
+}
+
+func TestDependencyCacheKey(t *testing.T) {
+	t.Run("Locked", func(t *testing.T) {
+		key, archives, err := DependencyCacheKey("./testdata/dependency", "https://github.com/argoproj/argo-cd", "default")
+		require.NoError(t, err)
+		assert.Regexp(t, "^sha256:[0-9a-f]{64}$", key)
+		assert.Equal(t, []string{"mongodb-7.8.10.tgz", "eventstore-0.2.5.tgz"}, archives)
+
+		otherKey, _, err := DependencyCacheKey("./testdata/dependency", "https://github.com/argoproj/other", "default")
+		require.NoError(t, err)
+		assert.NotEqual(t, key, otherKey)
+
+		// the dependencies are not shared with the applications of other projects
+		otherKey, _, err = DependencyCacheKey("./testdata/dependency", "https://github.com/argoproj/argo-cd", "other")
+		require.NoError(t, err)
+		assert.NotEqual(t, key, otherKey)
+	})
+
+	t.Run("DependenciesChanged", func(t *testing.T) {
+		chartPath := t.TempDir()
+		lock := `
+dependencies:
+- name: redis
+  repository: https://charts.example.com
+  version: 1.0.0
+`
+		writeChartLock(t, chartPath, lock)
+		writeChartYAML(t, chartPath, "dependencies:\n- name: redis\n  repository: https://charts.example.com\n  version: 1.0.0\n")
+		key, _, err := DependencyCacheKey(chartPath, "https://github.com/argoproj/argo-cd", "default")
+		require.NoError(t, err)
+
+		// the lock file is out of sync with the declared dependencies
+		writeChartYAML(t, chartPath, "dependencies:\n- name: redis\n  repository: https://charts.example.com\n  version: 2.0.0\n")
+		otherKey, _, err := DependencyCacheKey(chartPath, "https://github.com/argoproj/argo-cd", "default")
+		require.NoError(t, err)
+		assert.NotEqual(t, key, otherKey)
+	})
+
+	t.Run("RequirementsLock", func(t *testing.T) {
+		chartPath := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(chartPath, "requirements.lock"), []byte(`
+dependencies:
+- name: redis
+  repository: https://charts.example.com
+  version: 1.0.0
+`), 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(chartPath, "requirements.yaml"), []byte(`
+dependencies:
+- name: redis
+  repository: https://charts.example.com
+  version: 1.0.0
+`), 0o644))
+		key, archives, err := DependencyCacheKey(chartPath, "https://github.com/argoproj/argo-cd", "default")
+		require.NoError(t, err)
+		assert.NotEmpty(t, key)
+		assert.Equal(t, []string{"redis-1.0.0.tgz"}, archives)
+	})
+
+	t.Run("DependsOnLocalChart", func(t *testing.T) {
+		chartPath := t.TempDir()
+		writeChartLock(t, chartPath, `
+dependencies:
+- name: redis
+  repository: https://charts.example.com
+  version: 1.0.0
+- name: common
+  repository: file://../common
+  version: 0.1.0
+`)
+		writeChartYAML(t, chartPath, "")
+		key, _, err := DependencyCacheKey(chartPath, "https://github.com/argoproj/argo-cd", "default")
+		require.NoError(t, err)
+		assert.Empty(t, key)
+	})
+
+	t.Run("DependsOnVendoredChart", func(t *testing.T) {
+		chartPath := t.TempDir()
+		writeChartLock(t, chartPath, `
+dependencies:
+- name: common
+  repository: ""
+  version: 0.1.0
+`)
+		writeChartYAML(t, chartPath, "")
+		key, _, err := DependencyCacheKey(chartPath, "https://github.com/argoproj/argo-cd", "default")
+		require.NoError(t, err)
+		assert.Empty(t, key)
+	})
+
+	t.Run("NotLocked", func(t *testing.T) {
+		key, _, err := DependencyCacheKey(t.TempDir(), "https://github.com/argoproj/argo-cd", "default")
+		require.NoError(t, err)
+		assert.Empty(t, key)
+	})
+
+	t.Run("InvalidLock", func(t *testing.T) {
+		chartPath := t.TempDir()
+		writeChartLock(t, chartPath, "dependencies: {")
+		writeChartYAML(t, chartPath, "")
+		_, _, err := DependencyCacheKey(chartPath, "https://github.com/argoproj/argo-cd", "default")
+		require.ErrorContains(t, err, "error parsing chart dependency lock")
+	})
+}
+
+func TestDependencyCache(t *testing.T) {
+	var hits, misses int
+	var savedBytes, size int64
+	handlers := DependencyCacheEventHandlers{
+		OnHit: func(bytes int64) {
+			hits++
+			savedBytes += bytes
+		},
+		OnMiss:       func() { misses++ },
+		OnSizeChange: func(bytes int64) { size = bytes },
+	}
+	cache := NewDependencyCache(utilio.NewRandomizedTempPaths(t.TempDir()), 10, handlers)
+
+	built := t.TempDir()
+	writeChartArchives(t, built, map[string]string{"a-1.0.0.tgz": "aaaa", "b-1.0.0.tgz": "bb", "c-1.0.0.tgz": "cccccc"})
+
+	restored, err := cache.Restore("a", t.TempDir())
+	require.NoError(t, err)
+	assert.False(t, restored)
+	assert.Equal(t, 1, misses)
+
+	require.NoError(t, cache.Store("a", built, []string{"a-1.0.0.tgz"}))
+	require.NoError(t, cache.Store("b", built, []string{"b-1.0.0.tgz"}))
+	assert.Equal(t, int64(6), cache.Size())
+	assert.Equal(t, int64(6), size)
+
+	chartPath := t.TempDir()
+	restored, err = cache.Restore("a", chartPath)
+	require.NoError(t, err)
+	assert.True(t, restored)
+	content, err := os.ReadFile(filepath.Join(chartPath, "charts", "a-1.0.0.tgz"))
+	require.NoError(t, err)
+	assert.Equal(t, "aaaa", string(content))
+	assert.Equal(t, 1, hits)
+	assert.Equal(t, int64(4), savedBytes)
+
+	// storing c exceeds the maximum size and evicts b, which is the least recently used
+	require.NoError(t, cache.Store("c", built, []string{"c-1.0.0.tgz"}))
+	assert.Equal(t, int64(10), cache.Size())
+	assert.Equal(t, int64(10), size)
+	restored, err = cache.Restore("b", t.TempDir())
+	require.NoError(t, err)
+	assert.False(t, restored)
+	_, err = os.Stat(cache.paths.GetPathIfExists("b"))
+	assert.True(t, os.IsNotExist(err))
+	restored, err = cache.Restore("a", t.TempDir())
+	require.NoError(t, err)
+	assert.True(t, restored)
+
+	// archives larger than the cache are not stored
+	writeChartArchives(t, built, map[string]string{"d-1.0.0.tgz": "ddddddddddd"})
+	require.NoError(t, cache.Store("d", built, []string{"d-1.0.0.tgz"}))
+	assert.Equal(t, int64(10), cache.Size())
+	restored, err = cache.Restore("d", t.TempDir())
+	require.NoError(t, err)
+	assert.False(t, restored)
+
+	require.ErrorContains(t, cache.Store("e", built, []string{"missing-1.0.0.tgz"}), "error reading chart archive missing-1.0.0.tgz")
+}
@@ -0,0 +1,149 @@
+// Package ical implements a minimal parser of iCalendar (RFC 5545) files, which extracts the dates of their events.
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	dateFormat     = "20060102"
+	dateTimeFormat = "20060102T150405"
+	// maxEventDays bounds the number of dates of a single event, to protect against malformed calendars
+	maxEventDays = 366
+)
+
+// ParseDates returns the sorted list of dates, in the YYYY-MM-DD format, on which the events of the given calendar take
+// place. All-day events span from their start date to the day before their end date, while timed events span from the
+// date of their start to the date of their end, in their own time zone. Recurring events are not supported.
+func ParseDates(data string) ([]string, error) {
+	lines, err := unfold(data)
+	if err != nil {
+		return nil, err
+	}
+
+	dates := map[string]bool{}
+	inEvent := false
+	var start, end string
+	for _, line := range lines {
+		name, value, ok := parseProperty(line)
+		if !ok {
+			continue
+		}
+		switch {
+		case name == "BEGIN" && value == "VEVENT":
+			inEvent = true
+			start, end = "", ""
+		case name == "END" && value == "VEVENT":
+			if !inEvent {
+				return nil, errors.New("unexpected END:VEVENT")
+			}
+			inEvent = false
+			if start == "" {
+				return nil, errors.New("event without DTSTART")
+			}
+			eventDates, err := eventDates(start, end)
+			if err != nil {
+				return nil, err
+			}
+			for _, date := range eventDates {
+				dates[date] = true
+			}
+		case !inEvent:
+			continue
+		case name == "DTSTART":
+			start = value
+		case name == "DTEND":
+			end = value
+		case name == "RRULE" || name == "RDATE":
+			return nil, errors.New("recurring events are not supported")
+		}
+	}
+	if inEvent {
+		return nil, errors.New("event without END:VEVENT")
+	}
+
+	result := make([]string, 0, len(dates))
+	for date := range dates {
+		result = append(result, date)
+	}
+	slices.Sort(result)
+	return result, nil
+}
+
+// unfold returns the logical lines of the calendar, as long lines can be folded over several lines which start with
+// a whitespace
+func unfold(data string) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading calendar: %w", err)
+	}
+	return lines, nil
+}
+
+// parseProperty splits a content line into the name of the property and its value, ignoring its parameters
+func parseProperty(line string) (string, string, bool) {
+	nameAndParams, value, ok := strings.Cut(line, ":")
+	if !ok {
+		return "", "", false
+	}
+	name, _, _ := strings.Cut(nameAndParams, ";")
+	return strings.ToUpper(strings.TrimSpace(name)), strings.TrimSpace(value), true
+}
+
+// eventDates returns the dates of an event with the given DTSTART and DTEND values
+func eventDates(start string, end string) ([]string, error) {
+	startDate, allDay, err := parseDate(start)
+	if err != nil {
+		return nil, err
+	}
+	endDate := startDate
+	if end != "" {
+		endDate, _, err = parseDate(end)
+		if err != nil {
+			return nil, err
+		}
+		// the end of all-day events is exclusive
+		if allDay && endDate.After(startDate) {
+			endDate = endDate.AddDate(0, 0, -1)
+		}
+	}
+	if endDate.Before(startDate) {
+		return nil, fmt.Errorf("event ends before it starts: %s", start)
+	}
+
+	var dates []string
+	for date := startDate; !date.After(endDate); date = date.AddDate(0, 0, 1) {
+		if len(dates) == maxEventDays {
+			return nil, fmt.Errorf("event starting %s exceeds %d days", start, maxEventDays)
+		}
+		dates = append(dates, date.Format(time.DateOnly))
+	}
+	return dates, nil
+}
+
+// parseDate parses a DATE or DATE-TIME value, and returns its date and whether it was a DATE value. The time zone of
+// DATE-TIME values is not considered, so that the date is the one of the time zone of the event.
+func parseDate(value string) (time.Time, bool, error) {
+	if date, err := time.Parse(dateFormat, value); err == nil {
+		return date, true, nil
+	}
+	dateTime, err := time.Parse(dateTimeFormat, strings.TrimSuffix(value, "Z"))
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("cannot parse date '%s'", value)
+	}
+	return time.Date(dateTime.Year(), dateTime.Month(), dateTime.Day(), 0, 0, 0, 0, time.UTC), false, nil
+}
@@ -0,0 +1,81 @@
+package ical
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const holidays = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"PRODID:-//Example//Holidays//EN\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:christmas@example.com\r\n" +
+	"DTSTART;VALUE=DATE:20261225\r\n" +
+	"DTEND;VALUE=DATE:20261227\r\n" +
+	"SUMMARY:Christmas\r\n" +
+	"  holidays\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:new-year@example.com\r\n" +
+	"DTSTART;VALUE=DATE:20270101\r\n" +
+	"SUMMARY:New Year\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:release@example.com\r\n" +
+	"DTSTART;TZID=Europe/Paris:20261230T220000\r\n" +
+	"DTEND;TZID=Europe/Paris:20261231T020000\r\n" +
+	"SUMMARY:Release freeze\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestParseDates(t *testing.T) {
+	t.Run("Events", func(t *testing.T) {
+		dates, err := ParseDates(holidays)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"2026-12-25", "2026-12-26", "2026-12-30", "2026-12-31", "2027-01-01"}, dates)
+	})
+
+	t.Run("Empty calendar", func(t *testing.T) {
+		dates, err := ParseDates("BEGIN:VCALENDAR\nEND:VCALENDAR\n")
+		require.NoError(t, err)
+		assert.Empty(t, dates)
+	})
+
+	t.Run("UTC date-time", func(t *testing.T) {
+		dates, err := ParseDates("BEGIN:VEVENT\nDTSTART:20261001T100000Z\nEND:VEVENT\n")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"2026-10-01"}, dates)
+	})
+
+	t.Run("Recurring event", func(t *testing.T) {
+		_, err := ParseDates("BEGIN:VEVENT\nDTSTART;VALUE=DATE:20261225\nRRULE:FREQ=YEARLY\nEND:VEVENT\n")
+		require.EqualError(t, err, "recurring events are not supported")
+	})
+
+	t.Run("Invalid date", func(t *testing.T) {
+		_, err := ParseDates("BEGIN:VEVENT\nDTSTART:2026-12-25\nEND:VEVENT\n")
+		require.EqualError(t, err, "cannot parse date '2026-12-25'")
+	})
+
+	t.Run("Missing start", func(t *testing.T) {
+		_, err := ParseDates("BEGIN:VEVENT\nSUMMARY:Holiday\nEND:VEVENT\n")
+		require.EqualError(t, err, "event without DTSTART")
+	})
+
+	t.Run("Unterminated event", func(t *testing.T) {
+		_, err := ParseDates("BEGIN:VEVENT\nDTSTART;VALUE=DATE:20261225\n")
+		require.EqualError(t, err, "event without END:VEVENT")
+	})
+
+	t.Run("End before start", func(t *testing.T) {
+		_, err := ParseDates("BEGIN:VEVENT\nDTSTART:20261225T100000\nDTEND:20261224T100000\nEND:VEVENT\n")
+		require.EqualError(t, err, "event ends before it starts: 20261225T100000")
+	})
+
+	t.Run("Too long event", func(t *testing.T) {
+		_, err := ParseDates("BEGIN:VEVENT\nDTSTART;VALUE=DATE:20260101\nDTEND;VALUE=DATE:20280101\nEND:VEVENT\n")
+		require.EqualError(t, err, "event starting 20260101 exceeds 366 days")
+	})
+}
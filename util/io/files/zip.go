@@ -0,0 +1,144 @@
+package files
+
+import (
+	"archive/zip"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+)
+
+var ErrMaxSizeExceeded = errors.New("maximum extracted size exceeded")
+
+// Unzip will extract the zip archive read from r into dstPath. The total
+// size of the extracted files is limited to maxSize.
+// Callers must make sure dstPath is:
+//   - a full path
+//   - points to an empty directory or
+//   - points to a non-existing directory
+func Unzip(dstPath string, r io.ReaderAt, size int64, maxSize int64, preserveFileMode bool) error {
+	if !filepath.IsAbs(dstPath) {
+		return fmt.Errorf("dstPath points to a relative path: %s", dstPath)
+	}
+
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return fmt.Errorf("error reading file: %w", err)
+	}
+
+	remaining := maxSize
+	for _, zf := range zr.File {
+		if zf.Name == "." || zf.Name == "./" {
+			continue
+		}
+
+		target := filepath.Join(dstPath, zf.Name)
+		// Sanity check to protect against zip-slip
+		if !Inbound(target, dstPath) {
+			return fmt.Errorf("illegal filepath in archive: %s", target)
+		}
+
+		mode := zf.Mode()
+		switch {
+		case mode.IsDir():
+			var dirMode os.FileMode = 0o755
+			if preserveFileMode {
+				dirMode = mode.Perm()
+			}
+			err := os.MkdirAll(target, dirMode)
+			if err != nil {
+				return fmt.Errorf("error creating nested folders: %w", err)
+			}
+		case mode&os.ModeSymlink != 0:
+			linkName, err := readZipFile(zf, 4096)
+			if err != nil {
+				return fmt.Errorf("error reading symlink: %w", err)
+			}
+			// Sanity check to protect against symlink exploit
+			linkTarget := filepath.Join(filepath.Dir(target), string(linkName))
+			realPath, err := filepath.EvalSymlinks(linkTarget)
+			if os.IsNotExist(err) {
+				realPath = linkTarget
+			} else if err != nil {
+				return fmt.Errorf("error checking symlink realpath: %w", err)
+			}
+			if !Inbound(realPath, dstPath) {
+				return fmt.Errorf("illegal filepath in symlink: %s", linkTarget)
+			}
+			err = os.MkdirAll(filepath.Dir(target), 0o755)
+			if err != nil {
+				return fmt.Errorf("error creating nested folders: %w", err)
+			}
+			err = os.Symlink(realPath, target)
+			if err != nil {
+				return fmt.Errorf("error creating symlink: %w", err)
+			}
+		case mode.IsRegular():
+			var fileMode os.FileMode = 0o644
+			if preserveFileMode {
+				fileMode = mode.Perm()
+			}
+			written, err := writeZipFile(zf, target, fileMode, remaining)
+			if err != nil {
+				return err
+			}
+			remaining -= written
+		}
+	}
+	return nil
+}
+
+// limitWithOverflow returns the number of bytes to read in order to detect that more than maxSize bytes are available
+func limitWithOverflow(maxSize int64) int64 {
+	if maxSize == math.MaxInt64 {
+		return maxSize
+	}
+	return maxSize + 1
+}
+
+// readZipFile returns the content of a small file of the zip archive, such as the target of a symlink
+func readZipFile(zf *zip.File, maxSize int64) ([]byte, error) {
+	rc, err := zf.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(io.LimitReader(rc, limitWithOverflow(maxSize)))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxSize {
+		return nil, ErrMaxSizeExceeded
+	}
+	return data, nil
+}
+
+// writeZipFile extracts a regular file of the zip archive to target, writing at most maxSize bytes
+func writeZipFile(zf *zip.File, target string, mode os.FileMode, maxSize int64) (int64, error) {
+	rc, err := zf.Open()
+	if err != nil {
+		return 0, fmt.Errorf("error opening file %q of archive: %w", zf.Name, err)
+	}
+	defer rc.Close()
+
+	err = os.MkdirAll(filepath.Dir(target), 0o755)
+	if err != nil {
+		return 0, fmt.Errorf("error creating nested folders: %w", err)
+	}
+
+	f, err := os.OpenFile(target, os.O_RDWR|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return 0, fmt.Errorf("error creating file %q: %w", target, err)
+	}
+	defer f.Close()
+	written, err := io.Copy(f, io.LimitReader(rc, limitWithOverflow(maxSize)))
+	if err != nil {
+		return written, fmt.Errorf("error writing zip file: %w", err)
+	}
+	if written > maxSize {
+		return written, ErrMaxSizeExceeded
+	}
+	return written, nil
+}
@@ -0,0 +1,97 @@
+package files_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/util/io/files"
+)
+
+type zipEntry struct {
+	name    string
+	content string
+	mode    os.FileMode
+}
+
+func createZip(t *testing.T, entries ...zipEntry) *bytes.Reader {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	for _, entry := range entries {
+		header := &zip.FileHeader{Name: entry.name, Method: zip.Deflate}
+		header.SetMode(entry.mode)
+		w, err := zw.CreateHeader(header)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(entry.content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestUnzip(t *testing.T) {
+	unzip := func(t *testing.T, maxSize int64, preserveFileMode bool, entries ...zipEntry) (string, error) {
+		t.Helper()
+		r := createZip(t, entries...)
+		destDir := filepath.Join(t.TempDir(), "unzip")
+		return destDir, files.Unzip(destDir, r, r.Size(), maxSize, preserveFileMode)
+	}
+
+	t.Run("will unzip successfully", func(t *testing.T) {
+		destDir, err := unzip(t, math.MaxInt64, false,
+			zipEntry{name: "app/", mode: os.ModeDir | 0o755},
+			zipEntry{name: "app/deployment.yaml", content: "kind: Deployment", mode: 0o600},
+			zipEntry{name: "app/nested/service.yaml", content: "kind: Service", mode: 0o644},
+			zipEntry{name: "app/link.yaml", content: "deployment.yaml", mode: os.ModeSymlink | 0o777},
+		)
+		require.NoError(t, err)
+
+		data, err := os.ReadFile(filepath.Join(destDir, "app/deployment.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, "kind: Deployment", string(data))
+		data, err = os.ReadFile(filepath.Join(destDir, "app/nested/service.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, "kind: Service", string(data))
+		link, err := os.Readlink(filepath.Join(destDir, "app/link.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(destDir, "app/deployment.yaml"), link)
+
+		fi, err := os.Stat(filepath.Join(destDir, "app/deployment.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o644), fi.Mode().Perm())
+	})
+	t.Run("preserves file mode", func(t *testing.T) {
+		destDir, err := unzip(t, math.MaxInt64, true, zipEntry{name: "script.sh", content: "#!/bin/sh", mode: 0o755})
+		require.NoError(t, err)
+		fi, err := os.Stat(filepath.Join(destDir, "script.sh"))
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o755), fi.Mode().Perm())
+	})
+	t.Run("will protect against zip-slip", func(t *testing.T) {
+		_, err := unzip(t, math.MaxInt64, false, zipEntry{name: "../outside.yaml", content: "kind: Secret", mode: 0o644})
+		assert.ErrorContains(t, err, "illegal filepath in archive")
+	})
+	t.Run("will protect against symlink exploit", func(t *testing.T) {
+		_, err := unzip(t, math.MaxInt64, false, zipEntry{name: "link", content: "../../etc/passwd", mode: os.ModeSymlink | 0o777})
+		assert.ErrorContains(t, err, "illegal filepath in symlink")
+	})
+	t.Run("will limit the extracted size", func(t *testing.T) {
+		_, err := unzip(t, 10, false,
+			zipEntry{name: "a.yaml", content: "12345", mode: 0o644},
+			zipEntry{name: "b.yaml", content: "123456", mode: 0o644},
+		)
+		require.ErrorIs(t, err, files.ErrMaxSizeExceeded)
+	})
+	t.Run("will fail on invalid archive", func(t *testing.T) {
+		r := bytes.NewReader([]byte("not a zip"))
+		err := files.Unzip(filepath.Join(t.TempDir(), "unzip"), r, r.Size(), math.MaxInt64, false)
+		assert.ErrorContains(t, err, "error reading file")
+	})
+}
@@ -0,0 +1,266 @@
+package jsonnetbundler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+const (
+	// JsonnetFile is the name of the file declaring the jsonnet-bundler dependencies
+	JsonnetFile = "jsonnetfile.json"
+	// LockFile is the name of the file holding the versions the jsonnet-bundler dependencies are locked to
+	LockFile = "jsonnetfile.lock.json"
+	// VendorDir is the name of the directory jsonnet-bundler installs the dependencies to
+	VendorDir = "vendor"
+)
+
+// commitSHARegex matches full SHA-1 and SHA-256 commit hashes, which jsonnet-bundler locks git dependencies to
+var commitSHARegex = regexp.MustCompile(`^([0-9a-f]{40}|[0-9a-f]{64})$`)
+
+// File is a jsonnetfile.json or jsonnetfile.lock.json
+type File struct {
+	Version      int          `json:"version"`
+	Dependencies []Dependency `json:"dependencies"`
+	// LegacyImports makes the dependencies importable by their legacy name, which is the default
+	LegacyImports *bool `json:"legacyImports,omitempty"`
+}
+
+// Dependency is a dependency of a jsonnet-bundler project
+type Dependency struct {
+	Source Source `json:"source"`
+	// Version is the locked commit SHA of git dependencies
+	Version string `json:"version"`
+	Sum     string `json:"sum,omitempty"`
+	// Name overrides the legacy name of the dependency
+	Name string `json:"name,omitempty"`
+}
+
+// Source is the source of a dependency, either a git repository or a local directory
+type Source struct {
+	Git   *GitSource   `json:"git,omitempty"`
+	Local *LocalSource `json:"local,omitempty"`
+}
+
+// GitSource is a directory in a git repository
+type GitSource struct {
+	Remote string `json:"remote"`
+	Subdir string `json:"subdir"`
+}
+
+// LocalSource is a directory relative to the jsonnet-bundler project
+type LocalSource struct {
+	Directory string `json:"directory"`
+}
+
+// Fetcher fetches the git repository with the given remote at the given commit SHA and returns the path of its working
+// tree. The working tree must not be modified until the process exits.
+type Fetcher func(remote string, version string) (string, error)
+
+// FindLockFile returns the path of the lock file in the given directory or the nearest of its parents within the
+// repository root, or an empty string if there is none.
+func FindLockFile(dir string, repoRoot string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	repoRoot, err = filepath.Abs(repoRoot)
+	if err != nil {
+		return "", err
+	}
+	for {
+		lockFile := filepath.Join(dir, LockFile)
+		if _, err := os.Stat(lockFile); err == nil {
+			return lockFile, nil
+		} else if !os.IsNotExist(err) {
+			return "", err
+		}
+		if dir == repoRoot || !strings.HasPrefix(dir, repoRoot+string(filepath.Separator)) {
+			return "", nil
+		}
+		dir = filepath.Dir(dir)
+	}
+}
+
+// LoadFile reads a jsonnetfile.json or jsonnetfile.lock.json
+func LoadFile(path string) (*File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f File
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", filepath.Base(path), err)
+	}
+	return &f, nil
+}
+
+// Vendor makes the dependencies of the given lock file importable from a newly created vendor directory, which is
+// laid out like the one installed by jsonnet-bundler. Git dependencies which are vendored next to the lock file are
+// used as is, others are fetched using the given fetcher. Local dependencies must be within the repository root. The
+// returned closer removes the vendor directory.
+func Vendor(lockFilePath string, repoRoot string, fetch Fetcher) (string, utilio.Closer, error) {
+	lockFile, err := LoadFile(lockFilePath)
+	if err != nil {
+		return "", nil, err
+	}
+	projectDir := filepath.Dir(lockFilePath)
+	repoRoot, err = filepath.Abs(repoRoot)
+	if err != nil {
+		return "", nil, err
+	}
+
+	vendorPath, err := os.MkdirTemp("", "jsonnet-vendor")
+	if err != nil {
+		return "", nil, err
+	}
+	closer := utilio.NewCloser(func() error {
+		return os.RemoveAll(vendorPath)
+	})
+
+	var legacyLinks []string
+	for _, dep := range lockFile.Dependencies {
+		name, target, err := resolveDependency(dep, projectDir, repoRoot, fetch)
+		if err != nil {
+			utilio.Close(closer)
+			return "", nil, err
+		}
+		if err := link(vendorPath, name, target); err != nil {
+			utilio.Close(closer)
+			return "", nil, err
+		}
+		if lockFile.LegacyImports == nil || *lockFile.LegacyImports {
+			legacyLinks = append(legacyLinks, dep.LegacyName(), target)
+		}
+	}
+	// legacy names are linked last, so that they never shadow the full names of other dependencies
+	for i := 0; i < len(legacyLinks); i += 2 {
+		if _, err := os.Lstat(filepath.Join(vendorPath, legacyLinks[i])); err == nil {
+			continue
+		}
+		if err := link(vendorPath, legacyLinks[i], legacyLinks[i+1]); err != nil {
+			utilio.Close(closer)
+			return "", nil, err
+		}
+	}
+	return vendorPath, closer, nil
+}
+
+func resolveDependency(dep Dependency, projectDir string, repoRoot string, fetch Fetcher) (string, string, error) {
+	switch {
+	case dep.Source.Git != nil:
+		name, err := dep.Source.Git.Name()
+		if err != nil {
+			return "", "", err
+		}
+		vendored := filepath.Join(projectDir, VendorDir, filepath.FromSlash(name))
+		if info, err := os.Stat(vendored); err == nil && info.IsDir() && isWithin(vendored, repoRoot) {
+			return name, vendored, nil
+		}
+		if !commitSHARegex.MatchString(dep.Version) {
+			return "", "", fmt.Errorf("version %q of jsonnet dependency %s is not a commit SHA, run jb update to lock it", dep.Version, dep.Source.Git.Remote)
+		}
+		repoPath, err := fetch(dep.Source.Git.Remote, dep.Version)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to fetch jsonnet dependency %s: %w", dep.Source.Git.Remote, err)
+		}
+		target := filepath.Join(repoPath, filepath.FromSlash(dep.Source.Git.Subdir))
+		if !isWithin(target, repoPath) {
+			return "", "", fmt.Errorf("subdir %q of jsonnet dependency %s is outside of the repository", dep.Source.Git.Subdir, dep.Source.Git.Remote)
+		}
+		return name, target, nil
+	case dep.Source.Local != nil:
+		target := filepath.Join(projectDir, filepath.FromSlash(dep.Source.Local.Directory))
+		if !isWithin(target, repoRoot) {
+			return "", "", fmt.Errorf("directory %q of local jsonnet dependency is outside of the repository", dep.Source.Local.Directory)
+		}
+		return filepath.Base(target), target, nil
+	default:
+		return "", "", errors.New("jsonnet dependency has neither a git nor a local source")
+	}
+}
+
+// Name returns the path of the dependency in the vendor directory, which consists of the host and path of the remote
+// followed by the subdirectory, e.g. github.com/grafana/jsonnet-libs/grafana
+func (s *GitSource) Name() (string, error) {
+	host, repoPath, err := parseRemote(s.Remote)
+	if err != nil {
+		return "", err
+	}
+	name := path.Join(host, repoPath, s.Subdir)
+	if !strings.HasPrefix(name, host+"/") || strings.Contains(name, "..") {
+		return "", fmt.Errorf("invalid jsonnet dependency %s with subdir %q", s.Remote, s.Subdir)
+	}
+	return name, nil
+}
+
+// LegacyName returns the name the dependency is importable by if legacy imports are enabled, which is the last
+// element of its subdirectory or repository unless overridden
+func (d Dependency) LegacyName() string {
+	if d.Name != "" {
+		return path.Base(d.Name)
+	}
+	switch {
+	case d.Source.Git != nil:
+		if d.Source.Git.Subdir != "" {
+			return path.Base(d.Source.Git.Subdir)
+		}
+		_, repoPath, err := parseRemote(d.Source.Git.Remote)
+		if err != nil {
+			return ""
+		}
+		return path.Base(repoPath)
+	case d.Source.Local != nil:
+		return path.Base(filepath.ToSlash(d.Source.Local.Directory))
+	}
+	return ""
+}
+
+// parseRemote returns the host and the path without the .git suffix of the given git remote, which is either a URL or
+// an SCP-like SSH address
+func parseRemote(remote string) (string, string, error) {
+	var host, repoPath string
+	if strings.Contains(remote, "://") {
+		u, err := url.Parse(remote)
+		if err != nil {
+			return "", "", fmt.Errorf("invalid remote %q of jsonnet dependency: %w", remote, err)
+		}
+		host, repoPath = u.Hostname(), u.Path
+	} else if at := strings.Index(remote, "@"); at >= 0 && strings.Contains(remote[at:], ":") {
+		hostAndPath := remote[at+1:]
+		colon := strings.Index(hostAndPath, ":")
+		host, repoPath = hostAndPath[:colon], hostAndPath[colon+1:]
+	}
+	repoPath = strings.TrimSuffix(strings.Trim(repoPath, "/"), ".git")
+	if host == "" || repoPath == "" || strings.Contains(host, "/") {
+		return "", "", fmt.Errorf("invalid remote %q of jsonnet dependency", remote)
+	}
+	return host, repoPath, nil
+}
+
+func link(vendorPath string, name string, target string) error {
+	linkPath := filepath.Join(vendorPath, filepath.FromSlash(name))
+	if !isWithin(linkPath, vendorPath) || linkPath == vendorPath {
+		return fmt.Errorf("invalid name %q of jsonnet dependency", name)
+	}
+	if err := os.MkdirAll(filepath.Dir(linkPath), 0o755); err != nil {
+		return err
+	}
+	if err := os.Symlink(target, linkPath); err != nil {
+		return fmt.Errorf("failed to link jsonnet dependency %s: %w", name, err)
+	}
+	return nil
+}
+
+func isWithin(p string, root string) bool {
+	rel, err := filepath.Rel(root, p)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
@@ -0,0 +1,177 @@
+package jsonnetbundler
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+const testRevision = "0123456789abcdef0123456789abcdef01234567"
+
+func writeFile(t *testing.T, path string, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+func TestFindLockFile(t *testing.T) {
+	repoRoot := t.TempDir()
+	writeFile(t, filepath.Join(repoRoot, "jsonnet", LockFile), `{"version": 1}`)
+	require.NoError(t, os.MkdirAll(filepath.Join(repoRoot, "jsonnet", "app", "env"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(repoRoot, "other"), 0o755))
+
+	lockFile, err := FindLockFile(filepath.Join(repoRoot, "jsonnet", "app", "env"), repoRoot)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(repoRoot, "jsonnet", LockFile), lockFile)
+
+	lockFile, err = FindLockFile(filepath.Join(repoRoot, "jsonnet"), repoRoot)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(repoRoot, "jsonnet", LockFile), lockFile)
+
+	lockFile, err = FindLockFile(filepath.Join(repoRoot, "other"), repoRoot)
+	require.NoError(t, err)
+	assert.Empty(t, lockFile)
+
+	// the parents of the repository root are not searched
+	lockFile, err = FindLockFile(filepath.Join(repoRoot, "jsonnet", "app"), filepath.Join(repoRoot, "jsonnet", "app"))
+	require.NoError(t, err)
+	assert.Empty(t, lockFile)
+}
+
+func TestGitSource_Name(t *testing.T) {
+	for _, tc := range []struct {
+		remote string
+		subdir string
+		name   string
+	}{
+		{"https://github.com/grafana/jsonnet-libs.git", "grafana", "github.com/grafana/jsonnet-libs/grafana"},
+		{"https://github.com/grafana/jsonnet-libs", "", "github.com/grafana/jsonnet-libs"},
+		{"ssh://git@gitlab.example.com:2222/group/subgroup/libs.git", "lib/", "gitlab.example.com/group/subgroup/libs/lib"},
+		{"git@github.com:jsonnet-libs/k8s-libsonnet.git", "1.30", "github.com/jsonnet-libs/k8s-libsonnet/1.30"},
+	} {
+		t.Run(tc.remote, func(t *testing.T) {
+			name, err := (&GitSource{Remote: tc.remote, Subdir: tc.subdir}).Name()
+			require.NoError(t, err)
+			assert.Equal(t, tc.name, name)
+		})
+	}
+
+	for _, source := range []GitSource{
+		{Remote: "/local/path"},
+		{Remote: "file:///local/path"},
+		{Remote: "https://github.com/grafana/jsonnet-libs.git", Subdir: "../../../other"},
+	} {
+		_, err := source.Name()
+		assert.Error(t, err, source.Remote)
+	}
+}
+
+func TestDependency_LegacyName(t *testing.T) {
+	assert.Equal(t, "grafana", Dependency{Source: Source{Git: &GitSource{Remote: "https://github.com/grafana/jsonnet-libs.git", Subdir: "grafana"}}}.LegacyName())
+	assert.Equal(t, "jsonnet-libs", Dependency{Source: Source{Git: &GitSource{Remote: "https://github.com/grafana/jsonnet-libs.git"}}}.LegacyName())
+	assert.Equal(t, "k", Dependency{Name: "k", Source: Source{Git: &GitSource{Remote: "https://github.com/jsonnet-libs/k8s-libsonnet.git", Subdir: "1.30"}}}.LegacyName())
+	assert.Equal(t, "common", Dependency{Source: Source{Local: &LocalSource{Directory: "../lib/common"}}}.LegacyName())
+}
+
+func TestVendor(t *testing.T) {
+	fetched := t.TempDir()
+	writeFile(t, filepath.Join(fetched, "grafana", "grafana.libsonnet"), "{}")
+	repoRoot := t.TempDir()
+	writeFile(t, filepath.Join(repoRoot, "lib", "common", "common.libsonnet"), "{}")
+	writeFile(t, filepath.Join(repoRoot, "app", VendorDir, "github.com", "vendored", "libs", "vendored.libsonnet"), "{}")
+	lockFile := filepath.Join(repoRoot, "app", LockFile)
+	writeFile(t, lockFile, `{
+  "version": 1,
+  "dependencies": [
+    {"source": {"git": {"remote": "https://github.com/grafana/jsonnet-libs.git", "subdir": "grafana"}}, "version": "`+testRevision+`"},
+    {"source": {"git": {"remote": "https://github.com/vendored/libs.git", "subdir": ""}}, "version": "main"},
+    {"source": {"local": {"directory": "../lib/common"}}, "version": ""}
+  ],
+  "legacyImports": true
+}`)
+
+	var fetches []string
+	fetch := func(remote string, version string) (string, error) {
+		fetches = append(fetches, remote+"@"+version)
+		return fetched, nil
+	}
+	vendorPath, closer, err := Vendor(lockFile, repoRoot, fetch)
+	require.NoError(t, err)
+
+	// vendored dependencies are not fetched, so they may be locked to branches
+	assert.Equal(t, []string{"https://github.com/grafana/jsonnet-libs.git@" + testRevision}, fetches)
+	for _, file := range []string{
+		"github.com/grafana/jsonnet-libs/grafana/grafana.libsonnet",
+		"grafana/grafana.libsonnet",
+		"github.com/vendored/libs/vendored.libsonnet",
+		"libs/vendored.libsonnet",
+		"common/common.libsonnet",
+	} {
+		assert.FileExists(t, filepath.Join(vendorPath, filepath.FromSlash(file)))
+	}
+
+	utilio.Close(closer)
+	assert.NoDirExists(t, vendorPath)
+}
+
+func TestVendor_WithoutLegacyImports(t *testing.T) {
+	fetched := t.TempDir()
+	writeFile(t, filepath.Join(fetched, "grafana", "grafana.libsonnet"), "{}")
+	repoRoot := t.TempDir()
+	lockFile := filepath.Join(repoRoot, LockFile)
+	writeFile(t, lockFile, `{
+  "version": 1,
+  "dependencies": [
+    {"source": {"git": {"remote": "https://github.com/grafana/jsonnet-libs.git", "subdir": "grafana"}}, "version": "`+testRevision+`"}
+  ],
+  "legacyImports": false
+}`)
+
+	vendorPath, closer, err := Vendor(lockFile, repoRoot, func(string, string) (string, error) {
+		return fetched, nil
+	})
+	require.NoError(t, err)
+	defer utilio.Close(closer)
+	assert.FileExists(t, filepath.Join(vendorPath, "github.com", "grafana", "jsonnet-libs", "grafana", "grafana.libsonnet"))
+	assert.NoFileExists(t, filepath.Join(vendorPath, "grafana", "grafana.libsonnet"))
+}
+
+func TestVendor_Errors(t *testing.T) {
+	fetch := func(string, string) (string, error) {
+		return t.TempDir(), nil
+	}
+	for _, tc := range []struct {
+		name         string
+		dependencies string
+		err          string
+	}{{
+		name:         "NotLocked",
+		dependencies: `{"source": {"git": {"remote": "https://github.com/grafana/jsonnet-libs.git", "subdir": "grafana"}}, "version": "master"}`,
+		err:          `version "master" of jsonnet dependency https://github.com/grafana/jsonnet-libs.git is not a commit SHA, run jb update to lock it`,
+	}, {
+		name:         "SubdirOutsideOfRepository",
+		dependencies: `{"source": {"git": {"remote": "https://github.com/grafana/jsonnet-libs.git", "subdir": "a/../../b"}}, "version": "` + testRevision + `"}`,
+		err:          `subdir "a/../../b" of jsonnet dependency https://github.com/grafana/jsonnet-libs.git is outside of the repository`,
+	}, {
+		name:         "LocalOutsideOfRepository",
+		dependencies: `{"source": {"local": {"directory": "../../etc"}}}`,
+		err:          `directory "../../etc" of local jsonnet dependency is outside of the repository`,
+	}, {
+		name:         "NoSource",
+		dependencies: `{"source": {}}`,
+		err:          "jsonnet dependency has neither a git nor a local source",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			repoRoot := t.TempDir()
+			lockFile := filepath.Join(repoRoot, LockFile)
+			writeFile(t, lockFile, `{"version": 1, "dependencies": [`+tc.dependencies+`]}`)
+			_, _, err := Vendor(lockFile, repoRoot, fetch)
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
@@ -477,6 +477,11 @@ func Version() (string, error) {
 	return versionWithBinaryPath(&kustomize{})
 }
 
+// BinaryVersion returns the version of the kustomize binary at the given path, or of the default one if empty
+func BinaryVersion(binaryPath string) (string, error) {
+	return versionWithBinaryPath(&kustomize{binaryPath: binaryPath})
+}
+
 func versionWithBinaryPath(k *kustomize) (string, error) {
 	executable := k.getBinaryPath()
 	cmd := exec.Command(executable, "version", "--short")
@@ -0,0 +1,185 @@
+package lua
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+	"github.com/google/cel-go/ext"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/lru"
+
+	argoglob "github.com/argoproj/argo-cd/v3/util/glob"
+)
+
+const (
+	healthCELFile        = "health.cel"
+	invalidCELReturnType = "expect map output from CEL expression, not %s"
+	invalidCELHealth     = "CEL returned an invalid health status"
+	// celCostLimit bounds the cost of the evaluation of a health expression, as it runs for each resource update
+	celCostLimit = 1000000
+	// celProgramCacheSize bounds the number of compiled health expressions kept in memory. Expressions which are no
+	// longer configured are eventually evicted.
+	celProgramCacheSize = 256
+)
+
+var (
+	celEnvOnce sync.Once
+	celEnv     *cel.Env
+	celEnvErr  error
+
+	// celPrograms caches the compiled health expressions by expression, since the same expressions are evaluated for
+	// each resource of the matching kinds
+	celPrograms = lru.New(celProgramCacheSize)
+)
+
+// getCELEnv returns the environment in which health expressions are compiled. The resource is available as the `obj`
+// variable, along with helper functions to inspect the conditions of its status.
+func getCELEnv() (*cel.Env, error) {
+	celEnvOnce.Do(func() {
+		conditionsType := cel.ListType(cel.DynType)
+		celEnv, celEnvErr = cel.NewEnv(
+			cel.Variable("obj", cel.DynType),
+			cel.OptionalTypes(),
+			ext.Bindings(),
+			ext.Strings(),
+			ext.Lists(),
+			cel.Function("conditionStatus",
+				cel.Overload("conditionStatus_list_string", []*cel.Type{conditionsType, cel.StringType}, cel.StringType,
+					cel.BinaryBinding(func(conditions, conditionType ref.Val) ref.Val {
+						return findConditionField(conditions, conditionType, "status")
+					}))),
+			cel.Function("conditionMessage",
+				cel.Overload("conditionMessage_list_string", []*cel.Type{conditionsType, cel.StringType}, cel.StringType,
+					cel.BinaryBinding(func(conditions, conditionType ref.Val) ref.Val {
+						return findConditionField(conditions, conditionType, "message")
+					}))),
+			cel.Function("hasCondition",
+				cel.Overload("hasCondition_list_string_string", []*cel.Type{conditionsType, cel.StringType, cel.StringType}, cel.BoolType,
+					cel.FunctionBinding(func(args ...ref.Val) ref.Val {
+						status := findConditionField(args[0], args[1], "status")
+						if types.IsError(status) {
+							return status
+						}
+						return status.Equal(args[2])
+					}))),
+		)
+	})
+	return celEnv, celEnvErr
+}
+
+// findConditionField returns the value of the given field of the first condition with the given type, or an empty
+// string if there is no such condition
+func findConditionField(conditions ref.Val, conditionType ref.Val, field string) ref.Val {
+	lister, ok := conditions.(traits.Lister)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(conditions)
+	}
+	for it := lister.Iterator(); it.HasNext() == types.True; {
+		condition, ok := it.Next().(traits.Mapper)
+		if !ok {
+			continue
+		}
+		if t, found := condition.Find(types.String("type")); !found || t.Equal(conditionType) != types.True {
+			continue
+		}
+		if value, found := condition.Find(types.String(field)); found {
+			if s, ok := value.(types.String); ok {
+				return s
+			}
+		}
+		return types.String("")
+	}
+	return types.String("")
+}
+
+// getCELProgram compiles the given health expression, or returns it from the cache if it was already compiled
+func getCELProgram(expression string) (cel.Program, error) {
+	if prg, ok := celPrograms.Get(expression); ok {
+		return prg.(cel.Program), nil
+	}
+	env, err := getCELEnv()
+	if err != nil {
+		return nil, fmt.Errorf("error creating CEL environment: %w", err)
+	}
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("error compiling CEL expression: %w", issues.Err())
+	}
+	prg, err := env.Program(ast, cel.CostLimit(celCostLimit), cel.InterruptCheckFrequency(100))
+	if err != nil {
+		return nil, fmt.Errorf("error creating CEL program: %w", err)
+	}
+	celPrograms.Add(expression, prg)
+	return prg, nil
+}
+
+// ExecuteHealthCEL evaluates the CEL expression to generate the health status of a resource. The expression must
+// evaluate to a map with a status and an optional message.
+func (vm VM) ExecuteHealthCEL(obj *unstructured.Unstructured, expression string) (*health.HealthStatus, error) {
+	prg, err := getCELProgram(expression)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cancel()
+	out, _, err := prg.ContextEval(ctx, map[string]any{"obj": obj.Object})
+	if err != nil {
+		return nil, fmt.Errorf("error evaluating CEL expression: %w", err)
+	}
+	if out == types.NullValue {
+		return &health.HealthStatus{}, nil
+	}
+	if _, ok := out.(traits.Mapper); !ok {
+		return nil, fmt.Errorf(invalidCELReturnType, out.Type().TypeName())
+	}
+	native, err := out.ConvertToNative(reflect.TypeOf(map[string]string{}))
+	if err != nil {
+		return nil, errors.New("the status and message returned by the CEL expression must be strings")
+	}
+	result := native.(map[string]string)
+	healthStatus := &health.HealthStatus{
+		Status:  health.HealthStatusCode(result["status"]),
+		Message: result["message"],
+	}
+	if !isValidHealthStatusCode(healthStatus.Status) {
+		return &health.HealthStatus{
+			Status:  health.HealthStatusUnknown,
+			Message: invalidCELHealth,
+		}, nil
+	}
+	return healthStatus, nil
+}
+
+// GetHealthCEL returns the CEL health expression of the resource, from the config or from the built-in
+// customizations. It returns an empty string if the health of the resource is assessed by a Lua script instead, or
+// if there is no health customization for the resource.
+func (vm VM) GetHealthCEL(obj *unstructured.Unstructured) (string, error) {
+	key := GetConfigMapKey(obj.GroupVersionKind())
+	if override, ok := vm.ResourceOverrides[key]; ok && (override.HealthCEL != "" || override.HealthLua != "") {
+		return override.HealthCEL, nil
+	}
+
+	for pattern, override := range vm.ResourceOverrides {
+		if argoglob.Match(pattern, key) && (override.HealthCEL != "" || override.HealthLua != "") {
+			return override.HealthCEL, nil
+		}
+	}
+
+	builtInExpression, err := vm.getPredefinedLuaScripts(key, healthCELFile)
+	if err != nil {
+		if errors.Is(err, errScriptDoesNotExist) {
+			return "", nil
+		}
+		return "", fmt.Errorf("error while fetching built-in health expression: %w", err)
+	}
+	return builtInExpression, nil
+}
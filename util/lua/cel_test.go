@@ -0,0 +1,153 @@
+package lua
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const objWithConditionsYaml = `
+apiVersion: cert-manager.io/v1
+kind: Certificate
+metadata:
+  name: test
+  namespace: test
+status:
+  conditions:
+  - type: Issuing
+    status: "True"
+  - type: Ready
+    status: "False"
+    message: Issuing certificate as Secret does not exist
+`
+
+const tlsRouteYaml = `
+apiVersion: gateway.networking.k8s.io/v1alpha2
+kind: TLSRoute
+metadata:
+  name: test
+  namespace: test
+`
+
+func TestExecuteHealthCEL(t *testing.T) {
+	vm := VM{}
+	testObj := StrToUnstructured(objWithConditionsYaml)
+
+	t.Run("Conditions helpers", func(t *testing.T) {
+		status, err := vm.ExecuteHealthCEL(testObj, `
+hasCondition(obj.status.conditions, 'Ready', 'True') ?
+  {'status': 'Healthy'} :
+  {'status': 'Degraded', 'message': conditionMessage(obj.status.conditions, 'Ready') + ' (' + conditionStatus(obj.status.conditions, 'Issuing') + ')'}`)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusDegraded, Message: "Issuing certificate as Secret does not exist (True)"}, status)
+	})
+
+	t.Run("Missing condition", func(t *testing.T) {
+		status, err := vm.ExecuteHealthCEL(testObj, `{'status': 'Progressing', 'message': conditionStatus(obj.status.conditions, 'Missing')}`)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusProgressing}, status)
+	})
+
+	t.Run("Optional fields", func(t *testing.T) {
+		status, err := vm.ExecuteHealthCEL(testObj, `{'status': obj.?status.?phase.orValue('Progressing')}`)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusProgressing}, status)
+	})
+
+	t.Run("Invalid health status", func(t *testing.T) {
+		status, err := vm.ExecuteHealthCEL(testObj, `{'status': 'Unhealthy'}`)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusUnknown, Message: invalidCELHealth}, status)
+	})
+
+	t.Run("Null result", func(t *testing.T) {
+		status, err := vm.ExecuteHealthCEL(testObj, `null`)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{}, status)
+	})
+
+	t.Run("Invalid result type", func(t *testing.T) {
+		_, err := vm.ExecuteHealthCEL(testObj, `'Healthy'`)
+		require.EqualError(t, err, "expect map output from CEL expression, not string")
+	})
+
+	t.Run("Invalid result values", func(t *testing.T) {
+		_, err := vm.ExecuteHealthCEL(testObj, `{'status': 1}`)
+		require.Error(t, err)
+	})
+
+	t.Run("Invalid expression", func(t *testing.T) {
+		_, err := vm.ExecuteHealthCEL(testObj, `{'status': `)
+		require.ErrorContains(t, err, "error compiling CEL expression")
+	})
+
+	t.Run("Evaluation error", func(t *testing.T) {
+		_, err := vm.ExecuteHealthCEL(testObj, `{'status': obj.status.phase}`)
+		require.ErrorContains(t, err, "error evaluating CEL expression")
+	})
+
+	t.Run("Compiled expressions are bounded", func(t *testing.T) {
+		for i := 0; i <= celProgramCacheSize; i++ {
+			status, err := vm.ExecuteHealthCEL(testObj, fmt.Sprintf(`{'status': 'Healthy', 'message': '%d'}`, i))
+			require.NoError(t, err)
+			assert.Equal(t, strconv.Itoa(i), status.Message)
+		}
+		assert.Equal(t, celProgramCacheSize, celPrograms.Len())
+		_, found := celPrograms.Get(`{'status': 'Healthy', 'message': '0'}`)
+		assert.False(t, found)
+	})
+}
+
+func TestGetResourceHealthCEL(t *testing.T) {
+	const celHealthy = `{'status': 'Healthy', 'message': 'CEL'}`
+	const luaHealthy = `return {status = "Healthy", message = "Lua"}`
+
+	t.Run("CEL takes precedence over Lua in the same override", func(t *testing.T) {
+		overrides := ResourceHealthOverrides{
+			"cert-manager.io/Certificate": appv1.ResourceOverride{HealthLua: luaHealthy, HealthCEL: celHealthy},
+		}
+		status, err := overrides.GetResourceHealth(StrToUnstructured(objWithConditionsYaml))
+		require.NoError(t, err)
+		assert.Equal(t, "CEL", status.Message)
+	})
+
+	t.Run("Exact override takes precedence over wildcard override", func(t *testing.T) {
+		overrides := ResourceHealthOverrides{
+			"cert-manager.io/Certificate": appv1.ResourceOverride{HealthLua: luaHealthy},
+			"cert-manager.io/*":           appv1.ResourceOverride{HealthCEL: celHealthy},
+		}
+		status, err := overrides.GetResourceHealth(StrToUnstructured(objWithConditionsYaml))
+		require.NoError(t, err)
+		assert.Equal(t, "Lua", status.Message)
+	})
+
+	t.Run("Wildcard override", func(t *testing.T) {
+		overrides := ResourceHealthOverrides{
+			"cert-manager.io/*": appv1.ResourceOverride{HealthCEL: celHealthy},
+		}
+		status, err := overrides.GetResourceHealth(StrToUnstructured(objWithConditionsYaml))
+		require.NoError(t, err)
+		assert.Equal(t, "CEL", status.Message)
+	})
+
+	t.Run("Built-in expression", func(t *testing.T) {
+		status, err := ResourceHealthOverrides{}.GetResourceHealth(StrToUnstructured(tlsRouteYaml))
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusProgressing, Message: "Waiting for TLSRoute status"}, status)
+	})
+
+	t.Run("Override takes precedence over built-in expression", func(t *testing.T) {
+		overrides := ResourceHealthOverrides{
+			"gateway.networking.k8s.io/TLSRoute": appv1.ResourceOverride{HealthLua: luaHealthy},
+		}
+		status, err := overrides.GetResourceHealth(StrToUnstructured(tlsRouteYaml))
+		require.NoError(t, err)
+		assert.Equal(t, "Lua", status.Message)
+	})
+}
@@ -33,6 +33,16 @@ func getObj(t *testing.T, path string) *unstructured.Unstructured {
 	return &unstructured.Unstructured{Object: obj}
 }
 
+func getHealthTests(t *testing.T, dir string) TestStructure {
+	t.Helper()
+	yamlBytes, err := os.ReadFile(dir + "/health_test.yaml")
+	require.NoError(t, err)
+	var resourceTest TestStructure
+	err = yaml.Unmarshal(yamlBytes, &resourceTest)
+	require.NoError(t, err)
+	return resourceTest
+}
+
 func TestLuaHealthScript(t *testing.T) {
 	err := filepath.Walk("../../resource_customizations", func(path string, _ os.FileInfo, err error) error {
 		if !strings.Contains(path, "health.lua") {
@@ -40,11 +50,7 @@ func TestLuaHealthScript(t *testing.T) {
 		}
 		require.NoError(t, err)
 		dir := filepath.Dir(path)
-		yamlBytes, err := os.ReadFile(dir + "/health_test.yaml")
-		require.NoError(t, err)
-		var resourceTest TestStructure
-		err = yaml.Unmarshal(yamlBytes, &resourceTest)
-		require.NoError(t, err)
+		resourceTest := getHealthTests(t, dir)
 		for i := range resourceTest.Tests {
 			test := resourceTest.Tests[i]
 			t.Run(filepath.Join(strings.TrimPrefix(dir, "../../resource_customizations/"), test.InputPath), func(t *testing.T) {
@@ -63,3 +69,29 @@ func TestLuaHealthScript(t *testing.T) {
 	})
 	assert.NoError(t, err)
 }
+
+func TestCELHealthExpression(t *testing.T) {
+	err := filepath.Walk("../../resource_customizations", func(path string, _ os.FileInfo, err error) error {
+		if filepath.Base(path) != healthCELFile {
+			return nil
+		}
+		require.NoError(t, err)
+		dir := filepath.Dir(path)
+		resourceTest := getHealthTests(t, dir)
+		for i := range resourceTest.Tests {
+			test := resourceTest.Tests[i]
+			t.Run(filepath.Join(strings.TrimPrefix(dir, "../../resource_customizations/"), test.InputPath), func(t *testing.T) {
+				vm := VM{}
+				obj := getObj(t, filepath.Join(dir, test.InputPath))
+				expression, err := vm.GetHealthCEL(obj)
+				require.NoError(t, err)
+				require.NotEmpty(t, expression)
+				result, err := vm.ExecuteHealthCEL(obj, expression)
+				require.NoError(t, err)
+				assert.Equal(t, &test.HealthStatus, result)
+			})
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+}
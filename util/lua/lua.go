@@ -45,6 +45,13 @@ func (overrides ResourceHealthOverrides) GetResourceHealth(obj *unstructured.Uns
 	luaVM := VM{
 		ResourceOverrides: overrides,
 	}
+	expression, err := luaVM.GetHealthCEL(obj)
+	if err != nil {
+		return nil, err
+	}
+	if expression != "" {
+		return luaVM.ExecuteHealthCEL(obj, expression)
+	}
 	script, useOpenLibs, err := luaVM.GetHealthScript(obj)
 	if err != nil {
 		return nil, err
@@ -29,12 +29,15 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/argoproj/argo-cd/v3/util/cache"
+	"github.com/argoproj/argo-cd/v3/util/cosign"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/io/files"
 	"github.com/argoproj/argo-cd/v3/util/proxy"
 
 	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
 	"oras.land/oras-go/v2/content/file"
+	"oras.land/oras-go/v2/errdef"
 	"oras.land/oras-go/v2/registry/remote"
 	"oras.land/oras-go/v2/registry/remote/auth"
 )
@@ -44,6 +47,9 @@ var (
 	indexLock  = sync.NewKeyLock()
 )
 
+// maxCosignManifestSize is the maximum size of the manifests and layers of cosign signature and attestation images
+const maxCosignManifestSize = 4 * 1024 * 1024
+
 var _ Client = &nativeOCIClient{}
 
 type tagsCache interface {
@@ -74,6 +80,10 @@ type Client interface {
 
 	// GetTags retrieves the list of tags for the repository.
 	GetTags(ctx context.Context, noCache bool) ([]string, error)
+
+	// VerifySignature verifies the cosign signatures and, if required by the verifier, the attestations of the image
+	// with the given digest. These are looked up by the tags cosign stores them under.
+	VerifySignature(ctx context.Context, digest string, verifier *cosign.Verifier) (*cosign.Result, error)
 }
 
 type Creds struct {
@@ -358,6 +368,61 @@ func (c *nativeOCIClient) GetTags(ctx context.Context, noCache bool) ([]string,
 	return tags, nil
 }
 
+// VerifySignature verifies the cosign signatures and attestations of the image with the given digest.
+func (c *nativeOCIClient) VerifySignature(ctx context.Context, digest string, verifier *cosign.Verifier) (*cosign.Result, error) {
+	signatures, err := c.getCosignLayers(ctx, cosign.SignatureTag(digest))
+	if err != nil {
+		return nil, fmt.Errorf("error fetching cosign signatures of digest %s: %w", digest, err)
+	}
+
+	var attestations []cosign.Layer
+	if verifier.RequiresAttestations() {
+		attestations, err = c.getCosignLayers(ctx, cosign.AttestationTag(digest))
+		if err != nil {
+			return nil, fmt.Errorf("error fetching cosign attestations of digest %s: %w", digest, err)
+		}
+	}
+
+	return verifier.Verify(digest, signatures, attestations)
+}
+
+// getCosignLayers returns the layers of the cosign signature or attestation image with the given tag. If there is no
+// such image, no layers are returned.
+func (c *nativeOCIClient) getCosignLayers(ctx context.Context, tag string) ([]cosign.Layer, error) {
+	desc, err := c.repo.Resolve(ctx, tag)
+	if errors.Is(err, errdef.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if desc.Size > maxCosignManifestSize {
+		return nil, fmt.Errorf("manifest of %s exceeds the maximum size", tag)
+	}
+
+	data, err := content.FetchAll(ctx, c.repo, desc)
+	if err != nil {
+		return nil, err
+	}
+	var manifest imagev1.Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("error decoding manifest of %s: %w", tag, err)
+	}
+
+	layers := make([]cosign.Layer, 0, len(manifest.Layers))
+	for _, layer := range manifest.Layers {
+		if layer.Size > maxCosignManifestSize {
+			return nil, fmt.Errorf("layer %s of %s exceeds the maximum size", layer.Digest, tag)
+		}
+		data, err := content.FetchAll(ctx, c.repo, layer)
+		if err != nil {
+			return nil, err
+		}
+		layers = append(layers, cosign.Layer{MediaType: layer.MediaType, Annotations: layer.Annotations, Content: data})
+	}
+	return layers, nil
+}
+
 // resolveDigest resolves a digest from a tag.
 func (c *nativeOCIClient) resolveDigest(ctx context.Context, revision string) (string, error) {
 	descriptor, err := c.repo.Resolve(ctx, revision)
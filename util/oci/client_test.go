@@ -5,8 +5,16 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
 	"encoding/json"
+	"encoding/pem"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
@@ -20,6 +28,7 @@ import (
 	"oras.land/oras-go/v2/content"
 	"oras.land/oras-go/v2/content/memory"
 
+	"github.com/argoproj/argo-cd/v3/util/cosign"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/io/files"
 )
@@ -436,3 +445,73 @@ func Test_nativeOCIClient_ResolveRevision(t *testing.T) {
 		})
 	}
 }
+
+func pushCosignImage(t *testing.T, store *memory.Store, tag string, layers ...layerConf) {
+	t.Helper()
+	configBlob := fmt.Appendf(nil, `{"tag":%q}`, tag)
+	configDesc := content.NewDescriptorFromBytes(imagev1.MediaTypeImageConfig, configBlob)
+	require.NoError(t, store.Push(t.Context(), configDesc, bytes.NewReader(configBlob)))
+
+	manifest := imagev1.Manifest{Config: configDesc, Versioned: specs.Versioned{SchemaVersion: 2}}
+	for _, layer := range layers {
+		manifest.Layers = append(manifest.Layers, layer.desc)
+		exists, err := store.Exists(t.Context(), layer.desc)
+		require.NoError(t, err)
+		if !exists {
+			require.NoError(t, store.Push(t.Context(), layer.desc, bytes.NewReader(layer.bytes)))
+		}
+	}
+	manifestBlob, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	manifestDesc := content.NewDescriptorFromBytes(imagev1.MediaTypeImageManifest, manifestBlob)
+	require.NoError(t, store.Push(t.Context(), manifestDesc, bytes.NewReader(manifestBlob)))
+	require.NoError(t, store.Tag(t.Context(), manifestDesc, tag))
+}
+
+func Test_nativeOCIClient_VerifySignature(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	keyID, err := cosign.KeyID(&key.PublicKey)
+	require.NoError(t, err)
+	policy, err := cosign.NewPolicy([]string{string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))}, nil, nil)
+	require.NoError(t, err)
+	verifier := cosign.NewVerifier(policy, nil)
+
+	signatureLayer := func(digest string) layerConf {
+		payload := fmt.Appendf(nil, `{"critical":{"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"}}`, digest)
+		hash := sha256.Sum256(payload)
+		sig, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+		require.NoError(t, err)
+		desc := content.NewDescriptorFromBytes(cosign.SimpleSigningMediaType, payload)
+		desc.Annotations = map[string]string{"dev.cosignproject.cosign/signature": base64.StdEncoding.EncodeToString(sig)}
+		return layerConf{desc, payload}
+	}
+
+	store := memory.New()
+	layerBlob := createGzippedTarWithContent(t, "some-path", "some content")
+	signed := generateManifest(t, store, layerConf{content.NewDescriptorFromBytes(imagev1.MediaTypeImageLayerGzip, layerBlob), layerBlob})
+	pushCosignImage(t, store, cosign.SignatureTag(signed), signatureLayer(signed))
+	pushCosignImage(t, store, "unsigned")
+	unsignedDesc, err := store.Resolve(t.Context(), "unsigned")
+	require.NoError(t, err)
+	unsigned := unsignedDesc.Digest.String()
+
+	c := newClientWithLock("", globalLock, store, func(context.Context, string) ([]string, error) {
+		return nil, nil
+	}, func(_ context.Context) error {
+		return nil
+	}, []string{imagev1.MediaTypeImageLayerGzip})
+
+	result, err := c.VerifySignature(t.Context(), signed, verifier)
+	require.NoError(t, err)
+	require.Equal(t, &cosign.Result{Digest: signed, Signers: []cosign.Signer{{KeyID: keyID}}}, result)
+
+	_, err = c.VerifySignature(t.Context(), unsigned, verifier)
+	require.EqualError(t, err, "no cosign signature found for "+unsigned)
+
+	pushCosignImage(t, store, cosign.SignatureTag(unsigned), signatureLayer(signed))
+	_, err = c.VerifySignature(t.Context(), unsigned, verifier)
+	require.ErrorContains(t, err, "signature is for digest "+signed)
+}
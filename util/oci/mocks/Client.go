@@ -7,6 +7,7 @@ package mocks
 import (
 	"context"
 
+	"github.com/argoproj/argo-cd/v3/util/cosign"
 	"github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/opencontainers/image-spec/specs-go/v1"
 	mock "github.com/stretchr/testify/mock"
@@ -431,3 +432,77 @@ func (_c *Client_TestRepo_Call) RunAndReturn(run func(ctx context.Context) (bool
 	_c.Call.Return(run)
 	return _c
 }
+
+// VerifySignature provides a mock function for the type Client
+func (_mock *Client) VerifySignature(ctx context.Context, digest string, verifier *cosign.Verifier) (*cosign.Result, error) {
+	ret := _mock.Called(ctx, digest, verifier)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifySignature")
+	}
+
+	var r0 *cosign.Result
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *cosign.Verifier) (*cosign.Result, error)); ok {
+		return returnFunc(ctx, digest, verifier)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *cosign.Verifier) *cosign.Result); ok {
+		r0 = returnFunc(ctx, digest, verifier)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cosign.Result)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *cosign.Verifier) error); ok {
+		r1 = returnFunc(ctx, digest, verifier)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Client_VerifySignature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifySignature'
+type Client_VerifySignature_Call struct {
+	*mock.Call
+}
+
+// VerifySignature is a helper method to define mock.On call
+//   - ctx context.Context
+//   - digest string
+//   - verifier *cosign.Verifier
+func (_e *Client_Expecter) VerifySignature(ctx interface{}, digest interface{}, verifier interface{}) *Client_VerifySignature_Call {
+	return &Client_VerifySignature_Call{Call: _e.mock.On("VerifySignature", ctx, digest, verifier)}
+}
+
+func (_c *Client_VerifySignature_Call) Run(run func(ctx context.Context, digest string, verifier *cosign.Verifier)) *Client_VerifySignature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *cosign.Verifier
+		if args[2] != nil {
+			arg2 = args[2].(*cosign.Verifier)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Client_VerifySignature_Call) Return(result *cosign.Result, err error) *Client_VerifySignature_Call {
+	_c.Call.Return(result, err)
+	return _c
+}
+
+func (_c *Client_VerifySignature_Call) RunAndReturn(run func(ctx context.Context, digest string, verifier *cosign.Verifier) (*cosign.Result, error)) *Client_VerifySignature_Call {
+	_c.Call.Return(run)
+	return _c
+}
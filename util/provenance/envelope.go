@@ -0,0 +1,168 @@
+package provenance
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/argoproj/argo-cd/v3/util/cosign"
+)
+
+// PayloadType is the DSSE payload type of in-toto statements
+const PayloadType = "application/vnd.in-toto+json"
+
+// Envelope is a DSSE envelope holding a signed statement
+type Envelope struct {
+	PayloadType string      `json:"payloadType"`
+	Payload     string      `json:"payload"`
+	Signatures  []Signature `json:"signatures"`
+}
+
+// Signature is a signature of the payload of an envelope
+type Signature struct {
+	KeyID string `json:"keyid,omitempty"`
+	Sig   string `json:"sig"`
+}
+
+// Signer signs statements with a private key
+type Signer struct {
+	key   crypto.Signer
+	keyID string
+}
+
+// LoadSigner loads the PEM encoded private key at the given path
+func LoadSigner(path string) (*Signer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signing key: %w", err)
+	}
+	return NewSigner(data)
+}
+
+// NewSigner returns a signer for the given PEM encoded ECDSA, RSA or Ed25519 private key, either in PKCS #8 form or
+// in the SEC 1 or PKCS #1 form of its algorithm
+func NewSigner(data []byte) (*Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found in signing key")
+	}
+	var key any
+	var err error
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse signing key: %w", err)
+	}
+	var signer crypto.Signer
+	switch key := key.(type) {
+	case *ecdsa.PrivateKey:
+		signer = key
+	case *rsa.PrivateKey:
+		signer = key
+	case ed25519.PrivateKey:
+		signer = key
+	default:
+		return nil, fmt.Errorf("unsupported signing key type %T", key)
+	}
+	keyID, err := cosign.KeyID(signer.Public())
+	if err != nil {
+		return nil, err
+	}
+	return &Signer{key: signer, keyID: keyID}, nil
+}
+
+// KeyID returns the hex encoded SHA-256 fingerprint of the public key of the signer
+func (s *Signer) KeyID() string {
+	return s.keyID
+}
+
+// Sign signs the given statement and returns the JSON encoded DSSE envelope holding it
+func (s *Signer) Sign(statement *Statement) (string, error) {
+	payload, err := json.Marshal(statement)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal provenance statement: %w", err)
+	}
+	data := cosign.PreAuthEncoding(PayloadType, payload)
+	var sig []byte
+	if _, ok := s.key.(ed25519.PrivateKey); ok {
+		sig, err = s.key.Sign(rand.Reader, data, crypto.Hash(0))
+	} else {
+		digest := sha256.Sum256(data)
+		sig, err = s.key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to sign provenance statement: %w", err)
+	}
+	envelope, err := json.Marshal(Envelope{
+		PayloadType: PayloadType,
+		Payload:     base64.StdEncoding.EncodeToString(payload),
+		Signatures:  []Signature{{KeyID: s.keyID, Sig: base64.StdEncoding.EncodeToString(sig)}},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal provenance envelope: %w", err)
+	}
+	return string(envelope), nil
+}
+
+// Verify verifies that the given JSON encoded DSSE envelope is signed by one of the given public keys and returns
+// the statement it holds, along with the ID of the key it is signed with
+func Verify(envelope string, keys []crypto.PublicKey) (*Statement, string, error) {
+	var env Envelope
+	if err := json.Unmarshal([]byte(envelope), &env); err != nil {
+		return nil, "", fmt.Errorf("invalid provenance envelope: %w", err)
+	}
+	if env.PayloadType != PayloadType {
+		return nil, "", fmt.Errorf("unexpected payload type %s of provenance envelope", env.PayloadType)
+	}
+	payload, err := base64.StdEncoding.DecodeString(env.Payload)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid provenance payload: %w", err)
+	}
+	data := cosign.PreAuthEncoding(env.PayloadType, payload)
+	var keyID string
+	for _, s := range env.Signatures {
+		sig, err := base64.StdEncoding.DecodeString(s.Sig)
+		if err != nil {
+			continue
+		}
+		for _, key := range keys {
+			if cosign.VerifySignature(key, data, sig) == nil {
+				keyID, err = cosign.KeyID(key)
+				if err != nil {
+					return nil, "", err
+				}
+				break
+			}
+		}
+		if keyID != "" {
+			break
+		}
+	}
+	if keyID == "" {
+		return nil, "", errors.New("provenance is not signed by any of the trusted public keys")
+	}
+	var statement Statement
+	if err := json.Unmarshal(payload, &statement); err != nil {
+		return nil, "", fmt.Errorf("invalid provenance statement: %w", err)
+	}
+	if statement.Type != StatementType || statement.PredicateType != PredicateType {
+		return nil, "", fmt.Errorf("unexpected provenance statement of type %s with predicate %s", statement.Type, statement.PredicateType)
+	}
+	return &statement, keyID, nil
+}
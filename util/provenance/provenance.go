@@ -0,0 +1,158 @@
+package provenance
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const (
+	// StatementType is the type of the in-toto statements of provenance attestations
+	StatementType = "https://in-toto.io/Statement/v1"
+	// PredicateType is the type of the SLSA provenance predicate of the statements
+	PredicateType = "https://slsa.dev/provenance/v1"
+	// BuildType identifies the generation of manifests from an application source by the repo server
+	BuildType = "https://argo-cd.readthedocs.io/provenance/manifests/v1"
+	// BuilderID identifies the repo server as the builder of the manifests
+	BuilderID = "https://argo-cd.readthedocs.io/provenance/repo-server"
+)
+
+// Digest returns the SHA-256 digest of the given manifests, in the form sha256:<hex>. The manifests are the JSON
+// encoded objects generated by the repo server, which never contain newlines, and are hashed in order separated by
+// newlines.
+func Digest(manifests []string) string {
+	h := sha256.New()
+	for _, manifest := range manifests {
+		h.Write([]byte(manifest))
+		h.Write([]byte("\n"))
+	}
+	return "sha256:" + hex.EncodeToString(h.Sum(nil))
+}
+
+// Statement is an in-toto statement attesting the provenance of the manifests it is about
+type Statement struct {
+	Type          string               `json:"_type"`
+	Subject       []ResourceDescriptor `json:"subject"`
+	PredicateType string               `json:"predicateType"`
+	Predicate     Predicate            `json:"predicate"`
+}
+
+// ResourceDescriptor describes an artifact, either the manifests which are the subject of a statement or one of
+// the sources they were generated from
+type ResourceDescriptor struct {
+	Name        string            `json:"name,omitempty"`
+	URI         string            `json:"uri,omitempty"`
+	Digest      map[string]string `json:"digest,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Predicate is a SLSA provenance predicate
+type Predicate struct {
+	BuildDefinition BuildDefinition `json:"buildDefinition"`
+	RunDetails      RunDetails      `json:"runDetails"`
+}
+
+// BuildDefinition holds the inputs the manifests were generated from
+type BuildDefinition struct {
+	BuildType            string               `json:"buildType"`
+	ExternalParameters   ExternalParameters   `json:"externalParameters"`
+	InternalParameters   InternalParameters   `json:"internalParameters"`
+	ResolvedDependencies []ResourceDescriptor `json:"resolvedDependencies,omitempty"`
+}
+
+// ExternalParameters holds the application source the manifests were generated from, including the parameters of
+// its config management tool
+type ExternalParameters struct {
+	Source v1alpha1.ApplicationSource `json:"source"`
+}
+
+// InternalParameters holds the settings of the application which affected the generation of the manifests
+type InternalParameters struct {
+	AppName     string `json:"appName,omitempty"`
+	Namespace   string `json:"namespace,omitempty"`
+	Project     string `json:"project,omitempty"`
+	SourceType  string `json:"sourceType,omitempty"`
+	KubeVersion string `json:"kubeVersion,omitempty"`
+	// HelmValuesFromHash is the hash of the Helm values read from ConfigMaps and Secrets
+	HelmValuesFromHash string `json:"helmValuesFromHash,omitempty"`
+}
+
+// RunDetails holds the details of the manifest generation
+type RunDetails struct {
+	Builder  Builder  `json:"builder"`
+	Metadata Metadata `json:"metadata"`
+}
+
+// Builder identifies the repo server and the versions of the tools it generated the manifests with
+type Builder struct {
+	ID      string            `json:"id"`
+	Version map[string]string `json:"version,omitempty"`
+}
+
+// Metadata holds the time the manifest generation started and finished
+type Metadata struct {
+	StartedOn  *time.Time `json:"startedOn,omitempty"`
+	FinishedOn *time.Time `json:"finishedOn,omitempty"`
+}
+
+// ManifestsDigest returns the digest of the manifests the statement is about, in the form sha256:<hex>, or an empty
+// string if the statement has no SHA-256 subject
+func (s *Statement) ManifestsDigest() string {
+	for _, subject := range s.Subject {
+		if hexDigest := subject.Digest["sha256"]; hexDigest != "" {
+			return "sha256:" + hexDigest
+		}
+	}
+	return ""
+}
+
+// SourceRevision returns the revision the application source of the statement was resolved to, in the form it is
+// recorded in the revision history of applications
+func (s *Statement) SourceRevision() string {
+	deps := s.Predicate.BuildDefinition.ResolvedDependencies
+	if len(deps) == 0 {
+		return ""
+	}
+	if commitSHA, ok := deps[0].Digest["gitCommit"]; ok {
+		return commitSHA
+	}
+	for _, algorithm := range []string{"sha256", "sha384", "sha512"} {
+		if hexDigest, ok := deps[0].Digest[algorithm]; ok {
+			return algorithm + ":" + hexDigest
+		}
+	}
+	return deps[0].Annotations["revision"]
+}
+
+// NewSubject returns the descriptor of manifests with the given name and digest, in the form returned by Digest
+func NewSubject(name string, digest string) ResourceDescriptor {
+	algorithm, hexDigest, _ := strings.Cut(digest, ":")
+	return ResourceDescriptor{Name: name, Digest: map[string]string{algorithm: hexDigest}}
+}
+
+// NewDependency returns the descriptor of a source resolved to the given revision. Revisions in the form
+// <algorithm>:<hex>, like the digests of OCI artifacts, and commit SHAs of Git repositories are recorded as digests.
+// Other revisions, like Helm chart versions, are recorded as annotations since they are not immutable.
+func NewDependency(name string, uri string, revision string, isGit bool) ResourceDescriptor {
+	dep := ResourceDescriptor{Name: name, URI: uri}
+	switch algorithm, hexDigest, ok := strings.Cut(revision, ":"); {
+	case ok && isHex(hexDigest):
+		dep.Digest = map[string]string{algorithm: hexDigest}
+	case isGit && isHex(revision):
+		dep.Digest = map[string]string{"gitCommit": revision}
+	case revision != "":
+		dep.Annotations = map[string]string{"revision": revision}
+	}
+	return dep
+}
+
+func isHex(s string) bool {
+	if s == "" {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
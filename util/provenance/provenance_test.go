@@ -0,0 +1,146 @@
+package provenance
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func pemKey(t *testing.T, blockType string, der []byte) []byte {
+	t.Helper()
+	return pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+}
+
+func pkcs8(t *testing.T, key any) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return pemKey(t, "PRIVATE KEY", der)
+}
+
+func newStatement() *Statement {
+	return &Statement{
+		Type:          StatementType,
+		Subject:       []ResourceDescriptor{NewSubject("guestbook", Digest([]string{`{"kind":"ConfigMap"}`}))},
+		PredicateType: PredicateType,
+		Predicate: Predicate{
+			BuildDefinition: BuildDefinition{
+				BuildType:          BuildType,
+				ExternalParameters: ExternalParameters{Source: v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook"}},
+			},
+			RunDetails: RunDetails{Builder: Builder{ID: BuilderID}},
+		},
+	}
+}
+
+func TestDigest(t *testing.T) {
+	assert.Equal(t, "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", Digest(nil))
+	assert.Equal(t, Digest([]string{"a", "b"}), Digest([]string{"a", "b"}))
+	assert.NotEqual(t, Digest([]string{"a", "b"}), Digest([]string{"b", "a"}))
+	assert.NotEqual(t, Digest([]string{"ab"}), Digest([]string{"a", "b"}))
+}
+
+func TestNewDependency(t *testing.T) {
+	commit := "a4ebc9d6ad64e34bd2ae1ee3fe8ec4a4a8ed7e13"
+	assert.Equal(t, ResourceDescriptor{URI: "https://github.com/org/repo", Digest: map[string]string{"gitCommit": commit}}, NewDependency("", "https://github.com/org/repo", commit, true))
+	assert.Equal(t, ResourceDescriptor{Name: "$values", URI: "oci://ghcr.io/org/app", Digest: map[string]string{"sha256": "abcd"}}, NewDependency("$values", "oci://ghcr.io/org/app", "sha256:abcd", false))
+	assert.Equal(t, ResourceDescriptor{Name: "nginx", URI: "https://charts.example.com", Annotations: map[string]string{"revision": "1.2.3"}}, NewDependency("nginx", "https://charts.example.com", "1.2.3", false))
+	assert.Equal(t, ResourceDescriptor{URI: "https://github.com/org/repo", Annotations: map[string]string{"revision": "main"}}, NewDependency("", "https://github.com/org/repo", "main", true))
+}
+
+func TestStatement_SourceRevision(t *testing.T) {
+	for _, revision := range []string{"a4ebc9d6ad64e34bd2ae1ee3fe8ec4a4a8ed7e13", "sha256:abcd", "1.2.3"} {
+		statement := newStatement()
+		statement.Predicate.BuildDefinition.ResolvedDependencies = []ResourceDescriptor{NewDependency("", "https://github.com/org/repo", revision, true)}
+		assert.Equal(t, revision, statement.SourceRevision())
+	}
+	assert.Empty(t, newStatement().SourceRevision())
+}
+
+func TestSignAndVerify(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ecDER, err := x509.MarshalECPrivateKey(ecKey)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	edPub, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	for name, tc := range map[string]struct {
+		key    []byte
+		public crypto.PublicKey
+	}{
+		"ECDSA":      {key: pkcs8(t, ecKey), public: &ecKey.PublicKey},
+		"ECDSA SEC1": {key: pemKey(t, "EC PRIVATE KEY", ecDER), public: &ecKey.PublicKey},
+		"RSA":        {key: pkcs8(t, rsaKey), public: &rsaKey.PublicKey},
+		"RSA PKCS#1": {key: pemKey(t, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey)), public: &rsaKey.PublicKey},
+		"Ed25519":    {key: pkcs8(t, edKey), public: edPub},
+	} {
+		t.Run(name, func(t *testing.T) {
+			signer, err := NewSigner(tc.key)
+			require.NoError(t, err)
+			envelope, err := signer.Sign(newStatement())
+			require.NoError(t, err)
+
+			statement, keyID, err := Verify(envelope, []crypto.PublicKey{&ecdsa.PublicKey{Curve: elliptic.P256(), X: ecKey.X, Y: ecKey.Y}, tc.public})
+			require.NoError(t, err)
+			assert.Equal(t, signer.KeyID(), keyID)
+			assert.Equal(t, newStatement(), statement)
+			assert.Equal(t, Digest([]string{`{"kind":"ConfigMap"}`}), statement.ManifestsDigest())
+		})
+	}
+}
+
+func TestVerify_Errors(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	signer, err := NewSigner(pkcs8(t, key))
+	require.NoError(t, err)
+	otherPub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	envelope, err := signer.Sign(newStatement())
+	require.NoError(t, err)
+
+	t.Run("UntrustedKey", func(t *testing.T) {
+		_, _, err := Verify(envelope, []crypto.PublicKey{otherPub})
+		require.EqualError(t, err, "provenance is not signed by any of the trusted public keys")
+	})
+	t.Run("TamperedPayload", func(t *testing.T) {
+		var env Envelope
+		require.NoError(t, json.Unmarshal([]byte(envelope), &env))
+		statement := newStatement()
+		statement.Subject = []ResourceDescriptor{NewSubject("guestbook", Digest(nil))}
+		payload, err := json.Marshal(statement)
+		require.NoError(t, err)
+		env.Payload = base64.StdEncoding.EncodeToString(payload)
+		tampered, err := json.Marshal(env)
+		require.NoError(t, err)
+		_, _, err = Verify(string(tampered), []crypto.PublicKey{key.Public()})
+		require.EqualError(t, err, "provenance is not signed by any of the trusted public keys")
+	})
+	t.Run("InvalidEnvelope", func(t *testing.T) {
+		_, _, err := Verify("not json", []crypto.PublicKey{key.Public()})
+		require.ErrorContains(t, err, "invalid provenance envelope")
+	})
+}
+
+func TestNewSigner_Errors(t *testing.T) {
+	_, err := NewSigner([]byte("not a key"))
+	require.EqualError(t, err, "no PEM data found in signing key")
+	_, err = NewSigner(pemKey(t, "PRIVATE KEY", []byte("garbage")))
+	require.ErrorContains(t, err, "failed to parse signing key")
+}
@@ -79,6 +79,8 @@ const (
 	ActionOverride = "override"
 	ActionAction   = "action"
 	ActionInvoke   = "invoke"
+	ActionPause    = "pause"
+	ActionFreeze   = "freeze"
 )
 
 var (
@@ -106,6 +108,8 @@ var (
 		ActionOverride,
 		ActionAction,
 		ActionInvoke,
+		ActionPause,
+		ActionFreeze,
 	}
 )
 
@@ -549,6 +549,7 @@ var sourceTypeToEnableGenerationKey = map[v1alpha1.ApplicationSourceType]string{
 	v1alpha1.ApplicationSourceTypeKustomize: "kustomize.enable",
 	v1alpha1.ApplicationSourceTypeHelm:      "helm.enable",
 	v1alpha1.ApplicationSourceTypeDirectory: "jsonnet.enable",
+	v1alpha1.ApplicationSourceTypeCUE:       "cue.enable",
 }
 
 // SettingsManager holds config info for a new manager with which to access Kubernetes ConfigMaps.
@@ -1035,7 +1036,9 @@ func (mgr *SettingsManager) appendResourceOverridesFromSplitKeys(cmData map[stri
 			continue
 		}
 
-		overrideKey, err := convertToOverrideKey(parts[3])
+		customizationType, groupKind := parts[2], parts[3]
+
+		overrideKey, err := convertToOverrideKey(groupKind)
 		if err != nil {
 			return err
 		}
@@ -1049,10 +1052,11 @@ func (mgr *SettingsManager) appendResourceOverridesFromSplitKeys(cmData map[stri
 			overrideVal = v1alpha1.ResourceOverride{}
 		}
 
-		customizationType := parts[2]
 		switch customizationType {
 		case "health":
 			overrideVal.HealthLua = v
+		case "healthCEL":
+			overrideVal.HealthCEL = v
 		case "useOpenLibs":
 			useOpenLibs, err := strconv.ParseBool(v)
 			if err != nil {
@@ -1093,6 +1097,7 @@ func (mgr *SettingsManager) appendResourceOverridesFromSplitKeys(cmData map[stri
 // Convert group_kind format to <group/kind>, allowed key format examples
 // resource.customizations.health.cert-manager.io_Certificate
 // resource.customizations.health.Certificate
+// resource.customizations.healthCEL.cert-manager.io_Certificate
 func convertToOverrideKey(groupKind string) (string, error) {
 	parts := strings.Split(groupKind, "_")
 	if len(parts) == 2 {
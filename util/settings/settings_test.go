@@ -428,6 +428,20 @@ func TestGetResourceOverridesHealthWithWildcard(t *testing.T) {
 	})
 }
 
+func TestGetResourceOverridesHealthCEL(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{
+		"resource.customizations": `
+    cert-manager.io/Certificate:
+      health.cel: |
+        {'status': 'Healthy'}`,
+	})
+
+	overrides, err := settingsManager.GetResourceOverrides()
+	require.NoError(t, err)
+	assert.Equal(t, "{'status': 'Healthy'}", overrides["cert-manager.io/Certificate"].HealthCEL)
+	assert.Empty(t, overrides["cert-manager.io/Certificate"].HealthLua)
+}
+
 func TestSettingsManager_GetResourceOverrides_with_empty_string(t *testing.T) {
 	_, settingsManager := fixtures(map[string]string{
 		resourceCustomizationsKey: "",
@@ -493,12 +507,15 @@ func TestGetResourceOverrides_with_splitted_keys(t *testing.T) {
   type: bar`,
 			"resource.customizations.health.certmanager.k8s.io_Certificate":      "bar",
 			"resource.customizations.health.cert-manager.io_Certificate":         "bar",
+			"resource.customizations.healthCEL.cert-manager.io_Certificate":      "baz",
 			"resource.customizations.useOpenLibs.certmanager.k8s.io_Certificate": "false",
 			"resource.customizations.useOpenLibs.cert-manager.io_Certificate":    "true",
 			"resource.customizations.actions.apps_Deployment":                    "bar",
 			"resource.customizations.actions.Deployment":                         "bar",
 			"resource.customizations.health.iam-manager.k8s.io_Iamrole":          "bar",
 			"resource.customizations.health.Iamrole":                             "bar",
+			"resource.customizations.healthCEL.Iamrole":                          "baz",
+			"resource.customizations.health.cel.example.io_Foo":                  "bar",
 			"resource.customizations.ignoreDifferences.iam-manager.k8s.io_Iamrole": `jsonPointers:
         - bar`,
 			"resource.customizations.ignoreDifferences.apps_Deployment": `jqPathExpressions:
@@ -516,7 +533,7 @@ func TestGetResourceOverrides_with_splitted_keys(t *testing.T) {
 
 		overrides, err := settingsManager.GetResourceOverrides()
 		require.NoError(t, err)
-		assert.Len(t, overrides, 8)
+		assert.Len(t, overrides, 9)
 		assert.Len(t, overrides["admissionregistration.k8s.io/MutatingWebhookConfiguration"].IgnoreDifferences.JSONPointers, 1)
 		assert.Equal(t, "bar", overrides["admissionregistration.k8s.io/MutatingWebhookConfiguration"].IgnoreDifferences.JSONPointers[0])
 		assert.Len(t, overrides["admissionregistration.k8s.io/MutatingWebhookConfiguration"].IgnoreResourceUpdates.JSONPointers, 1)
@@ -526,12 +543,17 @@ func TestGetResourceOverrides_with_splitted_keys(t *testing.T) {
 		assert.Equal(t, "bar", overrides["admissionregistration.k8s.io/MutatingWebhookConfiguration"].HealthLua)
 		assert.Equal(t, "bar", overrides["certmanager.k8s.io/Certificate"].HealthLua)
 		assert.Equal(t, "bar", overrides["cert-manager.io/Certificate"].HealthLua)
+		assert.Equal(t, "baz", overrides["cert-manager.io/Certificate"].HealthCEL)
 		assert.False(t, overrides["certmanager.k8s.io/Certificate"].UseOpenLibs)
 		assert.True(t, overrides["cert-manager.io/Certificate"].UseOpenLibs)
 		assert.Equal(t, "bar", overrides["apps/Deployment"].Actions)
 		assert.Equal(t, "bar", overrides["Deployment"].Actions)
 		assert.Equal(t, "bar", overrides["iam-manager.k8s.io/Iamrole"].HealthLua)
 		assert.Equal(t, "bar", overrides["Iamrole"].HealthLua)
+		assert.Equal(t, "baz", overrides["Iamrole"].HealthCEL)
+		// the Lua health check of a resource whose API group starts with "cel."
+		assert.Equal(t, "bar", overrides["cel.example.io/Foo"].HealthLua)
+		assert.Empty(t, overrides["cel.example.io/Foo"].HealthCEL)
 		assert.Len(t, overrides["iam-manager.k8s.io/Iamrole"].IgnoreDifferences.JSONPointers, 1)
 		assert.Len(t, overrides["apps/Deployment"].IgnoreDifferences.JQPathExpressions, 1)
 		assert.Equal(t, "bar", overrides["apps/Deployment"].IgnoreDifferences.JQPathExpressions[0])
@@ -1422,6 +1444,11 @@ func TestGetEnableManifestGeneration(t *testing.T) {
 		enabled: true,
 		data:    map[string]string{"kustomize.enable": `true`},
 		source:  string(v1alpha1.ApplicationSourceTypeKustomize),
+	}, {
+		name:    "cue disabled",
+		enabled: false,
+		data:    map[string]string{"cue.enable": `false`},
+		source:  string(v1alpha1.ApplicationSourceTypeCUE),
 	}}
 	for i := range testCases {
 		tc := testCases[i]
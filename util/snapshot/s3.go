@@ -0,0 +1,126 @@
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// snapshotSuffix is the suffix of the snapshot objects in the bucket
+const snapshotSuffix = ".json.gz"
+
+type s3Store struct {
+	client s3iface.S3API
+	bucket string
+	prefix string
+}
+
+// NewS3Store returns a store which keeps the snapshots as objects of an S3 compatible bucket, described by an
+// s3://bucket/prefix URL. The region, endpoint and forcePathStyle query parameters configure the client, the
+// credentials are taken from the default AWS credential chain.
+func NewS3Store(u *url.URL) (Store, error) {
+	if u.Host == "" {
+		return nil, fmt.Errorf("snapshot store %q does not specify a bucket", u.String())
+	}
+	config := aws.NewConfig()
+	query := u.Query()
+	if region := query.Get("region"); region != "" {
+		config = config.WithRegion(region)
+	}
+	if endpoint := query.Get("endpoint"); endpoint != "" {
+		config = config.WithEndpoint(endpoint)
+	}
+	if forcePathStyle := query.Get("forcePathStyle"); forcePathStyle != "" {
+		enabled, err := strconv.ParseBool(forcePathStyle)
+		if err != nil {
+			return nil, fmt.Errorf("invalid forcePathStyle parameter of snapshot store: %w", err)
+		}
+		config = config.WithS3ForcePathStyle(enabled)
+	}
+	sess, err := session.NewSessionWithOptions(session.Options{Config: *config, SharedConfigState: session.SharedConfigEnable})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AWS session: %w", err)
+	}
+	return newS3Store(s3.New(sess), u.Host, u.Path), nil
+}
+
+func newS3Store(client s3iface.S3API, bucket string, prefix string) *s3Store {
+	return &s3Store{client: client, bucket: bucket, prefix: strings.Trim(prefix, "/")}
+}
+
+func (s *s3Store) appPrefix(app string) string {
+	return path.Join(s.prefix, app) + "/"
+}
+
+func (s *s3Store) key(app string, id string) string {
+	return s.appPrefix(app) + id + snapshotSuffix
+}
+
+// Save stores the snapshot as an application/gzip object. The owner is ignored, snapshots of deleted applications
+// are expected to be expired by the lifecycle rules of the bucket.
+func (s *s3Store) Save(ctx context.Context, app string, id string, data []byte, _ *metav1.OwnerReference) error {
+	// the object must not be stored with a gzip content encoding, which HTTP clients transparently decode
+	_, err := s.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(s.key(app, id)),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String("application/gzip"),
+	})
+	return err
+}
+
+func (s *s3Store) Load(ctx context.Context, app string, id string) ([]byte, error) {
+	out, err := s.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(app, id)),
+	})
+	if err != nil {
+		var awsErr awserr.Error
+		if errors.As(err, &awsErr) && awsErr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	defer out.Body.Close()
+	return io.ReadAll(out.Body)
+}
+
+func (s *s3Store) List(ctx context.Context, app string) ([]string, error) {
+	prefix := s.appPrefix(app)
+	var ids []string
+	err := s.client.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket:    aws.String(s.bucket),
+		Prefix:    aws.String(prefix),
+		Delimiter: aws.String("/"),
+	}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, obj := range page.Contents {
+			name := strings.TrimPrefix(aws.StringValue(obj.Key), prefix)
+			if id, ok := strings.CutSuffix(name, snapshotSuffix); ok {
+				ids = append(ids, id)
+			}
+		}
+		return true
+	})
+	return ids, err
+}
+
+func (s *s3Store) Delete(ctx context.Context, app string, id string) error {
+	_, err := s.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(app, id)),
+	})
+	return err
+}
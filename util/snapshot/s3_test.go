@@ -0,0 +1,152 @@
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// fakeS3 keeps the objects of a single bucket in memory
+type fakeS3 struct {
+	s3iface.S3API
+	objects map[string][]byte
+}
+
+func (f *fakeS3) PutObjectWithContext(_ context.Context, in *s3.PutObjectInput, _ ...request.Option) (*s3.PutObjectOutput, error) {
+	data, err := io.ReadAll(in.Body)
+	if err != nil {
+		return nil, err
+	}
+	f.objects[aws.StringValue(in.Key)] = data
+	return &s3.PutObjectOutput{}, nil
+}
+
+func (f *fakeS3) GetObjectWithContext(_ context.Context, in *s3.GetObjectInput, _ ...request.Option) (*s3.GetObjectOutput, error) {
+	data, ok := f.objects[aws.StringValue(in.Key)]
+	if !ok {
+		return nil, awserr.New(s3.ErrCodeNoSuchKey, "not found", nil)
+	}
+	return &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader(data))}, nil
+}
+
+func (f *fakeS3) ListObjectsV2PagesWithContext(_ context.Context, in *s3.ListObjectsV2Input, fn func(*s3.ListObjectsV2Output, bool) bool, _ ...request.Option) error {
+	page := &s3.ListObjectsV2Output{}
+	for key := range f.objects {
+		name, ok := strings.CutPrefix(key, aws.StringValue(in.Prefix))
+		if ok && !strings.Contains(name, aws.StringValue(in.Delimiter)) {
+			page.Contents = append(page.Contents, &s3.Object{Key: aws.String(key)})
+		}
+	}
+	fn(page, true)
+	return nil
+}
+
+func (f *fakeS3) DeleteObjectWithContext(_ context.Context, in *s3.DeleteObjectInput, _ ...request.Option) (*s3.DeleteObjectOutput, error) {
+	delete(f.objects, aws.StringValue(in.Key))
+	return &s3.DeleteObjectOutput{}, nil
+}
+
+func TestS3Store(t *testing.T) {
+	client := &fakeS3{objects: map[string][]byte{}}
+	store := newS3Store(client, "snapshots", "/argocd/")
+
+	require.NoError(t, store.Save(t.Context(), "argocd/guestbook", "20250304050607", []byte("data"), nil))
+	require.NoError(t, store.Save(t.Context(), "argocd/guestbook/nested", "20250304050607", []byte("other"), nil))
+	assert.Contains(t, client.objects, "argocd/argocd/guestbook/20250304050607.json.gz")
+
+	ids, err := store.List(t.Context(), "argocd/guestbook")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"20250304050607"}, ids)
+
+	data, err := store.Load(t.Context(), "argocd/guestbook", "20250304050607")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data"), data)
+
+	require.NoError(t, store.Delete(t.Context(), "argocd/guestbook", "20250304050607"))
+	_, err = store.Load(t.Context(), "argocd/guestbook", "20250304050607")
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+// s3Server serves the objects of a single bucket over HTTP like S3, storing the headers they were put with
+type s3Server struct {
+	lock    sync.Mutex
+	objects map[string][]byte
+	headers map[string]http.Header
+}
+
+func (s *s3Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		s.objects[r.URL.Path] = data
+		s.headers[r.URL.Path] = http.Header{
+			"Content-Type":     r.Header.Values("Content-Type"),
+			"Content-Encoding": r.Header.Values("Content-Encoding"),
+		}
+	case http.MethodGet:
+		data, ok := s.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`<Error><Code>NoSuchKey</Code></Error>`))
+			return
+		}
+		for key, values := range s.headers[r.URL.Path] {
+			for _, value := range values {
+				w.Header().Add(key, value)
+			}
+		}
+		_, _ = w.Write(data)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestS3Store_HTTP(t *testing.T) {
+	server := &s3Server{objects: map[string][]byte{}, headers: map[string]http.Header{}}
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+
+	sess, err := session.NewSession(aws.NewConfig().
+		WithEndpoint(httpServer.URL).
+		WithRegion("us-east-1").
+		WithS3ForcePathStyle(true).
+		WithCredentials(credentials.NewStaticCredentials("access", "secret", "")))
+	require.NoError(t, err)
+	store := newS3Store(s3.New(sess), "snapshots", "argocd")
+
+	createdAt := time.Date(2025, 3, 4, 5, 6, 7, 0, time.UTC)
+	require.NoError(t, Save(t.Context(), store, New("argocd/guestbook", "abc123", createdAt, []*unstructured.Unstructured{newLiveConfigMap("my-config")}), 0, nil))
+	assert.Equal(t, "application/gzip", server.headers["/snapshots/argocd/argocd/guestbook/20250304050607.json.gz"].Get("Content-Type"))
+
+	// the snapshot is decoded from the body returned through the HTTP transport
+	snapshot, err := Load(t.Context(), store, "argocd/guestbook", "20250304050607")
+	require.NoError(t, err)
+	require.Len(t, snapshot.Resources, 1)
+	assert.Equal(t, "my-config", snapshot.Resources[0].GetName())
+
+	_, err = Load(t.Context(), store, "argocd/guestbook", "20250304050608")
+	require.ErrorIs(t, err, ErrNotFound)
+}
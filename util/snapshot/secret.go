@@ -0,0 +1,115 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-cd/v3/util/hash"
+)
+
+const (
+	// labelKeySnapshotApp holds the hash of the qualified name of the application a snapshot Secret belongs to
+	labelKeySnapshotApp = "argocd.argoproj.io/snapshot-app"
+	// annotationKeySnapshotApp holds the qualified name of the application a snapshot Secret belongs to
+	annotationKeySnapshotApp = "argocd.argoproj.io/snapshot-app"
+	// annotationKeySnapshotID holds the ID of the snapshot stored in a Secret
+	annotationKeySnapshotID = "argocd.argoproj.io/snapshot-id"
+	// secretKeySnapshot is the key of the compressed snapshot in a snapshot Secret
+	secretKeySnapshot = "snapshot.json.gz"
+)
+
+type secretStore struct {
+	kubeClientset kubernetes.Interface
+	namespace     string
+}
+
+// NewSecretStore returns a store which keeps every snapshot in a Secret of the given namespace. Secrets are limited to
+// 1MiB, which is enough for the compressed state of most applications.
+func NewSecretStore(kubeClientset kubernetes.Interface, namespace string) Store {
+	return &secretStore{kubeClientset: kubeClientset, namespace: namespace}
+}
+
+func appHash(app string) string {
+	return fmt.Sprintf("%08x", hash.FNVa(app))
+}
+
+func secretName(app string, id string) string {
+	return fmt.Sprintf("argocd-snapshot-%s-%s", appHash(app), id)
+}
+
+// Save stores the snapshot in a Secret owned by the given owner, so that it is garbage collected with the application
+func (s *secretStore) Save(ctx context.Context, app string, id string, data []byte, owner *metav1.OwnerReference) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: secretName(app, id),
+			Labels: map[string]string{
+				labelKeySnapshotApp: appHash(app),
+			},
+			Annotations: map[string]string{
+				annotationKeySnapshotApp: app,
+				annotationKeySnapshotID:  id,
+			},
+		},
+		Data: map[string][]byte{secretKeySnapshot: data},
+	}
+	if owner != nil {
+		secret.OwnerReferences = []metav1.OwnerReference{*owner}
+	}
+	_, err := s.kubeClientset.CoreV1().Secrets(s.namespace).Create(ctx, secret, metav1.CreateOptions{})
+	return err
+}
+
+func (s *secretStore) get(ctx context.Context, app string, id string) (*corev1.Secret, error) {
+	secret, err := s.kubeClientset.CoreV1().Secrets(s.namespace).Get(ctx, secretName(app, id), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	// the name only contains a hash of the application name
+	if secret.Annotations[annotationKeySnapshotApp] != app {
+		return nil, ErrNotFound
+	}
+	return secret, nil
+}
+
+func (s *secretStore) Load(ctx context.Context, app string, id string) ([]byte, error) {
+	secret, err := s.get(ctx, app, id)
+	if err != nil {
+		return nil, err
+	}
+	return secret.Data[secretKeySnapshot], nil
+}
+
+func (s *secretStore) List(ctx context.Context, app string) ([]string, error) {
+	secrets, err := s.kubeClientset.CoreV1().Secrets(s.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", labelKeySnapshotApp, appHash(app)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, secret := range secrets.Items {
+		if secret.Annotations[annotationKeySnapshotApp] == app {
+			ids = append(ids, secret.Annotations[annotationKeySnapshotID])
+		}
+	}
+	return ids, nil
+}
+
+func (s *secretStore) Delete(ctx context.Context, app string, id string) error {
+	if _, err := s.get(ctx, app, id); err != nil {
+		return err
+	}
+	err := s.kubeClientset.CoreV1().Secrets(s.namespace).Delete(ctx, secretName(app, id), metav1.DeleteOptions{})
+	if apierrors.IsNotFound(err) {
+		return ErrNotFound
+	}
+	return err
+}
@@ -0,0 +1,207 @@
+// Package snapshot stores the live state of application resources captured before a sync operation modifies or
+// prunes them, so that the state can be restored later even if it was never committed to Git.
+package snapshot
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// StoreSecret stores the snapshots in Secrets of the Argo CD namespace
+	StoreSecret = "secret"
+	// idFormat is the format of the snapshot IDs, which sort in the order the snapshots were taken
+	idFormat = "20060102150405"
+)
+
+// ErrNotFound is returned by a Store if the requested snapshot does not exist
+var ErrNotFound = errors.New("snapshot not found")
+
+// Snapshot is the live state of the resources of an application taken before a sync operation
+type Snapshot struct {
+	// ID identifies the snapshot among the snapshots of the application
+	ID string `json:"id"`
+	// Application is the qualified name of the application the snapshot was taken of
+	Application string `json:"application"`
+	// Revision is the revision the sync operation which took the snapshot was syncing to
+	Revision string `json:"revision,omitempty"`
+	// CreatedAt is the time the snapshot was taken at
+	CreatedAt metav1.Time `json:"createdAt"`
+	// Resources is the live state of the resources which were modified or pruned by the sync operation
+	Resources []*unstructured.Unstructured `json:"resources"`
+}
+
+// Store persists the compressed snapshots of applications
+type Store interface {
+	// Save stores the snapshot data of the given application under the given ID. The owner, if any, is the object the
+	// stored snapshot should be garbage collected with.
+	Save(ctx context.Context, app string, id string, data []byte, owner *metav1.OwnerReference) error
+	// Load returns the snapshot data of the given application, or ErrNotFound if it does not exist
+	Load(ctx context.Context, app string, id string) ([]byte, error)
+	// List returns the IDs of the snapshots of the given application
+	List(ctx context.Context, app string) ([]string, error)
+	// Delete removes the snapshot of the given application
+	Delete(ctx context.Context, app string, id string) error
+}
+
+// NewStore returns the store described by the given specification, which is either "secret" or an
+// s3://bucket/prefix?region=...&endpoint=... URL
+func NewStore(spec string, kubeClientset kubernetes.Interface, namespace string) (Store, error) {
+	if spec == "" || spec == StoreSecret {
+		return NewSecretStore(kubeClientset, namespace), nil
+	}
+	u, err := url.Parse(spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse snapshot store %q: %w", spec, err)
+	}
+	if u.Scheme != "s3" {
+		return nil, fmt.Errorf("unsupported snapshot store %q: must be %q or an s3:// URL", spec, StoreSecret)
+	}
+	return NewS3Store(u)
+}
+
+// NewID returns the ID of a snapshot taken at the given time
+func NewID(t time.Time) string {
+	return t.UTC().Format(idFormat)
+}
+
+// New returns a snapshot of the given live objects, stripped from the metadata and status set by the cluster. Secrets
+// are left out, so that their data is never written to the store.
+func New(app string, revision string, createdAt time.Time, lives []*unstructured.Unstructured) *Snapshot {
+	resources := make([]*unstructured.Unstructured, 0, len(lives))
+	for _, live := range lives {
+		if IsSecret(live) {
+			continue
+		}
+		resources = append(resources, sanitize(live))
+	}
+	return &Snapshot{
+		ID:          NewID(createdAt),
+		Application: app,
+		Revision:    revision,
+		CreatedAt:   metav1.NewTime(createdAt),
+		Resources:   resources,
+	}
+}
+
+// IsSecret returns whether the given object is a Secret, which is never included in snapshots
+func IsSecret(obj *unstructured.Unstructured) bool {
+	gvk := obj.GroupVersionKind()
+	return gvk.Group == "" && gvk.Kind == "Secret"
+}
+
+func sanitize(live *unstructured.Unstructured) *unstructured.Unstructured {
+	obj := live.DeepCopy()
+	for _, field := range []string{"managedFields", "resourceVersion", "uid", "creationTimestamp", "generation", "selfLink", "deletionTimestamp", "deletionGracePeriodSeconds"} {
+		unstructured.RemoveNestedField(obj.Object, "metadata", field)
+	}
+	unstructured.RemoveNestedField(obj.Object, "status")
+	if annotations := obj.GetAnnotations(); annotations != nil {
+		delete(annotations, corev1.LastAppliedConfigAnnotation)
+		if len(annotations) == 0 {
+			annotations = nil
+		}
+		obj.SetAnnotations(annotations)
+	}
+	return obj
+}
+
+// Manifests returns the resources of the snapshot as manifests
+func (s *Snapshot) Manifests() ([]string, error) {
+	manifests := make([]string, len(s.Resources))
+	for i, res := range s.Resources {
+		data, err := json.Marshal(res)
+		if err != nil {
+			return nil, err
+		}
+		manifests[i] = string(data)
+	}
+	return manifests, nil
+}
+
+func encode(snapshot *Snapshot) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(w).Encode(snapshot); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decode(data []byte) (*Snapshot, error) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	uncompressed, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var snapshot Snapshot
+	if err := json.Unmarshal(uncompressed, &snapshot); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+// Save compresses and stores the snapshot, then deletes the oldest snapshots of the application so that at most
+// retention snapshots are kept. A retention lower than 1 keeps all snapshots. The owner, if any, is passed to the
+// store.
+func Save(ctx context.Context, store Store, snapshot *Snapshot, retention int, owner *metav1.OwnerReference) error {
+	data, err := encode(snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+	if err := store.Save(ctx, snapshot.Application, snapshot.ID, data, owner); err != nil {
+		return fmt.Errorf("failed to save snapshot %s: %w", snapshot.ID, err)
+	}
+	if retention < 1 {
+		return nil
+	}
+	ids, err := store.List(ctx, snapshot.Application)
+	if err != nil {
+		return fmt.Errorf("failed to list snapshots: %w", err)
+	}
+	slices.Sort(ids)
+	for len(ids) > retention {
+		if err := store.Delete(ctx, snapshot.Application, ids[0]); err != nil && !errors.Is(err, ErrNotFound) {
+			return fmt.Errorf("failed to delete snapshot %s: %w", ids[0], err)
+		}
+		ids = ids[1:]
+	}
+	return nil
+}
+
+// Load returns the snapshot of the application with the given ID
+func Load(ctx context.Context, store Store, app string, id string) (*Snapshot, error) {
+	if strings.TrimSpace(id) == "" {
+		return nil, errors.New("snapshot ID must not be empty")
+	}
+	data, err := store.Load(ctx, app, id)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := decode(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot %s: %w", id, err)
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,127 @@
+package snapshot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newLiveConfigMap(name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]any{
+			"name":              name,
+			"namespace":         "default",
+			"uid":               "3f1c8a2e",
+			"resourceVersion":   "123",
+			"creationTimestamp": "2025-01-01T00:00:00Z",
+			"managedFields":     []any{map[string]any{"manager": "kubectl-edit"}},
+			"annotations": map[string]any{
+				corev1.LastAppliedConfigAnnotation: `{"data":{"key":"old"}}`,
+			},
+			"labels": map[string]any{"app": "guestbook"},
+		},
+		"data": map[string]any{"key": "hotfix"},
+	}}
+}
+
+func TestNew(t *testing.T) {
+	live := newLiveConfigMap("my-config")
+	createdAt := time.Date(2025, 3, 4, 5, 6, 7, 0, time.UTC)
+	snapshot := New("argocd/guestbook", "abc123", createdAt, []*unstructured.Unstructured{live})
+
+	assert.Equal(t, "20250304050607", snapshot.ID)
+	assert.Equal(t, "argocd/guestbook", snapshot.Application)
+	assert.Equal(t, "abc123", snapshot.Revision)
+	require.Len(t, snapshot.Resources, 1)
+	assert.Equal(t, map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]any{
+			"name":      "my-config",
+			"namespace": "default",
+			"labels":    map[string]any{"app": "guestbook"},
+		},
+		"data": map[string]any{"key": "hotfix"},
+	}, snapshot.Resources[0].Object)
+	// the live object is left untouched
+	assert.Equal(t, "123", live.GetResourceVersion())
+
+	secret := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]any{"name": "my-secret", "namespace": "default"},
+		"data":       map[string]any{"password": "c2VjcmV0"},
+	}}
+	snapshot = New("argocd/guestbook", "abc123", createdAt, []*unstructured.Unstructured{secret, live})
+	require.Len(t, snapshot.Resources, 1)
+	assert.Equal(t, "ConfigMap", snapshot.Resources[0].GetKind())
+}
+
+func TestSaveLoad(t *testing.T) {
+	store := NewSecretStore(fake.NewClientset(), "argocd")
+	start := time.Date(2025, 3, 4, 5, 6, 7, 0, time.UTC)
+	for i := range 3 {
+		snapshot := New("argocd/guestbook", "abc123", start.Add(time.Duration(i)*time.Minute), []*unstructured.Unstructured{newLiveConfigMap("my-config")})
+		require.NoError(t, Save(t.Context(), store, snapshot, 2, nil))
+	}
+	require.NoError(t, Save(t.Context(), store, New("argocd/other", "abc123", start, nil), 2, nil))
+
+	ids, err := store.List(t.Context(), "argocd/guestbook")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"20250304050707", "20250304050807"}, ids)
+
+	snapshot, err := Load(t.Context(), store, "argocd/guestbook", "20250304050807")
+	require.NoError(t, err)
+	assert.Equal(t, "argocd/guestbook", snapshot.Application)
+	require.Len(t, snapshot.Resources, 1)
+	manifests, err := snapshot.Manifests()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"my-config","namespace":"default","labels":{"app":"guestbook"}},"data":{"key":"hotfix"}}`, manifests[0])
+
+	_, err = Load(t.Context(), store, "argocd/guestbook", "20250304050607")
+	require.ErrorIs(t, err, ErrNotFound)
+	_, err = Load(t.Context(), store, "argocd/other", "20250304050807")
+	require.ErrorIs(t, err, ErrNotFound)
+	_, err = Load(t.Context(), store, "argocd/guestbook", "")
+	require.EqualError(t, err, "snapshot ID must not be empty")
+}
+
+func TestSecretStore_Owner(t *testing.T) {
+	clientset := fake.NewClientset()
+	store := NewSecretStore(clientset, "argocd")
+	owner := &metav1.OwnerReference{APIVersion: "argoproj.io/v1alpha1", Kind: "Application", Name: "guestbook", UID: "4b6f5d1c"}
+	require.NoError(t, store.Save(t.Context(), "argocd/guestbook", "20250304050607", []byte("data"), owner))
+	require.NoError(t, store.Save(t.Context(), "apps/guestbook", "20250304050607", []byte("data"), nil))
+
+	secret, err := clientset.CoreV1().Secrets("argocd").Get(t.Context(), secretName("argocd/guestbook", "20250304050607"), metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []metav1.OwnerReference{*owner}, secret.OwnerReferences)
+	secret, err = clientset.CoreV1().Secrets("argocd").Get(t.Context(), secretName("apps/guestbook", "20250304050607"), metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, secret.OwnerReferences)
+}
+
+func TestNewStore(t *testing.T) {
+	store, err := NewStore("", fake.NewClientset(), "argocd")
+	require.NoError(t, err)
+	assert.IsType(t, &secretStore{}, store)
+
+	store, err = NewStore("s3://snapshots/argocd?region=eu-west-1&forcePathStyle=true", fake.NewClientset(), "argocd")
+	require.NoError(t, err)
+	require.IsType(t, &s3Store{}, store)
+	assert.Equal(t, "snapshots", store.(*s3Store).bucket)
+	assert.Equal(t, "argocd", store.(*s3Store).prefix)
+
+	_, err = NewStore("gs://snapshots", fake.NewClientset(), "argocd")
+	require.EqualError(t, err, `unsupported snapshot store "gs://snapshots": must be "secret" or an s3:// URL`)
+	_, err = NewStore("s3://snapshots?forcePathStyle=maybe", fake.NewClientset(), "argocd")
+	require.ErrorContains(t, err, "invalid forcePathStyle parameter")
+}